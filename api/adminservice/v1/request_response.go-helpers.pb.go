@@ -3260,3 +3260,595 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type GetDynamicConfigRequest to the protobuf v3 wire format
+func (val *GetDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigRequest from the protobuf v3 wire format
+func (val *GetDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigRequest
+	switch t := that.(type) {
+	case *GetDynamicConfigRequest:
+		that1 = t
+	case GetDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigResponse to the protobuf v3 wire format
+func (val *GetDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigResponse from the protobuf v3 wire format
+func (val *GetDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigResponse
+	switch t := that.(type) {
+	case *GetDynamicConfigResponse:
+		that1 = t
+	case GetDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigRequest to the protobuf v3 wire format
+func (val *SetDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigRequest from the protobuf v3 wire format
+func (val *SetDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigRequest
+	switch t := that.(type) {
+	case *SetDynamicConfigRequest:
+		that1 = t
+	case SetDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigResponse to the protobuf v3 wire format
+func (val *SetDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigResponse from the protobuf v3 wire format
+func (val *SetDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigResponse
+	switch t := that.(type) {
+	case *SetDynamicConfigResponse:
+		that1 = t
+	case SetDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteDynamicConfigRequest to the protobuf v3 wire format
+func (val *DeleteDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteDynamicConfigRequest from the protobuf v3 wire format
+func (val *DeleteDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteDynamicConfigRequest
+	switch t := that.(type) {
+	case *DeleteDynamicConfigRequest:
+		that1 = t
+	case DeleteDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteDynamicConfigResponse to the protobuf v3 wire format
+func (val *DeleteDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteDynamicConfigResponse from the protobuf v3 wire format
+func (val *DeleteDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteDynamicConfigResponse
+	switch t := that.(type) {
+	case *DeleteDynamicConfigResponse:
+		that1 = t
+	case DeleteDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigRequest to the protobuf v3 wire format
+func (val *ListDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigRequest from the protobuf v3 wire format
+func (val *ListDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigRequest
+	switch t := that.(type) {
+	case *ListDynamicConfigRequest:
+		that1 = t
+	case ListDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigResponse to the protobuf v3 wire format
+func (val *ListDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigResponse from the protobuf v3 wire format
+func (val *ListDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigResponse
+	switch t := that.(type) {
+	case *ListDynamicConfigResponse:
+		that1 = t
+	case ListDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigHistoryRequest to the protobuf v3 wire format
+func (val *ListDynamicConfigHistoryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigHistoryRequest from the protobuf v3 wire format
+func (val *ListDynamicConfigHistoryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigHistoryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigHistoryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigHistoryRequest
+	switch t := that.(type) {
+	case *ListDynamicConfigHistoryRequest:
+		that1 = t
+	case ListDynamicConfigHistoryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigHistoryResponse to the protobuf v3 wire format
+func (val *ListDynamicConfigHistoryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigHistoryResponse from the protobuf v3 wire format
+func (val *ListDynamicConfigHistoryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigHistoryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigHistoryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigHistoryResponse
+	switch t := that.(type) {
+	case *ListDynamicConfigHistoryResponse:
+		that1 = t
+	case ListDynamicConfigHistoryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListConcreteExecutionsRequest to the protobuf v3 wire format
+func (val *ListConcreteExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListConcreteExecutionsRequest from the protobuf v3 wire format
+func (val *ListConcreteExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListConcreteExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListConcreteExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListConcreteExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListConcreteExecutionsRequest
+	switch t := that.(type) {
+	case *ListConcreteExecutionsRequest:
+		that1 = t
+	case ListConcreteExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListConcreteExecutionsResponse to the protobuf v3 wire format
+func (val *ListConcreteExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListConcreteExecutionsResponse from the protobuf v3 wire format
+func (val *ListConcreteExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListConcreteExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListConcreteExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListConcreteExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListConcreteExecutionsResponse
+	switch t := that.(type) {
+	case *ListConcreteExecutionsResponse:
+		that1 = t
+	case ListConcreteExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHistoryTreeBranchesRequest to the protobuf v3 wire format
+func (val *ListHistoryTreeBranchesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHistoryTreeBranchesRequest from the protobuf v3 wire format
+func (val *ListHistoryTreeBranchesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHistoryTreeBranchesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHistoryTreeBranchesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHistoryTreeBranchesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHistoryTreeBranchesRequest
+	switch t := that.(type) {
+	case *ListHistoryTreeBranchesRequest:
+		that1 = t
+	case ListHistoryTreeBranchesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHistoryTreeBranchesResponse to the protobuf v3 wire format
+func (val *ListHistoryTreeBranchesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHistoryTreeBranchesResponse from the protobuf v3 wire format
+func (val *ListHistoryTreeBranchesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHistoryTreeBranchesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHistoryTreeBranchesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHistoryTreeBranchesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHistoryTreeBranchesResponse
+	switch t := that.(type) {
+	case *ListHistoryTreeBranchesResponse:
+		that1 = t
+	case ListHistoryTreeBranchesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetReplicationStatusRequest to the protobuf v3 wire format
+func (val *GetReplicationStatusRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetReplicationStatusRequest from the protobuf v3 wire format
+func (val *GetReplicationStatusRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetReplicationStatusRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetReplicationStatusRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetReplicationStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetReplicationStatusRequest
+	switch t := that.(type) {
+	case *GetReplicationStatusRequest:
+		that1 = t
+	case GetReplicationStatusRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetReplicationStatusResponse to the protobuf v3 wire format
+func (val *GetReplicationStatusResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetReplicationStatusResponse from the protobuf v3 wire format
+func (val *GetReplicationStatusResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetReplicationStatusResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetReplicationStatusResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetReplicationStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetReplicationStatusResponse
+	switch t := that.(type) {
+	case *GetReplicationStatusResponse:
+		that1 = t
+	case GetReplicationStatusResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -25,7 +25,7 @@ var File_temporal_server_api_adminservice_v1_service_proto protoreflect.FileDesc
 
 const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto2\xbf4\n" +
+	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto2\xb6>\n" +
 	"\fAdminService\x12\x9a\x01\n" +
 	"\x13RebuildMutableState\x12?.temporal.server.api.adminservice.v1.RebuildMutableStateRequest\x1a@.temporal.server.api.adminservice.v1.RebuildMutableStateResponse\"\x00\x12\xa6\x01\n" +
 	"\x17ImportWorkflowExecution\x12C.temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse\"\x00\x12\x9d\x01\n" +
@@ -72,7 +72,15 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\x11SyncWorkflowState\x12=.temporal.server.api.adminservice.v1.SyncWorkflowStateRequest\x1a>.temporal.server.api.adminservice.v1.SyncWorkflowStateResponse\"\x00\x12\xca\x01\n" +
 	"#GenerateLastHistoryReplicationTasks\x12O.temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest\x1aP.temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse\"\x00\x12\xaf\x01\n" +
 	"\x1aDescribeTaskQueuePartition\x12F.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest\x1aG.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse\"\x00\x12\xb8\x01\n" +
-	"\x1dForceUnloadTaskQueuePartition\x12I.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest\x1aJ.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse\"\x00B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x1dForceUnloadTaskQueuePartition\x12I.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest\x1aJ.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse\"\x00\x12\x91\x01\n" +
+	"\x10GetDynamicConfig\x12<.temporal.server.api.adminservice.v1.GetDynamicConfigRequest\x1a=.temporal.server.api.adminservice.v1.GetDynamicConfigResponse\"\x00\x12\x91\x01\n" +
+	"\x10SetDynamicConfig\x12<.temporal.server.api.adminservice.v1.SetDynamicConfigRequest\x1a=.temporal.server.api.adminservice.v1.SetDynamicConfigResponse\"\x00\x12\x9a\x01\n" +
+	"\x13DeleteDynamicConfig\x12?.temporal.server.api.adminservice.v1.DeleteDynamicConfigRequest\x1a@.temporal.server.api.adminservice.v1.DeleteDynamicConfigResponse\"\x00\x12\x94\x01\n" +
+	"\x11ListDynamicConfig\x12=.temporal.server.api.adminservice.v1.ListDynamicConfigRequest\x1a>.temporal.server.api.adminservice.v1.ListDynamicConfigResponse\"\x00\x12\xa9\x01\n" +
+	"\x18ListDynamicConfigHistory\x12D.temporal.server.api.adminservice.v1.ListDynamicConfigHistoryRequest\x1aE.temporal.server.api.adminservice.v1.ListDynamicConfigHistoryResponse\"\x00\x12\xa3\x01\n" +
+	"\x16ListConcreteExecutions\x12B.temporal.server.api.adminservice.v1.ListConcreteExecutionsRequest\x1aC.temporal.server.api.adminservice.v1.ListConcreteExecutionsResponse\"\x00\x12\xa6\x01\n" +
+	"\x17ListHistoryTreeBranches\x12C.temporal.server.api.adminservice.v1.ListHistoryTreeBranchesRequest\x1aD.temporal.server.api.adminservice.v1.ListHistoryTreeBranchesResponse\"\x00\x12\x9d\x01\n" +
+	"\x14GetReplicationStatus\x12@.temporal.server.api.adminservice.v1.GetReplicationStatusRequest\x1aA.temporal.server.api.adminservice.v1.GetReplicationStatusResponse\"\x00B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
@@ -118,142 +126,174 @@ var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 40: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
 	(*DescribeTaskQueuePartitionRequest)(nil),           // 41: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
 	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 42: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*RebuildMutableStateResponse)(nil),                 // 43: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionResponse)(nil),             // 44: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateResponse)(nil),                // 45: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostResponse)(nil),                 // 46: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*GetShardResponse)(nil),                            // 47: temporal.server.api.adminservice.v1.GetShardResponse
-	(*CloseShardResponse)(nil),                          // 48: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*ListHistoryTasksResponse)(nil),                    // 49: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*RemoveTaskResponse)(nil),                          // 50: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 51: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 52: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesResponse)(nil),              // 53: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 54: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesResponse)(nil),           // 55: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsResponse)(nil),                       // 56: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesResponse)(nil),                 // 57: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesResponse)(nil),              // 58: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesResponse)(nil),                 // 59: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterResponse)(nil),                     // 60: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersResponse)(nil),                        // 61: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*ListClusterMembersResponse)(nil),                  // 62: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 63: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterResponse)(nil),                 // 64: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*GetDLQMessagesResponse)(nil),                      // 65: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesResponse)(nil),                    // 66: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesResponse)(nil),                    // 67: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksResponse)(nil),                // 68: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksResponse)(nil),              // 69: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksResponse)(nil),                   // 70: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionResponse)(nil),             // 71: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 72: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceResponse)(nil),                        // 73: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksResponse)(nil),                         // 74: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksResponse)(nil),                       // 75: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*MergeDLQTasksResponse)(nil),                       // 76: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobResponse)(nil),                      // 77: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobResponse)(nil),                        // 78: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksResponse)(nil),                            // 79: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesResponse)(nil),                          // 80: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckResponse)(nil),                     // 81: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateResponse)(nil),                   // 82: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 85: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*GetDynamicConfigRequest)(nil),                     // 43: temporal.server.api.adminservice.v1.GetDynamicConfigRequest
+	(*SetDynamicConfigRequest)(nil),                     // 44: temporal.server.api.adminservice.v1.SetDynamicConfigRequest
+	(*DeleteDynamicConfigRequest)(nil),                  // 45: temporal.server.api.adminservice.v1.DeleteDynamicConfigRequest
+	(*ListDynamicConfigRequest)(nil),                    // 46: temporal.server.api.adminservice.v1.ListDynamicConfigRequest
+	(*ListDynamicConfigHistoryRequest)(nil),             // 47: temporal.server.api.adminservice.v1.ListDynamicConfigHistoryRequest
+	(*ListConcreteExecutionsRequest)(nil),               // 48: temporal.server.api.adminservice.v1.ListConcreteExecutionsRequest
+	(*ListHistoryTreeBranchesRequest)(nil),              // 49: temporal.server.api.adminservice.v1.ListHistoryTreeBranchesRequest
+	(*GetReplicationStatusRequest)(nil),                 // 50: temporal.server.api.adminservice.v1.GetReplicationStatusRequest
+	(*RebuildMutableStateResponse)(nil),                 // 51: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionResponse)(nil),             // 52: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*DescribeMutableStateResponse)(nil),                // 53: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostResponse)(nil),                 // 54: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*GetShardResponse)(nil),                            // 55: temporal.server.api.adminservice.v1.GetShardResponse
+	(*CloseShardResponse)(nil),                          // 56: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*ListHistoryTasksResponse)(nil),                    // 57: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*RemoveTaskResponse)(nil),                          // 58: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 59: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 60: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesResponse)(nil),              // 61: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesResponse)(nil),     // 62: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesResponse)(nil),           // 63: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsResponse)(nil),                       // 64: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesResponse)(nil),                 // 65: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesResponse)(nil),              // 66: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesResponse)(nil),                 // 67: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterResponse)(nil),                     // 68: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersResponse)(nil),                        // 69: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*ListClusterMembersResponse)(nil),                  // 70: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*AddOrUpdateRemoteClusterResponse)(nil),            // 71: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterResponse)(nil),                 // 72: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*GetDLQMessagesResponse)(nil),                      // 73: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesResponse)(nil),                    // 74: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesResponse)(nil),                    // 75: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksResponse)(nil),                // 76: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksResponse)(nil),              // 77: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksResponse)(nil),                   // 78: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionResponse)(nil),             // 79: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 80: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceResponse)(nil),                        // 81: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksResponse)(nil),                         // 82: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksResponse)(nil),                       // 83: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*MergeDLQTasksResponse)(nil),                       // 84: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobResponse)(nil),                      // 85: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobResponse)(nil),                        // 86: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksResponse)(nil),                            // 87: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesResponse)(nil),                          // 88: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckResponse)(nil),                     // 89: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateResponse)(nil),                   // 90: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 91: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 92: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 93: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*GetDynamicConfigResponse)(nil),                    // 94: temporal.server.api.adminservice.v1.GetDynamicConfigResponse
+	(*SetDynamicConfigResponse)(nil),                    // 95: temporal.server.api.adminservice.v1.SetDynamicConfigResponse
+	(*DeleteDynamicConfigResponse)(nil),                 // 96: temporal.server.api.adminservice.v1.DeleteDynamicConfigResponse
+	(*ListDynamicConfigResponse)(nil),                   // 97: temporal.server.api.adminservice.v1.ListDynamicConfigResponse
+	(*ListDynamicConfigHistoryResponse)(nil),            // 98: temporal.server.api.adminservice.v1.ListDynamicConfigHistoryResponse
+	(*ListConcreteExecutionsResponse)(nil),              // 99: temporal.server.api.adminservice.v1.ListConcreteExecutionsResponse
+	(*ListHistoryTreeBranchesResponse)(nil),             // 100: temporal.server.api.adminservice.v1.ListHistoryTreeBranchesResponse
+	(*GetReplicationStatusResponse)(nil),                // 101: temporal.server.api.adminservice.v1.GetReplicationStatusResponse
 }
 var file_temporal_server_api_adminservice_v1_service_proto_depIdxs = []int32{
-	0,  // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	1,  // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	2,  // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	3,  // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	4,  // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
-	5,  // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
-	6,  // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	7,  // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
-	8,  // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	9,  // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	10, // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	11, // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	12, // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	13, // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	14, // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	15, // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	16, // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	17, // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
-	18, // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
-	19, // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	20, // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	21, // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	22, // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	23, // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	24, // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	25, // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	26, // 26: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	27, // 27: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	28, // 28: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	29, // 29: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	30, // 30: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
-	31, // 31: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	32, // 32: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	33, // 33: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	34, // 34: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	35, // 35: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	36, // 36: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
-	37, // 37: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
-	38, // 38: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	39, // 39: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	40, // 40: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	41, // 41: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	42, // 42: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	43, // 43: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	44, // 44: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	45, // 45: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	46, // 46: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	47, // 47: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
-	48, // 48: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
-	49, // 49: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	50, // 50: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
-	51, // 51: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	52, // 52: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	53, // 53: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	54, // 54: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	55, // 55: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	56, // 56: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	57, // 57: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	58, // 58: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	59, // 59: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	60, // 60: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
-	61, // 61: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
-	62, // 62: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	63, // 63: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	64, // 64: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	65, // 65: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	66, // 66: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	67, // 67: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	68, // 68: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	69, // 69: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	70, // 70: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	71, // 71: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	72, // 72: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	73, // 73: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
-	74, // 74: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	75, // 75: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	76, // 76: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	77, // 77: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	78, // 78: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	79, // 79: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
-	80, // 80: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
-	81, // 81: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	82, // 82: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	83, // 83: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	84, // 84: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	85, // 85: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	43, // [43:86] is the sub-list for method output_type
-	0,  // [0:43] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	1,   // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	2,   // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	3,   // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	4,   // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
+	5,   // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
+	6,   // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	7,   // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
+	8,   // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	9,   // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	10,  // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	11,  // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	12,  // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	13,  // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	14,  // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	15,  // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	16,  // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	17,  // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
+	18,  // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
+	19,  // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	20,  // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	21,  // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	22,  // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	23,  // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	24,  // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	25,  // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	26,  // 26: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	27,  // 27: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	28,  // 28: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	29,  // 29: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	30,  // 30: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
+	31,  // 31: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	32,  // 32: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	33,  // 33: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	34,  // 34: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	35,  // 35: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	36,  // 36: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
+	37,  // 37: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
+	38,  // 38: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	39,  // 39: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	40,  // 40: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	41,  // 41: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	42,  // 42: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	43,  // 43: temporal.server.api.adminservice.v1.AdminService.GetDynamicConfig:input_type -> temporal.server.api.adminservice.v1.GetDynamicConfigRequest
+	44,  // 44: temporal.server.api.adminservice.v1.AdminService.SetDynamicConfig:input_type -> temporal.server.api.adminservice.v1.SetDynamicConfigRequest
+	45,  // 45: temporal.server.api.adminservice.v1.AdminService.DeleteDynamicConfig:input_type -> temporal.server.api.adminservice.v1.DeleteDynamicConfigRequest
+	46,  // 46: temporal.server.api.adminservice.v1.AdminService.ListDynamicConfig:input_type -> temporal.server.api.adminservice.v1.ListDynamicConfigRequest
+	47,  // 47: temporal.server.api.adminservice.v1.AdminService.ListDynamicConfigHistory:input_type -> temporal.server.api.adminservice.v1.ListDynamicConfigHistoryRequest
+	48,  // 48: temporal.server.api.adminservice.v1.AdminService.ListConcreteExecutions:input_type -> temporal.server.api.adminservice.v1.ListConcreteExecutionsRequest
+	49,  // 49: temporal.server.api.adminservice.v1.AdminService.ListHistoryTreeBranches:input_type -> temporal.server.api.adminservice.v1.ListHistoryTreeBranchesRequest
+	50,  // 50: temporal.server.api.adminservice.v1.AdminService.GetReplicationStatus:input_type -> temporal.server.api.adminservice.v1.GetReplicationStatusRequest
+	51,  // 51: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	52,  // 52: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	53,  // 53: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	54,  // 54: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	55,  // 55: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
+	56,  // 56: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
+	57,  // 57: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	58,  // 58: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
+	59,  // 59: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	60,  // 60: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	61,  // 61: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	62,  // 62: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	63,  // 63: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	64,  // 64: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	65,  // 65: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	66,  // 66: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	67,  // 67: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	68,  // 68: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
+	69,  // 69: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
+	70,  // 70: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	71,  // 71: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	72,  // 72: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	73,  // 73: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	74,  // 74: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	75,  // 75: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	76,  // 76: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	77,  // 77: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	78,  // 78: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	79,  // 79: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	80,  // 80: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	81,  // 81: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
+	82,  // 82: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	83,  // 83: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	84,  // 84: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	85,  // 85: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	86,  // 86: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	87,  // 87: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
+	88,  // 88: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
+	89,  // 89: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	90,  // 90: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	91,  // 91: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	92,  // 92: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	93,  // 93: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	94,  // 94: temporal.server.api.adminservice.v1.AdminService.GetDynamicConfig:output_type -> temporal.server.api.adminservice.v1.GetDynamicConfigResponse
+	95,  // 95: temporal.server.api.adminservice.v1.AdminService.SetDynamicConfig:output_type -> temporal.server.api.adminservice.v1.SetDynamicConfigResponse
+	96,  // 96: temporal.server.api.adminservice.v1.AdminService.DeleteDynamicConfig:output_type -> temporal.server.api.adminservice.v1.DeleteDynamicConfigResponse
+	97,  // 97: temporal.server.api.adminservice.v1.AdminService.ListDynamicConfig:output_type -> temporal.server.api.adminservice.v1.ListDynamicConfigResponse
+	98,  // 98: temporal.server.api.adminservice.v1.AdminService.ListDynamicConfigHistory:output_type -> temporal.server.api.adminservice.v1.ListDynamicConfigHistoryResponse
+	99,  // 99: temporal.server.api.adminservice.v1.AdminService.ListConcreteExecutions:output_type -> temporal.server.api.adminservice.v1.ListConcreteExecutionsResponse
+	100, // 100: temporal.server.api.adminservice.v1.AdminService.ListHistoryTreeBranches:output_type -> temporal.server.api.adminservice.v1.ListHistoryTreeBranchesResponse
+	101, // 101: temporal.server.api.adminservice.v1.AdminService.GetReplicationStatus:output_type -> temporal.server.api.adminservice.v1.GetReplicationStatusResponse
+	51,  // [51:102] is the sub-list for method output_type
+	0,   // [0:51] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_service_proto_init() }
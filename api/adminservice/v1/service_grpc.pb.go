@@ -63,6 +63,14 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_GetDynamicConfig_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig"
+	AdminService_SetDynamicConfig_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig"
+	AdminService_DeleteDynamicConfig_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfig"
+	AdminService_ListDynamicConfig_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig"
+	AdminService_ListDynamicConfigHistory_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigHistory"
+	AdminService_ListConcreteExecutions_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ListConcreteExecutions"
+	AdminService_ListHistoryTreeBranches_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTreeBranches"
+	AdminService_GetReplicationStatus_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationStatus"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -153,6 +161,27 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// GetDynamicConfig returns the dynamic config values stored in persistence for a key.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig stores a dynamic config value in persistence. Stored values are layered over the values from
+	// the dynamic config file on all hosts. The key must be a registered setting and the value and constraints must be
+	// valid for it. Fails with FailedPrecondition if persisted dynamic config is disabled in the server config.
+	SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error)
+	// DeleteDynamicConfig deletes a dynamic config value stored in persistence. Fails with FailedPrecondition if
+	// persisted dynamic config is disabled in the server config.
+	DeleteDynamicConfig(ctx context.Context, in *DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config values stored in persistence.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
+	// ListDynamicConfigHistory returns the changes made to the dynamic config values stored in persistence.
+	ListDynamicConfigHistory(ctx context.Context, in *ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*ListDynamicConfigHistoryResponse, error)
+	// ListConcreteExecutions returns the mutable states of all executions in a shard. It's meant for offline
+	// consistency checks and reads directly from persistence.
+	ListConcreteExecutions(ctx context.Context, in *ListConcreteExecutionsRequest, opts ...grpc.CallOption) (*ListConcreteExecutionsResponse, error)
+	// ListHistoryTreeBranches returns all history branches stored in persistence, across all shards.
+	ListHistoryTreeBranches(ctx context.Context, in *ListHistoryTreeBranchesRequest, opts ...grpc.CallOption) (*ListHistoryTreeBranchesResponse, error)
+	// GetReplicationStatus returns the replication status of every history shard of the cluster, as acknowledged by
+	// the remote clusters.
+	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
 }
 
 type adminServiceClient struct {
@@ -572,6 +601,78 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error) {
+	out := new(SetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDynamicConfig(ctx context.Context, in *DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigResponse, error) {
+	out := new(DeleteDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigHistory(ctx context.Context, in *ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*ListDynamicConfigHistoryResponse, error) {
+	out := new(ListDynamicConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDynamicConfigHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListConcreteExecutions(ctx context.Context, in *ListConcreteExecutionsRequest, opts ...grpc.CallOption) (*ListConcreteExecutionsResponse, error) {
+	out := new(ListConcreteExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListConcreteExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListHistoryTreeBranches(ctx context.Context, in *ListHistoryTreeBranchesRequest, opts ...grpc.CallOption) (*ListHistoryTreeBranchesResponse, error) {
+	out := new(ListHistoryTreeBranchesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListHistoryTreeBranches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error) {
+	out := new(GetReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetReplicationStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -660,6 +761,27 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// GetDynamicConfig returns the dynamic config values stored in persistence for a key.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig stores a dynamic config value in persistence. Stored values are layered over the values from
+	// the dynamic config file on all hosts. The key must be a registered setting and the value and constraints must be
+	// valid for it. Fails with FailedPrecondition if persisted dynamic config is disabled in the server config.
+	SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error)
+	// DeleteDynamicConfig deletes a dynamic config value stored in persistence. Fails with FailedPrecondition if
+	// persisted dynamic config is disabled in the server config.
+	DeleteDynamicConfig(context.Context, *DeleteDynamicConfigRequest) (*DeleteDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config values stored in persistence.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	// ListDynamicConfigHistory returns the changes made to the dynamic config values stored in persistence.
+	ListDynamicConfigHistory(context.Context, *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error)
+	// ListConcreteExecutions returns the mutable states of all executions in a shard. It's meant for offline
+	// consistency checks and reads directly from persistence.
+	ListConcreteExecutions(context.Context, *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+	// ListHistoryTreeBranches returns all history branches stored in persistence, across all shards.
+	ListHistoryTreeBranches(context.Context, *ListHistoryTreeBranchesRequest) (*ListHistoryTreeBranchesResponse, error)
+	// GetReplicationStatus returns the replication status of every history shard of the cluster, as acknowledged by
+	// the remote clusters.
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -796,6 +918,30 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteDynamicConfig(context.Context, *DeleteDynamicConfigRequest) (*DeleteDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDynamicConfigHistory(context.Context, *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) ListConcreteExecutions(context.Context, *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConcreteExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) ListHistoryTreeBranches(context.Context, *ListHistoryTreeBranchesRequest) (*ListHistoryTreeBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTreeBranches not implemented")
+}
+func (UnimplementedAdminServiceServer) GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationStatus not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1591,6 +1737,150 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, req.(*SetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDynamicConfig(ctx, req.(*DeleteDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDynamicConfigHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfigHistory(ctx, req.(*ListDynamicConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListConcreteExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConcreteExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListConcreteExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListConcreteExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListConcreteExecutions(ctx, req.(*ListConcreteExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListHistoryTreeBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryTreeBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListHistoryTreeBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListHistoryTreeBranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListHistoryTreeBranches(ctx, req.(*ListHistoryTreeBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetReplicationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, req.(*GetReplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1766,6 +2056,38 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfig",
+			Handler:    _AdminService_SetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "DeleteDynamicConfig",
+			Handler:    _AdminService_DeleteDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigHistory",
+			Handler:    _AdminService_ListDynamicConfigHistory_Handler,
+		},
+		{
+			MethodName: "ListConcreteExecutions",
+			Handler:    _AdminService_ListConcreteExecutions_Handler,
+		},
+		{
+			MethodName: "ListHistoryTreeBranches",
+			Handler:    _AdminService_ListHistoryTreeBranches_Handler,
+		},
+		{
+			MethodName: "GetReplicationStatus",
+			Handler:    _AdminService_GetReplicationStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -163,6 +163,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) DeleteDynamicConfig(ctx context.Context, in *adminservice.DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.DeleteDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfig indicates an expected call of DeleteDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) DeleteDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteDynamicConfig), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -383,6 +403,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQTasks(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQTasks), varargs...)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceClient) GetNamespace(ctx context.Context, in *adminservice.GetNamespaceRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -443,6 +483,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceClient) GetReplicationStatus(ctx context.Context, in *adminservice.GetReplicationStatusRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationStatus), varargs...)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceClient) GetSearchAttributes(ctx context.Context, in *adminservice.GetSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -603,6 +663,66 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
 }
 
+// ListConcreteExecutions mocks base method.
+func (m *MockAdminServiceClient) ListConcreteExecutions(ctx context.Context, in *adminservice.ListConcreteExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ListConcreteExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConcreteExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListConcreteExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConcreteExecutions indicates an expected call of ListConcreteExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ListConcreteExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConcreteExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ListConcreteExecutions), varargs...)
+}
+
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfig(ctx context.Context, in *adminservice.ListDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfig), varargs...)
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigHistory(ctx context.Context, in *adminservice.ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigHistory), varargs...)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -623,6 +743,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListHistoryTreeBranches mocks base method.
+func (m *MockAdminServiceClient) ListHistoryTreeBranches(ctx context.Context, in *adminservice.ListHistoryTreeBranchesRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTreeBranchesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHistoryTreeBranches", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListHistoryTreeBranchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistoryTreeBranches indicates an expected call of ListHistoryTreeBranches.
+func (mr *MockAdminServiceClientMockRecorder) ListHistoryTreeBranches(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTreeBranches", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTreeBranches), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceClient) ListQueues(ctx context.Context, in *adminservice.ListQueuesRequest, opts ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1003,26 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfig(ctx context.Context, in *adminservice.SetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfig), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -1155,6 +1315,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) DeleteDynamicConfig(arg0 context.Context, arg1 *adminservice.DeleteDynamicConfigRequest) (*adminservice.DeleteDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfig indicates an expected call of DeleteDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) DeleteDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteDynamicConfig), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1320,6 +1495,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQTasks(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQTasks), arg0, arg1)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceServer) GetNamespace(arg0 context.Context, arg1 *adminservice.GetNamespaceRequest) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1365,6 +1555,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceServer) GetReplicationStatus(arg0 context.Context, arg1 *adminservice.GetReplicationStatusRequest) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationStatus), arg0, arg1)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1485,6 +1690,51 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
 }
 
+// ListConcreteExecutions mocks base method.
+func (m *MockAdminServiceServer) ListConcreteExecutions(arg0 context.Context, arg1 *adminservice.ListConcreteExecutionsRequest) (*adminservice.ListConcreteExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConcreteExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListConcreteExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConcreteExecutions indicates an expected call of ListConcreteExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ListConcreteExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConcreteExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ListConcreteExecutions), arg0, arg1)
+}
+
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfig(arg0 context.Context, arg1 *adminservice.ListDynamicConfigRequest) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigHistory(arg0 context.Context, arg1 *adminservice.ListDynamicConfigHistoryRequest) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigHistory), arg0, arg1)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1500,6 +1750,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListHistoryTreeBranches mocks base method.
+func (m *MockAdminServiceServer) ListHistoryTreeBranches(arg0 context.Context, arg1 *adminservice.ListHistoryTreeBranchesRequest) (*adminservice.ListHistoryTreeBranchesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistoryTreeBranches", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListHistoryTreeBranchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistoryTreeBranches indicates an expected call of ListHistoryTreeBranches.
+func (mr *MockAdminServiceServerMockRecorder) ListHistoryTreeBranches(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTreeBranches", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTreeBranches), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceServer) ListQueues(arg0 context.Context, arg1 *adminservice.ListQueuesRequest) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1680,6 +1945,21 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfig(arg0 context.Context, arg1 *adminservice.SetDynamicConfigRequest) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfig), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
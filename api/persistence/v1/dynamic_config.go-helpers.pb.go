@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package persistence
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type DynamicConfigConstraints to the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigConstraints from the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigConstraints) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigConstraints values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigConstraints
+	switch t := that.(type) {
+	case *DynamicConfigConstraints:
+		that1 = t
+	case DynamicConfigConstraints:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigValue to the protobuf v3 wire format
+func (val *DynamicConfigValue) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigValue from the protobuf v3 wire format
+func (val *DynamicConfigValue) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigValue) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigValue values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigValue
+	switch t := that.(type) {
+	case *DynamicConfigValue:
+		that1 = t
+	case DynamicConfigValue:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigEntry to the protobuf v3 wire format
+func (val *DynamicConfigEntry) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigEntry from the protobuf v3 wire format
+func (val *DynamicConfigEntry) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigEntry) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigEntry values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigEntry
+	switch t := that.(type) {
+	case *DynamicConfigEntry:
+		that1 = t
+	case DynamicConfigEntry:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfig to the protobuf v3 wire format
+func (val *DynamicConfig) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfig from the protobuf v3 wire format
+func (val *DynamicConfig) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfig) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfig values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfig
+	switch t := that.(type) {
+	case *DynamicConfig:
+		that1 = t
+	case DynamicConfig:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigChange to the protobuf v3 wire format
+func (val *DynamicConfigChange) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigChange from the protobuf v3 wire format
+func (val *DynamicConfigChange) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigChange) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigChange values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigChange
+	switch t := that.(type) {
+	case *DynamicConfigChange:
+		that1 = t
+	case DynamicConfigChange:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/dynamic_config.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/enums/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Constraints under which a dynamic config value is used. Mirrors dynamicconfig.Constraints: a value is only used
+// for lookups with exactly the same constraints, including the unset ones.
+type DynamicConfigConstraints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceId   string                 `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueueName string                 `protobuf:"bytes,3,opt,name=task_queue_name,json=taskQueueName,proto3" json:"task_queue_name,omitempty"`
+	TaskQueueType v1.TaskQueueType       `protobuf:"varint,4,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	ShardId       int32                  `protobuf:"varint,5,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TaskType      v11.TaskType           `protobuf:"varint,6,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	Destination   string                 `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigConstraints) Reset() {
+	*x = DynamicConfigConstraints{}
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigConstraints) ProtoMessage() {}
+
+func (x *DynamicConfigConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigConstraints.ProtoReflect.Descriptor instead.
+func (*DynamicConfigConstraints) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DynamicConfigConstraints) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetTaskQueueName() string {
+	if x != nil {
+		return x.TaskQueueName
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetTaskQueueType() v1.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v1.TaskQueueType(0)
+}
+
+func (x *DynamicConfigConstraints) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *DynamicConfigConstraints) GetTaskType() v11.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v11.TaskType(0)
+}
+
+func (x *DynamicConfigConstraints) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type DynamicConfigValue struct {
+	state       protoimpl.MessageState    `protogen:"open.v1"`
+	Constraints *DynamicConfigConstraints `protobuf:"bytes,1,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// The value encoded as YAML (or JSON), in the form it would have in the dynamic config file.
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigValue) Reset() {
+	*x = DynamicConfigValue{}
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigValue) ProtoMessage() {}
+
+func (x *DynamicConfigValue) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigValue.ProtoReflect.Descriptor instead.
+func (*DynamicConfigValue) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DynamicConfigValue) GetConstraints() *DynamicConfigConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *DynamicConfigValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// The values set for a dynamic config key.
+type DynamicConfigEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key as it was first set. Keys are case-insensitive.
+	Key           string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []*DynamicConfigValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigEntry) Reset() {
+	*x = DynamicConfigEntry{}
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigEntry) ProtoMessage() {}
+
+func (x *DynamicConfigEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigEntry.ProtoReflect.Descriptor instead.
+func (*DynamicConfigEntry) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DynamicConfigEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DynamicConfigEntry) GetValues() []*DynamicConfigValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Dynamic config overrides stored in persistence. They are layered over the values from the dynamic config file.
+type DynamicConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entries sorted by lower-cased key.
+	Entries       []*DynamicConfigEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfig) Reset() {
+	*x = DynamicConfig{}
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfig) ProtoMessage() {}
+
+func (x *DynamicConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfig.ProtoReflect.Descriptor instead.
+func (*DynamicConfig) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DynamicConfig) GetEntries() []*DynamicConfigEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A change to the dynamic config overrides stored in persistence.
+type DynamicConfigChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the dynamic config overrides produced by this change.
+	Version int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Values set for the key before and after the change.
+	PreviousValues []*DynamicConfigValue  `protobuf:"bytes,3,rep,name=previous_values,json=previousValues,proto3" json:"previous_values,omitempty"`
+	Values         []*DynamicConfigValue  `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	ChangeTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	Identity       string                 `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason         string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DynamicConfigChange) Reset() {
+	*x = DynamicConfigChange{}
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigChange) ProtoMessage() {}
+
+func (x *DynamicConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigChange.ProtoReflect.Descriptor instead.
+func (*DynamicConfigChange) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DynamicConfigChange) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DynamicConfigChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DynamicConfigChange) GetPreviousValues() []*DynamicConfigValue {
+	if x != nil {
+		return x.PreviousValues
+	}
+	return nil
+}
+
+func (x *DynamicConfigChange) GetValues() []*DynamicConfigValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DynamicConfigChange) GetChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
+func (x *DynamicConfigChange) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *DynamicConfigChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_temporal_server_api_persistence_v1_dynamic_config_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDesc = "" +
+	"\n" +
+	"7temporal/server/api/persistence/v1/dynamic_config.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a'temporal/server/api/enums/v1/task.proto\"\xd3\x02\n" +
+	"\x18DynamicConfigConstraints\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12&\n" +
+	"\x0ftask_queue_name\x18\x03 \x01(\tR\rtaskQueueName\x12L\n" +
+	"\x0ftask_queue_type\x18\x04 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x19\n" +
+	"\bshard_id\x18\x05 \x01(\x05R\ashardId\x12C\n" +
+	"\ttask_type\x18\x06 \x01(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\btaskType\x12 \n" +
+	"\vdestination\x18\a \x01(\tR\vdestination\"\x8a\x01\n" +
+	"\x12DynamicConfigValue\x12^\n" +
+	"\vconstraints\x18\x01 \x01(\v2<.temporal.server.api.persistence.v1.DynamicConfigConstraintsR\vconstraints\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"v\n" +
+	"\x12DynamicConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12N\n" +
+	"\x06values\x18\x02 \x03(\v26.temporal.server.api.persistence.v1.DynamicConfigValueR\x06values\"a\n" +
+	"\rDynamicConfig\x12P\n" +
+	"\aentries\x18\x01 \x03(\v26.temporal.server.api.persistence.v1.DynamicConfigEntryR\aentries\"\xe3\x02\n" +
+	"\x13DynamicConfigChange\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12_\n" +
+	"\x0fprevious_values\x18\x03 \x03(\v26.temporal.server.api.persistence.v1.DynamicConfigValueR\x0epreviousValues\x12N\n" +
+	"\x06values\x18\x04 \x03(\v26.temporal.server.api.persistence.v1.DynamicConfigValueR\x06values\x12;\n" +
+	"\vchange_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changeTime\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reasonB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+
+var (
+	file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDesc), len(file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDesc)))
+	})
+	return file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_temporal_server_api_persistence_v1_dynamic_config_proto_goTypes = []any{
+	(*DynamicConfigConstraints)(nil), // 0: temporal.server.api.persistence.v1.DynamicConfigConstraints
+	(*DynamicConfigValue)(nil),       // 1: temporal.server.api.persistence.v1.DynamicConfigValue
+	(*DynamicConfigEntry)(nil),       // 2: temporal.server.api.persistence.v1.DynamicConfigEntry
+	(*DynamicConfig)(nil),            // 3: temporal.server.api.persistence.v1.DynamicConfig
+	(*DynamicConfigChange)(nil),      // 4: temporal.server.api.persistence.v1.DynamicConfigChange
+	(v1.TaskQueueType)(0),            // 5: temporal.api.enums.v1.TaskQueueType
+	(v11.TaskType)(0),                // 6: temporal.server.api.enums.v1.TaskType
+	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
+}
+var file_temporal_server_api_persistence_v1_dynamic_config_proto_depIdxs = []int32{
+	5, // 0: temporal.server.api.persistence.v1.DynamicConfigConstraints.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	6, // 1: temporal.server.api.persistence.v1.DynamicConfigConstraints.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	0, // 2: temporal.server.api.persistence.v1.DynamicConfigValue.constraints:type_name -> temporal.server.api.persistence.v1.DynamicConfigConstraints
+	1, // 3: temporal.server.api.persistence.v1.DynamicConfigEntry.values:type_name -> temporal.server.api.persistence.v1.DynamicConfigValue
+	2, // 4: temporal.server.api.persistence.v1.DynamicConfig.entries:type_name -> temporal.server.api.persistence.v1.DynamicConfigEntry
+	1, // 5: temporal.server.api.persistence.v1.DynamicConfigChange.previous_values:type_name -> temporal.server.api.persistence.v1.DynamicConfigValue
+	1, // 6: temporal.server.api.persistence.v1.DynamicConfigChange.values:type_name -> temporal.server.api.persistence.v1.DynamicConfigValue
+	7, // 7: temporal.server.api.persistence.v1.DynamicConfigChange.change_time:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_dynamic_config_proto_init() }
+func file_temporal_server_api_persistence_v1_dynamic_config_proto_init() {
+	if File_temporal_server_api_persistence_v1_dynamic_config_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDesc), len(file_temporal_server_api_persistence_v1_dynamic_config_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_dynamic_config_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_dynamic_config_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_dynamic_config_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_dynamic_config_proto = out.File
+	file_temporal_server_api_persistence_v1_dynamic_config_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_dynamic_config_proto_depIdxs = nil
+}
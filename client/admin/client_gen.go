@@ -69,6 +69,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -179,6 +189,16 @@ func (c *clientImpl) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -209,6 +229,16 @@ func (c *clientImpl) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationStatusResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -289,6 +319,36 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListConcreteExecutions(
+	ctx context.Context,
+	request *adminservice.ListConcreteExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListConcreteExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListConcreteExecutions(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfigHistory(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -299,6 +359,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListHistoryTreeBranches(
+	ctx context.Context,
+	request *adminservice.ListHistoryTreeBranchesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListHistoryTreeBranchesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListHistoryTreeBranches(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -419,6 +489,16 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
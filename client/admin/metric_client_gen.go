@@ -93,6 +93,20 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -247,6 +261,20 @@ func (c *metricClient) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -289,6 +317,20 @@ func (c *metricClient) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetReplicationStatusResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetReplicationStatus")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
 func (c *metricClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -401,6 +443,48 @@ func (c *metricClient) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *metricClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *adminservice.ListConcreteExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListConcreteExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListConcreteExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListConcreteExecutions(ctx, request, opts...)
+}
+
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDynamicConfig(ctx, request, opts...)
+}
+
+func (c *metricClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDynamicConfigHistoryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListDynamicConfigHistory")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDynamicConfigHistory(ctx, request, opts...)
+}
+
 func (c *metricClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -415,6 +499,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListHistoryTreeBranches(
+	ctx context.Context,
+	request *adminservice.ListHistoryTreeBranchesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListHistoryTreeBranchesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListHistoryTreeBranches")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListHistoryTreeBranches(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -583,6 +681,20 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -101,6 +101,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigResponse, error) {
+	var resp *adminservice.DeleteDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -266,6 +281,21 @@ func (c *retryableClient) GetDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -311,6 +341,21 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationStatusResponse, error) {
+	var resp *adminservice.GetReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -431,6 +476,51 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *adminservice.ListConcreteExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListConcreteExecutionsResponse, error) {
+	var resp *adminservice.ListConcreteExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListConcreteExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	var resp *adminservice.ListDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	var resp *adminservice.ListDynamicConfigHistoryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfigHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -446,6 +536,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListHistoryTreeBranches(
+	ctx context.Context,
+	request *adminservice.ListHistoryTreeBranchesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListHistoryTreeBranchesResponse, error) {
+	var resp *adminservice.ListHistoryTreeBranchesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListHistoryTreeBranches(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -626,6 +731,21 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	var resp *adminservice.SetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
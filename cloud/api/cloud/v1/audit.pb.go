@@ -0,0 +1,2170 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: cloud/v1/audit.proto
+
+package cloudv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditResult represents the result of an action.
+type AuditResult int32
+
+const (
+	AuditResult_AUDIT_RESULT_UNSPECIFIED AuditResult = 0
+	AuditResult_AUDIT_RESULT_SUCCESS     AuditResult = 1
+	AuditResult_AUDIT_RESULT_FAILURE     AuditResult = 2
+	AuditResult_AUDIT_RESULT_DENIED      AuditResult = 3
+)
+
+// Enum value maps for AuditResult.
+var (
+	AuditResult_name = map[int32]string{
+		0: "AUDIT_RESULT_UNSPECIFIED",
+		1: "AUDIT_RESULT_SUCCESS",
+		2: "AUDIT_RESULT_FAILURE",
+		3: "AUDIT_RESULT_DENIED",
+	}
+	AuditResult_value = map[string]int32{
+		"AUDIT_RESULT_UNSPECIFIED": 0,
+		"AUDIT_RESULT_SUCCESS":     1,
+		"AUDIT_RESULT_FAILURE":     2,
+		"AUDIT_RESULT_DENIED":      3,
+	}
+)
+
+func (x AuditResult) Enum() *AuditResult {
+	p := new(AuditResult)
+	*p = x
+	return p
+}
+
+func (x AuditResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditResult) Type() protoreflect.EnumType {
+	return &file_cloud_v1_audit_proto_enumTypes[0]
+}
+
+func (x AuditResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditResult.Descriptor instead.
+func (AuditResult) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+// ExportFormat defines export formats.
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// Newline-delimited JSON.
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 1
+	// ArcSight Common Event Format.
+	ExportFormat_EXPORT_FORMAT_CEF ExportFormat = 3
+	// OCSF API Activity events as newline-delimited JSON.
+	ExportFormat_EXPORT_FORMAT_OCSF ExportFormat = 4
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_NDJSON",
+		3: "EXPORT_FORMAT_CEF",
+		4: "EXPORT_FORMAT_OCSF",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_NDJSON":      1,
+		"EXPORT_FORMAT_CEF":         3,
+		"EXPORT_FORMAT_OCSF":        4,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_audit_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_cloud_v1_audit_proto_enumTypes[1]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+// AuditEvent represents an audit log entry.
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Actor information.
+	Actor *AuditActor `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Action performed.
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Result of the action.
+	Result AuditResult `protobuf:"varint,5,opt,name=result,proto3,enum=temporal.cloud.api.v1.AuditResult" json:"result,omitempty"`
+	// Resource affected.
+	Resource *AuditResource `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Request metadata.
+	RequestMetadata *AuditRequestMetadata `protobuf:"bytes,7,opt,name=request_metadata,json=requestMetadata,proto3" json:"request_metadata,omitempty"`
+	// Additional details.
+	Details *structpb.Struct `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
+	// Timestamp of the event.
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActor() *AuditActor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResult() AuditResult {
+	if x != nil {
+		return x.Result
+	}
+	return AuditResult_AUDIT_RESULT_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetResource() *AuditResource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetRequestMetadata() *AuditRequestMetadata {
+	if x != nil {
+		return x.RequestMetadata
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetDetails() *structpb.Struct {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// AuditActor represents the actor who performed an action.
+type AuditActor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Actor type (user, service_account, system).
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Actor ID.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Actor email (for users).
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Actor name.
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditActor) Reset() {
+	*x = AuditActor{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditActor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditActor) ProtoMessage() {}
+
+func (x *AuditActor) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditActor.ProtoReflect.Descriptor instead.
+func (*AuditActor) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditActor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AuditActor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditActor) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuditActor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// AuditResource represents the resource affected by an action.
+type AuditResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resource type (organization, namespace, user, api_key, etc.).
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Resource ID.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Resource name.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditResource) Reset() {
+	*x = AuditResource{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResource) ProtoMessage() {}
+
+func (x *AuditResource) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResource.ProtoReflect.Descriptor instead.
+func (*AuditResource) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuditResource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AuditResource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// AuditRequestMetadata contains request metadata.
+type AuditRequestMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Request ID.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Client IP address.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// User agent.
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Request method.
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// Request path.
+	Path          string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRequestMetadata) Reset() {
+	*x = AuditRequestMetadata{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRequestMetadata) ProtoMessage() {}
+
+func (x *AuditRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequestMetadata.ProtoReflect.Descriptor instead.
+func (*AuditRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuditRequestMetadata) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditRequestMetadata) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditRequestMetadata) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditRequestMetadata) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditRequestMetadata) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// ListAuditEventsRequest is the request for ListAuditEvents.
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Start timestamp filter.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End timestamp filter.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Actor ID filter.
+	ActorId string `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Action filter.
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// Resource type filter.
+	ResourceType string `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Resource ID filter.
+	ResourceId string `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Result filter.
+	Result AuditResult `protobuf:"varint,8,opt,name=result,proto3,enum=temporal.cloud.api.v1.AuditResult" json:"result,omitempty"`
+	// Maximum number of events to return.
+	PageSize int32 `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAuditEventsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResult() AuditResult {
+	if x != nil {
+		return x.Result
+	}
+	return AuditResult_AUDIT_RESULT_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListAuditEventsResponse is the response for ListAuditEvents.
+type ListAuditEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of audit events.
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetAuditEventRequest is the request for GetAuditEvent.
+type GetAuditEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event ID.
+	EventId       string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditEventRequest) Reset() {
+	*x = GetAuditEventRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditEventRequest) ProtoMessage() {}
+
+func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAuditEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// GetAuditEventResponse is the response for GetAuditEvent.
+type GetAuditEventResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The audit event.
+	Event         *AuditEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditEventResponse) Reset() {
+	*x = GetAuditEventResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditEventResponse) ProtoMessage() {}
+
+func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAuditEventResponse) GetEvent() *AuditEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// ExportAuditEventsRequest is the request for ExportAuditEvents.
+type ExportAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Start timestamp. Ignored when continuing an export.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End timestamp. Defaults to a few seconds ago, leaving out events that may
+	// still be being recorded.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Export format. Defaults to NDJSON.
+	Format ExportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=temporal.cloud.api.v1.ExportFormat" json:"format,omitempty"`
+	// Maximum number of events in the batch.
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from the previous response, to continue an export.
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditEventsRequest) Reset() {
+	*x = ExportAuditEventsRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditEventsRequest) ProtoMessage() {}
+
+func (x *ExportAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportAuditEventsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExportAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExportAuditEventsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ExportAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetAuditChainKeyRequest is the request for GetAuditChainKey.
+type GetAuditChainKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAuditChainKeyRequest) Reset() {
+	*x = GetAuditChainKeyRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditChainKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditChainKeyRequest) ProtoMessage() {}
+
+func (x *GetAuditChainKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditChainKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditChainKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAuditChainKeyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// GetAuditChainKeyResponse is the response for GetAuditChainKey.
+type GetAuditChainKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HMAC key of the organization's hash chains.
+	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditChainKeyResponse) Reset() {
+	*x = GetAuditChainKeyResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditChainKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditChainKeyResponse) ProtoMessage() {}
+
+func (x *GetAuditChainKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditChainKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditChainKeyResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAuditChainKeyResponse) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// ExportAuditEventsResponse is the response for ExportAuditEvents.
+type ExportAuditEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Encoded events, one per line. Empty if there are no events to export
+	// yet.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Chain link of the batch in data.
+	Manifest *AuditBatchManifest `protobuf:"bytes,4,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Token to continue the export after this batch. It is set even when no
+	// events are left, so that later events can be exported.
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Whether more events can be exported right away.
+	HasMore       bool `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditEventsResponse) Reset() {
+	*x = ExportAuditEventsResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditEventsResponse) ProtoMessage() {}
+
+func (x *ExportAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExportAuditEventsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportAuditEventsResponse) GetManifest() *AuditBatchManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *ExportAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// AuditBatchManifest describes a batch of exported audit events. A batch's
+// hash is the hex HMAC-SHA256, keyed with the organization's chain key (see
+// GetAuditChainKey), of the organization ID, the format name ("ndjson", "cef"
+// or "ocsf"), the sequence number, the previous batch's hash, the event count
+// and the first and last event IDs, each followed by a newline, and then the
+// batch's data.
+type AuditBatchManifest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sequence number of the batch, from 1.
+	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Hash of the previous batch, empty for the first batch.
+	PreviousHash string `protobuf:"bytes,2,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	// Hash of the batch.
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Number of events in the batch.
+	EventCount int32 `protobuf:"varint,4,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// ID of the first event in the batch.
+	FirstEventId string `protobuf:"bytes,5,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
+	// ID of the last event in the batch.
+	LastEventId   string `protobuf:"bytes,6,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditBatchManifest) Reset() {
+	*x = AuditBatchManifest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditBatchManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditBatchManifest) ProtoMessage() {}
+
+func (x *AuditBatchManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditBatchManifest.ProtoReflect.Descriptor instead.
+func (*AuditBatchManifest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AuditBatchManifest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AuditBatchManifest) GetPreviousHash() string {
+	if x != nil {
+		return x.PreviousHash
+	}
+	return ""
+}
+
+func (x *AuditBatchManifest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AuditBatchManifest) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *AuditBatchManifest) GetFirstEventId() string {
+	if x != nil {
+		return x.FirstEventId
+	}
+	return ""
+}
+
+func (x *AuditBatchManifest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+// AuditStream delivers an organization's audit events to a destination as
+// they are recorded, in batches described by AuditBatchManifest.
+type AuditStream struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stream ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Name of the stream (unique within the organization).
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Format of delivered events. Defaults to NDJSON.
+	Format ExportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=temporal.cloud.api.v1.ExportFormat" json:"format,omitempty"`
+	// Amazon S3 destination. Exactly one destination is set.
+	S3 *S3AuditDestination `protobuf:"bytes,5,opt,name=s3,proto3" json:"s3,omitempty"`
+	// HTTP webhook destination.
+	Webhook *WebhookAuditDestination `protobuf:"bytes,6,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// Whether events are delivered.
+	Enabled bool `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Sequence number of the last batch delivered.
+	LastSequence int64 `protobuf:"varint,8,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+	// Hash of the last batch delivered.
+	LastHash string `protobuf:"bytes,9,opt,name=last_hash,json=lastHash,proto3" json:"last_hash,omitempty"`
+	// Time of the last event delivered, or of the stream's creation.
+	CursorTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=cursor_time,json=cursorTime,proto3" json:"cursor_time,omitempty"`
+	// Time of the last delivery.
+	LastDeliveryTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_delivery_time,json=lastDeliveryTime,proto3" json:"last_delivery_time,omitempty"`
+	// Error of the last delivery, if it failed.
+	LastError string `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Creation timestamp.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditStream) Reset() {
+	*x = AuditStream{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditStream) ProtoMessage() {}
+
+func (x *AuditStream) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditStream.ProtoReflect.Descriptor instead.
+func (*AuditStream) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuditStream) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditStream) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AuditStream) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuditStream) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *AuditStream) GetS3() *S3AuditDestination {
+	if x != nil {
+		return x.S3
+	}
+	return nil
+}
+
+func (x *AuditStream) GetWebhook() *WebhookAuditDestination {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *AuditStream) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AuditStream) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *AuditStream) GetLastHash() string {
+	if x != nil {
+		return x.LastHash
+	}
+	return ""
+}
+
+func (x *AuditStream) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *AuditStream) GetLastDeliveryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDeliveryTime
+	}
+	return nil
+}
+
+func (x *AuditStream) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *AuditStream) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditStream) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// S3AuditDestination is an Amazon S3 bucket. Each batch is written to
+// <prefix>/<organization ID>/<sequence>.<format>, with its manifest in the
+// object's metadata, by assuming a role in the bucket owner's account.
+type S3AuditDestination struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name.
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Key prefix batches are written under.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// AWS region of the bucket.
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	// ARN of the IAM role assumed to write to the bucket. The role's trust
+	// policy must require external_id.
+	RoleArn string `protobuf:"bytes,5,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// External ID presented when assuming the role: the organization's ID.
+	// Output only.
+	ExternalId    string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S3AuditDestination) Reset() {
+	*x = S3AuditDestination{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S3AuditDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S3AuditDestination) ProtoMessage() {}
+
+func (x *S3AuditDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S3AuditDestination.ProtoReflect.Descriptor instead.
+func (*S3AuditDestination) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *S3AuditDestination) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *S3AuditDestination) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *S3AuditDestination) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *S3AuditDestination) GetRoleArn() string {
+	if x != nil {
+		return x.RoleArn
+	}
+	return ""
+}
+
+func (x *S3AuditDestination) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// WebhookAuditDestination is an HTTP endpoint each batch is POSTed to, with
+// its manifest in X-Audit-* headers. The endpoint must have a public address.
+type WebhookAuditDestination struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL to post to.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret the X-Audit-Signature header is computed with. It is never
+	// returned, and updates without a secret keep the current one.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Headers added to each request.
+	Headers       map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookAuditDestination) Reset() {
+	*x = WebhookAuditDestination{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookAuditDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAuditDestination) ProtoMessage() {}
+
+func (x *WebhookAuditDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAuditDestination.ProtoReflect.Descriptor instead.
+func (*WebhookAuditDestination) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WebhookAuditDestination) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookAuditDestination) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookAuditDestination) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// CreateAuditStreamRequest is the request for CreateAuditStream.
+type CreateAuditStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Stream to create.
+	Stream        *AuditStream `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuditStreamRequest) Reset() {
+	*x = CreateAuditStreamRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuditStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuditStreamRequest) ProtoMessage() {}
+
+func (x *CreateAuditStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuditStreamRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuditStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateAuditStreamRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateAuditStreamRequest) GetStream() *AuditStream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// CreateAuditStreamResponse is the response for CreateAuditStream.
+type CreateAuditStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created stream.
+	Stream        *AuditStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuditStreamResponse) Reset() {
+	*x = CreateAuditStreamResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuditStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuditStreamResponse) ProtoMessage() {}
+
+func (x *CreateAuditStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuditStreamResponse.ProtoReflect.Descriptor instead.
+func (*CreateAuditStreamResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateAuditStreamResponse) GetStream() *AuditStream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// GetAuditStreamRequest is the request for GetAuditStream.
+type GetAuditStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stream ID.
+	StreamId      string `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditStreamRequest) Reset() {
+	*x = GetAuditStreamRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditStreamRequest) ProtoMessage() {}
+
+func (x *GetAuditStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAuditStreamRequest) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+// GetAuditStreamResponse is the response for GetAuditStream.
+type GetAuditStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The stream.
+	Stream        *AuditStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditStreamResponse) Reset() {
+	*x = GetAuditStreamResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditStreamResponse) ProtoMessage() {}
+
+func (x *GetAuditStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditStreamResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAuditStreamResponse) GetStream() *AuditStream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// ListAuditStreamsRequest is the request for ListAuditStreams.
+type ListAuditStreamsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAuditStreamsRequest) Reset() {
+	*x = ListAuditStreamsRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditStreamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditStreamsRequest) ProtoMessage() {}
+
+func (x *ListAuditStreamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditStreamsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditStreamsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListAuditStreamsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// ListAuditStreamsResponse is the response for ListAuditStreams.
+type ListAuditStreamsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Streams of the organization.
+	Streams       []*AuditStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditStreamsResponse) Reset() {
+	*x = ListAuditStreamsResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditStreamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditStreamsResponse) ProtoMessage() {}
+
+func (x *ListAuditStreamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditStreamsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditStreamsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListAuditStreamsResponse) GetStreams() []*AuditStream {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+// UpdateAuditStreamRequest is the request for UpdateAuditStream.
+type UpdateAuditStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stream ID.
+	StreamId string `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// New name, format, destination and enabled state of the stream.
+	Stream        *AuditStream `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuditStreamRequest) Reset() {
+	*x = UpdateAuditStreamRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuditStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuditStreamRequest) ProtoMessage() {}
+
+func (x *UpdateAuditStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuditStreamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuditStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateAuditStreamRequest) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+func (x *UpdateAuditStreamRequest) GetStream() *AuditStream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// UpdateAuditStreamResponse is the response for UpdateAuditStream.
+type UpdateAuditStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated stream.
+	Stream        *AuditStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuditStreamResponse) Reset() {
+	*x = UpdateAuditStreamResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuditStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuditStreamResponse) ProtoMessage() {}
+
+func (x *UpdateAuditStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuditStreamResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAuditStreamResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateAuditStreamResponse) GetStream() *AuditStream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// DeleteAuditStreamRequest is the request for DeleteAuditStream.
+type DeleteAuditStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stream ID.
+	StreamId      string `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAuditStreamRequest) Reset() {
+	*x = DeleteAuditStreamRequest{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAuditStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuditStreamRequest) ProtoMessage() {}
+
+func (x *DeleteAuditStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuditStreamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAuditStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteAuditStreamRequest) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+// DeleteAuditStreamResponse is the response for DeleteAuditStream.
+type DeleteAuditStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAuditStreamResponse) Reset() {
+	*x = DeleteAuditStreamResponse{}
+	mi := &file_cloud_v1_audit_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAuditStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuditStreamResponse) ProtoMessage() {}
+
+func (x *DeleteAuditStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_audit_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuditStreamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAuditStreamResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_audit_proto_rawDescGZIP(), []int{25}
+}
+
+var File_cloud_v1_audit_proto protoreflect.FileDescriptor
+
+const file_cloud_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x14cloud/v1/audit.proto\x12\x15temporal.cloud.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xd9\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x127\n" +
+	"\x05actor\x18\x03 \x01(\v2!.temporal.cloud.api.v1.AuditActorR\x05actor\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12:\n" +
+	"\x06result\x18\x05 \x01(\x0e2\".temporal.cloud.api.v1.AuditResultR\x06result\x12@\n" +
+	"\bresource\x18\x06 \x01(\v2$.temporal.cloud.api.v1.AuditResourceR\bresource\x12V\n" +
+	"\x10request_metadata\x18\a \x01(\v2+.temporal.cloud.api.v1.AuditRequestMetadataR\x0frequestMetadata\x121\n" +
+	"\adetails\x18\b \x01(\v2\x17.google.protobuf.StructR\adetails\x128\n" +
+	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"Z\n" +
+	"\n" +
+	"AuditActor\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"G\n" +
+	"\rAuditResource\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x9f\x01\n" +
+	"\x14AuditRequestMetadata\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\"\xa4\x03\n" +
+	"\x16ListAuditEventsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x06 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\a \x01(\tR\n" +
+	"resourceId\x12:\n" +
+	"\x06result\x18\b \x01(\x0e2\".temporal.cloud.api.v1.AuditResultR\x06result\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\"|\n" +
+	"\x17ListAuditEventsResponse\x129\n" +
+	"\x06events\x18\x01 \x03(\v2!.temporal.cloud.api.v1.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"1\n" +
+	"\x14GetAuditEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"P\n" +
+	"\x15GetAuditEventResponse\x127\n" +
+	"\x05event\x18\x01 \x01(\v2!.temporal.cloud.api.v1.AuditEventR\x05event\"\xae\x02\n" +
+	"\x18ExportAuditEventsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12;\n" +
+	"\x06format\x18\x04 \x01(\x0e2#.temporal.cloud.api.v1.ExportFormatR\x06format\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"B\n" +
+	"\x17GetAuditChainKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\",\n" +
+	"\x18GetAuditChainKeyResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\"\xb9\x01\n" +
+	"\x19ExportAuditEventsResponse\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12E\n" +
+	"\bmanifest\x18\x04 \x01(\v2).temporal.cloud.api.v1.AuditBatchManifestR\bmanifest\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\x12\x19\n" +
+	"\bhas_more\x18\x06 \x01(\bR\ahasMore\"\xd4\x01\n" +
+	"\x12AuditBatchManifest\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12#\n" +
+	"\rprevious_hash\x18\x02 \x01(\tR\fpreviousHash\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vevent_count\x18\x04 \x01(\x05R\n" +
+	"eventCount\x12$\n" +
+	"\x0efirst_event_id\x18\x05 \x01(\tR\ffirstEventId\x12\"\n" +
+	"\rlast_event_id\x18\x06 \x01(\tR\vlastEventId\"\x94\x05\n" +
+	"\vAuditStream\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12;\n" +
+	"\x06format\x18\x04 \x01(\x0e2#.temporal.cloud.api.v1.ExportFormatR\x06format\x129\n" +
+	"\x02s3\x18\x05 \x01(\v2).temporal.cloud.api.v1.S3AuditDestinationR\x02s3\x12H\n" +
+	"\awebhook\x18\x06 \x01(\v2..temporal.cloud.api.v1.WebhookAuditDestinationR\awebhook\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12#\n" +
+	"\rlast_sequence\x18\b \x01(\x03R\flastSequence\x12\x1b\n" +
+	"\tlast_hash\x18\t \x01(\tR\blastHash\x12;\n" +
+	"\vcursor_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"cursorTime\x12H\n" +
+	"\x12last_delivery_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x10lastDeliveryTime\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\f \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x98\x01\n" +
+	"\x12S3AuditDestination\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x19\n" +
+	"\brole_arn\x18\x05 \x01(\tR\aroleArn\x12\x1f\n" +
+	"\vexternal_id\x18\x06 \x01(\tR\n" +
+	"externalId\"\xd6\x01\n" +
+	"\x17WebhookAuditDestination\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12U\n" +
+	"\aheaders\x18\x03 \x03(\v2;.temporal.cloud.api.v1.WebhookAuditDestination.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x7f\n" +
+	"\x18CreateAuditStreamRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12:\n" +
+	"\x06stream\x18\x02 \x01(\v2\".temporal.cloud.api.v1.AuditStreamR\x06stream\"W\n" +
+	"\x19CreateAuditStreamResponse\x12:\n" +
+	"\x06stream\x18\x01 \x01(\v2\".temporal.cloud.api.v1.AuditStreamR\x06stream\"4\n" +
+	"\x15GetAuditStreamRequest\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\"T\n" +
+	"\x16GetAuditStreamResponse\x12:\n" +
+	"\x06stream\x18\x01 \x01(\v2\".temporal.cloud.api.v1.AuditStreamR\x06stream\"B\n" +
+	"\x17ListAuditStreamsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"X\n" +
+	"\x18ListAuditStreamsResponse\x12<\n" +
+	"\astreams\x18\x01 \x03(\v2\".temporal.cloud.api.v1.AuditStreamR\astreams\"s\n" +
+	"\x18UpdateAuditStreamRequest\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\x12:\n" +
+	"\x06stream\x18\x02 \x01(\v2\".temporal.cloud.api.v1.AuditStreamR\x06stream\"W\n" +
+	"\x19UpdateAuditStreamResponse\x12:\n" +
+	"\x06stream\x18\x01 \x01(\v2\".temporal.cloud.api.v1.AuditStreamR\x06stream\"7\n" +
+	"\x18DeleteAuditStreamRequest\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\"\x1b\n" +
+	"\x19DeleteAuditStreamResponse*x\n" +
+	"\vAuditResult\x12\x1c\n" +
+	"\x18AUDIT_RESULT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14AUDIT_RESULT_SUCCESS\x10\x01\x12\x18\n" +
+	"\x14AUDIT_RESULT_FAILURE\x10\x02\x12\x17\n" +
+	"\x13AUDIT_RESULT_DENIED\x10\x03*v\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14EXPORT_FORMAT_NDJSON\x10\x01\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CEF\x10\x03\x12\x16\n" +
+	"\x12EXPORT_FORMAT_OCSF\x10\x042\xa5\b\n" +
+	"\fAuditService\x12p\n" +
+	"\x0fListAuditEvents\x12-.temporal.cloud.api.v1.ListAuditEventsRequest\x1a..temporal.cloud.api.v1.ListAuditEventsResponse\x12j\n" +
+	"\rGetAuditEvent\x12+.temporal.cloud.api.v1.GetAuditEventRequest\x1a,.temporal.cloud.api.v1.GetAuditEventResponse\x12v\n" +
+	"\x11ExportAuditEvents\x12/.temporal.cloud.api.v1.ExportAuditEventsRequest\x1a0.temporal.cloud.api.v1.ExportAuditEventsResponse\x12s\n" +
+	"\x10GetAuditChainKey\x12..temporal.cloud.api.v1.GetAuditChainKeyRequest\x1a/.temporal.cloud.api.v1.GetAuditChainKeyResponse\x12v\n" +
+	"\x11CreateAuditStream\x12/.temporal.cloud.api.v1.CreateAuditStreamRequest\x1a0.temporal.cloud.api.v1.CreateAuditStreamResponse\x12m\n" +
+	"\x0eGetAuditStream\x12,.temporal.cloud.api.v1.GetAuditStreamRequest\x1a-.temporal.cloud.api.v1.GetAuditStreamResponse\x12s\n" +
+	"\x10ListAuditStreams\x12..temporal.cloud.api.v1.ListAuditStreamsRequest\x1a/.temporal.cloud.api.v1.ListAuditStreamsResponse\x12v\n" +
+	"\x11UpdateAuditStream\x12/.temporal.cloud.api.v1.UpdateAuditStreamRequest\x1a0.temporal.cloud.api.v1.UpdateAuditStreamResponse\x12v\n" +
+	"\x11DeleteAuditStream\x12/.temporal.cloud.api.v1.DeleteAuditStreamRequest\x1a0.temporal.cloud.api.v1.DeleteAuditStreamResponseB+Z)go.temporal.io/cloud/api/cloud/v1;cloudv1b\x06proto3"
+
+var (
+	file_cloud_v1_audit_proto_rawDescOnce sync.Once
+	file_cloud_v1_audit_proto_rawDescData []byte
+)
+
+func file_cloud_v1_audit_proto_rawDescGZIP() []byte {
+	file_cloud_v1_audit_proto_rawDescOnce.Do(func() {
+		file_cloud_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cloud_v1_audit_proto_rawDesc), len(file_cloud_v1_audit_proto_rawDesc)))
+	})
+	return file_cloud_v1_audit_proto_rawDescData
+}
+
+var file_cloud_v1_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cloud_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_cloud_v1_audit_proto_goTypes = []any{
+	(AuditResult)(0),                  // 0: temporal.cloud.api.v1.AuditResult
+	(ExportFormat)(0),                 // 1: temporal.cloud.api.v1.ExportFormat
+	(*AuditEvent)(nil),                // 2: temporal.cloud.api.v1.AuditEvent
+	(*AuditActor)(nil),                // 3: temporal.cloud.api.v1.AuditActor
+	(*AuditResource)(nil),             // 4: temporal.cloud.api.v1.AuditResource
+	(*AuditRequestMetadata)(nil),      // 5: temporal.cloud.api.v1.AuditRequestMetadata
+	(*ListAuditEventsRequest)(nil),    // 6: temporal.cloud.api.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),   // 7: temporal.cloud.api.v1.ListAuditEventsResponse
+	(*GetAuditEventRequest)(nil),      // 8: temporal.cloud.api.v1.GetAuditEventRequest
+	(*GetAuditEventResponse)(nil),     // 9: temporal.cloud.api.v1.GetAuditEventResponse
+	(*ExportAuditEventsRequest)(nil),  // 10: temporal.cloud.api.v1.ExportAuditEventsRequest
+	(*GetAuditChainKeyRequest)(nil),   // 11: temporal.cloud.api.v1.GetAuditChainKeyRequest
+	(*GetAuditChainKeyResponse)(nil),  // 12: temporal.cloud.api.v1.GetAuditChainKeyResponse
+	(*ExportAuditEventsResponse)(nil), // 13: temporal.cloud.api.v1.ExportAuditEventsResponse
+	(*AuditBatchManifest)(nil),        // 14: temporal.cloud.api.v1.AuditBatchManifest
+	(*AuditStream)(nil),               // 15: temporal.cloud.api.v1.AuditStream
+	(*S3AuditDestination)(nil),        // 16: temporal.cloud.api.v1.S3AuditDestination
+	(*WebhookAuditDestination)(nil),   // 17: temporal.cloud.api.v1.WebhookAuditDestination
+	(*CreateAuditStreamRequest)(nil),  // 18: temporal.cloud.api.v1.CreateAuditStreamRequest
+	(*CreateAuditStreamResponse)(nil), // 19: temporal.cloud.api.v1.CreateAuditStreamResponse
+	(*GetAuditStreamRequest)(nil),     // 20: temporal.cloud.api.v1.GetAuditStreamRequest
+	(*GetAuditStreamResponse)(nil),    // 21: temporal.cloud.api.v1.GetAuditStreamResponse
+	(*ListAuditStreamsRequest)(nil),   // 22: temporal.cloud.api.v1.ListAuditStreamsRequest
+	(*ListAuditStreamsResponse)(nil),  // 23: temporal.cloud.api.v1.ListAuditStreamsResponse
+	(*UpdateAuditStreamRequest)(nil),  // 24: temporal.cloud.api.v1.UpdateAuditStreamRequest
+	(*UpdateAuditStreamResponse)(nil), // 25: temporal.cloud.api.v1.UpdateAuditStreamResponse
+	(*DeleteAuditStreamRequest)(nil),  // 26: temporal.cloud.api.v1.DeleteAuditStreamRequest
+	(*DeleteAuditStreamResponse)(nil), // 27: temporal.cloud.api.v1.DeleteAuditStreamResponse
+	nil,                               // 28: temporal.cloud.api.v1.WebhookAuditDestination.HeadersEntry
+	(*structpb.Struct)(nil),           // 29: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),     // 30: google.protobuf.Timestamp
+}
+var file_cloud_v1_audit_proto_depIdxs = []int32{
+	3,  // 0: temporal.cloud.api.v1.AuditEvent.actor:type_name -> temporal.cloud.api.v1.AuditActor
+	0,  // 1: temporal.cloud.api.v1.AuditEvent.result:type_name -> temporal.cloud.api.v1.AuditResult
+	4,  // 2: temporal.cloud.api.v1.AuditEvent.resource:type_name -> temporal.cloud.api.v1.AuditResource
+	5,  // 3: temporal.cloud.api.v1.AuditEvent.request_metadata:type_name -> temporal.cloud.api.v1.AuditRequestMetadata
+	29, // 4: temporal.cloud.api.v1.AuditEvent.details:type_name -> google.protobuf.Struct
+	30, // 5: temporal.cloud.api.v1.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
+	30, // 6: temporal.cloud.api.v1.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	30, // 7: temporal.cloud.api.v1.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 8: temporal.cloud.api.v1.ListAuditEventsRequest.result:type_name -> temporal.cloud.api.v1.AuditResult
+	2,  // 9: temporal.cloud.api.v1.ListAuditEventsResponse.events:type_name -> temporal.cloud.api.v1.AuditEvent
+	2,  // 10: temporal.cloud.api.v1.GetAuditEventResponse.event:type_name -> temporal.cloud.api.v1.AuditEvent
+	30, // 11: temporal.cloud.api.v1.ExportAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	30, // 12: temporal.cloud.api.v1.ExportAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 13: temporal.cloud.api.v1.ExportAuditEventsRequest.format:type_name -> temporal.cloud.api.v1.ExportFormat
+	14, // 14: temporal.cloud.api.v1.ExportAuditEventsResponse.manifest:type_name -> temporal.cloud.api.v1.AuditBatchManifest
+	1,  // 15: temporal.cloud.api.v1.AuditStream.format:type_name -> temporal.cloud.api.v1.ExportFormat
+	16, // 16: temporal.cloud.api.v1.AuditStream.s3:type_name -> temporal.cloud.api.v1.S3AuditDestination
+	17, // 17: temporal.cloud.api.v1.AuditStream.webhook:type_name -> temporal.cloud.api.v1.WebhookAuditDestination
+	30, // 18: temporal.cloud.api.v1.AuditStream.cursor_time:type_name -> google.protobuf.Timestamp
+	30, // 19: temporal.cloud.api.v1.AuditStream.last_delivery_time:type_name -> google.protobuf.Timestamp
+	30, // 20: temporal.cloud.api.v1.AuditStream.created_at:type_name -> google.protobuf.Timestamp
+	30, // 21: temporal.cloud.api.v1.AuditStream.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 22: temporal.cloud.api.v1.WebhookAuditDestination.headers:type_name -> temporal.cloud.api.v1.WebhookAuditDestination.HeadersEntry
+	15, // 23: temporal.cloud.api.v1.CreateAuditStreamRequest.stream:type_name -> temporal.cloud.api.v1.AuditStream
+	15, // 24: temporal.cloud.api.v1.CreateAuditStreamResponse.stream:type_name -> temporal.cloud.api.v1.AuditStream
+	15, // 25: temporal.cloud.api.v1.GetAuditStreamResponse.stream:type_name -> temporal.cloud.api.v1.AuditStream
+	15, // 26: temporal.cloud.api.v1.ListAuditStreamsResponse.streams:type_name -> temporal.cloud.api.v1.AuditStream
+	15, // 27: temporal.cloud.api.v1.UpdateAuditStreamRequest.stream:type_name -> temporal.cloud.api.v1.AuditStream
+	15, // 28: temporal.cloud.api.v1.UpdateAuditStreamResponse.stream:type_name -> temporal.cloud.api.v1.AuditStream
+	6,  // 29: temporal.cloud.api.v1.AuditService.ListAuditEvents:input_type -> temporal.cloud.api.v1.ListAuditEventsRequest
+	8,  // 30: temporal.cloud.api.v1.AuditService.GetAuditEvent:input_type -> temporal.cloud.api.v1.GetAuditEventRequest
+	10, // 31: temporal.cloud.api.v1.AuditService.ExportAuditEvents:input_type -> temporal.cloud.api.v1.ExportAuditEventsRequest
+	11, // 32: temporal.cloud.api.v1.AuditService.GetAuditChainKey:input_type -> temporal.cloud.api.v1.GetAuditChainKeyRequest
+	18, // 33: temporal.cloud.api.v1.AuditService.CreateAuditStream:input_type -> temporal.cloud.api.v1.CreateAuditStreamRequest
+	20, // 34: temporal.cloud.api.v1.AuditService.GetAuditStream:input_type -> temporal.cloud.api.v1.GetAuditStreamRequest
+	22, // 35: temporal.cloud.api.v1.AuditService.ListAuditStreams:input_type -> temporal.cloud.api.v1.ListAuditStreamsRequest
+	24, // 36: temporal.cloud.api.v1.AuditService.UpdateAuditStream:input_type -> temporal.cloud.api.v1.UpdateAuditStreamRequest
+	26, // 37: temporal.cloud.api.v1.AuditService.DeleteAuditStream:input_type -> temporal.cloud.api.v1.DeleteAuditStreamRequest
+	7,  // 38: temporal.cloud.api.v1.AuditService.ListAuditEvents:output_type -> temporal.cloud.api.v1.ListAuditEventsResponse
+	9,  // 39: temporal.cloud.api.v1.AuditService.GetAuditEvent:output_type -> temporal.cloud.api.v1.GetAuditEventResponse
+	13, // 40: temporal.cloud.api.v1.AuditService.ExportAuditEvents:output_type -> temporal.cloud.api.v1.ExportAuditEventsResponse
+	12, // 41: temporal.cloud.api.v1.AuditService.GetAuditChainKey:output_type -> temporal.cloud.api.v1.GetAuditChainKeyResponse
+	19, // 42: temporal.cloud.api.v1.AuditService.CreateAuditStream:output_type -> temporal.cloud.api.v1.CreateAuditStreamResponse
+	21, // 43: temporal.cloud.api.v1.AuditService.GetAuditStream:output_type -> temporal.cloud.api.v1.GetAuditStreamResponse
+	23, // 44: temporal.cloud.api.v1.AuditService.ListAuditStreams:output_type -> temporal.cloud.api.v1.ListAuditStreamsResponse
+	25, // 45: temporal.cloud.api.v1.AuditService.UpdateAuditStream:output_type -> temporal.cloud.api.v1.UpdateAuditStreamResponse
+	27, // 46: temporal.cloud.api.v1.AuditService.DeleteAuditStream:output_type -> temporal.cloud.api.v1.DeleteAuditStreamResponse
+	38, // [38:47] is the sub-list for method output_type
+	29, // [29:38] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_cloud_v1_audit_proto_init() }
+func file_cloud_v1_audit_proto_init() {
+	if File_cloud_v1_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cloud_v1_audit_proto_rawDesc), len(file_cloud_v1_audit_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cloud_v1_audit_proto_goTypes,
+		DependencyIndexes: file_cloud_v1_audit_proto_depIdxs,
+		EnumInfos:         file_cloud_v1_audit_proto_enumTypes,
+		MessageInfos:      file_cloud_v1_audit_proto_msgTypes,
+	}.Build()
+	File_cloud_v1_audit_proto = out.File
+	file_cloud_v1_audit_proto_goTypes = nil
+	file_cloud_v1_audit_proto_depIdxs = nil
+}
@@ -0,0 +1,2854 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: cloud/v1/billing.proto
+
+package cloudv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PlanTier represents subscription plan tiers.
+type PlanTier int32
+
+const (
+	PlanTier_PLAN_TIER_UNSPECIFIED      PlanTier = 0
+	PlanTier_PLAN_TIER_FREE             PlanTier = 1
+	PlanTier_PLAN_TIER_ESSENTIALS       PlanTier = 2
+	PlanTier_PLAN_TIER_BUSINESS         PlanTier = 3
+	PlanTier_PLAN_TIER_ENTERPRISE       PlanTier = 4
+	PlanTier_PLAN_TIER_MISSION_CRITICAL PlanTier = 5
+)
+
+// Enum value maps for PlanTier.
+var (
+	PlanTier_name = map[int32]string{
+		0: "PLAN_TIER_UNSPECIFIED",
+		1: "PLAN_TIER_FREE",
+		2: "PLAN_TIER_ESSENTIALS",
+		3: "PLAN_TIER_BUSINESS",
+		4: "PLAN_TIER_ENTERPRISE",
+		5: "PLAN_TIER_MISSION_CRITICAL",
+	}
+	PlanTier_value = map[string]int32{
+		"PLAN_TIER_UNSPECIFIED":      0,
+		"PLAN_TIER_FREE":             1,
+		"PLAN_TIER_ESSENTIALS":       2,
+		"PLAN_TIER_BUSINESS":         3,
+		"PLAN_TIER_ENTERPRISE":       4,
+		"PLAN_TIER_MISSION_CRITICAL": 5,
+	}
+)
+
+func (x PlanTier) Enum() *PlanTier {
+	p := new(PlanTier)
+	*p = x
+	return p
+}
+
+func (x PlanTier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanTier) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_billing_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanTier) Type() protoreflect.EnumType {
+	return &file_cloud_v1_billing_proto_enumTypes[0]
+}
+
+func (x PlanTier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanTier.Descriptor instead.
+func (PlanTier) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{0}
+}
+
+// SubscriptionStatus represents subscription status.
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED SubscriptionStatus = 0
+	SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE      SubscriptionStatus = 1
+	SubscriptionStatus_SUBSCRIPTION_STATUS_PAST_DUE    SubscriptionStatus = 2
+	SubscriptionStatus_SUBSCRIPTION_STATUS_SUSPENDED   SubscriptionStatus = 3
+	SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED    SubscriptionStatus = 4
+	SubscriptionStatus_SUBSCRIPTION_STATUS_TRIALING    SubscriptionStatus = 5
+)
+
+// Enum value maps for SubscriptionStatus.
+var (
+	SubscriptionStatus_name = map[int32]string{
+		0: "SUBSCRIPTION_STATUS_UNSPECIFIED",
+		1: "SUBSCRIPTION_STATUS_ACTIVE",
+		2: "SUBSCRIPTION_STATUS_PAST_DUE",
+		3: "SUBSCRIPTION_STATUS_SUSPENDED",
+		4: "SUBSCRIPTION_STATUS_CANCELED",
+		5: "SUBSCRIPTION_STATUS_TRIALING",
+	}
+	SubscriptionStatus_value = map[string]int32{
+		"SUBSCRIPTION_STATUS_UNSPECIFIED": 0,
+		"SUBSCRIPTION_STATUS_ACTIVE":      1,
+		"SUBSCRIPTION_STATUS_PAST_DUE":    2,
+		"SUBSCRIPTION_STATUS_SUSPENDED":   3,
+		"SUBSCRIPTION_STATUS_CANCELED":    4,
+		"SUBSCRIPTION_STATUS_TRIALING":    5,
+	}
+)
+
+func (x SubscriptionStatus) Enum() *SubscriptionStatus {
+	p := new(SubscriptionStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_billing_proto_enumTypes[1].Descriptor()
+}
+
+func (SubscriptionStatus) Type() protoreflect.EnumType {
+	return &file_cloud_v1_billing_proto_enumTypes[1]
+}
+
+func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionStatus.Descriptor instead.
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{1}
+}
+
+// InvoiceStatus represents invoice status.
+type InvoiceStatus int32
+
+const (
+	InvoiceStatus_INVOICE_STATUS_UNSPECIFIED   InvoiceStatus = 0
+	InvoiceStatus_INVOICE_STATUS_DRAFT         InvoiceStatus = 1
+	InvoiceStatus_INVOICE_STATUS_OPEN          InvoiceStatus = 2
+	InvoiceStatus_INVOICE_STATUS_PAID          InvoiceStatus = 3
+	InvoiceStatus_INVOICE_STATUS_VOID          InvoiceStatus = 4
+	InvoiceStatus_INVOICE_STATUS_UNCOLLECTIBLE InvoiceStatus = 5
+)
+
+// Enum value maps for InvoiceStatus.
+var (
+	InvoiceStatus_name = map[int32]string{
+		0: "INVOICE_STATUS_UNSPECIFIED",
+		1: "INVOICE_STATUS_DRAFT",
+		2: "INVOICE_STATUS_OPEN",
+		3: "INVOICE_STATUS_PAID",
+		4: "INVOICE_STATUS_VOID",
+		5: "INVOICE_STATUS_UNCOLLECTIBLE",
+	}
+	InvoiceStatus_value = map[string]int32{
+		"INVOICE_STATUS_UNSPECIFIED":   0,
+		"INVOICE_STATUS_DRAFT":         1,
+		"INVOICE_STATUS_OPEN":          2,
+		"INVOICE_STATUS_PAID":          3,
+		"INVOICE_STATUS_VOID":          4,
+		"INVOICE_STATUS_UNCOLLECTIBLE": 5,
+	}
+)
+
+func (x InvoiceStatus) Enum() *InvoiceStatus {
+	p := new(InvoiceStatus)
+	*p = x
+	return p
+}
+
+func (x InvoiceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvoiceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_billing_proto_enumTypes[2].Descriptor()
+}
+
+func (InvoiceStatus) Type() protoreflect.EnumType {
+	return &file_cloud_v1_billing_proto_enumTypes[2]
+}
+
+func (x InvoiceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvoiceStatus.Descriptor instead.
+func (InvoiceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{2}
+}
+
+// Subscription represents an organization's subscription.
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Subscription ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Plan tier.
+	Plan PlanTier `protobuf:"varint,3,opt,name=plan,proto3,enum=temporal.cloud.api.v1.PlanTier" json:"plan,omitempty"`
+	// Subscription status.
+	Status SubscriptionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.cloud.api.v1.SubscriptionStatus" json:"status,omitempty"`
+	// Plan limits.
+	Limits *PlanLimits `protobuf:"bytes,5,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Current period start.
+	CurrentPeriodStart *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=current_period_start,json=currentPeriodStart,proto3" json:"current_period_start,omitempty"`
+	// Current period end.
+	CurrentPeriodEnd *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=current_period_end,json=currentPeriodEnd,proto3" json:"current_period_end,omitempty"`
+	// Stripe customer ID.
+	StripeCustomerId string `protobuf:"bytes,8,opt,name=stripe_customer_id,json=stripeCustomerId,proto3" json:"stripe_customer_id,omitempty"`
+	// Stripe subscription ID.
+	StripeSubscriptionId string `protobuf:"bytes,9,opt,name=stripe_subscription_id,json=stripeSubscriptionId,proto3" json:"stripe_subscription_id,omitempty"`
+	// Timestamp when the subscription was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the subscription was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Version of the plan the subscription is on.
+	PlanVersion   int32 `protobuf:"varint,12,opt,name=plan_version,json=planVersion,proto3" json:"plan_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Subscription) GetPlan() PlanTier {
+	if x != nil {
+		return x.Plan
+	}
+	return PlanTier_PLAN_TIER_UNSPECIFIED
+}
+
+func (x *Subscription) GetStatus() SubscriptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (x *Subscription) GetLimits() *PlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Subscription) GetCurrentPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodStart
+	}
+	return nil
+}
+
+func (x *Subscription) GetCurrentPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodEnd
+	}
+	return nil
+}
+
+func (x *Subscription) GetStripeCustomerId() string {
+	if x != nil {
+		return x.StripeCustomerId
+	}
+	return ""
+}
+
+func (x *Subscription) GetStripeSubscriptionId() string {
+	if x != nil {
+		return x.StripeSubscriptionId
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetPlanVersion() int32 {
+	if x != nil {
+		return x.PlanVersion
+	}
+	return 0
+}
+
+// PlanLimits represents the limits for a subscription plan.
+type PlanLimits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Included actions per month.
+	ActionsIncluded int64 `protobuf:"varint,1,opt,name=actions_included,json=actionsIncluded,proto3" json:"actions_included,omitempty"`
+	// Included active storage (GB).
+	ActiveStorageGb float64 `protobuf:"fixed64,2,opt,name=active_storage_gb,json=activeStorageGb,proto3" json:"active_storage_gb,omitempty"`
+	// Included retained storage (GB).
+	RetainedStorageGb float64 `protobuf:"fixed64,3,opt,name=retained_storage_gb,json=retainedStorageGb,proto3" json:"retained_storage_gb,omitempty"`
+	// Maximum namespaces.
+	MaxNamespaces int32 `protobuf:"varint,4,opt,name=max_namespaces,json=maxNamespaces,proto3" json:"max_namespaces,omitempty"`
+	// Maximum users.
+	MaxUsers int32 `protobuf:"varint,5,opt,name=max_users,json=maxUsers,proto3" json:"max_users,omitempty"`
+	// Maximum retention period (days).
+	MaxRetentionDays int32 `protobuf:"varint,6,opt,name=max_retention_days,json=maxRetentionDays,proto3" json:"max_retention_days,omitempty"`
+	// Whether SSO is available.
+	SsoAvailable bool `protobuf:"varint,7,opt,name=sso_available,json=ssoAvailable,proto3" json:"sso_available,omitempty"`
+	// Whether SCIM is available.
+	ScimAvailable bool `protobuf:"varint,8,opt,name=scim_available,json=scimAvailable,proto3" json:"scim_available,omitempty"`
+	// Whether multi-region HA is available.
+	MultiRegionAvailable bool `protobuf:"varint,9,opt,name=multi_region_available,json=multiRegionAvailable,proto3" json:"multi_region_available,omitempty"`
+	// Maximum namespaces with a standby region.
+	MaxHaNamespaces int32 `protobuf:"varint,10,opt,name=max_ha_namespaces,json=maxHaNamespaces,proto3" json:"max_ha_namespaces,omitempty"`
+	// Whether usage beyond the included amounts blocks changes instead of being
+	// billed as overage.
+	HardUsageLimits bool `protobuf:"varint,11,opt,name=hard_usage_limits,json=hardUsageLimits,proto3" json:"hard_usage_limits,omitempty"`
+	// Features included in the plan, such as "history_export",
+	// "private_connectivity" and "nexus".
+	Features      []string `protobuf:"bytes,12,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanLimits) Reset() {
+	*x = PlanLimits{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanLimits) ProtoMessage() {}
+
+func (x *PlanLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanLimits.ProtoReflect.Descriptor instead.
+func (*PlanLimits) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlanLimits) GetActionsIncluded() int64 {
+	if x != nil {
+		return x.ActionsIncluded
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetActiveStorageGb() float64 {
+	if x != nil {
+		return x.ActiveStorageGb
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetRetainedStorageGb() float64 {
+	if x != nil {
+		return x.RetainedStorageGb
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxNamespaces() int32 {
+	if x != nil {
+		return x.MaxNamespaces
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxUsers() int32 {
+	if x != nil {
+		return x.MaxUsers
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxRetentionDays() int32 {
+	if x != nil {
+		return x.MaxRetentionDays
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetSsoAvailable() bool {
+	if x != nil {
+		return x.SsoAvailable
+	}
+	return false
+}
+
+func (x *PlanLimits) GetScimAvailable() bool {
+	if x != nil {
+		return x.ScimAvailable
+	}
+	return false
+}
+
+func (x *PlanLimits) GetMultiRegionAvailable() bool {
+	if x != nil {
+		return x.MultiRegionAvailable
+	}
+	return false
+}
+
+func (x *PlanLimits) GetMaxHaNamespaces() int32 {
+	if x != nil {
+		return x.MaxHaNamespaces
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetHardUsageLimits() bool {
+	if x != nil {
+		return x.HardUsageLimits
+	}
+	return false
+}
+
+func (x *PlanLimits) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// Plan represents a version of a plan tier.
+type Plan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Plan tier.
+	Tier PlanTier `protobuf:"varint,1,opt,name=tier,proto3,enum=temporal.cloud.api.v1.PlanTier" json:"tier,omitempty"`
+	// Plan version.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Plan limits.
+	Limits *PlanLimits `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Monthly base fee (cents).
+	BaseFeeCents  int64 `protobuf:"varint,4,opt,name=base_fee_cents,json=baseFeeCents,proto3" json:"base_fee_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Plan) GetTier() PlanTier {
+	if x != nil {
+		return x.Tier
+	}
+	return PlanTier_PLAN_TIER_UNSPECIFIED
+}
+
+func (x *Plan) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Plan) GetLimits() *PlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Plan) GetBaseFeeCents() int64 {
+	if x != nil {
+		return x.BaseFeeCents
+	}
+	return 0
+}
+
+// PlanViolation represents a resource, or usage, that a plan does not allow.
+type PlanViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resource type, such as "namespace", "export_sink", "connectivity_rule",
+	// "nexus_endpoint" or "usage".
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Resource ID. Empty if the violation is not about a single resource.
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Description of the violation.
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanViolation) Reset() {
+	*x = PlanViolation{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanViolation) ProtoMessage() {}
+
+func (x *PlanViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanViolation.ProtoReflect.Descriptor instead.
+func (*PlanViolation) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlanViolation) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *PlanViolation) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PlanViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UsageSummary represents usage data for a period.
+type UsageSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Period start.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// Period end.
+	PeriodEnd *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// Total actions.
+	TotalActions int64 `protobuf:"varint,4,opt,name=total_actions,json=totalActions,proto3" json:"total_actions,omitempty"`
+	// Active storage (GB-hours).
+	ActiveStorageGbh float64 `protobuf:"fixed64,5,opt,name=active_storage_gbh,json=activeStorageGbh,proto3" json:"active_storage_gbh,omitempty"`
+	// Retained storage (GB-hours).
+	RetainedStorageGbh float64 `protobuf:"fixed64,6,opt,name=retained_storage_gbh,json=retainedStorageGbh,proto3" json:"retained_storage_gbh,omitempty"`
+	// Usage breakdown by namespace.
+	NamespaceUsage []*NamespaceUsage `protobuf:"bytes,7,rep,name=namespace_usage,json=namespaceUsage,proto3" json:"namespace_usage,omitempty"`
+	// Usage breakdown by action type.
+	ActionBreakdown *ActionBreakdown `protobuf:"bytes,8,opt,name=action_breakdown,json=actionBreakdown,proto3" json:"action_breakdown,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UsageSummary) Reset() {
+	*x = UsageSummary{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSummary) ProtoMessage() {}
+
+func (x *UsageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSummary.ProtoReflect.Descriptor instead.
+func (*UsageSummary) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UsageSummary) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *UsageSummary) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *UsageSummary) GetTotalActions() int64 {
+	if x != nil {
+		return x.TotalActions
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetActiveStorageGbh() float64 {
+	if x != nil {
+		return x.ActiveStorageGbh
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetRetainedStorageGbh() float64 {
+	if x != nil {
+		return x.RetainedStorageGbh
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetNamespaceUsage() []*NamespaceUsage {
+	if x != nil {
+		return x.NamespaceUsage
+	}
+	return nil
+}
+
+func (x *UsageSummary) GetActionBreakdown() *ActionBreakdown {
+	if x != nil {
+		return x.ActionBreakdown
+	}
+	return nil
+}
+
+// NamespaceUsage represents usage for a single namespace.
+type NamespaceUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Namespace name.
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	// Total actions.
+	TotalActions int64 `protobuf:"varint,3,opt,name=total_actions,json=totalActions,proto3" json:"total_actions,omitempty"`
+	// Active storage (GB-hours).
+	ActiveStorageGbh float64 `protobuf:"fixed64,4,opt,name=active_storage_gbh,json=activeStorageGbh,proto3" json:"active_storage_gbh,omitempty"`
+	// Retained storage (GB-hours).
+	RetainedStorageGbh float64 `protobuf:"fixed64,5,opt,name=retained_storage_gbh,json=retainedStorageGbh,proto3" json:"retained_storage_gbh,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NamespaceUsage) Reset() {
+	*x = NamespaceUsage{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceUsage) ProtoMessage() {}
+
+func (x *NamespaceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceUsage.ProtoReflect.Descriptor instead.
+func (*NamespaceUsage) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NamespaceUsage) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *NamespaceUsage) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *NamespaceUsage) GetTotalActions() int64 {
+	if x != nil {
+		return x.TotalActions
+	}
+	return 0
+}
+
+func (x *NamespaceUsage) GetActiveStorageGbh() float64 {
+	if x != nil {
+		return x.ActiveStorageGbh
+	}
+	return 0
+}
+
+func (x *NamespaceUsage) GetRetainedStorageGbh() float64 {
+	if x != nil {
+		return x.RetainedStorageGbh
+	}
+	return 0
+}
+
+// ActionBreakdown represents usage breakdown by action type.
+type ActionBreakdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Workflow started.
+	WorkflowStarted int64 `protobuf:"varint,1,opt,name=workflow_started,json=workflowStarted,proto3" json:"workflow_started,omitempty"`
+	// Workflow reset.
+	WorkflowReset int64 `protobuf:"varint,2,opt,name=workflow_reset,json=workflowReset,proto3" json:"workflow_reset,omitempty"`
+	// Timer started.
+	TimerStarted int64 `protobuf:"varint,3,opt,name=timer_started,json=timerStarted,proto3" json:"timer_started,omitempty"`
+	// Signal sent.
+	SignalSent int64 `protobuf:"varint,4,opt,name=signal_sent,json=signalSent,proto3" json:"signal_sent,omitempty"`
+	// Query received.
+	QueryReceived int64 `protobuf:"varint,5,opt,name=query_received,json=queryReceived,proto3" json:"query_received,omitempty"`
+	// Update received.
+	UpdateReceived int64 `protobuf:"varint,6,opt,name=update_received,json=updateReceived,proto3" json:"update_received,omitempty"`
+	// Activity started.
+	ActivityStarted int64 `protobuf:"varint,7,opt,name=activity_started,json=activityStarted,proto3" json:"activity_started,omitempty"`
+	// Activity heartbeat.
+	ActivityHeartbeat int64 `protobuf:"varint,8,opt,name=activity_heartbeat,json=activityHeartbeat,proto3" json:"activity_heartbeat,omitempty"`
+	// Local activity batch.
+	LocalActivityBatch int64 `protobuf:"varint,9,opt,name=local_activity_batch,json=localActivityBatch,proto3" json:"local_activity_batch,omitempty"`
+	// Child workflow started.
+	ChildWorkflowStarted int64 `protobuf:"varint,10,opt,name=child_workflow_started,json=childWorkflowStarted,proto3" json:"child_workflow_started,omitempty"`
+	// Schedule execution.
+	ScheduleExecution int64 `protobuf:"varint,11,opt,name=schedule_execution,json=scheduleExecution,proto3" json:"schedule_execution,omitempty"`
+	// Nexus operation.
+	NexusOperation int64 `protobuf:"varint,12,opt,name=nexus_operation,json=nexusOperation,proto3" json:"nexus_operation,omitempty"`
+	// Search attribute upsert.
+	SearchAttributeUpsert int64 `protobuf:"varint,13,opt,name=search_attribute_upsert,json=searchAttributeUpsert,proto3" json:"search_attribute_upsert,omitempty"`
+	// Side effect recorded.
+	SideEffectRecorded int64 `protobuf:"varint,14,opt,name=side_effect_recorded,json=sideEffectRecorded,proto3" json:"side_effect_recorded,omitempty"`
+	// Workflow exported.
+	WorkflowExported int64 `protobuf:"varint,15,opt,name=workflow_exported,json=workflowExported,proto3" json:"workflow_exported,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ActionBreakdown) Reset() {
+	*x = ActionBreakdown{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionBreakdown) ProtoMessage() {}
+
+func (x *ActionBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionBreakdown.ProtoReflect.Descriptor instead.
+func (*ActionBreakdown) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActionBreakdown) GetWorkflowStarted() int64 {
+	if x != nil {
+		return x.WorkflowStarted
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetWorkflowReset() int64 {
+	if x != nil {
+		return x.WorkflowReset
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetTimerStarted() int64 {
+	if x != nil {
+		return x.TimerStarted
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetSignalSent() int64 {
+	if x != nil {
+		return x.SignalSent
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetQueryReceived() int64 {
+	if x != nil {
+		return x.QueryReceived
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetUpdateReceived() int64 {
+	if x != nil {
+		return x.UpdateReceived
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetActivityStarted() int64 {
+	if x != nil {
+		return x.ActivityStarted
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetActivityHeartbeat() int64 {
+	if x != nil {
+		return x.ActivityHeartbeat
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetLocalActivityBatch() int64 {
+	if x != nil {
+		return x.LocalActivityBatch
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetChildWorkflowStarted() int64 {
+	if x != nil {
+		return x.ChildWorkflowStarted
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetScheduleExecution() int64 {
+	if x != nil {
+		return x.ScheduleExecution
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetNexusOperation() int64 {
+	if x != nil {
+		return x.NexusOperation
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetSearchAttributeUpsert() int64 {
+	if x != nil {
+		return x.SearchAttributeUpsert
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetSideEffectRecorded() int64 {
+	if x != nil {
+		return x.SideEffectRecorded
+	}
+	return 0
+}
+
+func (x *ActionBreakdown) GetWorkflowExported() int64 {
+	if x != nil {
+		return x.WorkflowExported
+	}
+	return 0
+}
+
+// Invoice represents a billing invoice.
+type Invoice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invoice ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Invoice number.
+	InvoiceNumber string `protobuf:"bytes,3,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	// Period start.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// Period end.
+	PeriodEnd *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// Line items.
+	LineItems []*InvoiceLineItem `protobuf:"bytes,6,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	// Subtotal (cents).
+	SubtotalCents int64 `protobuf:"varint,7,opt,name=subtotal_cents,json=subtotalCents,proto3" json:"subtotal_cents,omitempty"`
+	// Tax (cents).
+	TaxCents int64 `protobuf:"varint,8,opt,name=tax_cents,json=taxCents,proto3" json:"tax_cents,omitempty"`
+	// Credits applied (cents).
+	CreditsAppliedCents int64 `protobuf:"varint,9,opt,name=credits_applied_cents,json=creditsAppliedCents,proto3" json:"credits_applied_cents,omitempty"`
+	// Total (cents).
+	TotalCents int64 `protobuf:"varint,10,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	// Invoice status.
+	Status InvoiceStatus `protobuf:"varint,11,opt,name=status,proto3,enum=temporal.cloud.api.v1.InvoiceStatus" json:"status,omitempty"`
+	// Stripe invoice ID.
+	StripeInvoiceId string `protobuf:"bytes,12,opt,name=stripe_invoice_id,json=stripeInvoiceId,proto3" json:"stripe_invoice_id,omitempty"`
+	// PDF download URL.
+	PdfUrl string `protobuf:"bytes,13,opt,name=pdf_url,json=pdfUrl,proto3" json:"pdf_url,omitempty"`
+	// Timestamp when the invoice was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the invoice was paid.
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Invoice) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *Invoice) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Invoice) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Invoice) GetLineItems() []*InvoiceLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Invoice) GetSubtotalCents() int64 {
+	if x != nil {
+		return x.SubtotalCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetTaxCents() int64 {
+	if x != nil {
+		return x.TaxCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetCreditsAppliedCents() int64 {
+	if x != nil {
+		return x.CreditsAppliedCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetStatus() InvoiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvoiceStatus_INVOICE_STATUS_UNSPECIFIED
+}
+
+func (x *Invoice) GetStripeInvoiceId() string {
+	if x != nil {
+		return x.StripeInvoiceId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPdfUrl() string {
+	if x != nil {
+		return x.PdfUrl
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+// InvoiceLineItem represents a line item on an invoice.
+type InvoiceLineItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Description.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// Quantity.
+	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Unit.
+	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Unit price (cents).
+	UnitPriceCents int64 `protobuf:"varint,4,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`
+	// Amount (cents).
+	AmountCents   int64 `protobuf:"varint,5,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceLineItem) Reset() {
+	*x = InvoiceLineItem{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLineItem) ProtoMessage() {}
+
+func (x *InvoiceLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLineItem.ProtoReflect.Descriptor instead.
+func (*InvoiceLineItem) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InvoiceLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+// CreditBalance represents an organization's credit balance.
+type CreditBalance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Current balance (cents).
+	BalanceCents int64 `protobuf:"varint,2,opt,name=balance_cents,json=balanceCents,proto3" json:"balance_cents,omitempty"`
+	// Credit transactions.
+	Transactions  []*CreditTransaction `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditBalance) Reset() {
+	*x = CreditBalance{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditBalance) ProtoMessage() {}
+
+func (x *CreditBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditBalance.ProtoReflect.Descriptor instead.
+func (*CreditBalance) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreditBalance) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreditBalance) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+func (x *CreditBalance) GetTransactions() []*CreditTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// CreditTransaction represents a credit transaction.
+type CreditTransaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Transaction ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Amount (cents, positive for credit, negative for debit).
+	AmountCents int64 `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	// Description.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Timestamp.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditTransaction) Reset() {
+	*x = CreditTransaction{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditTransaction) ProtoMessage() {}
+
+func (x *CreditTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditTransaction.ProtoReflect.Descriptor instead.
+func (*CreditTransaction) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreditTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreditTransaction) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *CreditTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreditTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetSubscriptionRequest is the request for GetSubscription.
+type GetSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSubscriptionRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// GetSubscriptionResponse is the response for GetSubscription.
+type GetSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The subscription.
+	Subscription  *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionResponse) Reset() {
+	*x = GetSubscriptionResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionResponse) ProtoMessage() {}
+
+func (x *GetSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// UpdateSubscriptionRequest is the request for UpdateSubscription.
+type UpdateSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// New plan tier.
+	Plan          PlanTier `protobuf:"varint,2,opt,name=plan,proto3,enum=temporal.cloud.api.v1.PlanTier" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionRequest) Reset() {
+	*x = UpdateSubscriptionRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateSubscriptionRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetPlan() PlanTier {
+	if x != nil {
+		return x.Plan
+	}
+	return PlanTier_PLAN_TIER_UNSPECIFIED
+}
+
+// UpdateSubscriptionResponse is the response for UpdateSubscription.
+type UpdateSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated subscription.
+	Subscription  *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionResponse) Reset() {
+	*x = UpdateSubscriptionResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// GetUsageRequest is the request for GetUsage.
+type GetUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Period start.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// Period end.
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUsageRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GetUsageRequest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+// GetUsageResponse is the response for GetUsage.
+type GetUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Usage summary.
+	Usage         *UsageSummary `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUsageResponse) GetUsage() *UsageSummary {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// GetUsageByNamespaceRequest is the request for GetUsageByNamespace.
+type GetUsageByNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Period start.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// Period end.
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageByNamespaceRequest) Reset() {
+	*x = GetUsageByNamespaceRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageByNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageByNamespaceRequest) ProtoMessage() {}
+
+func (x *GetUsageByNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageByNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUsageByNamespaceRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *GetUsageByNamespaceRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GetUsageByNamespaceRequest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+// GetUsageByNamespaceResponse is the response for GetUsageByNamespace.
+type GetUsageByNamespaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace usage.
+	Usage         *NamespaceUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageByNamespaceResponse) Reset() {
+	*x = GetUsageByNamespaceResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageByNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageByNamespaceResponse) ProtoMessage() {}
+
+func (x *GetUsageByNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageByNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageByNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUsageByNamespaceResponse) GetUsage() *NamespaceUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// ListInvoicesRequest is the request for ListInvoices.
+type ListInvoicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of invoices to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListInvoicesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListInvoicesResponse is the response for ListInvoices.
+type ListInvoicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of invoices.
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *ListInvoicesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetInvoiceRequest is the request for GetInvoice.
+type GetInvoiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invoice ID.
+	InvoiceId     string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetInvoiceRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+// GetInvoiceResponse is the response for GetInvoice.
+type GetInvoiceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invoice.
+	Invoice       *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceResponse) Reset() {
+	*x = GetInvoiceResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResponse) ProtoMessage() {}
+
+func (x *GetInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+// GetCreditBalanceRequest is the request for GetCreditBalance.
+type GetCreditBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCreditBalanceRequest) Reset() {
+	*x = GetCreditBalanceRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditBalanceRequest) ProtoMessage() {}
+
+func (x *GetCreditBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCreditBalanceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// GetCreditBalanceResponse is the response for GetCreditBalance.
+type GetCreditBalanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Credit balance.
+	Balance       *CreditBalance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreditBalanceResponse) Reset() {
+	*x = GetCreditBalanceResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditBalanceResponse) ProtoMessage() {}
+
+func (x *GetCreditBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCreditBalanceResponse) GetBalance() *CreditBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// PurchaseCreditsRequest is the request for PurchaseCredits.
+type PurchaseCreditsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Amount to purchase (cents).
+	AmountCents   int64 `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseCreditsRequest) Reset() {
+	*x = PurchaseCreditsRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseCreditsRequest) ProtoMessage() {}
+
+func (x *PurchaseCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseCreditsRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PurchaseCreditsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PurchaseCreditsRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+// PurchaseCreditsResponse is the response for PurchaseCredits.
+type PurchaseCreditsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updated credit balance.
+	Balance *CreditBalance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Stripe payment intent ID.
+	PaymentIntentId string `protobuf:"bytes,2,opt,name=payment_intent_id,json=paymentIntentId,proto3" json:"payment_intent_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurchaseCreditsResponse) Reset() {
+	*x = PurchaseCreditsResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseCreditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseCreditsResponse) ProtoMessage() {}
+
+func (x *PurchaseCreditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseCreditsResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PurchaseCreditsResponse) GetBalance() *CreditBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *PurchaseCreditsResponse) GetPaymentIntentId() string {
+	if x != nil {
+		return x.PaymentIntentId
+	}
+	return ""
+}
+
+// UpdatePaymentMethodRequest is the request for UpdatePaymentMethod.
+type UpdatePaymentMethodRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Stripe payment method ID.
+	PaymentMethodId string `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePaymentMethodRequest) Reset() {
+	*x = UpdatePaymentMethodRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePaymentMethodRequest) ProtoMessage() {}
+
+func (x *UpdatePaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdatePaymentMethodRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdatePaymentMethodRequest) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+// UpdatePaymentMethodResponse is the response for UpdatePaymentMethod.
+type UpdatePaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePaymentMethodResponse) Reset() {
+	*x = UpdatePaymentMethodResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePaymentMethodResponse) ProtoMessage() {}
+
+func (x *UpdatePaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{28}
+}
+
+// ListPlansRequest is the request for ListPlans.
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansRequest) ProtoMessage() {}
+
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{29}
+}
+
+// ListPlansResponse is the response for ListPlans.
+type ListPlansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Plans, from the lowest tier up.
+	Plans         []*Plan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansResponse) Reset() {
+	*x = ListPlansResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansResponse) ProtoMessage() {}
+
+func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPlansResponse) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// ValidatePlanChangeRequest is the request for ValidatePlanChange.
+type ValidatePlanChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Target plan tier.
+	Plan          PlanTier `protobuf:"varint,2,opt,name=plan,proto3,enum=temporal.cloud.api.v1.PlanTier" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePlanChangeRequest) Reset() {
+	*x = ValidatePlanChangeRequest{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePlanChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePlanChangeRequest) ProtoMessage() {}
+
+func (x *ValidatePlanChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePlanChangeRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePlanChangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ValidatePlanChangeRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ValidatePlanChangeRequest) GetPlan() PlanTier {
+	if x != nil {
+		return x.Plan
+	}
+	return PlanTier_PLAN_TIER_UNSPECIFIED
+}
+
+// ValidatePlanChangeResponse is the response for ValidatePlanChange.
+type ValidatePlanChangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the target plan that was validated against.
+	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// Violations of the target plan. Empty if the change is allowed.
+	Violations    []*PlanViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePlanChangeResponse) Reset() {
+	*x = ValidatePlanChangeResponse{}
+	mi := &file_cloud_v1_billing_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePlanChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePlanChangeResponse) ProtoMessage() {}
+
+func (x *ValidatePlanChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_billing_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePlanChangeResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePlanChangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_billing_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ValidatePlanChangeResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *ValidatePlanChangeResponse) GetViolations() []*PlanViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+var File_cloud_v1_billing_proto protoreflect.FileDescriptor
+
+const file_cloud_v1_billing_proto_rawDesc = "" +
+	"\n" +
+	"\x16cloud/v1/billing.proto\x12\x15temporal.cloud.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x05\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x123\n" +
+	"\x04plan\x18\x03 \x01(\x0e2\x1f.temporal.cloud.api.v1.PlanTierR\x04plan\x12A\n" +
+	"\x06status\x18\x04 \x01(\x0e2).temporal.cloud.api.v1.SubscriptionStatusR\x06status\x129\n" +
+	"\x06limits\x18\x05 \x01(\v2!.temporal.cloud.api.v1.PlanLimitsR\x06limits\x12L\n" +
+	"\x14current_period_start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x12currentPeriodStart\x12H\n" +
+	"\x12current_period_end\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10currentPeriodEnd\x12,\n" +
+	"\x12stripe_customer_id\x18\b \x01(\tR\x10stripeCustomerId\x124\n" +
+	"\x16stripe_subscription_id\x18\t \x01(\tR\x14stripeSubscriptionId\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\fplan_version\x18\f \x01(\x05R\vplanVersion\"\xfb\x03\n" +
+	"\n" +
+	"PlanLimits\x12)\n" +
+	"\x10actions_included\x18\x01 \x01(\x03R\x0factionsIncluded\x12*\n" +
+	"\x11active_storage_gb\x18\x02 \x01(\x01R\x0factiveStorageGb\x12.\n" +
+	"\x13retained_storage_gb\x18\x03 \x01(\x01R\x11retainedStorageGb\x12%\n" +
+	"\x0emax_namespaces\x18\x04 \x01(\x05R\rmaxNamespaces\x12\x1b\n" +
+	"\tmax_users\x18\x05 \x01(\x05R\bmaxUsers\x12,\n" +
+	"\x12max_retention_days\x18\x06 \x01(\x05R\x10maxRetentionDays\x12#\n" +
+	"\rsso_available\x18\a \x01(\bR\fssoAvailable\x12%\n" +
+	"\x0escim_available\x18\b \x01(\bR\rscimAvailable\x124\n" +
+	"\x16multi_region_available\x18\t \x01(\bR\x14multiRegionAvailable\x12*\n" +
+	"\x11max_ha_namespaces\x18\n" +
+	" \x01(\x05R\x0fmaxHaNamespaces\x12*\n" +
+	"\x11hard_usage_limits\x18\v \x01(\bR\x0fhardUsageLimits\x12\x1a\n" +
+	"\bfeatures\x18\f \x03(\tR\bfeatures\"\xb6\x01\n" +
+	"\x04Plan\x123\n" +
+	"\x04tier\x18\x01 \x01(\x0e2\x1f.temporal.cloud.api.v1.PlanTierR\x04tier\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x129\n" +
+	"\x06limits\x18\x03 \x01(\v2!.temporal.cloud.api.v1.PlanLimitsR\x06limits\x12$\n" +
+	"\x0ebase_fee_cents\x18\x04 \x01(\x03R\fbaseFeeCents\"o\n" +
+	"\rPlanViolation\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xd9\x03\n" +
+	"\fUsageSummary\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12#\n" +
+	"\rtotal_actions\x18\x04 \x01(\x03R\ftotalActions\x12,\n" +
+	"\x12active_storage_gbh\x18\x05 \x01(\x01R\x10activeStorageGbh\x120\n" +
+	"\x14retained_storage_gbh\x18\x06 \x01(\x01R\x12retainedStorageGbh\x12N\n" +
+	"\x0fnamespace_usage\x18\a \x03(\v2%.temporal.cloud.api.v1.NamespaceUsageR\x0enamespaceUsage\x12Q\n" +
+	"\x10action_breakdown\x18\b \x01(\v2&.temporal.cloud.api.v1.ActionBreakdownR\x0factionBreakdown\"\xdf\x01\n" +
+	"\x0eNamespaceUsage\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12%\n" +
+	"\x0enamespace_name\x18\x02 \x01(\tR\rnamespaceName\x12#\n" +
+	"\rtotal_actions\x18\x03 \x01(\x03R\ftotalActions\x12,\n" +
+	"\x12active_storage_gbh\x18\x04 \x01(\x01R\x10activeStorageGbh\x120\n" +
+	"\x14retained_storage_gbh\x18\x05 \x01(\x01R\x12retainedStorageGbh\"\xaa\x05\n" +
+	"\x0fActionBreakdown\x12)\n" +
+	"\x10workflow_started\x18\x01 \x01(\x03R\x0fworkflowStarted\x12%\n" +
+	"\x0eworkflow_reset\x18\x02 \x01(\x03R\rworkflowReset\x12#\n" +
+	"\rtimer_started\x18\x03 \x01(\x03R\ftimerStarted\x12\x1f\n" +
+	"\vsignal_sent\x18\x04 \x01(\x03R\n" +
+	"signalSent\x12%\n" +
+	"\x0equery_received\x18\x05 \x01(\x03R\rqueryReceived\x12'\n" +
+	"\x0fupdate_received\x18\x06 \x01(\x03R\x0eupdateReceived\x12)\n" +
+	"\x10activity_started\x18\a \x01(\x03R\x0factivityStarted\x12-\n" +
+	"\x12activity_heartbeat\x18\b \x01(\x03R\x11activityHeartbeat\x120\n" +
+	"\x14local_activity_batch\x18\t \x01(\x03R\x12localActivityBatch\x124\n" +
+	"\x16child_workflow_started\x18\n" +
+	" \x01(\x03R\x14childWorkflowStarted\x12-\n" +
+	"\x12schedule_execution\x18\v \x01(\x03R\x11scheduleExecution\x12'\n" +
+	"\x0fnexus_operation\x18\f \x01(\x03R\x0enexusOperation\x126\n" +
+	"\x17search_attribute_upsert\x18\r \x01(\x03R\x15searchAttributeUpsert\x120\n" +
+	"\x14side_effect_recorded\x18\x0e \x01(\x03R\x12sideEffectRecorded\x12+\n" +
+	"\x11workflow_exported\x18\x0f \x01(\x03R\x10workflowExported\"\xb6\x05\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12%\n" +
+	"\x0einvoice_number\x18\x03 \x01(\tR\rinvoiceNumber\x12=\n" +
+	"\fperiod_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12E\n" +
+	"\n" +
+	"line_items\x18\x06 \x03(\v2&.temporal.cloud.api.v1.InvoiceLineItemR\tlineItems\x12%\n" +
+	"\x0esubtotal_cents\x18\a \x01(\x03R\rsubtotalCents\x12\x1b\n" +
+	"\ttax_cents\x18\b \x01(\x03R\btaxCents\x122\n" +
+	"\x15credits_applied_cents\x18\t \x01(\x03R\x13creditsAppliedCents\x12\x1f\n" +
+	"\vtotal_cents\x18\n" +
+	" \x01(\x03R\n" +
+	"totalCents\x12<\n" +
+	"\x06status\x18\v \x01(\x0e2$.temporal.cloud.api.v1.InvoiceStatusR\x06status\x12*\n" +
+	"\x11stripe_invoice_id\x18\f \x01(\tR\x0fstripeInvoiceId\x12\x17\n" +
+	"\apdf_url\x18\r \x01(\tR\x06pdfUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\apaid_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\"\xb0\x01\n" +
+	"\x0fInvoiceLineItem\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12(\n" +
+	"\x10unit_price_cents\x18\x04 \x01(\x03R\x0eunitPriceCents\x12!\n" +
+	"\famount_cents\x18\x05 \x01(\x03R\vamountCents\"\xab\x01\n" +
+	"\rCreditBalance\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rbalance_cents\x18\x02 \x01(\x03R\fbalanceCents\x12L\n" +
+	"\ftransactions\x18\x03 \x03(\v2(.temporal.cloud.api.v1.CreditTransactionR\ftransactions\"\xa3\x01\n" +
+	"\x11CreditTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"A\n" +
+	"\x16GetSubscriptionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"b\n" +
+	"\x17GetSubscriptionResponse\x12G\n" +
+	"\fsubscription\x18\x01 \x01(\v2#.temporal.cloud.api.v1.SubscriptionR\fsubscription\"y\n" +
+	"\x19UpdateSubscriptionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x123\n" +
+	"\x04plan\x18\x02 \x01(\x0e2\x1f.temporal.cloud.api.v1.PlanTierR\x04plan\"e\n" +
+	"\x1aUpdateSubscriptionResponse\x12G\n" +
+	"\fsubscription\x18\x01 \x01(\v2#.temporal.cloud.api.v1.SubscriptionR\fsubscription\"\xb4\x01\n" +
+	"\x0fGetUsageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\"M\n" +
+	"\x10GetUsageResponse\x129\n" +
+	"\x05usage\x18\x01 \x01(\v2#.temporal.cloud.api.v1.UsageSummaryR\x05usage\"\xb9\x01\n" +
+	"\x1aGetUsageByNamespaceRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\"Z\n" +
+	"\x1bGetUsageByNamespaceResponse\x12;\n" +
+	"\x05usage\x18\x01 \x01(\v2%.temporal.cloud.api.v1.NamespaceUsageR\x05usage\"z\n" +
+	"\x13ListInvoicesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"z\n" +
+	"\x14ListInvoicesResponse\x12:\n" +
+	"\binvoices\x18\x01 \x03(\v2\x1e.temporal.cloud.api.v1.InvoiceR\binvoices\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"2\n" +
+	"\x11GetInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\"N\n" +
+	"\x12GetInvoiceResponse\x128\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x1e.temporal.cloud.api.v1.InvoiceR\ainvoice\"B\n" +
+	"\x17GetCreditBalanceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"Z\n" +
+	"\x18GetCreditBalanceResponse\x12>\n" +
+	"\abalance\x18\x01 \x01(\v2$.temporal.cloud.api.v1.CreditBalanceR\abalance\"d\n" +
+	"\x16PurchaseCreditsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\"\x85\x01\n" +
+	"\x17PurchaseCreditsResponse\x12>\n" +
+	"\abalance\x18\x01 \x01(\v2$.temporal.cloud.api.v1.CreditBalanceR\abalance\x12*\n" +
+	"\x11payment_intent_id\x18\x02 \x01(\tR\x0fpaymentIntentId\"q\n" +
+	"\x1aUpdatePaymentMethodRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
+	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\"\x1d\n" +
+	"\x1bUpdatePaymentMethodResponse\"\x12\n" +
+	"\x10ListPlansRequest\"F\n" +
+	"\x11ListPlansResponse\x121\n" +
+	"\x05plans\x18\x01 \x03(\v2\x1b.temporal.cloud.api.v1.PlanR\x05plans\"y\n" +
+	"\x19ValidatePlanChangeRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x123\n" +
+	"\x04plan\x18\x02 \x01(\x0e2\x1f.temporal.cloud.api.v1.PlanTierR\x04plan\"\x93\x01\n" +
+	"\x1aValidatePlanChangeResponse\x12/\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1b.temporal.cloud.api.v1.PlanR\x04plan\x12D\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2$.temporal.cloud.api.v1.PlanViolationR\n" +
+	"violations*\xa5\x01\n" +
+	"\bPlanTier\x12\x19\n" +
+	"\x15PLAN_TIER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0ePLAN_TIER_FREE\x10\x01\x12\x18\n" +
+	"\x14PLAN_TIER_ESSENTIALS\x10\x02\x12\x16\n" +
+	"\x12PLAN_TIER_BUSINESS\x10\x03\x12\x18\n" +
+	"\x14PLAN_TIER_ENTERPRISE\x10\x04\x12\x1e\n" +
+	"\x1aPLAN_TIER_MISSION_CRITICAL\x10\x05*\xe2\x01\n" +
+	"\x12SubscriptionStatus\x12#\n" +
+	"\x1fSUBSCRIPTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_ACTIVE\x10\x01\x12 \n" +
+	"\x1cSUBSCRIPTION_STATUS_PAST_DUE\x10\x02\x12!\n" +
+	"\x1dSUBSCRIPTION_STATUS_SUSPENDED\x10\x03\x12 \n" +
+	"\x1cSUBSCRIPTION_STATUS_CANCELED\x10\x04\x12 \n" +
+	"\x1cSUBSCRIPTION_STATUS_TRIALING\x10\x05*\xb6\x01\n" +
+	"\rInvoiceStatus\x12\x1e\n" +
+	"\x1aINVOICE_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14INVOICE_STATUS_DRAFT\x10\x01\x12\x17\n" +
+	"\x13INVOICE_STATUS_OPEN\x10\x02\x12\x17\n" +
+	"\x13INVOICE_STATUS_PAID\x10\x03\x12\x17\n" +
+	"\x13INVOICE_STATUS_VOID\x10\x04\x12 \n" +
+	"\x1cINVOICE_STATUS_UNCOLLECTIBLE\x10\x052\xe4\t\n" +
+	"\x0eBillingService\x12p\n" +
+	"\x0fGetSubscription\x12-.temporal.cloud.api.v1.GetSubscriptionRequest\x1a..temporal.cloud.api.v1.GetSubscriptionResponse\x12y\n" +
+	"\x12UpdateSubscription\x120.temporal.cloud.api.v1.UpdateSubscriptionRequest\x1a1.temporal.cloud.api.v1.UpdateSubscriptionResponse\x12[\n" +
+	"\bGetUsage\x12&.temporal.cloud.api.v1.GetUsageRequest\x1a'.temporal.cloud.api.v1.GetUsageResponse\x12|\n" +
+	"\x13GetUsageByNamespace\x121.temporal.cloud.api.v1.GetUsageByNamespaceRequest\x1a2.temporal.cloud.api.v1.GetUsageByNamespaceResponse\x12g\n" +
+	"\fListInvoices\x12*.temporal.cloud.api.v1.ListInvoicesRequest\x1a+.temporal.cloud.api.v1.ListInvoicesResponse\x12a\n" +
+	"\n" +
+	"GetInvoice\x12(.temporal.cloud.api.v1.GetInvoiceRequest\x1a).temporal.cloud.api.v1.GetInvoiceResponse\x12s\n" +
+	"\x10GetCreditBalance\x12..temporal.cloud.api.v1.GetCreditBalanceRequest\x1a/.temporal.cloud.api.v1.GetCreditBalanceResponse\x12p\n" +
+	"\x0fPurchaseCredits\x12-.temporal.cloud.api.v1.PurchaseCreditsRequest\x1a..temporal.cloud.api.v1.PurchaseCreditsResponse\x12|\n" +
+	"\x13UpdatePaymentMethod\x121.temporal.cloud.api.v1.UpdatePaymentMethodRequest\x1a2.temporal.cloud.api.v1.UpdatePaymentMethodResponse\x12^\n" +
+	"\tListPlans\x12'.temporal.cloud.api.v1.ListPlansRequest\x1a(.temporal.cloud.api.v1.ListPlansResponse\x12y\n" +
+	"\x12ValidatePlanChange\x120.temporal.cloud.api.v1.ValidatePlanChangeRequest\x1a1.temporal.cloud.api.v1.ValidatePlanChangeResponseB+Z)go.temporal.io/cloud/api/cloud/v1;cloudv1b\x06proto3"
+
+var (
+	file_cloud_v1_billing_proto_rawDescOnce sync.Once
+	file_cloud_v1_billing_proto_rawDescData []byte
+)
+
+func file_cloud_v1_billing_proto_rawDescGZIP() []byte {
+	file_cloud_v1_billing_proto_rawDescOnce.Do(func() {
+		file_cloud_v1_billing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cloud_v1_billing_proto_rawDesc), len(file_cloud_v1_billing_proto_rawDesc)))
+	})
+	return file_cloud_v1_billing_proto_rawDescData
+}
+
+var file_cloud_v1_billing_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cloud_v1_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_cloud_v1_billing_proto_goTypes = []any{
+	(PlanTier)(0),                       // 0: temporal.cloud.api.v1.PlanTier
+	(SubscriptionStatus)(0),             // 1: temporal.cloud.api.v1.SubscriptionStatus
+	(InvoiceStatus)(0),                  // 2: temporal.cloud.api.v1.InvoiceStatus
+	(*Subscription)(nil),                // 3: temporal.cloud.api.v1.Subscription
+	(*PlanLimits)(nil),                  // 4: temporal.cloud.api.v1.PlanLimits
+	(*Plan)(nil),                        // 5: temporal.cloud.api.v1.Plan
+	(*PlanViolation)(nil),               // 6: temporal.cloud.api.v1.PlanViolation
+	(*UsageSummary)(nil),                // 7: temporal.cloud.api.v1.UsageSummary
+	(*NamespaceUsage)(nil),              // 8: temporal.cloud.api.v1.NamespaceUsage
+	(*ActionBreakdown)(nil),             // 9: temporal.cloud.api.v1.ActionBreakdown
+	(*Invoice)(nil),                     // 10: temporal.cloud.api.v1.Invoice
+	(*InvoiceLineItem)(nil),             // 11: temporal.cloud.api.v1.InvoiceLineItem
+	(*CreditBalance)(nil),               // 12: temporal.cloud.api.v1.CreditBalance
+	(*CreditTransaction)(nil),           // 13: temporal.cloud.api.v1.CreditTransaction
+	(*GetSubscriptionRequest)(nil),      // 14: temporal.cloud.api.v1.GetSubscriptionRequest
+	(*GetSubscriptionResponse)(nil),     // 15: temporal.cloud.api.v1.GetSubscriptionResponse
+	(*UpdateSubscriptionRequest)(nil),   // 16: temporal.cloud.api.v1.UpdateSubscriptionRequest
+	(*UpdateSubscriptionResponse)(nil),  // 17: temporal.cloud.api.v1.UpdateSubscriptionResponse
+	(*GetUsageRequest)(nil),             // 18: temporal.cloud.api.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),            // 19: temporal.cloud.api.v1.GetUsageResponse
+	(*GetUsageByNamespaceRequest)(nil),  // 20: temporal.cloud.api.v1.GetUsageByNamespaceRequest
+	(*GetUsageByNamespaceResponse)(nil), // 21: temporal.cloud.api.v1.GetUsageByNamespaceResponse
+	(*ListInvoicesRequest)(nil),         // 22: temporal.cloud.api.v1.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),        // 23: temporal.cloud.api.v1.ListInvoicesResponse
+	(*GetInvoiceRequest)(nil),           // 24: temporal.cloud.api.v1.GetInvoiceRequest
+	(*GetInvoiceResponse)(nil),          // 25: temporal.cloud.api.v1.GetInvoiceResponse
+	(*GetCreditBalanceRequest)(nil),     // 26: temporal.cloud.api.v1.GetCreditBalanceRequest
+	(*GetCreditBalanceResponse)(nil),    // 27: temporal.cloud.api.v1.GetCreditBalanceResponse
+	(*PurchaseCreditsRequest)(nil),      // 28: temporal.cloud.api.v1.PurchaseCreditsRequest
+	(*PurchaseCreditsResponse)(nil),     // 29: temporal.cloud.api.v1.PurchaseCreditsResponse
+	(*UpdatePaymentMethodRequest)(nil),  // 30: temporal.cloud.api.v1.UpdatePaymentMethodRequest
+	(*UpdatePaymentMethodResponse)(nil), // 31: temporal.cloud.api.v1.UpdatePaymentMethodResponse
+	(*ListPlansRequest)(nil),            // 32: temporal.cloud.api.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),           // 33: temporal.cloud.api.v1.ListPlansResponse
+	(*ValidatePlanChangeRequest)(nil),   // 34: temporal.cloud.api.v1.ValidatePlanChangeRequest
+	(*ValidatePlanChangeResponse)(nil),  // 35: temporal.cloud.api.v1.ValidatePlanChangeResponse
+	(*timestamppb.Timestamp)(nil),       // 36: google.protobuf.Timestamp
+}
+var file_cloud_v1_billing_proto_depIdxs = []int32{
+	0,  // 0: temporal.cloud.api.v1.Subscription.plan:type_name -> temporal.cloud.api.v1.PlanTier
+	1,  // 1: temporal.cloud.api.v1.Subscription.status:type_name -> temporal.cloud.api.v1.SubscriptionStatus
+	4,  // 2: temporal.cloud.api.v1.Subscription.limits:type_name -> temporal.cloud.api.v1.PlanLimits
+	36, // 3: temporal.cloud.api.v1.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
+	36, // 4: temporal.cloud.api.v1.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
+	36, // 5: temporal.cloud.api.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	36, // 6: temporal.cloud.api.v1.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: temporal.cloud.api.v1.Plan.tier:type_name -> temporal.cloud.api.v1.PlanTier
+	4,  // 8: temporal.cloud.api.v1.Plan.limits:type_name -> temporal.cloud.api.v1.PlanLimits
+	36, // 9: temporal.cloud.api.v1.UsageSummary.period_start:type_name -> google.protobuf.Timestamp
+	36, // 10: temporal.cloud.api.v1.UsageSummary.period_end:type_name -> google.protobuf.Timestamp
+	8,  // 11: temporal.cloud.api.v1.UsageSummary.namespace_usage:type_name -> temporal.cloud.api.v1.NamespaceUsage
+	9,  // 12: temporal.cloud.api.v1.UsageSummary.action_breakdown:type_name -> temporal.cloud.api.v1.ActionBreakdown
+	36, // 13: temporal.cloud.api.v1.Invoice.period_start:type_name -> google.protobuf.Timestamp
+	36, // 14: temporal.cloud.api.v1.Invoice.period_end:type_name -> google.protobuf.Timestamp
+	11, // 15: temporal.cloud.api.v1.Invoice.line_items:type_name -> temporal.cloud.api.v1.InvoiceLineItem
+	2,  // 16: temporal.cloud.api.v1.Invoice.status:type_name -> temporal.cloud.api.v1.InvoiceStatus
+	36, // 17: temporal.cloud.api.v1.Invoice.created_at:type_name -> google.protobuf.Timestamp
+	36, // 18: temporal.cloud.api.v1.Invoice.paid_at:type_name -> google.protobuf.Timestamp
+	13, // 19: temporal.cloud.api.v1.CreditBalance.transactions:type_name -> temporal.cloud.api.v1.CreditTransaction
+	36, // 20: temporal.cloud.api.v1.CreditTransaction.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 21: temporal.cloud.api.v1.GetSubscriptionResponse.subscription:type_name -> temporal.cloud.api.v1.Subscription
+	0,  // 22: temporal.cloud.api.v1.UpdateSubscriptionRequest.plan:type_name -> temporal.cloud.api.v1.PlanTier
+	3,  // 23: temporal.cloud.api.v1.UpdateSubscriptionResponse.subscription:type_name -> temporal.cloud.api.v1.Subscription
+	36, // 24: temporal.cloud.api.v1.GetUsageRequest.period_start:type_name -> google.protobuf.Timestamp
+	36, // 25: temporal.cloud.api.v1.GetUsageRequest.period_end:type_name -> google.protobuf.Timestamp
+	7,  // 26: temporal.cloud.api.v1.GetUsageResponse.usage:type_name -> temporal.cloud.api.v1.UsageSummary
+	36, // 27: temporal.cloud.api.v1.GetUsageByNamespaceRequest.period_start:type_name -> google.protobuf.Timestamp
+	36, // 28: temporal.cloud.api.v1.GetUsageByNamespaceRequest.period_end:type_name -> google.protobuf.Timestamp
+	8,  // 29: temporal.cloud.api.v1.GetUsageByNamespaceResponse.usage:type_name -> temporal.cloud.api.v1.NamespaceUsage
+	10, // 30: temporal.cloud.api.v1.ListInvoicesResponse.invoices:type_name -> temporal.cloud.api.v1.Invoice
+	10, // 31: temporal.cloud.api.v1.GetInvoiceResponse.invoice:type_name -> temporal.cloud.api.v1.Invoice
+	12, // 32: temporal.cloud.api.v1.GetCreditBalanceResponse.balance:type_name -> temporal.cloud.api.v1.CreditBalance
+	12, // 33: temporal.cloud.api.v1.PurchaseCreditsResponse.balance:type_name -> temporal.cloud.api.v1.CreditBalance
+	5,  // 34: temporal.cloud.api.v1.ListPlansResponse.plans:type_name -> temporal.cloud.api.v1.Plan
+	0,  // 35: temporal.cloud.api.v1.ValidatePlanChangeRequest.plan:type_name -> temporal.cloud.api.v1.PlanTier
+	5,  // 36: temporal.cloud.api.v1.ValidatePlanChangeResponse.plan:type_name -> temporal.cloud.api.v1.Plan
+	6,  // 37: temporal.cloud.api.v1.ValidatePlanChangeResponse.violations:type_name -> temporal.cloud.api.v1.PlanViolation
+	14, // 38: temporal.cloud.api.v1.BillingService.GetSubscription:input_type -> temporal.cloud.api.v1.GetSubscriptionRequest
+	16, // 39: temporal.cloud.api.v1.BillingService.UpdateSubscription:input_type -> temporal.cloud.api.v1.UpdateSubscriptionRequest
+	18, // 40: temporal.cloud.api.v1.BillingService.GetUsage:input_type -> temporal.cloud.api.v1.GetUsageRequest
+	20, // 41: temporal.cloud.api.v1.BillingService.GetUsageByNamespace:input_type -> temporal.cloud.api.v1.GetUsageByNamespaceRequest
+	22, // 42: temporal.cloud.api.v1.BillingService.ListInvoices:input_type -> temporal.cloud.api.v1.ListInvoicesRequest
+	24, // 43: temporal.cloud.api.v1.BillingService.GetInvoice:input_type -> temporal.cloud.api.v1.GetInvoiceRequest
+	26, // 44: temporal.cloud.api.v1.BillingService.GetCreditBalance:input_type -> temporal.cloud.api.v1.GetCreditBalanceRequest
+	28, // 45: temporal.cloud.api.v1.BillingService.PurchaseCredits:input_type -> temporal.cloud.api.v1.PurchaseCreditsRequest
+	30, // 46: temporal.cloud.api.v1.BillingService.UpdatePaymentMethod:input_type -> temporal.cloud.api.v1.UpdatePaymentMethodRequest
+	32, // 47: temporal.cloud.api.v1.BillingService.ListPlans:input_type -> temporal.cloud.api.v1.ListPlansRequest
+	34, // 48: temporal.cloud.api.v1.BillingService.ValidatePlanChange:input_type -> temporal.cloud.api.v1.ValidatePlanChangeRequest
+	15, // 49: temporal.cloud.api.v1.BillingService.GetSubscription:output_type -> temporal.cloud.api.v1.GetSubscriptionResponse
+	17, // 50: temporal.cloud.api.v1.BillingService.UpdateSubscription:output_type -> temporal.cloud.api.v1.UpdateSubscriptionResponse
+	19, // 51: temporal.cloud.api.v1.BillingService.GetUsage:output_type -> temporal.cloud.api.v1.GetUsageResponse
+	21, // 52: temporal.cloud.api.v1.BillingService.GetUsageByNamespace:output_type -> temporal.cloud.api.v1.GetUsageByNamespaceResponse
+	23, // 53: temporal.cloud.api.v1.BillingService.ListInvoices:output_type -> temporal.cloud.api.v1.ListInvoicesResponse
+	25, // 54: temporal.cloud.api.v1.BillingService.GetInvoice:output_type -> temporal.cloud.api.v1.GetInvoiceResponse
+	27, // 55: temporal.cloud.api.v1.BillingService.GetCreditBalance:output_type -> temporal.cloud.api.v1.GetCreditBalanceResponse
+	29, // 56: temporal.cloud.api.v1.BillingService.PurchaseCredits:output_type -> temporal.cloud.api.v1.PurchaseCreditsResponse
+	31, // 57: temporal.cloud.api.v1.BillingService.UpdatePaymentMethod:output_type -> temporal.cloud.api.v1.UpdatePaymentMethodResponse
+	33, // 58: temporal.cloud.api.v1.BillingService.ListPlans:output_type -> temporal.cloud.api.v1.ListPlansResponse
+	35, // 59: temporal.cloud.api.v1.BillingService.ValidatePlanChange:output_type -> temporal.cloud.api.v1.ValidatePlanChangeResponse
+	49, // [49:60] is the sub-list for method output_type
+	38, // [38:49] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_cloud_v1_billing_proto_init() }
+func file_cloud_v1_billing_proto_init() {
+	if File_cloud_v1_billing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cloud_v1_billing_proto_rawDesc), len(file_cloud_v1_billing_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cloud_v1_billing_proto_goTypes,
+		DependencyIndexes: file_cloud_v1_billing_proto_depIdxs,
+		EnumInfos:         file_cloud_v1_billing_proto_enumTypes,
+		MessageInfos:      file_cloud_v1_billing_proto_msgTypes,
+	}.Build()
+	File_cloud_v1_billing_proto = out.File
+	file_cloud_v1_billing_proto_goTypes = nil
+	file_cloud_v1_billing_proto_depIdxs = nil
+}
@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cloud/v1/audit.proto
+
+package cloudv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.temporal.io/cloud/api/cloud/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "temporal.cloud.api.v1.AuditService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuditServiceListAuditEventsProcedure is the fully-qualified name of the AuditService's
+	// ListAuditEvents RPC.
+	AuditServiceListAuditEventsProcedure = "/temporal.cloud.api.v1.AuditService/ListAuditEvents"
+	// AuditServiceGetAuditEventProcedure is the fully-qualified name of the AuditService's
+	// GetAuditEvent RPC.
+	AuditServiceGetAuditEventProcedure = "/temporal.cloud.api.v1.AuditService/GetAuditEvent"
+	// AuditServiceExportAuditEventsProcedure is the fully-qualified name of the AuditService's
+	// ExportAuditEvents RPC.
+	AuditServiceExportAuditEventsProcedure = "/temporal.cloud.api.v1.AuditService/ExportAuditEvents"
+	// AuditServiceGetAuditChainKeyProcedure is the fully-qualified name of the AuditService's
+	// GetAuditChainKey RPC.
+	AuditServiceGetAuditChainKeyProcedure = "/temporal.cloud.api.v1.AuditService/GetAuditChainKey"
+	// AuditServiceCreateAuditStreamProcedure is the fully-qualified name of the AuditService's
+	// CreateAuditStream RPC.
+	AuditServiceCreateAuditStreamProcedure = "/temporal.cloud.api.v1.AuditService/CreateAuditStream"
+	// AuditServiceGetAuditStreamProcedure is the fully-qualified name of the AuditService's
+	// GetAuditStream RPC.
+	AuditServiceGetAuditStreamProcedure = "/temporal.cloud.api.v1.AuditService/GetAuditStream"
+	// AuditServiceListAuditStreamsProcedure is the fully-qualified name of the AuditService's
+	// ListAuditStreams RPC.
+	AuditServiceListAuditStreamsProcedure = "/temporal.cloud.api.v1.AuditService/ListAuditStreams"
+	// AuditServiceUpdateAuditStreamProcedure is the fully-qualified name of the AuditService's
+	// UpdateAuditStream RPC.
+	AuditServiceUpdateAuditStreamProcedure = "/temporal.cloud.api.v1.AuditService/UpdateAuditStream"
+	// AuditServiceDeleteAuditStreamProcedure is the fully-qualified name of the AuditService's
+	// DeleteAuditStream RPC.
+	AuditServiceDeleteAuditStreamProcedure = "/temporal.cloud.api.v1.AuditService/DeleteAuditStream"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	auditServiceServiceDescriptor                 = v1.File_cloud_v1_audit_proto.Services().ByName("AuditService")
+	auditServiceListAuditEventsMethodDescriptor   = auditServiceServiceDescriptor.Methods().ByName("ListAuditEvents")
+	auditServiceGetAuditEventMethodDescriptor     = auditServiceServiceDescriptor.Methods().ByName("GetAuditEvent")
+	auditServiceExportAuditEventsMethodDescriptor = auditServiceServiceDescriptor.Methods().ByName("ExportAuditEvents")
+	auditServiceGetAuditChainKeyMethodDescriptor  = auditServiceServiceDescriptor.Methods().ByName("GetAuditChainKey")
+	auditServiceCreateAuditStreamMethodDescriptor = auditServiceServiceDescriptor.Methods().ByName("CreateAuditStream")
+	auditServiceGetAuditStreamMethodDescriptor    = auditServiceServiceDescriptor.Methods().ByName("GetAuditStream")
+	auditServiceListAuditStreamsMethodDescriptor  = auditServiceServiceDescriptor.Methods().ByName("ListAuditStreams")
+	auditServiceUpdateAuditStreamMethodDescriptor = auditServiceServiceDescriptor.Methods().ByName("UpdateAuditStream")
+	auditServiceDeleteAuditStreamMethodDescriptor = auditServiceServiceDescriptor.Methods().ByName("DeleteAuditStream")
+)
+
+// AuditServiceClient is a client for the temporal.cloud.api.v1.AuditService service.
+type AuditServiceClient interface {
+	// ListAuditEvents lists audit events for an organization.
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// GetAuditEvent retrieves a specific audit event.
+	GetAuditEvent(context.Context, *connect.Request[v1.GetAuditEventRequest]) (*connect.Response[v1.GetAuditEventResponse], error)
+	// ExportAuditEvents exports a batch of audit events, oldest first, in a
+	// SIEM format. Successive batches of an export are linked by a hash chain.
+	ExportAuditEvents(context.Context, *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error)
+	// GetAuditChainKey retrieves the key an organization's export hash chains
+	// are computed with, to verify them.
+	GetAuditChainKey(context.Context, *connect.Request[v1.GetAuditChainKeyRequest]) (*connect.Response[v1.GetAuditChainKeyResponse], error)
+	// CreateAuditStream creates a stream delivering an organization's audit
+	// events to a destination as they are recorded.
+	CreateAuditStream(context.Context, *connect.Request[v1.CreateAuditStreamRequest]) (*connect.Response[v1.CreateAuditStreamResponse], error)
+	// GetAuditStream retrieves an audit stream.
+	GetAuditStream(context.Context, *connect.Request[v1.GetAuditStreamRequest]) (*connect.Response[v1.GetAuditStreamResponse], error)
+	// ListAuditStreams lists the audit streams of an organization.
+	ListAuditStreams(context.Context, *connect.Request[v1.ListAuditStreamsRequest]) (*connect.Response[v1.ListAuditStreamsResponse], error)
+	// UpdateAuditStream updates an audit stream.
+	UpdateAuditStream(context.Context, *connect.Request[v1.UpdateAuditStreamRequest]) (*connect.Response[v1.UpdateAuditStreamResponse], error)
+	// DeleteAuditStream deletes an audit stream.
+	DeleteAuditStream(context.Context, *connect.Request[v1.DeleteAuditStreamRequest]) (*connect.Response[v1.DeleteAuditStreamResponse], error)
+}
+
+// NewAuditServiceClient constructs a client for the temporal.cloud.api.v1.AuditService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &auditServiceClient{
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AuditServiceListAuditEventsProcedure,
+			connect.WithSchema(auditServiceListAuditEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAuditEvent: connect.NewClient[v1.GetAuditEventRequest, v1.GetAuditEventResponse](
+			httpClient,
+			baseURL+AuditServiceGetAuditEventProcedure,
+			connect.WithSchema(auditServiceGetAuditEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportAuditEvents: connect.NewClient[v1.ExportAuditEventsRequest, v1.ExportAuditEventsResponse](
+			httpClient,
+			baseURL+AuditServiceExportAuditEventsProcedure,
+			connect.WithSchema(auditServiceExportAuditEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAuditChainKey: connect.NewClient[v1.GetAuditChainKeyRequest, v1.GetAuditChainKeyResponse](
+			httpClient,
+			baseURL+AuditServiceGetAuditChainKeyProcedure,
+			connect.WithSchema(auditServiceGetAuditChainKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createAuditStream: connect.NewClient[v1.CreateAuditStreamRequest, v1.CreateAuditStreamResponse](
+			httpClient,
+			baseURL+AuditServiceCreateAuditStreamProcedure,
+			connect.WithSchema(auditServiceCreateAuditStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAuditStream: connect.NewClient[v1.GetAuditStreamRequest, v1.GetAuditStreamResponse](
+			httpClient,
+			baseURL+AuditServiceGetAuditStreamProcedure,
+			connect.WithSchema(auditServiceGetAuditStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditStreams: connect.NewClient[v1.ListAuditStreamsRequest, v1.ListAuditStreamsResponse](
+			httpClient,
+			baseURL+AuditServiceListAuditStreamsProcedure,
+			connect.WithSchema(auditServiceListAuditStreamsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateAuditStream: connect.NewClient[v1.UpdateAuditStreamRequest, v1.UpdateAuditStreamResponse](
+			httpClient,
+			baseURL+AuditServiceUpdateAuditStreamProcedure,
+			connect.WithSchema(auditServiceUpdateAuditStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAuditStream: connect.NewClient[v1.DeleteAuditStreamRequest, v1.DeleteAuditStreamResponse](
+			httpClient,
+			baseURL+AuditServiceDeleteAuditStreamProcedure,
+			connect.WithSchema(auditServiceDeleteAuditStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	listAuditEvents   *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+	getAuditEvent     *connect.Client[v1.GetAuditEventRequest, v1.GetAuditEventResponse]
+	exportAuditEvents *connect.Client[v1.ExportAuditEventsRequest, v1.ExportAuditEventsResponse]
+	getAuditChainKey  *connect.Client[v1.GetAuditChainKeyRequest, v1.GetAuditChainKeyResponse]
+	createAuditStream *connect.Client[v1.CreateAuditStreamRequest, v1.CreateAuditStreamResponse]
+	getAuditStream    *connect.Client[v1.GetAuditStreamRequest, v1.GetAuditStreamResponse]
+	listAuditStreams  *connect.Client[v1.ListAuditStreamsRequest, v1.ListAuditStreamsResponse]
+	updateAuditStream *connect.Client[v1.UpdateAuditStreamRequest, v1.UpdateAuditStreamResponse]
+	deleteAuditStream *connect.Client[v1.DeleteAuditStreamRequest, v1.DeleteAuditStreamResponse]
+}
+
+// ListAuditEvents calls temporal.cloud.api.v1.AuditService.ListAuditEvents.
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// GetAuditEvent calls temporal.cloud.api.v1.AuditService.GetAuditEvent.
+func (c *auditServiceClient) GetAuditEvent(ctx context.Context, req *connect.Request[v1.GetAuditEventRequest]) (*connect.Response[v1.GetAuditEventResponse], error) {
+	return c.getAuditEvent.CallUnary(ctx, req)
+}
+
+// ExportAuditEvents calls temporal.cloud.api.v1.AuditService.ExportAuditEvents.
+func (c *auditServiceClient) ExportAuditEvents(ctx context.Context, req *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error) {
+	return c.exportAuditEvents.CallUnary(ctx, req)
+}
+
+// GetAuditChainKey calls temporal.cloud.api.v1.AuditService.GetAuditChainKey.
+func (c *auditServiceClient) GetAuditChainKey(ctx context.Context, req *connect.Request[v1.GetAuditChainKeyRequest]) (*connect.Response[v1.GetAuditChainKeyResponse], error) {
+	return c.getAuditChainKey.CallUnary(ctx, req)
+}
+
+// CreateAuditStream calls temporal.cloud.api.v1.AuditService.CreateAuditStream.
+func (c *auditServiceClient) CreateAuditStream(ctx context.Context, req *connect.Request[v1.CreateAuditStreamRequest]) (*connect.Response[v1.CreateAuditStreamResponse], error) {
+	return c.createAuditStream.CallUnary(ctx, req)
+}
+
+// GetAuditStream calls temporal.cloud.api.v1.AuditService.GetAuditStream.
+func (c *auditServiceClient) GetAuditStream(ctx context.Context, req *connect.Request[v1.GetAuditStreamRequest]) (*connect.Response[v1.GetAuditStreamResponse], error) {
+	return c.getAuditStream.CallUnary(ctx, req)
+}
+
+// ListAuditStreams calls temporal.cloud.api.v1.AuditService.ListAuditStreams.
+func (c *auditServiceClient) ListAuditStreams(ctx context.Context, req *connect.Request[v1.ListAuditStreamsRequest]) (*connect.Response[v1.ListAuditStreamsResponse], error) {
+	return c.listAuditStreams.CallUnary(ctx, req)
+}
+
+// UpdateAuditStream calls temporal.cloud.api.v1.AuditService.UpdateAuditStream.
+func (c *auditServiceClient) UpdateAuditStream(ctx context.Context, req *connect.Request[v1.UpdateAuditStreamRequest]) (*connect.Response[v1.UpdateAuditStreamResponse], error) {
+	return c.updateAuditStream.CallUnary(ctx, req)
+}
+
+// DeleteAuditStream calls temporal.cloud.api.v1.AuditService.DeleteAuditStream.
+func (c *auditServiceClient) DeleteAuditStream(ctx context.Context, req *connect.Request[v1.DeleteAuditStreamRequest]) (*connect.Response[v1.DeleteAuditStreamResponse], error) {
+	return c.deleteAuditStream.CallUnary(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the temporal.cloud.api.v1.AuditService service.
+type AuditServiceHandler interface {
+	// ListAuditEvents lists audit events for an organization.
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// GetAuditEvent retrieves a specific audit event.
+	GetAuditEvent(context.Context, *connect.Request[v1.GetAuditEventRequest]) (*connect.Response[v1.GetAuditEventResponse], error)
+	// ExportAuditEvents exports a batch of audit events, oldest first, in a
+	// SIEM format. Successive batches of an export are linked by a hash chain.
+	ExportAuditEvents(context.Context, *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error)
+	// GetAuditChainKey retrieves the key an organization's export hash chains
+	// are computed with, to verify them.
+	GetAuditChainKey(context.Context, *connect.Request[v1.GetAuditChainKeyRequest]) (*connect.Response[v1.GetAuditChainKeyResponse], error)
+	// CreateAuditStream creates a stream delivering an organization's audit
+	// events to a destination as they are recorded.
+	CreateAuditStream(context.Context, *connect.Request[v1.CreateAuditStreamRequest]) (*connect.Response[v1.CreateAuditStreamResponse], error)
+	// GetAuditStream retrieves an audit stream.
+	GetAuditStream(context.Context, *connect.Request[v1.GetAuditStreamRequest]) (*connect.Response[v1.GetAuditStreamResponse], error)
+	// ListAuditStreams lists the audit streams of an organization.
+	ListAuditStreams(context.Context, *connect.Request[v1.ListAuditStreamsRequest]) (*connect.Response[v1.ListAuditStreamsResponse], error)
+	// UpdateAuditStream updates an audit stream.
+	UpdateAuditStream(context.Context, *connect.Request[v1.UpdateAuditStreamRequest]) (*connect.Response[v1.UpdateAuditStreamResponse], error)
+	// DeleteAuditStream deletes an audit stream.
+	DeleteAuditStream(context.Context, *connect.Request[v1.DeleteAuditStreamRequest]) (*connect.Response[v1.DeleteAuditStreamResponse], error)
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		AuditServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(auditServiceListAuditEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceGetAuditEventHandler := connect.NewUnaryHandler(
+		AuditServiceGetAuditEventProcedure,
+		svc.GetAuditEvent,
+		connect.WithSchema(auditServiceGetAuditEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceExportAuditEventsHandler := connect.NewUnaryHandler(
+		AuditServiceExportAuditEventsProcedure,
+		svc.ExportAuditEvents,
+		connect.WithSchema(auditServiceExportAuditEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceGetAuditChainKeyHandler := connect.NewUnaryHandler(
+		AuditServiceGetAuditChainKeyProcedure,
+		svc.GetAuditChainKey,
+		connect.WithSchema(auditServiceGetAuditChainKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceCreateAuditStreamHandler := connect.NewUnaryHandler(
+		AuditServiceCreateAuditStreamProcedure,
+		svc.CreateAuditStream,
+		connect.WithSchema(auditServiceCreateAuditStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceGetAuditStreamHandler := connect.NewUnaryHandler(
+		AuditServiceGetAuditStreamProcedure,
+		svc.GetAuditStream,
+		connect.WithSchema(auditServiceGetAuditStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceListAuditStreamsHandler := connect.NewUnaryHandler(
+		AuditServiceListAuditStreamsProcedure,
+		svc.ListAuditStreams,
+		connect.WithSchema(auditServiceListAuditStreamsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceUpdateAuditStreamHandler := connect.NewUnaryHandler(
+		AuditServiceUpdateAuditStreamProcedure,
+		svc.UpdateAuditStream,
+		connect.WithSchema(auditServiceUpdateAuditStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceDeleteAuditStreamHandler := connect.NewUnaryHandler(
+		AuditServiceDeleteAuditStreamProcedure,
+		svc.DeleteAuditStream,
+		connect.WithSchema(auditServiceDeleteAuditStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/temporal.cloud.api.v1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceListAuditEventsProcedure:
+			auditServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case AuditServiceGetAuditEventProcedure:
+			auditServiceGetAuditEventHandler.ServeHTTP(w, r)
+		case AuditServiceExportAuditEventsProcedure:
+			auditServiceExportAuditEventsHandler.ServeHTTP(w, r)
+		case AuditServiceGetAuditChainKeyProcedure:
+			auditServiceGetAuditChainKeyHandler.ServeHTTP(w, r)
+		case AuditServiceCreateAuditStreamProcedure:
+			auditServiceCreateAuditStreamHandler.ServeHTTP(w, r)
+		case AuditServiceGetAuditStreamProcedure:
+			auditServiceGetAuditStreamHandler.ServeHTTP(w, r)
+		case AuditServiceListAuditStreamsProcedure:
+			auditServiceListAuditStreamsHandler.ServeHTTP(w, r)
+		case AuditServiceUpdateAuditStreamProcedure:
+			auditServiceUpdateAuditStreamHandler.ServeHTTP(w, r)
+		case AuditServiceDeleteAuditStreamProcedure:
+			auditServiceDeleteAuditStreamHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) GetAuditEvent(context.Context, *connect.Request[v1.GetAuditEventRequest]) (*connect.Response[v1.GetAuditEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.GetAuditEvent is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) ExportAuditEvents(context.Context, *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.ExportAuditEvents is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) GetAuditChainKey(context.Context, *connect.Request[v1.GetAuditChainKeyRequest]) (*connect.Response[v1.GetAuditChainKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.GetAuditChainKey is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) CreateAuditStream(context.Context, *connect.Request[v1.CreateAuditStreamRequest]) (*connect.Response[v1.CreateAuditStreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.CreateAuditStream is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) GetAuditStream(context.Context, *connect.Request[v1.GetAuditStreamRequest]) (*connect.Response[v1.GetAuditStreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.GetAuditStream is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) ListAuditStreams(context.Context, *connect.Request[v1.ListAuditStreamsRequest]) (*connect.Response[v1.ListAuditStreamsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.ListAuditStreams is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) UpdateAuditStream(context.Context, *connect.Request[v1.UpdateAuditStreamRequest]) (*connect.Response[v1.UpdateAuditStreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.UpdateAuditStream is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) DeleteAuditStream(context.Context, *connect.Request[v1.DeleteAuditStreamRequest]) (*connect.Response[v1.DeleteAuditStreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.AuditService.DeleteAuditStream is not implemented"))
+}
@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cloud/v1/billing.proto
+
+package cloudv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.temporal.io/cloud/api/cloud/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BillingServiceName is the fully-qualified name of the BillingService service.
+	BillingServiceName = "temporal.cloud.api.v1.BillingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BillingServiceGetSubscriptionProcedure is the fully-qualified name of the BillingService's
+	// GetSubscription RPC.
+	BillingServiceGetSubscriptionProcedure = "/temporal.cloud.api.v1.BillingService/GetSubscription"
+	// BillingServiceUpdateSubscriptionProcedure is the fully-qualified name of the BillingService's
+	// UpdateSubscription RPC.
+	BillingServiceUpdateSubscriptionProcedure = "/temporal.cloud.api.v1.BillingService/UpdateSubscription"
+	// BillingServiceGetUsageProcedure is the fully-qualified name of the BillingService's GetUsage RPC.
+	BillingServiceGetUsageProcedure = "/temporal.cloud.api.v1.BillingService/GetUsage"
+	// BillingServiceGetUsageByNamespaceProcedure is the fully-qualified name of the BillingService's
+	// GetUsageByNamespace RPC.
+	BillingServiceGetUsageByNamespaceProcedure = "/temporal.cloud.api.v1.BillingService/GetUsageByNamespace"
+	// BillingServiceListInvoicesProcedure is the fully-qualified name of the BillingService's
+	// ListInvoices RPC.
+	BillingServiceListInvoicesProcedure = "/temporal.cloud.api.v1.BillingService/ListInvoices"
+	// BillingServiceGetInvoiceProcedure is the fully-qualified name of the BillingService's GetInvoice
+	// RPC.
+	BillingServiceGetInvoiceProcedure = "/temporal.cloud.api.v1.BillingService/GetInvoice"
+	// BillingServiceGetCreditBalanceProcedure is the fully-qualified name of the BillingService's
+	// GetCreditBalance RPC.
+	BillingServiceGetCreditBalanceProcedure = "/temporal.cloud.api.v1.BillingService/GetCreditBalance"
+	// BillingServicePurchaseCreditsProcedure is the fully-qualified name of the BillingService's
+	// PurchaseCredits RPC.
+	BillingServicePurchaseCreditsProcedure = "/temporal.cloud.api.v1.BillingService/PurchaseCredits"
+	// BillingServiceUpdatePaymentMethodProcedure is the fully-qualified name of the BillingService's
+	// UpdatePaymentMethod RPC.
+	BillingServiceUpdatePaymentMethodProcedure = "/temporal.cloud.api.v1.BillingService/UpdatePaymentMethod"
+	// BillingServiceListPlansProcedure is the fully-qualified name of the BillingService's ListPlans
+	// RPC.
+	BillingServiceListPlansProcedure = "/temporal.cloud.api.v1.BillingService/ListPlans"
+	// BillingServiceValidatePlanChangeProcedure is the fully-qualified name of the BillingService's
+	// ValidatePlanChange RPC.
+	BillingServiceValidatePlanChangeProcedure = "/temporal.cloud.api.v1.BillingService/ValidatePlanChange"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	billingServiceServiceDescriptor                   = v1.File_cloud_v1_billing_proto.Services().ByName("BillingService")
+	billingServiceGetSubscriptionMethodDescriptor     = billingServiceServiceDescriptor.Methods().ByName("GetSubscription")
+	billingServiceUpdateSubscriptionMethodDescriptor  = billingServiceServiceDescriptor.Methods().ByName("UpdateSubscription")
+	billingServiceGetUsageMethodDescriptor            = billingServiceServiceDescriptor.Methods().ByName("GetUsage")
+	billingServiceGetUsageByNamespaceMethodDescriptor = billingServiceServiceDescriptor.Methods().ByName("GetUsageByNamespace")
+	billingServiceListInvoicesMethodDescriptor        = billingServiceServiceDescriptor.Methods().ByName("ListInvoices")
+	billingServiceGetInvoiceMethodDescriptor          = billingServiceServiceDescriptor.Methods().ByName("GetInvoice")
+	billingServiceGetCreditBalanceMethodDescriptor    = billingServiceServiceDescriptor.Methods().ByName("GetCreditBalance")
+	billingServicePurchaseCreditsMethodDescriptor     = billingServiceServiceDescriptor.Methods().ByName("PurchaseCredits")
+	billingServiceUpdatePaymentMethodMethodDescriptor = billingServiceServiceDescriptor.Methods().ByName("UpdatePaymentMethod")
+	billingServiceListPlansMethodDescriptor           = billingServiceServiceDescriptor.Methods().ByName("ListPlans")
+	billingServiceValidatePlanChangeMethodDescriptor  = billingServiceServiceDescriptor.Methods().ByName("ValidatePlanChange")
+)
+
+// BillingServiceClient is a client for the temporal.cloud.api.v1.BillingService service.
+type BillingServiceClient interface {
+	// GetSubscription retrieves the subscription for an organization.
+	GetSubscription(context.Context, *connect.Request[v1.GetSubscriptionRequest]) (*connect.Response[v1.GetSubscriptionResponse], error)
+	// UpdateSubscription updates the subscription plan.
+	UpdateSubscription(context.Context, *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.UpdateSubscriptionResponse], error)
+	// GetUsage retrieves usage data for an organization.
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// GetUsageByNamespace retrieves usage data for a specific namespace.
+	GetUsageByNamespace(context.Context, *connect.Request[v1.GetUsageByNamespaceRequest]) (*connect.Response[v1.GetUsageByNamespaceResponse], error)
+	// ListInvoices lists invoices for an organization.
+	ListInvoices(context.Context, *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error)
+	// GetInvoice retrieves a specific invoice.
+	GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error)
+	// GetCreditBalance retrieves the credit balance for an organization.
+	GetCreditBalance(context.Context, *connect.Request[v1.GetCreditBalanceRequest]) (*connect.Response[v1.GetCreditBalanceResponse], error)
+	// PurchaseCredits purchases credits for an organization.
+	PurchaseCredits(context.Context, *connect.Request[v1.PurchaseCreditsRequest]) (*connect.Response[v1.PurchaseCreditsResponse], error)
+	// UpdatePaymentMethod updates the payment method for an organization.
+	UpdatePaymentMethod(context.Context, *connect.Request[v1.UpdatePaymentMethodRequest]) (*connect.Response[v1.UpdatePaymentMethodResponse], error)
+	// ListPlans lists the current version of every plan.
+	ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error)
+	// ValidatePlanChange lists the resources and usage of an organization that
+	// a plan does not allow. UpdateSubscription fails unless there are none.
+	ValidatePlanChange(context.Context, *connect.Request[v1.ValidatePlanChangeRequest]) (*connect.Response[v1.ValidatePlanChangeResponse], error)
+}
+
+// NewBillingServiceClient constructs a client for the temporal.cloud.api.v1.BillingService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBillingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BillingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &billingServiceClient{
+		getSubscription: connect.NewClient[v1.GetSubscriptionRequest, v1.GetSubscriptionResponse](
+			httpClient,
+			baseURL+BillingServiceGetSubscriptionProcedure,
+			connect.WithSchema(billingServiceGetSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateSubscription: connect.NewClient[v1.UpdateSubscriptionRequest, v1.UpdateSubscriptionResponse](
+			httpClient,
+			baseURL+BillingServiceUpdateSubscriptionProcedure,
+			connect.WithSchema(billingServiceUpdateSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[v1.GetUsageRequest, v1.GetUsageResponse](
+			httpClient,
+			baseURL+BillingServiceGetUsageProcedure,
+			connect.WithSchema(billingServiceGetUsageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getUsageByNamespace: connect.NewClient[v1.GetUsageByNamespaceRequest, v1.GetUsageByNamespaceResponse](
+			httpClient,
+			baseURL+BillingServiceGetUsageByNamespaceProcedure,
+			connect.WithSchema(billingServiceGetUsageByNamespaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listInvoices: connect.NewClient[v1.ListInvoicesRequest, v1.ListInvoicesResponse](
+			httpClient,
+			baseURL+BillingServiceListInvoicesProcedure,
+			connect.WithSchema(billingServiceListInvoicesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getInvoice: connect.NewClient[v1.GetInvoiceRequest, v1.GetInvoiceResponse](
+			httpClient,
+			baseURL+BillingServiceGetInvoiceProcedure,
+			connect.WithSchema(billingServiceGetInvoiceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getCreditBalance: connect.NewClient[v1.GetCreditBalanceRequest, v1.GetCreditBalanceResponse](
+			httpClient,
+			baseURL+BillingServiceGetCreditBalanceProcedure,
+			connect.WithSchema(billingServiceGetCreditBalanceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		purchaseCredits: connect.NewClient[v1.PurchaseCreditsRequest, v1.PurchaseCreditsResponse](
+			httpClient,
+			baseURL+BillingServicePurchaseCreditsProcedure,
+			connect.WithSchema(billingServicePurchaseCreditsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updatePaymentMethod: connect.NewClient[v1.UpdatePaymentMethodRequest, v1.UpdatePaymentMethodResponse](
+			httpClient,
+			baseURL+BillingServiceUpdatePaymentMethodProcedure,
+			connect.WithSchema(billingServiceUpdatePaymentMethodMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listPlans: connect.NewClient[v1.ListPlansRequest, v1.ListPlansResponse](
+			httpClient,
+			baseURL+BillingServiceListPlansProcedure,
+			connect.WithSchema(billingServiceListPlansMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		validatePlanChange: connect.NewClient[v1.ValidatePlanChangeRequest, v1.ValidatePlanChangeResponse](
+			httpClient,
+			baseURL+BillingServiceValidatePlanChangeProcedure,
+			connect.WithSchema(billingServiceValidatePlanChangeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// billingServiceClient implements BillingServiceClient.
+type billingServiceClient struct {
+	getSubscription     *connect.Client[v1.GetSubscriptionRequest, v1.GetSubscriptionResponse]
+	updateSubscription  *connect.Client[v1.UpdateSubscriptionRequest, v1.UpdateSubscriptionResponse]
+	getUsage            *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
+	getUsageByNamespace *connect.Client[v1.GetUsageByNamespaceRequest, v1.GetUsageByNamespaceResponse]
+	listInvoices        *connect.Client[v1.ListInvoicesRequest, v1.ListInvoicesResponse]
+	getInvoice          *connect.Client[v1.GetInvoiceRequest, v1.GetInvoiceResponse]
+	getCreditBalance    *connect.Client[v1.GetCreditBalanceRequest, v1.GetCreditBalanceResponse]
+	purchaseCredits     *connect.Client[v1.PurchaseCreditsRequest, v1.PurchaseCreditsResponse]
+	updatePaymentMethod *connect.Client[v1.UpdatePaymentMethodRequest, v1.UpdatePaymentMethodResponse]
+	listPlans           *connect.Client[v1.ListPlansRequest, v1.ListPlansResponse]
+	validatePlanChange  *connect.Client[v1.ValidatePlanChangeRequest, v1.ValidatePlanChangeResponse]
+}
+
+// GetSubscription calls temporal.cloud.api.v1.BillingService.GetSubscription.
+func (c *billingServiceClient) GetSubscription(ctx context.Context, req *connect.Request[v1.GetSubscriptionRequest]) (*connect.Response[v1.GetSubscriptionResponse], error) {
+	return c.getSubscription.CallUnary(ctx, req)
+}
+
+// UpdateSubscription calls temporal.cloud.api.v1.BillingService.UpdateSubscription.
+func (c *billingServiceClient) UpdateSubscription(ctx context.Context, req *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.UpdateSubscriptionResponse], error) {
+	return c.updateSubscription.CallUnary(ctx, req)
+}
+
+// GetUsage calls temporal.cloud.api.v1.BillingService.GetUsage.
+func (c *billingServiceClient) GetUsage(ctx context.Context, req *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// GetUsageByNamespace calls temporal.cloud.api.v1.BillingService.GetUsageByNamespace.
+func (c *billingServiceClient) GetUsageByNamespace(ctx context.Context, req *connect.Request[v1.GetUsageByNamespaceRequest]) (*connect.Response[v1.GetUsageByNamespaceResponse], error) {
+	return c.getUsageByNamespace.CallUnary(ctx, req)
+}
+
+// ListInvoices calls temporal.cloud.api.v1.BillingService.ListInvoices.
+func (c *billingServiceClient) ListInvoices(ctx context.Context, req *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error) {
+	return c.listInvoices.CallUnary(ctx, req)
+}
+
+// GetInvoice calls temporal.cloud.api.v1.BillingService.GetInvoice.
+func (c *billingServiceClient) GetInvoice(ctx context.Context, req *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error) {
+	return c.getInvoice.CallUnary(ctx, req)
+}
+
+// GetCreditBalance calls temporal.cloud.api.v1.BillingService.GetCreditBalance.
+func (c *billingServiceClient) GetCreditBalance(ctx context.Context, req *connect.Request[v1.GetCreditBalanceRequest]) (*connect.Response[v1.GetCreditBalanceResponse], error) {
+	return c.getCreditBalance.CallUnary(ctx, req)
+}
+
+// PurchaseCredits calls temporal.cloud.api.v1.BillingService.PurchaseCredits.
+func (c *billingServiceClient) PurchaseCredits(ctx context.Context, req *connect.Request[v1.PurchaseCreditsRequest]) (*connect.Response[v1.PurchaseCreditsResponse], error) {
+	return c.purchaseCredits.CallUnary(ctx, req)
+}
+
+// UpdatePaymentMethod calls temporal.cloud.api.v1.BillingService.UpdatePaymentMethod.
+func (c *billingServiceClient) UpdatePaymentMethod(ctx context.Context, req *connect.Request[v1.UpdatePaymentMethodRequest]) (*connect.Response[v1.UpdatePaymentMethodResponse], error) {
+	return c.updatePaymentMethod.CallUnary(ctx, req)
+}
+
+// ListPlans calls temporal.cloud.api.v1.BillingService.ListPlans.
+func (c *billingServiceClient) ListPlans(ctx context.Context, req *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error) {
+	return c.listPlans.CallUnary(ctx, req)
+}
+
+// ValidatePlanChange calls temporal.cloud.api.v1.BillingService.ValidatePlanChange.
+func (c *billingServiceClient) ValidatePlanChange(ctx context.Context, req *connect.Request[v1.ValidatePlanChangeRequest]) (*connect.Response[v1.ValidatePlanChangeResponse], error) {
+	return c.validatePlanChange.CallUnary(ctx, req)
+}
+
+// BillingServiceHandler is an implementation of the temporal.cloud.api.v1.BillingService service.
+type BillingServiceHandler interface {
+	// GetSubscription retrieves the subscription for an organization.
+	GetSubscription(context.Context, *connect.Request[v1.GetSubscriptionRequest]) (*connect.Response[v1.GetSubscriptionResponse], error)
+	// UpdateSubscription updates the subscription plan.
+	UpdateSubscription(context.Context, *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.UpdateSubscriptionResponse], error)
+	// GetUsage retrieves usage data for an organization.
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// GetUsageByNamespace retrieves usage data for a specific namespace.
+	GetUsageByNamespace(context.Context, *connect.Request[v1.GetUsageByNamespaceRequest]) (*connect.Response[v1.GetUsageByNamespaceResponse], error)
+	// ListInvoices lists invoices for an organization.
+	ListInvoices(context.Context, *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error)
+	// GetInvoice retrieves a specific invoice.
+	GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error)
+	// GetCreditBalance retrieves the credit balance for an organization.
+	GetCreditBalance(context.Context, *connect.Request[v1.GetCreditBalanceRequest]) (*connect.Response[v1.GetCreditBalanceResponse], error)
+	// PurchaseCredits purchases credits for an organization.
+	PurchaseCredits(context.Context, *connect.Request[v1.PurchaseCreditsRequest]) (*connect.Response[v1.PurchaseCreditsResponse], error)
+	// UpdatePaymentMethod updates the payment method for an organization.
+	UpdatePaymentMethod(context.Context, *connect.Request[v1.UpdatePaymentMethodRequest]) (*connect.Response[v1.UpdatePaymentMethodResponse], error)
+	// ListPlans lists the current version of every plan.
+	ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error)
+	// ValidatePlanChange lists the resources and usage of an organization that
+	// a plan does not allow. UpdateSubscription fails unless there are none.
+	ValidatePlanChange(context.Context, *connect.Request[v1.ValidatePlanChangeRequest]) (*connect.Response[v1.ValidatePlanChangeResponse], error)
+}
+
+// NewBillingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBillingServiceHandler(svc BillingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	billingServiceGetSubscriptionHandler := connect.NewUnaryHandler(
+		BillingServiceGetSubscriptionProcedure,
+		svc.GetSubscription,
+		connect.WithSchema(billingServiceGetSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceUpdateSubscriptionHandler := connect.NewUnaryHandler(
+		BillingServiceUpdateSubscriptionProcedure,
+		svc.UpdateSubscription,
+		connect.WithSchema(billingServiceUpdateSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetUsageHandler := connect.NewUnaryHandler(
+		BillingServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(billingServiceGetUsageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetUsageByNamespaceHandler := connect.NewUnaryHandler(
+		BillingServiceGetUsageByNamespaceProcedure,
+		svc.GetUsageByNamespace,
+		connect.WithSchema(billingServiceGetUsageByNamespaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceListInvoicesHandler := connect.NewUnaryHandler(
+		BillingServiceListInvoicesProcedure,
+		svc.ListInvoices,
+		connect.WithSchema(billingServiceListInvoicesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetInvoiceHandler := connect.NewUnaryHandler(
+		BillingServiceGetInvoiceProcedure,
+		svc.GetInvoice,
+		connect.WithSchema(billingServiceGetInvoiceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetCreditBalanceHandler := connect.NewUnaryHandler(
+		BillingServiceGetCreditBalanceProcedure,
+		svc.GetCreditBalance,
+		connect.WithSchema(billingServiceGetCreditBalanceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServicePurchaseCreditsHandler := connect.NewUnaryHandler(
+		BillingServicePurchaseCreditsProcedure,
+		svc.PurchaseCredits,
+		connect.WithSchema(billingServicePurchaseCreditsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceUpdatePaymentMethodHandler := connect.NewUnaryHandler(
+		BillingServiceUpdatePaymentMethodProcedure,
+		svc.UpdatePaymentMethod,
+		connect.WithSchema(billingServiceUpdatePaymentMethodMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceListPlansHandler := connect.NewUnaryHandler(
+		BillingServiceListPlansProcedure,
+		svc.ListPlans,
+		connect.WithSchema(billingServiceListPlansMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceValidatePlanChangeHandler := connect.NewUnaryHandler(
+		BillingServiceValidatePlanChangeProcedure,
+		svc.ValidatePlanChange,
+		connect.WithSchema(billingServiceValidatePlanChangeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/temporal.cloud.api.v1.BillingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BillingServiceGetSubscriptionProcedure:
+			billingServiceGetSubscriptionHandler.ServeHTTP(w, r)
+		case BillingServiceUpdateSubscriptionProcedure:
+			billingServiceUpdateSubscriptionHandler.ServeHTTP(w, r)
+		case BillingServiceGetUsageProcedure:
+			billingServiceGetUsageHandler.ServeHTTP(w, r)
+		case BillingServiceGetUsageByNamespaceProcedure:
+			billingServiceGetUsageByNamespaceHandler.ServeHTTP(w, r)
+		case BillingServiceListInvoicesProcedure:
+			billingServiceListInvoicesHandler.ServeHTTP(w, r)
+		case BillingServiceGetInvoiceProcedure:
+			billingServiceGetInvoiceHandler.ServeHTTP(w, r)
+		case BillingServiceGetCreditBalanceProcedure:
+			billingServiceGetCreditBalanceHandler.ServeHTTP(w, r)
+		case BillingServicePurchaseCreditsProcedure:
+			billingServicePurchaseCreditsHandler.ServeHTTP(w, r)
+		case BillingServiceUpdatePaymentMethodProcedure:
+			billingServiceUpdatePaymentMethodHandler.ServeHTTP(w, r)
+		case BillingServiceListPlansProcedure:
+			billingServiceListPlansHandler.ServeHTTP(w, r)
+		case BillingServiceValidatePlanChangeProcedure:
+			billingServiceValidatePlanChangeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBillingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBillingServiceHandler struct{}
+
+func (UnimplementedBillingServiceHandler) GetSubscription(context.Context, *connect.Request[v1.GetSubscriptionRequest]) (*connect.Response[v1.GetSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.GetSubscription is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) UpdateSubscription(context.Context, *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.UpdateSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.UpdateSubscription is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.GetUsage is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetUsageByNamespace(context.Context, *connect.Request[v1.GetUsageByNamespaceRequest]) (*connect.Response[v1.GetUsageByNamespaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.GetUsageByNamespace is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) ListInvoices(context.Context, *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.ListInvoices is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.GetInvoice is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetCreditBalance(context.Context, *connect.Request[v1.GetCreditBalanceRequest]) (*connect.Response[v1.GetCreditBalanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.GetCreditBalance is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) PurchaseCredits(context.Context, *connect.Request[v1.PurchaseCreditsRequest]) (*connect.Response[v1.PurchaseCreditsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.PurchaseCredits is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) UpdatePaymentMethod(context.Context, *connect.Request[v1.UpdatePaymentMethodRequest]) (*connect.Response[v1.UpdatePaymentMethodResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.UpdatePaymentMethod is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.ListPlans is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) ValidatePlanChange(context.Context, *connect.Request[v1.ValidatePlanChangeRequest]) (*connect.Response[v1.ValidatePlanChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.BillingService.ValidatePlanChange is not implemented"))
+}
@@ -0,0 +1,765 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cloud/v1/identity.proto
+
+package cloudv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.temporal.io/cloud/api/cloud/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// IdentityServiceName is the fully-qualified name of the IdentityService service.
+	IdentityServiceName = "temporal.cloud.api.v1.IdentityService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// IdentityServiceCreateAPIKeyProcedure is the fully-qualified name of the IdentityService's
+	// CreateAPIKey RPC.
+	IdentityServiceCreateAPIKeyProcedure = "/temporal.cloud.api.v1.IdentityService/CreateAPIKey"
+	// IdentityServiceGetAPIKeyProcedure is the fully-qualified name of the IdentityService's GetAPIKey
+	// RPC.
+	IdentityServiceGetAPIKeyProcedure = "/temporal.cloud.api.v1.IdentityService/GetAPIKey"
+	// IdentityServiceListAPIKeysProcedure is the fully-qualified name of the IdentityService's
+	// ListAPIKeys RPC.
+	IdentityServiceListAPIKeysProcedure = "/temporal.cloud.api.v1.IdentityService/ListAPIKeys"
+	// IdentityServiceRevokeAPIKeyProcedure is the fully-qualified name of the IdentityService's
+	// RevokeAPIKey RPC.
+	IdentityServiceRevokeAPIKeyProcedure = "/temporal.cloud.api.v1.IdentityService/RevokeAPIKey"
+	// IdentityServiceRotateAPIKeyProcedure is the fully-qualified name of the IdentityService's
+	// RotateAPIKey RPC.
+	IdentityServiceRotateAPIKeyProcedure = "/temporal.cloud.api.v1.IdentityService/RotateAPIKey"
+	// IdentityServiceCreateServiceAccountProcedure is the fully-qualified name of the IdentityService's
+	// CreateServiceAccount RPC.
+	IdentityServiceCreateServiceAccountProcedure = "/temporal.cloud.api.v1.IdentityService/CreateServiceAccount"
+	// IdentityServiceGetServiceAccountProcedure is the fully-qualified name of the IdentityService's
+	// GetServiceAccount RPC.
+	IdentityServiceGetServiceAccountProcedure = "/temporal.cloud.api.v1.IdentityService/GetServiceAccount"
+	// IdentityServiceListServiceAccountsProcedure is the fully-qualified name of the IdentityService's
+	// ListServiceAccounts RPC.
+	IdentityServiceListServiceAccountsProcedure = "/temporal.cloud.api.v1.IdentityService/ListServiceAccounts"
+	// IdentityServiceUpdateServiceAccountProcedure is the fully-qualified name of the IdentityService's
+	// UpdateServiceAccount RPC.
+	IdentityServiceUpdateServiceAccountProcedure = "/temporal.cloud.api.v1.IdentityService/UpdateServiceAccount"
+	// IdentityServiceDeleteServiceAccountProcedure is the fully-qualified name of the IdentityService's
+	// DeleteServiceAccount RPC.
+	IdentityServiceDeleteServiceAccountProcedure = "/temporal.cloud.api.v1.IdentityService/DeleteServiceAccount"
+	// IdentityServiceGetUserProcedure is the fully-qualified name of the IdentityService's GetUser RPC.
+	IdentityServiceGetUserProcedure = "/temporal.cloud.api.v1.IdentityService/GetUser"
+	// IdentityServiceUpdateUserProcedure is the fully-qualified name of the IdentityService's
+	// UpdateUser RPC.
+	IdentityServiceUpdateUserProcedure = "/temporal.cloud.api.v1.IdentityService/UpdateUser"
+	// IdentityServiceInitiateSAMLLoginProcedure is the fully-qualified name of the IdentityService's
+	// InitiateSAMLLogin RPC.
+	IdentityServiceInitiateSAMLLoginProcedure = "/temporal.cloud.api.v1.IdentityService/InitiateSAMLLogin"
+	// IdentityServiceCompleteSAMLLoginProcedure is the fully-qualified name of the IdentityService's
+	// CompleteSAMLLogin RPC.
+	IdentityServiceCompleteSAMLLoginProcedure = "/temporal.cloud.api.v1.IdentityService/CompleteSAMLLogin"
+	// IdentityServiceRotateSCIMTokenProcedure is the fully-qualified name of the IdentityService's
+	// RotateSCIMToken RPC.
+	IdentityServiceRotateSCIMTokenProcedure = "/temporal.cloud.api.v1.IdentityService/RotateSCIMToken"
+	// IdentityServiceListUserGroupsProcedure is the fully-qualified name of the IdentityService's
+	// ListUserGroups RPC.
+	IdentityServiceListUserGroupsProcedure = "/temporal.cloud.api.v1.IdentityService/ListUserGroups"
+	// IdentityServiceSetUserGroupNamespacePermissionsProcedure is the fully-qualified name of the
+	// IdentityService's SetUserGroupNamespacePermissions RPC.
+	IdentityServiceSetUserGroupNamespacePermissionsProcedure = "/temporal.cloud.api.v1.IdentityService/SetUserGroupNamespacePermissions"
+	// IdentityServiceAddDomainProcedure is the fully-qualified name of the IdentityService's AddDomain
+	// RPC.
+	IdentityServiceAddDomainProcedure = "/temporal.cloud.api.v1.IdentityService/AddDomain"
+	// IdentityServiceVerifyDomainProcedure is the fully-qualified name of the IdentityService's
+	// VerifyDomain RPC.
+	IdentityServiceVerifyDomainProcedure = "/temporal.cloud.api.v1.IdentityService/VerifyDomain"
+	// IdentityServiceListDomainsProcedure is the fully-qualified name of the IdentityService's
+	// ListDomains RPC.
+	IdentityServiceListDomainsProcedure = "/temporal.cloud.api.v1.IdentityService/ListDomains"
+	// IdentityServiceRemoveDomainProcedure is the fully-qualified name of the IdentityService's
+	// RemoveDomain RPC.
+	IdentityServiceRemoveDomainProcedure = "/temporal.cloud.api.v1.IdentityService/RemoveDomain"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	identityServiceServiceDescriptor                                = v1.File_cloud_v1_identity_proto.Services().ByName("IdentityService")
+	identityServiceCreateAPIKeyMethodDescriptor                     = identityServiceServiceDescriptor.Methods().ByName("CreateAPIKey")
+	identityServiceGetAPIKeyMethodDescriptor                        = identityServiceServiceDescriptor.Methods().ByName("GetAPIKey")
+	identityServiceListAPIKeysMethodDescriptor                      = identityServiceServiceDescriptor.Methods().ByName("ListAPIKeys")
+	identityServiceRevokeAPIKeyMethodDescriptor                     = identityServiceServiceDescriptor.Methods().ByName("RevokeAPIKey")
+	identityServiceRotateAPIKeyMethodDescriptor                     = identityServiceServiceDescriptor.Methods().ByName("RotateAPIKey")
+	identityServiceCreateServiceAccountMethodDescriptor             = identityServiceServiceDescriptor.Methods().ByName("CreateServiceAccount")
+	identityServiceGetServiceAccountMethodDescriptor                = identityServiceServiceDescriptor.Methods().ByName("GetServiceAccount")
+	identityServiceListServiceAccountsMethodDescriptor              = identityServiceServiceDescriptor.Methods().ByName("ListServiceAccounts")
+	identityServiceUpdateServiceAccountMethodDescriptor             = identityServiceServiceDescriptor.Methods().ByName("UpdateServiceAccount")
+	identityServiceDeleteServiceAccountMethodDescriptor             = identityServiceServiceDescriptor.Methods().ByName("DeleteServiceAccount")
+	identityServiceGetUserMethodDescriptor                          = identityServiceServiceDescriptor.Methods().ByName("GetUser")
+	identityServiceUpdateUserMethodDescriptor                       = identityServiceServiceDescriptor.Methods().ByName("UpdateUser")
+	identityServiceInitiateSAMLLoginMethodDescriptor                = identityServiceServiceDescriptor.Methods().ByName("InitiateSAMLLogin")
+	identityServiceCompleteSAMLLoginMethodDescriptor                = identityServiceServiceDescriptor.Methods().ByName("CompleteSAMLLogin")
+	identityServiceRotateSCIMTokenMethodDescriptor                  = identityServiceServiceDescriptor.Methods().ByName("RotateSCIMToken")
+	identityServiceListUserGroupsMethodDescriptor                   = identityServiceServiceDescriptor.Methods().ByName("ListUserGroups")
+	identityServiceSetUserGroupNamespacePermissionsMethodDescriptor = identityServiceServiceDescriptor.Methods().ByName("SetUserGroupNamespacePermissions")
+	identityServiceAddDomainMethodDescriptor                        = identityServiceServiceDescriptor.Methods().ByName("AddDomain")
+	identityServiceVerifyDomainMethodDescriptor                     = identityServiceServiceDescriptor.Methods().ByName("VerifyDomain")
+	identityServiceListDomainsMethodDescriptor                      = identityServiceServiceDescriptor.Methods().ByName("ListDomains")
+	identityServiceRemoveDomainMethodDescriptor                     = identityServiceServiceDescriptor.Methods().ByName("RemoveDomain")
+)
+
+// IdentityServiceClient is a client for the temporal.cloud.api.v1.IdentityService service.
+type IdentityServiceClient interface {
+	// CreateAPIKey creates a new API key.
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// GetAPIKey retrieves an API key by ID.
+	GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error)
+	// ListAPIKeys lists API keys for an owner.
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// RevokeAPIKey revokes an API key.
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// RotateAPIKey rotates an API key.
+	RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error)
+	// CreateServiceAccount creates a new service account.
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
+	// GetServiceAccount retrieves a service account by ID.
+	GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error)
+	// ListServiceAccounts lists service accounts for an organization.
+	ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error)
+	// UpdateServiceAccount updates a service account.
+	UpdateServiceAccount(context.Context, *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error)
+	// DeleteServiceAccount deletes a service account.
+	DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error)
+	// GetUser retrieves the current user.
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// UpdateUser updates the current user.
+	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
+	// InitiateSAMLLogin initiates a SAML SSO login.
+	InitiateSAMLLogin(context.Context, *connect.Request[v1.InitiateSAMLLoginRequest]) (*connect.Response[v1.InitiateSAMLLoginResponse], error)
+	// CompleteSAMLLogin completes a SAML SSO login.
+	CompleteSAMLLogin(context.Context, *connect.Request[v1.CompleteSAMLLoginRequest]) (*connect.Response[v1.CompleteSAMLLoginResponse], error)
+	// RotateSCIMToken issues a new SCIM bearer token for an organization and
+	// enables SCIM provisioning. The previous token stops working.
+	RotateSCIMToken(context.Context, *connect.Request[v1.RotateSCIMTokenRequest]) (*connect.Response[v1.RotateSCIMTokenResponse], error)
+	// ListUserGroups lists an organization's user groups.
+	ListUserGroups(context.Context, *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error)
+	// SetUserGroupNamespacePermissions sets the namespace permissions a user
+	// group grants its members.
+	SetUserGroupNamespacePermissions(context.Context, *connect.Request[v1.SetUserGroupNamespacePermissionsRequest]) (*connect.Response[v1.SetUserGroupNamespacePermissionsResponse], error)
+	// AddDomain claims an email domain for an organization. SAML logins only
+	// link or create users with email addresses in the organization's verified
+	// domains, unless they are already members.
+	AddDomain(context.Context, *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error)
+	// VerifyDomain checks a claimed domain's DNS TXT verification record and
+	// marks the domain verified if it is published.
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	// ListDomains lists the domains claimed by an organization.
+	ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error)
+	// RemoveDomain removes a domain from an organization.
+	RemoveDomain(context.Context, *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error)
+}
+
+// NewIdentityServiceClient constructs a client for the temporal.cloud.api.v1.IdentityService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewIdentityServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) IdentityServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &identityServiceClient{
+		createAPIKey: connect.NewClient[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+IdentityServiceCreateAPIKeyProcedure,
+			connect.WithSchema(identityServiceCreateAPIKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAPIKey: connect.NewClient[v1.GetAPIKeyRequest, v1.GetAPIKeyResponse](
+			httpClient,
+			baseURL+IdentityServiceGetAPIKeyProcedure,
+			connect.WithSchema(identityServiceGetAPIKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse](
+			httpClient,
+			baseURL+IdentityServiceListAPIKeysProcedure,
+			connect.WithSchema(identityServiceListAPIKeysMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+IdentityServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(identityServiceRevokeAPIKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rotateAPIKey: connect.NewClient[v1.RotateAPIKeyRequest, v1.RotateAPIKeyResponse](
+			httpClient,
+			baseURL+IdentityServiceRotateAPIKeyProcedure,
+			connect.WithSchema(identityServiceRotateAPIKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createServiceAccount: connect.NewClient[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse](
+			httpClient,
+			baseURL+IdentityServiceCreateServiceAccountProcedure,
+			connect.WithSchema(identityServiceCreateServiceAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getServiceAccount: connect.NewClient[v1.GetServiceAccountRequest, v1.GetServiceAccountResponse](
+			httpClient,
+			baseURL+IdentityServiceGetServiceAccountProcedure,
+			connect.WithSchema(identityServiceGetServiceAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listServiceAccounts: connect.NewClient[v1.ListServiceAccountsRequest, v1.ListServiceAccountsResponse](
+			httpClient,
+			baseURL+IdentityServiceListServiceAccountsProcedure,
+			connect.WithSchema(identityServiceListServiceAccountsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateServiceAccount: connect.NewClient[v1.UpdateServiceAccountRequest, v1.UpdateServiceAccountResponse](
+			httpClient,
+			baseURL+IdentityServiceUpdateServiceAccountProcedure,
+			connect.WithSchema(identityServiceUpdateServiceAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteServiceAccount: connect.NewClient[v1.DeleteServiceAccountRequest, v1.DeleteServiceAccountResponse](
+			httpClient,
+			baseURL+IdentityServiceDeleteServiceAccountProcedure,
+			connect.WithSchema(identityServiceDeleteServiceAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+IdentityServiceGetUserProcedure,
+			connect.WithSchema(identityServiceGetUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateUser: connect.NewClient[v1.UpdateUserRequest, v1.UpdateUserResponse](
+			httpClient,
+			baseURL+IdentityServiceUpdateUserProcedure,
+			connect.WithSchema(identityServiceUpdateUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		initiateSAMLLogin: connect.NewClient[v1.InitiateSAMLLoginRequest, v1.InitiateSAMLLoginResponse](
+			httpClient,
+			baseURL+IdentityServiceInitiateSAMLLoginProcedure,
+			connect.WithSchema(identityServiceInitiateSAMLLoginMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		completeSAMLLogin: connect.NewClient[v1.CompleteSAMLLoginRequest, v1.CompleteSAMLLoginResponse](
+			httpClient,
+			baseURL+IdentityServiceCompleteSAMLLoginProcedure,
+			connect.WithSchema(identityServiceCompleteSAMLLoginMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rotateSCIMToken: connect.NewClient[v1.RotateSCIMTokenRequest, v1.RotateSCIMTokenResponse](
+			httpClient,
+			baseURL+IdentityServiceRotateSCIMTokenProcedure,
+			connect.WithSchema(identityServiceRotateSCIMTokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listUserGroups: connect.NewClient[v1.ListUserGroupsRequest, v1.ListUserGroupsResponse](
+			httpClient,
+			baseURL+IdentityServiceListUserGroupsProcedure,
+			connect.WithSchema(identityServiceListUserGroupsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setUserGroupNamespacePermissions: connect.NewClient[v1.SetUserGroupNamespacePermissionsRequest, v1.SetUserGroupNamespacePermissionsResponse](
+			httpClient,
+			baseURL+IdentityServiceSetUserGroupNamespacePermissionsProcedure,
+			connect.WithSchema(identityServiceSetUserGroupNamespacePermissionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		addDomain: connect.NewClient[v1.AddDomainRequest, v1.AddDomainResponse](
+			httpClient,
+			baseURL+IdentityServiceAddDomainProcedure,
+			connect.WithSchema(identityServiceAddDomainMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		verifyDomain: connect.NewClient[v1.VerifyDomainRequest, v1.VerifyDomainResponse](
+			httpClient,
+			baseURL+IdentityServiceVerifyDomainProcedure,
+			connect.WithSchema(identityServiceVerifyDomainMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listDomains: connect.NewClient[v1.ListDomainsRequest, v1.ListDomainsResponse](
+			httpClient,
+			baseURL+IdentityServiceListDomainsProcedure,
+			connect.WithSchema(identityServiceListDomainsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeDomain: connect.NewClient[v1.RemoveDomainRequest, v1.RemoveDomainResponse](
+			httpClient,
+			baseURL+IdentityServiceRemoveDomainProcedure,
+			connect.WithSchema(identityServiceRemoveDomainMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// identityServiceClient implements IdentityServiceClient.
+type identityServiceClient struct {
+	createAPIKey                     *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	getAPIKey                        *connect.Client[v1.GetAPIKeyRequest, v1.GetAPIKeyResponse]
+	listAPIKeys                      *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
+	revokeAPIKey                     *connect.Client[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse]
+	rotateAPIKey                     *connect.Client[v1.RotateAPIKeyRequest, v1.RotateAPIKeyResponse]
+	createServiceAccount             *connect.Client[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse]
+	getServiceAccount                *connect.Client[v1.GetServiceAccountRequest, v1.GetServiceAccountResponse]
+	listServiceAccounts              *connect.Client[v1.ListServiceAccountsRequest, v1.ListServiceAccountsResponse]
+	updateServiceAccount             *connect.Client[v1.UpdateServiceAccountRequest, v1.UpdateServiceAccountResponse]
+	deleteServiceAccount             *connect.Client[v1.DeleteServiceAccountRequest, v1.DeleteServiceAccountResponse]
+	getUser                          *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	updateUser                       *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	initiateSAMLLogin                *connect.Client[v1.InitiateSAMLLoginRequest, v1.InitiateSAMLLoginResponse]
+	completeSAMLLogin                *connect.Client[v1.CompleteSAMLLoginRequest, v1.CompleteSAMLLoginResponse]
+	rotateSCIMToken                  *connect.Client[v1.RotateSCIMTokenRequest, v1.RotateSCIMTokenResponse]
+	listUserGroups                   *connect.Client[v1.ListUserGroupsRequest, v1.ListUserGroupsResponse]
+	setUserGroupNamespacePermissions *connect.Client[v1.SetUserGroupNamespacePermissionsRequest, v1.SetUserGroupNamespacePermissionsResponse]
+	addDomain                        *connect.Client[v1.AddDomainRequest, v1.AddDomainResponse]
+	verifyDomain                     *connect.Client[v1.VerifyDomainRequest, v1.VerifyDomainResponse]
+	listDomains                      *connect.Client[v1.ListDomainsRequest, v1.ListDomainsResponse]
+	removeDomain                     *connect.Client[v1.RemoveDomainRequest, v1.RemoveDomainResponse]
+}
+
+// CreateAPIKey calls temporal.cloud.api.v1.IdentityService.CreateAPIKey.
+func (c *identityServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// GetAPIKey calls temporal.cloud.api.v1.IdentityService.GetAPIKey.
+func (c *identityServiceClient) GetAPIKey(ctx context.Context, req *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error) {
+	return c.getAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls temporal.cloud.api.v1.IdentityService.ListAPIKeys.
+func (c *identityServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls temporal.cloud.api.v1.IdentityService.RevokeAPIKey.
+func (c *identityServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// RotateAPIKey calls temporal.cloud.api.v1.IdentityService.RotateAPIKey.
+func (c *identityServiceClient) RotateAPIKey(ctx context.Context, req *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error) {
+	return c.rotateAPIKey.CallUnary(ctx, req)
+}
+
+// CreateServiceAccount calls temporal.cloud.api.v1.IdentityService.CreateServiceAccount.
+func (c *identityServiceClient) CreateServiceAccount(ctx context.Context, req *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return c.createServiceAccount.CallUnary(ctx, req)
+}
+
+// GetServiceAccount calls temporal.cloud.api.v1.IdentityService.GetServiceAccount.
+func (c *identityServiceClient) GetServiceAccount(ctx context.Context, req *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error) {
+	return c.getServiceAccount.CallUnary(ctx, req)
+}
+
+// ListServiceAccounts calls temporal.cloud.api.v1.IdentityService.ListServiceAccounts.
+func (c *identityServiceClient) ListServiceAccounts(ctx context.Context, req *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error) {
+	return c.listServiceAccounts.CallUnary(ctx, req)
+}
+
+// UpdateServiceAccount calls temporal.cloud.api.v1.IdentityService.UpdateServiceAccount.
+func (c *identityServiceClient) UpdateServiceAccount(ctx context.Context, req *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error) {
+	return c.updateServiceAccount.CallUnary(ctx, req)
+}
+
+// DeleteServiceAccount calls temporal.cloud.api.v1.IdentityService.DeleteServiceAccount.
+func (c *identityServiceClient) DeleteServiceAccount(ctx context.Context, req *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error) {
+	return c.deleteServiceAccount.CallUnary(ctx, req)
+}
+
+// GetUser calls temporal.cloud.api.v1.IdentityService.GetUser.
+func (c *identityServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// UpdateUser calls temporal.cloud.api.v1.IdentityService.UpdateUser.
+func (c *identityServiceClient) UpdateUser(ctx context.Context, req *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error) {
+	return c.updateUser.CallUnary(ctx, req)
+}
+
+// InitiateSAMLLogin calls temporal.cloud.api.v1.IdentityService.InitiateSAMLLogin.
+func (c *identityServiceClient) InitiateSAMLLogin(ctx context.Context, req *connect.Request[v1.InitiateSAMLLoginRequest]) (*connect.Response[v1.InitiateSAMLLoginResponse], error) {
+	return c.initiateSAMLLogin.CallUnary(ctx, req)
+}
+
+// CompleteSAMLLogin calls temporal.cloud.api.v1.IdentityService.CompleteSAMLLogin.
+func (c *identityServiceClient) CompleteSAMLLogin(ctx context.Context, req *connect.Request[v1.CompleteSAMLLoginRequest]) (*connect.Response[v1.CompleteSAMLLoginResponse], error) {
+	return c.completeSAMLLogin.CallUnary(ctx, req)
+}
+
+// RotateSCIMToken calls temporal.cloud.api.v1.IdentityService.RotateSCIMToken.
+func (c *identityServiceClient) RotateSCIMToken(ctx context.Context, req *connect.Request[v1.RotateSCIMTokenRequest]) (*connect.Response[v1.RotateSCIMTokenResponse], error) {
+	return c.rotateSCIMToken.CallUnary(ctx, req)
+}
+
+// ListUserGroups calls temporal.cloud.api.v1.IdentityService.ListUserGroups.
+func (c *identityServiceClient) ListUserGroups(ctx context.Context, req *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error) {
+	return c.listUserGroups.CallUnary(ctx, req)
+}
+
+// SetUserGroupNamespacePermissions calls
+// temporal.cloud.api.v1.IdentityService.SetUserGroupNamespacePermissions.
+func (c *identityServiceClient) SetUserGroupNamespacePermissions(ctx context.Context, req *connect.Request[v1.SetUserGroupNamespacePermissionsRequest]) (*connect.Response[v1.SetUserGroupNamespacePermissionsResponse], error) {
+	return c.setUserGroupNamespacePermissions.CallUnary(ctx, req)
+}
+
+// AddDomain calls temporal.cloud.api.v1.IdentityService.AddDomain.
+func (c *identityServiceClient) AddDomain(ctx context.Context, req *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error) {
+	return c.addDomain.CallUnary(ctx, req)
+}
+
+// VerifyDomain calls temporal.cloud.api.v1.IdentityService.VerifyDomain.
+func (c *identityServiceClient) VerifyDomain(ctx context.Context, req *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return c.verifyDomain.CallUnary(ctx, req)
+}
+
+// ListDomains calls temporal.cloud.api.v1.IdentityService.ListDomains.
+func (c *identityServiceClient) ListDomains(ctx context.Context, req *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error) {
+	return c.listDomains.CallUnary(ctx, req)
+}
+
+// RemoveDomain calls temporal.cloud.api.v1.IdentityService.RemoveDomain.
+func (c *identityServiceClient) RemoveDomain(ctx context.Context, req *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error) {
+	return c.removeDomain.CallUnary(ctx, req)
+}
+
+// IdentityServiceHandler is an implementation of the temporal.cloud.api.v1.IdentityService service.
+type IdentityServiceHandler interface {
+	// CreateAPIKey creates a new API key.
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// GetAPIKey retrieves an API key by ID.
+	GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error)
+	// ListAPIKeys lists API keys for an owner.
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// RevokeAPIKey revokes an API key.
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// RotateAPIKey rotates an API key.
+	RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error)
+	// CreateServiceAccount creates a new service account.
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
+	// GetServiceAccount retrieves a service account by ID.
+	GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error)
+	// ListServiceAccounts lists service accounts for an organization.
+	ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error)
+	// UpdateServiceAccount updates a service account.
+	UpdateServiceAccount(context.Context, *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error)
+	// DeleteServiceAccount deletes a service account.
+	DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error)
+	// GetUser retrieves the current user.
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// UpdateUser updates the current user.
+	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
+	// InitiateSAMLLogin initiates a SAML SSO login.
+	InitiateSAMLLogin(context.Context, *connect.Request[v1.InitiateSAMLLoginRequest]) (*connect.Response[v1.InitiateSAMLLoginResponse], error)
+	// CompleteSAMLLogin completes a SAML SSO login.
+	CompleteSAMLLogin(context.Context, *connect.Request[v1.CompleteSAMLLoginRequest]) (*connect.Response[v1.CompleteSAMLLoginResponse], error)
+	// RotateSCIMToken issues a new SCIM bearer token for an organization and
+	// enables SCIM provisioning. The previous token stops working.
+	RotateSCIMToken(context.Context, *connect.Request[v1.RotateSCIMTokenRequest]) (*connect.Response[v1.RotateSCIMTokenResponse], error)
+	// ListUserGroups lists an organization's user groups.
+	ListUserGroups(context.Context, *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error)
+	// SetUserGroupNamespacePermissions sets the namespace permissions a user
+	// group grants its members.
+	SetUserGroupNamespacePermissions(context.Context, *connect.Request[v1.SetUserGroupNamespacePermissionsRequest]) (*connect.Response[v1.SetUserGroupNamespacePermissionsResponse], error)
+	// AddDomain claims an email domain for an organization. SAML logins only
+	// link or create users with email addresses in the organization's verified
+	// domains, unless they are already members.
+	AddDomain(context.Context, *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error)
+	// VerifyDomain checks a claimed domain's DNS TXT verification record and
+	// marks the domain verified if it is published.
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	// ListDomains lists the domains claimed by an organization.
+	ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error)
+	// RemoveDomain removes a domain from an organization.
+	RemoveDomain(context.Context, *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error)
+}
+
+// NewIdentityServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewIdentityServiceHandler(svc IdentityServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	identityServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		IdentityServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(identityServiceCreateAPIKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceGetAPIKeyHandler := connect.NewUnaryHandler(
+		IdentityServiceGetAPIKeyProcedure,
+		svc.GetAPIKey,
+		connect.WithSchema(identityServiceGetAPIKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		IdentityServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(identityServiceListAPIKeysMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		IdentityServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(identityServiceRevokeAPIKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceRotateAPIKeyHandler := connect.NewUnaryHandler(
+		IdentityServiceRotateAPIKeyProcedure,
+		svc.RotateAPIKey,
+		connect.WithSchema(identityServiceRotateAPIKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceCreateServiceAccountHandler := connect.NewUnaryHandler(
+		IdentityServiceCreateServiceAccountProcedure,
+		svc.CreateServiceAccount,
+		connect.WithSchema(identityServiceCreateServiceAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceGetServiceAccountHandler := connect.NewUnaryHandler(
+		IdentityServiceGetServiceAccountProcedure,
+		svc.GetServiceAccount,
+		connect.WithSchema(identityServiceGetServiceAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceListServiceAccountsHandler := connect.NewUnaryHandler(
+		IdentityServiceListServiceAccountsProcedure,
+		svc.ListServiceAccounts,
+		connect.WithSchema(identityServiceListServiceAccountsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceUpdateServiceAccountHandler := connect.NewUnaryHandler(
+		IdentityServiceUpdateServiceAccountProcedure,
+		svc.UpdateServiceAccount,
+		connect.WithSchema(identityServiceUpdateServiceAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceDeleteServiceAccountHandler := connect.NewUnaryHandler(
+		IdentityServiceDeleteServiceAccountProcedure,
+		svc.DeleteServiceAccount,
+		connect.WithSchema(identityServiceDeleteServiceAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceGetUserHandler := connect.NewUnaryHandler(
+		IdentityServiceGetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(identityServiceGetUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceUpdateUserHandler := connect.NewUnaryHandler(
+		IdentityServiceUpdateUserProcedure,
+		svc.UpdateUser,
+		connect.WithSchema(identityServiceUpdateUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceInitiateSAMLLoginHandler := connect.NewUnaryHandler(
+		IdentityServiceInitiateSAMLLoginProcedure,
+		svc.InitiateSAMLLogin,
+		connect.WithSchema(identityServiceInitiateSAMLLoginMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceCompleteSAMLLoginHandler := connect.NewUnaryHandler(
+		IdentityServiceCompleteSAMLLoginProcedure,
+		svc.CompleteSAMLLogin,
+		connect.WithSchema(identityServiceCompleteSAMLLoginMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceRotateSCIMTokenHandler := connect.NewUnaryHandler(
+		IdentityServiceRotateSCIMTokenProcedure,
+		svc.RotateSCIMToken,
+		connect.WithSchema(identityServiceRotateSCIMTokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceListUserGroupsHandler := connect.NewUnaryHandler(
+		IdentityServiceListUserGroupsProcedure,
+		svc.ListUserGroups,
+		connect.WithSchema(identityServiceListUserGroupsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceSetUserGroupNamespacePermissionsHandler := connect.NewUnaryHandler(
+		IdentityServiceSetUserGroupNamespacePermissionsProcedure,
+		svc.SetUserGroupNamespacePermissions,
+		connect.WithSchema(identityServiceSetUserGroupNamespacePermissionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceAddDomainHandler := connect.NewUnaryHandler(
+		IdentityServiceAddDomainProcedure,
+		svc.AddDomain,
+		connect.WithSchema(identityServiceAddDomainMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceVerifyDomainHandler := connect.NewUnaryHandler(
+		IdentityServiceVerifyDomainProcedure,
+		svc.VerifyDomain,
+		connect.WithSchema(identityServiceVerifyDomainMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceListDomainsHandler := connect.NewUnaryHandler(
+		IdentityServiceListDomainsProcedure,
+		svc.ListDomains,
+		connect.WithSchema(identityServiceListDomainsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceRemoveDomainHandler := connect.NewUnaryHandler(
+		IdentityServiceRemoveDomainProcedure,
+		svc.RemoveDomain,
+		connect.WithSchema(identityServiceRemoveDomainMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/temporal.cloud.api.v1.IdentityService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case IdentityServiceCreateAPIKeyProcedure:
+			identityServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case IdentityServiceGetAPIKeyProcedure:
+			identityServiceGetAPIKeyHandler.ServeHTTP(w, r)
+		case IdentityServiceListAPIKeysProcedure:
+			identityServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case IdentityServiceRevokeAPIKeyProcedure:
+			identityServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case IdentityServiceRotateAPIKeyProcedure:
+			identityServiceRotateAPIKeyHandler.ServeHTTP(w, r)
+		case IdentityServiceCreateServiceAccountProcedure:
+			identityServiceCreateServiceAccountHandler.ServeHTTP(w, r)
+		case IdentityServiceGetServiceAccountProcedure:
+			identityServiceGetServiceAccountHandler.ServeHTTP(w, r)
+		case IdentityServiceListServiceAccountsProcedure:
+			identityServiceListServiceAccountsHandler.ServeHTTP(w, r)
+		case IdentityServiceUpdateServiceAccountProcedure:
+			identityServiceUpdateServiceAccountHandler.ServeHTTP(w, r)
+		case IdentityServiceDeleteServiceAccountProcedure:
+			identityServiceDeleteServiceAccountHandler.ServeHTTP(w, r)
+		case IdentityServiceGetUserProcedure:
+			identityServiceGetUserHandler.ServeHTTP(w, r)
+		case IdentityServiceUpdateUserProcedure:
+			identityServiceUpdateUserHandler.ServeHTTP(w, r)
+		case IdentityServiceInitiateSAMLLoginProcedure:
+			identityServiceInitiateSAMLLoginHandler.ServeHTTP(w, r)
+		case IdentityServiceCompleteSAMLLoginProcedure:
+			identityServiceCompleteSAMLLoginHandler.ServeHTTP(w, r)
+		case IdentityServiceRotateSCIMTokenProcedure:
+			identityServiceRotateSCIMTokenHandler.ServeHTTP(w, r)
+		case IdentityServiceListUserGroupsProcedure:
+			identityServiceListUserGroupsHandler.ServeHTTP(w, r)
+		case IdentityServiceSetUserGroupNamespacePermissionsProcedure:
+			identityServiceSetUserGroupNamespacePermissionsHandler.ServeHTTP(w, r)
+		case IdentityServiceAddDomainProcedure:
+			identityServiceAddDomainHandler.ServeHTTP(w, r)
+		case IdentityServiceVerifyDomainProcedure:
+			identityServiceVerifyDomainHandler.ServeHTTP(w, r)
+		case IdentityServiceListDomainsProcedure:
+			identityServiceListDomainsHandler.ServeHTTP(w, r)
+		case IdentityServiceRemoveDomainProcedure:
+			identityServiceRemoveDomainHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedIdentityServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedIdentityServiceHandler struct{}
+
+func (UnimplementedIdentityServiceHandler) CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.GetAPIKey is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.RotateAPIKey is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.CreateServiceAccount is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.GetServiceAccount is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.ListServiceAccounts is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) UpdateServiceAccount(context.Context, *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.UpdateServiceAccount is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.DeleteServiceAccount is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.GetUser is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.UpdateUser is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) InitiateSAMLLogin(context.Context, *connect.Request[v1.InitiateSAMLLoginRequest]) (*connect.Response[v1.InitiateSAMLLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.InitiateSAMLLogin is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) CompleteSAMLLogin(context.Context, *connect.Request[v1.CompleteSAMLLoginRequest]) (*connect.Response[v1.CompleteSAMLLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.CompleteSAMLLogin is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) RotateSCIMToken(context.Context, *connect.Request[v1.RotateSCIMTokenRequest]) (*connect.Response[v1.RotateSCIMTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.RotateSCIMToken is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) ListUserGroups(context.Context, *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.ListUserGroups is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) SetUserGroupNamespacePermissions(context.Context, *connect.Request[v1.SetUserGroupNamespacePermissionsRequest]) (*connect.Response[v1.SetUserGroupNamespacePermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.SetUserGroupNamespacePermissions is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) AddDomain(context.Context, *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.AddDomain is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.VerifyDomain is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.ListDomains is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) RemoveDomain(context.Context, *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.IdentityService.RemoveDomain is not implemented"))
+}
@@ -0,0 +1,1059 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cloud/v1/namespaces.proto
+
+package cloudv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.temporal.io/cloud/api/cloud/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NamespaceServiceName is the fully-qualified name of the NamespaceService service.
+	NamespaceServiceName = "temporal.cloud.api.v1.NamespaceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NamespaceServiceCreateNamespaceProcedure is the fully-qualified name of the NamespaceService's
+	// CreateNamespace RPC.
+	NamespaceServiceCreateNamespaceProcedure = "/temporal.cloud.api.v1.NamespaceService/CreateNamespace"
+	// NamespaceServiceGetNamespaceProcedure is the fully-qualified name of the NamespaceService's
+	// GetNamespace RPC.
+	NamespaceServiceGetNamespaceProcedure = "/temporal.cloud.api.v1.NamespaceService/GetNamespace"
+	// NamespaceServiceUpdateNamespaceProcedure is the fully-qualified name of the NamespaceService's
+	// UpdateNamespace RPC.
+	NamespaceServiceUpdateNamespaceProcedure = "/temporal.cloud.api.v1.NamespaceService/UpdateNamespace"
+	// NamespaceServiceDeleteNamespaceProcedure is the fully-qualified name of the NamespaceService's
+	// DeleteNamespace RPC.
+	NamespaceServiceDeleteNamespaceProcedure = "/temporal.cloud.api.v1.NamespaceService/DeleteNamespace"
+	// NamespaceServiceListNamespacesProcedure is the fully-qualified name of the NamespaceService's
+	// ListNamespaces RPC.
+	NamespaceServiceListNamespacesProcedure = "/temporal.cloud.api.v1.NamespaceService/ListNamespaces"
+	// NamespaceServiceAddSearchAttributesProcedure is the fully-qualified name of the
+	// NamespaceService's AddSearchAttributes RPC.
+	NamespaceServiceAddSearchAttributesProcedure = "/temporal.cloud.api.v1.NamespaceService/AddSearchAttributes"
+	// NamespaceServiceRemoveSearchAttributeProcedure is the fully-qualified name of the
+	// NamespaceService's RemoveSearchAttribute RPC.
+	NamespaceServiceRemoveSearchAttributeProcedure = "/temporal.cloud.api.v1.NamespaceService/RemoveSearchAttribute"
+	// NamespaceServiceAddCertificateFilterProcedure is the fully-qualified name of the
+	// NamespaceService's AddCertificateFilter RPC.
+	NamespaceServiceAddCertificateFilterProcedure = "/temporal.cloud.api.v1.NamespaceService/AddCertificateFilter"
+	// NamespaceServiceRemoveCertificateFilterProcedure is the fully-qualified name of the
+	// NamespaceService's RemoveCertificateFilter RPC.
+	NamespaceServiceRemoveCertificateFilterProcedure = "/temporal.cloud.api.v1.NamespaceService/RemoveCertificateFilter"
+	// NamespaceServiceFailoverNamespaceProcedure is the fully-qualified name of the NamespaceService's
+	// FailoverNamespace RPC.
+	NamespaceServiceFailoverNamespaceProcedure = "/temporal.cloud.api.v1.NamespaceService/FailoverNamespace"
+	// NamespaceServiceIssueClientCertificateProcedure is the fully-qualified name of the
+	// NamespaceService's IssueClientCertificate RPC.
+	NamespaceServiceIssueClientCertificateProcedure = "/temporal.cloud.api.v1.NamespaceService/IssueClientCertificate"
+	// NamespaceServiceCreateExportSinkProcedure is the fully-qualified name of the NamespaceService's
+	// CreateExportSink RPC.
+	NamespaceServiceCreateExportSinkProcedure = "/temporal.cloud.api.v1.NamespaceService/CreateExportSink"
+	// NamespaceServiceGetExportSinkProcedure is the fully-qualified name of the NamespaceService's
+	// GetExportSink RPC.
+	NamespaceServiceGetExportSinkProcedure = "/temporal.cloud.api.v1.NamespaceService/GetExportSink"
+	// NamespaceServiceListExportSinksProcedure is the fully-qualified name of the NamespaceService's
+	// ListExportSinks RPC.
+	NamespaceServiceListExportSinksProcedure = "/temporal.cloud.api.v1.NamespaceService/ListExportSinks"
+	// NamespaceServiceUpdateExportSinkProcedure is the fully-qualified name of the NamespaceService's
+	// UpdateExportSink RPC.
+	NamespaceServiceUpdateExportSinkProcedure = "/temporal.cloud.api.v1.NamespaceService/UpdateExportSink"
+	// NamespaceServiceDeleteExportSinkProcedure is the fully-qualified name of the NamespaceService's
+	// DeleteExportSink RPC.
+	NamespaceServiceDeleteExportSinkProcedure = "/temporal.cloud.api.v1.NamespaceService/DeleteExportSink"
+	// NamespaceServiceListExportJobsProcedure is the fully-qualified name of the NamespaceService's
+	// ListExportJobs RPC.
+	NamespaceServiceListExportJobsProcedure = "/temporal.cloud.api.v1.NamespaceService/ListExportJobs"
+	// NamespaceServiceCreateConnectivityRuleProcedure is the fully-qualified name of the
+	// NamespaceService's CreateConnectivityRule RPC.
+	NamespaceServiceCreateConnectivityRuleProcedure = "/temporal.cloud.api.v1.NamespaceService/CreateConnectivityRule"
+	// NamespaceServiceGetConnectivityRuleProcedure is the fully-qualified name of the
+	// NamespaceService's GetConnectivityRule RPC.
+	NamespaceServiceGetConnectivityRuleProcedure = "/temporal.cloud.api.v1.NamespaceService/GetConnectivityRule"
+	// NamespaceServiceListConnectivityRulesProcedure is the fully-qualified name of the
+	// NamespaceService's ListConnectivityRules RPC.
+	NamespaceServiceListConnectivityRulesProcedure = "/temporal.cloud.api.v1.NamespaceService/ListConnectivityRules"
+	// NamespaceServiceUpdateConnectivityRuleProcedure is the fully-qualified name of the
+	// NamespaceService's UpdateConnectivityRule RPC.
+	NamespaceServiceUpdateConnectivityRuleProcedure = "/temporal.cloud.api.v1.NamespaceService/UpdateConnectivityRule"
+	// NamespaceServiceDeleteConnectivityRuleProcedure is the fully-qualified name of the
+	// NamespaceService's DeleteConnectivityRule RPC.
+	NamespaceServiceDeleteConnectivityRuleProcedure = "/temporal.cloud.api.v1.NamespaceService/DeleteConnectivityRule"
+	// NamespaceServiceAddNamespaceConnectivityRuleProcedure is the fully-qualified name of the
+	// NamespaceService's AddNamespaceConnectivityRule RPC.
+	NamespaceServiceAddNamespaceConnectivityRuleProcedure = "/temporal.cloud.api.v1.NamespaceService/AddNamespaceConnectivityRule"
+	// NamespaceServiceRemoveNamespaceConnectivityRuleProcedure is the fully-qualified name of the
+	// NamespaceService's RemoveNamespaceConnectivityRule RPC.
+	NamespaceServiceRemoveNamespaceConnectivityRuleProcedure = "/temporal.cloud.api.v1.NamespaceService/RemoveNamespaceConnectivityRule"
+	// NamespaceServiceListNamespaceConnectivityRulesProcedure is the fully-qualified name of the
+	// NamespaceService's ListNamespaceConnectivityRules RPC.
+	NamespaceServiceListNamespaceConnectivityRulesProcedure = "/temporal.cloud.api.v1.NamespaceService/ListNamespaceConnectivityRules"
+	// NamespaceServiceCreateNexusEndpointProcedure is the fully-qualified name of the
+	// NamespaceService's CreateNexusEndpoint RPC.
+	NamespaceServiceCreateNexusEndpointProcedure = "/temporal.cloud.api.v1.NamespaceService/CreateNexusEndpoint"
+	// NamespaceServiceGetNexusEndpointProcedure is the fully-qualified name of the NamespaceService's
+	// GetNexusEndpoint RPC.
+	NamespaceServiceGetNexusEndpointProcedure = "/temporal.cloud.api.v1.NamespaceService/GetNexusEndpoint"
+	// NamespaceServiceListNexusEndpointsProcedure is the fully-qualified name of the NamespaceService's
+	// ListNexusEndpoints RPC.
+	NamespaceServiceListNexusEndpointsProcedure = "/temporal.cloud.api.v1.NamespaceService/ListNexusEndpoints"
+	// NamespaceServiceUpdateNexusEndpointProcedure is the fully-qualified name of the
+	// NamespaceService's UpdateNexusEndpoint RPC.
+	NamespaceServiceUpdateNexusEndpointProcedure = "/temporal.cloud.api.v1.NamespaceService/UpdateNexusEndpoint"
+	// NamespaceServiceDeleteNexusEndpointProcedure is the fully-qualified name of the
+	// NamespaceService's DeleteNexusEndpoint RPC.
+	NamespaceServiceDeleteNexusEndpointProcedure = "/temporal.cloud.api.v1.NamespaceService/DeleteNexusEndpoint"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	namespaceServiceServiceDescriptor                               = v1.File_cloud_v1_namespaces_proto.Services().ByName("NamespaceService")
+	namespaceServiceCreateNamespaceMethodDescriptor                 = namespaceServiceServiceDescriptor.Methods().ByName("CreateNamespace")
+	namespaceServiceGetNamespaceMethodDescriptor                    = namespaceServiceServiceDescriptor.Methods().ByName("GetNamespace")
+	namespaceServiceUpdateNamespaceMethodDescriptor                 = namespaceServiceServiceDescriptor.Methods().ByName("UpdateNamespace")
+	namespaceServiceDeleteNamespaceMethodDescriptor                 = namespaceServiceServiceDescriptor.Methods().ByName("DeleteNamespace")
+	namespaceServiceListNamespacesMethodDescriptor                  = namespaceServiceServiceDescriptor.Methods().ByName("ListNamespaces")
+	namespaceServiceAddSearchAttributesMethodDescriptor             = namespaceServiceServiceDescriptor.Methods().ByName("AddSearchAttributes")
+	namespaceServiceRemoveSearchAttributeMethodDescriptor           = namespaceServiceServiceDescriptor.Methods().ByName("RemoveSearchAttribute")
+	namespaceServiceAddCertificateFilterMethodDescriptor            = namespaceServiceServiceDescriptor.Methods().ByName("AddCertificateFilter")
+	namespaceServiceRemoveCertificateFilterMethodDescriptor         = namespaceServiceServiceDescriptor.Methods().ByName("RemoveCertificateFilter")
+	namespaceServiceFailoverNamespaceMethodDescriptor               = namespaceServiceServiceDescriptor.Methods().ByName("FailoverNamespace")
+	namespaceServiceIssueClientCertificateMethodDescriptor          = namespaceServiceServiceDescriptor.Methods().ByName("IssueClientCertificate")
+	namespaceServiceCreateExportSinkMethodDescriptor                = namespaceServiceServiceDescriptor.Methods().ByName("CreateExportSink")
+	namespaceServiceGetExportSinkMethodDescriptor                   = namespaceServiceServiceDescriptor.Methods().ByName("GetExportSink")
+	namespaceServiceListExportSinksMethodDescriptor                 = namespaceServiceServiceDescriptor.Methods().ByName("ListExportSinks")
+	namespaceServiceUpdateExportSinkMethodDescriptor                = namespaceServiceServiceDescriptor.Methods().ByName("UpdateExportSink")
+	namespaceServiceDeleteExportSinkMethodDescriptor                = namespaceServiceServiceDescriptor.Methods().ByName("DeleteExportSink")
+	namespaceServiceListExportJobsMethodDescriptor                  = namespaceServiceServiceDescriptor.Methods().ByName("ListExportJobs")
+	namespaceServiceCreateConnectivityRuleMethodDescriptor          = namespaceServiceServiceDescriptor.Methods().ByName("CreateConnectivityRule")
+	namespaceServiceGetConnectivityRuleMethodDescriptor             = namespaceServiceServiceDescriptor.Methods().ByName("GetConnectivityRule")
+	namespaceServiceListConnectivityRulesMethodDescriptor           = namespaceServiceServiceDescriptor.Methods().ByName("ListConnectivityRules")
+	namespaceServiceUpdateConnectivityRuleMethodDescriptor          = namespaceServiceServiceDescriptor.Methods().ByName("UpdateConnectivityRule")
+	namespaceServiceDeleteConnectivityRuleMethodDescriptor          = namespaceServiceServiceDescriptor.Methods().ByName("DeleteConnectivityRule")
+	namespaceServiceAddNamespaceConnectivityRuleMethodDescriptor    = namespaceServiceServiceDescriptor.Methods().ByName("AddNamespaceConnectivityRule")
+	namespaceServiceRemoveNamespaceConnectivityRuleMethodDescriptor = namespaceServiceServiceDescriptor.Methods().ByName("RemoveNamespaceConnectivityRule")
+	namespaceServiceListNamespaceConnectivityRulesMethodDescriptor  = namespaceServiceServiceDescriptor.Methods().ByName("ListNamespaceConnectivityRules")
+	namespaceServiceCreateNexusEndpointMethodDescriptor             = namespaceServiceServiceDescriptor.Methods().ByName("CreateNexusEndpoint")
+	namespaceServiceGetNexusEndpointMethodDescriptor                = namespaceServiceServiceDescriptor.Methods().ByName("GetNexusEndpoint")
+	namespaceServiceListNexusEndpointsMethodDescriptor              = namespaceServiceServiceDescriptor.Methods().ByName("ListNexusEndpoints")
+	namespaceServiceUpdateNexusEndpointMethodDescriptor             = namespaceServiceServiceDescriptor.Methods().ByName("UpdateNexusEndpoint")
+	namespaceServiceDeleteNexusEndpointMethodDescriptor             = namespaceServiceServiceDescriptor.Methods().ByName("DeleteNexusEndpoint")
+)
+
+// NamespaceServiceClient is a client for the temporal.cloud.api.v1.NamespaceService service.
+type NamespaceServiceClient interface {
+	// CreateNamespace creates a new namespace.
+	CreateNamespace(context.Context, *connect.Request[v1.CreateNamespaceRequest]) (*connect.Response[v1.CreateNamespaceResponse], error)
+	// GetNamespace retrieves a namespace by ID.
+	GetNamespace(context.Context, *connect.Request[v1.GetNamespaceRequest]) (*connect.Response[v1.GetNamespaceResponse], error)
+	// UpdateNamespace updates a namespace.
+	UpdateNamespace(context.Context, *connect.Request[v1.UpdateNamespaceRequest]) (*connect.Response[v1.UpdateNamespaceResponse], error)
+	// DeleteNamespace deletes a namespace.
+	DeleteNamespace(context.Context, *connect.Request[v1.DeleteNamespaceRequest]) (*connect.Response[v1.DeleteNamespaceResponse], error)
+	// ListNamespaces lists namespaces in an organization.
+	ListNamespaces(context.Context, *connect.Request[v1.ListNamespacesRequest]) (*connect.Response[v1.ListNamespacesResponse], error)
+	// AddSearchAttributes adds custom search attributes to a namespace.
+	AddSearchAttributes(context.Context, *connect.Request[v1.AddSearchAttributesRequest]) (*connect.Response[v1.AddSearchAttributesResponse], error)
+	// RemoveSearchAttribute removes a custom search attribute from a namespace.
+	RemoveSearchAttribute(context.Context, *connect.Request[v1.RemoveSearchAttributeRequest]) (*connect.Response[v1.RemoveSearchAttributeResponse], error)
+	// AddCertificateFilter adds a certificate filter to a namespace.
+	AddCertificateFilter(context.Context, *connect.Request[v1.AddCertificateFilterRequest]) (*connect.Response[v1.AddCertificateFilterResponse], error)
+	// RemoveCertificateFilter removes a certificate filter from a namespace.
+	RemoveCertificateFilter(context.Context, *connect.Request[v1.RemoveCertificateFilterRequest]) (*connect.Response[v1.RemoveCertificateFilterResponse], error)
+	// FailoverNamespace initiates a failover to the standby region.
+	FailoverNamespace(context.Context, *connect.Request[v1.FailoverNamespaceRequest]) (*connect.Response[v1.FailoverNamespaceResponse], error)
+	// IssueClientCertificate issues a client certificate signed by the
+	// namespace's CA. The private key is returned once and is not stored.
+	IssueClientCertificate(context.Context, *connect.Request[v1.IssueClientCertificateRequest]) (*connect.Response[v1.IssueClientCertificateResponse], error)
+	// CreateExportSink adds a sink the namespace's workflow histories are
+	// exported to.
+	CreateExportSink(context.Context, *connect.Request[v1.CreateExportSinkRequest]) (*connect.Response[v1.CreateExportSinkResponse], error)
+	// GetExportSink retrieves an export sink.
+	GetExportSink(context.Context, *connect.Request[v1.GetExportSinkRequest]) (*connect.Response[v1.GetExportSinkResponse], error)
+	// ListExportSinks lists the export sinks of a namespace.
+	ListExportSinks(context.Context, *connect.Request[v1.ListExportSinksRequest]) (*connect.Response[v1.ListExportSinksResponse], error)
+	// UpdateExportSink updates an export sink.
+	UpdateExportSink(context.Context, *connect.Request[v1.UpdateExportSinkRequest]) (*connect.Response[v1.UpdateExportSinkResponse], error)
+	// DeleteExportSink deletes an export sink and its job history.
+	DeleteExportSink(context.Context, *connect.Request[v1.DeleteExportSinkRequest]) (*connect.Response[v1.DeleteExportSinkResponse], error)
+	// ListExportJobs lists the export jobs of a sink, most recent first.
+	ListExportJobs(context.Context, *connect.Request[v1.ListExportJobsRequest]) (*connect.Response[v1.ListExportJobsResponse], error)
+	// CreateConnectivityRule creates a connectivity rule in an organization.
+	CreateConnectivityRule(context.Context, *connect.Request[v1.CreateConnectivityRuleRequest]) (*connect.Response[v1.CreateConnectivityRuleResponse], error)
+	// GetConnectivityRule retrieves a connectivity rule.
+	GetConnectivityRule(context.Context, *connect.Request[v1.GetConnectivityRuleRequest]) (*connect.Response[v1.GetConnectivityRuleResponse], error)
+	// ListConnectivityRules lists the connectivity rules of an organization.
+	ListConnectivityRules(context.Context, *connect.Request[v1.ListConnectivityRulesRequest]) (*connect.Response[v1.ListConnectivityRulesResponse], error)
+	// UpdateConnectivityRule updates a connectivity rule.
+	UpdateConnectivityRule(context.Context, *connect.Request[v1.UpdateConnectivityRuleRequest]) (*connect.Response[v1.UpdateConnectivityRuleResponse], error)
+	// DeleteConnectivityRule deletes a connectivity rule and unbinds it from
+	// its namespaces.
+	DeleteConnectivityRule(context.Context, *connect.Request[v1.DeleteConnectivityRuleRequest]) (*connect.Response[v1.DeleteConnectivityRuleResponse], error)
+	// AddNamespaceConnectivityRule binds a connectivity rule to a namespace.
+	AddNamespaceConnectivityRule(context.Context, *connect.Request[v1.AddNamespaceConnectivityRuleRequest]) (*connect.Response[v1.AddNamespaceConnectivityRuleResponse], error)
+	// RemoveNamespaceConnectivityRule unbinds a connectivity rule from a
+	// namespace.
+	RemoveNamespaceConnectivityRule(context.Context, *connect.Request[v1.RemoveNamespaceConnectivityRuleRequest]) (*connect.Response[v1.RemoveNamespaceConnectivityRuleResponse], error)
+	// ListNamespaceConnectivityRules lists the connectivity rules bound to a
+	// namespace.
+	ListNamespaceConnectivityRules(context.Context, *connect.Request[v1.ListNamespaceConnectivityRulesRequest]) (*connect.Response[v1.ListNamespaceConnectivityRulesResponse], error)
+	// CreateNexusEndpoint creates a Nexus endpoint in an organization. It is
+	// provisioned on the cluster hosting its target namespace asynchronously.
+	CreateNexusEndpoint(context.Context, *connect.Request[v1.CreateNexusEndpointRequest]) (*connect.Response[v1.CreateNexusEndpointResponse], error)
+	// GetNexusEndpoint retrieves a Nexus endpoint.
+	GetNexusEndpoint(context.Context, *connect.Request[v1.GetNexusEndpointRequest]) (*connect.Response[v1.GetNexusEndpointResponse], error)
+	// ListNexusEndpoints lists the Nexus endpoints of an organization.
+	ListNexusEndpoints(context.Context, *connect.Request[v1.ListNexusEndpointsRequest]) (*connect.Response[v1.ListNexusEndpointsResponse], error)
+	// UpdateNexusEndpoint updates a Nexus endpoint.
+	UpdateNexusEndpoint(context.Context, *connect.Request[v1.UpdateNexusEndpointRequest]) (*connect.Response[v1.UpdateNexusEndpointResponse], error)
+	// DeleteNexusEndpoint deletes a Nexus endpoint from its cluster.
+	DeleteNexusEndpoint(context.Context, *connect.Request[v1.DeleteNexusEndpointRequest]) (*connect.Response[v1.DeleteNexusEndpointResponse], error)
+}
+
+// NewNamespaceServiceClient constructs a client for the temporal.cloud.api.v1.NamespaceService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNamespaceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NamespaceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &namespaceServiceClient{
+		createNamespace: connect.NewClient[v1.CreateNamespaceRequest, v1.CreateNamespaceResponse](
+			httpClient,
+			baseURL+NamespaceServiceCreateNamespaceProcedure,
+			connect.WithSchema(namespaceServiceCreateNamespaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getNamespace: connect.NewClient[v1.GetNamespaceRequest, v1.GetNamespaceResponse](
+			httpClient,
+			baseURL+NamespaceServiceGetNamespaceProcedure,
+			connect.WithSchema(namespaceServiceGetNamespaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateNamespace: connect.NewClient[v1.UpdateNamespaceRequest, v1.UpdateNamespaceResponse](
+			httpClient,
+			baseURL+NamespaceServiceUpdateNamespaceProcedure,
+			connect.WithSchema(namespaceServiceUpdateNamespaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNamespace: connect.NewClient[v1.DeleteNamespaceRequest, v1.DeleteNamespaceResponse](
+			httpClient,
+			baseURL+NamespaceServiceDeleteNamespaceProcedure,
+			connect.WithSchema(namespaceServiceDeleteNamespaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listNamespaces: connect.NewClient[v1.ListNamespacesRequest, v1.ListNamespacesResponse](
+			httpClient,
+			baseURL+NamespaceServiceListNamespacesProcedure,
+			connect.WithSchema(namespaceServiceListNamespacesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		addSearchAttributes: connect.NewClient[v1.AddSearchAttributesRequest, v1.AddSearchAttributesResponse](
+			httpClient,
+			baseURL+NamespaceServiceAddSearchAttributesProcedure,
+			connect.WithSchema(namespaceServiceAddSearchAttributesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeSearchAttribute: connect.NewClient[v1.RemoveSearchAttributeRequest, v1.RemoveSearchAttributeResponse](
+			httpClient,
+			baseURL+NamespaceServiceRemoveSearchAttributeProcedure,
+			connect.WithSchema(namespaceServiceRemoveSearchAttributeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		addCertificateFilter: connect.NewClient[v1.AddCertificateFilterRequest, v1.AddCertificateFilterResponse](
+			httpClient,
+			baseURL+NamespaceServiceAddCertificateFilterProcedure,
+			connect.WithSchema(namespaceServiceAddCertificateFilterMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeCertificateFilter: connect.NewClient[v1.RemoveCertificateFilterRequest, v1.RemoveCertificateFilterResponse](
+			httpClient,
+			baseURL+NamespaceServiceRemoveCertificateFilterProcedure,
+			connect.WithSchema(namespaceServiceRemoveCertificateFilterMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		failoverNamespace: connect.NewClient[v1.FailoverNamespaceRequest, v1.FailoverNamespaceResponse](
+			httpClient,
+			baseURL+NamespaceServiceFailoverNamespaceProcedure,
+			connect.WithSchema(namespaceServiceFailoverNamespaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		issueClientCertificate: connect.NewClient[v1.IssueClientCertificateRequest, v1.IssueClientCertificateResponse](
+			httpClient,
+			baseURL+NamespaceServiceIssueClientCertificateProcedure,
+			connect.WithSchema(namespaceServiceIssueClientCertificateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createExportSink: connect.NewClient[v1.CreateExportSinkRequest, v1.CreateExportSinkResponse](
+			httpClient,
+			baseURL+NamespaceServiceCreateExportSinkProcedure,
+			connect.WithSchema(namespaceServiceCreateExportSinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getExportSink: connect.NewClient[v1.GetExportSinkRequest, v1.GetExportSinkResponse](
+			httpClient,
+			baseURL+NamespaceServiceGetExportSinkProcedure,
+			connect.WithSchema(namespaceServiceGetExportSinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listExportSinks: connect.NewClient[v1.ListExportSinksRequest, v1.ListExportSinksResponse](
+			httpClient,
+			baseURL+NamespaceServiceListExportSinksProcedure,
+			connect.WithSchema(namespaceServiceListExportSinksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateExportSink: connect.NewClient[v1.UpdateExportSinkRequest, v1.UpdateExportSinkResponse](
+			httpClient,
+			baseURL+NamespaceServiceUpdateExportSinkProcedure,
+			connect.WithSchema(namespaceServiceUpdateExportSinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteExportSink: connect.NewClient[v1.DeleteExportSinkRequest, v1.DeleteExportSinkResponse](
+			httpClient,
+			baseURL+NamespaceServiceDeleteExportSinkProcedure,
+			connect.WithSchema(namespaceServiceDeleteExportSinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listExportJobs: connect.NewClient[v1.ListExportJobsRequest, v1.ListExportJobsResponse](
+			httpClient,
+			baseURL+NamespaceServiceListExportJobsProcedure,
+			connect.WithSchema(namespaceServiceListExportJobsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createConnectivityRule: connect.NewClient[v1.CreateConnectivityRuleRequest, v1.CreateConnectivityRuleResponse](
+			httpClient,
+			baseURL+NamespaceServiceCreateConnectivityRuleProcedure,
+			connect.WithSchema(namespaceServiceCreateConnectivityRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getConnectivityRule: connect.NewClient[v1.GetConnectivityRuleRequest, v1.GetConnectivityRuleResponse](
+			httpClient,
+			baseURL+NamespaceServiceGetConnectivityRuleProcedure,
+			connect.WithSchema(namespaceServiceGetConnectivityRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listConnectivityRules: connect.NewClient[v1.ListConnectivityRulesRequest, v1.ListConnectivityRulesResponse](
+			httpClient,
+			baseURL+NamespaceServiceListConnectivityRulesProcedure,
+			connect.WithSchema(namespaceServiceListConnectivityRulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateConnectivityRule: connect.NewClient[v1.UpdateConnectivityRuleRequest, v1.UpdateConnectivityRuleResponse](
+			httpClient,
+			baseURL+NamespaceServiceUpdateConnectivityRuleProcedure,
+			connect.WithSchema(namespaceServiceUpdateConnectivityRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteConnectivityRule: connect.NewClient[v1.DeleteConnectivityRuleRequest, v1.DeleteConnectivityRuleResponse](
+			httpClient,
+			baseURL+NamespaceServiceDeleteConnectivityRuleProcedure,
+			connect.WithSchema(namespaceServiceDeleteConnectivityRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		addNamespaceConnectivityRule: connect.NewClient[v1.AddNamespaceConnectivityRuleRequest, v1.AddNamespaceConnectivityRuleResponse](
+			httpClient,
+			baseURL+NamespaceServiceAddNamespaceConnectivityRuleProcedure,
+			connect.WithSchema(namespaceServiceAddNamespaceConnectivityRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeNamespaceConnectivityRule: connect.NewClient[v1.RemoveNamespaceConnectivityRuleRequest, v1.RemoveNamespaceConnectivityRuleResponse](
+			httpClient,
+			baseURL+NamespaceServiceRemoveNamespaceConnectivityRuleProcedure,
+			connect.WithSchema(namespaceServiceRemoveNamespaceConnectivityRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listNamespaceConnectivityRules: connect.NewClient[v1.ListNamespaceConnectivityRulesRequest, v1.ListNamespaceConnectivityRulesResponse](
+			httpClient,
+			baseURL+NamespaceServiceListNamespaceConnectivityRulesProcedure,
+			connect.WithSchema(namespaceServiceListNamespaceConnectivityRulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createNexusEndpoint: connect.NewClient[v1.CreateNexusEndpointRequest, v1.CreateNexusEndpointResponse](
+			httpClient,
+			baseURL+NamespaceServiceCreateNexusEndpointProcedure,
+			connect.WithSchema(namespaceServiceCreateNexusEndpointMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getNexusEndpoint: connect.NewClient[v1.GetNexusEndpointRequest, v1.GetNexusEndpointResponse](
+			httpClient,
+			baseURL+NamespaceServiceGetNexusEndpointProcedure,
+			connect.WithSchema(namespaceServiceGetNexusEndpointMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listNexusEndpoints: connect.NewClient[v1.ListNexusEndpointsRequest, v1.ListNexusEndpointsResponse](
+			httpClient,
+			baseURL+NamespaceServiceListNexusEndpointsProcedure,
+			connect.WithSchema(namespaceServiceListNexusEndpointsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateNexusEndpoint: connect.NewClient[v1.UpdateNexusEndpointRequest, v1.UpdateNexusEndpointResponse](
+			httpClient,
+			baseURL+NamespaceServiceUpdateNexusEndpointProcedure,
+			connect.WithSchema(namespaceServiceUpdateNexusEndpointMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNexusEndpoint: connect.NewClient[v1.DeleteNexusEndpointRequest, v1.DeleteNexusEndpointResponse](
+			httpClient,
+			baseURL+NamespaceServiceDeleteNexusEndpointProcedure,
+			connect.WithSchema(namespaceServiceDeleteNexusEndpointMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// namespaceServiceClient implements NamespaceServiceClient.
+type namespaceServiceClient struct {
+	createNamespace                 *connect.Client[v1.CreateNamespaceRequest, v1.CreateNamespaceResponse]
+	getNamespace                    *connect.Client[v1.GetNamespaceRequest, v1.GetNamespaceResponse]
+	updateNamespace                 *connect.Client[v1.UpdateNamespaceRequest, v1.UpdateNamespaceResponse]
+	deleteNamespace                 *connect.Client[v1.DeleteNamespaceRequest, v1.DeleteNamespaceResponse]
+	listNamespaces                  *connect.Client[v1.ListNamespacesRequest, v1.ListNamespacesResponse]
+	addSearchAttributes             *connect.Client[v1.AddSearchAttributesRequest, v1.AddSearchAttributesResponse]
+	removeSearchAttribute           *connect.Client[v1.RemoveSearchAttributeRequest, v1.RemoveSearchAttributeResponse]
+	addCertificateFilter            *connect.Client[v1.AddCertificateFilterRequest, v1.AddCertificateFilterResponse]
+	removeCertificateFilter         *connect.Client[v1.RemoveCertificateFilterRequest, v1.RemoveCertificateFilterResponse]
+	failoverNamespace               *connect.Client[v1.FailoverNamespaceRequest, v1.FailoverNamespaceResponse]
+	issueClientCertificate          *connect.Client[v1.IssueClientCertificateRequest, v1.IssueClientCertificateResponse]
+	createExportSink                *connect.Client[v1.CreateExportSinkRequest, v1.CreateExportSinkResponse]
+	getExportSink                   *connect.Client[v1.GetExportSinkRequest, v1.GetExportSinkResponse]
+	listExportSinks                 *connect.Client[v1.ListExportSinksRequest, v1.ListExportSinksResponse]
+	updateExportSink                *connect.Client[v1.UpdateExportSinkRequest, v1.UpdateExportSinkResponse]
+	deleteExportSink                *connect.Client[v1.DeleteExportSinkRequest, v1.DeleteExportSinkResponse]
+	listExportJobs                  *connect.Client[v1.ListExportJobsRequest, v1.ListExportJobsResponse]
+	createConnectivityRule          *connect.Client[v1.CreateConnectivityRuleRequest, v1.CreateConnectivityRuleResponse]
+	getConnectivityRule             *connect.Client[v1.GetConnectivityRuleRequest, v1.GetConnectivityRuleResponse]
+	listConnectivityRules           *connect.Client[v1.ListConnectivityRulesRequest, v1.ListConnectivityRulesResponse]
+	updateConnectivityRule          *connect.Client[v1.UpdateConnectivityRuleRequest, v1.UpdateConnectivityRuleResponse]
+	deleteConnectivityRule          *connect.Client[v1.DeleteConnectivityRuleRequest, v1.DeleteConnectivityRuleResponse]
+	addNamespaceConnectivityRule    *connect.Client[v1.AddNamespaceConnectivityRuleRequest, v1.AddNamespaceConnectivityRuleResponse]
+	removeNamespaceConnectivityRule *connect.Client[v1.RemoveNamespaceConnectivityRuleRequest, v1.RemoveNamespaceConnectivityRuleResponse]
+	listNamespaceConnectivityRules  *connect.Client[v1.ListNamespaceConnectivityRulesRequest, v1.ListNamespaceConnectivityRulesResponse]
+	createNexusEndpoint             *connect.Client[v1.CreateNexusEndpointRequest, v1.CreateNexusEndpointResponse]
+	getNexusEndpoint                *connect.Client[v1.GetNexusEndpointRequest, v1.GetNexusEndpointResponse]
+	listNexusEndpoints              *connect.Client[v1.ListNexusEndpointsRequest, v1.ListNexusEndpointsResponse]
+	updateNexusEndpoint             *connect.Client[v1.UpdateNexusEndpointRequest, v1.UpdateNexusEndpointResponse]
+	deleteNexusEndpoint             *connect.Client[v1.DeleteNexusEndpointRequest, v1.DeleteNexusEndpointResponse]
+}
+
+// CreateNamespace calls temporal.cloud.api.v1.NamespaceService.CreateNamespace.
+func (c *namespaceServiceClient) CreateNamespace(ctx context.Context, req *connect.Request[v1.CreateNamespaceRequest]) (*connect.Response[v1.CreateNamespaceResponse], error) {
+	return c.createNamespace.CallUnary(ctx, req)
+}
+
+// GetNamespace calls temporal.cloud.api.v1.NamespaceService.GetNamespace.
+func (c *namespaceServiceClient) GetNamespace(ctx context.Context, req *connect.Request[v1.GetNamespaceRequest]) (*connect.Response[v1.GetNamespaceResponse], error) {
+	return c.getNamespace.CallUnary(ctx, req)
+}
+
+// UpdateNamespace calls temporal.cloud.api.v1.NamespaceService.UpdateNamespace.
+func (c *namespaceServiceClient) UpdateNamespace(ctx context.Context, req *connect.Request[v1.UpdateNamespaceRequest]) (*connect.Response[v1.UpdateNamespaceResponse], error) {
+	return c.updateNamespace.CallUnary(ctx, req)
+}
+
+// DeleteNamespace calls temporal.cloud.api.v1.NamespaceService.DeleteNamespace.
+func (c *namespaceServiceClient) DeleteNamespace(ctx context.Context, req *connect.Request[v1.DeleteNamespaceRequest]) (*connect.Response[v1.DeleteNamespaceResponse], error) {
+	return c.deleteNamespace.CallUnary(ctx, req)
+}
+
+// ListNamespaces calls temporal.cloud.api.v1.NamespaceService.ListNamespaces.
+func (c *namespaceServiceClient) ListNamespaces(ctx context.Context, req *connect.Request[v1.ListNamespacesRequest]) (*connect.Response[v1.ListNamespacesResponse], error) {
+	return c.listNamespaces.CallUnary(ctx, req)
+}
+
+// AddSearchAttributes calls temporal.cloud.api.v1.NamespaceService.AddSearchAttributes.
+func (c *namespaceServiceClient) AddSearchAttributes(ctx context.Context, req *connect.Request[v1.AddSearchAttributesRequest]) (*connect.Response[v1.AddSearchAttributesResponse], error) {
+	return c.addSearchAttributes.CallUnary(ctx, req)
+}
+
+// RemoveSearchAttribute calls temporal.cloud.api.v1.NamespaceService.RemoveSearchAttribute.
+func (c *namespaceServiceClient) RemoveSearchAttribute(ctx context.Context, req *connect.Request[v1.RemoveSearchAttributeRequest]) (*connect.Response[v1.RemoveSearchAttributeResponse], error) {
+	return c.removeSearchAttribute.CallUnary(ctx, req)
+}
+
+// AddCertificateFilter calls temporal.cloud.api.v1.NamespaceService.AddCertificateFilter.
+func (c *namespaceServiceClient) AddCertificateFilter(ctx context.Context, req *connect.Request[v1.AddCertificateFilterRequest]) (*connect.Response[v1.AddCertificateFilterResponse], error) {
+	return c.addCertificateFilter.CallUnary(ctx, req)
+}
+
+// RemoveCertificateFilter calls temporal.cloud.api.v1.NamespaceService.RemoveCertificateFilter.
+func (c *namespaceServiceClient) RemoveCertificateFilter(ctx context.Context, req *connect.Request[v1.RemoveCertificateFilterRequest]) (*connect.Response[v1.RemoveCertificateFilterResponse], error) {
+	return c.removeCertificateFilter.CallUnary(ctx, req)
+}
+
+// FailoverNamespace calls temporal.cloud.api.v1.NamespaceService.FailoverNamespace.
+func (c *namespaceServiceClient) FailoverNamespace(ctx context.Context, req *connect.Request[v1.FailoverNamespaceRequest]) (*connect.Response[v1.FailoverNamespaceResponse], error) {
+	return c.failoverNamespace.CallUnary(ctx, req)
+}
+
+// IssueClientCertificate calls temporal.cloud.api.v1.NamespaceService.IssueClientCertificate.
+func (c *namespaceServiceClient) IssueClientCertificate(ctx context.Context, req *connect.Request[v1.IssueClientCertificateRequest]) (*connect.Response[v1.IssueClientCertificateResponse], error) {
+	return c.issueClientCertificate.CallUnary(ctx, req)
+}
+
+// CreateExportSink calls temporal.cloud.api.v1.NamespaceService.CreateExportSink.
+func (c *namespaceServiceClient) CreateExportSink(ctx context.Context, req *connect.Request[v1.CreateExportSinkRequest]) (*connect.Response[v1.CreateExportSinkResponse], error) {
+	return c.createExportSink.CallUnary(ctx, req)
+}
+
+// GetExportSink calls temporal.cloud.api.v1.NamespaceService.GetExportSink.
+func (c *namespaceServiceClient) GetExportSink(ctx context.Context, req *connect.Request[v1.GetExportSinkRequest]) (*connect.Response[v1.GetExportSinkResponse], error) {
+	return c.getExportSink.CallUnary(ctx, req)
+}
+
+// ListExportSinks calls temporal.cloud.api.v1.NamespaceService.ListExportSinks.
+func (c *namespaceServiceClient) ListExportSinks(ctx context.Context, req *connect.Request[v1.ListExportSinksRequest]) (*connect.Response[v1.ListExportSinksResponse], error) {
+	return c.listExportSinks.CallUnary(ctx, req)
+}
+
+// UpdateExportSink calls temporal.cloud.api.v1.NamespaceService.UpdateExportSink.
+func (c *namespaceServiceClient) UpdateExportSink(ctx context.Context, req *connect.Request[v1.UpdateExportSinkRequest]) (*connect.Response[v1.UpdateExportSinkResponse], error) {
+	return c.updateExportSink.CallUnary(ctx, req)
+}
+
+// DeleteExportSink calls temporal.cloud.api.v1.NamespaceService.DeleteExportSink.
+func (c *namespaceServiceClient) DeleteExportSink(ctx context.Context, req *connect.Request[v1.DeleteExportSinkRequest]) (*connect.Response[v1.DeleteExportSinkResponse], error) {
+	return c.deleteExportSink.CallUnary(ctx, req)
+}
+
+// ListExportJobs calls temporal.cloud.api.v1.NamespaceService.ListExportJobs.
+func (c *namespaceServiceClient) ListExportJobs(ctx context.Context, req *connect.Request[v1.ListExportJobsRequest]) (*connect.Response[v1.ListExportJobsResponse], error) {
+	return c.listExportJobs.CallUnary(ctx, req)
+}
+
+// CreateConnectivityRule calls temporal.cloud.api.v1.NamespaceService.CreateConnectivityRule.
+func (c *namespaceServiceClient) CreateConnectivityRule(ctx context.Context, req *connect.Request[v1.CreateConnectivityRuleRequest]) (*connect.Response[v1.CreateConnectivityRuleResponse], error) {
+	return c.createConnectivityRule.CallUnary(ctx, req)
+}
+
+// GetConnectivityRule calls temporal.cloud.api.v1.NamespaceService.GetConnectivityRule.
+func (c *namespaceServiceClient) GetConnectivityRule(ctx context.Context, req *connect.Request[v1.GetConnectivityRuleRequest]) (*connect.Response[v1.GetConnectivityRuleResponse], error) {
+	return c.getConnectivityRule.CallUnary(ctx, req)
+}
+
+// ListConnectivityRules calls temporal.cloud.api.v1.NamespaceService.ListConnectivityRules.
+func (c *namespaceServiceClient) ListConnectivityRules(ctx context.Context, req *connect.Request[v1.ListConnectivityRulesRequest]) (*connect.Response[v1.ListConnectivityRulesResponse], error) {
+	return c.listConnectivityRules.CallUnary(ctx, req)
+}
+
+// UpdateConnectivityRule calls temporal.cloud.api.v1.NamespaceService.UpdateConnectivityRule.
+func (c *namespaceServiceClient) UpdateConnectivityRule(ctx context.Context, req *connect.Request[v1.UpdateConnectivityRuleRequest]) (*connect.Response[v1.UpdateConnectivityRuleResponse], error) {
+	return c.updateConnectivityRule.CallUnary(ctx, req)
+}
+
+// DeleteConnectivityRule calls temporal.cloud.api.v1.NamespaceService.DeleteConnectivityRule.
+func (c *namespaceServiceClient) DeleteConnectivityRule(ctx context.Context, req *connect.Request[v1.DeleteConnectivityRuleRequest]) (*connect.Response[v1.DeleteConnectivityRuleResponse], error) {
+	return c.deleteConnectivityRule.CallUnary(ctx, req)
+}
+
+// AddNamespaceConnectivityRule calls
+// temporal.cloud.api.v1.NamespaceService.AddNamespaceConnectivityRule.
+func (c *namespaceServiceClient) AddNamespaceConnectivityRule(ctx context.Context, req *connect.Request[v1.AddNamespaceConnectivityRuleRequest]) (*connect.Response[v1.AddNamespaceConnectivityRuleResponse], error) {
+	return c.addNamespaceConnectivityRule.CallUnary(ctx, req)
+}
+
+// RemoveNamespaceConnectivityRule calls
+// temporal.cloud.api.v1.NamespaceService.RemoveNamespaceConnectivityRule.
+func (c *namespaceServiceClient) RemoveNamespaceConnectivityRule(ctx context.Context, req *connect.Request[v1.RemoveNamespaceConnectivityRuleRequest]) (*connect.Response[v1.RemoveNamespaceConnectivityRuleResponse], error) {
+	return c.removeNamespaceConnectivityRule.CallUnary(ctx, req)
+}
+
+// ListNamespaceConnectivityRules calls
+// temporal.cloud.api.v1.NamespaceService.ListNamespaceConnectivityRules.
+func (c *namespaceServiceClient) ListNamespaceConnectivityRules(ctx context.Context, req *connect.Request[v1.ListNamespaceConnectivityRulesRequest]) (*connect.Response[v1.ListNamespaceConnectivityRulesResponse], error) {
+	return c.listNamespaceConnectivityRules.CallUnary(ctx, req)
+}
+
+// CreateNexusEndpoint calls temporal.cloud.api.v1.NamespaceService.CreateNexusEndpoint.
+func (c *namespaceServiceClient) CreateNexusEndpoint(ctx context.Context, req *connect.Request[v1.CreateNexusEndpointRequest]) (*connect.Response[v1.CreateNexusEndpointResponse], error) {
+	return c.createNexusEndpoint.CallUnary(ctx, req)
+}
+
+// GetNexusEndpoint calls temporal.cloud.api.v1.NamespaceService.GetNexusEndpoint.
+func (c *namespaceServiceClient) GetNexusEndpoint(ctx context.Context, req *connect.Request[v1.GetNexusEndpointRequest]) (*connect.Response[v1.GetNexusEndpointResponse], error) {
+	return c.getNexusEndpoint.CallUnary(ctx, req)
+}
+
+// ListNexusEndpoints calls temporal.cloud.api.v1.NamespaceService.ListNexusEndpoints.
+func (c *namespaceServiceClient) ListNexusEndpoints(ctx context.Context, req *connect.Request[v1.ListNexusEndpointsRequest]) (*connect.Response[v1.ListNexusEndpointsResponse], error) {
+	return c.listNexusEndpoints.CallUnary(ctx, req)
+}
+
+// UpdateNexusEndpoint calls temporal.cloud.api.v1.NamespaceService.UpdateNexusEndpoint.
+func (c *namespaceServiceClient) UpdateNexusEndpoint(ctx context.Context, req *connect.Request[v1.UpdateNexusEndpointRequest]) (*connect.Response[v1.UpdateNexusEndpointResponse], error) {
+	return c.updateNexusEndpoint.CallUnary(ctx, req)
+}
+
+// DeleteNexusEndpoint calls temporal.cloud.api.v1.NamespaceService.DeleteNexusEndpoint.
+func (c *namespaceServiceClient) DeleteNexusEndpoint(ctx context.Context, req *connect.Request[v1.DeleteNexusEndpointRequest]) (*connect.Response[v1.DeleteNexusEndpointResponse], error) {
+	return c.deleteNexusEndpoint.CallUnary(ctx, req)
+}
+
+// NamespaceServiceHandler is an implementation of the temporal.cloud.api.v1.NamespaceService
+// service.
+type NamespaceServiceHandler interface {
+	// CreateNamespace creates a new namespace.
+	CreateNamespace(context.Context, *connect.Request[v1.CreateNamespaceRequest]) (*connect.Response[v1.CreateNamespaceResponse], error)
+	// GetNamespace retrieves a namespace by ID.
+	GetNamespace(context.Context, *connect.Request[v1.GetNamespaceRequest]) (*connect.Response[v1.GetNamespaceResponse], error)
+	// UpdateNamespace updates a namespace.
+	UpdateNamespace(context.Context, *connect.Request[v1.UpdateNamespaceRequest]) (*connect.Response[v1.UpdateNamespaceResponse], error)
+	// DeleteNamespace deletes a namespace.
+	DeleteNamespace(context.Context, *connect.Request[v1.DeleteNamespaceRequest]) (*connect.Response[v1.DeleteNamespaceResponse], error)
+	// ListNamespaces lists namespaces in an organization.
+	ListNamespaces(context.Context, *connect.Request[v1.ListNamespacesRequest]) (*connect.Response[v1.ListNamespacesResponse], error)
+	// AddSearchAttributes adds custom search attributes to a namespace.
+	AddSearchAttributes(context.Context, *connect.Request[v1.AddSearchAttributesRequest]) (*connect.Response[v1.AddSearchAttributesResponse], error)
+	// RemoveSearchAttribute removes a custom search attribute from a namespace.
+	RemoveSearchAttribute(context.Context, *connect.Request[v1.RemoveSearchAttributeRequest]) (*connect.Response[v1.RemoveSearchAttributeResponse], error)
+	// AddCertificateFilter adds a certificate filter to a namespace.
+	AddCertificateFilter(context.Context, *connect.Request[v1.AddCertificateFilterRequest]) (*connect.Response[v1.AddCertificateFilterResponse], error)
+	// RemoveCertificateFilter removes a certificate filter from a namespace.
+	RemoveCertificateFilter(context.Context, *connect.Request[v1.RemoveCertificateFilterRequest]) (*connect.Response[v1.RemoveCertificateFilterResponse], error)
+	// FailoverNamespace initiates a failover to the standby region.
+	FailoverNamespace(context.Context, *connect.Request[v1.FailoverNamespaceRequest]) (*connect.Response[v1.FailoverNamespaceResponse], error)
+	// IssueClientCertificate issues a client certificate signed by the
+	// namespace's CA. The private key is returned once and is not stored.
+	IssueClientCertificate(context.Context, *connect.Request[v1.IssueClientCertificateRequest]) (*connect.Response[v1.IssueClientCertificateResponse], error)
+	// CreateExportSink adds a sink the namespace's workflow histories are
+	// exported to.
+	CreateExportSink(context.Context, *connect.Request[v1.CreateExportSinkRequest]) (*connect.Response[v1.CreateExportSinkResponse], error)
+	// GetExportSink retrieves an export sink.
+	GetExportSink(context.Context, *connect.Request[v1.GetExportSinkRequest]) (*connect.Response[v1.GetExportSinkResponse], error)
+	// ListExportSinks lists the export sinks of a namespace.
+	ListExportSinks(context.Context, *connect.Request[v1.ListExportSinksRequest]) (*connect.Response[v1.ListExportSinksResponse], error)
+	// UpdateExportSink updates an export sink.
+	UpdateExportSink(context.Context, *connect.Request[v1.UpdateExportSinkRequest]) (*connect.Response[v1.UpdateExportSinkResponse], error)
+	// DeleteExportSink deletes an export sink and its job history.
+	DeleteExportSink(context.Context, *connect.Request[v1.DeleteExportSinkRequest]) (*connect.Response[v1.DeleteExportSinkResponse], error)
+	// ListExportJobs lists the export jobs of a sink, most recent first.
+	ListExportJobs(context.Context, *connect.Request[v1.ListExportJobsRequest]) (*connect.Response[v1.ListExportJobsResponse], error)
+	// CreateConnectivityRule creates a connectivity rule in an organization.
+	CreateConnectivityRule(context.Context, *connect.Request[v1.CreateConnectivityRuleRequest]) (*connect.Response[v1.CreateConnectivityRuleResponse], error)
+	// GetConnectivityRule retrieves a connectivity rule.
+	GetConnectivityRule(context.Context, *connect.Request[v1.GetConnectivityRuleRequest]) (*connect.Response[v1.GetConnectivityRuleResponse], error)
+	// ListConnectivityRules lists the connectivity rules of an organization.
+	ListConnectivityRules(context.Context, *connect.Request[v1.ListConnectivityRulesRequest]) (*connect.Response[v1.ListConnectivityRulesResponse], error)
+	// UpdateConnectivityRule updates a connectivity rule.
+	UpdateConnectivityRule(context.Context, *connect.Request[v1.UpdateConnectivityRuleRequest]) (*connect.Response[v1.UpdateConnectivityRuleResponse], error)
+	// DeleteConnectivityRule deletes a connectivity rule and unbinds it from
+	// its namespaces.
+	DeleteConnectivityRule(context.Context, *connect.Request[v1.DeleteConnectivityRuleRequest]) (*connect.Response[v1.DeleteConnectivityRuleResponse], error)
+	// AddNamespaceConnectivityRule binds a connectivity rule to a namespace.
+	AddNamespaceConnectivityRule(context.Context, *connect.Request[v1.AddNamespaceConnectivityRuleRequest]) (*connect.Response[v1.AddNamespaceConnectivityRuleResponse], error)
+	// RemoveNamespaceConnectivityRule unbinds a connectivity rule from a
+	// namespace.
+	RemoveNamespaceConnectivityRule(context.Context, *connect.Request[v1.RemoveNamespaceConnectivityRuleRequest]) (*connect.Response[v1.RemoveNamespaceConnectivityRuleResponse], error)
+	// ListNamespaceConnectivityRules lists the connectivity rules bound to a
+	// namespace.
+	ListNamespaceConnectivityRules(context.Context, *connect.Request[v1.ListNamespaceConnectivityRulesRequest]) (*connect.Response[v1.ListNamespaceConnectivityRulesResponse], error)
+	// CreateNexusEndpoint creates a Nexus endpoint in an organization. It is
+	// provisioned on the cluster hosting its target namespace asynchronously.
+	CreateNexusEndpoint(context.Context, *connect.Request[v1.CreateNexusEndpointRequest]) (*connect.Response[v1.CreateNexusEndpointResponse], error)
+	// GetNexusEndpoint retrieves a Nexus endpoint.
+	GetNexusEndpoint(context.Context, *connect.Request[v1.GetNexusEndpointRequest]) (*connect.Response[v1.GetNexusEndpointResponse], error)
+	// ListNexusEndpoints lists the Nexus endpoints of an organization.
+	ListNexusEndpoints(context.Context, *connect.Request[v1.ListNexusEndpointsRequest]) (*connect.Response[v1.ListNexusEndpointsResponse], error)
+	// UpdateNexusEndpoint updates a Nexus endpoint.
+	UpdateNexusEndpoint(context.Context, *connect.Request[v1.UpdateNexusEndpointRequest]) (*connect.Response[v1.UpdateNexusEndpointResponse], error)
+	// DeleteNexusEndpoint deletes a Nexus endpoint from its cluster.
+	DeleteNexusEndpoint(context.Context, *connect.Request[v1.DeleteNexusEndpointRequest]) (*connect.Response[v1.DeleteNexusEndpointResponse], error)
+}
+
+// NewNamespaceServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNamespaceServiceHandler(svc NamespaceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	namespaceServiceCreateNamespaceHandler := connect.NewUnaryHandler(
+		NamespaceServiceCreateNamespaceProcedure,
+		svc.CreateNamespace,
+		connect.WithSchema(namespaceServiceCreateNamespaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceGetNamespaceHandler := connect.NewUnaryHandler(
+		NamespaceServiceGetNamespaceProcedure,
+		svc.GetNamespace,
+		connect.WithSchema(namespaceServiceGetNamespaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceUpdateNamespaceHandler := connect.NewUnaryHandler(
+		NamespaceServiceUpdateNamespaceProcedure,
+		svc.UpdateNamespace,
+		connect.WithSchema(namespaceServiceUpdateNamespaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceDeleteNamespaceHandler := connect.NewUnaryHandler(
+		NamespaceServiceDeleteNamespaceProcedure,
+		svc.DeleteNamespace,
+		connect.WithSchema(namespaceServiceDeleteNamespaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceListNamespacesHandler := connect.NewUnaryHandler(
+		NamespaceServiceListNamespacesProcedure,
+		svc.ListNamespaces,
+		connect.WithSchema(namespaceServiceListNamespacesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceAddSearchAttributesHandler := connect.NewUnaryHandler(
+		NamespaceServiceAddSearchAttributesProcedure,
+		svc.AddSearchAttributes,
+		connect.WithSchema(namespaceServiceAddSearchAttributesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceRemoveSearchAttributeHandler := connect.NewUnaryHandler(
+		NamespaceServiceRemoveSearchAttributeProcedure,
+		svc.RemoveSearchAttribute,
+		connect.WithSchema(namespaceServiceRemoveSearchAttributeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceAddCertificateFilterHandler := connect.NewUnaryHandler(
+		NamespaceServiceAddCertificateFilterProcedure,
+		svc.AddCertificateFilter,
+		connect.WithSchema(namespaceServiceAddCertificateFilterMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceRemoveCertificateFilterHandler := connect.NewUnaryHandler(
+		NamespaceServiceRemoveCertificateFilterProcedure,
+		svc.RemoveCertificateFilter,
+		connect.WithSchema(namespaceServiceRemoveCertificateFilterMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceFailoverNamespaceHandler := connect.NewUnaryHandler(
+		NamespaceServiceFailoverNamespaceProcedure,
+		svc.FailoverNamespace,
+		connect.WithSchema(namespaceServiceFailoverNamespaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceIssueClientCertificateHandler := connect.NewUnaryHandler(
+		NamespaceServiceIssueClientCertificateProcedure,
+		svc.IssueClientCertificate,
+		connect.WithSchema(namespaceServiceIssueClientCertificateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceCreateExportSinkHandler := connect.NewUnaryHandler(
+		NamespaceServiceCreateExportSinkProcedure,
+		svc.CreateExportSink,
+		connect.WithSchema(namespaceServiceCreateExportSinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceGetExportSinkHandler := connect.NewUnaryHandler(
+		NamespaceServiceGetExportSinkProcedure,
+		svc.GetExportSink,
+		connect.WithSchema(namespaceServiceGetExportSinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceListExportSinksHandler := connect.NewUnaryHandler(
+		NamespaceServiceListExportSinksProcedure,
+		svc.ListExportSinks,
+		connect.WithSchema(namespaceServiceListExportSinksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceUpdateExportSinkHandler := connect.NewUnaryHandler(
+		NamespaceServiceUpdateExportSinkProcedure,
+		svc.UpdateExportSink,
+		connect.WithSchema(namespaceServiceUpdateExportSinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceDeleteExportSinkHandler := connect.NewUnaryHandler(
+		NamespaceServiceDeleteExportSinkProcedure,
+		svc.DeleteExportSink,
+		connect.WithSchema(namespaceServiceDeleteExportSinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceListExportJobsHandler := connect.NewUnaryHandler(
+		NamespaceServiceListExportJobsProcedure,
+		svc.ListExportJobs,
+		connect.WithSchema(namespaceServiceListExportJobsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceCreateConnectivityRuleHandler := connect.NewUnaryHandler(
+		NamespaceServiceCreateConnectivityRuleProcedure,
+		svc.CreateConnectivityRule,
+		connect.WithSchema(namespaceServiceCreateConnectivityRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceGetConnectivityRuleHandler := connect.NewUnaryHandler(
+		NamespaceServiceGetConnectivityRuleProcedure,
+		svc.GetConnectivityRule,
+		connect.WithSchema(namespaceServiceGetConnectivityRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceListConnectivityRulesHandler := connect.NewUnaryHandler(
+		NamespaceServiceListConnectivityRulesProcedure,
+		svc.ListConnectivityRules,
+		connect.WithSchema(namespaceServiceListConnectivityRulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceUpdateConnectivityRuleHandler := connect.NewUnaryHandler(
+		NamespaceServiceUpdateConnectivityRuleProcedure,
+		svc.UpdateConnectivityRule,
+		connect.WithSchema(namespaceServiceUpdateConnectivityRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceDeleteConnectivityRuleHandler := connect.NewUnaryHandler(
+		NamespaceServiceDeleteConnectivityRuleProcedure,
+		svc.DeleteConnectivityRule,
+		connect.WithSchema(namespaceServiceDeleteConnectivityRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceAddNamespaceConnectivityRuleHandler := connect.NewUnaryHandler(
+		NamespaceServiceAddNamespaceConnectivityRuleProcedure,
+		svc.AddNamespaceConnectivityRule,
+		connect.WithSchema(namespaceServiceAddNamespaceConnectivityRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceRemoveNamespaceConnectivityRuleHandler := connect.NewUnaryHandler(
+		NamespaceServiceRemoveNamespaceConnectivityRuleProcedure,
+		svc.RemoveNamespaceConnectivityRule,
+		connect.WithSchema(namespaceServiceRemoveNamespaceConnectivityRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceListNamespaceConnectivityRulesHandler := connect.NewUnaryHandler(
+		NamespaceServiceListNamespaceConnectivityRulesProcedure,
+		svc.ListNamespaceConnectivityRules,
+		connect.WithSchema(namespaceServiceListNamespaceConnectivityRulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceCreateNexusEndpointHandler := connect.NewUnaryHandler(
+		NamespaceServiceCreateNexusEndpointProcedure,
+		svc.CreateNexusEndpoint,
+		connect.WithSchema(namespaceServiceCreateNexusEndpointMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceGetNexusEndpointHandler := connect.NewUnaryHandler(
+		NamespaceServiceGetNexusEndpointProcedure,
+		svc.GetNexusEndpoint,
+		connect.WithSchema(namespaceServiceGetNexusEndpointMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceListNexusEndpointsHandler := connect.NewUnaryHandler(
+		NamespaceServiceListNexusEndpointsProcedure,
+		svc.ListNexusEndpoints,
+		connect.WithSchema(namespaceServiceListNexusEndpointsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceUpdateNexusEndpointHandler := connect.NewUnaryHandler(
+		NamespaceServiceUpdateNexusEndpointProcedure,
+		svc.UpdateNexusEndpoint,
+		connect.WithSchema(namespaceServiceUpdateNexusEndpointMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	namespaceServiceDeleteNexusEndpointHandler := connect.NewUnaryHandler(
+		NamespaceServiceDeleteNexusEndpointProcedure,
+		svc.DeleteNexusEndpoint,
+		connect.WithSchema(namespaceServiceDeleteNexusEndpointMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/temporal.cloud.api.v1.NamespaceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NamespaceServiceCreateNamespaceProcedure:
+			namespaceServiceCreateNamespaceHandler.ServeHTTP(w, r)
+		case NamespaceServiceGetNamespaceProcedure:
+			namespaceServiceGetNamespaceHandler.ServeHTTP(w, r)
+		case NamespaceServiceUpdateNamespaceProcedure:
+			namespaceServiceUpdateNamespaceHandler.ServeHTTP(w, r)
+		case NamespaceServiceDeleteNamespaceProcedure:
+			namespaceServiceDeleteNamespaceHandler.ServeHTTP(w, r)
+		case NamespaceServiceListNamespacesProcedure:
+			namespaceServiceListNamespacesHandler.ServeHTTP(w, r)
+		case NamespaceServiceAddSearchAttributesProcedure:
+			namespaceServiceAddSearchAttributesHandler.ServeHTTP(w, r)
+		case NamespaceServiceRemoveSearchAttributeProcedure:
+			namespaceServiceRemoveSearchAttributeHandler.ServeHTTP(w, r)
+		case NamespaceServiceAddCertificateFilterProcedure:
+			namespaceServiceAddCertificateFilterHandler.ServeHTTP(w, r)
+		case NamespaceServiceRemoveCertificateFilterProcedure:
+			namespaceServiceRemoveCertificateFilterHandler.ServeHTTP(w, r)
+		case NamespaceServiceFailoverNamespaceProcedure:
+			namespaceServiceFailoverNamespaceHandler.ServeHTTP(w, r)
+		case NamespaceServiceIssueClientCertificateProcedure:
+			namespaceServiceIssueClientCertificateHandler.ServeHTTP(w, r)
+		case NamespaceServiceCreateExportSinkProcedure:
+			namespaceServiceCreateExportSinkHandler.ServeHTTP(w, r)
+		case NamespaceServiceGetExportSinkProcedure:
+			namespaceServiceGetExportSinkHandler.ServeHTTP(w, r)
+		case NamespaceServiceListExportSinksProcedure:
+			namespaceServiceListExportSinksHandler.ServeHTTP(w, r)
+		case NamespaceServiceUpdateExportSinkProcedure:
+			namespaceServiceUpdateExportSinkHandler.ServeHTTP(w, r)
+		case NamespaceServiceDeleteExportSinkProcedure:
+			namespaceServiceDeleteExportSinkHandler.ServeHTTP(w, r)
+		case NamespaceServiceListExportJobsProcedure:
+			namespaceServiceListExportJobsHandler.ServeHTTP(w, r)
+		case NamespaceServiceCreateConnectivityRuleProcedure:
+			namespaceServiceCreateConnectivityRuleHandler.ServeHTTP(w, r)
+		case NamespaceServiceGetConnectivityRuleProcedure:
+			namespaceServiceGetConnectivityRuleHandler.ServeHTTP(w, r)
+		case NamespaceServiceListConnectivityRulesProcedure:
+			namespaceServiceListConnectivityRulesHandler.ServeHTTP(w, r)
+		case NamespaceServiceUpdateConnectivityRuleProcedure:
+			namespaceServiceUpdateConnectivityRuleHandler.ServeHTTP(w, r)
+		case NamespaceServiceDeleteConnectivityRuleProcedure:
+			namespaceServiceDeleteConnectivityRuleHandler.ServeHTTP(w, r)
+		case NamespaceServiceAddNamespaceConnectivityRuleProcedure:
+			namespaceServiceAddNamespaceConnectivityRuleHandler.ServeHTTP(w, r)
+		case NamespaceServiceRemoveNamespaceConnectivityRuleProcedure:
+			namespaceServiceRemoveNamespaceConnectivityRuleHandler.ServeHTTP(w, r)
+		case NamespaceServiceListNamespaceConnectivityRulesProcedure:
+			namespaceServiceListNamespaceConnectivityRulesHandler.ServeHTTP(w, r)
+		case NamespaceServiceCreateNexusEndpointProcedure:
+			namespaceServiceCreateNexusEndpointHandler.ServeHTTP(w, r)
+		case NamespaceServiceGetNexusEndpointProcedure:
+			namespaceServiceGetNexusEndpointHandler.ServeHTTP(w, r)
+		case NamespaceServiceListNexusEndpointsProcedure:
+			namespaceServiceListNexusEndpointsHandler.ServeHTTP(w, r)
+		case NamespaceServiceUpdateNexusEndpointProcedure:
+			namespaceServiceUpdateNexusEndpointHandler.ServeHTTP(w, r)
+		case NamespaceServiceDeleteNexusEndpointProcedure:
+			namespaceServiceDeleteNexusEndpointHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNamespaceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNamespaceServiceHandler struct{}
+
+func (UnimplementedNamespaceServiceHandler) CreateNamespace(context.Context, *connect.Request[v1.CreateNamespaceRequest]) (*connect.Response[v1.CreateNamespaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.CreateNamespace is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) GetNamespace(context.Context, *connect.Request[v1.GetNamespaceRequest]) (*connect.Response[v1.GetNamespaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.GetNamespace is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) UpdateNamespace(context.Context, *connect.Request[v1.UpdateNamespaceRequest]) (*connect.Response[v1.UpdateNamespaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.UpdateNamespace is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) DeleteNamespace(context.Context, *connect.Request[v1.DeleteNamespaceRequest]) (*connect.Response[v1.DeleteNamespaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.DeleteNamespace is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) ListNamespaces(context.Context, *connect.Request[v1.ListNamespacesRequest]) (*connect.Response[v1.ListNamespacesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.ListNamespaces is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) AddSearchAttributes(context.Context, *connect.Request[v1.AddSearchAttributesRequest]) (*connect.Response[v1.AddSearchAttributesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.AddSearchAttributes is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) RemoveSearchAttribute(context.Context, *connect.Request[v1.RemoveSearchAttributeRequest]) (*connect.Response[v1.RemoveSearchAttributeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.RemoveSearchAttribute is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) AddCertificateFilter(context.Context, *connect.Request[v1.AddCertificateFilterRequest]) (*connect.Response[v1.AddCertificateFilterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.AddCertificateFilter is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) RemoveCertificateFilter(context.Context, *connect.Request[v1.RemoveCertificateFilterRequest]) (*connect.Response[v1.RemoveCertificateFilterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.RemoveCertificateFilter is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) FailoverNamespace(context.Context, *connect.Request[v1.FailoverNamespaceRequest]) (*connect.Response[v1.FailoverNamespaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.FailoverNamespace is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) IssueClientCertificate(context.Context, *connect.Request[v1.IssueClientCertificateRequest]) (*connect.Response[v1.IssueClientCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.IssueClientCertificate is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) CreateExportSink(context.Context, *connect.Request[v1.CreateExportSinkRequest]) (*connect.Response[v1.CreateExportSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.CreateExportSink is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) GetExportSink(context.Context, *connect.Request[v1.GetExportSinkRequest]) (*connect.Response[v1.GetExportSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.GetExportSink is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) ListExportSinks(context.Context, *connect.Request[v1.ListExportSinksRequest]) (*connect.Response[v1.ListExportSinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.ListExportSinks is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) UpdateExportSink(context.Context, *connect.Request[v1.UpdateExportSinkRequest]) (*connect.Response[v1.UpdateExportSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.UpdateExportSink is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) DeleteExportSink(context.Context, *connect.Request[v1.DeleteExportSinkRequest]) (*connect.Response[v1.DeleteExportSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.DeleteExportSink is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) ListExportJobs(context.Context, *connect.Request[v1.ListExportJobsRequest]) (*connect.Response[v1.ListExportJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.ListExportJobs is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) CreateConnectivityRule(context.Context, *connect.Request[v1.CreateConnectivityRuleRequest]) (*connect.Response[v1.CreateConnectivityRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.CreateConnectivityRule is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) GetConnectivityRule(context.Context, *connect.Request[v1.GetConnectivityRuleRequest]) (*connect.Response[v1.GetConnectivityRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.GetConnectivityRule is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) ListConnectivityRules(context.Context, *connect.Request[v1.ListConnectivityRulesRequest]) (*connect.Response[v1.ListConnectivityRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.ListConnectivityRules is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) UpdateConnectivityRule(context.Context, *connect.Request[v1.UpdateConnectivityRuleRequest]) (*connect.Response[v1.UpdateConnectivityRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.UpdateConnectivityRule is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) DeleteConnectivityRule(context.Context, *connect.Request[v1.DeleteConnectivityRuleRequest]) (*connect.Response[v1.DeleteConnectivityRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.DeleteConnectivityRule is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) AddNamespaceConnectivityRule(context.Context, *connect.Request[v1.AddNamespaceConnectivityRuleRequest]) (*connect.Response[v1.AddNamespaceConnectivityRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.AddNamespaceConnectivityRule is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) RemoveNamespaceConnectivityRule(context.Context, *connect.Request[v1.RemoveNamespaceConnectivityRuleRequest]) (*connect.Response[v1.RemoveNamespaceConnectivityRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.RemoveNamespaceConnectivityRule is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) ListNamespaceConnectivityRules(context.Context, *connect.Request[v1.ListNamespaceConnectivityRulesRequest]) (*connect.Response[v1.ListNamespaceConnectivityRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.ListNamespaceConnectivityRules is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) CreateNexusEndpoint(context.Context, *connect.Request[v1.CreateNexusEndpointRequest]) (*connect.Response[v1.CreateNexusEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.CreateNexusEndpoint is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) GetNexusEndpoint(context.Context, *connect.Request[v1.GetNexusEndpointRequest]) (*connect.Response[v1.GetNexusEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.GetNexusEndpoint is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) ListNexusEndpoints(context.Context, *connect.Request[v1.ListNexusEndpointsRequest]) (*connect.Response[v1.ListNexusEndpointsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.ListNexusEndpoints is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) UpdateNexusEndpoint(context.Context, *connect.Request[v1.UpdateNexusEndpointRequest]) (*connect.Response[v1.UpdateNexusEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.UpdateNexusEndpoint is not implemented"))
+}
+
+func (UnimplementedNamespaceServiceHandler) DeleteNexusEndpoint(context.Context, *connect.Request[v1.DeleteNexusEndpointRequest]) (*connect.Response[v1.DeleteNexusEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.NamespaceService.DeleteNexusEndpoint is not implemented"))
+}
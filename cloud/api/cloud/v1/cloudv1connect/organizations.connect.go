@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cloud/v1/organizations.proto
+
+package cloudv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.temporal.io/cloud/api/cloud/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OrganizationServiceName is the fully-qualified name of the OrganizationService service.
+	OrganizationServiceName = "temporal.cloud.api.v1.OrganizationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OrganizationServiceCreateOrganizationProcedure is the fully-qualified name of the
+	// OrganizationService's CreateOrganization RPC.
+	OrganizationServiceCreateOrganizationProcedure = "/temporal.cloud.api.v1.OrganizationService/CreateOrganization"
+	// OrganizationServiceGetOrganizationProcedure is the fully-qualified name of the
+	// OrganizationService's GetOrganization RPC.
+	OrganizationServiceGetOrganizationProcedure = "/temporal.cloud.api.v1.OrganizationService/GetOrganization"
+	// OrganizationServiceUpdateOrganizationProcedure is the fully-qualified name of the
+	// OrganizationService's UpdateOrganization RPC.
+	OrganizationServiceUpdateOrganizationProcedure = "/temporal.cloud.api.v1.OrganizationService/UpdateOrganization"
+	// OrganizationServiceDeleteOrganizationProcedure is the fully-qualified name of the
+	// OrganizationService's DeleteOrganization RPC.
+	OrganizationServiceDeleteOrganizationProcedure = "/temporal.cloud.api.v1.OrganizationService/DeleteOrganization"
+	// OrganizationServiceCancelOrganizationDeletionProcedure is the fully-qualified name of the
+	// OrganizationService's CancelOrganizationDeletion RPC.
+	OrganizationServiceCancelOrganizationDeletionProcedure = "/temporal.cloud.api.v1.OrganizationService/CancelOrganizationDeletion"
+	// OrganizationServiceListOrganizationsProcedure is the fully-qualified name of the
+	// OrganizationService's ListOrganizations RPC.
+	OrganizationServiceListOrganizationsProcedure = "/temporal.cloud.api.v1.OrganizationService/ListOrganizations"
+	// OrganizationServiceInviteUserProcedure is the fully-qualified name of the OrganizationService's
+	// InviteUser RPC.
+	OrganizationServiceInviteUserProcedure = "/temporal.cloud.api.v1.OrganizationService/InviteUser"
+	// OrganizationServiceListInvitationsProcedure is the fully-qualified name of the
+	// OrganizationService's ListInvitations RPC.
+	OrganizationServiceListInvitationsProcedure = "/temporal.cloud.api.v1.OrganizationService/ListInvitations"
+	// OrganizationServiceResendInvitationProcedure is the fully-qualified name of the
+	// OrganizationService's ResendInvitation RPC.
+	OrganizationServiceResendInvitationProcedure = "/temporal.cloud.api.v1.OrganizationService/ResendInvitation"
+	// OrganizationServiceRevokeInvitationProcedure is the fully-qualified name of the
+	// OrganizationService's RevokeInvitation RPC.
+	OrganizationServiceRevokeInvitationProcedure = "/temporal.cloud.api.v1.OrganizationService/RevokeInvitation"
+	// OrganizationServiceAcceptInvitationProcedure is the fully-qualified name of the
+	// OrganizationService's AcceptInvitation RPC.
+	OrganizationServiceAcceptInvitationProcedure = "/temporal.cloud.api.v1.OrganizationService/AcceptInvitation"
+	// OrganizationServiceDeclineInvitationProcedure is the fully-qualified name of the
+	// OrganizationService's DeclineInvitation RPC.
+	OrganizationServiceDeclineInvitationProcedure = "/temporal.cloud.api.v1.OrganizationService/DeclineInvitation"
+	// OrganizationServiceListMembersProcedure is the fully-qualified name of the OrganizationService's
+	// ListMembers RPC.
+	OrganizationServiceListMembersProcedure = "/temporal.cloud.api.v1.OrganizationService/ListMembers"
+	// OrganizationServiceUpdateMemberRoleProcedure is the fully-qualified name of the
+	// OrganizationService's UpdateMemberRole RPC.
+	OrganizationServiceUpdateMemberRoleProcedure = "/temporal.cloud.api.v1.OrganizationService/UpdateMemberRole"
+	// OrganizationServiceRemoveMemberProcedure is the fully-qualified name of the OrganizationService's
+	// RemoveMember RPC.
+	OrganizationServiceRemoveMemberProcedure = "/temporal.cloud.api.v1.OrganizationService/RemoveMember"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	organizationServiceServiceDescriptor                          = v1.File_cloud_v1_organizations_proto.Services().ByName("OrganizationService")
+	organizationServiceCreateOrganizationMethodDescriptor         = organizationServiceServiceDescriptor.Methods().ByName("CreateOrganization")
+	organizationServiceGetOrganizationMethodDescriptor            = organizationServiceServiceDescriptor.Methods().ByName("GetOrganization")
+	organizationServiceUpdateOrganizationMethodDescriptor         = organizationServiceServiceDescriptor.Methods().ByName("UpdateOrganization")
+	organizationServiceDeleteOrganizationMethodDescriptor         = organizationServiceServiceDescriptor.Methods().ByName("DeleteOrganization")
+	organizationServiceCancelOrganizationDeletionMethodDescriptor = organizationServiceServiceDescriptor.Methods().ByName("CancelOrganizationDeletion")
+	organizationServiceListOrganizationsMethodDescriptor          = organizationServiceServiceDescriptor.Methods().ByName("ListOrganizations")
+	organizationServiceInviteUserMethodDescriptor                 = organizationServiceServiceDescriptor.Methods().ByName("InviteUser")
+	organizationServiceListInvitationsMethodDescriptor            = organizationServiceServiceDescriptor.Methods().ByName("ListInvitations")
+	organizationServiceResendInvitationMethodDescriptor           = organizationServiceServiceDescriptor.Methods().ByName("ResendInvitation")
+	organizationServiceRevokeInvitationMethodDescriptor           = organizationServiceServiceDescriptor.Methods().ByName("RevokeInvitation")
+	organizationServiceAcceptInvitationMethodDescriptor           = organizationServiceServiceDescriptor.Methods().ByName("AcceptInvitation")
+	organizationServiceDeclineInvitationMethodDescriptor          = organizationServiceServiceDescriptor.Methods().ByName("DeclineInvitation")
+	organizationServiceListMembersMethodDescriptor                = organizationServiceServiceDescriptor.Methods().ByName("ListMembers")
+	organizationServiceUpdateMemberRoleMethodDescriptor           = organizationServiceServiceDescriptor.Methods().ByName("UpdateMemberRole")
+	organizationServiceRemoveMemberMethodDescriptor               = organizationServiceServiceDescriptor.Methods().ByName("RemoveMember")
+)
+
+// OrganizationServiceClient is a client for the temporal.cloud.api.v1.OrganizationService service.
+type OrganizationServiceClient interface {
+	// CreateOrganization creates a new organization.
+	CreateOrganization(context.Context, *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error)
+	// GetOrganization retrieves an organization by ID.
+	GetOrganization(context.Context, *connect.Request[v1.GetOrganizationRequest]) (*connect.Response[v1.GetOrganizationResponse], error)
+	// UpdateOrganization updates an organization.
+	UpdateOrganization(context.Context, *connect.Request[v1.UpdateOrganizationRequest]) (*connect.Response[v1.UpdateOrganizationResponse], error)
+	// DeleteOrganization schedules an organization's deletion. After a grace
+	// period its namespaces are deleted, its API keys revoked, a final invoice
+	// issued and its audit log exported, and the organization is deleted.
+	DeleteOrganization(context.Context, *connect.Request[v1.DeleteOrganizationRequest]) (*connect.Response[v1.DeleteOrganizationResponse], error)
+	// CancelOrganizationDeletion cancels an organization's scheduled deletion
+	// during its grace period.
+	CancelOrganizationDeletion(context.Context, *connect.Request[v1.CancelOrganizationDeletionRequest]) (*connect.Response[v1.CancelOrganizationDeletionResponse], error)
+	// ListOrganizations lists organizations the caller has access to.
+	ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error)
+	// InviteUser invites a user to join an organization and emails them the
+	// invitation. Inviting an email again replaces its invitation.
+	InviteUser(context.Context, *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error)
+	// ListInvitations lists the invitations of an organization.
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	// ResendInvitation emails a pending invitation again with a new token and
+	// expiry. Tokens sent before no longer work.
+	ResendInvitation(context.Context, *connect.Request[v1.ResendInvitationRequest]) (*connect.Response[v1.ResendInvitationResponse], error)
+	// RevokeInvitation deletes an invitation that was not accepted.
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error)
+	// AcceptInvitation accepts an invitation sent to the caller's email,
+	// making the caller a member of the organization with the invited role.
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error)
+	// DeclineInvitation declines an invitation sent to the caller's email.
+	DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error)
+	// ListMembers lists members of an organization.
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
+	// UpdateMemberRole updates a member's role in an organization.
+	UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error)
+	// RemoveMember removes a member from an organization.
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+}
+
+// NewOrganizationServiceClient constructs a client for the
+// temporal.cloud.api.v1.OrganizationService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOrganizationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OrganizationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &organizationServiceClient{
+		createOrganization: connect.NewClient[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse](
+			httpClient,
+			baseURL+OrganizationServiceCreateOrganizationProcedure,
+			connect.WithSchema(organizationServiceCreateOrganizationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getOrganization: connect.NewClient[v1.GetOrganizationRequest, v1.GetOrganizationResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetOrganizationProcedure,
+			connect.WithSchema(organizationServiceGetOrganizationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateOrganization: connect.NewClient[v1.UpdateOrganizationRequest, v1.UpdateOrganizationResponse](
+			httpClient,
+			baseURL+OrganizationServiceUpdateOrganizationProcedure,
+			connect.WithSchema(organizationServiceUpdateOrganizationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOrganization: connect.NewClient[v1.DeleteOrganizationRequest, v1.DeleteOrganizationResponse](
+			httpClient,
+			baseURL+OrganizationServiceDeleteOrganizationProcedure,
+			connect.WithSchema(organizationServiceDeleteOrganizationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cancelOrganizationDeletion: connect.NewClient[v1.CancelOrganizationDeletionRequest, v1.CancelOrganizationDeletionResponse](
+			httpClient,
+			baseURL+OrganizationServiceCancelOrganizationDeletionProcedure,
+			connect.WithSchema(organizationServiceCancelOrganizationDeletionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listOrganizations: connect.NewClient[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse](
+			httpClient,
+			baseURL+OrganizationServiceListOrganizationsProcedure,
+			connect.WithSchema(organizationServiceListOrganizationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		inviteUser: connect.NewClient[v1.InviteUserRequest, v1.InviteUserResponse](
+			httpClient,
+			baseURL+OrganizationServiceInviteUserProcedure,
+			connect.WithSchema(organizationServiceInviteUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listInvitations: connect.NewClient[v1.ListInvitationsRequest, v1.ListInvitationsResponse](
+			httpClient,
+			baseURL+OrganizationServiceListInvitationsProcedure,
+			connect.WithSchema(organizationServiceListInvitationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resendInvitation: connect.NewClient[v1.ResendInvitationRequest, v1.ResendInvitationResponse](
+			httpClient,
+			baseURL+OrganizationServiceResendInvitationProcedure,
+			connect.WithSchema(organizationServiceResendInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvitation: connect.NewClient[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse](
+			httpClient,
+			baseURL+OrganizationServiceRevokeInvitationProcedure,
+			connect.WithSchema(organizationServiceRevokeInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse](
+			httpClient,
+			baseURL+OrganizationServiceAcceptInvitationProcedure,
+			connect.WithSchema(organizationServiceAcceptInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		declineInvitation: connect.NewClient[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse](
+			httpClient,
+			baseURL+OrganizationServiceDeclineInvitationProcedure,
+			connect.WithSchema(organizationServiceDeclineInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listMembers: connect.NewClient[v1.ListMembersRequest, v1.ListMembersResponse](
+			httpClient,
+			baseURL+OrganizationServiceListMembersProcedure,
+			connect.WithSchema(organizationServiceListMembersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemberRole: connect.NewClient[v1.UpdateMemberRoleRequest, v1.UpdateMemberRoleResponse](
+			httpClient,
+			baseURL+OrganizationServiceUpdateMemberRoleProcedure,
+			connect.WithSchema(organizationServiceUpdateMemberRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeMember: connect.NewClient[v1.RemoveMemberRequest, v1.RemoveMemberResponse](
+			httpClient,
+			baseURL+OrganizationServiceRemoveMemberProcedure,
+			connect.WithSchema(organizationServiceRemoveMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// organizationServiceClient implements OrganizationServiceClient.
+type organizationServiceClient struct {
+	createOrganization         *connect.Client[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse]
+	getOrganization            *connect.Client[v1.GetOrganizationRequest, v1.GetOrganizationResponse]
+	updateOrganization         *connect.Client[v1.UpdateOrganizationRequest, v1.UpdateOrganizationResponse]
+	deleteOrganization         *connect.Client[v1.DeleteOrganizationRequest, v1.DeleteOrganizationResponse]
+	cancelOrganizationDeletion *connect.Client[v1.CancelOrganizationDeletionRequest, v1.CancelOrganizationDeletionResponse]
+	listOrganizations          *connect.Client[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse]
+	inviteUser                 *connect.Client[v1.InviteUserRequest, v1.InviteUserResponse]
+	listInvitations            *connect.Client[v1.ListInvitationsRequest, v1.ListInvitationsResponse]
+	resendInvitation           *connect.Client[v1.ResendInvitationRequest, v1.ResendInvitationResponse]
+	revokeInvitation           *connect.Client[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse]
+	acceptInvitation           *connect.Client[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse]
+	declineInvitation          *connect.Client[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse]
+	listMembers                *connect.Client[v1.ListMembersRequest, v1.ListMembersResponse]
+	updateMemberRole           *connect.Client[v1.UpdateMemberRoleRequest, v1.UpdateMemberRoleResponse]
+	removeMember               *connect.Client[v1.RemoveMemberRequest, v1.RemoveMemberResponse]
+}
+
+// CreateOrganization calls temporal.cloud.api.v1.OrganizationService.CreateOrganization.
+func (c *organizationServiceClient) CreateOrganization(ctx context.Context, req *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error) {
+	return c.createOrganization.CallUnary(ctx, req)
+}
+
+// GetOrganization calls temporal.cloud.api.v1.OrganizationService.GetOrganization.
+func (c *organizationServiceClient) GetOrganization(ctx context.Context, req *connect.Request[v1.GetOrganizationRequest]) (*connect.Response[v1.GetOrganizationResponse], error) {
+	return c.getOrganization.CallUnary(ctx, req)
+}
+
+// UpdateOrganization calls temporal.cloud.api.v1.OrganizationService.UpdateOrganization.
+func (c *organizationServiceClient) UpdateOrganization(ctx context.Context, req *connect.Request[v1.UpdateOrganizationRequest]) (*connect.Response[v1.UpdateOrganizationResponse], error) {
+	return c.updateOrganization.CallUnary(ctx, req)
+}
+
+// DeleteOrganization calls temporal.cloud.api.v1.OrganizationService.DeleteOrganization.
+func (c *organizationServiceClient) DeleteOrganization(ctx context.Context, req *connect.Request[v1.DeleteOrganizationRequest]) (*connect.Response[v1.DeleteOrganizationResponse], error) {
+	return c.deleteOrganization.CallUnary(ctx, req)
+}
+
+// CancelOrganizationDeletion calls
+// temporal.cloud.api.v1.OrganizationService.CancelOrganizationDeletion.
+func (c *organizationServiceClient) CancelOrganizationDeletion(ctx context.Context, req *connect.Request[v1.CancelOrganizationDeletionRequest]) (*connect.Response[v1.CancelOrganizationDeletionResponse], error) {
+	return c.cancelOrganizationDeletion.CallUnary(ctx, req)
+}
+
+// ListOrganizations calls temporal.cloud.api.v1.OrganizationService.ListOrganizations.
+func (c *organizationServiceClient) ListOrganizations(ctx context.Context, req *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error) {
+	return c.listOrganizations.CallUnary(ctx, req)
+}
+
+// InviteUser calls temporal.cloud.api.v1.OrganizationService.InviteUser.
+func (c *organizationServiceClient) InviteUser(ctx context.Context, req *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error) {
+	return c.inviteUser.CallUnary(ctx, req)
+}
+
+// ListInvitations calls temporal.cloud.api.v1.OrganizationService.ListInvitations.
+func (c *organizationServiceClient) ListInvitations(ctx context.Context, req *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return c.listInvitations.CallUnary(ctx, req)
+}
+
+// ResendInvitation calls temporal.cloud.api.v1.OrganizationService.ResendInvitation.
+func (c *organizationServiceClient) ResendInvitation(ctx context.Context, req *connect.Request[v1.ResendInvitationRequest]) (*connect.Response[v1.ResendInvitationResponse], error) {
+	return c.resendInvitation.CallUnary(ctx, req)
+}
+
+// RevokeInvitation calls temporal.cloud.api.v1.OrganizationService.RevokeInvitation.
+func (c *organizationServiceClient) RevokeInvitation(ctx context.Context, req *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error) {
+	return c.revokeInvitation.CallUnary(ctx, req)
+}
+
+// AcceptInvitation calls temporal.cloud.api.v1.OrganizationService.AcceptInvitation.
+func (c *organizationServiceClient) AcceptInvitation(ctx context.Context, req *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error) {
+	return c.acceptInvitation.CallUnary(ctx, req)
+}
+
+// DeclineInvitation calls temporal.cloud.api.v1.OrganizationService.DeclineInvitation.
+func (c *organizationServiceClient) DeclineInvitation(ctx context.Context, req *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error) {
+	return c.declineInvitation.CallUnary(ctx, req)
+}
+
+// ListMembers calls temporal.cloud.api.v1.OrganizationService.ListMembers.
+func (c *organizationServiceClient) ListMembers(ctx context.Context, req *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return c.listMembers.CallUnary(ctx, req)
+}
+
+// UpdateMemberRole calls temporal.cloud.api.v1.OrganizationService.UpdateMemberRole.
+func (c *organizationServiceClient) UpdateMemberRole(ctx context.Context, req *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error) {
+	return c.updateMemberRole.CallUnary(ctx, req)
+}
+
+// RemoveMember calls temporal.cloud.api.v1.OrganizationService.RemoveMember.
+func (c *organizationServiceClient) RemoveMember(ctx context.Context, req *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return c.removeMember.CallUnary(ctx, req)
+}
+
+// OrganizationServiceHandler is an implementation of the temporal.cloud.api.v1.OrganizationService
+// service.
+type OrganizationServiceHandler interface {
+	// CreateOrganization creates a new organization.
+	CreateOrganization(context.Context, *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error)
+	// GetOrganization retrieves an organization by ID.
+	GetOrganization(context.Context, *connect.Request[v1.GetOrganizationRequest]) (*connect.Response[v1.GetOrganizationResponse], error)
+	// UpdateOrganization updates an organization.
+	UpdateOrganization(context.Context, *connect.Request[v1.UpdateOrganizationRequest]) (*connect.Response[v1.UpdateOrganizationResponse], error)
+	// DeleteOrganization schedules an organization's deletion. After a grace
+	// period its namespaces are deleted, its API keys revoked, a final invoice
+	// issued and its audit log exported, and the organization is deleted.
+	DeleteOrganization(context.Context, *connect.Request[v1.DeleteOrganizationRequest]) (*connect.Response[v1.DeleteOrganizationResponse], error)
+	// CancelOrganizationDeletion cancels an organization's scheduled deletion
+	// during its grace period.
+	CancelOrganizationDeletion(context.Context, *connect.Request[v1.CancelOrganizationDeletionRequest]) (*connect.Response[v1.CancelOrganizationDeletionResponse], error)
+	// ListOrganizations lists organizations the caller has access to.
+	ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error)
+	// InviteUser invites a user to join an organization and emails them the
+	// invitation. Inviting an email again replaces its invitation.
+	InviteUser(context.Context, *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error)
+	// ListInvitations lists the invitations of an organization.
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	// ResendInvitation emails a pending invitation again with a new token and
+	// expiry. Tokens sent before no longer work.
+	ResendInvitation(context.Context, *connect.Request[v1.ResendInvitationRequest]) (*connect.Response[v1.ResendInvitationResponse], error)
+	// RevokeInvitation deletes an invitation that was not accepted.
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error)
+	// AcceptInvitation accepts an invitation sent to the caller's email,
+	// making the caller a member of the organization with the invited role.
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error)
+	// DeclineInvitation declines an invitation sent to the caller's email.
+	DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error)
+	// ListMembers lists members of an organization.
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
+	// UpdateMemberRole updates a member's role in an organization.
+	UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error)
+	// RemoveMember removes a member from an organization.
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+}
+
+// NewOrganizationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	organizationServiceCreateOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationServiceCreateOrganizationProcedure,
+		svc.CreateOrganization,
+		connect.WithSchema(organizationServiceCreateOrganizationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceGetOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetOrganizationProcedure,
+		svc.GetOrganization,
+		connect.WithSchema(organizationServiceGetOrganizationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceUpdateOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationServiceUpdateOrganizationProcedure,
+		svc.UpdateOrganization,
+		connect.WithSchema(organizationServiceUpdateOrganizationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceDeleteOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationServiceDeleteOrganizationProcedure,
+		svc.DeleteOrganization,
+		connect.WithSchema(organizationServiceDeleteOrganizationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceCancelOrganizationDeletionHandler := connect.NewUnaryHandler(
+		OrganizationServiceCancelOrganizationDeletionProcedure,
+		svc.CancelOrganizationDeletion,
+		connect.WithSchema(organizationServiceCancelOrganizationDeletionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListOrganizationsHandler := connect.NewUnaryHandler(
+		OrganizationServiceListOrganizationsProcedure,
+		svc.ListOrganizations,
+		connect.WithSchema(organizationServiceListOrganizationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceInviteUserHandler := connect.NewUnaryHandler(
+		OrganizationServiceInviteUserProcedure,
+		svc.InviteUser,
+		connect.WithSchema(organizationServiceInviteUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListInvitationsHandler := connect.NewUnaryHandler(
+		OrganizationServiceListInvitationsProcedure,
+		svc.ListInvitations,
+		connect.WithSchema(organizationServiceListInvitationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceResendInvitationHandler := connect.NewUnaryHandler(
+		OrganizationServiceResendInvitationProcedure,
+		svc.ResendInvitation,
+		connect.WithSchema(organizationServiceResendInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceRevokeInvitationHandler := connect.NewUnaryHandler(
+		OrganizationServiceRevokeInvitationProcedure,
+		svc.RevokeInvitation,
+		connect.WithSchema(organizationServiceRevokeInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceAcceptInvitationHandler := connect.NewUnaryHandler(
+		OrganizationServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(organizationServiceAcceptInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceDeclineInvitationHandler := connect.NewUnaryHandler(
+		OrganizationServiceDeclineInvitationProcedure,
+		svc.DeclineInvitation,
+		connect.WithSchema(organizationServiceDeclineInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListMembersHandler := connect.NewUnaryHandler(
+		OrganizationServiceListMembersProcedure,
+		svc.ListMembers,
+		connect.WithSchema(organizationServiceListMembersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceUpdateMemberRoleHandler := connect.NewUnaryHandler(
+		OrganizationServiceUpdateMemberRoleProcedure,
+		svc.UpdateMemberRole,
+		connect.WithSchema(organizationServiceUpdateMemberRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceRemoveMemberHandler := connect.NewUnaryHandler(
+		OrganizationServiceRemoveMemberProcedure,
+		svc.RemoveMember,
+		connect.WithSchema(organizationServiceRemoveMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/temporal.cloud.api.v1.OrganizationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OrganizationServiceCreateOrganizationProcedure:
+			organizationServiceCreateOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetOrganizationProcedure:
+			organizationServiceGetOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationServiceUpdateOrganizationProcedure:
+			organizationServiceUpdateOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationServiceDeleteOrganizationProcedure:
+			organizationServiceDeleteOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationServiceCancelOrganizationDeletionProcedure:
+			organizationServiceCancelOrganizationDeletionHandler.ServeHTTP(w, r)
+		case OrganizationServiceListOrganizationsProcedure:
+			organizationServiceListOrganizationsHandler.ServeHTTP(w, r)
+		case OrganizationServiceInviteUserProcedure:
+			organizationServiceInviteUserHandler.ServeHTTP(w, r)
+		case OrganizationServiceListInvitationsProcedure:
+			organizationServiceListInvitationsHandler.ServeHTTP(w, r)
+		case OrganizationServiceResendInvitationProcedure:
+			organizationServiceResendInvitationHandler.ServeHTTP(w, r)
+		case OrganizationServiceRevokeInvitationProcedure:
+			organizationServiceRevokeInvitationHandler.ServeHTTP(w, r)
+		case OrganizationServiceAcceptInvitationProcedure:
+			organizationServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case OrganizationServiceDeclineInvitationProcedure:
+			organizationServiceDeclineInvitationHandler.ServeHTTP(w, r)
+		case OrganizationServiceListMembersProcedure:
+			organizationServiceListMembersHandler.ServeHTTP(w, r)
+		case OrganizationServiceUpdateMemberRoleProcedure:
+			organizationServiceUpdateMemberRoleHandler.ServeHTTP(w, r)
+		case OrganizationServiceRemoveMemberProcedure:
+			organizationServiceRemoveMemberHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOrganizationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOrganizationServiceHandler struct{}
+
+func (UnimplementedOrganizationServiceHandler) CreateOrganization(context.Context, *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.CreateOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) GetOrganization(context.Context, *connect.Request[v1.GetOrganizationRequest]) (*connect.Response[v1.GetOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.GetOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) UpdateOrganization(context.Context, *connect.Request[v1.UpdateOrganizationRequest]) (*connect.Response[v1.UpdateOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.UpdateOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) DeleteOrganization(context.Context, *connect.Request[v1.DeleteOrganizationRequest]) (*connect.Response[v1.DeleteOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.DeleteOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) CancelOrganizationDeletion(context.Context, *connect.Request[v1.CancelOrganizationDeletionRequest]) (*connect.Response[v1.CancelOrganizationDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.CancelOrganizationDeletion is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.ListOrganizations is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) InviteUser(context.Context, *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.InviteUser is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.ListInvitations is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ResendInvitation(context.Context, *connect.Request[v1.ResendInvitationRequest]) (*connect.Response[v1.ResendInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.ResendInvitation is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.RevokeInvitation is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.DeclineInvitation is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.ListMembers is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.UpdateMemberRole is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("temporal.cloud.api.v1.OrganizationService.RemoveMember is not implemented"))
+}
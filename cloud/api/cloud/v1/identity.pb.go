@@ -0,0 +1,3329 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: cloud/v1/identity.proto
+
+package cloudv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PermissionType defines permission types.
+type PermissionType int32
+
+const (
+	PermissionType_PERMISSION_TYPE_UNSPECIFIED     PermissionType = 0
+	PermissionType_PERMISSION_TYPE_ORG_READ        PermissionType = 1
+	PermissionType_PERMISSION_TYPE_ORG_WRITE       PermissionType = 2
+	PermissionType_PERMISSION_TYPE_ORG_ADMIN       PermissionType = 3
+	PermissionType_PERMISSION_TYPE_NAMESPACE_READ  PermissionType = 4
+	PermissionType_PERMISSION_TYPE_NAMESPACE_WRITE PermissionType = 5
+	PermissionType_PERMISSION_TYPE_NAMESPACE_ADMIN PermissionType = 6
+	PermissionType_PERMISSION_TYPE_BILLING_READ    PermissionType = 7
+	PermissionType_PERMISSION_TYPE_BILLING_WRITE   PermissionType = 8
+)
+
+// Enum value maps for PermissionType.
+var (
+	PermissionType_name = map[int32]string{
+		0: "PERMISSION_TYPE_UNSPECIFIED",
+		1: "PERMISSION_TYPE_ORG_READ",
+		2: "PERMISSION_TYPE_ORG_WRITE",
+		3: "PERMISSION_TYPE_ORG_ADMIN",
+		4: "PERMISSION_TYPE_NAMESPACE_READ",
+		5: "PERMISSION_TYPE_NAMESPACE_WRITE",
+		6: "PERMISSION_TYPE_NAMESPACE_ADMIN",
+		7: "PERMISSION_TYPE_BILLING_READ",
+		8: "PERMISSION_TYPE_BILLING_WRITE",
+	}
+	PermissionType_value = map[string]int32{
+		"PERMISSION_TYPE_UNSPECIFIED":     0,
+		"PERMISSION_TYPE_ORG_READ":        1,
+		"PERMISSION_TYPE_ORG_WRITE":       2,
+		"PERMISSION_TYPE_ORG_ADMIN":       3,
+		"PERMISSION_TYPE_NAMESPACE_READ":  4,
+		"PERMISSION_TYPE_NAMESPACE_WRITE": 5,
+		"PERMISSION_TYPE_NAMESPACE_ADMIN": 6,
+		"PERMISSION_TYPE_BILLING_READ":    7,
+		"PERMISSION_TYPE_BILLING_WRITE":   8,
+	}
+)
+
+func (x PermissionType) Enum() *PermissionType {
+	p := new(PermissionType)
+	*p = x
+	return p
+}
+
+func (x PermissionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_identity_proto_enumTypes[0].Descriptor()
+}
+
+func (PermissionType) Type() protoreflect.EnumType {
+	return &file_cloud_v1_identity_proto_enumTypes[0]
+}
+
+func (x PermissionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionType.Descriptor instead.
+func (PermissionType) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{0}
+}
+
+// APIKey represents an API key.
+type APIKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// API key ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner type (user or service_account).
+	OwnerType string `protobuf:"bytes,2,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`
+	// Owner ID.
+	OwnerId string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Key prefix (for identification).
+	KeyPrefix string `protobuf:"bytes,5,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// Permissions.
+	Permissions []*Permission `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Whether the key is disabled.
+	Disabled bool `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Expiration timestamp.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Last used timestamp.
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Timestamp when the key was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+func (x *APIKey) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *APIKey) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Permission represents a permission.
+type Permission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Permission type.
+	Type PermissionType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.cloud.api.v1.PermissionType" json:"type,omitempty"`
+	// Resource ID (namespace ID for namespace permissions).
+	ResourceId    string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Permission) GetType() PermissionType {
+	if x != nil {
+		return x.Type
+	}
+	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
+}
+
+func (x *Permission) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+// ServiceAccount represents a service account.
+type ServiceAccount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service account ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Description.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Account-level role.
+	AccountRole string `protobuf:"bytes,5,opt,name=account_role,json=accountRole,proto3" json:"account_role,omitempty"`
+	// Namespace permissions.
+	NamespacePermissions []*NamespacePermission `protobuf:"bytes,6,rep,name=namespace_permissions,json=namespacePermissions,proto3" json:"namespace_permissions,omitempty"`
+	// Timestamp when the service account was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the service account was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccount) ProtoMessage() {}
+
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccount.ProtoReflect.Descriptor instead.
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServiceAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetAccountRole() string {
+	if x != nil {
+		return x.AccountRole
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetNamespacePermissions() []*NamespacePermission {
+	if x != nil {
+		return x.NamespacePermissions
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// NamespacePermission represents a permission on a namespace.
+type NamespacePermission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Permission level.
+	Permission    string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespacePermission) Reset() {
+	*x = NamespacePermission{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespacePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespacePermission) ProtoMessage() {}
+
+func (x *NamespacePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespacePermission.ProtoReflect.Descriptor instead.
+func (*NamespacePermission) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NamespacePermission) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *NamespacePermission) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// User represents a user.
+type User struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Email address.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Avatar URL.
+	AvatarUrl string `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Timestamp when the user was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the user was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// UserGroup represents a group of an organization's users, provisioned by
+// SCIM.
+type UserGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User group ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the group in the identity provider.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// Namespace permissions granted to the group's members.
+	NamespacePermissions []*NamespacePermission `protobuf:"bytes,5,rep,name=namespace_permissions,json=namespacePermissions,proto3" json:"namespace_permissions,omitempty"`
+	// Timestamp when the group was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the group was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGroup) Reset() {
+	*x = UserGroup{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGroup) ProtoMessage() {}
+
+func (x *UserGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGroup.ProtoReflect.Descriptor instead.
+func (*UserGroup) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserGroup) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UserGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserGroup) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *UserGroup) GetNamespacePermissions() []*NamespacePermission {
+	if x != nil {
+		return x.NamespacePermissions
+	}
+	return nil
+}
+
+func (x *UserGroup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserGroup) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateAPIKeyRequest is the request for CreateAPIKey.
+type CreateAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Owner type (user or service_account).
+	OwnerType string `protobuf:"bytes,1,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`
+	// Owner ID.
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Permissions.
+	Permissions []*Permission `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Expiration timestamp (optional).
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateAPIKeyRequest) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateAPIKeyResponse is the response for CreateAPIKey.
+type CreateAPIKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created API key.
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The secret key (only returned once).
+	SecretKey     string `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+// GetAPIKeyRequest is the request for GetAPIKey.
+type GetAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// API key ID.
+	ApiKeyId      string `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyRequest) Reset() {
+	*x = GetAPIKeyRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyRequest) ProtoMessage() {}
+
+func (x *GetAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAPIKeyRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+// GetAPIKeyResponse is the response for GetAPIKey.
+type GetAPIKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The API key.
+	ApiKey        *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyResponse) Reset() {
+	*x = GetAPIKeyResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyResponse) ProtoMessage() {}
+
+func (x *GetAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// ListAPIKeysRequest is the request for ListAPIKeys.
+type ListAPIKeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Owner type (user or service_account).
+	OwnerType string `protobuf:"bytes,1,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`
+	// Owner ID.
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Maximum number of keys to return.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAPIKeysRequest) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+func (x *ListAPIKeysRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListAPIKeysRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAPIKeysRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListAPIKeysResponse is the response for ListAPIKeys.
+type ListAPIKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of API keys.
+	ApiKeys []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (x *ListAPIKeysResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RevokeAPIKeyRequest is the request for RevokeAPIKey.
+type RevokeAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// API key ID.
+	ApiKeyId      string `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeAPIKeyRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+// RevokeAPIKeyResponse is the response for RevokeAPIKey.
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{13}
+}
+
+// RotateAPIKeyRequest is the request for RotateAPIKey.
+type RotateAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// API key ID.
+	ApiKeyId      string `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyRequest) Reset() {
+	*x = RotateAPIKeyRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+
+func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RotateAPIKeyRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+// RotateAPIKeyResponse is the response for RotateAPIKey.
+type RotateAPIKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rotated API key.
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The new secret key (only returned once).
+	SecretKey     string `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyResponse) Reset() {
+	*x = RotateAPIKeyResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyResponse) ProtoMessage() {}
+
+func (x *RotateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RotateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *RotateAPIKeyResponse) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+// CreateServiceAccountRequest is the request for CreateServiceAccount.
+type CreateServiceAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Account-level role.
+	AccountRole string `protobuf:"bytes,4,opt,name=account_role,json=accountRole,proto3" json:"account_role,omitempty"`
+	// Namespace permissions.
+	NamespacePermissions []*NamespacePermission `protobuf:"bytes,5,rep,name=namespace_permissions,json=namespacePermissions,proto3" json:"namespace_permissions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateServiceAccountRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetAccountRole() string {
+	if x != nil {
+		return x.AccountRole
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetNamespacePermissions() []*NamespacePermission {
+	if x != nil {
+		return x.NamespacePermissions
+	}
+	return nil
+}
+
+// CreateServiceAccountResponse is the response for CreateServiceAccount.
+type CreateServiceAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created service account.
+	ServiceAccount *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+// GetServiceAccountRequest is the request for GetServiceAccount.
+type GetServiceAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service account ID.
+	ServiceAccountId string `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetServiceAccountRequest) Reset() {
+	*x = GetServiceAccountRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountRequest) ProtoMessage() {}
+
+func (x *GetServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetServiceAccountRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+// GetServiceAccountResponse is the response for GetServiceAccount.
+type GetServiceAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The service account.
+	ServiceAccount *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetServiceAccountResponse) Reset() {
+	*x = GetServiceAccountResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountResponse) ProtoMessage() {}
+
+func (x *GetServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+// ListServiceAccountsRequest is the request for ListServiceAccounts.
+type ListServiceAccountsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of service accounts to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsRequest) Reset() {
+	*x = ListServiceAccountsRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListServiceAccountsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListServiceAccountsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListServiceAccountsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListServiceAccountsResponse is the response for ListServiceAccounts.
+type ListServiceAccountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of service accounts.
+	ServiceAccounts []*ServiceAccount `protobuf:"bytes,1,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsResponse) Reset() {
+	*x = ListServiceAccountsResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListServiceAccountsResponse) GetServiceAccounts() []*ServiceAccount {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+func (x *ListServiceAccountsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateServiceAccountRequest is the request for UpdateServiceAccount.
+type UpdateServiceAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service account ID.
+	ServiceAccountId string `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Account-level role.
+	AccountRole string `protobuf:"bytes,4,opt,name=account_role,json=accountRole,proto3" json:"account_role,omitempty"`
+	// Namespace permissions.
+	NamespacePermissions []*NamespacePermission `protobuf:"bytes,5,rep,name=namespace_permissions,json=namespacePermissions,proto3" json:"namespace_permissions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateServiceAccountRequest) Reset() {
+	*x = UpdateServiceAccountRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceAccountRequest) ProtoMessage() {}
+
+func (x *UpdateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateServiceAccountRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+func (x *UpdateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServiceAccountRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateServiceAccountRequest) GetAccountRole() string {
+	if x != nil {
+		return x.AccountRole
+	}
+	return ""
+}
+
+func (x *UpdateServiceAccountRequest) GetNamespacePermissions() []*NamespacePermission {
+	if x != nil {
+		return x.NamespacePermissions
+	}
+	return nil
+}
+
+// UpdateServiceAccountResponse is the response for UpdateServiceAccount.
+type UpdateServiceAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated service account.
+	ServiceAccount *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateServiceAccountResponse) Reset() {
+	*x = UpdateServiceAccountResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceAccountResponse) ProtoMessage() {}
+
+func (x *UpdateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+// DeleteServiceAccountRequest is the request for DeleteServiceAccount.
+type DeleteServiceAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service account ID.
+	ServiceAccountId string `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountRequest) Reset() {
+	*x = DeleteServiceAccountRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountRequest) ProtoMessage() {}
+
+func (x *DeleteServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteServiceAccountRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+// DeleteServiceAccountResponse is the response for DeleteServiceAccount.
+type DeleteServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountResponse) Reset() {
+	*x = DeleteServiceAccountResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountResponse) ProtoMessage() {}
+
+func (x *DeleteServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{25}
+}
+
+// GetUserRequest is the request for GetUser.
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{26}
+}
+
+// GetUserResponse is the response for GetUser.
+type GetUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user.
+	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// UpdateUserRequest is the request for UpdateUser.
+type UpdateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Display name.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UpdateUserResponse is the response for UpdateUser.
+type UpdateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated user.
+	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// InitiateSAMLLoginRequest is the request for InitiateSAMLLogin.
+type InitiateSAMLLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization slug.
+	OrganizationSlug string `protobuf:"bytes,1,opt,name=organization_slug,json=organizationSlug,proto3" json:"organization_slug,omitempty"`
+	// Redirect URL after login.
+	RedirectUrl   string `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateSAMLLoginRequest) Reset() {
+	*x = InitiateSAMLLoginRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateSAMLLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateSAMLLoginRequest) ProtoMessage() {}
+
+func (x *InitiateSAMLLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateSAMLLoginRequest.ProtoReflect.Descriptor instead.
+func (*InitiateSAMLLoginRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InitiateSAMLLoginRequest) GetOrganizationSlug() string {
+	if x != nil {
+		return x.OrganizationSlug
+	}
+	return ""
+}
+
+func (x *InitiateSAMLLoginRequest) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+// InitiateSAMLLoginResponse is the response for InitiateSAMLLogin.
+type InitiateSAMLLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SAML request URL.
+	SamlRequestUrl string `protobuf:"bytes,1,opt,name=saml_request_url,json=samlRequestUrl,proto3" json:"saml_request_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InitiateSAMLLoginResponse) Reset() {
+	*x = InitiateSAMLLoginResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateSAMLLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateSAMLLoginResponse) ProtoMessage() {}
+
+func (x *InitiateSAMLLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateSAMLLoginResponse.ProtoReflect.Descriptor instead.
+func (*InitiateSAMLLoginResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InitiateSAMLLoginResponse) GetSamlRequestUrl() string {
+	if x != nil {
+		return x.SamlRequestUrl
+	}
+	return ""
+}
+
+// CompleteSAMLLoginRequest is the request for CompleteSAMLLogin.
+type CompleteSAMLLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SAML response.
+	SamlResponse string `protobuf:"bytes,1,opt,name=saml_response,json=samlResponse,proto3" json:"saml_response,omitempty"`
+	// Relay state.
+	RelayState    string `protobuf:"bytes,2,opt,name=relay_state,json=relayState,proto3" json:"relay_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteSAMLLoginRequest) Reset() {
+	*x = CompleteSAMLLoginRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteSAMLLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSAMLLoginRequest) ProtoMessage() {}
+
+func (x *CompleteSAMLLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSAMLLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteSAMLLoginRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CompleteSAMLLoginRequest) GetSamlResponse() string {
+	if x != nil {
+		return x.SamlResponse
+	}
+	return ""
+}
+
+func (x *CompleteSAMLLoginRequest) GetRelayState() string {
+	if x != nil {
+		return x.RelayState
+	}
+	return ""
+}
+
+// CompleteSAMLLoginResponse is the response for CompleteSAMLLogin.
+type CompleteSAMLLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JWT access token.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// JWT refresh token.
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Token expiration timestamp.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteSAMLLoginResponse) Reset() {
+	*x = CompleteSAMLLoginResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteSAMLLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSAMLLoginResponse) ProtoMessage() {}
+
+func (x *CompleteSAMLLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSAMLLoginResponse.ProtoReflect.Descriptor instead.
+func (*CompleteSAMLLoginResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CompleteSAMLLoginResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *CompleteSAMLLoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CompleteSAMLLoginResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// RotateSCIMTokenRequest is the request for RotateSCIMToken.
+type RotateSCIMTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateSCIMTokenRequest) Reset() {
+	*x = RotateSCIMTokenRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSCIMTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSCIMTokenRequest) ProtoMessage() {}
+
+func (x *RotateSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RotateSCIMTokenRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// RotateSCIMTokenResponse is the response for RotateSCIMToken.
+type RotateSCIMTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The SCIM bearer token. It is only returned once.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Base URL of the SCIM API to register with the identity provider.
+	ScimBaseUrl   string `protobuf:"bytes,2,opt,name=scim_base_url,json=scimBaseUrl,proto3" json:"scim_base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSCIMTokenResponse) Reset() {
+	*x = RotateSCIMTokenResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSCIMTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSCIMTokenResponse) ProtoMessage() {}
+
+func (x *RotateSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RotateSCIMTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RotateSCIMTokenResponse) GetScimBaseUrl() string {
+	if x != nil {
+		return x.ScimBaseUrl
+	}
+	return ""
+}
+
+// ListUserGroupsRequest is the request for ListUserGroups.
+type ListUserGroupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of user groups to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupsRequest) Reset() {
+	*x = ListUserGroupsRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupsRequest) ProtoMessage() {}
+
+func (x *ListUserGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListUserGroupsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListUserGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserGroupsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListUserGroupsResponse is the response for ListUserGroups.
+type ListUserGroupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of user groups.
+	UserGroups []*UserGroup `protobuf:"bytes,1,rep,name=user_groups,json=userGroups,proto3" json:"user_groups,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupsResponse) Reset() {
+	*x = ListUserGroupsResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupsResponse) ProtoMessage() {}
+
+func (x *ListUserGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListUserGroupsResponse) GetUserGroups() []*UserGroup {
+	if x != nil {
+		return x.UserGroups
+	}
+	return nil
+}
+
+func (x *ListUserGroupsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SetUserGroupNamespacePermissionsRequest is the request for
+// SetUserGroupNamespacePermissions.
+type SetUserGroupNamespacePermissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// User group ID.
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Namespace permissions, replacing the group's current ones. Permission is
+	// one of read, write or admin.
+	NamespacePermissions []*NamespacePermission `protobuf:"bytes,3,rep,name=namespace_permissions,json=namespacePermissions,proto3" json:"namespace_permissions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetUserGroupNamespacePermissionsRequest) Reset() {
+	*x = SetUserGroupNamespacePermissionsRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserGroupNamespacePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserGroupNamespacePermissionsRequest) ProtoMessage() {}
+
+func (x *SetUserGroupNamespacePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserGroupNamespacePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetUserGroupNamespacePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetUserGroupNamespacePermissionsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetUserGroupNamespacePermissionsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetUserGroupNamespacePermissionsRequest) GetNamespacePermissions() []*NamespacePermission {
+	if x != nil {
+		return x.NamespacePermissions
+	}
+	return nil
+}
+
+// SetUserGroupNamespacePermissionsResponse is the response for
+// SetUserGroupNamespacePermissions.
+type SetUserGroupNamespacePermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated user group.
+	UserGroup     *UserGroup `protobuf:"bytes,1,opt,name=user_group,json=userGroup,proto3" json:"user_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserGroupNamespacePermissionsResponse) Reset() {
+	*x = SetUserGroupNamespacePermissionsResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserGroupNamespacePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserGroupNamespacePermissionsResponse) ProtoMessage() {}
+
+func (x *SetUserGroupNamespacePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserGroupNamespacePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*SetUserGroupNamespacePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetUserGroupNamespacePermissionsResponse) GetUserGroup() *UserGroup {
+	if x != nil {
+		return x.UserGroup
+	}
+	return nil
+}
+
+// Domain is an email domain claimed by an organization.
+type Domain struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The domain name.
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Name of the DNS TXT record that must hold verification_token.
+	VerificationRecord string `protobuf:"bytes,2,opt,name=verification_record,json=verificationRecord,proto3" json:"verification_record,omitempty"`
+	// Value of the DNS TXT record that proves control of the domain.
+	VerificationToken string `protobuf:"bytes,3,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	// When the domain was verified, unset until it is.
+	VerifiedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	// Timestamp when the domain was claimed.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Domain) Reset() {
+	*x = Domain{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Domain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Domain) ProtoMessage() {}
+
+func (x *Domain) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Domain.ProtoReflect.Descriptor instead.
+func (*Domain) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Domain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Domain) GetVerificationRecord() string {
+	if x != nil {
+		return x.VerificationRecord
+	}
+	return ""
+}
+
+func (x *Domain) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
+func (x *Domain) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+func (x *Domain) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AddDomainRequest is the request for AddDomain.
+type AddDomainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// The domain to claim.
+	Domain        string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainRequest) Reset() {
+	*x = AddDomainRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainRequest) ProtoMessage() {}
+
+func (x *AddDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainRequest.ProtoReflect.Descriptor instead.
+func (*AddDomainRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddDomainRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AddDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// AddDomainResponse is the response for AddDomain.
+type AddDomainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The claimed domain.
+	Domain        *Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainResponse) Reset() {
+	*x = AddDomainResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainResponse) ProtoMessage() {}
+
+func (x *AddDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainResponse.ProtoReflect.Descriptor instead.
+func (*AddDomainResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// VerifyDomainRequest is the request for VerifyDomain.
+type VerifyDomainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// The domain to verify.
+	Domain        string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainRequest) ProtoMessage() {}
+
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifyDomainRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *VerifyDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// VerifyDomainResponse is the response for VerifyDomain.
+type VerifyDomainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The verified domain.
+	Domain        *Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainResponse) ProtoMessage() {}
+
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VerifyDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// ListDomainsRequest is the request for ListDomains.
+type ListDomainsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDomainsRequest) Reset() {
+	*x = ListDomainsRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsRequest) ProtoMessage() {}
+
+func (x *ListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListDomainsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// ListDomainsResponse is the response for ListDomains.
+type ListDomainsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The organization's domains.
+	Domains       []*Domain `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainsResponse) Reset() {
+	*x = ListDomainsResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsResponse) ProtoMessage() {}
+
+func (x *ListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListDomainsResponse) GetDomains() []*Domain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// RemoveDomainRequest is the request for RemoveDomain.
+type RemoveDomainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// The domain to remove.
+	Domain        string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainRequest) Reset() {
+	*x = RemoveDomainRequest{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainRequest) ProtoMessage() {}
+
+func (x *RemoveDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDomainRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RemoveDomainRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RemoveDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// RemoveDomainResponse is the response for RemoveDomain.
+type RemoveDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainResponse) Reset() {
+	*x = RemoveDomainResponse{}
+	mi := &file_cloud_v1_identity_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainResponse) ProtoMessage() {}
+
+func (x *RemoveDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_identity_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDomainResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_identity_proto_rawDescGZIP(), []int{48}
+}
+
+var File_cloud_v1_identity_proto protoreflect.FileDescriptor
+
+const file_cloud_v1_identity_proto_rawDesc = "" +
+	"\n" +
+	"\x17cloud/v1/identity.proto\x12\x15temporal.cloud.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x03\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\tR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x05 \x01(\tR\tkeyPrefix\x12C\n" +
+	"\vpermissions\x18\x06 \x03(\v2!.temporal.cloud.api.v1.PermissionR\vpermissions\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"h\n" +
+	"\n" +
+	"Permission\x129\n" +
+	"\x04type\x18\x01 \x01(\x0e2%.temporal.cloud.api.v1.PermissionTypeR\x04type\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\"\xf9\x02\n" +
+	"\x0eServiceAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12!\n" +
+	"\faccount_role\x18\x05 \x01(\tR\vaccountRole\x12_\n" +
+	"\x15namespace_permissions\x18\x06 \x03(\v2*.temporal.cloud.api.v1.NamespacePermissionR\x14namespacePermissions\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"X\n" +
+	"\x13NamespacePermission\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"\xd5\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd0\x02\n" +
+	"\tUserGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vexternal_id\x18\x04 \x01(\tR\n" +
+	"externalId\x12_\n" +
+	"\x15namespace_permissions\x18\x05 \x03(\v2*.temporal.cloud.api.v1.NamespacePermissionR\x14namespacePermissions\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe3\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x1d\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\tR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12C\n" +
+	"\vpermissions\x18\x04 \x03(\v2!.temporal.cloud.api.v1.PermissionR\vpermissions\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"m\n" +
+	"\x14CreateAPIKeyResponse\x126\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1d.temporal.cloud.api.v1.APIKeyR\x06apiKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\"0\n" +
+	"\x10GetAPIKeyRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\"K\n" +
+	"\x11GetAPIKeyResponse\x126\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1d.temporal.cloud.api.v1.APIKeyR\x06apiKey\"\x8a\x01\n" +
+	"\x12ListAPIKeysRequest\x12\x1d\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\tR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"w\n" +
+	"\x13ListAPIKeysResponse\x128\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x1d.temporal.cloud.api.v1.APIKeyR\aapiKeys\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"3\n" +
+	"\x13RevokeAPIKeyRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\"\x16\n" +
+	"\x14RevokeAPIKeyResponse\"3\n" +
+	"\x13RotateAPIKeyRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\"m\n" +
+	"\x14RotateAPIKeyResponse\x126\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1d.temporal.cloud.api.v1.APIKeyR\x06apiKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\"\x80\x02\n" +
+	"\x1bCreateServiceAccountRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\faccount_role\x18\x04 \x01(\tR\vaccountRole\x12_\n" +
+	"\x15namespace_permissions\x18\x05 \x03(\v2*.temporal.cloud.api.v1.NamespacePermissionR\x14namespacePermissions\"n\n" +
+	"\x1cCreateServiceAccountResponse\x12N\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2%.temporal.cloud.api.v1.ServiceAccountR\x0eserviceAccount\"H\n" +
+	"\x18GetServiceAccountRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\tR\x10serviceAccountId\"k\n" +
+	"\x19GetServiceAccountResponse\x12N\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2%.temporal.cloud.api.v1.ServiceAccountR\x0eserviceAccount\"\x81\x01\n" +
+	"\x1aListServiceAccountsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x97\x01\n" +
+	"\x1bListServiceAccountsResponse\x12P\n" +
+	"\x10service_accounts\x18\x01 \x03(\v2%.temporal.cloud.api.v1.ServiceAccountR\x0fserviceAccounts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x85\x02\n" +
+	"\x1bUpdateServiceAccountRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\tR\x10serviceAccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\faccount_role\x18\x04 \x01(\tR\vaccountRole\x12_\n" +
+	"\x15namespace_permissions\x18\x05 \x03(\v2*.temporal.cloud.api.v1.NamespacePermissionR\x14namespacePermissions\"n\n" +
+	"\x1cUpdateServiceAccountResponse\x12N\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2%.temporal.cloud.api.v1.ServiceAccountR\x0eserviceAccount\"K\n" +
+	"\x1bDeleteServiceAccountRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\tR\x10serviceAccountId\"\x1e\n" +
+	"\x1cDeleteServiceAccountResponse\"\x10\n" +
+	"\x0eGetUserRequest\"B\n" +
+	"\x0fGetUserResponse\x12/\n" +
+	"\x04user\x18\x01 \x01(\v2\x1b.temporal.cloud.api.v1.UserR\x04user\"'\n" +
+	"\x11UpdateUserRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"E\n" +
+	"\x12UpdateUserResponse\x12/\n" +
+	"\x04user\x18\x01 \x01(\v2\x1b.temporal.cloud.api.v1.UserR\x04user\"j\n" +
+	"\x18InitiateSAMLLoginRequest\x12+\n" +
+	"\x11organization_slug\x18\x01 \x01(\tR\x10organizationSlug\x12!\n" +
+	"\fredirect_url\x18\x02 \x01(\tR\vredirectUrl\"E\n" +
+	"\x19InitiateSAMLLoginResponse\x12(\n" +
+	"\x10saml_request_url\x18\x01 \x01(\tR\x0esamlRequestUrl\"`\n" +
+	"\x18CompleteSAMLLoginRequest\x12#\n" +
+	"\rsaml_response\x18\x01 \x01(\tR\fsamlResponse\x12\x1f\n" +
+	"\vrelay_state\x18\x02 \x01(\tR\n" +
+	"relayState\"\x9e\x01\n" +
+	"\x19CompleteSAMLLoginResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"A\n" +
+	"\x16RotateSCIMTokenRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"S\n" +
+	"\x17RotateSCIMTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\rscim_base_url\x18\x02 \x01(\tR\vscimBaseUrl\"|\n" +
+	"\x15ListUserGroupsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x83\x01\n" +
+	"\x16ListUserGroupsResponse\x12A\n" +
+	"\vuser_groups\x18\x01 \x03(\v2 .temporal.cloud.api.v1.UserGroupR\n" +
+	"userGroups\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xce\x01\n" +
+	"'SetUserGroupNamespacePermissionsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12_\n" +
+	"\x15namespace_permissions\x18\x03 \x03(\v2*.temporal.cloud.api.v1.NamespacePermissionR\x14namespacePermissions\"k\n" +
+	"(SetUserGroupNamespacePermissionsResponse\x12?\n" +
+	"\n" +
+	"user_group\x18\x01 \x01(\v2 .temporal.cloud.api.v1.UserGroupR\tuserGroup\"\xf8\x01\n" +
+	"\x06Domain\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12/\n" +
+	"\x13verification_record\x18\x02 \x01(\tR\x12verificationRecord\x12-\n" +
+	"\x12verification_token\x18\x03 \x01(\tR\x11verificationToken\x12;\n" +
+	"\vverified_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"S\n" +
+	"\x10AddDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\"J\n" +
+	"\x11AddDomainResponse\x125\n" +
+	"\x06domain\x18\x01 \x01(\v2\x1d.temporal.cloud.api.v1.DomainR\x06domain\"V\n" +
+	"\x13VerifyDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\"M\n" +
+	"\x14VerifyDomainResponse\x125\n" +
+	"\x06domain\x18\x01 \x01(\v2\x1d.temporal.cloud.api.v1.DomainR\x06domain\"=\n" +
+	"\x12ListDomainsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"N\n" +
+	"\x13ListDomainsResponse\x127\n" +
+	"\adomains\x18\x01 \x03(\v2\x1d.temporal.cloud.api.v1.DomainR\adomains\"V\n" +
+	"\x13RemoveDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\"\x16\n" +
+	"\x14RemoveDomainResponse*\xc0\x02\n" +
+	"\x0ePermissionType\x12\x1f\n" +
+	"\x1bPERMISSION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PERMISSION_TYPE_ORG_READ\x10\x01\x12\x1d\n" +
+	"\x19PERMISSION_TYPE_ORG_WRITE\x10\x02\x12\x1d\n" +
+	"\x19PERMISSION_TYPE_ORG_ADMIN\x10\x03\x12\"\n" +
+	"\x1ePERMISSION_TYPE_NAMESPACE_READ\x10\x04\x12#\n" +
+	"\x1fPERMISSION_TYPE_NAMESPACE_WRITE\x10\x05\x12#\n" +
+	"\x1fPERMISSION_TYPE_NAMESPACE_ADMIN\x10\x06\x12 \n" +
+	"\x1cPERMISSION_TYPE_BILLING_READ\x10\a\x12!\n" +
+	"\x1dPERMISSION_TYPE_BILLING_WRITE\x10\b2\xd7\x12\n" +
+	"\x0fIdentityService\x12g\n" +
+	"\fCreateAPIKey\x12*.temporal.cloud.api.v1.CreateAPIKeyRequest\x1a+.temporal.cloud.api.v1.CreateAPIKeyResponse\x12^\n" +
+	"\tGetAPIKey\x12'.temporal.cloud.api.v1.GetAPIKeyRequest\x1a(.temporal.cloud.api.v1.GetAPIKeyResponse\x12d\n" +
+	"\vListAPIKeys\x12).temporal.cloud.api.v1.ListAPIKeysRequest\x1a*.temporal.cloud.api.v1.ListAPIKeysResponse\x12g\n" +
+	"\fRevokeAPIKey\x12*.temporal.cloud.api.v1.RevokeAPIKeyRequest\x1a+.temporal.cloud.api.v1.RevokeAPIKeyResponse\x12g\n" +
+	"\fRotateAPIKey\x12*.temporal.cloud.api.v1.RotateAPIKeyRequest\x1a+.temporal.cloud.api.v1.RotateAPIKeyResponse\x12\x7f\n" +
+	"\x14CreateServiceAccount\x122.temporal.cloud.api.v1.CreateServiceAccountRequest\x1a3.temporal.cloud.api.v1.CreateServiceAccountResponse\x12v\n" +
+	"\x11GetServiceAccount\x12/.temporal.cloud.api.v1.GetServiceAccountRequest\x1a0.temporal.cloud.api.v1.GetServiceAccountResponse\x12|\n" +
+	"\x13ListServiceAccounts\x121.temporal.cloud.api.v1.ListServiceAccountsRequest\x1a2.temporal.cloud.api.v1.ListServiceAccountsResponse\x12\x7f\n" +
+	"\x14UpdateServiceAccount\x122.temporal.cloud.api.v1.UpdateServiceAccountRequest\x1a3.temporal.cloud.api.v1.UpdateServiceAccountResponse\x12\x7f\n" +
+	"\x14DeleteServiceAccount\x122.temporal.cloud.api.v1.DeleteServiceAccountRequest\x1a3.temporal.cloud.api.v1.DeleteServiceAccountResponse\x12X\n" +
+	"\aGetUser\x12%.temporal.cloud.api.v1.GetUserRequest\x1a&.temporal.cloud.api.v1.GetUserResponse\x12a\n" +
+	"\n" +
+	"UpdateUser\x12(.temporal.cloud.api.v1.UpdateUserRequest\x1a).temporal.cloud.api.v1.UpdateUserResponse\x12v\n" +
+	"\x11InitiateSAMLLogin\x12/.temporal.cloud.api.v1.InitiateSAMLLoginRequest\x1a0.temporal.cloud.api.v1.InitiateSAMLLoginResponse\x12v\n" +
+	"\x11CompleteSAMLLogin\x12/.temporal.cloud.api.v1.CompleteSAMLLoginRequest\x1a0.temporal.cloud.api.v1.CompleteSAMLLoginResponse\x12p\n" +
+	"\x0fRotateSCIMToken\x12-.temporal.cloud.api.v1.RotateSCIMTokenRequest\x1a..temporal.cloud.api.v1.RotateSCIMTokenResponse\x12m\n" +
+	"\x0eListUserGroups\x12,.temporal.cloud.api.v1.ListUserGroupsRequest\x1a-.temporal.cloud.api.v1.ListUserGroupsResponse\x12\xa3\x01\n" +
+	" SetUserGroupNamespacePermissions\x12>.temporal.cloud.api.v1.SetUserGroupNamespacePermissionsRequest\x1a?.temporal.cloud.api.v1.SetUserGroupNamespacePermissionsResponse\x12^\n" +
+	"\tAddDomain\x12'.temporal.cloud.api.v1.AddDomainRequest\x1a(.temporal.cloud.api.v1.AddDomainResponse\x12g\n" +
+	"\fVerifyDomain\x12*.temporal.cloud.api.v1.VerifyDomainRequest\x1a+.temporal.cloud.api.v1.VerifyDomainResponse\x12d\n" +
+	"\vListDomains\x12).temporal.cloud.api.v1.ListDomainsRequest\x1a*.temporal.cloud.api.v1.ListDomainsResponse\x12g\n" +
+	"\fRemoveDomain\x12*.temporal.cloud.api.v1.RemoveDomainRequest\x1a+.temporal.cloud.api.v1.RemoveDomainResponseB+Z)go.temporal.io/cloud/api/cloud/v1;cloudv1b\x06proto3"
+
+var (
+	file_cloud_v1_identity_proto_rawDescOnce sync.Once
+	file_cloud_v1_identity_proto_rawDescData []byte
+)
+
+func file_cloud_v1_identity_proto_rawDescGZIP() []byte {
+	file_cloud_v1_identity_proto_rawDescOnce.Do(func() {
+		file_cloud_v1_identity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cloud_v1_identity_proto_rawDesc), len(file_cloud_v1_identity_proto_rawDesc)))
+	})
+	return file_cloud_v1_identity_proto_rawDescData
+}
+
+var file_cloud_v1_identity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cloud_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_cloud_v1_identity_proto_goTypes = []any{
+	(PermissionType)(0),                              // 0: temporal.cloud.api.v1.PermissionType
+	(*APIKey)(nil),                                   // 1: temporal.cloud.api.v1.APIKey
+	(*Permission)(nil),                               // 2: temporal.cloud.api.v1.Permission
+	(*ServiceAccount)(nil),                           // 3: temporal.cloud.api.v1.ServiceAccount
+	(*NamespacePermission)(nil),                      // 4: temporal.cloud.api.v1.NamespacePermission
+	(*User)(nil),                                     // 5: temporal.cloud.api.v1.User
+	(*UserGroup)(nil),                                // 6: temporal.cloud.api.v1.UserGroup
+	(*CreateAPIKeyRequest)(nil),                      // 7: temporal.cloud.api.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),                     // 8: temporal.cloud.api.v1.CreateAPIKeyResponse
+	(*GetAPIKeyRequest)(nil),                         // 9: temporal.cloud.api.v1.GetAPIKeyRequest
+	(*GetAPIKeyResponse)(nil),                        // 10: temporal.cloud.api.v1.GetAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                       // 11: temporal.cloud.api.v1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                      // 12: temporal.cloud.api.v1.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),                      // 13: temporal.cloud.api.v1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),                     // 14: temporal.cloud.api.v1.RevokeAPIKeyResponse
+	(*RotateAPIKeyRequest)(nil),                      // 15: temporal.cloud.api.v1.RotateAPIKeyRequest
+	(*RotateAPIKeyResponse)(nil),                     // 16: temporal.cloud.api.v1.RotateAPIKeyResponse
+	(*CreateServiceAccountRequest)(nil),              // 17: temporal.cloud.api.v1.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),             // 18: temporal.cloud.api.v1.CreateServiceAccountResponse
+	(*GetServiceAccountRequest)(nil),                 // 19: temporal.cloud.api.v1.GetServiceAccountRequest
+	(*GetServiceAccountResponse)(nil),                // 20: temporal.cloud.api.v1.GetServiceAccountResponse
+	(*ListServiceAccountsRequest)(nil),               // 21: temporal.cloud.api.v1.ListServiceAccountsRequest
+	(*ListServiceAccountsResponse)(nil),              // 22: temporal.cloud.api.v1.ListServiceAccountsResponse
+	(*UpdateServiceAccountRequest)(nil),              // 23: temporal.cloud.api.v1.UpdateServiceAccountRequest
+	(*UpdateServiceAccountResponse)(nil),             // 24: temporal.cloud.api.v1.UpdateServiceAccountResponse
+	(*DeleteServiceAccountRequest)(nil),              // 25: temporal.cloud.api.v1.DeleteServiceAccountRequest
+	(*DeleteServiceAccountResponse)(nil),             // 26: temporal.cloud.api.v1.DeleteServiceAccountResponse
+	(*GetUserRequest)(nil),                           // 27: temporal.cloud.api.v1.GetUserRequest
+	(*GetUserResponse)(nil),                          // 28: temporal.cloud.api.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),                        // 29: temporal.cloud.api.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                       // 30: temporal.cloud.api.v1.UpdateUserResponse
+	(*InitiateSAMLLoginRequest)(nil),                 // 31: temporal.cloud.api.v1.InitiateSAMLLoginRequest
+	(*InitiateSAMLLoginResponse)(nil),                // 32: temporal.cloud.api.v1.InitiateSAMLLoginResponse
+	(*CompleteSAMLLoginRequest)(nil),                 // 33: temporal.cloud.api.v1.CompleteSAMLLoginRequest
+	(*CompleteSAMLLoginResponse)(nil),                // 34: temporal.cloud.api.v1.CompleteSAMLLoginResponse
+	(*RotateSCIMTokenRequest)(nil),                   // 35: temporal.cloud.api.v1.RotateSCIMTokenRequest
+	(*RotateSCIMTokenResponse)(nil),                  // 36: temporal.cloud.api.v1.RotateSCIMTokenResponse
+	(*ListUserGroupsRequest)(nil),                    // 37: temporal.cloud.api.v1.ListUserGroupsRequest
+	(*ListUserGroupsResponse)(nil),                   // 38: temporal.cloud.api.v1.ListUserGroupsResponse
+	(*SetUserGroupNamespacePermissionsRequest)(nil),  // 39: temporal.cloud.api.v1.SetUserGroupNamespacePermissionsRequest
+	(*SetUserGroupNamespacePermissionsResponse)(nil), // 40: temporal.cloud.api.v1.SetUserGroupNamespacePermissionsResponse
+	(*Domain)(nil),                                   // 41: temporal.cloud.api.v1.Domain
+	(*AddDomainRequest)(nil),                         // 42: temporal.cloud.api.v1.AddDomainRequest
+	(*AddDomainResponse)(nil),                        // 43: temporal.cloud.api.v1.AddDomainResponse
+	(*VerifyDomainRequest)(nil),                      // 44: temporal.cloud.api.v1.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),                     // 45: temporal.cloud.api.v1.VerifyDomainResponse
+	(*ListDomainsRequest)(nil),                       // 46: temporal.cloud.api.v1.ListDomainsRequest
+	(*ListDomainsResponse)(nil),                      // 47: temporal.cloud.api.v1.ListDomainsResponse
+	(*RemoveDomainRequest)(nil),                      // 48: temporal.cloud.api.v1.RemoveDomainRequest
+	(*RemoveDomainResponse)(nil),                     // 49: temporal.cloud.api.v1.RemoveDomainResponse
+	(*timestamppb.Timestamp)(nil),                    // 50: google.protobuf.Timestamp
+}
+var file_cloud_v1_identity_proto_depIdxs = []int32{
+	2,  // 0: temporal.cloud.api.v1.APIKey.permissions:type_name -> temporal.cloud.api.v1.Permission
+	50, // 1: temporal.cloud.api.v1.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 2: temporal.cloud.api.v1.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	50, // 3: temporal.cloud.api.v1.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: temporal.cloud.api.v1.Permission.type:type_name -> temporal.cloud.api.v1.PermissionType
+	4,  // 5: temporal.cloud.api.v1.ServiceAccount.namespace_permissions:type_name -> temporal.cloud.api.v1.NamespacePermission
+	50, // 6: temporal.cloud.api.v1.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	50, // 7: temporal.cloud.api.v1.ServiceAccount.updated_at:type_name -> google.protobuf.Timestamp
+	50, // 8: temporal.cloud.api.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	50, // 9: temporal.cloud.api.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 10: temporal.cloud.api.v1.UserGroup.namespace_permissions:type_name -> temporal.cloud.api.v1.NamespacePermission
+	50, // 11: temporal.cloud.api.v1.UserGroup.created_at:type_name -> google.protobuf.Timestamp
+	50, // 12: temporal.cloud.api.v1.UserGroup.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 13: temporal.cloud.api.v1.CreateAPIKeyRequest.permissions:type_name -> temporal.cloud.api.v1.Permission
+	50, // 14: temporal.cloud.api.v1.CreateAPIKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 15: temporal.cloud.api.v1.CreateAPIKeyResponse.api_key:type_name -> temporal.cloud.api.v1.APIKey
+	1,  // 16: temporal.cloud.api.v1.GetAPIKeyResponse.api_key:type_name -> temporal.cloud.api.v1.APIKey
+	1,  // 17: temporal.cloud.api.v1.ListAPIKeysResponse.api_keys:type_name -> temporal.cloud.api.v1.APIKey
+	1,  // 18: temporal.cloud.api.v1.RotateAPIKeyResponse.api_key:type_name -> temporal.cloud.api.v1.APIKey
+	4,  // 19: temporal.cloud.api.v1.CreateServiceAccountRequest.namespace_permissions:type_name -> temporal.cloud.api.v1.NamespacePermission
+	3,  // 20: temporal.cloud.api.v1.CreateServiceAccountResponse.service_account:type_name -> temporal.cloud.api.v1.ServiceAccount
+	3,  // 21: temporal.cloud.api.v1.GetServiceAccountResponse.service_account:type_name -> temporal.cloud.api.v1.ServiceAccount
+	3,  // 22: temporal.cloud.api.v1.ListServiceAccountsResponse.service_accounts:type_name -> temporal.cloud.api.v1.ServiceAccount
+	4,  // 23: temporal.cloud.api.v1.UpdateServiceAccountRequest.namespace_permissions:type_name -> temporal.cloud.api.v1.NamespacePermission
+	3,  // 24: temporal.cloud.api.v1.UpdateServiceAccountResponse.service_account:type_name -> temporal.cloud.api.v1.ServiceAccount
+	5,  // 25: temporal.cloud.api.v1.GetUserResponse.user:type_name -> temporal.cloud.api.v1.User
+	5,  // 26: temporal.cloud.api.v1.UpdateUserResponse.user:type_name -> temporal.cloud.api.v1.User
+	50, // 27: temporal.cloud.api.v1.CompleteSAMLLoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 28: temporal.cloud.api.v1.ListUserGroupsResponse.user_groups:type_name -> temporal.cloud.api.v1.UserGroup
+	4,  // 29: temporal.cloud.api.v1.SetUserGroupNamespacePermissionsRequest.namespace_permissions:type_name -> temporal.cloud.api.v1.NamespacePermission
+	6,  // 30: temporal.cloud.api.v1.SetUserGroupNamespacePermissionsResponse.user_group:type_name -> temporal.cloud.api.v1.UserGroup
+	50, // 31: temporal.cloud.api.v1.Domain.verified_at:type_name -> google.protobuf.Timestamp
+	50, // 32: temporal.cloud.api.v1.Domain.created_at:type_name -> google.protobuf.Timestamp
+	41, // 33: temporal.cloud.api.v1.AddDomainResponse.domain:type_name -> temporal.cloud.api.v1.Domain
+	41, // 34: temporal.cloud.api.v1.VerifyDomainResponse.domain:type_name -> temporal.cloud.api.v1.Domain
+	41, // 35: temporal.cloud.api.v1.ListDomainsResponse.domains:type_name -> temporal.cloud.api.v1.Domain
+	7,  // 36: temporal.cloud.api.v1.IdentityService.CreateAPIKey:input_type -> temporal.cloud.api.v1.CreateAPIKeyRequest
+	9,  // 37: temporal.cloud.api.v1.IdentityService.GetAPIKey:input_type -> temporal.cloud.api.v1.GetAPIKeyRequest
+	11, // 38: temporal.cloud.api.v1.IdentityService.ListAPIKeys:input_type -> temporal.cloud.api.v1.ListAPIKeysRequest
+	13, // 39: temporal.cloud.api.v1.IdentityService.RevokeAPIKey:input_type -> temporal.cloud.api.v1.RevokeAPIKeyRequest
+	15, // 40: temporal.cloud.api.v1.IdentityService.RotateAPIKey:input_type -> temporal.cloud.api.v1.RotateAPIKeyRequest
+	17, // 41: temporal.cloud.api.v1.IdentityService.CreateServiceAccount:input_type -> temporal.cloud.api.v1.CreateServiceAccountRequest
+	19, // 42: temporal.cloud.api.v1.IdentityService.GetServiceAccount:input_type -> temporal.cloud.api.v1.GetServiceAccountRequest
+	21, // 43: temporal.cloud.api.v1.IdentityService.ListServiceAccounts:input_type -> temporal.cloud.api.v1.ListServiceAccountsRequest
+	23, // 44: temporal.cloud.api.v1.IdentityService.UpdateServiceAccount:input_type -> temporal.cloud.api.v1.UpdateServiceAccountRequest
+	25, // 45: temporal.cloud.api.v1.IdentityService.DeleteServiceAccount:input_type -> temporal.cloud.api.v1.DeleteServiceAccountRequest
+	27, // 46: temporal.cloud.api.v1.IdentityService.GetUser:input_type -> temporal.cloud.api.v1.GetUserRequest
+	29, // 47: temporal.cloud.api.v1.IdentityService.UpdateUser:input_type -> temporal.cloud.api.v1.UpdateUserRequest
+	31, // 48: temporal.cloud.api.v1.IdentityService.InitiateSAMLLogin:input_type -> temporal.cloud.api.v1.InitiateSAMLLoginRequest
+	33, // 49: temporal.cloud.api.v1.IdentityService.CompleteSAMLLogin:input_type -> temporal.cloud.api.v1.CompleteSAMLLoginRequest
+	35, // 50: temporal.cloud.api.v1.IdentityService.RotateSCIMToken:input_type -> temporal.cloud.api.v1.RotateSCIMTokenRequest
+	37, // 51: temporal.cloud.api.v1.IdentityService.ListUserGroups:input_type -> temporal.cloud.api.v1.ListUserGroupsRequest
+	39, // 52: temporal.cloud.api.v1.IdentityService.SetUserGroupNamespacePermissions:input_type -> temporal.cloud.api.v1.SetUserGroupNamespacePermissionsRequest
+	42, // 53: temporal.cloud.api.v1.IdentityService.AddDomain:input_type -> temporal.cloud.api.v1.AddDomainRequest
+	44, // 54: temporal.cloud.api.v1.IdentityService.VerifyDomain:input_type -> temporal.cloud.api.v1.VerifyDomainRequest
+	46, // 55: temporal.cloud.api.v1.IdentityService.ListDomains:input_type -> temporal.cloud.api.v1.ListDomainsRequest
+	48, // 56: temporal.cloud.api.v1.IdentityService.RemoveDomain:input_type -> temporal.cloud.api.v1.RemoveDomainRequest
+	8,  // 57: temporal.cloud.api.v1.IdentityService.CreateAPIKey:output_type -> temporal.cloud.api.v1.CreateAPIKeyResponse
+	10, // 58: temporal.cloud.api.v1.IdentityService.GetAPIKey:output_type -> temporal.cloud.api.v1.GetAPIKeyResponse
+	12, // 59: temporal.cloud.api.v1.IdentityService.ListAPIKeys:output_type -> temporal.cloud.api.v1.ListAPIKeysResponse
+	14, // 60: temporal.cloud.api.v1.IdentityService.RevokeAPIKey:output_type -> temporal.cloud.api.v1.RevokeAPIKeyResponse
+	16, // 61: temporal.cloud.api.v1.IdentityService.RotateAPIKey:output_type -> temporal.cloud.api.v1.RotateAPIKeyResponse
+	18, // 62: temporal.cloud.api.v1.IdentityService.CreateServiceAccount:output_type -> temporal.cloud.api.v1.CreateServiceAccountResponse
+	20, // 63: temporal.cloud.api.v1.IdentityService.GetServiceAccount:output_type -> temporal.cloud.api.v1.GetServiceAccountResponse
+	22, // 64: temporal.cloud.api.v1.IdentityService.ListServiceAccounts:output_type -> temporal.cloud.api.v1.ListServiceAccountsResponse
+	24, // 65: temporal.cloud.api.v1.IdentityService.UpdateServiceAccount:output_type -> temporal.cloud.api.v1.UpdateServiceAccountResponse
+	26, // 66: temporal.cloud.api.v1.IdentityService.DeleteServiceAccount:output_type -> temporal.cloud.api.v1.DeleteServiceAccountResponse
+	28, // 67: temporal.cloud.api.v1.IdentityService.GetUser:output_type -> temporal.cloud.api.v1.GetUserResponse
+	30, // 68: temporal.cloud.api.v1.IdentityService.UpdateUser:output_type -> temporal.cloud.api.v1.UpdateUserResponse
+	32, // 69: temporal.cloud.api.v1.IdentityService.InitiateSAMLLogin:output_type -> temporal.cloud.api.v1.InitiateSAMLLoginResponse
+	34, // 70: temporal.cloud.api.v1.IdentityService.CompleteSAMLLogin:output_type -> temporal.cloud.api.v1.CompleteSAMLLoginResponse
+	36, // 71: temporal.cloud.api.v1.IdentityService.RotateSCIMToken:output_type -> temporal.cloud.api.v1.RotateSCIMTokenResponse
+	38, // 72: temporal.cloud.api.v1.IdentityService.ListUserGroups:output_type -> temporal.cloud.api.v1.ListUserGroupsResponse
+	40, // 73: temporal.cloud.api.v1.IdentityService.SetUserGroupNamespacePermissions:output_type -> temporal.cloud.api.v1.SetUserGroupNamespacePermissionsResponse
+	43, // 74: temporal.cloud.api.v1.IdentityService.AddDomain:output_type -> temporal.cloud.api.v1.AddDomainResponse
+	45, // 75: temporal.cloud.api.v1.IdentityService.VerifyDomain:output_type -> temporal.cloud.api.v1.VerifyDomainResponse
+	47, // 76: temporal.cloud.api.v1.IdentityService.ListDomains:output_type -> temporal.cloud.api.v1.ListDomainsResponse
+	49, // 77: temporal.cloud.api.v1.IdentityService.RemoveDomain:output_type -> temporal.cloud.api.v1.RemoveDomainResponse
+	57, // [57:78] is the sub-list for method output_type
+	36, // [36:57] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_cloud_v1_identity_proto_init() }
+func file_cloud_v1_identity_proto_init() {
+	if File_cloud_v1_identity_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cloud_v1_identity_proto_rawDesc), len(file_cloud_v1_identity_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   49,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cloud_v1_identity_proto_goTypes,
+		DependencyIndexes: file_cloud_v1_identity_proto_depIdxs,
+		EnumInfos:         file_cloud_v1_identity_proto_enumTypes,
+		MessageInfos:      file_cloud_v1_identity_proto_msgTypes,
+	}.Build()
+	File_cloud_v1_identity_proto = out.File
+	file_cloud_v1_identity_proto_goTypes = nil
+	file_cloud_v1_identity_proto_depIdxs = nil
+}
@@ -0,0 +1,5456 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: cloud/v1/namespaces.proto
+
+package cloudv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NamespaceState represents the state of a namespace.
+type NamespaceState int32
+
+const (
+	NamespaceState_NAMESPACE_STATE_UNSPECIFIED  NamespaceState = 0
+	NamespaceState_NAMESPACE_STATE_PENDING      NamespaceState = 1
+	NamespaceState_NAMESPACE_STATE_PROVISIONING NamespaceState = 2
+	NamespaceState_NAMESPACE_STATE_ACTIVE       NamespaceState = 3
+	NamespaceState_NAMESPACE_STATE_UPDATING     NamespaceState = 4
+	NamespaceState_NAMESPACE_STATE_DELETING     NamespaceState = 5
+	NamespaceState_NAMESPACE_STATE_DELETED      NamespaceState = 6
+	NamespaceState_NAMESPACE_STATE_SUSPENDED    NamespaceState = 7
+	NamespaceState_NAMESPACE_STATE_FAILED       NamespaceState = 8
+)
+
+// Enum value maps for NamespaceState.
+var (
+	NamespaceState_name = map[int32]string{
+		0: "NAMESPACE_STATE_UNSPECIFIED",
+		1: "NAMESPACE_STATE_PENDING",
+		2: "NAMESPACE_STATE_PROVISIONING",
+		3: "NAMESPACE_STATE_ACTIVE",
+		4: "NAMESPACE_STATE_UPDATING",
+		5: "NAMESPACE_STATE_DELETING",
+		6: "NAMESPACE_STATE_DELETED",
+		7: "NAMESPACE_STATE_SUSPENDED",
+		8: "NAMESPACE_STATE_FAILED",
+	}
+	NamespaceState_value = map[string]int32{
+		"NAMESPACE_STATE_UNSPECIFIED":  0,
+		"NAMESPACE_STATE_PENDING":      1,
+		"NAMESPACE_STATE_PROVISIONING": 2,
+		"NAMESPACE_STATE_ACTIVE":       3,
+		"NAMESPACE_STATE_UPDATING":     4,
+		"NAMESPACE_STATE_DELETING":     5,
+		"NAMESPACE_STATE_DELETED":      6,
+		"NAMESPACE_STATE_SUSPENDED":    7,
+		"NAMESPACE_STATE_FAILED":       8,
+	}
+)
+
+func (x NamespaceState) Enum() *NamespaceState {
+	p := new(NamespaceState)
+	*p = x
+	return p
+}
+
+func (x NamespaceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NamespaceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_namespaces_proto_enumTypes[0].Descriptor()
+}
+
+func (NamespaceState) Type() protoreflect.EnumType {
+	return &file_cloud_v1_namespaces_proto_enumTypes[0]
+}
+
+func (x NamespaceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NamespaceState.Descriptor instead.
+func (NamespaceState) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{0}
+}
+
+// SearchAttributeType defines search attribute types.
+type SearchAttributeType int32
+
+const (
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_UNSPECIFIED  SearchAttributeType = 0
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_TEXT         SearchAttributeType = 1
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_KEYWORD      SearchAttributeType = 2
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_INT          SearchAttributeType = 3
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_DOUBLE       SearchAttributeType = 4
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_BOOL         SearchAttributeType = 5
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_DATETIME     SearchAttributeType = 6
+	SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_KEYWORD_LIST SearchAttributeType = 7
+)
+
+// Enum value maps for SearchAttributeType.
+var (
+	SearchAttributeType_name = map[int32]string{
+		0: "SEARCH_ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "SEARCH_ATTRIBUTE_TYPE_TEXT",
+		2: "SEARCH_ATTRIBUTE_TYPE_KEYWORD",
+		3: "SEARCH_ATTRIBUTE_TYPE_INT",
+		4: "SEARCH_ATTRIBUTE_TYPE_DOUBLE",
+		5: "SEARCH_ATTRIBUTE_TYPE_BOOL",
+		6: "SEARCH_ATTRIBUTE_TYPE_DATETIME",
+		7: "SEARCH_ATTRIBUTE_TYPE_KEYWORD_LIST",
+	}
+	SearchAttributeType_value = map[string]int32{
+		"SEARCH_ATTRIBUTE_TYPE_UNSPECIFIED":  0,
+		"SEARCH_ATTRIBUTE_TYPE_TEXT":         1,
+		"SEARCH_ATTRIBUTE_TYPE_KEYWORD":      2,
+		"SEARCH_ATTRIBUTE_TYPE_INT":          3,
+		"SEARCH_ATTRIBUTE_TYPE_DOUBLE":       4,
+		"SEARCH_ATTRIBUTE_TYPE_BOOL":         5,
+		"SEARCH_ATTRIBUTE_TYPE_DATETIME":     6,
+		"SEARCH_ATTRIBUTE_TYPE_KEYWORD_LIST": 7,
+	}
+)
+
+func (x SearchAttributeType) Enum() *SearchAttributeType {
+	p := new(SearchAttributeType)
+	*p = x
+	return p
+}
+
+func (x SearchAttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchAttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_namespaces_proto_enumTypes[1].Descriptor()
+}
+
+func (SearchAttributeType) Type() protoreflect.EnumType {
+	return &file_cloud_v1_namespaces_proto_enumTypes[1]
+}
+
+func (x SearchAttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchAttributeType.Descriptor instead.
+func (SearchAttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{1}
+}
+
+// ExportJobState represents the state of an export job.
+type ExportJobState int32
+
+const (
+	ExportJobState_EXPORT_JOB_STATE_UNSPECIFIED ExportJobState = 0
+	ExportJobState_EXPORT_JOB_STATE_PENDING     ExportJobState = 1
+	ExportJobState_EXPORT_JOB_STATE_RUNNING     ExportJobState = 2
+	ExportJobState_EXPORT_JOB_STATE_COMPLETED   ExportJobState = 3
+	ExportJobState_EXPORT_JOB_STATE_FAILED      ExportJobState = 4
+)
+
+// Enum value maps for ExportJobState.
+var (
+	ExportJobState_name = map[int32]string{
+		0: "EXPORT_JOB_STATE_UNSPECIFIED",
+		1: "EXPORT_JOB_STATE_PENDING",
+		2: "EXPORT_JOB_STATE_RUNNING",
+		3: "EXPORT_JOB_STATE_COMPLETED",
+		4: "EXPORT_JOB_STATE_FAILED",
+	}
+	ExportJobState_value = map[string]int32{
+		"EXPORT_JOB_STATE_UNSPECIFIED": 0,
+		"EXPORT_JOB_STATE_PENDING":     1,
+		"EXPORT_JOB_STATE_RUNNING":     2,
+		"EXPORT_JOB_STATE_COMPLETED":   3,
+		"EXPORT_JOB_STATE_FAILED":      4,
+	}
+)
+
+func (x ExportJobState) Enum() *ExportJobState {
+	p := new(ExportJobState)
+	*p = x
+	return p
+}
+
+func (x ExportJobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportJobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_namespaces_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportJobState) Type() protoreflect.EnumType {
+	return &file_cloud_v1_namespaces_proto_enumTypes[2]
+}
+
+func (x ExportJobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportJobState.Descriptor instead.
+func (ExportJobState) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{2}
+}
+
+// NexusEndpointState is the provisioning state of a Nexus endpoint.
+type NexusEndpointState int32
+
+const (
+	NexusEndpointState_NEXUS_ENDPOINT_STATE_UNSPECIFIED NexusEndpointState = 0
+	NexusEndpointState_NEXUS_ENDPOINT_STATE_PENDING     NexusEndpointState = 1
+	NexusEndpointState_NEXUS_ENDPOINT_STATE_ACTIVE      NexusEndpointState = 2
+	NexusEndpointState_NEXUS_ENDPOINT_STATE_FAILED      NexusEndpointState = 3
+	NexusEndpointState_NEXUS_ENDPOINT_STATE_DELETING    NexusEndpointState = 4
+)
+
+// Enum value maps for NexusEndpointState.
+var (
+	NexusEndpointState_name = map[int32]string{
+		0: "NEXUS_ENDPOINT_STATE_UNSPECIFIED",
+		1: "NEXUS_ENDPOINT_STATE_PENDING",
+		2: "NEXUS_ENDPOINT_STATE_ACTIVE",
+		3: "NEXUS_ENDPOINT_STATE_FAILED",
+		4: "NEXUS_ENDPOINT_STATE_DELETING",
+	}
+	NexusEndpointState_value = map[string]int32{
+		"NEXUS_ENDPOINT_STATE_UNSPECIFIED": 0,
+		"NEXUS_ENDPOINT_STATE_PENDING":     1,
+		"NEXUS_ENDPOINT_STATE_ACTIVE":      2,
+		"NEXUS_ENDPOINT_STATE_FAILED":      3,
+		"NEXUS_ENDPOINT_STATE_DELETING":    4,
+	}
+)
+
+func (x NexusEndpointState) Enum() *NexusEndpointState {
+	p := new(NexusEndpointState)
+	*p = x
+	return p
+}
+
+func (x NexusEndpointState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NexusEndpointState) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_namespaces_proto_enumTypes[3].Descriptor()
+}
+
+func (NexusEndpointState) Type() protoreflect.EnumType {
+	return &file_cloud_v1_namespaces_proto_enumTypes[3]
+}
+
+func (x NexusEndpointState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NexusEndpointState.Descriptor instead.
+func (NexusEndpointState) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{3}
+}
+
+// Namespace represents a Temporal Cloud namespace.
+type Namespace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the namespace.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the namespace (unique within organization).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Region where the namespace is deployed.
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// Current state of the namespace.
+	State NamespaceState `protobuf:"varint,5,opt,name=state,proto3,enum=temporal.cloud.api.v1.NamespaceState" json:"state,omitempty"`
+	// Namespace configuration.
+	Config *NamespaceConfig `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
+	// Namespace endpoints.
+	Endpoints *NamespaceEndpoints `protobuf:"bytes,7,opt,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// Custom search attributes.
+	SearchAttributes []*SearchAttribute `protobuf:"bytes,8,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	// Certificate filters for mTLS.
+	CertificateFilters []*CertificateFilter `protobuf:"bytes,9,rep,name=certificate_filters,json=certificateFilters,proto3" json:"certificate_filters,omitempty"`
+	// Tags for organization.
+	Tags map[string]string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Timestamp when the namespace was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the namespace was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Namespace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Namespace) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Namespace) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Namespace) GetState() NamespaceState {
+	if x != nil {
+		return x.State
+	}
+	return NamespaceState_NAMESPACE_STATE_UNSPECIFIED
+}
+
+func (x *Namespace) GetConfig() *NamespaceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Namespace) GetEndpoints() *NamespaceEndpoints {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *Namespace) GetSearchAttributes() []*SearchAttribute {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+func (x *Namespace) GetCertificateFilters() []*CertificateFilter {
+	if x != nil {
+		return x.CertificateFilters
+	}
+	return nil
+}
+
+func (x *Namespace) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Namespace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Namespace) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// NamespaceConfig contains namespace configuration.
+type NamespaceConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Retention period for workflow history.
+	RetentionPeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=retention_period,json=retentionPeriod,proto3" json:"retention_period,omitempty"`
+	// Whether deletion protection is enabled.
+	DeletionProtected bool `protobuf:"varint,2,opt,name=deletion_protected,json=deletionProtected,proto3" json:"deletion_protected,omitempty"`
+	// High availability configuration.
+	HaConfig *HighAvailabilityConfig `protobuf:"bytes,3,opt,name=ha_config,json=haConfig,proto3" json:"ha_config,omitempty"`
+	// Codec server configuration.
+	CodecServer   *CodecServerConfig `protobuf:"bytes,4,opt,name=codec_server,json=codecServer,proto3" json:"codec_server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceConfig) Reset() {
+	*x = NamespaceConfig{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceConfig) ProtoMessage() {}
+
+func (x *NamespaceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceConfig.ProtoReflect.Descriptor instead.
+func (*NamespaceConfig) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NamespaceConfig) GetRetentionPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.RetentionPeriod
+	}
+	return nil
+}
+
+func (x *NamespaceConfig) GetDeletionProtected() bool {
+	if x != nil {
+		return x.DeletionProtected
+	}
+	return false
+}
+
+func (x *NamespaceConfig) GetHaConfig() *HighAvailabilityConfig {
+	if x != nil {
+		return x.HaConfig
+	}
+	return nil
+}
+
+func (x *NamespaceConfig) GetCodecServer() *CodecServerConfig {
+	if x != nil {
+		return x.CodecServer
+	}
+	return nil
+}
+
+// HighAvailabilityConfig contains HA configuration.
+type HighAvailabilityConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether multi-region HA is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Standby region.
+	StandbyRegion string `protobuf:"bytes,2,opt,name=standby_region,json=standbyRegion,proto3" json:"standby_region,omitempty"`
+	// Replication lag threshold for failover.
+	FailoverThreshold *durationpb.Duration `protobuf:"bytes,3,opt,name=failover_threshold,json=failoverThreshold,proto3" json:"failover_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HighAvailabilityConfig) Reset() {
+	*x = HighAvailabilityConfig{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighAvailabilityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighAvailabilityConfig) ProtoMessage() {}
+
+func (x *HighAvailabilityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighAvailabilityConfig.ProtoReflect.Descriptor instead.
+func (*HighAvailabilityConfig) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HighAvailabilityConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *HighAvailabilityConfig) GetStandbyRegion() string {
+	if x != nil {
+		return x.StandbyRegion
+	}
+	return ""
+}
+
+func (x *HighAvailabilityConfig) GetFailoverThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.FailoverThreshold
+	}
+	return nil
+}
+
+// CodecServerConfig contains codec server configuration.
+type CodecServerConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Codec server endpoint URL.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Whether to pass access token to codec server.
+	PassAccessToken bool `protobuf:"varint,2,opt,name=pass_access_token,json=passAccessToken,proto3" json:"pass_access_token,omitempty"`
+	// Whether to include cross-origin credentials.
+	IncludeCredentials bool `protobuf:"varint,3,opt,name=include_credentials,json=includeCredentials,proto3" json:"include_credentials,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CodecServerConfig) Reset() {
+	*x = CodecServerConfig{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodecServerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodecServerConfig) ProtoMessage() {}
+
+func (x *CodecServerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodecServerConfig.ProtoReflect.Descriptor instead.
+func (*CodecServerConfig) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CodecServerConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *CodecServerConfig) GetPassAccessToken() bool {
+	if x != nil {
+		return x.PassAccessToken
+	}
+	return false
+}
+
+func (x *CodecServerConfig) GetIncludeCredentials() bool {
+	if x != nil {
+		return x.IncludeCredentials
+	}
+	return false
+}
+
+// NamespaceEndpoints contains namespace connection endpoints.
+type NamespaceEndpoints struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// gRPC endpoint for SDK connections.
+	GrpcEndpoint string `protobuf:"bytes,1,opt,name=grpc_endpoint,json=grpcEndpoint,proto3" json:"grpc_endpoint,omitempty"`
+	// Web UI endpoint.
+	WebEndpoint string `protobuf:"bytes,2,opt,name=web_endpoint,json=webEndpoint,proto3" json:"web_endpoint,omitempty"`
+	// Metrics endpoint for Prometheus scraping.
+	MetricsEndpoint string `protobuf:"bytes,3,opt,name=metrics_endpoint,json=metricsEndpoint,proto3" json:"metrics_endpoint,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NamespaceEndpoints) Reset() {
+	*x = NamespaceEndpoints{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceEndpoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceEndpoints) ProtoMessage() {}
+
+func (x *NamespaceEndpoints) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceEndpoints.ProtoReflect.Descriptor instead.
+func (*NamespaceEndpoints) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NamespaceEndpoints) GetGrpcEndpoint() string {
+	if x != nil {
+		return x.GrpcEndpoint
+	}
+	return ""
+}
+
+func (x *NamespaceEndpoints) GetWebEndpoint() string {
+	if x != nil {
+		return x.WebEndpoint
+	}
+	return ""
+}
+
+func (x *NamespaceEndpoints) GetMetricsEndpoint() string {
+	if x != nil {
+		return x.MetricsEndpoint
+	}
+	return ""
+}
+
+// SearchAttribute represents a custom search attribute.
+type SearchAttribute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the search attribute.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of the search attribute.
+	Type          SearchAttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=temporal.cloud.api.v1.SearchAttributeType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAttribute) Reset() {
+	*x = SearchAttribute{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAttribute) ProtoMessage() {}
+
+func (x *SearchAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAttribute.ProtoReflect.Descriptor instead.
+func (*SearchAttribute) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchAttribute) GetType() SearchAttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+// CertificateFilter represents a certificate filter for mTLS.
+type CertificateFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Common Name filter (regex).
+	CommonName string `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	// Organization filter (regex).
+	Organization string `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Organizational Unit filter (regex).
+	OrganizationalUnit string `protobuf:"bytes,4,opt,name=organizational_unit,json=organizationalUnit,proto3" json:"organizational_unit,omitempty"`
+	// Subject Alternative Name filter (regex).
+	SubjectAlternativeName string `protobuf:"bytes,5,opt,name=subject_alternative_name,json=subjectAlternativeName,proto3" json:"subject_alternative_name,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CertificateFilter) Reset() {
+	*x = CertificateFilter{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateFilter) ProtoMessage() {}
+
+func (x *CertificateFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateFilter.ProtoReflect.Descriptor instead.
+func (*CertificateFilter) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CertificateFilter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertificateFilter) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *CertificateFilter) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *CertificateFilter) GetOrganizationalUnit() string {
+	if x != nil {
+		return x.OrganizationalUnit
+	}
+	return ""
+}
+
+func (x *CertificateFilter) GetSubjectAlternativeName() string {
+	if x != nil {
+		return x.SubjectAlternativeName
+	}
+	return ""
+}
+
+// ExportSink is a destination the histories of a namespace's closed
+// workflows are periodically exported to.
+type ExportSink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sink ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the sink (unique within the namespace).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Amazon S3 destination. Exactly one destination is set.
+	S3 *S3ExportDestination `protobuf:"bytes,3,opt,name=s3,proto3" json:"s3,omitempty"`
+	// Google Cloud Storage destination.
+	Gcs *GCSExportDestination `protobuf:"bytes,4,opt,name=gcs,proto3" json:"gcs,omitempty"`
+	// Whether scheduled exports write to the sink.
+	Enabled bool `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Close time up to which histories have been exported.
+	LastExportTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_export_time,json=lastExportTime,proto3" json:"last_export_time,omitempty"`
+	// Creation timestamp.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSink) Reset() {
+	*x = ExportSink{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSink) ProtoMessage() {}
+
+func (x *ExportSink) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSink.ProtoReflect.Descriptor instead.
+func (*ExportSink) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportSink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportSink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportSink) GetS3() *S3ExportDestination {
+	if x != nil {
+		return x.S3
+	}
+	return nil
+}
+
+func (x *ExportSink) GetGcs() *GCSExportDestination {
+	if x != nil {
+		return x.Gcs
+	}
+	return nil
+}
+
+func (x *ExportSink) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ExportSink) GetLastExportTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastExportTime
+	}
+	return nil
+}
+
+func (x *ExportSink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExportSink) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// S3ExportDestination is an Amazon S3 bucket. Histories are written by
+// assuming a role in the bucket owner's account.
+type S3ExportDestination struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name.
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Key prefix histories are written under.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// AWS region of the bucket.
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	// ARN of the IAM role assumed to write to the bucket. The role's trust
+	// policy must require external_id.
+	RoleArn string `protobuf:"bytes,5,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// External ID presented when assuming the role: the organization's ID.
+	// Output only.
+	ExternalId    string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S3ExportDestination) Reset() {
+	*x = S3ExportDestination{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S3ExportDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S3ExportDestination) ProtoMessage() {}
+
+func (x *S3ExportDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S3ExportDestination.ProtoReflect.Descriptor instead.
+func (*S3ExportDestination) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *S3ExportDestination) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *S3ExportDestination) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *S3ExportDestination) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *S3ExportDestination) GetRoleArn() string {
+	if x != nil {
+		return x.RoleArn
+	}
+	return ""
+}
+
+func (x *S3ExportDestination) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// GCSExportDestination is a Google Cloud Storage bucket.
+type GCSExportDestination struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name.
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Object prefix histories are written under.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Email of the service account impersonated to write to the bucket.
+	ServiceAccount string `protobuf:"bytes,3,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GCSExportDestination) Reset() {
+	*x = GCSExportDestination{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GCSExportDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCSExportDestination) ProtoMessage() {}
+
+func (x *GCSExportDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCSExportDestination.ProtoReflect.Descriptor instead.
+func (*GCSExportDestination) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GCSExportDestination) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GCSExportDestination) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *GCSExportDestination) GetServiceAccount() string {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return ""
+}
+
+// ExportJob is one run of an export sink.
+type ExportJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Sink ID.
+	SinkId string `protobuf:"bytes,2,opt,name=sink_id,json=sinkId,proto3" json:"sink_id,omitempty"`
+	// Current state.
+	State ExportJobState `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.cloud.api.v1.ExportJobState" json:"state,omitempty"`
+	// Number of workflow histories exported.
+	WorkflowsExported int64 `protobuf:"varint,4,opt,name=workflows_exported,json=workflowsExported,proto3" json:"workflows_exported,omitempty"`
+	// Number of bytes of history exported.
+	BytesExported int64 `protobuf:"varint,5,opt,name=bytes_exported,json=bytesExported,proto3" json:"bytes_exported,omitempty"`
+	// Why the job failed.
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Start of the period of close times the job covers.
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// End of the period of close times the job covers.
+	WindowEnd *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// When the job started running.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// When the job completed or failed.
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJob) Reset() {
+	*x = ExportJob{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJob) ProtoMessage() {}
+
+func (x *ExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJob.ProtoReflect.Descriptor instead.
+func (*ExportJob) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportJob) GetSinkId() string {
+	if x != nil {
+		return x.SinkId
+	}
+	return ""
+}
+
+func (x *ExportJob) GetState() ExportJobState {
+	if x != nil {
+		return x.State
+	}
+	return ExportJobState_EXPORT_JOB_STATE_UNSPECIFIED
+}
+
+func (x *ExportJob) GetWorkflowsExported() int64 {
+	if x != nil {
+		return x.WorkflowsExported
+	}
+	return 0
+}
+
+func (x *ExportJob) GetBytesExported() int64 {
+	if x != nil {
+		return x.BytesExported
+	}
+	return 0
+}
+
+func (x *ExportJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ExportJob) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ExportJob) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *ExportJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ExportJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// ConnectivityRule controls how clients reach the namespaces it is bound to.
+// A namespace bound to enabled IP allowlist rules only accepts requests from
+// the addresses they allow.
+type ConnectivityRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rule ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the rule (unique within the organization).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// IP allowlist. Exactly one of ip_allowlist, private_link and vpc_peering
+	// is set.
+	IpAllowlist *IPAllowlistRule `protobuf:"bytes,3,opt,name=ip_allowlist,json=ipAllowlist,proto3" json:"ip_allowlist,omitempty"`
+	// AWS PrivateLink connection.
+	PrivateLink *PrivateLinkRule `protobuf:"bytes,4,opt,name=private_link,json=privateLink,proto3" json:"private_link,omitempty"`
+	// VPC peering connection.
+	VpcPeering *VPCPeeringRule `protobuf:"bytes,5,opt,name=vpc_peering,json=vpcPeering,proto3" json:"vpc_peering,omitempty"`
+	// Whether the rule is applied to its namespaces.
+	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Creation timestamp.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectivityRule) Reset() {
+	*x = ConnectivityRule{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectivityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectivityRule) ProtoMessage() {}
+
+func (x *ConnectivityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectivityRule.ProtoReflect.Descriptor instead.
+func (*ConnectivityRule) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConnectivityRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConnectivityRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConnectivityRule) GetIpAllowlist() *IPAllowlistRule {
+	if x != nil {
+		return x.IpAllowlist
+	}
+	return nil
+}
+
+func (x *ConnectivityRule) GetPrivateLink() *PrivateLinkRule {
+	if x != nil {
+		return x.PrivateLink
+	}
+	return nil
+}
+
+func (x *ConnectivityRule) GetVpcPeering() *VPCPeeringRule {
+	if x != nil {
+		return x.VpcPeering
+	}
+	return nil
+}
+
+func (x *ConnectivityRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ConnectivityRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ConnectivityRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// IPAllowlistRule allows requests from a set of addresses.
+type IPAllowlistRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Allowed IPv4 and IPv6 addresses and CIDRs.
+	Cidrs         []string `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPAllowlistRule) Reset() {
+	*x = IPAllowlistRule{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPAllowlistRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPAllowlistRule) ProtoMessage() {}
+
+func (x *IPAllowlistRule) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPAllowlistRule.ProtoReflect.Descriptor instead.
+func (*IPAllowlistRule) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IPAllowlistRule) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+// PrivateLinkRule is an AWS PrivateLink connection to the cluster.
+type PrivateLinkRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the VPC endpoint.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// AWS region of the endpoint.
+	Region        string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivateLinkRule) Reset() {
+	*x = PrivateLinkRule{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateLinkRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateLinkRule) ProtoMessage() {}
+
+func (x *PrivateLinkRule) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateLinkRule.ProtoReflect.Descriptor instead.
+func (*PrivateLinkRule) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PrivateLinkRule) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *PrivateLinkRule) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// VPCPeeringRule is a VPC peering connection to the cluster.
+type VPCPeeringRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the peered VPC.
+	VpcId string `protobuf:"bytes,1,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	// AWS account owning the peered VPC.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// AWS region of the peered VPC.
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	// CIDR of the peered VPC.
+	Cidr          string `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VPCPeeringRule) Reset() {
+	*x = VPCPeeringRule{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPCPeeringRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPCPeeringRule) ProtoMessage() {}
+
+func (x *VPCPeeringRule) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPCPeeringRule.ProtoReflect.Descriptor instead.
+func (*VPCPeeringRule) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VPCPeeringRule) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
+
+func (x *VPCPeeringRule) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *VPCPeeringRule) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *VPCPeeringRule) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+// CreateNamespaceRequest is the request for CreateNamespace.
+type CreateNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Name of the namespace.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Region to deploy the namespace.
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	// Namespace configuration.
+	Config *NamespaceConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// Tags for organization.
+	Tags          map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNamespaceRequest) Reset() {
+	*x = CreateNamespaceRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceRequest) ProtoMessage() {}
+
+func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateNamespaceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNamespaceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateNamespaceRequest) GetConfig() *NamespaceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateNamespaceRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// CreateNamespaceResponse is the response for CreateNamespace.
+type CreateNamespaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created namespace.
+	Namespace *Namespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Async operation ID for tracking provisioning.
+	OperationId   string `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNamespaceResponse) Reset() {
+	*x = CreateNamespaceResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceResponse) ProtoMessage() {}
+
+func (x *CreateNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *CreateNamespaceResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// GetNamespaceRequest is the request for GetNamespace.
+type GetNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId   string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetNamespaceRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+// GetNamespaceResponse is the response for GetNamespace.
+type GetNamespaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The namespace.
+	Namespace     *Namespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceResponse) Reset() {
+	*x = GetNamespaceResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceResponse) ProtoMessage() {}
+
+func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+// UpdateNamespaceRequest is the request for UpdateNamespace.
+type UpdateNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Updated namespace data.
+	Namespace *Namespace `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceRequest) Reset() {
+	*x = UpdateNamespaceRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceRequest) ProtoMessage() {}
+
+func (x *UpdateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateNamespaceRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *UpdateNamespaceRequest) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *UpdateNamespaceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateNamespaceResponse is the response for UpdateNamespace.
+type UpdateNamespaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated namespace.
+	Namespace *Namespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Async operation ID for tracking update.
+	OperationId   string `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceResponse) Reset() {
+	*x = UpdateNamespaceResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceResponse) ProtoMessage() {}
+
+func (x *UpdateNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *UpdateNamespaceResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// DeleteNamespaceRequest is the request for DeleteNamespace.
+type DeleteNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId   string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceRequest) Reset() {
+	*x = DeleteNamespaceRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceRequest) ProtoMessage() {}
+
+func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteNamespaceRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+// DeleteNamespaceResponse is the response for DeleteNamespace.
+type DeleteNamespaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Async operation ID for tracking deletion.
+	OperationId   string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceResponse) Reset() {
+	*x = DeleteNamespaceResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceResponse) ProtoMessage() {}
+
+func (x *DeleteNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteNamespaceResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// ListNamespacesRequest is the request for ListNamespaces.
+type ListNamespacesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of namespaces to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filter by region.
+	RegionFilter string `protobuf:"bytes,4,opt,name=region_filter,json=regionFilter,proto3" json:"region_filter,omitempty"`
+	// Filter by state.
+	StateFilter   NamespaceState `protobuf:"varint,5,opt,name=state_filter,json=stateFilter,proto3,enum=temporal.cloud.api.v1.NamespaceState" json:"state_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesRequest) Reset() {
+	*x = ListNamespacesRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesRequest) ProtoMessage() {}
+
+func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListNamespacesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListNamespacesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNamespacesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNamespacesRequest) GetRegionFilter() string {
+	if x != nil {
+		return x.RegionFilter
+	}
+	return ""
+}
+
+func (x *ListNamespacesRequest) GetStateFilter() NamespaceState {
+	if x != nil {
+		return x.StateFilter
+	}
+	return NamespaceState_NAMESPACE_STATE_UNSPECIFIED
+}
+
+// ListNamespacesResponse is the response for ListNamespaces.
+type ListNamespacesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of namespaces.
+	Namespaces []*Namespace `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesResponse) Reset() {
+	*x = ListNamespacesResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesResponse) ProtoMessage() {}
+
+func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListNamespacesResponse) GetNamespaces() []*Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *ListNamespacesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AddSearchAttributesRequest is the request for AddSearchAttributes.
+type AddSearchAttributesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Search attributes to add.
+	SearchAttributes []*SearchAttribute `protobuf:"bytes,2,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddSearchAttributesRequest) Reset() {
+	*x = AddSearchAttributesRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSearchAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSearchAttributesRequest) ProtoMessage() {}
+
+func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddSearchAttributesRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *AddSearchAttributesRequest) GetSearchAttributes() []*SearchAttribute {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+// AddSearchAttributesResponse is the response for AddSearchAttributes.
+type AddSearchAttributesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Async operation ID.
+	OperationId   string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSearchAttributesResponse) Reset() {
+	*x = AddSearchAttributesResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSearchAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSearchAttributesResponse) ProtoMessage() {}
+
+func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddSearchAttributesResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// RemoveSearchAttributeRequest is the request for RemoveSearchAttribute.
+type RemoveSearchAttributeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Name of the search attribute to remove.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSearchAttributeRequest) Reset() {
+	*x = RemoveSearchAttributeRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSearchAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSearchAttributeRequest) ProtoMessage() {}
+
+func (x *RemoveSearchAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSearchAttributeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveSearchAttributeRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *RemoveSearchAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RemoveSearchAttributeResponse is the response for RemoveSearchAttribute.
+type RemoveSearchAttributeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Async operation ID.
+	OperationId   string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSearchAttributeResponse) Reset() {
+	*x = RemoveSearchAttributeResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSearchAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSearchAttributeResponse) ProtoMessage() {}
+
+func (x *RemoveSearchAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSearchAttributeResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveSearchAttributeResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// AddCertificateFilterRequest is the request for AddCertificateFilter.
+type AddCertificateFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Certificate filter to add.
+	CertificateFilter *CertificateFilter `protobuf:"bytes,2,opt,name=certificate_filter,json=certificateFilter,proto3" json:"certificate_filter,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddCertificateFilterRequest) Reset() {
+	*x = AddCertificateFilterRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCertificateFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCertificateFilterRequest) ProtoMessage() {}
+
+func (x *AddCertificateFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCertificateFilterRequest.ProtoReflect.Descriptor instead.
+func (*AddCertificateFilterRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddCertificateFilterRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *AddCertificateFilterRequest) GetCertificateFilter() *CertificateFilter {
+	if x != nil {
+		return x.CertificateFilter
+	}
+	return nil
+}
+
+// AddCertificateFilterResponse is the response for AddCertificateFilter.
+type AddCertificateFilterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The added certificate filter.
+	CertificateFilter *CertificateFilter `protobuf:"bytes,1,opt,name=certificate_filter,json=certificateFilter,proto3" json:"certificate_filter,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddCertificateFilterResponse) Reset() {
+	*x = AddCertificateFilterResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCertificateFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCertificateFilterResponse) ProtoMessage() {}
+
+func (x *AddCertificateFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCertificateFilterResponse.ProtoReflect.Descriptor instead.
+func (*AddCertificateFilterResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddCertificateFilterResponse) GetCertificateFilter() *CertificateFilter {
+	if x != nil {
+		return x.CertificateFilter
+	}
+	return nil
+}
+
+// RemoveCertificateFilterRequest is the request for RemoveCertificateFilter.
+type RemoveCertificateFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Certificate filter ID.
+	FilterId      string `protobuf:"bytes,2,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCertificateFilterRequest) Reset() {
+	*x = RemoveCertificateFilterRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCertificateFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCertificateFilterRequest) ProtoMessage() {}
+
+func (x *RemoveCertificateFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCertificateFilterRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCertificateFilterRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveCertificateFilterRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *RemoveCertificateFilterRequest) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+// RemoveCertificateFilterResponse is the response for RemoveCertificateFilter.
+type RemoveCertificateFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCertificateFilterResponse) Reset() {
+	*x = RemoveCertificateFilterResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCertificateFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCertificateFilterResponse) ProtoMessage() {}
+
+func (x *RemoveCertificateFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCertificateFilterResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCertificateFilterResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{32}
+}
+
+// FailoverNamespaceRequest is the request for FailoverNamespace.
+type FailoverNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Target region for failover.
+	TargetRegion  string `protobuf:"bytes,2,opt,name=target_region,json=targetRegion,proto3" json:"target_region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailoverNamespaceRequest) Reset() {
+	*x = FailoverNamespaceRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverNamespaceRequest) ProtoMessage() {}
+
+func (x *FailoverNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*FailoverNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FailoverNamespaceRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *FailoverNamespaceRequest) GetTargetRegion() string {
+	if x != nil {
+		return x.TargetRegion
+	}
+	return ""
+}
+
+// FailoverNamespaceResponse is the response for FailoverNamespace.
+type FailoverNamespaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Async operation ID for tracking failover.
+	OperationId   string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailoverNamespaceResponse) Reset() {
+	*x = FailoverNamespaceResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverNamespaceResponse) ProtoMessage() {}
+
+func (x *FailoverNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*FailoverNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FailoverNamespaceResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// IssueClientCertificateRequest is the request for IssueClientCertificate.
+type IssueClientCertificateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Common name of the certificate.
+	CommonName    string `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueClientCertificateRequest) Reset() {
+	*x = IssueClientCertificateRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueClientCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueClientCertificateRequest) ProtoMessage() {}
+
+func (x *IssueClientCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueClientCertificateRequest.ProtoReflect.Descriptor instead.
+func (*IssueClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *IssueClientCertificateRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *IssueClientCertificateRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+// IssueClientCertificateResponse is the response for IssueClientCertificate.
+type IssueClientCertificateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM-encoded certificate.
+	CertificatePem string `protobuf:"bytes,1,opt,name=certificate_pem,json=certificatePem,proto3" json:"certificate_pem,omitempty"`
+	// PEM-encoded private key of the certificate.
+	PrivateKeyPem string `protobuf:"bytes,2,opt,name=private_key_pem,json=privateKeyPem,proto3" json:"private_key_pem,omitempty"`
+	// SHA-256 fingerprint of the certificate.
+	Fingerprint string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// When the certificate expires.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueClientCertificateResponse) Reset() {
+	*x = IssueClientCertificateResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueClientCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueClientCertificateResponse) ProtoMessage() {}
+
+func (x *IssueClientCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueClientCertificateResponse.ProtoReflect.Descriptor instead.
+func (*IssueClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *IssueClientCertificateResponse) GetCertificatePem() string {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return ""
+}
+
+func (x *IssueClientCertificateResponse) GetPrivateKeyPem() string {
+	if x != nil {
+		return x.PrivateKeyPem
+	}
+	return ""
+}
+
+func (x *IssueClientCertificateResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *IssueClientCertificateResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateExportSinkRequest is the request for CreateExportSink.
+type CreateExportSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Sink to create.
+	Sink          *ExportSink `protobuf:"bytes,2,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExportSinkRequest) Reset() {
+	*x = CreateExportSinkRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExportSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportSinkRequest) ProtoMessage() {}
+
+func (x *CreateExportSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportSinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateExportSinkRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateExportSinkRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *CreateExportSinkRequest) GetSink() *ExportSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// CreateExportSinkResponse is the response for CreateExportSink.
+type CreateExportSinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created sink.
+	Sink          *ExportSink `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExportSinkResponse) Reset() {
+	*x = CreateExportSinkResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExportSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportSinkResponse) ProtoMessage() {}
+
+func (x *CreateExportSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportSinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateExportSinkResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateExportSinkResponse) GetSink() *ExportSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// GetExportSinkRequest is the request for GetExportSink.
+type GetExportSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Sink ID.
+	SinkId        string `protobuf:"bytes,2,opt,name=sink_id,json=sinkId,proto3" json:"sink_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportSinkRequest) Reset() {
+	*x = GetExportSinkRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportSinkRequest) ProtoMessage() {}
+
+func (x *GetExportSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportSinkRequest.ProtoReflect.Descriptor instead.
+func (*GetExportSinkRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetExportSinkRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *GetExportSinkRequest) GetSinkId() string {
+	if x != nil {
+		return x.SinkId
+	}
+	return ""
+}
+
+// GetExportSinkResponse is the response for GetExportSink.
+type GetExportSinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sink.
+	Sink          *ExportSink `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportSinkResponse) Reset() {
+	*x = GetExportSinkResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportSinkResponse) ProtoMessage() {}
+
+func (x *GetExportSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportSinkResponse.ProtoReflect.Descriptor instead.
+func (*GetExportSinkResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetExportSinkResponse) GetSink() *ExportSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// ListExportSinksRequest is the request for ListExportSinks.
+type ListExportSinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId   string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportSinksRequest) Reset() {
+	*x = ListExportSinksRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportSinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportSinksRequest) ProtoMessage() {}
+
+func (x *ListExportSinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportSinksRequest.ProtoReflect.Descriptor instead.
+func (*ListExportSinksRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListExportSinksRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+// ListExportSinksResponse is the response for ListExportSinks.
+type ListExportSinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of sinks.
+	Sinks         []*ExportSink `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportSinksResponse) Reset() {
+	*x = ListExportSinksResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportSinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportSinksResponse) ProtoMessage() {}
+
+func (x *ListExportSinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportSinksResponse.ProtoReflect.Descriptor instead.
+func (*ListExportSinksResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListExportSinksResponse) GetSinks() []*ExportSink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+// UpdateExportSinkRequest is the request for UpdateExportSink.
+type UpdateExportSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Sink ID.
+	SinkId string `protobuf:"bytes,2,opt,name=sink_id,json=sinkId,proto3" json:"sink_id,omitempty"`
+	// The sink's new name, destination and enabled state.
+	Sink          *ExportSink `protobuf:"bytes,3,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExportSinkRequest) Reset() {
+	*x = UpdateExportSinkRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExportSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExportSinkRequest) ProtoMessage() {}
+
+func (x *UpdateExportSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExportSinkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExportSinkRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateExportSinkRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *UpdateExportSinkRequest) GetSinkId() string {
+	if x != nil {
+		return x.SinkId
+	}
+	return ""
+}
+
+func (x *UpdateExportSinkRequest) GetSink() *ExportSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// UpdateExportSinkResponse is the response for UpdateExportSink.
+type UpdateExportSinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated sink.
+	Sink          *ExportSink `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExportSinkResponse) Reset() {
+	*x = UpdateExportSinkResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExportSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExportSinkResponse) ProtoMessage() {}
+
+func (x *UpdateExportSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExportSinkResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExportSinkResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateExportSinkResponse) GetSink() *ExportSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// DeleteExportSinkRequest is the request for DeleteExportSink.
+type DeleteExportSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Sink ID.
+	SinkId        string `protobuf:"bytes,2,opt,name=sink_id,json=sinkId,proto3" json:"sink_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExportSinkRequest) Reset() {
+	*x = DeleteExportSinkRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExportSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExportSinkRequest) ProtoMessage() {}
+
+func (x *DeleteExportSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExportSinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExportSinkRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteExportSinkRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *DeleteExportSinkRequest) GetSinkId() string {
+	if x != nil {
+		return x.SinkId
+	}
+	return ""
+}
+
+// DeleteExportSinkResponse is the response for DeleteExportSink.
+type DeleteExportSinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExportSinkResponse) Reset() {
+	*x = DeleteExportSinkResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExportSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExportSinkResponse) ProtoMessage() {}
+
+func (x *DeleteExportSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExportSinkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExportSinkResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{46}
+}
+
+// ListExportJobsRequest is the request for ListExportJobs.
+type ListExportJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Sink ID.
+	SinkId string `protobuf:"bytes,2,opt,name=sink_id,json=sinkId,proto3" json:"sink_id,omitempty"`
+	// Maximum number of jobs to return.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportJobsRequest) Reset() {
+	*x = ListExportJobsRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportJobsRequest) ProtoMessage() {}
+
+func (x *ListExportJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListExportJobsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListExportJobsRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *ListExportJobsRequest) GetSinkId() string {
+	if x != nil {
+		return x.SinkId
+	}
+	return ""
+}
+
+func (x *ListExportJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExportJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListExportJobsResponse is the response for ListExportJobs.
+type ListExportJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of jobs.
+	Jobs []*ExportJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportJobsResponse) Reset() {
+	*x = ListExportJobsResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportJobsResponse) ProtoMessage() {}
+
+func (x *ListExportJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListExportJobsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListExportJobsResponse) GetJobs() []*ExportJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListExportJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreateConnectivityRuleRequest is the request for CreateConnectivityRule.
+type CreateConnectivityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Rule to create.
+	Rule          *ConnectivityRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConnectivityRuleRequest) Reset() {
+	*x = CreateConnectivityRuleRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConnectivityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConnectivityRuleRequest) ProtoMessage() {}
+
+func (x *CreateConnectivityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConnectivityRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateConnectivityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateConnectivityRuleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateConnectivityRuleRequest) GetRule() *ConnectivityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// CreateConnectivityRuleResponse is the response for CreateConnectivityRule.
+type CreateConnectivityRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created rule.
+	Rule          *ConnectivityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConnectivityRuleResponse) Reset() {
+	*x = CreateConnectivityRuleResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConnectivityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConnectivityRuleResponse) ProtoMessage() {}
+
+func (x *CreateConnectivityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConnectivityRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateConnectivityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateConnectivityRuleResponse) GetRule() *ConnectivityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// GetConnectivityRuleRequest is the request for GetConnectivityRule.
+type GetConnectivityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Rule ID.
+	RuleId        string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectivityRuleRequest) Reset() {
+	*x = GetConnectivityRuleRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectivityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectivityRuleRequest) ProtoMessage() {}
+
+func (x *GetConnectivityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectivityRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectivityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetConnectivityRuleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetConnectivityRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// GetConnectivityRuleResponse is the response for GetConnectivityRule.
+type GetConnectivityRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rule.
+	Rule          *ConnectivityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectivityRuleResponse) Reset() {
+	*x = GetConnectivityRuleResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectivityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectivityRuleResponse) ProtoMessage() {}
+
+func (x *GetConnectivityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectivityRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectivityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetConnectivityRuleResponse) GetRule() *ConnectivityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ListConnectivityRulesRequest is the request for ListConnectivityRules.
+type ListConnectivityRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of rules to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectivityRulesRequest) Reset() {
+	*x = ListConnectivityRulesRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectivityRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectivityRulesRequest) ProtoMessage() {}
+
+func (x *ListConnectivityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectivityRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectivityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListConnectivityRulesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListConnectivityRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListConnectivityRulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListConnectivityRulesResponse is the response for ListConnectivityRules.
+type ListConnectivityRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of rules.
+	Rules []*ConnectivityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectivityRulesResponse) Reset() {
+	*x = ListConnectivityRulesResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectivityRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectivityRulesResponse) ProtoMessage() {}
+
+func (x *ListConnectivityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectivityRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectivityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListConnectivityRulesResponse) GetRules() []*ConnectivityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListConnectivityRulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateConnectivityRuleRequest is the request for UpdateConnectivityRule.
+type UpdateConnectivityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Rule ID.
+	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// The rule's new name, configuration and enabled state. The type of a
+	// rule cannot be changed.
+	Rule          *ConnectivityRule `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConnectivityRuleRequest) Reset() {
+	*x = UpdateConnectivityRuleRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConnectivityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConnectivityRuleRequest) ProtoMessage() {}
+
+func (x *UpdateConnectivityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConnectivityRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConnectivityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateConnectivityRuleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateConnectivityRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *UpdateConnectivityRuleRequest) GetRule() *ConnectivityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// UpdateConnectivityRuleResponse is the response for UpdateConnectivityRule.
+type UpdateConnectivityRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated rule.
+	Rule          *ConnectivityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConnectivityRuleResponse) Reset() {
+	*x = UpdateConnectivityRuleResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConnectivityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConnectivityRuleResponse) ProtoMessage() {}
+
+func (x *UpdateConnectivityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConnectivityRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConnectivityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateConnectivityRuleResponse) GetRule() *ConnectivityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// DeleteConnectivityRuleRequest is the request for DeleteConnectivityRule.
+type DeleteConnectivityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Rule ID.
+	RuleId        string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConnectivityRuleRequest) Reset() {
+	*x = DeleteConnectivityRuleRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConnectivityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConnectivityRuleRequest) ProtoMessage() {}
+
+func (x *DeleteConnectivityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConnectivityRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConnectivityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteConnectivityRuleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteConnectivityRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// DeleteConnectivityRuleResponse is the response for DeleteConnectivityRule.
+type DeleteConnectivityRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConnectivityRuleResponse) Reset() {
+	*x = DeleteConnectivityRuleResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConnectivityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConnectivityRuleResponse) ProtoMessage() {}
+
+func (x *DeleteConnectivityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConnectivityRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConnectivityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{58}
+}
+
+// AddNamespaceConnectivityRuleRequest is the request for
+// AddNamespaceConnectivityRule.
+type AddNamespaceConnectivityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// ID of a rule of the namespace's organization.
+	RuleId        string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNamespaceConnectivityRuleRequest) Reset() {
+	*x = AddNamespaceConnectivityRuleRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNamespaceConnectivityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNamespaceConnectivityRuleRequest) ProtoMessage() {}
+
+func (x *AddNamespaceConnectivityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNamespaceConnectivityRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddNamespaceConnectivityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddNamespaceConnectivityRuleRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *AddNamespaceConnectivityRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// AddNamespaceConnectivityRuleResponse is the response for
+// AddNamespaceConnectivityRule.
+type AddNamespaceConnectivityRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNamespaceConnectivityRuleResponse) Reset() {
+	*x = AddNamespaceConnectivityRuleResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNamespaceConnectivityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNamespaceConnectivityRuleResponse) ProtoMessage() {}
+
+func (x *AddNamespaceConnectivityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNamespaceConnectivityRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddNamespaceConnectivityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{60}
+}
+
+// RemoveNamespaceConnectivityRuleRequest is the request for
+// RemoveNamespaceConnectivityRule.
+type RemoveNamespaceConnectivityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Rule ID.
+	RuleId        string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNamespaceConnectivityRuleRequest) Reset() {
+	*x = RemoveNamespaceConnectivityRuleRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNamespaceConnectivityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNamespaceConnectivityRuleRequest) ProtoMessage() {}
+
+func (x *RemoveNamespaceConnectivityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNamespaceConnectivityRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveNamespaceConnectivityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveNamespaceConnectivityRuleRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *RemoveNamespaceConnectivityRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// RemoveNamespaceConnectivityRuleResponse is the response for
+// RemoveNamespaceConnectivityRule.
+type RemoveNamespaceConnectivityRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNamespaceConnectivityRuleResponse) Reset() {
+	*x = RemoveNamespaceConnectivityRuleResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNamespaceConnectivityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNamespaceConnectivityRuleResponse) ProtoMessage() {}
+
+func (x *RemoveNamespaceConnectivityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNamespaceConnectivityRuleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveNamespaceConnectivityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{62}
+}
+
+// ListNamespaceConnectivityRulesRequest is the request for
+// ListNamespaceConnectivityRules.
+type ListNamespaceConnectivityRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace ID.
+	NamespaceId   string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespaceConnectivityRulesRequest) Reset() {
+	*x = ListNamespaceConnectivityRulesRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespaceConnectivityRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespaceConnectivityRulesRequest) ProtoMessage() {}
+
+func (x *ListNamespaceConnectivityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespaceConnectivityRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespaceConnectivityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListNamespaceConnectivityRulesRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+// ListNamespaceConnectivityRulesResponse is the response for
+// ListNamespaceConnectivityRules.
+type ListNamespaceConnectivityRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rules bound to the namespace.
+	Rules         []*ConnectivityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespaceConnectivityRulesResponse) Reset() {
+	*x = ListNamespaceConnectivityRulesResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespaceConnectivityRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespaceConnectivityRulesResponse) ProtoMessage() {}
+
+func (x *ListNamespaceConnectivityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespaceConnectivityRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListNamespaceConnectivityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListNamespaceConnectivityRulesResponse) GetRules() []*ConnectivityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// NexusEndpointSpec is the desired configuration of a Nexus endpoint.
+type NexusEndpointSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the endpoint (unique across the cluster). It cannot be changed.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the endpoint, in Markdown.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// ID of the namespace whose workers handle the endpoint's operations.
+	TargetNamespaceId string `protobuf:"bytes,3,opt,name=target_namespace_id,json=targetNamespaceId,proto3" json:"target_namespace_id,omitempty"`
+	// Task queue the target namespace's workers poll.
+	TargetTaskQueue string `protobuf:"bytes,4,opt,name=target_task_queue,json=targetTaskQueue,proto3" json:"target_task_queue,omitempty"`
+	// IDs of the namespaces allowed to call the endpoint.
+	AllowedCallerNamespaceIds []string `protobuf:"bytes,5,rep,name=allowed_caller_namespace_ids,json=allowedCallerNamespaceIds,proto3" json:"allowed_caller_namespace_ids,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *NexusEndpointSpec) Reset() {
+	*x = NexusEndpointSpec{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointSpec) ProtoMessage() {}
+
+func (x *NexusEndpointSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointSpec.ProtoReflect.Descriptor instead.
+func (*NexusEndpointSpec) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *NexusEndpointSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NexusEndpointSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NexusEndpointSpec) GetTargetNamespaceId() string {
+	if x != nil {
+		return x.TargetNamespaceId
+	}
+	return ""
+}
+
+func (x *NexusEndpointSpec) GetTargetTaskQueue() string {
+	if x != nil {
+		return x.TargetTaskQueue
+	}
+	return ""
+}
+
+func (x *NexusEndpointSpec) GetAllowedCallerNamespaceIds() []string {
+	if x != nil {
+		return x.AllowedCallerNamespaceIds
+	}
+	return nil
+}
+
+// NexusEndpoint routes Nexus requests from the organization's namespaces to
+// workers of a target namespace.
+type NexusEndpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Endpoint ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Desired configuration.
+	Spec *NexusEndpointSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Provisioning state.
+	State NexusEndpointState `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.cloud.api.v1.NexusEndpointState" json:"state,omitempty"`
+	// Error of the last failed attempt to provision the endpoint.
+	SyncError string `protobuf:"bytes,4,opt,name=sync_error,json=syncError,proto3" json:"sync_error,omitempty"`
+	// ID of the endpoint on its cluster, once provisioned.
+	ClusterEndpointId string `protobuf:"bytes,5,opt,name=cluster_endpoint_id,json=clusterEndpointId,proto3" json:"cluster_endpoint_id,omitempty"`
+	// Creation timestamp.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NexusEndpoint) Reset() {
+	*x = NexusEndpoint{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpoint) ProtoMessage() {}
+
+func (x *NexusEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpoint.ProtoReflect.Descriptor instead.
+func (*NexusEndpoint) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *NexusEndpoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NexusEndpoint) GetSpec() *NexusEndpointSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *NexusEndpoint) GetState() NexusEndpointState {
+	if x != nil {
+		return x.State
+	}
+	return NexusEndpointState_NEXUS_ENDPOINT_STATE_UNSPECIFIED
+}
+
+func (x *NexusEndpoint) GetSyncError() string {
+	if x != nil {
+		return x.SyncError
+	}
+	return ""
+}
+
+func (x *NexusEndpoint) GetClusterEndpointId() string {
+	if x != nil {
+		return x.ClusterEndpointId
+	}
+	return ""
+}
+
+func (x *NexusEndpoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NexusEndpoint) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateNexusEndpointRequest is the request for CreateNexusEndpoint.
+type CreateNexusEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Endpoint to create.
+	Spec          *NexusEndpointSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNexusEndpointRequest) Reset() {
+	*x = CreateNexusEndpointRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNexusEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNexusEndpointRequest) ProtoMessage() {}
+
+func (x *CreateNexusEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNexusEndpointRequest.ProtoReflect.Descriptor instead.
+func (*CreateNexusEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateNexusEndpointRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateNexusEndpointRequest) GetSpec() *NexusEndpointSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+// CreateNexusEndpointResponse is the response for CreateNexusEndpoint.
+type CreateNexusEndpointResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created endpoint.
+	Endpoint      *NexusEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNexusEndpointResponse) Reset() {
+	*x = CreateNexusEndpointResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNexusEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNexusEndpointResponse) ProtoMessage() {}
+
+func (x *CreateNexusEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNexusEndpointResponse.ProtoReflect.Descriptor instead.
+func (*CreateNexusEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateNexusEndpointResponse) GetEndpoint() *NexusEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+// GetNexusEndpointRequest is the request for GetNexusEndpoint.
+type GetNexusEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Endpoint ID.
+	EndpointId    string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNexusEndpointRequest) Reset() {
+	*x = GetNexusEndpointRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNexusEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNexusEndpointRequest) ProtoMessage() {}
+
+func (x *GetNexusEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNexusEndpointRequest.ProtoReflect.Descriptor instead.
+func (*GetNexusEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetNexusEndpointRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetNexusEndpointRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+// GetNexusEndpointResponse is the response for GetNexusEndpoint.
+type GetNexusEndpointResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The endpoint.
+	Endpoint      *NexusEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNexusEndpointResponse) Reset() {
+	*x = GetNexusEndpointResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNexusEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNexusEndpointResponse) ProtoMessage() {}
+
+func (x *GetNexusEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNexusEndpointResponse.ProtoReflect.Descriptor instead.
+func (*GetNexusEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetNexusEndpointResponse) GetEndpoint() *NexusEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+// ListNexusEndpointsRequest is the request for ListNexusEndpoints.
+type ListNexusEndpointsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of endpoints to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNexusEndpointsRequest) Reset() {
+	*x = ListNexusEndpointsRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNexusEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNexusEndpointsRequest) ProtoMessage() {}
+
+func (x *ListNexusEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNexusEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListNexusEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListNexusEndpointsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListNexusEndpointsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNexusEndpointsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListNexusEndpointsResponse is the response for ListNexusEndpoints.
+type ListNexusEndpointsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of endpoints.
+	Endpoints []*NexusEndpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNexusEndpointsResponse) Reset() {
+	*x = ListNexusEndpointsResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNexusEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNexusEndpointsResponse) ProtoMessage() {}
+
+func (x *ListNexusEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNexusEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListNexusEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListNexusEndpointsResponse) GetEndpoints() []*NexusEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *ListNexusEndpointsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateNexusEndpointRequest is the request for UpdateNexusEndpoint.
+type UpdateNexusEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Endpoint ID.
+	EndpointId string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	// The endpoint's new description, target and allowed callers. The name
+	// of an endpoint cannot be changed.
+	Spec          *NexusEndpointSpec `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNexusEndpointRequest) Reset() {
+	*x = UpdateNexusEndpointRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNexusEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNexusEndpointRequest) ProtoMessage() {}
+
+func (x *UpdateNexusEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNexusEndpointRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNexusEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateNexusEndpointRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateNexusEndpointRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *UpdateNexusEndpointRequest) GetSpec() *NexusEndpointSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+// UpdateNexusEndpointResponse is the response for UpdateNexusEndpoint.
+type UpdateNexusEndpointResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated endpoint.
+	Endpoint      *NexusEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNexusEndpointResponse) Reset() {
+	*x = UpdateNexusEndpointResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNexusEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNexusEndpointResponse) ProtoMessage() {}
+
+func (x *UpdateNexusEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNexusEndpointResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNexusEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateNexusEndpointResponse) GetEndpoint() *NexusEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+// DeleteNexusEndpointRequest is the request for DeleteNexusEndpoint.
+type DeleteNexusEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Endpoint ID.
+	EndpointId    string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNexusEndpointRequest) Reset() {
+	*x = DeleteNexusEndpointRequest{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNexusEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNexusEndpointRequest) ProtoMessage() {}
+
+func (x *DeleteNexusEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNexusEndpointRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNexusEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteNexusEndpointRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteNexusEndpointRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+// DeleteNexusEndpointResponse is the response for DeleteNexusEndpoint.
+type DeleteNexusEndpointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNexusEndpointResponse) Reset() {
+	*x = DeleteNexusEndpointResponse{}
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNexusEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNexusEndpointResponse) ProtoMessage() {}
+
+func (x *DeleteNexusEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_namespaces_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNexusEndpointResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNexusEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_namespaces_proto_rawDescGZIP(), []int{76}
+}
+
+var File_cloud_v1_namespaces_proto protoreflect.FileDescriptor
+
+const file_cloud_v1_namespaces_proto_rawDesc = "" +
+	"\n" +
+	"\x19cloud/v1/namespaces.proto\x12\x15temporal.cloud.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1egoogle/protobuf/duration.proto\"\xd5\x05\n" +
+	"\tNamespace\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12;\n" +
+	"\x05state\x18\x05 \x01(\x0e2%.temporal.cloud.api.v1.NamespaceStateR\x05state\x12>\n" +
+	"\x06config\x18\x06 \x01(\v2&.temporal.cloud.api.v1.NamespaceConfigR\x06config\x12G\n" +
+	"\tendpoints\x18\a \x01(\v2).temporal.cloud.api.v1.NamespaceEndpointsR\tendpoints\x12S\n" +
+	"\x11search_attributes\x18\b \x03(\v2&.temporal.cloud.api.v1.SearchAttributeR\x10searchAttributes\x12Y\n" +
+	"\x13certificate_filters\x18\t \x03(\v2(.temporal.cloud.api.v1.CertificateFilterR\x12certificateFilters\x12>\n" +
+	"\x04tags\x18\n" +
+	" \x03(\v2*.temporal.cloud.api.v1.Namespace.TagsEntryR\x04tags\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9f\x02\n" +
+	"\x0fNamespaceConfig\x12D\n" +
+	"\x10retention_period\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0fretentionPeriod\x12-\n" +
+	"\x12deletion_protected\x18\x02 \x01(\bR\x11deletionProtected\x12J\n" +
+	"\tha_config\x18\x03 \x01(\v2-.temporal.cloud.api.v1.HighAvailabilityConfigR\bhaConfig\x12K\n" +
+	"\fcodec_server\x18\x04 \x01(\v2(.temporal.cloud.api.v1.CodecServerConfigR\vcodecServer\"\xa3\x01\n" +
+	"\x16HighAvailabilityConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12%\n" +
+	"\x0estandby_region\x18\x02 \x01(\tR\rstandbyRegion\x12H\n" +
+	"\x12failover_threshold\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x11failoverThreshold\"\x8c\x01\n" +
+	"\x11CodecServerConfig\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12*\n" +
+	"\x11pass_access_token\x18\x02 \x01(\bR\x0fpassAccessToken\x12/\n" +
+	"\x13include_credentials\x18\x03 \x01(\bR\x12includeCredentials\"\x87\x01\n" +
+	"\x12NamespaceEndpoints\x12#\n" +
+	"\rgrpc_endpoint\x18\x01 \x01(\tR\fgrpcEndpoint\x12!\n" +
+	"\fweb_endpoint\x18\x02 \x01(\tR\vwebEndpoint\x12)\n" +
+	"\x10metrics_endpoint\x18\x03 \x01(\tR\x0fmetricsEndpoint\"e\n" +
+	"\x0fSearchAttribute\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\x04type\x18\x02 \x01(\x0e2*.temporal.cloud.api.v1.SearchAttributeTypeR\x04type\"\xd3\x01\n" +
+	"\x11CertificateFilter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcommon_name\x18\x02 \x01(\tR\n" +
+	"commonName\x12\"\n" +
+	"\forganization\x18\x03 \x01(\tR\forganization\x12/\n" +
+	"\x13organizational_unit\x18\x04 \x01(\tR\x12organizationalUnit\x128\n" +
+	"\x18subject_alternative_name\x18\x05 \x01(\tR\x16subjectAlternativeName\"\x81\x03\n" +
+	"\n" +
+	"ExportSink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
+	"\x02s3\x18\x03 \x01(\v2*.temporal.cloud.api.v1.S3ExportDestinationR\x02s3\x12=\n" +
+	"\x03gcs\x18\x04 \x01(\v2+.temporal.cloud.api.v1.GCSExportDestinationR\x03gcs\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12D\n" +
+	"\x10last_export_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastExportTime\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x99\x01\n" +
+	"\x13S3ExportDestination\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x19\n" +
+	"\brole_arn\x18\x05 \x01(\tR\aroleArn\x12\x1f\n" +
+	"\vexternal_id\x18\x06 \x01(\tR\n" +
+	"externalId\"o\n" +
+	"\x14GCSExportDestination\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12'\n" +
+	"\x0fservice_account\x18\x03 \x01(\tR\x0eserviceAccount\"\xe0\x03\n" +
+	"\tExportJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\asink_id\x18\x02 \x01(\tR\x06sinkId\x12;\n" +
+	"\x05state\x18\x03 \x01(\x0e2%.temporal.cloud.api.v1.ExportJobStateR\x05state\x12-\n" +
+	"\x12workflows_exported\x18\x04 \x01(\x03R\x11workflowsExported\x12%\n" +
+	"\x0ebytes_exported\x18\x05 \x01(\x03R\rbytesExported\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12=\n" +
+	"\fwindow_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xa4\x03\n" +
+	"\x10ConnectivityRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12I\n" +
+	"\fip_allowlist\x18\x03 \x01(\v2&.temporal.cloud.api.v1.IPAllowlistRuleR\vipAllowlist\x12I\n" +
+	"\fprivate_link\x18\x04 \x01(\v2&.temporal.cloud.api.v1.PrivateLinkRuleR\vprivateLink\x12F\n" +
+	"\vvpc_peering\x18\x05 \x01(\v2%.temporal.cloud.api.v1.VPCPeeringRuleR\n" +
+	"vpcPeering\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"'\n" +
+	"\x0fIPAllowlistRule\x12\x14\n" +
+	"\x05cidrs\x18\x01 \x03(\tR\x05cidrs\"N\n" +
+	"\x0fPrivateLinkRule\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\tR\fconnectionId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"r\n" +
+	"\x0eVPCPeeringRule\x12\x15\n" +
+	"\x06vpc_id\x18\x01 \x01(\tR\x05vpcId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x12\n" +
+	"\x04cidr\x18\x04 \x01(\tR\x04cidr\"\xb3\x02\n" +
+	"\x16CreateNamespaceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12>\n" +
+	"\x06config\x18\x04 \x01(\v2&.temporal.cloud.api.v1.NamespaceConfigR\x06config\x12K\n" +
+	"\x04tags\x18\x05 \x03(\v27.temporal.cloud.api.v1.CreateNamespaceRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"|\n" +
+	"\x17CreateNamespaceResponse\x12>\n" +
+	"\tnamespace\x18\x01 \x01(\v2 .temporal.cloud.api.v1.NamespaceR\tnamespace\x12!\n" +
+	"\foperation_id\x18\x02 \x01(\tR\voperationId\"8\n" +
+	"\x13GetNamespaceRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\"V\n" +
+	"\x14GetNamespaceResponse\x12>\n" +
+	"\tnamespace\x18\x01 \x01(\v2 .temporal.cloud.api.v1.NamespaceR\tnamespace\"\xb8\x01\n" +
+	"\x16UpdateNamespaceRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12>\n" +
+	"\tnamespace\x18\x02 \x01(\v2 .temporal.cloud.api.v1.NamespaceR\tnamespace\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"|\n" +
+	"\x17UpdateNamespaceResponse\x12>\n" +
+	"\tnamespace\x18\x01 \x01(\v2 .temporal.cloud.api.v1.NamespaceR\tnamespace\x12!\n" +
+	"\foperation_id\x18\x02 \x01(\tR\voperationId\";\n" +
+	"\x16DeleteNamespaceRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\"<\n" +
+	"\x17DeleteNamespaceResponse\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\xeb\x01\n" +
+	"\x15ListNamespacesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12#\n" +
+	"\rregion_filter\x18\x04 \x01(\tR\fregionFilter\x12H\n" +
+	"\fstate_filter\x18\x05 \x01(\x0e2%.temporal.cloud.api.v1.NamespaceStateR\vstateFilter\"\x82\x01\n" +
+	"\x16ListNamespacesResponse\x12@\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2 .temporal.cloud.api.v1.NamespaceR\n" +
+	"namespaces\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x94\x01\n" +
+	"\x1aAddSearchAttributesRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12S\n" +
+	"\x11search_attributes\x18\x02 \x03(\v2&.temporal.cloud.api.v1.SearchAttributeR\x10searchAttributes\"@\n" +
+	"\x1bAddSearchAttributesResponse\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"U\n" +
+	"\x1cRemoveSearchAttributeRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"B\n" +
+	"\x1dRemoveSearchAttributeResponse\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\x99\x01\n" +
+	"\x1bAddCertificateFilterRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12W\n" +
+	"\x12certificate_filter\x18\x02 \x01(\v2(.temporal.cloud.api.v1.CertificateFilterR\x11certificateFilter\"w\n" +
+	"\x1cAddCertificateFilterResponse\x12W\n" +
+	"\x12certificate_filter\x18\x01 \x01(\v2(.temporal.cloud.api.v1.CertificateFilterR\x11certificateFilter\"`\n" +
+	"\x1eRemoveCertificateFilterRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1b\n" +
+	"\tfilter_id\x18\x02 \x01(\tR\bfilterId\"!\n" +
+	"\x1fRemoveCertificateFilterResponse\"b\n" +
+	"\x18FailoverNamespaceRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12#\n" +
+	"\rtarget_region\x18\x02 \x01(\tR\ftargetRegion\">\n" +
+	"\x19FailoverNamespaceResponse\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"c\n" +
+	"\x1dIssueClientCertificateRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vcommon_name\x18\x02 \x01(\tR\n" +
+	"commonName\"\xce\x01\n" +
+	"\x1eIssueClientCertificateResponse\x12'\n" +
+	"\x0fcertificate_pem\x18\x01 \x01(\tR\x0ecertificatePem\x12&\n" +
+	"\x0fprivate_key_pem\x18\x02 \x01(\tR\rprivateKeyPem\x12 \n" +
+	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"s\n" +
+	"\x17CreateExportSinkRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x125\n" +
+	"\x04sink\x18\x02 \x01(\v2!.temporal.cloud.api.v1.ExportSinkR\x04sink\"Q\n" +
+	"\x18CreateExportSinkResponse\x125\n" +
+	"\x04sink\x18\x01 \x01(\v2!.temporal.cloud.api.v1.ExportSinkR\x04sink\"R\n" +
+	"\x14GetExportSinkRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x17\n" +
+	"\asink_id\x18\x02 \x01(\tR\x06sinkId\"N\n" +
+	"\x15GetExportSinkResponse\x125\n" +
+	"\x04sink\x18\x01 \x01(\v2!.temporal.cloud.api.v1.ExportSinkR\x04sink\";\n" +
+	"\x16ListExportSinksRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\"R\n" +
+	"\x17ListExportSinksResponse\x127\n" +
+	"\x05sinks\x18\x01 \x03(\v2!.temporal.cloud.api.v1.ExportSinkR\x05sinks\"\x8c\x01\n" +
+	"\x17UpdateExportSinkRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x17\n" +
+	"\asink_id\x18\x02 \x01(\tR\x06sinkId\x125\n" +
+	"\x04sink\x18\x03 \x01(\v2!.temporal.cloud.api.v1.ExportSinkR\x04sink\"Q\n" +
+	"\x18UpdateExportSinkResponse\x125\n" +
+	"\x04sink\x18\x01 \x01(\v2!.temporal.cloud.api.v1.ExportSinkR\x04sink\"U\n" +
+	"\x17DeleteExportSinkRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x17\n" +
+	"\asink_id\x18\x02 \x01(\tR\x06sinkId\"\x1a\n" +
+	"\x18DeleteExportSinkResponse\"\x8f\x01\n" +
+	"\x15ListExportJobsRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x17\n" +
+	"\asink_id\x18\x02 \x01(\tR\x06sinkId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"v\n" +
+	"\x16ListExportJobsResponse\x124\n" +
+	"\x04jobs\x18\x01 \x03(\v2 .temporal.cloud.api.v1.ExportJobR\x04jobs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x85\x01\n" +
+	"\x1dCreateConnectivityRuleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12;\n" +
+	"\x04rule\x18\x02 \x01(\v2'.temporal.cloud.api.v1.ConnectivityRuleR\x04rule\"]\n" +
+	"\x1eCreateConnectivityRuleResponse\x12;\n" +
+	"\x04rule\x18\x01 \x01(\v2'.temporal.cloud.api.v1.ConnectivityRuleR\x04rule\"^\n" +
+	"\x1aGetConnectivityRuleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"Z\n" +
+	"\x1bGetConnectivityRuleResponse\x12;\n" +
+	"\x04rule\x18\x01 \x01(\v2'.temporal.cloud.api.v1.ConnectivityRuleR\x04rule\"\x83\x01\n" +
+	"\x1cListConnectivityRulesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x86\x01\n" +
+	"\x1dListConnectivityRulesResponse\x12=\n" +
+	"\x05rules\x18\x01 \x03(\v2'.temporal.cloud.api.v1.ConnectivityRuleR\x05rules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9e\x01\n" +
+	"\x1dUpdateConnectivityRuleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12;\n" +
+	"\x04rule\x18\x03 \x01(\v2'.temporal.cloud.api.v1.ConnectivityRuleR\x04rule\"]\n" +
+	"\x1eUpdateConnectivityRuleResponse\x12;\n" +
+	"\x04rule\x18\x01 \x01(\v2'.temporal.cloud.api.v1.ConnectivityRuleR\x04rule\"a\n" +
+	"\x1dDeleteConnectivityRuleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\" \n" +
+	"\x1eDeleteConnectivityRuleResponse\"a\n" +
+	"#AddNamespaceConnectivityRuleRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"&\n" +
+	"$AddNamespaceConnectivityRuleResponse\"d\n" +
+	"&RemoveNamespaceConnectivityRuleRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\")\n" +
+	"'RemoveNamespaceConnectivityRuleResponse\"J\n" +
+	"%ListNamespaceConnectivityRulesRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\"g\n" +
+	"&ListNamespaceConnectivityRulesResponse\x12=\n" +
+	"\x05rules\x18\x01 \x03(\v2'.temporal.cloud.api.v1.ConnectivityRuleR\x05rules\"\xe6\x01\n" +
+	"\x11NexusEndpointSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12.\n" +
+	"\x13target_namespace_id\x18\x03 \x01(\tR\x11targetNamespaceId\x12*\n" +
+	"\x11target_task_queue\x18\x04 \x01(\tR\x0ftargetTaskQueue\x12?\n" +
+	"\x1callowed_caller_namespace_ids\x18\x05 \x03(\tR\x19allowedCallerNamespaceIds\"\xe3\x02\n" +
+	"\rNexusEndpoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
+	"\x04spec\x18\x02 \x01(\v2(.temporal.cloud.api.v1.NexusEndpointSpecR\x04spec\x12?\n" +
+	"\x05state\x18\x03 \x01(\x0e2).temporal.cloud.api.v1.NexusEndpointStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"sync_error\x18\x04 \x01(\tR\tsyncError\x12.\n" +
+	"\x13cluster_endpoint_id\x18\x05 \x01(\tR\x11clusterEndpointId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x83\x01\n" +
+	"\x1aCreateNexusEndpointRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12<\n" +
+	"\x04spec\x18\x02 \x01(\v2(.temporal.cloud.api.v1.NexusEndpointSpecR\x04spec\"_\n" +
+	"\x1bCreateNexusEndpointResponse\x12@\n" +
+	"\bendpoint\x18\x01 \x01(\v2$.temporal.cloud.api.v1.NexusEndpointR\bendpoint\"c\n" +
+	"\x17GetNexusEndpointRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\"\\\n" +
+	"\x18GetNexusEndpointResponse\x12@\n" +
+	"\bendpoint\x18\x01 \x01(\v2$.temporal.cloud.api.v1.NexusEndpointR\bendpoint\"\x80\x01\n" +
+	"\x19ListNexusEndpointsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\x1aListNexusEndpointsResponse\x12B\n" +
+	"\tendpoints\x18\x01 \x03(\v2$.temporal.cloud.api.v1.NexusEndpointR\tendpoints\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa4\x01\n" +
+	"\x1aUpdateNexusEndpointRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\x12<\n" +
+	"\x04spec\x18\x03 \x01(\v2(.temporal.cloud.api.v1.NexusEndpointSpecR\x04spec\"_\n" +
+	"\x1bUpdateNexusEndpointResponse\x12@\n" +
+	"\bendpoint\x18\x01 \x01(\v2$.temporal.cloud.api.v1.NexusEndpointR\bendpoint\"f\n" +
+	"\x1aDeleteNexusEndpointRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\"\x1d\n" +
+	"\x1bDeleteNexusEndpointResponse*\xa0\x02\n" +
+	"\x0eNamespaceState\x12\x1f\n" +
+	"\x1bNAMESPACE_STATE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17NAMESPACE_STATE_PENDING\x10\x01\x12 \n" +
+	"\x1cNAMESPACE_STATE_PROVISIONING\x10\x02\x12\x1a\n" +
+	"\x16NAMESPACE_STATE_ACTIVE\x10\x03\x12\x1c\n" +
+	"\x18NAMESPACE_STATE_UPDATING\x10\x04\x12\x1c\n" +
+	"\x18NAMESPACE_STATE_DELETING\x10\x05\x12\x1b\n" +
+	"\x17NAMESPACE_STATE_DELETED\x10\x06\x12\x1d\n" +
+	"\x19NAMESPACE_STATE_SUSPENDED\x10\a\x12\x1a\n" +
+	"\x16NAMESPACE_STATE_FAILED\x10\b*\xac\x02\n" +
+	"\x13SearchAttributeType\x12%\n" +
+	"!SEARCH_ATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSEARCH_ATTRIBUTE_TYPE_TEXT\x10\x01\x12!\n" +
+	"\x1dSEARCH_ATTRIBUTE_TYPE_KEYWORD\x10\x02\x12\x1d\n" +
+	"\x19SEARCH_ATTRIBUTE_TYPE_INT\x10\x03\x12 \n" +
+	"\x1cSEARCH_ATTRIBUTE_TYPE_DOUBLE\x10\x04\x12\x1e\n" +
+	"\x1aSEARCH_ATTRIBUTE_TYPE_BOOL\x10\x05\x12\"\n" +
+	"\x1eSEARCH_ATTRIBUTE_TYPE_DATETIME\x10\x06\x12&\n" +
+	"\"SEARCH_ATTRIBUTE_TYPE_KEYWORD_LIST\x10\a*\xab\x01\n" +
+	"\x0eExportJobState\x12 \n" +
+	"\x1cEXPORT_JOB_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18EXPORT_JOB_STATE_PENDING\x10\x01\x12\x1c\n" +
+	"\x18EXPORT_JOB_STATE_RUNNING\x10\x02\x12\x1e\n" +
+	"\x1aEXPORT_JOB_STATE_COMPLETED\x10\x03\x12\x1b\n" +
+	"\x17EXPORT_JOB_STATE_FAILED\x10\x04*\xc1\x01\n" +
+	"\x12NexusEndpointState\x12$\n" +
+	" NEXUS_ENDPOINT_STATE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cNEXUS_ENDPOINT_STATE_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bNEXUS_ENDPOINT_STATE_ACTIVE\x10\x02\x12\x1f\n" +
+	"\x1bNEXUS_ENDPOINT_STATE_FAILED\x10\x03\x12!\n" +
+	"\x1dNEXUS_ENDPOINT_STATE_DELETING\x10\x042\xdd\x1d\n" +
+	"\x10NamespaceService\x12p\n" +
+	"\x0fCreateNamespace\x12-.temporal.cloud.api.v1.CreateNamespaceRequest\x1a..temporal.cloud.api.v1.CreateNamespaceResponse\x12g\n" +
+	"\fGetNamespace\x12*.temporal.cloud.api.v1.GetNamespaceRequest\x1a+.temporal.cloud.api.v1.GetNamespaceResponse\x12p\n" +
+	"\x0fUpdateNamespace\x12-.temporal.cloud.api.v1.UpdateNamespaceRequest\x1a..temporal.cloud.api.v1.UpdateNamespaceResponse\x12p\n" +
+	"\x0fDeleteNamespace\x12-.temporal.cloud.api.v1.DeleteNamespaceRequest\x1a..temporal.cloud.api.v1.DeleteNamespaceResponse\x12m\n" +
+	"\x0eListNamespaces\x12,.temporal.cloud.api.v1.ListNamespacesRequest\x1a-.temporal.cloud.api.v1.ListNamespacesResponse\x12|\n" +
+	"\x13AddSearchAttributes\x121.temporal.cloud.api.v1.AddSearchAttributesRequest\x1a2.temporal.cloud.api.v1.AddSearchAttributesResponse\x12\x82\x01\n" +
+	"\x15RemoveSearchAttribute\x123.temporal.cloud.api.v1.RemoveSearchAttributeRequest\x1a4.temporal.cloud.api.v1.RemoveSearchAttributeResponse\x12\x7f\n" +
+	"\x14AddCertificateFilter\x122.temporal.cloud.api.v1.AddCertificateFilterRequest\x1a3.temporal.cloud.api.v1.AddCertificateFilterResponse\x12\x88\x01\n" +
+	"\x17RemoveCertificateFilter\x125.temporal.cloud.api.v1.RemoveCertificateFilterRequest\x1a6.temporal.cloud.api.v1.RemoveCertificateFilterResponse\x12v\n" +
+	"\x11FailoverNamespace\x12/.temporal.cloud.api.v1.FailoverNamespaceRequest\x1a0.temporal.cloud.api.v1.FailoverNamespaceResponse\x12\x85\x01\n" +
+	"\x16IssueClientCertificate\x124.temporal.cloud.api.v1.IssueClientCertificateRequest\x1a5.temporal.cloud.api.v1.IssueClientCertificateResponse\x12s\n" +
+	"\x10CreateExportSink\x12..temporal.cloud.api.v1.CreateExportSinkRequest\x1a/.temporal.cloud.api.v1.CreateExportSinkResponse\x12j\n" +
+	"\rGetExportSink\x12+.temporal.cloud.api.v1.GetExportSinkRequest\x1a,.temporal.cloud.api.v1.GetExportSinkResponse\x12p\n" +
+	"\x0fListExportSinks\x12-.temporal.cloud.api.v1.ListExportSinksRequest\x1a..temporal.cloud.api.v1.ListExportSinksResponse\x12s\n" +
+	"\x10UpdateExportSink\x12..temporal.cloud.api.v1.UpdateExportSinkRequest\x1a/.temporal.cloud.api.v1.UpdateExportSinkResponse\x12s\n" +
+	"\x10DeleteExportSink\x12..temporal.cloud.api.v1.DeleteExportSinkRequest\x1a/.temporal.cloud.api.v1.DeleteExportSinkResponse\x12m\n" +
+	"\x0eListExportJobs\x12,.temporal.cloud.api.v1.ListExportJobsRequest\x1a-.temporal.cloud.api.v1.ListExportJobsResponse\x12\x85\x01\n" +
+	"\x16CreateConnectivityRule\x124.temporal.cloud.api.v1.CreateConnectivityRuleRequest\x1a5.temporal.cloud.api.v1.CreateConnectivityRuleResponse\x12|\n" +
+	"\x13GetConnectivityRule\x121.temporal.cloud.api.v1.GetConnectivityRuleRequest\x1a2.temporal.cloud.api.v1.GetConnectivityRuleResponse\x12\x82\x01\n" +
+	"\x15ListConnectivityRules\x123.temporal.cloud.api.v1.ListConnectivityRulesRequest\x1a4.temporal.cloud.api.v1.ListConnectivityRulesResponse\x12\x85\x01\n" +
+	"\x16UpdateConnectivityRule\x124.temporal.cloud.api.v1.UpdateConnectivityRuleRequest\x1a5.temporal.cloud.api.v1.UpdateConnectivityRuleResponse\x12\x85\x01\n" +
+	"\x16DeleteConnectivityRule\x124.temporal.cloud.api.v1.DeleteConnectivityRuleRequest\x1a5.temporal.cloud.api.v1.DeleteConnectivityRuleResponse\x12\x97\x01\n" +
+	"\x1cAddNamespaceConnectivityRule\x12:.temporal.cloud.api.v1.AddNamespaceConnectivityRuleRequest\x1a;.temporal.cloud.api.v1.AddNamespaceConnectivityRuleResponse\x12\xa0\x01\n" +
+	"\x1fRemoveNamespaceConnectivityRule\x12=.temporal.cloud.api.v1.RemoveNamespaceConnectivityRuleRequest\x1a>.temporal.cloud.api.v1.RemoveNamespaceConnectivityRuleResponse\x12\x9d\x01\n" +
+	"\x1eListNamespaceConnectivityRules\x12<.temporal.cloud.api.v1.ListNamespaceConnectivityRulesRequest\x1a=.temporal.cloud.api.v1.ListNamespaceConnectivityRulesResponse\x12|\n" +
+	"\x13CreateNexusEndpoint\x121.temporal.cloud.api.v1.CreateNexusEndpointRequest\x1a2.temporal.cloud.api.v1.CreateNexusEndpointResponse\x12s\n" +
+	"\x10GetNexusEndpoint\x12..temporal.cloud.api.v1.GetNexusEndpointRequest\x1a/.temporal.cloud.api.v1.GetNexusEndpointResponse\x12y\n" +
+	"\x12ListNexusEndpoints\x120.temporal.cloud.api.v1.ListNexusEndpointsRequest\x1a1.temporal.cloud.api.v1.ListNexusEndpointsResponse\x12|\n" +
+	"\x13UpdateNexusEndpoint\x121.temporal.cloud.api.v1.UpdateNexusEndpointRequest\x1a2.temporal.cloud.api.v1.UpdateNexusEndpointResponse\x12|\n" +
+	"\x13DeleteNexusEndpoint\x121.temporal.cloud.api.v1.DeleteNexusEndpointRequest\x1a2.temporal.cloud.api.v1.DeleteNexusEndpointResponseB+Z)go.temporal.io/cloud/api/cloud/v1;cloudv1b\x06proto3"
+
+var (
+	file_cloud_v1_namespaces_proto_rawDescOnce sync.Once
+	file_cloud_v1_namespaces_proto_rawDescData []byte
+)
+
+func file_cloud_v1_namespaces_proto_rawDescGZIP() []byte {
+	file_cloud_v1_namespaces_proto_rawDescOnce.Do(func() {
+		file_cloud_v1_namespaces_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cloud_v1_namespaces_proto_rawDesc), len(file_cloud_v1_namespaces_proto_rawDesc)))
+	})
+	return file_cloud_v1_namespaces_proto_rawDescData
+}
+
+var file_cloud_v1_namespaces_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cloud_v1_namespaces_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_cloud_v1_namespaces_proto_goTypes = []any{
+	(NamespaceState)(0),                             // 0: temporal.cloud.api.v1.NamespaceState
+	(SearchAttributeType)(0),                        // 1: temporal.cloud.api.v1.SearchAttributeType
+	(ExportJobState)(0),                             // 2: temporal.cloud.api.v1.ExportJobState
+	(NexusEndpointState)(0),                         // 3: temporal.cloud.api.v1.NexusEndpointState
+	(*Namespace)(nil),                               // 4: temporal.cloud.api.v1.Namespace
+	(*NamespaceConfig)(nil),                         // 5: temporal.cloud.api.v1.NamespaceConfig
+	(*HighAvailabilityConfig)(nil),                  // 6: temporal.cloud.api.v1.HighAvailabilityConfig
+	(*CodecServerConfig)(nil),                       // 7: temporal.cloud.api.v1.CodecServerConfig
+	(*NamespaceEndpoints)(nil),                      // 8: temporal.cloud.api.v1.NamespaceEndpoints
+	(*SearchAttribute)(nil),                         // 9: temporal.cloud.api.v1.SearchAttribute
+	(*CertificateFilter)(nil),                       // 10: temporal.cloud.api.v1.CertificateFilter
+	(*ExportSink)(nil),                              // 11: temporal.cloud.api.v1.ExportSink
+	(*S3ExportDestination)(nil),                     // 12: temporal.cloud.api.v1.S3ExportDestination
+	(*GCSExportDestination)(nil),                    // 13: temporal.cloud.api.v1.GCSExportDestination
+	(*ExportJob)(nil),                               // 14: temporal.cloud.api.v1.ExportJob
+	(*ConnectivityRule)(nil),                        // 15: temporal.cloud.api.v1.ConnectivityRule
+	(*IPAllowlistRule)(nil),                         // 16: temporal.cloud.api.v1.IPAllowlistRule
+	(*PrivateLinkRule)(nil),                         // 17: temporal.cloud.api.v1.PrivateLinkRule
+	(*VPCPeeringRule)(nil),                          // 18: temporal.cloud.api.v1.VPCPeeringRule
+	(*CreateNamespaceRequest)(nil),                  // 19: temporal.cloud.api.v1.CreateNamespaceRequest
+	(*CreateNamespaceResponse)(nil),                 // 20: temporal.cloud.api.v1.CreateNamespaceResponse
+	(*GetNamespaceRequest)(nil),                     // 21: temporal.cloud.api.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),                    // 22: temporal.cloud.api.v1.GetNamespaceResponse
+	(*UpdateNamespaceRequest)(nil),                  // 23: temporal.cloud.api.v1.UpdateNamespaceRequest
+	(*UpdateNamespaceResponse)(nil),                 // 24: temporal.cloud.api.v1.UpdateNamespaceResponse
+	(*DeleteNamespaceRequest)(nil),                  // 25: temporal.cloud.api.v1.DeleteNamespaceRequest
+	(*DeleteNamespaceResponse)(nil),                 // 26: temporal.cloud.api.v1.DeleteNamespaceResponse
+	(*ListNamespacesRequest)(nil),                   // 27: temporal.cloud.api.v1.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),                  // 28: temporal.cloud.api.v1.ListNamespacesResponse
+	(*AddSearchAttributesRequest)(nil),              // 29: temporal.cloud.api.v1.AddSearchAttributesRequest
+	(*AddSearchAttributesResponse)(nil),             // 30: temporal.cloud.api.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributeRequest)(nil),            // 31: temporal.cloud.api.v1.RemoveSearchAttributeRequest
+	(*RemoveSearchAttributeResponse)(nil),           // 32: temporal.cloud.api.v1.RemoveSearchAttributeResponse
+	(*AddCertificateFilterRequest)(nil),             // 33: temporal.cloud.api.v1.AddCertificateFilterRequest
+	(*AddCertificateFilterResponse)(nil),            // 34: temporal.cloud.api.v1.AddCertificateFilterResponse
+	(*RemoveCertificateFilterRequest)(nil),          // 35: temporal.cloud.api.v1.RemoveCertificateFilterRequest
+	(*RemoveCertificateFilterResponse)(nil),         // 36: temporal.cloud.api.v1.RemoveCertificateFilterResponse
+	(*FailoverNamespaceRequest)(nil),                // 37: temporal.cloud.api.v1.FailoverNamespaceRequest
+	(*FailoverNamespaceResponse)(nil),               // 38: temporal.cloud.api.v1.FailoverNamespaceResponse
+	(*IssueClientCertificateRequest)(nil),           // 39: temporal.cloud.api.v1.IssueClientCertificateRequest
+	(*IssueClientCertificateResponse)(nil),          // 40: temporal.cloud.api.v1.IssueClientCertificateResponse
+	(*CreateExportSinkRequest)(nil),                 // 41: temporal.cloud.api.v1.CreateExportSinkRequest
+	(*CreateExportSinkResponse)(nil),                // 42: temporal.cloud.api.v1.CreateExportSinkResponse
+	(*GetExportSinkRequest)(nil),                    // 43: temporal.cloud.api.v1.GetExportSinkRequest
+	(*GetExportSinkResponse)(nil),                   // 44: temporal.cloud.api.v1.GetExportSinkResponse
+	(*ListExportSinksRequest)(nil),                  // 45: temporal.cloud.api.v1.ListExportSinksRequest
+	(*ListExportSinksResponse)(nil),                 // 46: temporal.cloud.api.v1.ListExportSinksResponse
+	(*UpdateExportSinkRequest)(nil),                 // 47: temporal.cloud.api.v1.UpdateExportSinkRequest
+	(*UpdateExportSinkResponse)(nil),                // 48: temporal.cloud.api.v1.UpdateExportSinkResponse
+	(*DeleteExportSinkRequest)(nil),                 // 49: temporal.cloud.api.v1.DeleteExportSinkRequest
+	(*DeleteExportSinkResponse)(nil),                // 50: temporal.cloud.api.v1.DeleteExportSinkResponse
+	(*ListExportJobsRequest)(nil),                   // 51: temporal.cloud.api.v1.ListExportJobsRequest
+	(*ListExportJobsResponse)(nil),                  // 52: temporal.cloud.api.v1.ListExportJobsResponse
+	(*CreateConnectivityRuleRequest)(nil),           // 53: temporal.cloud.api.v1.CreateConnectivityRuleRequest
+	(*CreateConnectivityRuleResponse)(nil),          // 54: temporal.cloud.api.v1.CreateConnectivityRuleResponse
+	(*GetConnectivityRuleRequest)(nil),              // 55: temporal.cloud.api.v1.GetConnectivityRuleRequest
+	(*GetConnectivityRuleResponse)(nil),             // 56: temporal.cloud.api.v1.GetConnectivityRuleResponse
+	(*ListConnectivityRulesRequest)(nil),            // 57: temporal.cloud.api.v1.ListConnectivityRulesRequest
+	(*ListConnectivityRulesResponse)(nil),           // 58: temporal.cloud.api.v1.ListConnectivityRulesResponse
+	(*UpdateConnectivityRuleRequest)(nil),           // 59: temporal.cloud.api.v1.UpdateConnectivityRuleRequest
+	(*UpdateConnectivityRuleResponse)(nil),          // 60: temporal.cloud.api.v1.UpdateConnectivityRuleResponse
+	(*DeleteConnectivityRuleRequest)(nil),           // 61: temporal.cloud.api.v1.DeleteConnectivityRuleRequest
+	(*DeleteConnectivityRuleResponse)(nil),          // 62: temporal.cloud.api.v1.DeleteConnectivityRuleResponse
+	(*AddNamespaceConnectivityRuleRequest)(nil),     // 63: temporal.cloud.api.v1.AddNamespaceConnectivityRuleRequest
+	(*AddNamespaceConnectivityRuleResponse)(nil),    // 64: temporal.cloud.api.v1.AddNamespaceConnectivityRuleResponse
+	(*RemoveNamespaceConnectivityRuleRequest)(nil),  // 65: temporal.cloud.api.v1.RemoveNamespaceConnectivityRuleRequest
+	(*RemoveNamespaceConnectivityRuleResponse)(nil), // 66: temporal.cloud.api.v1.RemoveNamespaceConnectivityRuleResponse
+	(*ListNamespaceConnectivityRulesRequest)(nil),   // 67: temporal.cloud.api.v1.ListNamespaceConnectivityRulesRequest
+	(*ListNamespaceConnectivityRulesResponse)(nil),  // 68: temporal.cloud.api.v1.ListNamespaceConnectivityRulesResponse
+	(*NexusEndpointSpec)(nil),                       // 69: temporal.cloud.api.v1.NexusEndpointSpec
+	(*NexusEndpoint)(nil),                           // 70: temporal.cloud.api.v1.NexusEndpoint
+	(*CreateNexusEndpointRequest)(nil),              // 71: temporal.cloud.api.v1.CreateNexusEndpointRequest
+	(*CreateNexusEndpointResponse)(nil),             // 72: temporal.cloud.api.v1.CreateNexusEndpointResponse
+	(*GetNexusEndpointRequest)(nil),                 // 73: temporal.cloud.api.v1.GetNexusEndpointRequest
+	(*GetNexusEndpointResponse)(nil),                // 74: temporal.cloud.api.v1.GetNexusEndpointResponse
+	(*ListNexusEndpointsRequest)(nil),               // 75: temporal.cloud.api.v1.ListNexusEndpointsRequest
+	(*ListNexusEndpointsResponse)(nil),              // 76: temporal.cloud.api.v1.ListNexusEndpointsResponse
+	(*UpdateNexusEndpointRequest)(nil),              // 77: temporal.cloud.api.v1.UpdateNexusEndpointRequest
+	(*UpdateNexusEndpointResponse)(nil),             // 78: temporal.cloud.api.v1.UpdateNexusEndpointResponse
+	(*DeleteNexusEndpointRequest)(nil),              // 79: temporal.cloud.api.v1.DeleteNexusEndpointRequest
+	(*DeleteNexusEndpointResponse)(nil),             // 80: temporal.cloud.api.v1.DeleteNexusEndpointResponse
+	nil,                                             // 81: temporal.cloud.api.v1.Namespace.TagsEntry
+	nil,                                             // 82: temporal.cloud.api.v1.CreateNamespaceRequest.TagsEntry
+	(*timestamppb.Timestamp)(nil),                   // 83: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                     // 84: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),                   // 85: google.protobuf.FieldMask
+}
+var file_cloud_v1_namespaces_proto_depIdxs = []int32{
+	0,  // 0: temporal.cloud.api.v1.Namespace.state:type_name -> temporal.cloud.api.v1.NamespaceState
+	5,  // 1: temporal.cloud.api.v1.Namespace.config:type_name -> temporal.cloud.api.v1.NamespaceConfig
+	8,  // 2: temporal.cloud.api.v1.Namespace.endpoints:type_name -> temporal.cloud.api.v1.NamespaceEndpoints
+	9,  // 3: temporal.cloud.api.v1.Namespace.search_attributes:type_name -> temporal.cloud.api.v1.SearchAttribute
+	10, // 4: temporal.cloud.api.v1.Namespace.certificate_filters:type_name -> temporal.cloud.api.v1.CertificateFilter
+	81, // 5: temporal.cloud.api.v1.Namespace.tags:type_name -> temporal.cloud.api.v1.Namespace.TagsEntry
+	83, // 6: temporal.cloud.api.v1.Namespace.created_at:type_name -> google.protobuf.Timestamp
+	83, // 7: temporal.cloud.api.v1.Namespace.updated_at:type_name -> google.protobuf.Timestamp
+	84, // 8: temporal.cloud.api.v1.NamespaceConfig.retention_period:type_name -> google.protobuf.Duration
+	6,  // 9: temporal.cloud.api.v1.NamespaceConfig.ha_config:type_name -> temporal.cloud.api.v1.HighAvailabilityConfig
+	7,  // 10: temporal.cloud.api.v1.NamespaceConfig.codec_server:type_name -> temporal.cloud.api.v1.CodecServerConfig
+	84, // 11: temporal.cloud.api.v1.HighAvailabilityConfig.failover_threshold:type_name -> google.protobuf.Duration
+	1,  // 12: temporal.cloud.api.v1.SearchAttribute.type:type_name -> temporal.cloud.api.v1.SearchAttributeType
+	12, // 13: temporal.cloud.api.v1.ExportSink.s3:type_name -> temporal.cloud.api.v1.S3ExportDestination
+	13, // 14: temporal.cloud.api.v1.ExportSink.gcs:type_name -> temporal.cloud.api.v1.GCSExportDestination
+	83, // 15: temporal.cloud.api.v1.ExportSink.last_export_time:type_name -> google.protobuf.Timestamp
+	83, // 16: temporal.cloud.api.v1.ExportSink.created_at:type_name -> google.protobuf.Timestamp
+	83, // 17: temporal.cloud.api.v1.ExportSink.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 18: temporal.cloud.api.v1.ExportJob.state:type_name -> temporal.cloud.api.v1.ExportJobState
+	83, // 19: temporal.cloud.api.v1.ExportJob.window_start:type_name -> google.protobuf.Timestamp
+	83, // 20: temporal.cloud.api.v1.ExportJob.window_end:type_name -> google.protobuf.Timestamp
+	83, // 21: temporal.cloud.api.v1.ExportJob.started_at:type_name -> google.protobuf.Timestamp
+	83, // 22: temporal.cloud.api.v1.ExportJob.completed_at:type_name -> google.protobuf.Timestamp
+	16, // 23: temporal.cloud.api.v1.ConnectivityRule.ip_allowlist:type_name -> temporal.cloud.api.v1.IPAllowlistRule
+	17, // 24: temporal.cloud.api.v1.ConnectivityRule.private_link:type_name -> temporal.cloud.api.v1.PrivateLinkRule
+	18, // 25: temporal.cloud.api.v1.ConnectivityRule.vpc_peering:type_name -> temporal.cloud.api.v1.VPCPeeringRule
+	83, // 26: temporal.cloud.api.v1.ConnectivityRule.created_at:type_name -> google.protobuf.Timestamp
+	83, // 27: temporal.cloud.api.v1.ConnectivityRule.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 28: temporal.cloud.api.v1.CreateNamespaceRequest.config:type_name -> temporal.cloud.api.v1.NamespaceConfig
+	82, // 29: temporal.cloud.api.v1.CreateNamespaceRequest.tags:type_name -> temporal.cloud.api.v1.CreateNamespaceRequest.TagsEntry
+	4,  // 30: temporal.cloud.api.v1.CreateNamespaceResponse.namespace:type_name -> temporal.cloud.api.v1.Namespace
+	4,  // 31: temporal.cloud.api.v1.GetNamespaceResponse.namespace:type_name -> temporal.cloud.api.v1.Namespace
+	4,  // 32: temporal.cloud.api.v1.UpdateNamespaceRequest.namespace:type_name -> temporal.cloud.api.v1.Namespace
+	85, // 33: temporal.cloud.api.v1.UpdateNamespaceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 34: temporal.cloud.api.v1.UpdateNamespaceResponse.namespace:type_name -> temporal.cloud.api.v1.Namespace
+	0,  // 35: temporal.cloud.api.v1.ListNamespacesRequest.state_filter:type_name -> temporal.cloud.api.v1.NamespaceState
+	4,  // 36: temporal.cloud.api.v1.ListNamespacesResponse.namespaces:type_name -> temporal.cloud.api.v1.Namespace
+	9,  // 37: temporal.cloud.api.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.cloud.api.v1.SearchAttribute
+	10, // 38: temporal.cloud.api.v1.AddCertificateFilterRequest.certificate_filter:type_name -> temporal.cloud.api.v1.CertificateFilter
+	10, // 39: temporal.cloud.api.v1.AddCertificateFilterResponse.certificate_filter:type_name -> temporal.cloud.api.v1.CertificateFilter
+	83, // 40: temporal.cloud.api.v1.IssueClientCertificateResponse.expires_at:type_name -> google.protobuf.Timestamp
+	11, // 41: temporal.cloud.api.v1.CreateExportSinkRequest.sink:type_name -> temporal.cloud.api.v1.ExportSink
+	11, // 42: temporal.cloud.api.v1.CreateExportSinkResponse.sink:type_name -> temporal.cloud.api.v1.ExportSink
+	11, // 43: temporal.cloud.api.v1.GetExportSinkResponse.sink:type_name -> temporal.cloud.api.v1.ExportSink
+	11, // 44: temporal.cloud.api.v1.ListExportSinksResponse.sinks:type_name -> temporal.cloud.api.v1.ExportSink
+	11, // 45: temporal.cloud.api.v1.UpdateExportSinkRequest.sink:type_name -> temporal.cloud.api.v1.ExportSink
+	11, // 46: temporal.cloud.api.v1.UpdateExportSinkResponse.sink:type_name -> temporal.cloud.api.v1.ExportSink
+	14, // 47: temporal.cloud.api.v1.ListExportJobsResponse.jobs:type_name -> temporal.cloud.api.v1.ExportJob
+	15, // 48: temporal.cloud.api.v1.CreateConnectivityRuleRequest.rule:type_name -> temporal.cloud.api.v1.ConnectivityRule
+	15, // 49: temporal.cloud.api.v1.CreateConnectivityRuleResponse.rule:type_name -> temporal.cloud.api.v1.ConnectivityRule
+	15, // 50: temporal.cloud.api.v1.GetConnectivityRuleResponse.rule:type_name -> temporal.cloud.api.v1.ConnectivityRule
+	15, // 51: temporal.cloud.api.v1.ListConnectivityRulesResponse.rules:type_name -> temporal.cloud.api.v1.ConnectivityRule
+	15, // 52: temporal.cloud.api.v1.UpdateConnectivityRuleRequest.rule:type_name -> temporal.cloud.api.v1.ConnectivityRule
+	15, // 53: temporal.cloud.api.v1.UpdateConnectivityRuleResponse.rule:type_name -> temporal.cloud.api.v1.ConnectivityRule
+	15, // 54: temporal.cloud.api.v1.ListNamespaceConnectivityRulesResponse.rules:type_name -> temporal.cloud.api.v1.ConnectivityRule
+	69, // 55: temporal.cloud.api.v1.NexusEndpoint.spec:type_name -> temporal.cloud.api.v1.NexusEndpointSpec
+	3,  // 56: temporal.cloud.api.v1.NexusEndpoint.state:type_name -> temporal.cloud.api.v1.NexusEndpointState
+	83, // 57: temporal.cloud.api.v1.NexusEndpoint.created_at:type_name -> google.protobuf.Timestamp
+	83, // 58: temporal.cloud.api.v1.NexusEndpoint.updated_at:type_name -> google.protobuf.Timestamp
+	69, // 59: temporal.cloud.api.v1.CreateNexusEndpointRequest.spec:type_name -> temporal.cloud.api.v1.NexusEndpointSpec
+	70, // 60: temporal.cloud.api.v1.CreateNexusEndpointResponse.endpoint:type_name -> temporal.cloud.api.v1.NexusEndpoint
+	70, // 61: temporal.cloud.api.v1.GetNexusEndpointResponse.endpoint:type_name -> temporal.cloud.api.v1.NexusEndpoint
+	70, // 62: temporal.cloud.api.v1.ListNexusEndpointsResponse.endpoints:type_name -> temporal.cloud.api.v1.NexusEndpoint
+	69, // 63: temporal.cloud.api.v1.UpdateNexusEndpointRequest.spec:type_name -> temporal.cloud.api.v1.NexusEndpointSpec
+	70, // 64: temporal.cloud.api.v1.UpdateNexusEndpointResponse.endpoint:type_name -> temporal.cloud.api.v1.NexusEndpoint
+	19, // 65: temporal.cloud.api.v1.NamespaceService.CreateNamespace:input_type -> temporal.cloud.api.v1.CreateNamespaceRequest
+	21, // 66: temporal.cloud.api.v1.NamespaceService.GetNamespace:input_type -> temporal.cloud.api.v1.GetNamespaceRequest
+	23, // 67: temporal.cloud.api.v1.NamespaceService.UpdateNamespace:input_type -> temporal.cloud.api.v1.UpdateNamespaceRequest
+	25, // 68: temporal.cloud.api.v1.NamespaceService.DeleteNamespace:input_type -> temporal.cloud.api.v1.DeleteNamespaceRequest
+	27, // 69: temporal.cloud.api.v1.NamespaceService.ListNamespaces:input_type -> temporal.cloud.api.v1.ListNamespacesRequest
+	29, // 70: temporal.cloud.api.v1.NamespaceService.AddSearchAttributes:input_type -> temporal.cloud.api.v1.AddSearchAttributesRequest
+	31, // 71: temporal.cloud.api.v1.NamespaceService.RemoveSearchAttribute:input_type -> temporal.cloud.api.v1.RemoveSearchAttributeRequest
+	33, // 72: temporal.cloud.api.v1.NamespaceService.AddCertificateFilter:input_type -> temporal.cloud.api.v1.AddCertificateFilterRequest
+	35, // 73: temporal.cloud.api.v1.NamespaceService.RemoveCertificateFilter:input_type -> temporal.cloud.api.v1.RemoveCertificateFilterRequest
+	37, // 74: temporal.cloud.api.v1.NamespaceService.FailoverNamespace:input_type -> temporal.cloud.api.v1.FailoverNamespaceRequest
+	39, // 75: temporal.cloud.api.v1.NamespaceService.IssueClientCertificate:input_type -> temporal.cloud.api.v1.IssueClientCertificateRequest
+	41, // 76: temporal.cloud.api.v1.NamespaceService.CreateExportSink:input_type -> temporal.cloud.api.v1.CreateExportSinkRequest
+	43, // 77: temporal.cloud.api.v1.NamespaceService.GetExportSink:input_type -> temporal.cloud.api.v1.GetExportSinkRequest
+	45, // 78: temporal.cloud.api.v1.NamespaceService.ListExportSinks:input_type -> temporal.cloud.api.v1.ListExportSinksRequest
+	47, // 79: temporal.cloud.api.v1.NamespaceService.UpdateExportSink:input_type -> temporal.cloud.api.v1.UpdateExportSinkRequest
+	49, // 80: temporal.cloud.api.v1.NamespaceService.DeleteExportSink:input_type -> temporal.cloud.api.v1.DeleteExportSinkRequest
+	51, // 81: temporal.cloud.api.v1.NamespaceService.ListExportJobs:input_type -> temporal.cloud.api.v1.ListExportJobsRequest
+	53, // 82: temporal.cloud.api.v1.NamespaceService.CreateConnectivityRule:input_type -> temporal.cloud.api.v1.CreateConnectivityRuleRequest
+	55, // 83: temporal.cloud.api.v1.NamespaceService.GetConnectivityRule:input_type -> temporal.cloud.api.v1.GetConnectivityRuleRequest
+	57, // 84: temporal.cloud.api.v1.NamespaceService.ListConnectivityRules:input_type -> temporal.cloud.api.v1.ListConnectivityRulesRequest
+	59, // 85: temporal.cloud.api.v1.NamespaceService.UpdateConnectivityRule:input_type -> temporal.cloud.api.v1.UpdateConnectivityRuleRequest
+	61, // 86: temporal.cloud.api.v1.NamespaceService.DeleteConnectivityRule:input_type -> temporal.cloud.api.v1.DeleteConnectivityRuleRequest
+	63, // 87: temporal.cloud.api.v1.NamespaceService.AddNamespaceConnectivityRule:input_type -> temporal.cloud.api.v1.AddNamespaceConnectivityRuleRequest
+	65, // 88: temporal.cloud.api.v1.NamespaceService.RemoveNamespaceConnectivityRule:input_type -> temporal.cloud.api.v1.RemoveNamespaceConnectivityRuleRequest
+	67, // 89: temporal.cloud.api.v1.NamespaceService.ListNamespaceConnectivityRules:input_type -> temporal.cloud.api.v1.ListNamespaceConnectivityRulesRequest
+	71, // 90: temporal.cloud.api.v1.NamespaceService.CreateNexusEndpoint:input_type -> temporal.cloud.api.v1.CreateNexusEndpointRequest
+	73, // 91: temporal.cloud.api.v1.NamespaceService.GetNexusEndpoint:input_type -> temporal.cloud.api.v1.GetNexusEndpointRequest
+	75, // 92: temporal.cloud.api.v1.NamespaceService.ListNexusEndpoints:input_type -> temporal.cloud.api.v1.ListNexusEndpointsRequest
+	77, // 93: temporal.cloud.api.v1.NamespaceService.UpdateNexusEndpoint:input_type -> temporal.cloud.api.v1.UpdateNexusEndpointRequest
+	79, // 94: temporal.cloud.api.v1.NamespaceService.DeleteNexusEndpoint:input_type -> temporal.cloud.api.v1.DeleteNexusEndpointRequest
+	20, // 95: temporal.cloud.api.v1.NamespaceService.CreateNamespace:output_type -> temporal.cloud.api.v1.CreateNamespaceResponse
+	22, // 96: temporal.cloud.api.v1.NamespaceService.GetNamespace:output_type -> temporal.cloud.api.v1.GetNamespaceResponse
+	24, // 97: temporal.cloud.api.v1.NamespaceService.UpdateNamespace:output_type -> temporal.cloud.api.v1.UpdateNamespaceResponse
+	26, // 98: temporal.cloud.api.v1.NamespaceService.DeleteNamespace:output_type -> temporal.cloud.api.v1.DeleteNamespaceResponse
+	28, // 99: temporal.cloud.api.v1.NamespaceService.ListNamespaces:output_type -> temporal.cloud.api.v1.ListNamespacesResponse
+	30, // 100: temporal.cloud.api.v1.NamespaceService.AddSearchAttributes:output_type -> temporal.cloud.api.v1.AddSearchAttributesResponse
+	32, // 101: temporal.cloud.api.v1.NamespaceService.RemoveSearchAttribute:output_type -> temporal.cloud.api.v1.RemoveSearchAttributeResponse
+	34, // 102: temporal.cloud.api.v1.NamespaceService.AddCertificateFilter:output_type -> temporal.cloud.api.v1.AddCertificateFilterResponse
+	36, // 103: temporal.cloud.api.v1.NamespaceService.RemoveCertificateFilter:output_type -> temporal.cloud.api.v1.RemoveCertificateFilterResponse
+	38, // 104: temporal.cloud.api.v1.NamespaceService.FailoverNamespace:output_type -> temporal.cloud.api.v1.FailoverNamespaceResponse
+	40, // 105: temporal.cloud.api.v1.NamespaceService.IssueClientCertificate:output_type -> temporal.cloud.api.v1.IssueClientCertificateResponse
+	42, // 106: temporal.cloud.api.v1.NamespaceService.CreateExportSink:output_type -> temporal.cloud.api.v1.CreateExportSinkResponse
+	44, // 107: temporal.cloud.api.v1.NamespaceService.GetExportSink:output_type -> temporal.cloud.api.v1.GetExportSinkResponse
+	46, // 108: temporal.cloud.api.v1.NamespaceService.ListExportSinks:output_type -> temporal.cloud.api.v1.ListExportSinksResponse
+	48, // 109: temporal.cloud.api.v1.NamespaceService.UpdateExportSink:output_type -> temporal.cloud.api.v1.UpdateExportSinkResponse
+	50, // 110: temporal.cloud.api.v1.NamespaceService.DeleteExportSink:output_type -> temporal.cloud.api.v1.DeleteExportSinkResponse
+	52, // 111: temporal.cloud.api.v1.NamespaceService.ListExportJobs:output_type -> temporal.cloud.api.v1.ListExportJobsResponse
+	54, // 112: temporal.cloud.api.v1.NamespaceService.CreateConnectivityRule:output_type -> temporal.cloud.api.v1.CreateConnectivityRuleResponse
+	56, // 113: temporal.cloud.api.v1.NamespaceService.GetConnectivityRule:output_type -> temporal.cloud.api.v1.GetConnectivityRuleResponse
+	58, // 114: temporal.cloud.api.v1.NamespaceService.ListConnectivityRules:output_type -> temporal.cloud.api.v1.ListConnectivityRulesResponse
+	60, // 115: temporal.cloud.api.v1.NamespaceService.UpdateConnectivityRule:output_type -> temporal.cloud.api.v1.UpdateConnectivityRuleResponse
+	62, // 116: temporal.cloud.api.v1.NamespaceService.DeleteConnectivityRule:output_type -> temporal.cloud.api.v1.DeleteConnectivityRuleResponse
+	64, // 117: temporal.cloud.api.v1.NamespaceService.AddNamespaceConnectivityRule:output_type -> temporal.cloud.api.v1.AddNamespaceConnectivityRuleResponse
+	66, // 118: temporal.cloud.api.v1.NamespaceService.RemoveNamespaceConnectivityRule:output_type -> temporal.cloud.api.v1.RemoveNamespaceConnectivityRuleResponse
+	68, // 119: temporal.cloud.api.v1.NamespaceService.ListNamespaceConnectivityRules:output_type -> temporal.cloud.api.v1.ListNamespaceConnectivityRulesResponse
+	72, // 120: temporal.cloud.api.v1.NamespaceService.CreateNexusEndpoint:output_type -> temporal.cloud.api.v1.CreateNexusEndpointResponse
+	74, // 121: temporal.cloud.api.v1.NamespaceService.GetNexusEndpoint:output_type -> temporal.cloud.api.v1.GetNexusEndpointResponse
+	76, // 122: temporal.cloud.api.v1.NamespaceService.ListNexusEndpoints:output_type -> temporal.cloud.api.v1.ListNexusEndpointsResponse
+	78, // 123: temporal.cloud.api.v1.NamespaceService.UpdateNexusEndpoint:output_type -> temporal.cloud.api.v1.UpdateNexusEndpointResponse
+	80, // 124: temporal.cloud.api.v1.NamespaceService.DeleteNexusEndpoint:output_type -> temporal.cloud.api.v1.DeleteNexusEndpointResponse
+	95, // [95:125] is the sub-list for method output_type
+	65, // [65:95] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
+}
+
+func init() { file_cloud_v1_namespaces_proto_init() }
+func file_cloud_v1_namespaces_proto_init() {
+	if File_cloud_v1_namespaces_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cloud_v1_namespaces_proto_rawDesc), len(file_cloud_v1_namespaces_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   79,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cloud_v1_namespaces_proto_goTypes,
+		DependencyIndexes: file_cloud_v1_namespaces_proto_depIdxs,
+		EnumInfos:         file_cloud_v1_namespaces_proto_enumTypes,
+		MessageInfos:      file_cloud_v1_namespaces_proto_msgTypes,
+	}.Build()
+	File_cloud_v1_namespaces_proto = out.File
+	file_cloud_v1_namespaces_proto_goTypes = nil
+	file_cloud_v1_namespaces_proto_depIdxs = nil
+}
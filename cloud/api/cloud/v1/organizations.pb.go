@@ -0,0 +1,2463 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: cloud/v1/organizations.proto
+
+package cloudv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OrganizationRole defines roles within an organization.
+type OrganizationRole int32
+
+const (
+	OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED OrganizationRole = 0
+	OrganizationRole_ORGANIZATION_ROLE_OWNER       OrganizationRole = 1
+	OrganizationRole_ORGANIZATION_ROLE_ADMIN       OrganizationRole = 2
+	OrganizationRole_ORGANIZATION_ROLE_DEVELOPER   OrganizationRole = 3
+	OrganizationRole_ORGANIZATION_ROLE_READ_ONLY   OrganizationRole = 4
+	// Finance members manage billing and can read the rest of the organization.
+	OrganizationRole_ORGANIZATION_ROLE_FINANCE OrganizationRole = 5
+)
+
+// Enum value maps for OrganizationRole.
+var (
+	OrganizationRole_name = map[int32]string{
+		0: "ORGANIZATION_ROLE_UNSPECIFIED",
+		1: "ORGANIZATION_ROLE_OWNER",
+		2: "ORGANIZATION_ROLE_ADMIN",
+		3: "ORGANIZATION_ROLE_DEVELOPER",
+		4: "ORGANIZATION_ROLE_READ_ONLY",
+		5: "ORGANIZATION_ROLE_FINANCE",
+	}
+	OrganizationRole_value = map[string]int32{
+		"ORGANIZATION_ROLE_UNSPECIFIED": 0,
+		"ORGANIZATION_ROLE_OWNER":       1,
+		"ORGANIZATION_ROLE_ADMIN":       2,
+		"ORGANIZATION_ROLE_DEVELOPER":   3,
+		"ORGANIZATION_ROLE_READ_ONLY":   4,
+		"ORGANIZATION_ROLE_FINANCE":     5,
+	}
+)
+
+func (x OrganizationRole) Enum() *OrganizationRole {
+	p := new(OrganizationRole)
+	*p = x
+	return p
+}
+
+func (x OrganizationRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrganizationRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_organizations_proto_enumTypes[0].Descriptor()
+}
+
+func (OrganizationRole) Type() protoreflect.EnumType {
+	return &file_cloud_v1_organizations_proto_enumTypes[0]
+}
+
+func (x OrganizationRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrganizationRole.Descriptor instead.
+func (OrganizationRole) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{0}
+}
+
+// InvitationStatus is the status of an invitation.
+type InvitationStatus int32
+
+const (
+	InvitationStatus_INVITATION_STATUS_UNSPECIFIED InvitationStatus = 0
+	InvitationStatus_INVITATION_STATUS_PENDING     InvitationStatus = 1
+	InvitationStatus_INVITATION_STATUS_ACCEPTED    InvitationStatus = 2
+	InvitationStatus_INVITATION_STATUS_DECLINED    InvitationStatus = 3
+	// Expired invitations were neither accepted nor declined in time. They are
+	// deleted after a while.
+	InvitationStatus_INVITATION_STATUS_EXPIRED InvitationStatus = 4
+)
+
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_STATUS_UNSPECIFIED",
+		1: "INVITATION_STATUS_PENDING",
+		2: "INVITATION_STATUS_ACCEPTED",
+		3: "INVITATION_STATUS_DECLINED",
+		4: "INVITATION_STATUS_EXPIRED",
+	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_STATUS_UNSPECIFIED": 0,
+		"INVITATION_STATUS_PENDING":     1,
+		"INVITATION_STATUS_ACCEPTED":    2,
+		"INVITATION_STATUS_DECLINED":    3,
+		"INVITATION_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
+}
+
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_v1_organizations_proto_enumTypes[1].Descriptor()
+}
+
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_cloud_v1_organizations_proto_enumTypes[1]
+}
+
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{1}
+}
+
+// Organization represents a Temporal Cloud organization.
+type Organization struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the organization.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Human-readable name of the organization.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// URL-safe slug for the organization (unique).
+	Slug string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Organization settings.
+	Settings *OrganizationSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Timestamp when the organization was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the organization was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// When the organization's scheduled deletion begins, unset if no deletion
+	// is scheduled.
+	DeleteAfter *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=delete_after,json=deleteAfter,proto3" json:"delete_after,omitempty"`
+	// When teardown of the organization began, after which its deletion can no
+	// longer be cancelled.
+	DeletionStartedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deletion_started_at,json=deletionStartedAt,proto3" json:"deletion_started_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Organization) GetSettings() *OrganizationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetDeleteAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteAfter
+	}
+	return nil
+}
+
+func (x *Organization) GetDeletionStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionStartedAt
+	}
+	return nil
+}
+
+// OrganizationSettings contains organization-level settings.
+type OrganizationSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether SAML SSO is enabled.
+	SsoEnabled bool `protobuf:"varint,1,opt,name=sso_enabled,json=ssoEnabled,proto3" json:"sso_enabled,omitempty"`
+	// SAML SSO configuration (if enabled).
+	SamlConfig *SAMLConfig `protobuf:"bytes,2,opt,name=saml_config,json=samlConfig,proto3" json:"saml_config,omitempty"`
+	// Whether SCIM provisioning is enabled.
+	ScimEnabled bool `protobuf:"varint,3,opt,name=scim_enabled,json=scimEnabled,proto3" json:"scim_enabled,omitempty"`
+	// Default retention period for new namespaces (days).
+	DefaultRetentionDays int32 `protobuf:"varint,4,opt,name=default_retention_days,json=defaultRetentionDays,proto3" json:"default_retention_days,omitempty"`
+	// Allowed regions for namespace creation.
+	AllowedRegions []string `protobuf:"bytes,5,rep,name=allowed_regions,json=allowedRegions,proto3" json:"allowed_regions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationSettings) Reset() {
+	*x = OrganizationSettings{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationSettings) ProtoMessage() {}
+
+func (x *OrganizationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationSettings) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrganizationSettings) GetSsoEnabled() bool {
+	if x != nil {
+		return x.SsoEnabled
+	}
+	return false
+}
+
+func (x *OrganizationSettings) GetSamlConfig() *SAMLConfig {
+	if x != nil {
+		return x.SamlConfig
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetScimEnabled() bool {
+	if x != nil {
+		return x.ScimEnabled
+	}
+	return false
+}
+
+func (x *OrganizationSettings) GetDefaultRetentionDays() int32 {
+	if x != nil {
+		return x.DefaultRetentionDays
+	}
+	return 0
+}
+
+func (x *OrganizationSettings) GetAllowedRegions() []string {
+	if x != nil {
+		return x.AllowedRegions
+	}
+	return nil
+}
+
+// SAMLConfig contains SAML SSO configuration.
+type SAMLConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identity Provider Entity ID.
+	IdpEntityId string `protobuf:"bytes,1,opt,name=idp_entity_id,json=idpEntityId,proto3" json:"idp_entity_id,omitempty"`
+	// Identity Provider SSO URL.
+	IdpSsoUrl string `protobuf:"bytes,2,opt,name=idp_sso_url,json=idpSsoUrl,proto3" json:"idp_sso_url,omitempty"`
+	// Identity Provider certificate (PEM format).
+	IdpCertificate string `protobuf:"bytes,3,opt,name=idp_certificate,json=idpCertificate,proto3" json:"idp_certificate,omitempty"`
+	// Service Provider Entity ID.
+	SpEntityId string `protobuf:"bytes,4,opt,name=sp_entity_id,json=spEntityId,proto3" json:"sp_entity_id,omitempty"`
+	// Service Provider ACS URL.
+	SpAcsUrl      string `protobuf:"bytes,5,opt,name=sp_acs_url,json=spAcsUrl,proto3" json:"sp_acs_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLConfig) Reset() {
+	*x = SAMLConfig{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLConfig) ProtoMessage() {}
+
+func (x *SAMLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLConfig.ProtoReflect.Descriptor instead.
+func (*SAMLConfig) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SAMLConfig) GetIdpEntityId() string {
+	if x != nil {
+		return x.IdpEntityId
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetIdpSsoUrl() string {
+	if x != nil {
+		return x.IdpSsoUrl
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetIdpCertificate() string {
+	if x != nil {
+		return x.IdpCertificate
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetSpEntityId() string {
+	if x != nil {
+		return x.SpEntityId
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetSpAcsUrl() string {
+	if x != nil {
+		return x.SpAcsUrl
+	}
+	return ""
+}
+
+// OrganizationMember represents a member of an organization.
+type OrganizationMember struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// User email.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// User name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Role in the organization.
+	Role OrganizationRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.cloud.api.v1.OrganizationRole" json:"role,omitempty"`
+	// Timestamp when the user joined.
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMember) ProtoMessage() {}
+
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrganizationMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+func (x *OrganizationMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// CreateOrganizationRequest is the request for CreateOrganization.
+type CreateOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the organization.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// URL-safe slug (optional, generated from name if not provided).
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// CreateOrganizationResponse is the response for CreateOrganization.
+type CreateOrganizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created organization.
+	Organization  *Organization `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// GetOrganizationRequest is the request for GetOrganization.
+type GetOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// GetOrganizationResponse is the response for GetOrganization.
+type GetOrganizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The organization.
+	Organization  *Organization `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationResponse) Reset() {
+	*x = GetOrganizationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// UpdateOrganizationRequest is the request for UpdateOrganization.
+type UpdateOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Updated organization data.
+	Organization *Organization `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationRequest) Reset() {
+	*x = UpdateOrganizationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequest) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateOrganizationResponse is the response for UpdateOrganization.
+type UpdateOrganizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated organization.
+	Organization  *Organization `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationResponse) Reset() {
+	*x = UpdateOrganizationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// DeleteOrganizationRequest is the request for DeleteOrganization.
+type DeleteOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationRequest) Reset() {
+	*x = DeleteOrganizationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// DeleteOrganizationResponse is the response for DeleteOrganization.
+type DeleteOrganizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The organization, with its deletion scheduled.
+	Organization  *Organization `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationResponse) Reset() {
+	*x = DeleteOrganizationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationResponse) ProtoMessage() {}
+
+func (x *DeleteOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// CancelOrganizationDeletionRequest is the request for
+// CancelOrganizationDeletion.
+type CancelOrganizationDeletionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelOrganizationDeletionRequest) Reset() {
+	*x = CancelOrganizationDeletionRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrganizationDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrganizationDeletionRequest) ProtoMessage() {}
+
+func (x *CancelOrganizationDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrganizationDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrganizationDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelOrganizationDeletionRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// CancelOrganizationDeletionResponse is the response for
+// CancelOrganizationDeletion.
+type CancelOrganizationDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrganizationDeletionResponse) Reset() {
+	*x = CancelOrganizationDeletionResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrganizationDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrganizationDeletionResponse) ProtoMessage() {}
+
+func (x *CancelOrganizationDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrganizationDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrganizationDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelOrganizationDeletionResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// ListOrganizationsRequest is the request for ListOrganizations.
+type ListOrganizationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of organizations to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListOrganizationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrganizationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListOrganizationsResponse is the response for ListOrganizations.
+type ListOrganizationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of organizations.
+	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// InviteUserRequest is the request for InviteUser.
+type InviteUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Email of the user to invite.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Role to assign to the user.
+	Role          OrganizationRole `protobuf:"varint,3,opt,name=role,proto3,enum=temporal.cloud.api.v1.OrganizationRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserRequest) ProtoMessage() {}
+
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InviteUserRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+// InviteUserResponse is the response for InviteUser.
+type InviteUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invitation ID.
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	// The invitation.
+	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserResponse) Reset() {
+	*x = InviteUserResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserResponse) ProtoMessage() {}
+
+func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
+func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InviteUserResponse) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *InviteUserResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// Invitation is an invitation of an email to join an organization.
+type Invitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invitation ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Email the invitation was sent to.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Role the invitee gets on accepting.
+	Role OrganizationRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.cloud.api.v1.OrganizationRole" json:"role,omitempty"`
+	// Status of the invitation.
+	Status InvitationStatus `protobuf:"varint,5,opt,name=status,proto3,enum=temporal.cloud.api.v1.InvitationStatus" json:"status,omitempty"`
+	// ID of the user who sent the invitation.
+	InvitedBy string `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	// Timestamp after which the invitation can no longer be accepted.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Timestamp when the invitation was last emailed.
+	LastSentAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`
+	// Number of times the invitation was emailed.
+	SendCount int32 `protobuf:"varint,9,opt,name=send_count,json=sendCount,proto3" json:"send_count,omitempty"`
+	// Timestamp when the invitation was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+func (x *Invitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+}
+
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetLastSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetSendCount() int32 {
+	if x != nil {
+		return x.SendCount
+	}
+	return 0
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListInvitationsRequest is the request for ListInvitations.
+type ListInvitationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of invitations to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListInvitationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvitationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListInvitationsResponse is the response for ListInvitations.
+type ListInvitationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invitations, newest first.
+	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ResendInvitationRequest is the request for ResendInvitation.
+type ResendInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invitation ID.
+	InvitationId  string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationRequest) Reset() {
+	*x = ResendInvitationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationRequest) ProtoMessage() {}
+
+func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResendInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResendInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+// ResendInvitationResponse is the response for ResendInvitation.
+type ResendInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invitation.
+	Invitation    *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationResponse) Reset() {
+	*x = ResendInvitationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationResponse) ProtoMessage() {}
+
+func (x *ResendInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ResendInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResendInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// RevokeInvitationRequest is the request for RevokeInvitation.
+type RevokeInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invitation ID.
+	InvitationId  string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+// RevokeInvitationResponse is the response for RevokeInvitation.
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{24}
+}
+
+// AcceptInvitationRequest is the request for AcceptInvitation.
+type AcceptInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token from the invitation email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// AcceptInvitationResponse is the response for AcceptInvitation.
+type AcceptInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The organization the caller joined.
+	Organization *Organization `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// The accepted invitation.
+	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AcceptInvitationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *AcceptInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// DeclineInvitationRequest is the request for DeclineInvitation.
+type DeclineInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token from the invitation email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationRequest) Reset() {
+	*x = DeclineInvitationRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationRequest) ProtoMessage() {}
+
+func (x *DeclineInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeclineInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// DeclineInvitationResponse is the response for DeclineInvitation.
+type DeclineInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationResponse) Reset() {
+	*x = DeclineInvitationResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationResponse) ProtoMessage() {}
+
+func (x *DeclineInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationResponse.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{28}
+}
+
+// ListMembersRequest is the request for ListMembers.
+type ListMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Maximum number of members to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMembersRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListMembersResponse is the response for ListMembers.
+type ListMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of members.
+	Members []*OrganizationMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// Token for the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMembersResponse) GetMembers() []*OrganizationMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateMemberRoleRequest is the request for UpdateMemberRole.
+type UpdateMemberRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// User ID.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// New role.
+	Role          OrganizationRole `protobuf:"varint,3,opt,name=role,proto3,enum=temporal.cloud.api.v1.OrganizationRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRoleRequest) Reset() {
+	*x = UpdateMemberRoleRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateMemberRoleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRoleRequest) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+// UpdateMemberRoleResponse is the response for UpdateMemberRole.
+type UpdateMemberRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updated member.
+	Member        *OrganizationMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRoleResponse) Reset() {
+	*x = UpdateMemberRoleResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateMemberRoleResponse) GetMember() *OrganizationMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// RemoveMemberRequest is the request for RemoveMember.
+type RemoveMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization ID.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// User ID.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RemoveMemberResponse is the response for RemoveMember.
+type RemoveMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_cloud_v1_organizations_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_organizations_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_organizations_proto_rawDescGZIP(), []int{34}
+}
+
+var File_cloud_v1_organizations_proto protoreflect.FileDescriptor
+
+const file_cloud_v1_organizations_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccloud/v1/organizations.proto\x12\x15temporal.cloud.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\"\x90\x03\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12G\n" +
+	"\bsettings\x18\x04 \x01(\v2+.temporal.cloud.api.v1.OrganizationSettingsR\bsettings\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fdelete_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vdeleteAfter\x12J\n" +
+	"\x13deletion_started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x11deletionStartedAt\"\xfd\x01\n" +
+	"\x14OrganizationSettings\x12\x1f\n" +
+	"\vsso_enabled\x18\x01 \x01(\bR\n" +
+	"ssoEnabled\x12B\n" +
+	"\vsaml_config\x18\x02 \x01(\v2!.temporal.cloud.api.v1.SAMLConfigR\n" +
+	"samlConfig\x12!\n" +
+	"\fscim_enabled\x18\x03 \x01(\bR\vscimEnabled\x124\n" +
+	"\x16default_retention_days\x18\x04 \x01(\x05R\x14defaultRetentionDays\x12'\n" +
+	"\x0fallowed_regions\x18\x05 \x03(\tR\x0eallowedRegions\"\xb9\x01\n" +
+	"\n" +
+	"SAMLConfig\x12\"\n" +
+	"\ridp_entity_id\x18\x01 \x01(\tR\vidpEntityId\x12\x1e\n" +
+	"\vidp_sso_url\x18\x02 \x01(\tR\tidpSsoUrl\x12'\n" +
+	"\x0fidp_certificate\x18\x03 \x01(\tR\x0eidpCertificate\x12 \n" +
+	"\fsp_entity_id\x18\x04 \x01(\tR\n" +
+	"spEntityId\x12\x1c\n" +
+	"\n" +
+	"sp_acs_url\x18\x05 \x01(\tR\bspAcsUrl\"\xcd\x01\n" +
+	"\x12OrganizationMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12;\n" +
+	"\x04role\x18\x04 \x01(\x0e2'.temporal.cloud.api.v1.OrganizationRoleR\x04role\x127\n" +
+	"\tjoined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"C\n" +
+	"\x19CreateOrganizationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"e\n" +
+	"\x1aCreateOrganizationResponse\x12G\n" +
+	"\forganization\x18\x01 \x01(\v2#.temporal.cloud.api.v1.OrganizationR\forganization\"A\n" +
+	"\x16GetOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"b\n" +
+	"\x17GetOrganizationResponse\x12G\n" +
+	"\forganization\x18\x01 \x01(\v2#.temporal.cloud.api.v1.OrganizationR\forganization\"\xca\x01\n" +
+	"\x19UpdateOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12G\n" +
+	"\forganization\x18\x02 \x01(\v2#.temporal.cloud.api.v1.OrganizationR\forganization\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"e\n" +
+	"\x1aUpdateOrganizationResponse\x12G\n" +
+	"\forganization\x18\x01 \x01(\v2#.temporal.cloud.api.v1.OrganizationR\forganization\"D\n" +
+	"\x19DeleteOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"e\n" +
+	"\x1aDeleteOrganizationResponse\x12G\n" +
+	"\forganization\x18\x01 \x01(\v2#.temporal.cloud.api.v1.OrganizationR\forganization\"L\n" +
+	"!CancelOrganizationDeletionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"m\n" +
+	"\"CancelOrganizationDeletionResponse\x12G\n" +
+	"\forganization\x18\x01 \x01(\v2#.temporal.cloud.api.v1.OrganizationR\forganization\"V\n" +
+	"\x18ListOrganizationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8e\x01\n" +
+	"\x19ListOrganizationsResponse\x12I\n" +
+	"\rorganizations\x18\x01 \x03(\v2#.temporal.cloud.api.v1.OrganizationR\rorganizations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x01\n" +
+	"\x11InviteUserRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12;\n" +
+	"\x04role\x18\x03 \x01(\x0e2'.temporal.cloud.api.v1.OrganizationRoleR\x04role\"|\n" +
+	"\x12InviteUserResponse\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12A\n" +
+	"\n" +
+	"invitation\x18\x02 \x01(\v2!.temporal.cloud.api.v1.InvitationR\n" +
+	"invitation\"\xcb\x03\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12;\n" +
+	"\x04role\x18\x04 \x01(\x0e2'.temporal.cloud.api.v1.OrganizationRoleR\x04role\x12?\n" +
+	"\x06status\x18\x05 \x01(\x0e2'.temporal.cloud.api.v1.InvitationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_sent_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSentAt\x12\x1d\n" +
+	"\n" +
+	"send_count\x18\t \x01(\x05R\tsendCount\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"}\n" +
+	"\x16ListInvitationsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x86\x01\n" +
+	"\x17ListInvitationsResponse\x12C\n" +
+	"\vinvitations\x18\x01 \x03(\v2!.temporal.cloud.api.v1.InvitationR\vinvitations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\">\n" +
+	"\x17ResendInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\"]\n" +
+	"\x18ResendInvitationResponse\x12A\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2!.temporal.cloud.api.v1.InvitationR\n" +
+	"invitation\">\n" +
+	"\x17RevokeInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\"\x1a\n" +
+	"\x18RevokeInvitationResponse\"/\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xa6\x01\n" +
+	"\x18AcceptInvitationResponse\x12G\n" +
+	"\forganization\x18\x01 \x01(\v2#.temporal.cloud.api.v1.OrganizationR\forganization\x12A\n" +
+	"\n" +
+	"invitation\x18\x02 \x01(\v2!.temporal.cloud.api.v1.InvitationR\n" +
+	"invitation\"0\n" +
+	"\x18DeclineInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19DeclineInvitationResponse\"y\n" +
+	"\x12ListMembersRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x13ListMembersResponse\x12C\n" +
+	"\amembers\x18\x01 \x03(\v2).temporal.cloud.api.v1.OrganizationMemberR\amembers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x98\x01\n" +
+	"\x17UpdateMemberRoleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12;\n" +
+	"\x04role\x18\x03 \x01(\x0e2'.temporal.cloud.api.v1.OrganizationRoleR\x04role\"]\n" +
+	"\x18UpdateMemberRoleResponse\x12A\n" +
+	"\x06member\x18\x01 \x01(\v2).temporal.cloud.api.v1.OrganizationMemberR\x06member\"W\n" +
+	"\x13RemoveMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x16\n" +
+	"\x14RemoveMemberResponse*\xd0\x01\n" +
+	"\x10OrganizationRole\x12!\n" +
+	"\x1dORGANIZATION_ROLE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ORGANIZATION_ROLE_OWNER\x10\x01\x12\x1b\n" +
+	"\x17ORGANIZATION_ROLE_ADMIN\x10\x02\x12\x1f\n" +
+	"\x1bORGANIZATION_ROLE_DEVELOPER\x10\x03\x12\x1f\n" +
+	"\x1bORGANIZATION_ROLE_READ_ONLY\x10\x04\x12\x1d\n" +
+	"\x19ORGANIZATION_ROLE_FINANCE\x10\x05*\xb3\x01\n" +
+	"\x10InvitationStatus\x12!\n" +
+	"\x1dINVITATION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19INVITATION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aINVITATION_STATUS_ACCEPTED\x10\x02\x12\x1e\n" +
+	"\x1aINVITATION_STATUS_DECLINED\x10\x03\x12\x1d\n" +
+	"\x19INVITATION_STATUS_EXPIRED\x10\x042\xf4\r\n" +
+	"\x13OrganizationService\x12y\n" +
+	"\x12CreateOrganization\x120.temporal.cloud.api.v1.CreateOrganizationRequest\x1a1.temporal.cloud.api.v1.CreateOrganizationResponse\x12p\n" +
+	"\x0fGetOrganization\x12-.temporal.cloud.api.v1.GetOrganizationRequest\x1a..temporal.cloud.api.v1.GetOrganizationResponse\x12y\n" +
+	"\x12UpdateOrganization\x120.temporal.cloud.api.v1.UpdateOrganizationRequest\x1a1.temporal.cloud.api.v1.UpdateOrganizationResponse\x12y\n" +
+	"\x12DeleteOrganization\x120.temporal.cloud.api.v1.DeleteOrganizationRequest\x1a1.temporal.cloud.api.v1.DeleteOrganizationResponse\x12\x91\x01\n" +
+	"\x1aCancelOrganizationDeletion\x128.temporal.cloud.api.v1.CancelOrganizationDeletionRequest\x1a9.temporal.cloud.api.v1.CancelOrganizationDeletionResponse\x12v\n" +
+	"\x11ListOrganizations\x12/.temporal.cloud.api.v1.ListOrganizationsRequest\x1a0.temporal.cloud.api.v1.ListOrganizationsResponse\x12a\n" +
+	"\n" +
+	"InviteUser\x12(.temporal.cloud.api.v1.InviteUserRequest\x1a).temporal.cloud.api.v1.InviteUserResponse\x12p\n" +
+	"\x0fListInvitations\x12-.temporal.cloud.api.v1.ListInvitationsRequest\x1a..temporal.cloud.api.v1.ListInvitationsResponse\x12s\n" +
+	"\x10ResendInvitation\x12..temporal.cloud.api.v1.ResendInvitationRequest\x1a/.temporal.cloud.api.v1.ResendInvitationResponse\x12s\n" +
+	"\x10RevokeInvitation\x12..temporal.cloud.api.v1.RevokeInvitationRequest\x1a/.temporal.cloud.api.v1.RevokeInvitationResponse\x12s\n" +
+	"\x10AcceptInvitation\x12..temporal.cloud.api.v1.AcceptInvitationRequest\x1a/.temporal.cloud.api.v1.AcceptInvitationResponse\x12v\n" +
+	"\x11DeclineInvitation\x12/.temporal.cloud.api.v1.DeclineInvitationRequest\x1a0.temporal.cloud.api.v1.DeclineInvitationResponse\x12d\n" +
+	"\vListMembers\x12).temporal.cloud.api.v1.ListMembersRequest\x1a*.temporal.cloud.api.v1.ListMembersResponse\x12s\n" +
+	"\x10UpdateMemberRole\x12..temporal.cloud.api.v1.UpdateMemberRoleRequest\x1a/.temporal.cloud.api.v1.UpdateMemberRoleResponse\x12g\n" +
+	"\fRemoveMember\x12*.temporal.cloud.api.v1.RemoveMemberRequest\x1a+.temporal.cloud.api.v1.RemoveMemberResponseB+Z)go.temporal.io/cloud/api/cloud/v1;cloudv1b\x06proto3"
+
+var (
+	file_cloud_v1_organizations_proto_rawDescOnce sync.Once
+	file_cloud_v1_organizations_proto_rawDescData []byte
+)
+
+func file_cloud_v1_organizations_proto_rawDescGZIP() []byte {
+	file_cloud_v1_organizations_proto_rawDescOnce.Do(func() {
+		file_cloud_v1_organizations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cloud_v1_organizations_proto_rawDesc), len(file_cloud_v1_organizations_proto_rawDesc)))
+	})
+	return file_cloud_v1_organizations_proto_rawDescData
+}
+
+var file_cloud_v1_organizations_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cloud_v1_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_cloud_v1_organizations_proto_goTypes = []any{
+	(OrganizationRole)(0),                      // 0: temporal.cloud.api.v1.OrganizationRole
+	(InvitationStatus)(0),                      // 1: temporal.cloud.api.v1.InvitationStatus
+	(*Organization)(nil),                       // 2: temporal.cloud.api.v1.Organization
+	(*OrganizationSettings)(nil),               // 3: temporal.cloud.api.v1.OrganizationSettings
+	(*SAMLConfig)(nil),                         // 4: temporal.cloud.api.v1.SAMLConfig
+	(*OrganizationMember)(nil),                 // 5: temporal.cloud.api.v1.OrganizationMember
+	(*CreateOrganizationRequest)(nil),          // 6: temporal.cloud.api.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),         // 7: temporal.cloud.api.v1.CreateOrganizationResponse
+	(*GetOrganizationRequest)(nil),             // 8: temporal.cloud.api.v1.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),            // 9: temporal.cloud.api.v1.GetOrganizationResponse
+	(*UpdateOrganizationRequest)(nil),          // 10: temporal.cloud.api.v1.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil),         // 11: temporal.cloud.api.v1.UpdateOrganizationResponse
+	(*DeleteOrganizationRequest)(nil),          // 12: temporal.cloud.api.v1.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),         // 13: temporal.cloud.api.v1.DeleteOrganizationResponse
+	(*CancelOrganizationDeletionRequest)(nil),  // 14: temporal.cloud.api.v1.CancelOrganizationDeletionRequest
+	(*CancelOrganizationDeletionResponse)(nil), // 15: temporal.cloud.api.v1.CancelOrganizationDeletionResponse
+	(*ListOrganizationsRequest)(nil),           // 16: temporal.cloud.api.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),          // 17: temporal.cloud.api.v1.ListOrganizationsResponse
+	(*InviteUserRequest)(nil),                  // 18: temporal.cloud.api.v1.InviteUserRequest
+	(*InviteUserResponse)(nil),                 // 19: temporal.cloud.api.v1.InviteUserResponse
+	(*Invitation)(nil),                         // 20: temporal.cloud.api.v1.Invitation
+	(*ListInvitationsRequest)(nil),             // 21: temporal.cloud.api.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),            // 22: temporal.cloud.api.v1.ListInvitationsResponse
+	(*ResendInvitationRequest)(nil),            // 23: temporal.cloud.api.v1.ResendInvitationRequest
+	(*ResendInvitationResponse)(nil),           // 24: temporal.cloud.api.v1.ResendInvitationResponse
+	(*RevokeInvitationRequest)(nil),            // 25: temporal.cloud.api.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),           // 26: temporal.cloud.api.v1.RevokeInvitationResponse
+	(*AcceptInvitationRequest)(nil),            // 27: temporal.cloud.api.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),           // 28: temporal.cloud.api.v1.AcceptInvitationResponse
+	(*DeclineInvitationRequest)(nil),           // 29: temporal.cloud.api.v1.DeclineInvitationRequest
+	(*DeclineInvitationResponse)(nil),          // 30: temporal.cloud.api.v1.DeclineInvitationResponse
+	(*ListMembersRequest)(nil),                 // 31: temporal.cloud.api.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),                // 32: temporal.cloud.api.v1.ListMembersResponse
+	(*UpdateMemberRoleRequest)(nil),            // 33: temporal.cloud.api.v1.UpdateMemberRoleRequest
+	(*UpdateMemberRoleResponse)(nil),           // 34: temporal.cloud.api.v1.UpdateMemberRoleResponse
+	(*RemoveMemberRequest)(nil),                // 35: temporal.cloud.api.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),               // 36: temporal.cloud.api.v1.RemoveMemberResponse
+	(*timestamppb.Timestamp)(nil),              // 37: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),              // 38: google.protobuf.FieldMask
+}
+var file_cloud_v1_organizations_proto_depIdxs = []int32{
+	3,  // 0: temporal.cloud.api.v1.Organization.settings:type_name -> temporal.cloud.api.v1.OrganizationSettings
+	37, // 1: temporal.cloud.api.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	37, // 2: temporal.cloud.api.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 3: temporal.cloud.api.v1.Organization.delete_after:type_name -> google.protobuf.Timestamp
+	37, // 4: temporal.cloud.api.v1.Organization.deletion_started_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: temporal.cloud.api.v1.OrganizationSettings.saml_config:type_name -> temporal.cloud.api.v1.SAMLConfig
+	0,  // 6: temporal.cloud.api.v1.OrganizationMember.role:type_name -> temporal.cloud.api.v1.OrganizationRole
+	37, // 7: temporal.cloud.api.v1.OrganizationMember.joined_at:type_name -> google.protobuf.Timestamp
+	2,  // 8: temporal.cloud.api.v1.CreateOrganizationResponse.organization:type_name -> temporal.cloud.api.v1.Organization
+	2,  // 9: temporal.cloud.api.v1.GetOrganizationResponse.organization:type_name -> temporal.cloud.api.v1.Organization
+	2,  // 10: temporal.cloud.api.v1.UpdateOrganizationRequest.organization:type_name -> temporal.cloud.api.v1.Organization
+	38, // 11: temporal.cloud.api.v1.UpdateOrganizationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 12: temporal.cloud.api.v1.UpdateOrganizationResponse.organization:type_name -> temporal.cloud.api.v1.Organization
+	2,  // 13: temporal.cloud.api.v1.DeleteOrganizationResponse.organization:type_name -> temporal.cloud.api.v1.Organization
+	2,  // 14: temporal.cloud.api.v1.CancelOrganizationDeletionResponse.organization:type_name -> temporal.cloud.api.v1.Organization
+	2,  // 15: temporal.cloud.api.v1.ListOrganizationsResponse.organizations:type_name -> temporal.cloud.api.v1.Organization
+	0,  // 16: temporal.cloud.api.v1.InviteUserRequest.role:type_name -> temporal.cloud.api.v1.OrganizationRole
+	20, // 17: temporal.cloud.api.v1.InviteUserResponse.invitation:type_name -> temporal.cloud.api.v1.Invitation
+	0,  // 18: temporal.cloud.api.v1.Invitation.role:type_name -> temporal.cloud.api.v1.OrganizationRole
+	1,  // 19: temporal.cloud.api.v1.Invitation.status:type_name -> temporal.cloud.api.v1.InvitationStatus
+	37, // 20: temporal.cloud.api.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	37, // 21: temporal.cloud.api.v1.Invitation.last_sent_at:type_name -> google.protobuf.Timestamp
+	37, // 22: temporal.cloud.api.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	20, // 23: temporal.cloud.api.v1.ListInvitationsResponse.invitations:type_name -> temporal.cloud.api.v1.Invitation
+	20, // 24: temporal.cloud.api.v1.ResendInvitationResponse.invitation:type_name -> temporal.cloud.api.v1.Invitation
+	2,  // 25: temporal.cloud.api.v1.AcceptInvitationResponse.organization:type_name -> temporal.cloud.api.v1.Organization
+	20, // 26: temporal.cloud.api.v1.AcceptInvitationResponse.invitation:type_name -> temporal.cloud.api.v1.Invitation
+	5,  // 27: temporal.cloud.api.v1.ListMembersResponse.members:type_name -> temporal.cloud.api.v1.OrganizationMember
+	0,  // 28: temporal.cloud.api.v1.UpdateMemberRoleRequest.role:type_name -> temporal.cloud.api.v1.OrganizationRole
+	5,  // 29: temporal.cloud.api.v1.UpdateMemberRoleResponse.member:type_name -> temporal.cloud.api.v1.OrganizationMember
+	6,  // 30: temporal.cloud.api.v1.OrganizationService.CreateOrganization:input_type -> temporal.cloud.api.v1.CreateOrganizationRequest
+	8,  // 31: temporal.cloud.api.v1.OrganizationService.GetOrganization:input_type -> temporal.cloud.api.v1.GetOrganizationRequest
+	10, // 32: temporal.cloud.api.v1.OrganizationService.UpdateOrganization:input_type -> temporal.cloud.api.v1.UpdateOrganizationRequest
+	12, // 33: temporal.cloud.api.v1.OrganizationService.DeleteOrganization:input_type -> temporal.cloud.api.v1.DeleteOrganizationRequest
+	14, // 34: temporal.cloud.api.v1.OrganizationService.CancelOrganizationDeletion:input_type -> temporal.cloud.api.v1.CancelOrganizationDeletionRequest
+	16, // 35: temporal.cloud.api.v1.OrganizationService.ListOrganizations:input_type -> temporal.cloud.api.v1.ListOrganizationsRequest
+	18, // 36: temporal.cloud.api.v1.OrganizationService.InviteUser:input_type -> temporal.cloud.api.v1.InviteUserRequest
+	21, // 37: temporal.cloud.api.v1.OrganizationService.ListInvitations:input_type -> temporal.cloud.api.v1.ListInvitationsRequest
+	23, // 38: temporal.cloud.api.v1.OrganizationService.ResendInvitation:input_type -> temporal.cloud.api.v1.ResendInvitationRequest
+	25, // 39: temporal.cloud.api.v1.OrganizationService.RevokeInvitation:input_type -> temporal.cloud.api.v1.RevokeInvitationRequest
+	27, // 40: temporal.cloud.api.v1.OrganizationService.AcceptInvitation:input_type -> temporal.cloud.api.v1.AcceptInvitationRequest
+	29, // 41: temporal.cloud.api.v1.OrganizationService.DeclineInvitation:input_type -> temporal.cloud.api.v1.DeclineInvitationRequest
+	31, // 42: temporal.cloud.api.v1.OrganizationService.ListMembers:input_type -> temporal.cloud.api.v1.ListMembersRequest
+	33, // 43: temporal.cloud.api.v1.OrganizationService.UpdateMemberRole:input_type -> temporal.cloud.api.v1.UpdateMemberRoleRequest
+	35, // 44: temporal.cloud.api.v1.OrganizationService.RemoveMember:input_type -> temporal.cloud.api.v1.RemoveMemberRequest
+	7,  // 45: temporal.cloud.api.v1.OrganizationService.CreateOrganization:output_type -> temporal.cloud.api.v1.CreateOrganizationResponse
+	9,  // 46: temporal.cloud.api.v1.OrganizationService.GetOrganization:output_type -> temporal.cloud.api.v1.GetOrganizationResponse
+	11, // 47: temporal.cloud.api.v1.OrganizationService.UpdateOrganization:output_type -> temporal.cloud.api.v1.UpdateOrganizationResponse
+	13, // 48: temporal.cloud.api.v1.OrganizationService.DeleteOrganization:output_type -> temporal.cloud.api.v1.DeleteOrganizationResponse
+	15, // 49: temporal.cloud.api.v1.OrganizationService.CancelOrganizationDeletion:output_type -> temporal.cloud.api.v1.CancelOrganizationDeletionResponse
+	17, // 50: temporal.cloud.api.v1.OrganizationService.ListOrganizations:output_type -> temporal.cloud.api.v1.ListOrganizationsResponse
+	19, // 51: temporal.cloud.api.v1.OrganizationService.InviteUser:output_type -> temporal.cloud.api.v1.InviteUserResponse
+	22, // 52: temporal.cloud.api.v1.OrganizationService.ListInvitations:output_type -> temporal.cloud.api.v1.ListInvitationsResponse
+	24, // 53: temporal.cloud.api.v1.OrganizationService.ResendInvitation:output_type -> temporal.cloud.api.v1.ResendInvitationResponse
+	26, // 54: temporal.cloud.api.v1.OrganizationService.RevokeInvitation:output_type -> temporal.cloud.api.v1.RevokeInvitationResponse
+	28, // 55: temporal.cloud.api.v1.OrganizationService.AcceptInvitation:output_type -> temporal.cloud.api.v1.AcceptInvitationResponse
+	30, // 56: temporal.cloud.api.v1.OrganizationService.DeclineInvitation:output_type -> temporal.cloud.api.v1.DeclineInvitationResponse
+	32, // 57: temporal.cloud.api.v1.OrganizationService.ListMembers:output_type -> temporal.cloud.api.v1.ListMembersResponse
+	34, // 58: temporal.cloud.api.v1.OrganizationService.UpdateMemberRole:output_type -> temporal.cloud.api.v1.UpdateMemberRoleResponse
+	36, // 59: temporal.cloud.api.v1.OrganizationService.RemoveMember:output_type -> temporal.cloud.api.v1.RemoveMemberResponse
+	45, // [45:60] is the sub-list for method output_type
+	30, // [30:45] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_cloud_v1_organizations_proto_init() }
+func file_cloud_v1_organizations_proto_init() {
+	if File_cloud_v1_organizations_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cloud_v1_organizations_proto_rawDesc), len(file_cloud_v1_organizations_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   35,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cloud_v1_organizations_proto_goTypes,
+		DependencyIndexes: file_cloud_v1_organizations_proto_depIdxs,
+		EnumInfos:         file_cloud_v1_organizations_proto_enumTypes,
+		MessageInfos:      file_cloud_v1_organizations_proto_msgTypes,
+	}.Build()
+	File_cloud_v1_organizations_proto = out.File
+	file_cloud_v1_organizations_proto_goTypes = nil
+	file_cloud_v1_organizations_proto_depIdxs = nil
+}
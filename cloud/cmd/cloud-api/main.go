@@ -14,11 +14,18 @@ import (
 	"connectrpc.com/grpcreflect"
 	"github.com/joho/godotenv"
 	"github.com/rs/cors"
+	"go.temporal.io/cloud/api/cloud/v1/cloudv1connect"
 	"go.temporal.io/cloud/internal/api/v1"
+	"go.temporal.io/cloud/internal/ca"
 	"go.temporal.io/cloud/internal/config"
 	"go.temporal.io/cloud/internal/interceptors"
+	"go.temporal.io/cloud/internal/mail"
+	"go.temporal.io/cloud/internal/ratelimit"
 	"go.temporal.io/cloud/internal/repository"
 	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/cloud/internal/stripe"
+	"go.temporal.io/cloud/internal/workflows"
+	"go.temporal.io/sdk/client"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"golang.org/x/net/http2"
@@ -50,11 +57,34 @@ func main() {
 	repos := repository.NewRepositories(db)
 
 	// Initialize services
-	orgService := service.NewOrganizationService(repos, logger)
-	nsService := service.NewNamespaceService(repos, logger)
-	billingService := service.NewBillingService(repos, cfg.Stripe, logger)
-	identityService := service.NewIdentityService(repos, cfg.JWT, logger)
-	auditService := service.NewAuditService(repos, logger)
+	var stripeClient stripe.Client
+	if cfg.Stripe.SecretKey != "" {
+		stripeClient = stripe.NewHTTPClient(cfg.Stripe.SecretKey, cfg.Stripe.APIBase)
+	}
+	temporalClient, err := client.NewLazyClient(client.Options{
+		HostPort:  cfg.Temporal.HostPort,
+		Namespace: cfg.Temporal.Namespace,
+	})
+	if err != nil {
+		logger.Fatal("Failed to create Temporal client", tag.Error(err))
+	}
+	defer temporalClient.Close()
+	authority, err := ca.NewAuthority(repos.CAs, repos.Namespaces, cfg.CA, logger)
+	if err != nil {
+		logger.Fatal("Failed to create certificate authority", tag.Error(err))
+	}
+	nsService := service.NewNamespaceService(repos, workflows.NewNamespaceOperationStarter(temporalClient, cfg.Temporal.TaskQueue), workflows.NewNamespaceFailoverStarter(temporalClient, cfg.Temporal.TaskQueue), authority, logger)
+	paymentNotifier := workflows.NewPaymentNotifier(temporalClient, cfg.Temporal.TaskQueue)
+	orgDeleter := workflows.NewOrganizationDeleter(temporalClient, cfg.Temporal.TaskQueue, cfg.OrganizationDeletion.AuditArchive)
+	orgService := service.NewOrganizationService(repos, cfg.OrganizationDeletion, orgDeleter, logger)
+	billingService := service.NewBillingService(repos, stripeClient, cfg.Stripe, paymentNotifier, logger)
+	identityService := service.NewIdentityService(repos, cfg.JWT, cfg.SAML, logger)
+	auditService := service.NewAuditService(repos, cfg.AuditExport, logger)
+	mailer, err := mail.New(cfg.Mail, logger)
+	if err != nil {
+		logger.Fatal("Failed to create mailer", tag.Error(err))
+	}
+	invitationService := service.NewInvitationService(repos, cfg.JWT, cfg.Invitation, mailer, logger)
 	
 	// Initialize OAuth config and auth service
 	googleConfig := service.InitGoogleOAuthConfig(
@@ -66,14 +96,22 @@ func main() {
 
 	// Create interceptors
 	authInterceptor := interceptors.NewAuthInterceptor(identityService, logger)
+	authzInterceptor := interceptors.NewAuthorizationInterceptor(service.NewAuthorizationService(repos, logger), auditService, service.NewEntitlementService(repos), logger)
 	auditInterceptor := interceptors.NewAuditInterceptor(auditService, logger)
-	rateLimitInterceptor := interceptors.NewRateLimitInterceptor(cfg.RateLimit, logger)
+	rateLimitStore, err := ratelimit.NewStore(cfg.RateLimit)
+	if err != nil {
+		logger.Fatal("Failed to create rate limit store", tag.Error(err))
+	}
+	ipRateLimitInterceptor := interceptors.NewIPRateLimitInterceptor(cfg.RateLimit, rateLimitStore, logger)
+	rateLimitInterceptor := interceptors.NewRateLimitInterceptor(cfg.RateLimit, rateLimitStore, billingService, logger)
 	recoveryInterceptor := interceptors.NewRecoveryInterceptor(logger)
 
 	interceptorChain := connect.WithInterceptors(
 		recoveryInterceptor,
-		rateLimitInterceptor,
+		ipRateLimitInterceptor,
 		authInterceptor,
+		rateLimitInterceptor,
+		authzInterceptor,
 		auditInterceptor,
 	)
 
@@ -81,7 +119,7 @@ func main() {
 	mux := http.NewServeMux()
 
 	// Register services
-	orgHandler := api.NewOrganizationHandler(orgService)
+	orgHandler := api.NewOrganizationHandler(orgService, identityService, invitationService)
 	mux.Handle(orgHandler.Path(), orgHandler.Handler(interceptorChain))
 
 	nsHandler := api.NewNamespaceHandler(nsService)
@@ -98,11 +136,11 @@ func main() {
 
 	// Register reflection for grpcurl/grpcui
 	reflector := grpcreflect.NewStaticReflector(
-		"temporal.cloud.api.v1.OrganizationService",
-		"temporal.cloud.api.v1.NamespaceService",
-		"temporal.cloud.api.v1.BillingService",
-		"temporal.cloud.api.v1.IdentityService",
-		"temporal.cloud.api.v1.AuditService",
+		cloudv1connect.OrganizationServiceName,
+		cloudv1connect.NamespaceServiceName,
+		cloudv1connect.BillingServiceName,
+		cloudv1connect.IdentityServiceName,
+		cloudv1connect.AuditServiceName,
 	)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
@@ -114,6 +152,18 @@ func main() {
 	mux.HandleFunc("/auth/refresh", authHandler.HandleRefreshToken)
 	mux.HandleFunc("/auth/logout", authHandler.HandleLogout)
 
+	// SAML SSO endpoints
+	samlHandler := api.NewSAMLHandler(identityService, logger)
+	mux.HandleFunc("/auth/saml/login", samlHandler.HandleLogin)
+	mux.HandleFunc(service.SAMLACSPath, samlHandler.HandleACS)
+	mux.HandleFunc(service.SAMLMetadataPath, samlHandler.HandleMetadata)
+
+	// SCIM provisioning API, authenticated with per-organization tokens
+	mux.Handle(service.SCIMBasePath+"/", api.NewSCIMHandler(service.NewSCIMService(repos, logger), logger))
+
+	// Webhooks
+	mux.Handle("/webhooks/stripe", api.NewStripeWebhookHandler(billingService, logger))
+
 	// Health check endpoints
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -135,7 +185,7 @@ func main() {
 		AllowedOrigins:   cfg.CORS.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Connect-Protocol-Version"},
-		ExposedHeaders:   []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"},
+		ExposedHeaders:   []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	})
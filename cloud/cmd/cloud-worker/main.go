@@ -0,0 +1,85 @@
+// Package main is the entry point for the Cloud control plane worker, which
+// runs the workflows in internal/workflows and their schedules.
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/joho/godotenv"
+	"go.temporal.io/cloud/internal/ca"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/cloud/internal/stripe"
+	"go.temporal.io/cloud/internal/workflows"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+func main() {
+	_ = godotenv.Load()
+
+	logger := log.NewZapLogger(log.BuildZapLogger(log.Config{
+		Level:  "info",
+		Format: "json",
+	}))
+
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Failed to load config", tag.Error(err))
+	}
+
+	db, err := repository.NewPostgresDB(cfg.Database)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", tag.Error(err))
+	}
+	defer db.Close()
+	repos := repository.NewRepositories(db)
+
+	temporalClient, err := client.Dial(client.Options{
+		HostPort:  cfg.Temporal.HostPort,
+		Namespace: cfg.Temporal.Namespace,
+	})
+	if err != nil {
+		logger.Fatal("Failed to create Temporal client", tag.Error(err))
+	}
+	defer temporalClient.Close()
+
+	clusters, err := workflows.NewClusterRegistry(cfg.Temporal.Clusters)
+	if err != nil {
+		logger.Fatal("Failed to connect to clusters", tag.Error(err))
+	}
+	authority, err := ca.NewAuthority(repos.CAs, repos.Namespaces, cfg.CA, logger)
+	if err != nil {
+		logger.Fatal("Failed to create certificate authority", tag.Error(err))
+	}
+	dns, err := workflows.NewDNSProvider(cfg.DNS)
+	if err != nil {
+		logger.Fatal("Failed to create DNS provider", tag.Error(err))
+	}
+	var stripeClient stripe.Client
+	if cfg.Stripe.SecretKey != "" {
+		stripeClient = stripe.NewHTTPClient(cfg.Stripe.SecretKey, cfg.Stripe.APIBase)
+	}
+	billingService := service.NewBillingService(repos, stripeClient, cfg.Stripe, workflows.NewPaymentNotifier(temporalClient, cfg.Temporal.TaskQueue), logger)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	err = workflows.EnsureSchedules(ctx, temporalClient, cfg.Temporal.TaskQueue)
+	cancel()
+	if err != nil {
+		logger.Fatal("Failed to create schedules", tag.Error(err))
+	}
+
+	w := worker.New(temporalClient, cfg.Temporal.TaskQueue, worker.Options{})
+	auditService := service.NewAuditService(repos, cfg.AuditExport, logger)
+	workflows.Register(w, workflows.NewActivities(repos, clusters, authority, dns, billingService, auditService, logger))
+
+	logger.Info("Starting Cloud worker", tag.NewStringTag("task-queue", cfg.Temporal.TaskQueue))
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		logger.Fatal("Worker failed", tag.Error(err))
+	}
+	logger.Info("Worker stopped")
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	"connectrpc.com/connect"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const auditResultPrefix = "AUDIT_RESULT_"
+
+// ListAuditEvents implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, req *connect.Request[cloudv1.ListAuditEventsRequest]) (*connect.Response[cloudv1.ListAuditEventsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	input := &service.ListEventsInput{
+		OrganizationID: orgID,
+		ActorID:        req.Msg.GetActorId(),
+		Action:         req.Msg.GetAction(),
+		ResourceType:   req.Msg.GetResourceType(),
+		ResourceID:     req.Msg.GetResourceId(),
+		Limit:          page.Limit(),
+		Offset:         page.Offset,
+	}
+	if req.Msg.GetStartTime() != nil {
+		input.StartTime = req.Msg.GetStartTime().AsTime()
+	}
+	if req.Msg.GetEndTime() != nil {
+		input.EndTime = req.Msg.GetEndTime().AsTime()
+	}
+	if result := req.Msg.GetResult(); result != cloudv1.AuditResult_AUDIT_RESULT_UNSPECIFIED {
+		input.Result = enumToString(result.String(), auditResultPrefix)
+	}
+
+	events, err := h.service.ListEvents(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	events, nextPageToken := trimPage(page, events)
+
+	resp := &cloudv1.ListAuditEventsResponse{NextPageToken: nextPageToken}
+	for _, event := range events {
+		resp.Events = append(resp.Events, auditEventToProto(event))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetAuditEvent implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) GetAuditEvent(ctx context.Context, req *connect.Request[cloudv1.GetAuditEventRequest]) (*connect.Response[cloudv1.GetAuditEventResponse], error) {
+	eventID, err := parseUUID("event_id", req.Msg.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := h.service.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if event == nil {
+		return nil, notFound("audit event not found")
+	}
+
+	return connect.NewResponse(&cloudv1.GetAuditEventResponse{Event: auditEventToProto(event)}), nil
+}
+
+func auditEventToProto(event *repository.AuditEvent) *cloudv1.AuditEvent {
+	pb := &cloudv1.AuditEvent{
+		Id:             event.ID.String(),
+		OrganizationId: event.OrganizationID.String(),
+		Actor: &cloudv1.AuditActor{
+			Type:  event.ActorType,
+			Id:    event.ActorID,
+			Email: event.ActorEmail.String,
+			Name:  event.ActorName.String,
+		},
+		Action: event.Action,
+		Result: cloudv1.AuditResult(stringToEnum(event.Result, auditResultPrefix, cloudv1.AuditResult_value)),
+		Resource: &cloudv1.AuditResource{
+			Type: event.ResourceType,
+			Id:   event.ResourceID.String,
+			Name: event.ResourceName.String,
+		},
+		RequestMetadata: &cloudv1.AuditRequestMetadata{
+			RequestId: event.RequestID.String,
+			UserAgent: event.UserAgent.String,
+			Method:    event.Method.String,
+			Path:      event.Path.String,
+		},
+		Timestamp: timestampOrNil(event.CreatedAt),
+	}
+	if event.IPAddress != nil {
+		pb.RequestMetadata.IpAddress = event.IPAddress.String()
+	}
+	if len(event.Details) > 0 {
+		var details map[string]any
+		if err := json.Unmarshal(event.Details, &details); err == nil {
+			if s, err := structpb.NewStruct(details); err == nil {
+				pb.Details = s
+			}
+		}
+	}
+	return pb
+}
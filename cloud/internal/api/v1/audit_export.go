@@ -0,0 +1,281 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/auditexport"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+const exportFormatPrefix = "EXPORT_FORMAT_"
+
+// ExportAuditEvents implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) ExportAuditEvents(ctx context.Context, req *connect.Request[cloudv1.ExportAuditEventsRequest]) (*connect.Response[cloudv1.ExportAuditEventsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetPageSize() < 0 {
+		return nil, invalidArgument("page_size must not be negative")
+	}
+
+	input := &service.ExportEventsInput{
+		OrganizationID: orgID,
+		Format:         exportFormat(req.Msg.GetFormat()),
+		Limit:          int(req.Msg.GetPageSize()),
+	}
+	if req.Msg.GetStartTime() != nil {
+		input.StartTime = req.Msg.GetStartTime().AsTime()
+	}
+	if req.Msg.GetEndTime() != nil {
+		input.EndTime = req.Msg.GetEndTime().AsTime()
+	}
+	if token := req.Msg.GetPageToken(); token != "" {
+		if input.After, err = decodeExportToken(token); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := h.service.ExportEvents(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.ExportAuditEventsResponse{
+		NextPageToken: encodeExportToken(out.Next),
+		HasMore:       out.More,
+	}
+	if b := out.Batch; b != nil {
+		resp.Data = b.Data
+		resp.Manifest = &cloudv1.AuditBatchManifest{
+			Sequence:     b.Sequence,
+			PreviousHash: b.PreviousHash,
+			Hash:         b.Hash,
+			EventCount:   int32(b.Events),
+			FirstEventId: b.FirstEventID.String(),
+			LastEventId:  b.LastEventID.String(),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetAuditChainKey implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) GetAuditChainKey(ctx context.Context, req *connect.Request[cloudv1.GetAuditChainKeyRequest]) (*connect.Response[cloudv1.GetAuditChainKeyResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&cloudv1.GetAuditChainKeyResponse{Key: h.service.ChainKey(orgID)}), nil
+}
+
+// exportToken is the decoded page token of an export. Unlike list page
+// tokens, it carries a cursor rather than an offset, so that events recorded
+// while exporting do not shift the pages, and the chain state.
+type exportToken struct {
+	Time     time.Time `json:"t"`
+	ID       uuid.UUID `json:"i"`
+	Sequence int64     `json:"s"`
+	Hash     string    `json:"h"`
+}
+
+func encodeExportToken(pos service.AuditExportPosition) string {
+	// The token is a plain struct and always marshals.
+	raw, _ := json.Marshal(exportToken{Time: pos.Cursor.Time, ID: pos.Cursor.ID, Sequence: pos.Sequence, Hash: pos.Hash})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeExportToken(token string) (*service.AuditExportPosition, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidArgument("invalid page_token")
+	}
+	var t exportToken
+	if err := json.Unmarshal(raw, &t); err != nil || t.Sequence < 0 {
+		return nil, invalidArgument("invalid page_token")
+	}
+	return &service.AuditExportPosition{
+		Cursor:   repository.AuditCursor{Time: t.Time, ID: t.ID},
+		Sequence: t.Sequence,
+		Hash:     t.Hash,
+	}, nil
+}
+
+// exportFormat converts an export format from the API, defaulting to NDJSON.
+// Unknown formats are rejected by the service.
+func exportFormat(format cloudv1.ExportFormat) string {
+	if format == cloudv1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return auditexport.FormatNDJSON
+	}
+	return enumToString(format.String(), exportFormatPrefix)
+}
+
+// CreateAuditStream implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) CreateAuditStream(ctx context.Context, req *connect.Request[cloudv1.CreateAuditStreamRequest]) (*connect.Response[cloudv1.CreateAuditStreamResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	input, err := auditStreamInput(req.Msg.GetStream())
+	if err != nil {
+		return nil, err
+	}
+	input.OrganizationID = orgID
+
+	stream, err := h.service.CreateAuditStream(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateAuditStreamResponse{Stream: auditStreamToProto(stream)}), nil
+}
+
+// GetAuditStream implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) GetAuditStream(ctx context.Context, req *connect.Request[cloudv1.GetAuditStreamRequest]) (*connect.Response[cloudv1.GetAuditStreamResponse], error) {
+	streamID, err := parseUUID("stream_id", req.Msg.GetStreamId())
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := h.service.GetAuditStream(ctx, streamID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetAuditStreamResponse{Stream: auditStreamToProto(stream)}), nil
+}
+
+// ListAuditStreams implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) ListAuditStreams(ctx context.Context, req *connect.Request[cloudv1.ListAuditStreamsRequest]) (*connect.Response[cloudv1.ListAuditStreamsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	streams, err := h.service.ListAuditStreams(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.ListAuditStreamsResponse{}
+	for _, stream := range streams {
+		resp.Streams = append(resp.Streams, auditStreamToProto(stream))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateAuditStream implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) UpdateAuditStream(ctx context.Context, req *connect.Request[cloudv1.UpdateAuditStreamRequest]) (*connect.Response[cloudv1.UpdateAuditStreamResponse], error) {
+	streamID, err := parseUUID("stream_id", req.Msg.GetStreamId())
+	if err != nil {
+		return nil, err
+	}
+	input, err := auditStreamInput(req.Msg.GetStream())
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := h.service.UpdateAuditStream(ctx, streamID, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateAuditStreamResponse{Stream: auditStreamToProto(stream)}), nil
+}
+
+// DeleteAuditStream implements cloudv1connect.AuditServiceHandler.
+func (h *AuditHandler) DeleteAuditStream(ctx context.Context, req *connect.Request[cloudv1.DeleteAuditStreamRequest]) (*connect.Response[cloudv1.DeleteAuditStreamResponse], error) {
+	streamID, err := parseUUID("stream_id", req.Msg.GetStreamId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteAuditStream(ctx, streamID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeleteAuditStreamResponse{}), nil
+}
+
+// auditStreamInput converts a stream and its destination from the API into
+// service input.
+func auditStreamInput(stream *cloudv1.AuditStream) (*service.AuditStreamInput, error) {
+	if stream == nil {
+		return nil, invalidArgument("stream is required")
+	}
+
+	input := &service.AuditStreamInput{
+		Name:    stream.GetName(),
+		Format:  exportFormat(stream.GetFormat()),
+		Enabled: stream.GetEnabled(),
+	}
+	var config any
+	switch {
+	case stream.GetS3() != nil && stream.GetWebhook() != nil:
+		return nil, invalidArgument("stream must have exactly one destination")
+	case stream.GetS3() != nil:
+		s3 := stream.GetS3()
+		input.SinkType = auditexport.SinkTypeS3
+		config = auditexport.S3Config{
+			Bucket:  s3.GetBucket(),
+			Prefix:  s3.GetPrefix(),
+			Region:  s3.GetRegion(),
+			RoleARN: s3.GetRoleArn(),
+		}
+	case stream.GetWebhook() != nil:
+		webhook := stream.GetWebhook()
+		input.SinkType = auditexport.SinkTypeWebhook
+		config = auditexport.WebhookConfig{
+			URL:     webhook.GetUrl(),
+			Secret:  webhook.GetSecret(),
+			Headers: webhook.GetHeaders(),
+		}
+	default:
+		return nil, invalidArgument("stream destination is required")
+	}
+	// The config types are plain structs and always marshal.
+	input.Config, _ = json.Marshal(config)
+	return input, nil
+}
+
+func auditStreamToProto(stream *repository.AuditStream) *cloudv1.AuditStream {
+	pb := &cloudv1.AuditStream{
+		Id:               stream.ID.String(),
+		OrganizationId:   stream.OrganizationID.String(),
+		Name:             stream.Name,
+		Format:           cloudv1.ExportFormat(stringToEnum(stream.Format, exportFormatPrefix, cloudv1.ExportFormat_value)),
+		Enabled:          stream.Enabled,
+		LastSequence:     stream.Sequence,
+		LastHash:         stream.LastHash,
+		CursorTime:       timestampOrNil(stream.Cursor.Time),
+		LastDeliveryTime: nullTimestamp(stream.LastDeliveredAt),
+		LastError:        stream.LastError.String,
+		CreatedAt:        timestampOrNil(stream.CreatedAt),
+		UpdatedAt:        timestampOrNil(stream.UpdatedAt),
+	}
+	// The config was validated when the stream was saved. Webhook secrets are
+	// never returned.
+	switch stream.SinkType {
+	case auditexport.SinkTypeS3:
+		var cfg auditexport.S3Config
+		_ = json.Unmarshal(stream.Config, &cfg)
+		pb.S3 = &cloudv1.S3AuditDestination{
+			Bucket:     cfg.Bucket,
+			Prefix:     cfg.Prefix,
+			Region:     cfg.Region,
+			RoleArn:    cfg.RoleARN,
+			ExternalId: stream.OrganizationID.String(),
+		}
+	case auditexport.SinkTypeWebhook:
+		var cfg auditexport.WebhookConfig
+		_ = json.Unmarshal(stream.Config, &cfg)
+		pb.Webhook = &cloudv1.WebhookAuditDestination{Url: cfg.URL, Headers: cfg.Headers}
+	}
+	return pb
+}
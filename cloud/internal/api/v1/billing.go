@@ -0,0 +1,396 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"connectrpc.com/connect"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+const (
+	planTierPrefix           = "PLAN_TIER_"
+	subscriptionStatusPrefix = "SUBSCRIPTION_STATUS_"
+	invoiceStatusPrefix      = "INVOICE_STATUS_"
+)
+
+// GetSubscription implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) GetSubscription(ctx context.Context, req *connect.Request[cloudv1.GetSubscriptionRequest]) (*connect.Response[cloudv1.GetSubscriptionResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := h.service.GetSubscription(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if sub == nil {
+		return nil, notFound("subscription not found")
+	}
+	plan, err := h.service.GetSubscriptionPlan(ctx, sub)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetSubscriptionResponse{
+		Subscription: subscriptionToProto(sub, plan),
+	}), nil
+}
+
+// UpdateSubscription implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) UpdateSubscription(ctx context.Context, req *connect.Request[cloudv1.UpdateSubscriptionRequest]) (*connect.Response[cloudv1.UpdateSubscriptionResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetPlan() == cloudv1.PlanTier_PLAN_TIER_UNSPECIFIED {
+		return nil, invalidArgument("plan is required")
+	}
+
+	sub, err := h.service.UpdateSubscription(ctx, &service.UpdateSubscriptionInput{
+		OrganizationID: orgID,
+		Plan:           enumToString(req.Msg.GetPlan().String(), planTierPrefix),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	plan, err := h.service.GetSubscriptionPlan(ctx, sub)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateSubscriptionResponse{
+		Subscription: subscriptionToProto(sub, plan),
+	}), nil
+}
+
+// ListPlans implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) ListPlans(ctx context.Context, req *connect.Request[cloudv1.ListPlansRequest]) (*connect.Response[cloudv1.ListPlansResponse], error) {
+	plans, err := h.service.ListPlans(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.ListPlansResponse{}
+	for _, plan := range plans {
+		resp.Plans = append(resp.Plans, planToProto(plan))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ValidatePlanChange implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) ValidatePlanChange(ctx context.Context, req *connect.Request[cloudv1.ValidatePlanChangeRequest]) (*connect.Response[cloudv1.ValidatePlanChangeResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetPlan() == cloudv1.PlanTier_PLAN_TIER_UNSPECIFIED {
+		return nil, invalidArgument("plan is required")
+	}
+
+	plan, violations, err := h.service.ValidatePlanChange(ctx, orgID, enumToString(req.Msg.GetPlan().String(), planTierPrefix))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.ValidatePlanChangeResponse{Plan: planToProto(plan)}
+	for _, v := range violations {
+		resp.Violations = append(resp.Violations, &cloudv1.PlanViolation{
+			ResourceType: v.ResourceType,
+			ResourceId:   v.ResourceID,
+			Message:      v.Message,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetUsage implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) GetUsage(ctx context.Context, req *connect.Request[cloudv1.GetUsageRequest]) (*connect.Response[cloudv1.GetUsageResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := usagePeriod(req.Msg.GetPeriodStart().AsTime(), req.Msg.GetPeriodEnd().AsTime(), req.Msg.GetPeriodStart() != nil, req.Msg.GetPeriodEnd() != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := h.service.GetUsage(ctx, orgID, start, end)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	usage := &cloudv1.UsageSummary{
+		OrganizationId:     summary.OrganizationID.String(),
+		PeriodStart:        timestampOrNil(summary.PeriodStart),
+		PeriodEnd:          timestampOrNil(summary.PeriodEnd),
+		TotalActions:       summary.TotalActions,
+		ActiveStorageGbh:   summary.ActiveStorageGBH.InexactFloat64(),
+		RetainedStorageGbh: summary.RetainedStorageGBH.InexactFloat64(),
+		ActionBreakdown: &cloudv1.ActionBreakdown{
+			WorkflowStarted:       summary.ActionBreakdown.WorkflowStarted,
+			WorkflowReset:         summary.ActionBreakdown.WorkflowReset,
+			TimerStarted:          summary.ActionBreakdown.TimerStarted,
+			SignalSent:            summary.ActionBreakdown.SignalSent,
+			QueryReceived:         summary.ActionBreakdown.QueryReceived,
+			UpdateReceived:        summary.ActionBreakdown.UpdateReceived,
+			ActivityStarted:       summary.ActionBreakdown.ActivityStarted,
+			ActivityHeartbeat:     summary.ActionBreakdown.ActivityHeartbeat,
+			LocalActivityBatch:    summary.ActionBreakdown.LocalActivityBatch,
+			ChildWorkflowStarted:  summary.ActionBreakdown.ChildWorkflowStarted,
+			ScheduleExecution:     summary.ActionBreakdown.ScheduleExecution,
+			NexusOperation:        summary.ActionBreakdown.NexusOperation,
+			SearchAttributeUpsert: summary.ActionBreakdown.SearchAttributeUpsert,
+			SideEffectRecorded:    summary.ActionBreakdown.SideEffectRecorded,
+			WorkflowExported:      summary.ActionBreakdown.WorkflowExported,
+		},
+	}
+	for _, ns := range summary.NamespaceUsage {
+		usage.NamespaceUsage = append(usage.NamespaceUsage, namespaceUsageToProto(&ns))
+	}
+
+	return connect.NewResponse(&cloudv1.GetUsageResponse{Usage: usage}), nil
+}
+
+// GetUsageByNamespace implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) GetUsageByNamespace(ctx context.Context, req *connect.Request[cloudv1.GetUsageByNamespaceRequest]) (*connect.Response[cloudv1.GetUsageByNamespaceResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	start, end, err := usagePeriod(req.Msg.GetPeriodStart().AsTime(), req.Msg.GetPeriodEnd().AsTime(), req.Msg.GetPeriodStart() != nil, req.Msg.GetPeriodEnd() != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := h.service.GetUsageByNamespace(ctx, req.Msg.GetNamespaceId(), start, end)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetUsageByNamespaceResponse{
+		Usage: namespaceUsageToProto(summary),
+	}), nil
+}
+
+// ListInvoices implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) ListInvoices(ctx context.Context, req *connect.Request[cloudv1.ListInvoicesRequest]) (*connect.Response[cloudv1.ListInvoicesResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := h.service.ListInvoices(ctx, orgID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	invoices, nextPageToken := trimPage(page, invoices)
+
+	resp := &cloudv1.ListInvoicesResponse{NextPageToken: nextPageToken}
+	for _, inv := range invoices {
+		resp.Invoices = append(resp.Invoices, invoiceToProto(inv))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetInvoice implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) GetInvoice(ctx context.Context, req *connect.Request[cloudv1.GetInvoiceRequest]) (*connect.Response[cloudv1.GetInvoiceResponse], error) {
+	invoiceID, err := parseUUID("invoice_id", req.Msg.GetInvoiceId())
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := h.service.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if inv == nil {
+		return nil, notFound("invoice not found")
+	}
+
+	return connect.NewResponse(&cloudv1.GetInvoiceResponse{Invoice: invoiceToProto(inv)}), nil
+}
+
+// GetCreditBalance implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) GetCreditBalance(ctx context.Context, req *connect.Request[cloudv1.GetCreditBalanceRequest]) (*connect.Response[cloudv1.GetCreditBalanceResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := h.service.GetCreditBalance(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetCreditBalanceResponse{
+		Balance: creditBalanceToProto(balance),
+	}), nil
+}
+
+// PurchaseCredits implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) PurchaseCredits(ctx context.Context, req *connect.Request[cloudv1.PurchaseCreditsRequest]) (*connect.Response[cloudv1.PurchaseCreditsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetAmountCents() <= 0 {
+		return nil, invalidArgument("amount_cents must be positive")
+	}
+
+	balance, paymentIntentID, err := h.service.PurchaseCredits(ctx, orgID, req.Msg.GetAmountCents())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.PurchaseCreditsResponse{
+		Balance:         creditBalanceToProto(balance),
+		PaymentIntentId: paymentIntentID,
+	}), nil
+}
+
+// UpdatePaymentMethod implements cloudv1connect.BillingServiceHandler.
+func (h *BillingHandler) UpdatePaymentMethod(ctx context.Context, req *connect.Request[cloudv1.UpdatePaymentMethodRequest]) (*connect.Response[cloudv1.UpdatePaymentMethodResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetPaymentMethodId() == "" {
+		return nil, invalidArgument("payment_method_id is required")
+	}
+
+	if err := h.service.UpdatePaymentMethod(ctx, orgID, req.Msg.GetPaymentMethodId()); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&cloudv1.UpdatePaymentMethodResponse{}), nil
+}
+
+func subscriptionToProto(sub *repository.Subscription, plan *repository.Plan) *cloudv1.Subscription {
+	// The included usage is the subscription's own, which may have been
+	// negotiated away from the plan's.
+	limits := planLimitsToProto(plan)
+	limits.ActionsIncluded = sub.ActionsIncluded
+	limits.ActiveStorageGb = sub.ActiveStorageGB.InexactFloat64()
+	limits.RetainedStorageGb = sub.RetainedStorageGB.InexactFloat64()
+
+	return &cloudv1.Subscription{
+		Id:                   sub.ID.String(),
+		OrganizationId:       sub.OrganizationID.String(),
+		Plan:                 cloudv1.PlanTier(stringToEnum(sub.Plan, planTierPrefix, cloudv1.PlanTier_value)),
+		PlanVersion:          int32(sub.PlanVersion),
+		Status:               cloudv1.SubscriptionStatus(stringToEnum(sub.Status, subscriptionStatusPrefix, cloudv1.SubscriptionStatus_value)),
+		Limits:               limits,
+		CurrentPeriodStart:   nullTimestamp(sub.CurrentPeriodStart),
+		CurrentPeriodEnd:     nullTimestamp(sub.CurrentPeriodEnd),
+		StripeCustomerId:     sub.StripeCustomerID.String,
+		StripeSubscriptionId: sub.StripeSubscriptionID.String,
+		CreatedAt:            timestampOrNil(sub.CreatedAt),
+		UpdatedAt:            timestampOrNil(sub.UpdatedAt),
+	}
+}
+
+func planToProto(plan *repository.Plan) *cloudv1.Plan {
+	return &cloudv1.Plan{
+		Tier:         cloudv1.PlanTier(stringToEnum(plan.Tier, planTierPrefix, cloudv1.PlanTier_value)),
+		Version:      int32(plan.Version),
+		Limits:       planLimitsToProto(plan),
+		BaseFeeCents: plan.BaseFeeCents,
+	}
+}
+
+func planLimitsToProto(plan *repository.Plan) *cloudv1.PlanLimits {
+	return &cloudv1.PlanLimits{
+		ActionsIncluded:      plan.ActionsIncluded,
+		ActiveStorageGb:      plan.ActiveStorageGB.InexactFloat64(),
+		RetainedStorageGb:    plan.RetainedStorageGB.InexactFloat64(),
+		MaxNamespaces:        int32(plan.MaxNamespaces),
+		MaxRetentionDays:     int32(plan.MaxRetentionDays),
+		MultiRegionAvailable: plan.MaxHANamespaces > 0,
+		MaxHaNamespaces:      int32(plan.MaxHANamespaces),
+		HardUsageLimits:      plan.HardUsageLimits,
+		Features:             plan.Features,
+	}
+}
+
+func namespaceUsageToProto(usage *service.NamespaceUsageSummary) *cloudv1.NamespaceUsage {
+	return &cloudv1.NamespaceUsage{
+		NamespaceId:        usage.NamespaceID,
+		NamespaceName:      usage.NamespaceName,
+		TotalActions:       usage.TotalActions,
+		ActiveStorageGbh:   usage.ActiveStorageGBH.InexactFloat64(),
+		RetainedStorageGbh: usage.RetainedStorageGBH.InexactFloat64(),
+	}
+}
+
+func invoiceToProto(inv *repository.Invoice) *cloudv1.Invoice {
+	pb := &cloudv1.Invoice{
+		Id:                  inv.ID.String(),
+		OrganizationId:      inv.OrganizationID.String(),
+		InvoiceNumber:       inv.InvoiceNumber,
+		PeriodStart:         timestampOrNil(inv.PeriodStart),
+		PeriodEnd:           timestampOrNil(inv.PeriodEnd),
+		SubtotalCents:       inv.SubtotalCents,
+		TaxCents:            inv.TaxCents,
+		CreditsAppliedCents: inv.CreditsAppliedCents,
+		TotalCents:          inv.TotalCents,
+		Status:              cloudv1.InvoiceStatus(stringToEnum(inv.Status, invoiceStatusPrefix, cloudv1.InvoiceStatus_value)),
+		StripeInvoiceId:     inv.StripeInvoiceID.String,
+		PdfUrl:              inv.PDFURL.String,
+		CreatedAt:           timestampOrNil(inv.CreatedAt),
+		PaidAt:              nullTimestamp(inv.PaidAt),
+	}
+
+	var items []service.InvoiceLineItem
+	if len(inv.LineItems) > 0 {
+		_ = json.Unmarshal(inv.LineItems, &items)
+	}
+	for _, item := range items {
+		pb.LineItems = append(pb.LineItems, &cloudv1.InvoiceLineItem{
+			Description:    item.Description,
+			Quantity:       item.Quantity,
+			Unit:           item.Unit,
+			UnitPriceCents: item.UnitPriceCents,
+			AmountCents:    item.AmountCents,
+		})
+	}
+	return pb
+}
+
+func creditBalanceToProto(balance *service.CreditBalance) *cloudv1.CreditBalance {
+	pb := &cloudv1.CreditBalance{
+		OrganizationId: balance.OrganizationID.String(),
+		BalanceCents:   balance.BalanceCents,
+	}
+	for _, txn := range balance.Transactions {
+		pb.Transactions = append(pb.Transactions, &cloudv1.CreditTransaction{
+			Id:          txn.ID.String(),
+			AmountCents: txn.AmountCents,
+			Description: txn.Description.String,
+			CreatedAt:   timestampOrNil(txn.CreatedAt),
+		})
+	}
+	return pb
+}
+
+// usagePeriod resolves the requested usage period, defaulting to the current
+// calendar month (UTC) when no bounds are given.
+func usagePeriod(start, end time.Time, hasStart, hasEnd bool) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	if !hasStart {
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	if !hasEnd {
+		end = now
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, invalidArgument("period_end must be after period_start")
+	}
+	return start, end, nil
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+// CreateConnectivityRule implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) CreateConnectivityRule(ctx context.Context, req *connect.Request[cloudv1.CreateConnectivityRuleRequest]) (*connect.Response[cloudv1.CreateConnectivityRuleResponse], error) {
+	input, err := connectivityRuleInput(req.Msg.GetOrganizationId(), req.Msg.GetRule())
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := h.service.CreateConnectivityRule(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateConnectivityRuleResponse{Rule: connectivityRuleToProto(rule)}), nil
+}
+
+// GetConnectivityRule implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) GetConnectivityRule(ctx context.Context, req *connect.Request[cloudv1.GetConnectivityRuleRequest]) (*connect.Response[cloudv1.GetConnectivityRuleResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	ruleID, err := parseUUID("rule_id", req.Msg.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := h.service.GetConnectivityRule(ctx, orgID, ruleID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetConnectivityRuleResponse{Rule: connectivityRuleToProto(rule)}), nil
+}
+
+// ListConnectivityRules implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) ListConnectivityRules(ctx context.Context, req *connect.Request[cloudv1.ListConnectivityRulesRequest]) (*connect.Response[cloudv1.ListConnectivityRulesResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := h.service.ListConnectivityRules(ctx, orgID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	rules, nextPageToken := trimPage(page, rules)
+
+	resp := &cloudv1.ListConnectivityRulesResponse{NextPageToken: nextPageToken}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, connectivityRuleToProto(rule))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateConnectivityRule implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) UpdateConnectivityRule(ctx context.Context, req *connect.Request[cloudv1.UpdateConnectivityRuleRequest]) (*connect.Response[cloudv1.UpdateConnectivityRuleResponse], error) {
+	ruleID, err := parseUUID("rule_id", req.Msg.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+	input, err := connectivityRuleInput(req.Msg.GetOrganizationId(), req.Msg.GetRule())
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := h.service.UpdateConnectivityRule(ctx, ruleID, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateConnectivityRuleResponse{Rule: connectivityRuleToProto(rule)}), nil
+}
+
+// DeleteConnectivityRule implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) DeleteConnectivityRule(ctx context.Context, req *connect.Request[cloudv1.DeleteConnectivityRuleRequest]) (*connect.Response[cloudv1.DeleteConnectivityRuleResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	ruleID, err := parseUUID("rule_id", req.Msg.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteConnectivityRule(ctx, orgID, ruleID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeleteConnectivityRuleResponse{}), nil
+}
+
+// AddNamespaceConnectivityRule implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) AddNamespaceConnectivityRule(ctx context.Context, req *connect.Request[cloudv1.AddNamespaceConnectivityRuleRequest]) (*connect.Response[cloudv1.AddNamespaceConnectivityRuleResponse], error) {
+	namespaceID, ruleID, err := namespaceConnectivityRule(req.Msg.GetNamespaceId(), req.Msg.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.AddNamespaceConnectivityRule(ctx, namespaceID, ruleID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.AddNamespaceConnectivityRuleResponse{}), nil
+}
+
+// RemoveNamespaceConnectivityRule implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) RemoveNamespaceConnectivityRule(ctx context.Context, req *connect.Request[cloudv1.RemoveNamespaceConnectivityRuleRequest]) (*connect.Response[cloudv1.RemoveNamespaceConnectivityRuleResponse], error) {
+	namespaceID, ruleID, err := namespaceConnectivityRule(req.Msg.GetNamespaceId(), req.Msg.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RemoveNamespaceConnectivityRule(ctx, namespaceID, ruleID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RemoveNamespaceConnectivityRuleResponse{}), nil
+}
+
+// ListNamespaceConnectivityRules implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) ListNamespaceConnectivityRules(ctx context.Context, req *connect.Request[cloudv1.ListNamespaceConnectivityRulesRequest]) (*connect.Response[cloudv1.ListNamespaceConnectivityRulesResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+
+	rules, err := h.service.ListNamespaceConnectivityRules(ctx, req.Msg.GetNamespaceId())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.ListNamespaceConnectivityRulesResponse{}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, connectivityRuleToProto(rule))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func namespaceConnectivityRule(namespaceID, ruleID string) (string, uuid.UUID, error) {
+	if namespaceID == "" {
+		return "", uuid.Nil, invalidArgument("namespace_id is required")
+	}
+	id, err := parseUUID("rule_id", ruleID)
+	if err != nil {
+		return "", uuid.Nil, err
+	}
+	return namespaceID, id, nil
+}
+
+// connectivityRuleInput converts a rule and its configuration from the API
+// into service input.
+func connectivityRuleInput(orgID string, rule *cloudv1.ConnectivityRule) (*service.ConnectivityRuleInput, error) {
+	id, err := parseUUID("organization_id", orgID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, invalidArgument("rule is required")
+	}
+
+	input := &service.ConnectivityRuleInput{
+		OrganizationID: id,
+		Name:           rule.GetName(),
+		Enabled:        rule.GetEnabled(),
+	}
+	var config any
+	var set int
+	if allowlist := rule.GetIpAllowlist(); allowlist != nil {
+		set++
+		input.Type = repository.ConnectivityRuleTypeIPAllowlist
+		config = service.IPAllowlistConfig{CIDRs: allowlist.GetCidrs()}
+	}
+	if privateLink := rule.GetPrivateLink(); privateLink != nil {
+		set++
+		input.Type = repository.ConnectivityRuleTypePrivateLink
+		config = service.PrivateLinkConfig{ConnectionID: privateLink.GetConnectionId(), Region: privateLink.GetRegion()}
+	}
+	if peering := rule.GetVpcPeering(); peering != nil {
+		set++
+		input.Type = repository.ConnectivityRuleTypeVPCPeering
+		config = service.VPCPeeringConfig{
+			VPCID:     peering.GetVpcId(),
+			AccountID: peering.GetAccountId(),
+			Region:    peering.GetRegion(),
+			CIDR:      peering.GetCidr(),
+		}
+	}
+	switch set {
+	case 0:
+		return nil, invalidArgument("rule configuration is required")
+	case 1:
+	default:
+		return nil, invalidArgument("rule must have exactly one configuration")
+	}
+	// The config types are plain structs and always marshal.
+	input.Config, _ = json.Marshal(config)
+	return input, nil
+}
+
+func connectivityRuleToProto(rule *repository.ConnectivityRule) *cloudv1.ConnectivityRule {
+	pb := &cloudv1.ConnectivityRule{
+		Id:        rule.ID.String(),
+		Name:      rule.Name,
+		Enabled:   rule.Enabled,
+		CreatedAt: timestampOrNil(rule.CreatedAt),
+		UpdatedAt: timestampOrNil(rule.UpdatedAt),
+	}
+	// The config was validated when the rule was saved.
+	switch rule.Type {
+	case repository.ConnectivityRuleTypeIPAllowlist:
+		var cfg service.IPAllowlistConfig
+		_ = json.Unmarshal(rule.Config, &cfg)
+		pb.IpAllowlist = &cloudv1.IPAllowlistRule{Cidrs: cfg.CIDRs}
+	case repository.ConnectivityRuleTypePrivateLink:
+		var cfg service.PrivateLinkConfig
+		_ = json.Unmarshal(rule.Config, &cfg)
+		pb.PrivateLink = &cloudv1.PrivateLinkRule{ConnectionId: cfg.ConnectionID, Region: cfg.Region}
+	case repository.ConnectivityRuleTypeVPCPeering:
+		var cfg service.VPCPeeringConfig
+		_ = json.Unmarshal(rule.Config, &cfg)
+		pb.VpcPeering = &cloudv1.VPCPeeringRule{
+			VpcId:     cfg.VPCID,
+			AccountId: cfg.AccountID,
+			Region:    cfg.Region,
+			Cidr:      cfg.CIDR,
+		}
+	}
+	return pb
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// enumToString converts a proto enum value name such as ORGANIZATION_ROLE_READ_ONLY
+// into the lower-case form stored in the database ("read_only").
+func enumToString(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// stringToEnum converts a database value back into a proto enum number. Unknown
+// values map to the zero (UNSPECIFIED) value.
+func stringToEnum(value, prefix string, values map[string]int32) int32 {
+	return values[prefix+strings.ToUpper(value)]
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func nullTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+func parseUUID(field, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, invalidArgument(field + " is required")
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, invalidArgument("invalid " + field)
+	}
+	return id, nil
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestEnumConversion(t *testing.T) {
+	name := cloudv1.OrganizationRole_ORGANIZATION_ROLE_READ_ONLY.String()
+	require.Equal(t, "read_only", enumToString(name, "ORGANIZATION_ROLE_"))
+	require.Equal(t, int32(cloudv1.OrganizationRole_ORGANIZATION_ROLE_READ_ONLY),
+		stringToEnum("read_only", "ORGANIZATION_ROLE_", cloudv1.OrganizationRole_value))
+	require.Zero(t, stringToEnum("emperor", "ORGANIZATION_ROLE_", cloudv1.OrganizationRole_value))
+}
+
+func TestParseUUID(t *testing.T) {
+	id := uuid.New()
+	parsed, err := parseUUID("organization_id", id.String())
+	require.NoError(t, err)
+	require.Equal(t, id, parsed)
+
+	_, err = parseUUID("organization_id", "")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.ErrorContains(t, err, "organization_id is required")
+
+	_, err = parseUUID("organization_id", "not-a-uuid")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.ErrorContains(t, err, "invalid organization_id")
+}
+
+func TestRetentionDays(t *testing.T) {
+	days, err := retentionDays(durationpb.New(30 * 24 * time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 30, days)
+
+	for _, d := range []*durationpb.Duration{
+		durationpb.New(0),
+		durationpb.New(-24 * time.Hour),
+		durationpb.New(36 * time.Hour),
+		{Seconds: 1, Nanos: -1},
+	} {
+		_, err := retentionDays(d)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), d)
+	}
+}
+
+func TestUsagePeriod(t *testing.T) {
+	start, end, err := usagePeriod(time.Time{}, time.Time{}, false, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, start.Day())
+	require.True(t, end.After(start))
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	start, end, err = usagePeriod(from, to, true, true)
+	require.NoError(t, err)
+	require.Equal(t, from, start)
+	require.Equal(t, to, end)
+
+	_, _, err = usagePeriod(to, from, true, true)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestPermissionStrings(t *testing.T) {
+	perms := []*cloudv1.Permission{
+		{Type: cloudv1.PermissionType_PERMISSION_TYPE_ORG_READ},
+		{Type: cloudv1.PermissionType_PERMISSION_TYPE_NAMESPACE_WRITE, ResourceId: "orders.1234abcd"},
+	}
+	encoded := permissionsToStrings(perms)
+	require.Equal(t, []string{"org_read", "namespace_write:orders.1234abcd"}, encoded)
+
+	decoded := permissionsFromStrings(encoded)
+	require.Len(t, decoded, len(perms))
+	for i := range perms {
+		require.True(t, proto.Equal(perms[i], decoded[i]))
+	}
+}
+
+func TestExportToken(t *testing.T) {
+	pos := service.AuditExportPosition{
+		Cursor:   repository.AuditCursor{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), ID: uuid.New()},
+		Sequence: 42,
+		Hash:     "abc123",
+	}
+	decoded, err := decodeExportToken(encodeExportToken(pos))
+	require.NoError(t, err)
+	require.True(t, pos.Cursor.Time.Equal(decoded.Cursor.Time))
+	require.Equal(t, pos.Cursor.ID, decoded.Cursor.ID)
+	require.Equal(t, pos.Sequence, decoded.Sequence)
+	require.Equal(t, pos.Hash, decoded.Hash)
+
+	_, err = decodeExportToken("%%%")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = decodeExportToken("eyJzIjotMX0") // {"s":-1}
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestConnectivityRuleInput(t *testing.T) {
+	orgID := uuid.New()
+	input, err := connectivityRuleInput(orgID.String(), &cloudv1.ConnectivityRule{
+		Name:        "office",
+		Enabled:     true,
+		IpAllowlist: &cloudv1.IPAllowlistRule{Cidrs: []string{"192.0.2.0/24"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, orgID, input.OrganizationID)
+	require.Equal(t, repository.ConnectivityRuleTypeIPAllowlist, input.Type)
+	var config service.IPAllowlistConfig
+	require.NoError(t, json.Unmarshal(input.Config, &config))
+	require.Equal(t, []string{"192.0.2.0/24"}, config.CIDRs)
+
+	_, err = connectivityRuleInput(orgID.String(), nil)
+	require.ErrorContains(t, err, "rule is required")
+	_, err = connectivityRuleInput(orgID.String(), &cloudv1.ConnectivityRule{Name: "empty"})
+	require.ErrorContains(t, err, "rule configuration is required")
+	_, err = connectivityRuleInput(orgID.String(), &cloudv1.ConnectivityRule{
+		IpAllowlist: &cloudv1.IPAllowlistRule{Cidrs: []string{"192.0.2.0/24"}},
+		PrivateLink: &cloudv1.PrivateLinkRule{ConnectionId: "vpce-1", Region: "us-east-1"},
+	})
+	require.ErrorContains(t, err, "exactly one configuration")
+}
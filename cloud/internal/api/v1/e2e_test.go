@@ -0,0 +1,2107 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/quotedprintable"
+	"net/http"
+	"net/http/httptest"
+	netmail "net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/api/cloud/v1/cloudv1connect"
+	api "go.temporal.io/cloud/internal/api/v1"
+	"go.temporal.io/cloud/internal/auditexport"
+	"go.temporal.io/cloud/internal/ca"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/interceptors"
+	"go.temporal.io/cloud/internal/mail"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/repository/repositorytest"
+	"go.temporal.io/cloud/internal/saml"
+	"go.temporal.io/cloud/internal/saml/samltest"
+	"go.temporal.io/cloud/internal/scim"
+	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/cloud/internal/stripe"
+	"go.temporal.io/cloud/internal/stripe/stripetest"
+	"go.temporal.io/server/common/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// The end-to-end tests run the Connect handlers behind the real auth
+// interceptor against a throwaway Postgres database. They are skipped unless
+// CLOUD_API_E2E is set; the database connection is taken from the usual DB_*
+// environment variables.
+const e2eEnvVar = repositorytest.EnvVar
+
+type e2eEnv struct {
+	db         *repository.PostgresDB
+	repos      *repository.Repositories
+	stripe     *stripetest.Server
+	payments   *recordingPaymentNotifier
+	deleter    *recordingOrganizationDeleter
+	operations *recordingNamespaceOperations
+	failover   *recordingFailoverStarter
+	billing    *service.BillingService
+	identity   *service.IdentityService
+	auth       *service.AuthService
+	audit      *service.AuditService
+	user       *repository.User
+	url        string
+	// mailDir holds the emails sent, one .eml file each.
+	mailDir string
+
+	orgs        cloudv1connect.OrganizationServiceClient
+	namespaces  cloudv1connect.NamespaceServiceClient
+	billingAPI  cloudv1connect.BillingServiceClient
+	identityAPI cloudv1connect.IdentityServiceClient
+	auditAPI    cloudv1connect.AuditServiceClient
+}
+
+func newE2EEnv(t *testing.T) *e2eEnv {
+	t.Helper()
+	if os.Getenv(e2eEnvVar) == "" {
+		t.Skipf("%s not set", e2eEnvVar)
+	}
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	db := repositorytest.NewDB(t, cfg.Database)
+
+	cfg.Stripe.SecretKey = "sk_test_e2e"
+	cfg.Stripe.WebhookSecret = "whsec_e2e"
+	fakeStripe := stripetest.NewServer(cfg.Stripe.SecretKey, cfg.Stripe.WebhookSecret)
+	t.Cleanup(fakeStripe.Close)
+
+	// SAML responses name this URL; tests post them to the server themselves.
+	cfg.SAML.BaseURL = "https://cloud.e2e.test"
+	cfg.Invitation.AcceptURL = "https://cloud.e2e.test/invitations/accept"
+	cfg.OrganizationDeletion.GracePeriod = time.Hour
+	mailDir := t.TempDir()
+	mailer, err := mail.NewFileMailer(mailDir, "Temporal Cloud <noreply@cloud.e2e.test>")
+	require.NoError(t, err)
+
+	logger := log.NewNoopLogger()
+	repos := repository.NewRepositories(db)
+	payments := &recordingPaymentNotifier{}
+	deleter := &recordingOrganizationDeleter{}
+	operations := &recordingNamespaceOperations{}
+	failover := &recordingFailoverStarter{}
+	authority, err := ca.NewAuthority(repos.CAs, repos.Namespaces, cfg.CA, logger)
+	require.NoError(t, err)
+	stripeClient := stripe.NewHTTPClient(cfg.Stripe.SecretKey, fakeStripe.URL)
+	env := &e2eEnv{
+		db:         db,
+		repos:      repos,
+		stripe:     fakeStripe,
+		payments:   payments,
+		deleter:    deleter,
+		operations: operations,
+		failover:   failover,
+		billing:    service.NewBillingService(repos, stripeClient, cfg.Stripe, payments, logger),
+		identity:   service.NewIdentityService(repos, cfg.JWT, cfg.SAML, logger),
+		auth:       service.NewAuthService(repos, cfg.JWT, nil, logger),
+		audit:      service.NewAuditService(repos, cfg.AuditExport, logger),
+		mailDir:    mailDir,
+	}
+
+	ctx := context.Background()
+	env.user, err = env.identity.CreateUser(ctx, "e2e@example.com", "E2E User")
+	require.NoError(t, err)
+	token, _, _, err := env.identity.GenerateTokens(ctx, env.user.ID, env.user.Email, uuid.Nil, "owner")
+	require.NoError(t, err)
+
+	handlerOpts := connect.WithInterceptors(
+		interceptors.NewAuthInterceptor(env.identity, logger),
+		interceptors.NewAuthorizationInterceptor(service.NewAuthorizationService(repos, logger), env.audit, service.NewEntitlementService(repos), logger),
+	)
+	mux := http.NewServeMux()
+	for _, h := range []interface {
+		Path() string
+		Handler(...connect.HandlerOption) http.Handler
+	}{
+		api.NewOrganizationHandler(service.NewOrganizationService(repos, cfg.OrganizationDeletion, deleter, logger), env.identity,
+			service.NewInvitationService(repos, cfg.JWT, cfg.Invitation, mailer, logger)),
+		api.NewNamespaceHandler(service.NewNamespaceService(repos, operations, failover, authority, logger)),
+		api.NewBillingHandler(env.billing),
+		api.NewIdentityHandler(env.identity),
+		api.NewAuditHandler(env.audit),
+	} {
+		mux.Handle(h.Path(), h.Handler(handlerOpts))
+	}
+	mux.Handle("/webhooks/stripe", api.NewStripeWebhookHandler(env.billing, logger))
+	samlHandler := api.NewSAMLHandler(env.identity, logger)
+	mux.HandleFunc(service.SAMLACSPath, samlHandler.HandleACS)
+	mux.HandleFunc(service.SAMLMetadataPath, samlHandler.HandleMetadata)
+	mux.Handle(service.SCIMBasePath+"/", api.NewSCIMHandler(service.NewSCIMService(repos, logger), logger))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	env.url = server.URL
+	fakeStripe.SetWebhookURL(server.URL + "/webhooks/stripe")
+
+	clientOpts := connect.WithInterceptors(bearerToken(token))
+	env.orgs = cloudv1connect.NewOrganizationServiceClient(server.Client(), server.URL, clientOpts)
+	env.namespaces = cloudv1connect.NewNamespaceServiceClient(server.Client(), server.URL, clientOpts)
+	env.billingAPI = cloudv1connect.NewBillingServiceClient(server.Client(), server.URL, clientOpts)
+	env.identityAPI = cloudv1connect.NewIdentityServiceClient(server.Client(), server.URL, clientOpts)
+	env.auditAPI = cloudv1connect.NewAuditServiceClient(server.Client(), server.URL, clientOpts)
+	return env
+}
+
+func (e *e2eEnv) createOrganization(t *testing.T, name string) *cloudv1.Organization {
+	t.Helper()
+	resp, err := e.orgs.CreateOrganization(context.Background(), connect.NewRequest(&cloudv1.CreateOrganizationRequest{Name: name}))
+	require.NoError(t, err)
+	return resp.Msg.GetOrganization()
+}
+
+// recordingPaymentNotifier records the payment events billing reports to the
+// dunning workflows.
+type recordingPaymentNotifier struct {
+	mu     sync.Mutex
+	failed []uuid.UUID
+	paid   []uuid.UUID
+}
+
+func (n *recordingPaymentNotifier) InvoicePaymentFailed(_ context.Context, _, invoiceID uuid.UUID) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.failed = append(n.failed, invoiceID)
+	return nil
+}
+
+func (n *recordingPaymentNotifier) InvoicePaid(_ context.Context, _, invoiceID uuid.UUID) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.paid = append(n.paid, invoiceID)
+	return nil
+}
+
+// recordingOrganizationDeleter records the organization deletions scheduled
+// and cancelled, in place of the deletion workflows.
+type recordingOrganizationDeleter struct {
+	mu        sync.Mutex
+	scheduled []uuid.UUID
+	cancelled []uuid.UUID
+}
+
+func (d *recordingOrganizationDeleter) ScheduleDeletion(_ context.Context, orgID uuid.UUID, _ time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.scheduled = append(d.scheduled, orgID)
+	return nil
+}
+
+func (d *recordingOrganizationDeleter) CancelDeletion(_ context.Context, orgID uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.cancelled = append(d.cancelled, orgID)
+	return nil
+}
+
+// recordingNamespaceOperations records the namespace operations started, in
+// place of the namespace workflows.
+type recordingNamespaceOperations struct {
+	mu          sync.Mutex
+	provisioned map[string]string
+	updates     []string
+	deleted     map[string]string
+}
+
+func (o *recordingNamespaceOperations) StartProvisioning(_ context.Context, operationID string, ns *repository.Namespace) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.provisioned == nil {
+		o.provisioned = make(map[string]string)
+	}
+	o.provisioned[ns.ID] = operationID
+	return nil
+}
+
+func (o *recordingNamespaceOperations) StartUpdate(_ context.Context, operationID string, ns *repository.Namespace, searchAttributes map[string]string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	update := fmt.Sprintf("%s retention=%d", ns.ID, ns.RetentionDays)
+	names := make([]string, 0, len(searchAttributes))
+	for name := range searchAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		update += " " + name + "=" + searchAttributes[name]
+	}
+	o.updates = append(o.updates, update)
+	return nil
+}
+
+func (o *recordingNamespaceOperations) StartDeletion(_ context.Context, operationID string, ns *repository.Namespace) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.deleted == nil {
+		o.deleted = make(map[string]string)
+	}
+	o.deleted[ns.ID] = operationID
+	return nil
+}
+
+// recordingFailoverStarter records the namespace failovers started, in place
+// of the failover workflows.
+type recordingFailoverStarter struct {
+	mu      sync.Mutex
+	started []string
+}
+
+func (f *recordingFailoverStarter) StartFailover(_ context.Context, namespaceID, targetRegion, _ string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.started = append(f.started, namespaceID+"->"+targetRegion)
+	return uuid.NewString(), nil
+}
+
+// invitationToken returns the token of the last invitation emailed to the
+// address.
+func (e *e2eEnv) invitationToken(t *testing.T, to string) string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(e.mailDir, "*.eml"))
+	require.NoError(t, err)
+	sort.Strings(files)
+	for i := len(files) - 1; i >= 0; i-- {
+		f, err := os.Open(files[i])
+		require.NoError(t, err)
+		msg, err := netmail.ReadMessage(f)
+		require.NoError(t, err)
+		body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+		_ = f.Close()
+		require.NoError(t, err)
+		if msg.Header.Get("To") != "<"+to+">" {
+			continue
+		}
+		for _, line := range strings.Fields(string(body)) {
+			if u, err := url.Parse(line); err == nil && u.Query().Get("token") != "" {
+				return u.Query().Get("token")
+			}
+		}
+	}
+	t.Fatalf("no invitation emailed to %s", to)
+	return ""
+}
+
+func bearerToken(token string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	}
+}
+
+func TestE2E_Unauthenticated(t *testing.T) {
+	env := newE2EEnv(t)
+	client := cloudv1connect.NewOrganizationServiceClient(http.DefaultClient, env.url)
+	_, err := client.ListOrganizations(context.Background(), connect.NewRequest(&cloudv1.ListOrganizationsRequest{}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func TestE2E_OrganizationService(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+
+	org := env.createOrganization(t, "Acme Corp")
+	require.Equal(t, "acme-corp", org.GetSlug())
+
+	_, err := env.orgs.CreateOrganization(ctx, connect.NewRequest(&cloudv1.CreateOrganizationRequest{Name: "Acme Corp"}))
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	_, err = env.orgs.CreateOrganization(ctx, connect.NewRequest(&cloudv1.CreateOrganizationRequest{Name: "x"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	got, err := env.orgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, org.GetName(), got.Msg.GetOrganization().GetName())
+	_, err = env.orgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: uuid.NewString()}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = env.orgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: "not-a-uuid"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	updated, err := env.orgs.UpdateOrganization(ctx, connect.NewRequest(&cloudv1.UpdateOrganizationRequest{
+		OrganizationId: org.GetId(),
+		Organization:   &cloudv1.Organization{Name: "Acme Inc"},
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "Acme Inc", updated.Msg.GetOrganization().GetName())
+
+	for i := 0; i < 2; i++ {
+		env.createOrganization(t, fmt.Sprintf("Paged Org %d", i))
+	}
+	first, err := env.orgs.ListOrganizations(ctx, connect.NewRequest(&cloudv1.ListOrganizationsRequest{PageSize: 2}))
+	require.NoError(t, err)
+	require.Len(t, first.Msg.GetOrganizations(), 2)
+	require.NotEmpty(t, first.Msg.GetNextPageToken())
+	second, err := env.orgs.ListOrganizations(ctx, connect.NewRequest(&cloudv1.ListOrganizationsRequest{PageSize: 2, PageToken: first.Msg.GetNextPageToken()}))
+	require.NoError(t, err)
+	require.Len(t, second.Msg.GetOrganizations(), 1)
+	require.Empty(t, second.Msg.GetNextPageToken())
+
+	inv, err := env.orgs.InviteUser(ctx, connect.NewRequest(&cloudv1.InviteUserRequest{
+		OrganizationId: org.GetId(),
+		Email:          "invitee@example.com",
+		Role:           cloudv1.OrganizationRole_ORGANIZATION_ROLE_DEVELOPER,
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, inv.Msg.GetInvitationId())
+
+	members, err := env.orgs.ListMembers(ctx, connect.NewRequest(&cloudv1.ListMembersRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, members.Msg.GetMembers(), 1)
+	require.Equal(t, env.user.Email, members.Msg.GetMembers()[0].GetEmail())
+	require.Equal(t, cloudv1.OrganizationRole_ORGANIZATION_ROLE_OWNER, members.Msg.GetMembers()[0].GetRole())
+
+	member, err := env.orgs.UpdateMemberRole(ctx, connect.NewRequest(&cloudv1.UpdateMemberRoleRequest{
+		OrganizationId: org.GetId(),
+		UserId:         env.user.ID.String(),
+		Role:           cloudv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, cloudv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN, member.Msg.GetMember().GetRole())
+	_, err = env.orgs.UpdateMemberRole(ctx, connect.NewRequest(&cloudv1.UpdateMemberRoleRequest{
+		OrganizationId: org.GetId(),
+		UserId:         uuid.NewString(),
+		Role:           cloudv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN,
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// Admins can neither delete the organization nor grant the owner role.
+	_, err = env.orgs.DeleteOrganization(ctx, connect.NewRequest(&cloudv1.DeleteOrganizationRequest{OrganizationId: org.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = env.orgs.UpdateMemberRole(ctx, connect.NewRequest(&cloudv1.UpdateMemberRoleRequest{
+		OrganizationId: org.GetId(),
+		UserId:         env.user.ID.String(),
+		Role:           cloudv1.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = env.orgs.RemoveMember(ctx, connect.NewRequest(&cloudv1.RemoveMemberRequest{OrganizationId: org.GetId(), UserId: env.user.ID.String()}))
+	require.NoError(t, err)
+	_, err = env.orgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: org.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// Deletion is scheduled after a grace period, during which it can be
+	// cancelled.
+	doomed := env.createOrganization(t, "Doomed Org")
+	doomedID := uuid.MustParse(doomed.GetId())
+	deleteReq := connect.NewRequest(&cloudv1.DeleteOrganizationRequest{OrganizationId: doomed.GetId()})
+	deleted, err := env.orgs.DeleteOrganization(ctx, deleteReq)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Hour), deleted.Msg.GetOrganization().GetDeleteAfter().AsTime(), time.Minute)
+	_, err = env.orgs.DeleteOrganization(ctx, deleteReq)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	cancelReq := connect.NewRequest(&cloudv1.CancelOrganizationDeletionRequest{OrganizationId: doomed.GetId()})
+	cancelled, err := env.orgs.CancelOrganizationDeletion(ctx, cancelReq)
+	require.NoError(t, err)
+	require.Nil(t, cancelled.Msg.GetOrganization().GetDeleteAfter())
+	_, err = env.orgs.CancelOrganizationDeletion(ctx, cancelReq)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = env.orgs.DeleteOrganization(ctx, deleteReq)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{doomedID, doomedID}, env.deleter.scheduled)
+	require.Equal(t, []uuid.UUID{doomedID}, env.deleter.cancelled)
+
+	// Once teardown begins the deletion is final, and when it completes the
+	// organization is gone.
+	begun, err := env.repos.Organizations.BeginDeletion(ctx, doomedID, time.Now())
+	require.NoError(t, err)
+	require.True(t, begun)
+	_, err = env.orgs.CancelOrganizationDeletion(ctx, cancelReq)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.NoError(t, env.repos.Organizations.MarkDeleted(ctx, doomedID, time.Now()))
+	_, err = env.orgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: doomed.GetId()}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// Its slug can be reused.
+	reused, err := env.orgs.CreateOrganization(ctx, connect.NewRequest(&cloudv1.CreateOrganizationRequest{
+		Name: "Doomed Org",
+		Slug: doomed.GetSlug(),
+	}))
+	require.NoError(t, err)
+	require.NotEqual(t, doomed.GetId(), reused.Msg.GetOrganization().GetId())
+}
+
+func TestE2E_NamespaceService(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Namespace Org")
+
+	_, err := env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_BUSINESS,
+	}))
+	require.NoError(t, err)
+
+	created, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "orders",
+		Region:         "us-east-1",
+		Config: &cloudv1.NamespaceConfig{
+			RetentionPeriod: durationpb.New(14 * 24 * time.Hour),
+			HaConfig:        &cloudv1.HighAvailabilityConfig{Enabled: true, StandbyRegion: "us-west-2"},
+		},
+		Tags: map[string]string{"team": "payments"},
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Msg.GetOperationId())
+	nsID := created.Msg.GetNamespace().GetId()
+	require.Equal(t, created.Msg.GetOperationId(), env.operations.provisioned[nsID])
+
+	_, err = env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "orders",
+		Region:         "us-east-1",
+	}))
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	_, err = env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "shipping",
+		Region:         "mars-1",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	got, err := env.namespaces.GetNamespace(ctx, connect.NewRequest(&cloudv1.GetNamespaceRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	require.Equal(t, "payments", got.Msg.GetNamespace().GetTags()["team"])
+	require.Equal(t, 14*24*time.Hour, got.Msg.GetNamespace().GetConfig().GetRetentionPeriod().AsDuration())
+
+	_, err = env.namespaces.FailoverNamespace(ctx, connect.NewRequest(&cloudv1.FailoverNamespaceRequest{NamespaceId: nsID, TargetRegion: "us-west-2"}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// Changing the retention of a namespace that is not provisioned yet has
+	// no cluster to apply it to.
+	updateRetention := connect.NewRequest(&cloudv1.UpdateNamespaceRequest{
+		NamespaceId: nsID,
+		Namespace: &cloudv1.Namespace{Config: &cloudv1.NamespaceConfig{
+			RetentionPeriod: durationpb.New(30 * 24 * time.Hour),
+		}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"config.retention_period"}},
+	})
+	_, err = env.namespaces.UpdateNamespace(ctx, updateRetention)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	// Nor can it be deleted until its provisioning is done.
+	_, err = env.namespaces.DeleteNamespace(ctx, connect.NewRequest(&cloudv1.DeleteNamespaceRequest{NamespaceId: nsID}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.NoError(t, env.repos.Namespaces.UpdatePlacement(ctx, nsID, "use1-a", "", "", ""))
+	require.NoError(t, env.repos.Namespaces.UpdateState(ctx, nsID, "active"))
+
+	updated, err := env.namespaces.UpdateNamespace(ctx, updateRetention)
+	require.NoError(t, err)
+	require.NotEmpty(t, updated.Msg.GetOperationId())
+	require.Equal(t, 30*24*time.Hour, updated.Msg.GetNamespace().GetConfig().GetRetentionPeriod().AsDuration())
+	require.Equal(t, cloudv1.NamespaceState_NAMESPACE_STATE_UPDATING, updated.Msg.GetNamespace().GetState())
+	require.Equal(t, []string{nsID + " retention=30"}, env.operations.updates)
+
+	// Settings kept by the cloud API alone are updated in place.
+	tagged, err := env.namespaces.UpdateNamespace(ctx, connect.NewRequest(&cloudv1.UpdateNamespaceRequest{
+		NamespaceId: nsID,
+		Namespace:   &cloudv1.Namespace{Tags: map[string]string{"team": "orders"}},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+	}))
+	require.NoError(t, err)
+	require.Empty(t, tagged.Msg.GetOperationId())
+	require.Equal(t, "orders", tagged.Msg.GetNamespace().GetTags()["team"])
+	require.Len(t, env.operations.updates, 1)
+
+	list, err := env.namespaces.ListNamespaces(ctx, connect.NewRequest(&cloudv1.ListNamespacesRequest{
+		OrganizationId: org.GetId(),
+		RegionFilter:   "us-east-1",
+	}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetNamespaces(), 1)
+	list, err = env.namespaces.ListNamespaces(ctx, connect.NewRequest(&cloudv1.ListNamespacesRequest{
+		OrganizationId: org.GetId(),
+		RegionFilter:   "eu-west-1",
+	}))
+	require.NoError(t, err)
+	require.Empty(t, list.Msg.GetNamespaces())
+
+	addSearchAttributes := connect.NewRequest(&cloudv1.AddSearchAttributesRequest{
+		NamespaceId: nsID,
+		SearchAttributes: []*cloudv1.SearchAttribute{
+			{Name: "CustomerId", Type: cloudv1.SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_KEYWORD},
+		},
+	})
+	// The retention update is still being applied.
+	_, err = env.namespaces.AddSearchAttributes(ctx, addSearchAttributes)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.NoError(t, env.repos.Namespaces.UpdateState(ctx, nsID, "active"))
+	added, err := env.namespaces.AddSearchAttributes(ctx, addSearchAttributes)
+	require.NoError(t, err)
+	require.NotEmpty(t, added.Msg.GetOperationId())
+	require.Equal(t, nsID+" retention=30 CustomerId=keyword", env.operations.updates[1])
+	require.NoError(t, env.repos.Namespaces.UpdateState(ctx, nsID, "active"))
+	_, err = env.namespaces.AddSearchAttributes(ctx, connect.NewRequest(&cloudv1.AddSearchAttributesRequest{
+		NamespaceId:      "missing.00000000",
+		SearchAttributes: addSearchAttributes.Msg.GetSearchAttributes(),
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = env.namespaces.RemoveSearchAttribute(ctx, connect.NewRequest(&cloudv1.RemoveSearchAttributeRequest{NamespaceId: nsID, Name: "CustomerId"}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+
+	filter, err := env.namespaces.AddCertificateFilter(ctx, connect.NewRequest(&cloudv1.AddCertificateFilterRequest{
+		NamespaceId:       nsID,
+		CertificateFilter: &cloudv1.CertificateFilter{CommonName: "worker.example.com"},
+	}))
+	require.NoError(t, err)
+	_, err = env.namespaces.AddCertificateFilter(ctx, connect.NewRequest(&cloudv1.AddCertificateFilterRequest{
+		NamespaceId:       nsID,
+		CertificateFilter: &cloudv1.CertificateFilter{},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = env.namespaces.RemoveCertificateFilter(ctx, connect.NewRequest(&cloudv1.RemoveCertificateFilterRequest{
+		NamespaceId: nsID,
+		FilterId:    filter.Msg.GetCertificateFilter().GetId(),
+	}))
+	require.NoError(t, err)
+	_, err = env.namespaces.RemoveCertificateFilter(ctx, connect.NewRequest(&cloudv1.RemoveCertificateFilterRequest{
+		NamespaceId: nsID,
+		FilterId:    filter.Msg.GetCertificateFilter().GetId(),
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	sink, err := env.namespaces.CreateExportSink(ctx, connect.NewRequest(&cloudv1.CreateExportSinkRequest{
+		NamespaceId: nsID,
+		Sink: &cloudv1.ExportSink{
+			Name:    "archive",
+			S3:      &cloudv1.S3ExportDestination{Bucket: "histories", Prefix: "orders", Region: "us-east-1"},
+			Enabled: true,
+		},
+	}))
+	require.NoError(t, err)
+	sinkID := sink.Msg.GetSink().GetId()
+	require.Equal(t, "histories", sink.Msg.GetSink().GetS3().GetBucket())
+	_, err = env.namespaces.CreateExportSink(ctx, connect.NewRequest(&cloudv1.CreateExportSinkRequest{
+		NamespaceId: nsID,
+		Sink:        &cloudv1.ExportSink{Name: "archive", Gcs: &cloudv1.GCSExportDestination{Bucket: "histories"}},
+	}))
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	_, err = env.namespaces.CreateExportSink(ctx, connect.NewRequest(&cloudv1.CreateExportSinkRequest{
+		NamespaceId: nsID,
+		Sink:        &cloudv1.ExportSink{Name: "no-region", S3: &cloudv1.S3ExportDestination{Bucket: "histories"}},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	updatedSink, err := env.namespaces.UpdateExportSink(ctx, connect.NewRequest(&cloudv1.UpdateExportSinkRequest{
+		NamespaceId: nsID,
+		SinkId:      sinkID,
+		Sink:        &cloudv1.ExportSink{Name: "archive", Gcs: &cloudv1.GCSExportDestination{Bucket: "histories"}},
+	}))
+	require.NoError(t, err)
+	require.False(t, updatedSink.Msg.GetSink().GetEnabled())
+	require.Nil(t, updatedSink.Msg.GetSink().GetS3())
+	sinks, err := env.namespaces.ListExportSinks(ctx, connect.NewRequest(&cloudv1.ListExportSinksRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	require.Len(t, sinks.Msg.GetSinks(), 1)
+	require.Equal(t, "histories", sinks.Msg.GetSinks()[0].GetGcs().GetBucket())
+	jobs, err := env.namespaces.ListExportJobs(ctx, connect.NewRequest(&cloudv1.ListExportJobsRequest{NamespaceId: nsID, SinkId: sinkID}))
+	require.NoError(t, err)
+	require.Empty(t, jobs.Msg.GetJobs())
+	_, err = env.namespaces.DeleteExportSink(ctx, connect.NewRequest(&cloudv1.DeleteExportSinkRequest{NamespaceId: nsID, SinkId: sinkID}))
+	require.NoError(t, err)
+	_, err = env.namespaces.GetExportSink(ctx, connect.NewRequest(&cloudv1.GetExportSinkRequest{NamespaceId: nsID, SinkId: sinkID}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	rule, err := env.namespaces.CreateConnectivityRule(ctx, connect.NewRequest(&cloudv1.CreateConnectivityRuleRequest{
+		OrganizationId: org.GetId(),
+		Rule: &cloudv1.ConnectivityRule{
+			Name:        "office",
+			IpAllowlist: &cloudv1.IPAllowlistRule{Cidrs: []string{"203.0.113.0/24"}},
+			Enabled:     true,
+		},
+	}))
+	require.NoError(t, err)
+	ruleID := rule.Msg.GetRule().GetId()
+	_, err = env.namespaces.CreateConnectivityRule(ctx, connect.NewRequest(&cloudv1.CreateConnectivityRuleRequest{
+		OrganizationId: org.GetId(),
+		Rule:           &cloudv1.ConnectivityRule{Name: "office", IpAllowlist: &cloudv1.IPAllowlistRule{Cidrs: []string{"198.51.100.0/24"}}},
+	}))
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	_, err = env.namespaces.CreateConnectivityRule(ctx, connect.NewRequest(&cloudv1.CreateConnectivityRuleRequest{
+		OrganizationId: org.GetId(),
+		Rule:           &cloudv1.ConnectivityRule{Name: "bad", IpAllowlist: &cloudv1.IPAllowlistRule{Cidrs: []string{"203.0.113.0/33"}}},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	updatedRule, err := env.namespaces.UpdateConnectivityRule(ctx, connect.NewRequest(&cloudv1.UpdateConnectivityRuleRequest{
+		OrganizationId: org.GetId(),
+		RuleId:         ruleID,
+		Rule: &cloudv1.ConnectivityRule{
+			Name:        "office",
+			IpAllowlist: &cloudv1.IPAllowlistRule{Cidrs: []string{"203.0.113.0/24", "198.51.100.7"}},
+			Enabled:     true,
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, updatedRule.Msg.GetRule().GetIpAllowlist().GetCidrs(), 2)
+	_, err = env.namespaces.UpdateConnectivityRule(ctx, connect.NewRequest(&cloudv1.UpdateConnectivityRuleRequest{
+		OrganizationId: org.GetId(),
+		RuleId:         ruleID,
+		Rule: &cloudv1.ConnectivityRule{
+			Name:        "office",
+			PrivateLink: &cloudv1.PrivateLinkRule{ConnectionId: "vpce-123", Region: "us-east-1"},
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	rules, err := env.namespaces.ListConnectivityRules(ctx, connect.NewRequest(&cloudv1.ListConnectivityRulesRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, rules.Msg.GetRules(), 1)
+
+	_, err = env.namespaces.AddNamespaceConnectivityRule(ctx, connect.NewRequest(&cloudv1.AddNamespaceConnectivityRuleRequest{NamespaceId: nsID, RuleId: ruleID}))
+	require.NoError(t, err)
+	otherOrg := env.createOrganization(t, "Other Connectivity Org")
+	otherRule, err := env.namespaces.CreateConnectivityRule(ctx, connect.NewRequest(&cloudv1.CreateConnectivityRuleRequest{
+		OrganizationId: otherOrg.GetId(),
+		Rule:           &cloudv1.ConnectivityRule{Name: "other", IpAllowlist: &cloudv1.IPAllowlistRule{Cidrs: []string{"0.0.0.0/0"}}},
+	}))
+	require.NoError(t, err)
+	_, err = env.namespaces.AddNamespaceConnectivityRule(ctx, connect.NewRequest(&cloudv1.AddNamespaceConnectivityRuleRequest{
+		NamespaceId: nsID,
+		RuleId:      otherRule.Msg.GetRule().GetId(),
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	bound, err := env.namespaces.ListNamespaceConnectivityRules(ctx, connect.NewRequest(&cloudv1.ListNamespaceConnectivityRulesRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	require.Len(t, bound.Msg.GetRules(), 1)
+	require.Equal(t, ruleID, bound.Msg.GetRules()[0].GetId())
+	_, err = env.namespaces.RemoveNamespaceConnectivityRule(ctx, connect.NewRequest(&cloudv1.RemoveNamespaceConnectivityRuleRequest{NamespaceId: nsID, RuleId: ruleID}))
+	require.NoError(t, err)
+	_, err = env.namespaces.RemoveNamespaceConnectivityRule(ctx, connect.NewRequest(&cloudv1.RemoveNamespaceConnectivityRuleRequest{NamespaceId: nsID, RuleId: ruleID}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = env.namespaces.DeleteConnectivityRule(ctx, connect.NewRequest(&cloudv1.DeleteConnectivityRuleRequest{OrganizationId: org.GetId(), RuleId: ruleID}))
+	require.NoError(t, err)
+	_, err = env.namespaces.GetConnectivityRule(ctx, connect.NewRequest(&cloudv1.GetConnectivityRuleRequest{OrganizationId: org.GetId(), RuleId: ruleID}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	failover, err := env.namespaces.FailoverNamespace(ctx, connect.NewRequest(&cloudv1.FailoverNamespaceRequest{NamespaceId: nsID, TargetRegion: "us-west-2"}))
+	require.NoError(t, err)
+	require.NotEmpty(t, failover.Msg.GetOperationId())
+	require.Equal(t, []string{nsID + "->us-west-2"}, env.failover.started)
+	_, err = env.namespaces.FailoverNamespace(ctx, connect.NewRequest(&cloudv1.FailoverNamespaceRequest{NamespaceId: nsID, TargetRegion: "eu-west-1"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	issued, err := env.namespaces.IssueClientCertificate(ctx, connect.NewRequest(&cloudv1.IssueClientCertificateRequest{NamespaceId: nsID, CommonName: "worker"}))
+	require.NoError(t, err)
+	require.Contains(t, issued.Msg.GetCertificatePem(), "BEGIN CERTIFICATE")
+	require.NotEmpty(t, issued.Msg.GetPrivateKeyPem())
+	trusted, err := env.repos.CAs.ListTrusted(ctx, nsID, time.Now())
+	require.NoError(t, err)
+	require.Len(t, trusted, 1)
+
+	deleted, err := env.namespaces.DeleteNamespace(ctx, connect.NewRequest(&cloudv1.DeleteNamespaceRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	require.NotEmpty(t, deleted.Msg.GetOperationId())
+	require.Equal(t, deleted.Msg.GetOperationId(), env.operations.deleted[nsID])
+	got, err = env.namespaces.GetNamespace(ctx, connect.NewRequest(&cloudv1.GetNamespaceRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	require.Equal(t, cloudv1.NamespaceState_NAMESPACE_STATE_DELETING, got.Msg.GetNamespace().GetState())
+	_, err = env.namespaces.DeleteNamespace(ctx, connect.NewRequest(&cloudv1.DeleteNamespaceRequest{NamespaceId: nsID}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = env.namespaces.GetNamespace(ctx, connect.NewRequest(&cloudv1.GetNamespaceRequest{NamespaceId: "missing.00000000"}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestE2E_CertificateAuthorities(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "CA Org")
+	_, err := env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_BUSINESS,
+	}))
+	require.NoError(t, err)
+	created, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "payments",
+		Region:         "us-east-1",
+	}))
+	require.NoError(t, err)
+	nsID := created.Msg.GetNamespace().GetId()
+
+	current, err := env.repos.CAs.GetCurrent(ctx, nsID)
+	require.NoError(t, err)
+	require.Nil(t, current)
+
+	now := time.Now()
+	newCA := func(fingerprint string) *repository.CertificateAuthority {
+		return &repository.CertificateAuthority{
+			NamespaceID:         nsID,
+			CertificatePEM:      fingerprint,
+			EncryptedPrivateKey: []byte("sealed"),
+			Fingerprint:         fingerprint,
+			NotBefore:           now.Add(-time.Hour),
+			NotAfter:            now.Add(24 * time.Hour),
+		}
+	}
+	require.NoError(t, env.repos.CAs.Rotate(ctx, newCA("first"), now))
+	// Rotating keeps the first CA trusted until the end of its overlap window.
+	require.NoError(t, env.repos.CAs.Rotate(ctx, newCA("second"), now.Add(time.Hour)))
+
+	current, err = env.repos.CAs.GetCurrent(ctx, nsID)
+	require.NoError(t, err)
+	require.Equal(t, "second", current.Fingerprint)
+	trusted, err := env.repos.CAs.ListTrusted(ctx, nsID, now)
+	require.NoError(t, err)
+	require.Len(t, trusted, 2)
+	require.Equal(t, "second", trusted[0].Fingerprint)
+	trusted, err = env.repos.CAs.ListTrusted(ctx, nsID, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, trusted, 1)
+
+	deleted, err := env.repos.CAs.DeleteRetired(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+	deleted, err = env.repos.CAs.DeleteRetired(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+}
+
+func TestE2E_BillingService(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Billing Org")
+	orgID := uuid.MustParse(org.GetId())
+
+	sub, err := env.billingAPI.GetSubscription(ctx, connect.NewRequest(&cloudv1.GetSubscriptionRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, cloudv1.PlanTier_PLAN_TIER_FREE, sub.Msg.GetSubscription().GetPlan())
+
+	upgraded, err := env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_ESSENTIALS,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, cloudv1.PlanTier_PLAN_TIER_ESSENTIALS, upgraded.Msg.GetSubscription().GetPlan())
+	require.Equal(t, int32(1), upgraded.Msg.GetSubscription().GetPlanVersion())
+	require.Equal(t, int32(5), upgraded.Msg.GetSubscription().GetLimits().GetMaxNamespaces())
+	require.Equal(t, []string{"history_export"}, upgraded.Msg.GetSubscription().GetLimits().GetFeatures())
+
+	plans, err := env.billingAPI.ListPlans(ctx, connect.NewRequest(&cloudv1.ListPlansRequest{}))
+	require.NoError(t, err)
+	require.Len(t, plans.Msg.GetPlans(), 5)
+	require.Equal(t, cloudv1.PlanTier_PLAN_TIER_FREE, plans.Msg.GetPlans()[0].GetTier())
+	require.True(t, plans.Msg.GetPlans()[0].GetLimits().GetHardUsageLimits())
+
+	usage, err := env.billingAPI.GetUsage(ctx, connect.NewRequest(&cloudv1.GetUsageRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, org.GetId(), usage.Msg.GetUsage().GetOrganizationId())
+
+	ns, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "orders",
+		Region:         "us-east-1",
+	}))
+	require.NoError(t, err)
+	nsID := ns.Msg.GetNamespace().GetId()
+	_, err = env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "payments",
+		Region:         "us-east-1",
+		Config:         &cloudv1.NamespaceConfig{RetentionPeriod: durationpb.New(30 * 24 * time.Hour)},
+	}))
+	require.NoError(t, err)
+
+	// Downgrading to the free plan is refused until the organization is
+	// within its limits.
+	validation, err := env.billingAPI.ValidatePlanChange(ctx, connect.NewRequest(&cloudv1.ValidatePlanChangeRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_FREE,
+	}))
+	require.NoError(t, err)
+	var violations []string
+	for _, v := range validation.Msg.GetViolations() {
+		violations = append(violations, v.GetResourceType()+" "+v.GetResourceId())
+	}
+	require.ElementsMatch(t, []string{"namespace payments." + org.GetId()[:8], "namespace "}, violations)
+	_, err = env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_FREE,
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	nsUsage, err := env.billingAPI.GetUsageByNamespace(ctx, connect.NewRequest(&cloudv1.GetUsageByNamespaceRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	require.Equal(t, nsID, nsUsage.Msg.GetUsage().GetNamespaceId())
+
+	now := time.Now().UTC()
+	inv, err := env.billing.GenerateInvoice(ctx, orgID, now.AddDate(0, -1, 0), now)
+	require.NoError(t, err)
+
+	invoices, err := env.billingAPI.ListInvoices(ctx, connect.NewRequest(&cloudv1.ListInvoicesRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, invoices.Msg.GetInvoices(), 1)
+
+	got, err := env.billingAPI.GetInvoice(ctx, connect.NewRequest(&cloudv1.GetInvoiceRequest{InvoiceId: inv.ID.String()}))
+	require.NoError(t, err)
+	require.Equal(t, inv.InvoiceNumber, got.Msg.GetInvoice().GetInvoiceNumber())
+	_, err = env.billingAPI.GetInvoice(ctx, connect.NewRequest(&cloudv1.GetInvoiceRequest{InvoiceId: uuid.NewString()}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	balance, err := env.billingAPI.GetCreditBalance(ctx, connect.NewRequest(&cloudv1.GetCreditBalanceRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, org.GetId(), balance.Msg.GetBalance().GetOrganizationId())
+}
+
+func TestE2E_StripePayments(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Paying Org")
+	orgID := uuid.MustParse(org.GetId())
+
+	_, err := env.billingAPI.PurchaseCredits(ctx, connect.NewRequest(&cloudv1.PurchaseCreditsRequest{
+		OrganizationId: org.GetId(), AmountCents: 5000,
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "no payment method on file")
+
+	// A declined card fails the purchase without adding credits.
+	_, err = env.billingAPI.UpdatePaymentMethod(ctx, connect.NewRequest(&cloudv1.UpdatePaymentMethodRequest{
+		OrganizationId: org.GetId(), PaymentMethodId: stripe.TestPaymentMethodDeclined,
+	}))
+	require.NoError(t, err)
+	_, err = env.billingAPI.PurchaseCredits(ctx, connect.NewRequest(&cloudv1.PurchaseCreditsRequest{
+		OrganizationId: org.GetId(), AmountCents: 5000,
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = env.billingAPI.UpdatePaymentMethod(ctx, connect.NewRequest(&cloudv1.UpdatePaymentMethodRequest{
+		OrganizationId: org.GetId(), PaymentMethodId: stripe.TestPaymentMethodVisa,
+	}))
+	require.NoError(t, err)
+	purchased, err := env.billingAPI.PurchaseCredits(ctx, connect.NewRequest(&cloudv1.PurchaseCreditsRequest{
+		OrganizationId: org.GetId(), AmountCents: 5000,
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, purchased.Msg.GetPaymentIntentId())
+	// The purchase is recorded once even though the webhook reported it too.
+	require.Equal(t, int64(5000), purchased.Msg.GetBalance().GetBalanceCents())
+
+	// An invoice that fails to collect starts dunning and marks the
+	// subscription past due; paying it later ends dunning.
+	_, err = env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(), Plan: cloudv1.PlanTier_PLAN_TIER_ESSENTIALS,
+	}))
+	require.NoError(t, err)
+	_, err = env.billingAPI.UpdatePaymentMethod(ctx, connect.NewRequest(&cloudv1.UpdatePaymentMethodRequest{
+		OrganizationId: org.GetId(), PaymentMethodId: stripe.TestPaymentMethodDeclined,
+	}))
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	inv, err := env.billing.GenerateInvoice(ctx, orgID, now.AddDate(0, -1, 0), now)
+	require.NoError(t, err)
+	inv, err = env.billing.SubmitInvoice(ctx, inv.ID)
+	require.NoError(t, err)
+	require.Equal(t, "open", inv.Status)
+	require.Empty(t, env.stripe.DeliveryErrors())
+	require.Equal(t, []uuid.UUID{inv.ID}, env.payments.failed)
+
+	sub, err := env.billingAPI.GetSubscription(ctx, connect.NewRequest(&cloudv1.GetSubscriptionRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, cloudv1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAST_DUE, sub.Msg.GetSubscription().GetStatus())
+
+	paid, err := env.billing.CheckInvoicePaid(ctx, inv.ID)
+	require.NoError(t, err)
+	require.False(t, paid)
+
+	_, err = env.billingAPI.UpdatePaymentMethod(ctx, connect.NewRequest(&cloudv1.UpdatePaymentMethodRequest{
+		OrganizationId: org.GetId(), PaymentMethodId: stripe.TestPaymentMethodVisa,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, env.stripe.PayInvoice(inv.StripeInvoiceID.String))
+	require.Empty(t, env.stripe.DeliveryErrors())
+	require.Equal(t, []uuid.UUID{inv.ID}, env.payments.paid)
+
+	paid, err = env.billing.CheckInvoicePaid(ctx, inv.ID)
+	require.NoError(t, err)
+	require.True(t, paid)
+	sub, err = env.billingAPI.GetSubscription(ctx, connect.NewRequest(&cloudv1.GetSubscriptionRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, cloudv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE, sub.Msg.GetSubscription().GetStatus())
+
+	// Requests not signed by Stripe are rejected.
+	resp, err := http.Post(env.url+"/webhooks/stripe", "application/json", strings.NewReader(`{"type":"invoice.paid"}`))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestE2E_CreditLedger(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Prepaid Org")
+	orgID := uuid.MustParse(org.GetId())
+
+	_, err := env.billingAPI.UpdatePaymentMethod(ctx, connect.NewRequest(&cloudv1.UpdatePaymentMethodRequest{
+		OrganizationId: org.GetId(), PaymentMethodId: stripe.TestPaymentMethodVisa,
+	}))
+	require.NoError(t, err)
+	_, err = env.billingAPI.PurchaseCredits(ctx, connect.NewRequest(&cloudv1.PurchaseCreditsRequest{
+		OrganizationId: org.GetId(), AmountCents: 4000,
+	}))
+	require.NoError(t, err)
+	// A second lot that has already lapsed is written off, not applied.
+	now := time.Now().UTC()
+	added, err := env.repos.Credits.RecordPurchase(ctx, &repository.CreditPurchase{
+		OrganizationID: orgID,
+		AmountCents:    2500,
+		PurchasedAt:    now.AddDate(-1, 0, -1),
+		ExpiresAt:      now.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.True(t, added)
+
+	_, err = env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(), Plan: cloudv1.PlanTier_PLAN_TIER_ESSENTIALS,
+	}))
+	require.NoError(t, err)
+	inv, err := env.billing.GenerateInvoice(ctx, orgID, now.AddDate(0, -1, 0), now)
+	require.NoError(t, err)
+	require.Equal(t, int64(4000), inv.CreditsAppliedCents)
+	require.Equal(t, inv.SubtotalCents-4000, inv.TotalCents)
+
+	got, err := env.billingAPI.GetInvoice(ctx, connect.NewRequest(&cloudv1.GetInvoiceRequest{InvoiceId: inv.ID.String()}))
+	require.NoError(t, err)
+	items := got.Msg.GetInvoice().GetLineItems()
+	require.Equal(t, int64(-4000), items[len(items)-1].GetAmountCents())
+
+	balance, err := env.billingAPI.GetCreditBalance(ctx, connect.NewRequest(&cloudv1.GetCreditBalanceRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Zero(t, balance.Msg.GetBalance().GetBalanceCents())
+	var amounts []int64
+	for _, txn := range balance.Msg.GetBalance().GetTransactions() {
+		amounts = append(amounts, txn.GetAmountCents())
+	}
+	require.ElementsMatch(t, []int64{4000, 2500, -2500, -4000}, amounts)
+
+	// Every transaction posts balanced entries to the customer's account.
+	txns, err := env.repos.Credits.ListTransactions(ctx, orgID, 10, 0)
+	require.NoError(t, err)
+	for _, txn := range txns {
+		entries, err := env.repos.Credits.ListEntries(ctx, txn.ID)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Zero(t, entries[0].AmountCents+entries[1].AmountCents)
+	}
+
+	// Regenerating the invoice does not apply credits twice, and nothing is
+	// left to expire.
+	again, err := env.billing.GenerateInvoice(ctx, orgID, now.AddDate(0, -1, 0), now)
+	require.NoError(t, err)
+	require.Equal(t, inv.ID, again.ID)
+	orgs, err := env.billing.ExpireCredits(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, orgs)
+}
+
+func TestE2E_IdentityService(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+
+	created, err := env.identityAPI.CreateAPIKey(ctx, connect.NewRequest(&cloudv1.CreateAPIKeyRequest{
+		Name: "ci",
+		Permissions: []*cloudv1.Permission{
+			{Type: cloudv1.PermissionType_PERMISSION_TYPE_NAMESPACE_READ, ResourceId: "orders.12345678"},
+		},
+	}))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Msg.GetSecretKey(), "tc_"))
+	key := created.Msg.GetApiKey()
+	require.Equal(t, env.user.ID.String(), key.GetOwnerId())
+	require.Equal(t, "orders.12345678", key.GetPermissions()[0].GetResourceId())
+
+	got, err := env.identityAPI.GetAPIKey(ctx, connect.NewRequest(&cloudv1.GetAPIKeyRequest{ApiKeyId: key.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, "ci", got.Msg.GetApiKey().GetName())
+
+	list, err := env.identityAPI.ListAPIKeys(ctx, connect.NewRequest(&cloudv1.ListAPIKeysRequest{}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetApiKeys(), 1)
+
+	rotated, err := env.identityAPI.RotateAPIKey(ctx, connect.NewRequest(&cloudv1.RotateAPIKeyRequest{ApiKeyId: key.GetId()}))
+	require.NoError(t, err)
+	require.NotEqual(t, created.Msg.GetSecretKey(), rotated.Msg.GetSecretKey())
+
+	// The new secret authenticates on its own.
+	apiKeyClient := cloudv1connect.NewIdentityServiceClient(http.DefaultClient, env.url, connect.WithInterceptors(bearerToken(rotated.Msg.GetSecretKey())))
+	_, err = apiKeyClient.GetUser(ctx, connect.NewRequest(&cloudv1.GetUserRequest{}))
+	require.NoError(t, err)
+
+	_, err = env.identityAPI.RevokeAPIKey(ctx, connect.NewRequest(&cloudv1.RevokeAPIKeyRequest{ApiKeyId: rotated.Msg.GetApiKey().GetId()}))
+	require.NoError(t, err)
+	_, err = apiKeyClient.GetUser(ctx, connect.NewRequest(&cloudv1.GetUserRequest{}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	user, err := env.identityAPI.GetUser(ctx, connect.NewRequest(&cloudv1.GetUserRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, env.user.Email, user.Msg.GetUser().GetEmail())
+
+	renamed, err := env.identityAPI.UpdateUser(ctx, connect.NewRequest(&cloudv1.UpdateUserRequest{Name: "Renamed"}))
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", renamed.Msg.GetUser().GetName())
+}
+
+func TestE2E_ServiceAccounts(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Service Account Org")
+
+	_, err := env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_BUSINESS,
+	}))
+	require.NoError(t, err)
+	ns, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "deploys",
+		Region:         "us-east-1",
+	}))
+	require.NoError(t, err)
+	nsID := ns.Msg.GetNamespace().GetId()
+
+	created, err := env.identityAPI.CreateServiceAccount(ctx, connect.NewRequest(&cloudv1.CreateServiceAccountRequest{
+		OrganizationId:       org.GetId(),
+		Name:                 "ci",
+		AccountRole:          "read_only",
+		NamespacePermissions: []*cloudv1.NamespacePermission{{NamespaceId: nsID, Permission: "write"}},
+	}))
+	require.NoError(t, err)
+	sa := created.Msg.GetServiceAccount()
+	require.Equal(t, "write", sa.GetNamespacePermissions()[0].GetPermission())
+
+	_, err = env.identityAPI.CreateServiceAccount(ctx, connect.NewRequest(&cloudv1.CreateServiceAccountRequest{
+		OrganizationId: org.GetId(),
+		Name:           "owner",
+		AccountRole:    "owner",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = env.identityAPI.CreateServiceAccount(ctx, connect.NewRequest(&cloudv1.CreateServiceAccountRequest{
+		OrganizationId:       org.GetId(),
+		Name:                 "elsewhere",
+		NamespacePermissions: []*cloudv1.NamespacePermission{{NamespaceId: "orders.12345678", Permission: "read"}},
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	list, err := env.identityAPI.ListServiceAccounts(ctx, connect.NewRequest(&cloudv1.ListServiceAccountsRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetServiceAccounts(), 1)
+
+	key, err := env.identityAPI.CreateAPIKey(ctx, connect.NewRequest(&cloudv1.CreateAPIKeyRequest{
+		OwnerType: "service_account",
+		OwnerId:   sa.GetId(),
+		Name:      "ci-key",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, sa.GetId(), key.Msg.GetApiKey().GetOwnerId())
+
+	hash := sha256.Sum256([]byte(key.Msg.GetSecretKey()))
+	info, err := env.identity.ValidateAPIKey(ctx, hex.EncodeToString(hash[:]))
+	require.NoError(t, err)
+	require.Equal(t, org.GetId(), info.OrganizationID.String())
+	require.Equal(t, "read_only", info.Role)
+	require.Equal(t, []string{"namespace_write:" + nsID}, info.Permissions)
+
+	// Permission changes apply to existing keys.
+	updated, err := env.identityAPI.UpdateServiceAccount(ctx, connect.NewRequest(&cloudv1.UpdateServiceAccountRequest{
+		ServiceAccountId:     sa.GetId(),
+		Name:                 "ci",
+		NamespacePermissions: []*cloudv1.NamespacePermission{{NamespaceId: nsID, Permission: "admin"}},
+	}))
+	require.NoError(t, err)
+	require.Empty(t, updated.Msg.GetServiceAccount().GetAccountRole())
+	info, err = env.identity.ValidateAPIKey(ctx, hex.EncodeToString(hash[:]))
+	require.NoError(t, err)
+	require.Empty(t, info.Role)
+	require.Equal(t, []string{"namespace_admin:" + nsID}, info.Permissions)
+
+	saClient := cloudv1connect.NewIdentityServiceClient(http.DefaultClient, env.url, connect.WithInterceptors(bearerToken(key.Msg.GetSecretKey())))
+	got, err := saClient.GetServiceAccount(ctx, connect.NewRequest(&cloudv1.GetServiceAccountRequest{ServiceAccountId: sa.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, "ci", got.Msg.GetServiceAccount().GetName())
+
+	// Deleting the service account revokes its keys.
+	_, err = env.identityAPI.DeleteServiceAccount(ctx, connect.NewRequest(&cloudv1.DeleteServiceAccountRequest{ServiceAccountId: sa.GetId()}))
+	require.NoError(t, err)
+	_, err = saClient.GetServiceAccount(ctx, connect.NewRequest(&cloudv1.GetServiceAccountRequest{ServiceAccountId: sa.GetId()}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	_, err = env.identityAPI.GetServiceAccount(ctx, connect.NewRequest(&cloudv1.GetServiceAccountRequest{ServiceAccountId: sa.GetId()}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestE2E_Authorization(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Authz Org")
+	orgID := uuid.MustParse(org.GetId())
+	otherOrg := env.createOrganization(t, "Authz Other Org")
+	ns, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "payments",
+		Region:         "us-east-1",
+	}))
+	require.NoError(t, err)
+	nsID := ns.Msg.GetNamespace().GetId()
+
+	member, err := env.identity.CreateUser(ctx, "member@example.com", "Member")
+	require.NoError(t, err)
+	require.NoError(t, env.repos.Organizations.AddMember(ctx, &repository.OrganizationMember{
+		OrganizationID: orgID, UserID: member.ID, Role: "read_only",
+	}))
+	// Roles are resolved per request, not taken from the token.
+	token, _, _, err := env.identity.GenerateTokens(ctx, member.ID, member.Email, orgID, "owner")
+	require.NoError(t, err)
+	memberOpts := connect.WithInterceptors(bearerToken(token))
+	memberOrgs := cloudv1connect.NewOrganizationServiceClient(http.DefaultClient, env.url, memberOpts)
+	memberNamespaces := cloudv1connect.NewNamespaceServiceClient(http.DefaultClient, env.url, memberOpts)
+	memberBilling := cloudv1connect.NewBillingServiceClient(http.DefaultClient, env.url, memberOpts)
+	memberIdentity := cloudv1connect.NewIdentityServiceClient(http.DefaultClient, env.url, memberOpts)
+
+	_, err = memberOrgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	_, err = memberOrgs.DeleteOrganization(ctx, connect.NewRequest(&cloudv1.DeleteOrganizationRequest{OrganizationId: org.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = memberOrgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: otherOrg.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = memberNamespaces.GetNamespace(ctx, connect.NewRequest(&cloudv1.GetNamespaceRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	_, err = memberBilling.GetSubscription(ctx, connect.NewRequest(&cloudv1.GetSubscriptionRequest{OrganizationId: org.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// Namespace admins manage their namespace whatever their role.
+	removeAttribute := connect.NewRequest(&cloudv1.RemoveSearchAttributeRequest{NamespaceId: nsID, Name: "CustomerId"})
+	_, err = memberNamespaces.RemoveSearchAttribute(ctx, removeAttribute)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	require.NoError(t, env.repos.Users.SetNamespacePermission(ctx, &repository.UserNamespacePermission{
+		UserID: member.ID, NamespaceID: nsID, Permission: "admin",
+	}))
+	_, err = memberNamespaces.RemoveSearchAttribute(ctx, removeAttribute)
+	require.NotEqual(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// Finance members manage billing.
+	_, err = env.orgs.UpdateMemberRole(ctx, connect.NewRequest(&cloudv1.UpdateMemberRoleRequest{
+		OrganizationId: org.GetId(),
+		UserId:         member.ID.String(),
+		Role:           cloudv1.OrganizationRole_ORGANIZATION_ROLE_FINANCE,
+	}))
+	require.NoError(t, err)
+	_, err = memberBilling.GetSubscription(ctx, connect.NewRequest(&cloudv1.GetSubscriptionRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+
+	// API keys belong to their owner.
+	key, err := env.identityAPI.CreateAPIKey(ctx, connect.NewRequest(&cloudv1.CreateAPIKeyRequest{Name: "owner-key"}))
+	require.NoError(t, err)
+	_, err = memberIdentity.RevokeAPIKey(ctx, connect.NewRequest(&cloudv1.RevokeAPIKeyRequest{ApiKeyId: key.Msg.GetApiKey().GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = memberIdentity.ListAPIKeys(ctx, connect.NewRequest(&cloudv1.ListAPIKeysRequest{OwnerId: env.user.ID.String()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// Service accounts act only within their organization.
+	sa, err := env.identityAPI.CreateServiceAccount(ctx, connect.NewRequest(&cloudv1.CreateServiceAccountRequest{
+		OrganizationId:       org.GetId(),
+		Name:                 "reader",
+		NamespacePermissions: []*cloudv1.NamespacePermission{{NamespaceId: nsID, Permission: "read"}},
+	}))
+	require.NoError(t, err)
+	saKey, err := env.identityAPI.CreateAPIKey(ctx, connect.NewRequest(&cloudv1.CreateAPIKeyRequest{
+		OwnerType: "service_account",
+		OwnerId:   sa.Msg.GetServiceAccount().GetId(),
+		Name:      "reader-key",
+	}))
+	require.NoError(t, err)
+	saNamespaces := cloudv1connect.NewNamespaceServiceClient(http.DefaultClient, env.url, connect.WithInterceptors(bearerToken(saKey.Msg.GetSecretKey())))
+	_, err = saNamespaces.GetNamespace(ctx, connect.NewRequest(&cloudv1.GetNamespaceRequest{NamespaceId: nsID}))
+	require.NoError(t, err)
+	_, err = saNamespaces.ListNamespaces(ctx, connect.NewRequest(&cloudv1.ListNamespacesRequest{OrganizationId: org.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = saNamespaces.ListNamespaces(ctx, connect.NewRequest(&cloudv1.ListNamespacesRequest{OrganizationId: otherOrg.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// Denials are audited.
+	events, err := env.auditAPI.ListAuditEvents(ctx, connect.NewRequest(&cloudv1.ListAuditEventsRequest{
+		OrganizationId: org.GetId(),
+		Action:         "DeleteOrganization",
+	}))
+	require.NoError(t, err)
+	require.Len(t, events.Msg.GetEvents(), 1)
+	denied := events.Msg.GetEvents()[0]
+	require.Equal(t, cloudv1.AuditResult_AUDIT_RESULT_DENIED, denied.GetResult())
+	require.Equal(t, member.ID.String(), denied.GetActor().GetId())
+}
+
+func TestE2E_SAMLLogin(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "SAML Org")
+	orgID := uuid.MustParse(org.GetId())
+
+	idp := samltest.NewIdP()
+	defer idp.Close()
+
+	// SSO cannot be enabled without an identity provider.
+	_, err := env.orgs.UpdateOrganization(ctx, connect.NewRequest(&cloudv1.UpdateOrganizationRequest{
+		OrganizationId: org.GetId(),
+		Organization:   &cloudv1.Organization{Settings: &cloudv1.OrganizationSettings{SsoEnabled: true}},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	updated, err := env.orgs.UpdateOrganization(ctx, connect.NewRequest(&cloudv1.UpdateOrganizationRequest{
+		OrganizationId: org.GetId(),
+		Organization: &cloudv1.Organization{Settings: &cloudv1.OrganizationSettings{
+			SsoEnabled: true,
+			SamlConfig: &cloudv1.SAMLConfig{
+				IdpEntityId:    idp.EntityID(),
+				IdpSsoUrl:      idp.SSOURL(),
+				IdpCertificate: saml.EncodeCertificates([]*x509.Certificate{idp.Certificate()}),
+			},
+		}},
+	}))
+	require.NoError(t, err)
+	samlConfig := updated.Msg.GetOrganization().GetSettings().GetSamlConfig()
+	require.Equal(t, "https://cloud.e2e.test/auth/saml/metadata/"+org.GetId(), samlConfig.GetSpEntityId())
+	require.Equal(t, "https://cloud.e2e.test/auth/saml/acs", samlConfig.GetSpAcsUrl())
+
+	_, err = env.identity.ConfigureSAML(ctx, &service.ConfigureSAMLInput{
+		OrganizationID: orgID,
+		Enabled:        true,
+		AttributeMapping: &service.SAMLAttributeMapping{
+			Name:  "displayName",
+			Role:  "groups",
+			Roles: map[string]string{"temporal-admins": "admin", "engineering": "developer"},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := http.Get(env.url + service.SAMLMetadataPath + org.GetId())
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The SAML procedures need no credentials.
+	anonymous := cloudv1connect.NewIdentityServiceClient(http.DefaultClient, env.url)
+	login := func(user samltest.User) (samlResponse, relayState string) {
+		idp.SetUser(user)
+		initiated, err := anonymous.InitiateSAMLLogin(ctx, connect.NewRequest(&cloudv1.InitiateSAMLLoginRequest{
+			OrganizationSlug: org.GetSlug(),
+		}))
+		require.NoError(t, err)
+		samlResponse, relayState, err = idp.Login(initiated.Msg.GetSamlRequestUrl())
+		require.NoError(t, err)
+		return samlResponse, relayState
+	}
+
+	// Until the organization verifies the domain, its IdP cannot sign in or
+	// create users who are not members.
+	samlResponse, relayState := login(samltest.User{NameID: "sso-user@example.com"})
+	_, err = anonymous.CompleteSAMLLogin(ctx, connect.NewRequest(&cloudv1.CompleteSAMLLoginRequest{
+		SamlResponse: samlResponse,
+		RelayState:   relayState,
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	added, err := env.identityAPI.AddDomain(ctx, connect.NewRequest(&cloudv1.AddDomainRequest{
+		OrganizationId: org.GetId(), Domain: "Example.com.",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "example.com", added.Msg.GetDomain().GetDomain())
+	require.Equal(t, "_temporal-cloud-verification.example.com", added.Msg.GetDomain().GetVerificationRecord())
+	require.Nil(t, added.Msg.GetDomain().GetVerifiedAt())
+	_, err = env.identityAPI.VerifyDomain(ctx, connect.NewRequest(&cloudv1.VerifyDomainRequest{
+		OrganizationId: org.GetId(), Domain: "example.com",
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "the TXT record is not published")
+	claimed, err := env.repos.Domains.Get(ctx, orgID, "example.com")
+	require.NoError(t, err)
+	require.NoError(t, env.repos.Domains.MarkVerified(ctx, claimed, time.Now()))
+
+	// Another organization cannot verify the same domain.
+	rival := env.createOrganization(t, "Rival Org")
+	rivalClaim, err := env.identity.AddDomain(ctx, uuid.MustParse(rival.GetId()), "example.com")
+	require.NoError(t, err)
+	require.ErrorIs(t, env.repos.Domains.MarkVerified(ctx, rivalClaim, time.Now()), repository.ErrDomainVerifiedElsewhere)
+
+	// Existing users outside the verified domains are not linked.
+	outsider, err := env.identity.CreateUser(ctx, "victim@elsewhere.test", "Victim")
+	require.NoError(t, err)
+	samlResponse, relayState = login(samltest.User{NameID: "victim@elsewhere.test"})
+	_, err = anonymous.CompleteSAMLLogin(ctx, connect.NewRequest(&cloudv1.CompleteSAMLLoginRequest{
+		SamlResponse: samlResponse,
+		RelayState:   relayState,
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	member, err := env.repos.Organizations.GetMember(ctx, orgID, outsider.ID)
+	require.NoError(t, err)
+	require.Nil(t, member)
+
+	// A new user is provisioned with the role their groups map to, and gets
+	// a token for the organization.
+	samlResponse, relayState = login(samltest.User{
+		NameID: "sso-user@example.com",
+		Attributes: map[string][]string{
+			"displayName": {"SSO User"},
+			"groups":      {"engineering", "temporal-admins"},
+		},
+	})
+	completed, err := anonymous.CompleteSAMLLogin(ctx, connect.NewRequest(&cloudv1.CompleteSAMLLoginRequest{
+		SamlResponse: samlResponse,
+		RelayState:   relayState,
+	}))
+	require.NoError(t, err)
+	claims, err := env.identity.ValidateToken(ctx, completed.Msg.GetAccessToken())
+	require.NoError(t, err)
+	require.Equal(t, "sso-user@example.com", claims["email"])
+	require.Equal(t, org.GetId(), claims["org_id"])
+	require.Equal(t, "admin", claims["role"])
+
+	user, err := env.repos.Users.GetByEmail(ctx, "sso-user@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "SSO User", user.Name.String)
+	require.True(t, user.EmailVerified)
+
+	// Refreshing keeps the session scoped to the organization.
+	refreshed, err := env.auth.RefreshAccessToken(ctx, completed.Msg.GetRefreshToken())
+	require.NoError(t, err)
+	claims, err = env.identity.ValidateToken(ctx, refreshed)
+	require.NoError(t, err)
+	require.Equal(t, org.GetId(), claims["org_id"])
+	require.Equal(t, "admin", claims["role"])
+
+	// A response completes one login only.
+	_, err = anonymous.CompleteSAMLLogin(ctx, connect.NewRequest(&cloudv1.CompleteSAMLLoginRequest{
+		SamlResponse: samlResponse,
+		RelayState:   relayState,
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// The ACS endpoint sets the auth cookies and redirects the browser.
+	samlResponse, relayState = login(samltest.User{
+		NameID:     "sso-user@example.com",
+		Attributes: map[string][]string{"groups": {"engineering"}},
+	})
+	noRedirects := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err = noRedirects.PostForm(env.url+service.SAMLACSPath, url.Values{
+		"SAMLResponse": {samlResponse},
+		"RelayState":   {relayState},
+	})
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+	var accessToken string
+	for _, c := range resp.Cookies() {
+		if c.Name == "access_token" {
+			accessToken = c.Value
+		}
+	}
+	claims, err = env.identity.ValidateToken(ctx, accessToken)
+	require.NoError(t, err)
+	require.Equal(t, "developer", claims["role"])
+
+	// Owners keep their role whatever the IdP says.
+	require.NoError(t, env.repos.Organizations.AddMember(ctx, &repository.OrganizationMember{
+		OrganizationID: orgID, UserID: user.ID, Role: "owner",
+	}))
+	samlResponse, relayState = login(samltest.User{NameID: "sso-user@example.com"})
+	completed, err = anonymous.CompleteSAMLLogin(ctx, connect.NewRequest(&cloudv1.CompleteSAMLLoginRequest{
+		SamlResponse: samlResponse,
+		RelayState:   relayState,
+	}))
+	require.NoError(t, err)
+	claims, err = env.identity.ValidateToken(ctx, completed.Msg.GetAccessToken())
+	require.NoError(t, err)
+	require.Equal(t, "owner", claims["role"])
+
+	// Members who leave cannot refresh their session.
+	require.NoError(t, env.repos.Organizations.RemoveMember(ctx, orgID, user.ID))
+	_, err = env.auth.RefreshAccessToken(ctx, completed.Msg.GetRefreshToken())
+	require.Error(t, err)
+
+	// Responses signed by anyone but the configured IdP are rejected.
+	other := samltest.NewIdP()
+	defer other.Close()
+	initiated, err := anonymous.InitiateSAMLLogin(ctx, connect.NewRequest(&cloudv1.InitiateSAMLLoginRequest{
+		OrganizationSlug: org.GetSlug(),
+	}))
+	require.NoError(t, err)
+	authnRequest, err := samltest.ParseAuthnRequestURL(initiated.Msg.GetSamlRequestUrl())
+	require.NoError(t, err)
+	forged := other.NewResponse(authnRequest)
+	forged.Issuer = idp.EntityID()
+	forged.User = samltest.User{NameID: "e2e@example.com"}
+	encoded, err := other.Encode(forged)
+	require.NoError(t, err)
+	_, err = anonymous.CompleteSAMLLogin(ctx, connect.NewRequest(&cloudv1.CompleteSAMLLoginRequest{
+		SamlResponse: encoded,
+		RelayState:   authnRequest.Relay,
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// Logins may not redirect to arbitrary sites.
+	_, err = anonymous.InitiateSAMLLogin(ctx, connect.NewRequest(&cloudv1.InitiateSAMLLoginRequest{
+		OrganizationSlug: org.GetSlug(),
+		RedirectUrl:      "https://evil.example.com/",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestE2E_SCIM(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "SCIM Org")
+	orgID := uuid.MustParse(org.GetId())
+
+	// SCIM cannot be enabled before a token is issued.
+	_, err := env.orgs.UpdateOrganization(ctx, connect.NewRequest(&cloudv1.UpdateOrganizationRequest{
+		OrganizationId: org.GetId(),
+		Organization:   &cloudv1.Organization{Settings: &cloudv1.OrganizationSettings{ScimEnabled: true}},
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	rotated, err := env.identityAPI.RotateSCIMToken(ctx, connect.NewRequest(&cloudv1.RotateSCIMTokenRequest{
+		OrganizationId: org.GetId(),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "https://cloud.e2e.test/scim/v2", rotated.Msg.GetScimBaseUrl())
+	token := rotated.Msg.GetToken()
+
+	do := func(method, path, token string, body any) (int, map[string]any) {
+		t.Helper()
+		var reader io.Reader
+		if body != nil {
+			b, err := json.Marshal(body)
+			require.NoError(t, err)
+			reader = bytes.NewReader(b)
+		}
+		req, err := http.NewRequest(method, env.url+service.SCIMBasePath+path, reader)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", scim.ContentType)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		var out map[string]any
+		if resp.StatusCode != http.StatusNoContent {
+			require.Equal(t, scim.ContentType, resp.Header.Get("Content-Type"))
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+		}
+		return resp.StatusCode, out
+	}
+
+	status, _ := do(http.MethodGet, "/Users", "scim_wrong", nil)
+	require.Equal(t, http.StatusUnauthorized, status)
+	status, _ = do(http.MethodGet, "/ServiceProviderConfig", token, nil)
+	require.Equal(t, http.StatusOK, status)
+
+	// Users are provisioned as active members with the default role.
+	status, created := do(http.MethodPost, "/Users", token, map[string]any{
+		"schemas":    []string{scim.SchemaUser},
+		"userName":   "scim-user@example.com",
+		"externalId": "idp-1",
+		"name":       map[string]string{"givenName": "Scim", "familyName": "User"},
+	})
+	require.Equal(t, http.StatusCreated, status)
+	require.Equal(t, true, created["active"])
+	userID := created["id"].(string)
+	member, err := env.repos.Organizations.GetMember(ctx, orgID, uuid.MustParse(userID))
+	require.NoError(t, err)
+	require.Equal(t, "read_only", member.Role)
+
+	status, conflict := do(http.MethodPost, "/Users", token, map[string]any{
+		"schemas":  []string{scim.SchemaUser},
+		"userName": "scim-user@example.com",
+	})
+	require.Equal(t, http.StatusConflict, status)
+	require.Equal(t, scim.ErrorUniqueness, conflict["scimType"])
+
+	status, list := do(http.MethodGet, "/Users?filter="+url.QueryEscape(`userName eq "scim-user@example.com"`), token, nil)
+	require.Equal(t, http.StatusOK, status)
+	require.EqualValues(t, 1, list["totalResults"])
+
+	// Provisioning and renaming an existing user changes the name this
+	// organization sees, not the user's own profile.
+	shared, err := env.identity.CreateUser(ctx, "shared@example.com", "Shared User")
+	require.NoError(t, err)
+	status, existing := do(http.MethodPost, "/Users", token, map[string]any{
+		"schemas":     []string{scim.SchemaUser},
+		"userName":    shared.Email,
+		"displayName": "Renamed By IdP",
+	})
+	require.Equal(t, http.StatusCreated, status)
+	require.Equal(t, "Renamed By IdP", existing["displayName"])
+	status, _ = do(http.MethodPatch, "/Users/"+existing["id"].(string), token, map[string]any{
+		"schemas":    []string{scim.SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "replace", "path": "name.givenName", "value": "Mallory"}},
+	})
+	require.Equal(t, http.StatusOK, status)
+	profile, err := env.repos.Users.GetByID(ctx, shared.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Shared User", profile.Name.String)
+
+	// Groups grant their members namespace permissions.
+	_, err = env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_BUSINESS,
+	}))
+	require.NoError(t, err)
+	ns, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: org.GetId(),
+		Name:           "scim",
+		Region:         "us-east-1",
+	}))
+	require.NoError(t, err)
+	nsID := ns.Msg.GetNamespace().GetId()
+
+	status, group := do(http.MethodPost, "/Groups", token, map[string]any{
+		"schemas":     []string{scim.SchemaGroup},
+		"displayName": "Engineering",
+	})
+	require.Equal(t, http.StatusCreated, status)
+	groupID := group["id"].(string)
+	_, err = env.identityAPI.SetUserGroupNamespacePermissions(ctx, connect.NewRequest(&cloudv1.SetUserGroupNamespacePermissionsRequest{
+		OrganizationId:       org.GetId(),
+		GroupId:              groupID,
+		NamespacePermissions: []*cloudv1.NamespacePermission{{NamespaceId: nsID, Permission: "write"}},
+	}))
+	require.NoError(t, err)
+
+	perms, err := env.identity.UserPermissions(ctx, uuid.MustParse(userID), orgID)
+	require.NoError(t, err)
+	require.Empty(t, perms)
+
+	status, group = do(http.MethodPatch, "/Groups/"+groupID, token, map[string]any{
+		"schemas":    []string{scim.SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "add", "path": "members", "value": []map[string]string{{"value": userID}}}},
+	})
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, group["members"], 1)
+
+	perms, err = env.identity.UserPermissions(ctx, uuid.MustParse(userID), orgID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"namespace_write:" + nsID}, perms)
+
+	// A direct grant above the group's wins.
+	require.NoError(t, env.repos.Users.SetNamespacePermission(ctx, &repository.UserNamespacePermission{
+		UserID: uuid.MustParse(userID), NamespaceID: nsID, Permission: "admin",
+	}))
+	perms, err = env.identity.UserPermissions(ctx, uuid.MustParse(userID), orgID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"namespace_admin:" + nsID}, perms)
+
+	// Organization tokens of the user carry the permissions until the user is
+	// deactivated.
+	userToken, _, _, err := env.identity.GenerateTokens(ctx, uuid.MustParse(userID), "scim-user@example.com", orgID, "read_only")
+	require.NoError(t, err)
+	userClient := cloudv1connect.NewIdentityServiceClient(http.DefaultClient, env.url, connect.WithInterceptors(bearerToken(userToken)))
+	_, err = userClient.GetUser(ctx, connect.NewRequest(&cloudv1.GetUserRequest{}))
+	require.NoError(t, err)
+
+	status, patched := do(http.MethodPatch, "/Users/"+userID, token, map[string]any{
+		"schemas":    []string{scim.SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "Replace", "value": map[string]any{"active": "False"}}},
+	})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, false, patched["active"])
+	_, err = userClient.GetUser(ctx, connect.NewRequest(&cloudv1.GetUserRequest{}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	status, rejected := do(http.MethodPatch, "/Users/"+userID, token, map[string]any{
+		"schemas":    []string{scim.SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "replace", "path": "userName", "value": "other@example.com"}},
+	})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, scim.ErrorMutability, rejected["scimType"])
+
+	// Removing members and deleting users revoke group permissions.
+	status, group = do(http.MethodPatch, "/Groups/"+groupID, token, map[string]any{
+		"schemas":    []string{scim.SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "remove", "path": fmt.Sprintf(`members[value eq %q]`, userID)}},
+	})
+	require.Equal(t, http.StatusOK, status)
+	require.Empty(t, group["members"])
+
+	status, _ = do(http.MethodDelete, "/Users/"+userID, token, nil)
+	require.Equal(t, http.StatusNoContent, status)
+	status, _ = do(http.MethodGet, "/Users/"+userID, token, nil)
+	require.Equal(t, http.StatusNotFound, status)
+	member, err = env.repos.Organizations.GetMember(ctx, orgID, uuid.MustParse(userID))
+	require.NoError(t, err)
+	require.Nil(t, member)
+
+	groups, err := env.identityAPI.ListUserGroups(ctx, connect.NewRequest(&cloudv1.ListUserGroupsRequest{
+		OrganizationId: org.GetId(),
+	}))
+	require.NoError(t, err)
+	require.Len(t, groups.Msg.GetUserGroups(), 1)
+	require.Equal(t, "Engineering", groups.Msg.GetUserGroups()[0].GetName())
+
+	// Disabling SCIM revokes the token.
+	_, err = env.orgs.UpdateOrganization(ctx, connect.NewRequest(&cloudv1.UpdateOrganizationRequest{
+		OrganizationId: org.GetId(),
+		Organization:   &cloudv1.Organization{Settings: &cloudv1.OrganizationSettings{ScimEnabled: false}},
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"settings"}},
+	}))
+	require.NoError(t, err)
+	status, _ = do(http.MethodGet, "/Groups", token, nil)
+	require.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestE2E_AuditService(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Audit Org")
+	orgID := uuid.MustParse(org.GetId())
+
+	for _, action := range []string{"CreateNamespace", "DeleteNamespace", "CreateNamespace"} {
+		require.NoError(t, env.audit.LogEvent(ctx, &service.AuditEventInput{
+			OrganizationID: orgID,
+			ActorType:      "user",
+			ActorID:        env.user.ID.String(),
+			Action:         action,
+			Result:         "success",
+			ResourceType:   "namespace",
+			Details:        []byte(`{"name":"orders"}`),
+		}))
+	}
+
+	list, err := env.auditAPI.ListAuditEvents(ctx, connect.NewRequest(&cloudv1.ListAuditEventsRequest{
+		OrganizationId: org.GetId(),
+		Action:         "CreateNamespace",
+		PageSize:       1,
+	}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetEvents(), 1)
+	require.NotEmpty(t, list.Msg.GetNextPageToken())
+	event := list.Msg.GetEvents()[0]
+	require.Equal(t, cloudv1.AuditResult_AUDIT_RESULT_SUCCESS, event.GetResult())
+	require.Equal(t, "orders", event.GetDetails().GetFields()["name"].GetStringValue())
+
+	next, err := env.auditAPI.ListAuditEvents(ctx, connect.NewRequest(&cloudv1.ListAuditEventsRequest{
+		OrganizationId: org.GetId(),
+		Action:         "CreateNamespace",
+		PageSize:       1,
+		PageToken:      list.Msg.GetNextPageToken(),
+	}))
+	require.NoError(t, err)
+	require.Len(t, next.Msg.GetEvents(), 1)
+	require.Empty(t, next.Msg.GetNextPageToken())
+
+	got, err := env.auditAPI.GetAuditEvent(ctx, connect.NewRequest(&cloudv1.GetAuditEventRequest{EventId: event.GetId()}))
+	require.NoError(t, err)
+	require.Equal(t, event.GetAction(), got.Msg.GetEvent().GetAction())
+	_, err = env.auditAPI.GetAuditEvent(ctx, connect.NewRequest(&cloudv1.GetAuditEventRequest{EventId: uuid.NewString()}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestE2E_AuditExport(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Audit Export Org")
+	orgID := uuid.MustParse(org.GetId())
+
+	for _, action := range []string{"CreateNamespace", "UpdateNamespace", "DeleteNamespace"} {
+		require.NoError(t, env.audit.LogEvent(ctx, &service.AuditEventInput{
+			OrganizationID: orgID,
+			ActorType:      "user",
+			ActorID:        env.user.ID.String(),
+			Action:         action,
+			Result:         "success",
+			ResourceType:   "namespace",
+		}))
+	}
+	// Events only become exportable after service.AuditExportDelay.
+	_, err := env.db.DB().ExecContext(ctx, `
+		UPDATE audit_events SET created_at = created_at - INTERVAL '1 minute' WHERE organization_id = $1
+	`, orgID)
+	require.NoError(t, err)
+
+	var batches []*auditexport.Batch
+	var token string
+	for {
+		resp, err := env.auditAPI.ExportAuditEvents(ctx, connect.NewRequest(&cloudv1.ExportAuditEventsRequest{
+			OrganizationId: org.GetId(),
+			Format:         cloudv1.ExportFormat_EXPORT_FORMAT_OCSF,
+			PageSize:       2,
+			PageToken:      token,
+		}))
+		require.NoError(t, err)
+		if m := resp.Msg.GetManifest(); m != nil {
+			batches = append(batches, &auditexport.Batch{
+				Manifest: auditexport.Manifest{
+					OrganizationID: orgID,
+					Format:         auditexport.FormatOCSF,
+					Sequence:       m.GetSequence(),
+					PreviousHash:   m.GetPreviousHash(),
+					Hash:           m.GetHash(),
+					Events:         int(m.GetEventCount()),
+					FirstEventID:   uuid.MustParse(m.GetFirstEventId()),
+					LastEventID:    uuid.MustParse(m.GetLastEventId()),
+				},
+				Data: resp.Msg.GetData(),
+			})
+		}
+		token = resp.Msg.GetNextPageToken()
+		if !resp.Msg.GetHasMore() {
+			break
+		}
+	}
+	require.Len(t, batches, 2)
+	require.Equal(t, 2, batches[0].Events)
+	require.Equal(t, 1, batches[1].Events)
+	key, err := env.auditAPI.GetAuditChainKey(ctx, connect.NewRequest(&cloudv1.GetAuditChainKeyRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.NoError(t, auditexport.VerifyChain(key.Msg.GetKey(), "", batches))
+	require.Contains(t, string(batches[1].Data), `"class_uid":6003`)
+
+	// The final token continues the chain once new events are recorded.
+	resp, err := env.auditAPI.ExportAuditEvents(ctx, connect.NewRequest(&cloudv1.ExportAuditEventsRequest{
+		OrganizationId: org.GetId(),
+		Format:         cloudv1.ExportFormat_EXPORT_FORMAT_OCSF,
+		PageToken:      token,
+	}))
+	require.NoError(t, err)
+	require.Nil(t, resp.Msg.GetManifest())
+	require.Equal(t, token, resp.Msg.GetNextPageToken())
+
+	_, err = env.auditAPI.ExportAuditEvents(ctx, connect.NewRequest(&cloudv1.ExportAuditEventsRequest{
+		OrganizationId: org.GetId(),
+		PageToken:      "not-a-token",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestE2E_AuditStreams(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Audit Stream Org")
+
+	created, err := env.auditAPI.CreateAuditStream(ctx, connect.NewRequest(&cloudv1.CreateAuditStreamRequest{
+		OrganizationId: org.GetId(),
+		Stream: &cloudv1.AuditStream{
+			Name:    "siem",
+			Format:  cloudv1.ExportFormat_EXPORT_FORMAT_CEF,
+			Enabled: true,
+			Webhook: &cloudv1.WebhookAuditDestination{Url: "https://siem.example.com/ingest", Secret: "s3cret"},
+		},
+	}))
+	require.NoError(t, err)
+	stream := created.Msg.GetStream()
+	require.Equal(t, cloudv1.ExportFormat_EXPORT_FORMAT_CEF, stream.GetFormat())
+	require.Empty(t, stream.GetWebhook().GetSecret())
+	require.Zero(t, stream.GetLastSequence())
+	require.NotNil(t, stream.GetCursorTime())
+
+	_, err = env.auditAPI.CreateAuditStream(ctx, connect.NewRequest(&cloudv1.CreateAuditStreamRequest{
+		OrganizationId: org.GetId(),
+		Stream: &cloudv1.AuditStream{
+			Name: "siem",
+			S3:   &cloudv1.S3AuditDestination{Bucket: "audit", Region: "us-east-1", RoleArn: "arn:aws:iam::123456789012:role/audit"},
+		},
+	}))
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	_, err = env.auditAPI.CreateAuditStream(ctx, connect.NewRequest(&cloudv1.CreateAuditStreamRequest{
+		OrganizationId: org.GetId(),
+		Stream:         &cloudv1.AuditStream{Name: "archive", S3: &cloudv1.S3AuditDestination{Bucket: "audit", Region: "us-east-1"}},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = env.auditAPI.CreateAuditStream(ctx, connect.NewRequest(&cloudv1.CreateAuditStreamRequest{
+		OrganizationId: org.GetId(),
+		Stream:         &cloudv1.AuditStream{Name: "internal", Webhook: &cloudv1.WebhookAuditDestination{Url: "http://169.254.169.254/latest/meta-data"}},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// Updating a webhook without a secret keeps its secret.
+	_, err = env.auditAPI.UpdateAuditStream(ctx, connect.NewRequest(&cloudv1.UpdateAuditStreamRequest{
+		StreamId: stream.GetId(),
+		Stream: &cloudv1.AuditStream{
+			Name:    "siem",
+			Format:  cloudv1.ExportFormat_EXPORT_FORMAT_NDJSON,
+			Webhook: &cloudv1.WebhookAuditDestination{Url: "https://siem.example.com/v2/ingest"},
+		},
+	}))
+	require.NoError(t, err)
+	saved, err := env.repos.AuditStreams.GetByID(ctx, uuid.MustParse(stream.GetId()))
+	require.NoError(t, err)
+	require.False(t, saved.Enabled)
+	var cfg auditexport.WebhookConfig
+	require.NoError(t, json.Unmarshal(saved.Config, &cfg))
+	require.Equal(t, "https://siem.example.com/v2/ingest", cfg.URL)
+	require.Equal(t, "s3cret", cfg.Secret)
+
+	list, err := env.auditAPI.ListAuditStreams(ctx, connect.NewRequest(&cloudv1.ListAuditStreamsRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetStreams(), 1)
+	require.Equal(t, cloudv1.ExportFormat_EXPORT_FORMAT_NDJSON, list.Msg.GetStreams()[0].GetFormat())
+
+	_, err = env.auditAPI.DeleteAuditStream(ctx, connect.NewRequest(&cloudv1.DeleteAuditStreamRequest{StreamId: stream.GetId()}))
+	require.NoError(t, err)
+	_, err = env.auditAPI.GetAuditStream(ctx, connect.NewRequest(&cloudv1.GetAuditStreamRequest{StreamId: stream.GetId()}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestE2E_Invitations(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Invite Org")
+
+	// orgsAs returns an organization client for a new user with the email.
+	orgsAs := func(email string) (*repository.User, cloudv1connect.OrganizationServiceClient) {
+		user, err := env.identity.CreateUser(ctx, email, email)
+		require.NoError(t, err)
+		token, _, _, err := env.identity.GenerateTokens(ctx, user.ID, user.Email, uuid.Nil, "")
+		require.NoError(t, err)
+		return user, cloudv1connect.NewOrganizationServiceClient(http.DefaultClient, env.url, connect.WithInterceptors(bearerToken(token)))
+	}
+	invite := func(email string) *cloudv1.Invitation {
+		resp, err := env.orgs.InviteUser(ctx, connect.NewRequest(&cloudv1.InviteUserRequest{
+			OrganizationId: org.GetId(),
+			Email:          email,
+			Role:           cloudv1.OrganizationRole_ORGANIZATION_ROLE_DEVELOPER,
+		}))
+		require.NoError(t, err)
+		return resp.Msg.GetInvitation()
+	}
+
+	inv := invite("Invitee@Example.com")
+	require.Equal(t, "invitee@example.com", inv.GetEmail())
+	require.Equal(t, cloudv1.InvitationStatus_INVITATION_STATUS_PENDING, inv.GetStatus())
+	require.EqualValues(t, 1, inv.GetSendCount())
+	token := env.invitationToken(t, "invitee@example.com")
+
+	_, err := env.orgs.InviteUser(ctx, connect.NewRequest(&cloudv1.InviteUserRequest{
+		OrganizationId: org.GetId(),
+		Email:          env.user.Email,
+		Role:           cloudv1.OrganizationRole_ORGANIZATION_ROLE_DEVELOPER,
+	}))
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	// Resends are throttled, and replace the token.
+	_, err = env.orgs.ResendInvitation(ctx, connect.NewRequest(&cloudv1.ResendInvitationRequest{InvitationId: inv.GetId()}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	_, err = env.db.DB().ExecContext(ctx, `UPDATE user_invitations SET last_sent_at = NOW() - INTERVAL '1 hour' WHERE id = $1`, inv.GetId())
+	require.NoError(t, err)
+	resent, err := env.orgs.ResendInvitation(ctx, connect.NewRequest(&cloudv1.ResendInvitationRequest{InvitationId: inv.GetId()}))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resent.Msg.GetInvitation().GetSendCount())
+	newToken := env.invitationToken(t, "invitee@example.com")
+	require.NotEqual(t, token, newToken)
+
+	// Only the invited user can accept, with the latest token.
+	_, strangerOrgs := orgsAs("stranger@example.com")
+	_, err = strangerOrgs.AcceptInvitation(ctx, connect.NewRequest(&cloudv1.AcceptInvitationRequest{Token: newToken}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	invitee, inviteeOrgs := orgsAs("invitee@example.com")
+	_, err = inviteeOrgs.AcceptInvitation(ctx, connect.NewRequest(&cloudv1.AcceptInvitationRequest{Token: token}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = inviteeOrgs.AcceptInvitation(ctx, connect.NewRequest(&cloudv1.AcceptInvitationRequest{Token: "not-a-token"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	accepted, err := inviteeOrgs.AcceptInvitation(ctx, connect.NewRequest(&cloudv1.AcceptInvitationRequest{Token: newToken}))
+	require.NoError(t, err)
+	require.Equal(t, org.GetId(), accepted.Msg.GetOrganization().GetId())
+	require.Equal(t, cloudv1.InvitationStatus_INVITATION_STATUS_ACCEPTED, accepted.Msg.GetInvitation().GetStatus())
+	_, err = inviteeOrgs.AcceptInvitation(ctx, connect.NewRequest(&cloudv1.AcceptInvitationRequest{Token: newToken}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	member, err := env.repos.Organizations.GetMember(ctx, uuid.MustParse(org.GetId()), invitee.ID)
+	require.NoError(t, err)
+	require.Equal(t, "developer", member.Role)
+	_, err = env.orgs.RevokeInvitation(ctx, connect.NewRequest(&cloudv1.RevokeInvitationRequest{InvitationId: inv.GetId()}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// Declining.
+	declined := invite("decliner@example.com")
+	_, declinerOrgs := orgsAs("decliner@example.com")
+	_, err = declinerOrgs.DeclineInvitation(ctx, connect.NewRequest(&cloudv1.DeclineInvitationRequest{Token: env.invitationToken(t, "decliner@example.com")}))
+	require.NoError(t, err)
+	_, err = declinerOrgs.GetOrganization(ctx, connect.NewRequest(&cloudv1.GetOrganizationRequest{OrganizationId: org.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// Expired invitations cannot be accepted, and are cleaned up.
+	expired := invite("late@example.com")
+	_, err = env.db.DB().ExecContext(ctx, `UPDATE user_invitations SET expires_at = NOW() - INTERVAL '1 hour' WHERE id = $1`, expired.GetId())
+	require.NoError(t, err)
+	_, lateOrgs := orgsAs("late@example.com")
+	_, err = lateOrgs.AcceptInvitation(ctx, connect.NewRequest(&cloudv1.AcceptInvitationRequest{Token: env.invitationToken(t, "late@example.com")}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	list, err := env.orgs.ListInvitations(ctx, connect.NewRequest(&cloudv1.ListInvitationsRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	statuses := map[string]cloudv1.InvitationStatus{}
+	for _, i := range list.Msg.GetInvitations() {
+		statuses[i.GetId()] = i.GetStatus()
+	}
+	require.Equal(t, map[string]cloudv1.InvitationStatus{
+		inv.GetId():      cloudv1.InvitationStatus_INVITATION_STATUS_ACCEPTED,
+		declined.GetId(): cloudv1.InvitationStatus_INVITATION_STATUS_DECLINED,
+		expired.GetId():  cloudv1.InvitationStatus_INVITATION_STATUS_EXPIRED,
+	}, statuses)
+
+	deleted, err := env.repos.Users.DeleteExpiredInvitations(ctx, time.Now())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted)
+
+	// Revoking.
+	revoked := invite("revoked@example.com")
+	_, err = env.orgs.RevokeInvitation(ctx, connect.NewRequest(&cloudv1.RevokeInvitationRequest{InvitationId: revoked.GetId()}))
+	require.NoError(t, err)
+	_, revokedOrgs := orgsAs("revoked@example.com")
+	_, err = revokedOrgs.AcceptInvitation(ctx, connect.NewRequest(&cloudv1.AcceptInvitationRequest{Token: env.invitationToken(t, "revoked@example.com")}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestE2E_NexusEndpoints(t *testing.T) {
+	env := newE2EEnv(t)
+	ctx := context.Background()
+	org := env.createOrganization(t, "Nexus Org")
+
+	_, err := env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: org.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_BUSINESS,
+	}))
+	require.NoError(t, err)
+	var nsIDs []string
+	for _, name := range []string{"orders", "billing"} {
+		created, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+			OrganizationId: org.GetId(),
+			Name:           name,
+			Region:         "us-east-1",
+		}))
+		require.NoError(t, err)
+		nsIDs = append(nsIDs, created.Msg.GetNamespace().GetId())
+	}
+	target, caller := nsIDs[0], nsIDs[1]
+
+	spec := &cloudv1.NexusEndpointSpec{
+		Name:                      "orders-api",
+		Description:               "Order operations",
+		TargetNamespaceId:         target,
+		TargetTaskQueue:           "orders-nexus",
+		AllowedCallerNamespaceIds: []string{caller, caller},
+	}
+	created, err := env.namespaces.CreateNexusEndpoint(ctx, connect.NewRequest(&cloudv1.CreateNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		Spec:           spec,
+	}))
+	require.NoError(t, err)
+	endpointID := created.Msg.GetEndpoint().GetId()
+	require.Equal(t, cloudv1.NexusEndpointState_NEXUS_ENDPOINT_STATE_PENDING, created.Msg.GetEndpoint().GetState())
+	require.Equal(t, []string{caller}, created.Msg.GetEndpoint().GetSpec().GetAllowedCallerNamespaceIds())
+
+	_, err = env.namespaces.CreateNexusEndpoint(ctx, connect.NewRequest(&cloudv1.CreateNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		Spec:           spec,
+	}))
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	_, err = env.namespaces.CreateNexusEndpoint(ctx, connect.NewRequest(&cloudv1.CreateNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		Spec: &cloudv1.NexusEndpointSpec{
+			Name:              "no-callers",
+			TargetNamespaceId: target,
+			TargetTaskQueue:   "orders-nexus",
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// Namespaces of other organizations can be neither targets nor callers.
+	otherOrg := env.createOrganization(t, "Other Nexus Org")
+	_, err = env.billingAPI.UpdateSubscription(ctx, connect.NewRequest(&cloudv1.UpdateSubscriptionRequest{
+		OrganizationId: otherOrg.GetId(),
+		Plan:           cloudv1.PlanTier_PLAN_TIER_BUSINESS,
+	}))
+	require.NoError(t, err)
+	otherNS, err := env.namespaces.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{
+		OrganizationId: otherOrg.GetId(),
+		Name:           "intruder",
+		Region:         "us-east-1",
+	}))
+	require.NoError(t, err)
+	_, err = env.namespaces.UpdateNexusEndpoint(ctx, connect.NewRequest(&cloudv1.UpdateNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		EndpointId:     endpointID,
+		Spec: &cloudv1.NexusEndpointSpec{
+			TargetNamespaceId:         target,
+			TargetTaskQueue:           "orders-nexus",
+			AllowedCallerNamespaceIds: []string{otherNS.Msg.GetNamespace().GetId()},
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	updated, err := env.namespaces.UpdateNexusEndpoint(ctx, connect.NewRequest(&cloudv1.UpdateNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		EndpointId:     endpointID,
+		Spec: &cloudv1.NexusEndpointSpec{
+			TargetNamespaceId:         target,
+			TargetTaskQueue:           "orders-nexus-v2",
+			AllowedCallerNamespaceIds: []string{caller, target},
+		},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "orders-api", updated.Msg.GetEndpoint().GetSpec().GetName())
+	require.Equal(t, "orders-nexus-v2", updated.Msg.GetEndpoint().GetSpec().GetTargetTaskQueue())
+	require.Len(t, updated.Msg.GetEndpoint().GetSpec().GetAllowedCallerNamespaceIds(), 2)
+	_, err = env.namespaces.UpdateNexusEndpoint(ctx, connect.NewRequest(&cloudv1.UpdateNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		EndpointId:     endpointID,
+		Spec: &cloudv1.NexusEndpointSpec{
+			Name:                      "renamed",
+			TargetNamespaceId:         target,
+			TargetTaskQueue:           "orders-nexus",
+			AllowedCallerNamespaceIds: []string{caller},
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	list, err := env.namespaces.ListNexusEndpoints(ctx, connect.NewRequest(&cloudv1.ListNexusEndpointsRequest{OrganizationId: org.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetEndpoints(), 1)
+	_, err = env.namespaces.GetNexusEndpoint(ctx, connect.NewRequest(&cloudv1.GetNexusEndpointRequest{
+		OrganizationId: otherOrg.GetId(),
+		EndpointId:     endpointID,
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// Deleted endpoints are kept until they are removed from their cluster.
+	_, err = env.namespaces.DeleteNexusEndpoint(ctx, connect.NewRequest(&cloudv1.DeleteNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		EndpointId:     endpointID,
+	}))
+	require.NoError(t, err)
+	got, err := env.namespaces.GetNexusEndpoint(ctx, connect.NewRequest(&cloudv1.GetNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		EndpointId:     endpointID,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, cloudv1.NexusEndpointState_NEXUS_ENDPOINT_STATE_DELETING, got.Msg.GetEndpoint().GetState())
+	_, err = env.namespaces.DeleteNexusEndpoint(ctx, connect.NewRequest(&cloudv1.DeleteNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		EndpointId:     endpointID,
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.NoError(t, env.repos.Nexus.DeleteEndpoint(ctx, uuid.MustParse(endpointID)))
+	_, err = env.namespaces.GetNexusEndpoint(ctx, connect.NewRequest(&cloudv1.GetNexusEndpointRequest{
+		OrganizationId: org.GetId(),
+		EndpointId:     endpointID,
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/interceptors"
+)
+
+// toConnectError translates errors returned by the service layer into Connect
+// errors. Service errors keep their code and message; anything else is treated
+// as an internal error and its details are not leaked to the caller.
+func toConnectError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	var svcErr serviceerror.ServiceError
+	if errors.As(err, &svcErr) {
+		st := svcErr.Status()
+		return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+}
+
+func invalidArgument(msg string) error {
+	return connect.NewError(connect.CodeInvalidArgument, errors.New(msg))
+}
+
+func notFound(msg string) error {
+	return connect.NewError(connect.CodeNotFound, errors.New(msg))
+}
+
+// authInfo returns the caller's auth info or an Unauthenticated error if the
+// request was not authenticated.
+func authInfo(ctx context.Context) (*interceptors.AuthInfo, error) {
+	info := interceptors.GetAuthInfo(ctx)
+	if info == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+	return info, nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+)
+
+func TestToConnectError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code connect.Code
+	}{
+		{"invalid argument", serviceerror.NewInvalidArgument("bad"), connect.CodeInvalidArgument},
+		{"not found", serviceerror.NewNotFound("missing"), connect.CodeNotFound},
+		{"already exists", serviceerror.NewAlreadyExists("dup"), connect.CodeAlreadyExists},
+		{"failed precondition", serviceerror.NewFailedPrecondition("no"), connect.CodeFailedPrecondition},
+		{"permission denied", serviceerror.NewPermissionDenied("denied", ""), connect.CodePermissionDenied},
+		{"wrapped", fmt.Errorf("outer: %w", serviceerror.NewNotFound("missing")), connect.CodeNotFound},
+		{"connect error", connect.NewError(connect.CodeAborted, nil), connect.CodeAborted},
+		{"canceled", context.Canceled, connect.CodeCanceled},
+		{"deadline", context.DeadlineExceeded, connect.CodeDeadlineExceeded},
+		{"unknown", errors.New("db exploded"), connect.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.code, connect.CodeOf(toConnectError(tt.err)))
+		})
+	}
+
+	require.NoError(t, toConnectError(nil))
+	require.NotContains(t, toConnectError(errors.New("db exploded")).Error(), "db exploded")
+}
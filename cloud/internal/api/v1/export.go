@@ -0,0 +1,233 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	"connectrpc.com/connect"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/export"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+const exportJobStatePrefix = "EXPORT_JOB_STATE_"
+
+// CreateExportSink implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) CreateExportSink(ctx context.Context, req *connect.Request[cloudv1.CreateExportSinkRequest]) (*connect.Response[cloudv1.CreateExportSinkResponse], error) {
+	input, err := exportSinkInput(req.Msg.GetNamespaceId(), req.Msg.GetSink())
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := h.service.CreateExportSink(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	externalID, err := h.service.ExportExternalID(ctx, sink.NamespaceID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateExportSinkResponse{Sink: exportSinkToProto(sink, externalID)}), nil
+}
+
+// GetExportSink implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) GetExportSink(ctx context.Context, req *connect.Request[cloudv1.GetExportSinkRequest]) (*connect.Response[cloudv1.GetExportSinkResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	sinkID, err := parseUUID("sink_id", req.Msg.GetSinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := h.service.GetExportSink(ctx, req.Msg.GetNamespaceId(), sinkID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	externalID, err := h.service.ExportExternalID(ctx, sink.NamespaceID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetExportSinkResponse{Sink: exportSinkToProto(sink, externalID)}), nil
+}
+
+// ListExportSinks implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) ListExportSinks(ctx context.Context, req *connect.Request[cloudv1.ListExportSinksRequest]) (*connect.Response[cloudv1.ListExportSinksResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+
+	sinks, err := h.service.ListExportSinks(ctx, req.Msg.GetNamespaceId())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	externalID, err := h.service.ExportExternalID(ctx, req.Msg.GetNamespaceId())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.ListExportSinksResponse{}
+	for _, sink := range sinks {
+		resp.Sinks = append(resp.Sinks, exportSinkToProto(sink, externalID))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateExportSink implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) UpdateExportSink(ctx context.Context, req *connect.Request[cloudv1.UpdateExportSinkRequest]) (*connect.Response[cloudv1.UpdateExportSinkResponse], error) {
+	sinkID, err := parseUUID("sink_id", req.Msg.GetSinkId())
+	if err != nil {
+		return nil, err
+	}
+	input, err := exportSinkInput(req.Msg.GetNamespaceId(), req.Msg.GetSink())
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := h.service.UpdateExportSink(ctx, sinkID, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	externalID, err := h.service.ExportExternalID(ctx, sink.NamespaceID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateExportSinkResponse{Sink: exportSinkToProto(sink, externalID)}), nil
+}
+
+// DeleteExportSink implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) DeleteExportSink(ctx context.Context, req *connect.Request[cloudv1.DeleteExportSinkRequest]) (*connect.Response[cloudv1.DeleteExportSinkResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	sinkID, err := parseUUID("sink_id", req.Msg.GetSinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteExportSink(ctx, req.Msg.GetNamespaceId(), sinkID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeleteExportSinkResponse{}), nil
+}
+
+// ListExportJobs implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) ListExportJobs(ctx context.Context, req *connect.Request[cloudv1.ListExportJobsRequest]) (*connect.Response[cloudv1.ListExportJobsResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	sinkID, err := parseUUID("sink_id", req.Msg.GetSinkId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := h.service.ListExportJobs(ctx, req.Msg.GetNamespaceId(), sinkID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	jobs, nextPageToken := trimPage(page, jobs)
+
+	resp := &cloudv1.ListExportJobsResponse{NextPageToken: nextPageToken}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, exportJobToProto(job))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// exportSinkInput converts a sink and its destination from the API into
+// service input.
+func exportSinkInput(namespaceID string, sink *cloudv1.ExportSink) (*service.ExportSinkInput, error) {
+	if namespaceID == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	if sink == nil {
+		return nil, invalidArgument("sink is required")
+	}
+
+	input := &service.ExportSinkInput{
+		NamespaceID: namespaceID,
+		Name:        sink.GetName(),
+		Enabled:     sink.GetEnabled(),
+	}
+	var config any
+	switch {
+	case sink.GetS3() != nil && sink.GetGcs() != nil:
+		return nil, invalidArgument("sink must have exactly one destination")
+	case sink.GetS3() != nil:
+		s3 := sink.GetS3()
+		input.SinkType = export.SinkTypeS3
+		config = export.S3Config{
+			Bucket:  s3.GetBucket(),
+			Prefix:  s3.GetPrefix(),
+			Region:  s3.GetRegion(),
+			RoleARN: s3.GetRoleArn(),
+		}
+	case sink.GetGcs() != nil:
+		gcs := sink.GetGcs()
+		input.SinkType = export.SinkTypeGCS
+		config = export.GCSConfig{Bucket: gcs.GetBucket(), Prefix: gcs.GetPrefix(), ServiceAccount: gcs.GetServiceAccount()}
+	default:
+		return nil, invalidArgument("sink destination is required")
+	}
+	// The config types are plain structs and always marshal.
+	input.Config, _ = json.Marshal(config)
+	return input, nil
+}
+
+// exportSinkToProto converts a sink to the API. externalID is the external ID
+// S3 sinks present when assuming their role.
+func exportSinkToProto(sink *repository.ExportSink, externalID string) *cloudv1.ExportSink {
+	pb := &cloudv1.ExportSink{
+		Id:             sink.ID.String(),
+		Name:           sink.Name,
+		Enabled:        sink.Enabled,
+		LastExportTime: nullTimestamp(sink.LastExportAt),
+		CreatedAt:      timestampOrNil(sink.CreatedAt),
+		UpdatedAt:      timestampOrNil(sink.UpdatedAt),
+	}
+	// The config was validated when the sink was saved.
+	switch sink.SinkType {
+	case export.SinkTypeS3:
+		var cfg export.S3Config
+		_ = json.Unmarshal(sink.Config, &cfg)
+		pb.S3 = &cloudv1.S3ExportDestination{
+			Bucket:     cfg.Bucket,
+			Prefix:     cfg.Prefix,
+			Region:     cfg.Region,
+			RoleArn:    cfg.RoleARN,
+			ExternalId: externalID,
+		}
+	case export.SinkTypeGCS:
+		var cfg export.GCSConfig
+		_ = json.Unmarshal(sink.Config, &cfg)
+		pb.Gcs = &cloudv1.GCSExportDestination{Bucket: cfg.Bucket, Prefix: cfg.Prefix, ServiceAccount: cfg.ServiceAccount}
+	}
+	return pb
+}
+
+func exportJobToProto(job *repository.ExportJob) *cloudv1.ExportJob {
+	return &cloudv1.ExportJob{
+		Id:                job.ID.String(),
+		SinkId:            job.SinkID.String(),
+		State:             cloudv1.ExportJobState(stringToEnum(job.Status, exportJobStatePrefix, cloudv1.ExportJobState_value)),
+		WorkflowsExported: job.WorkflowsExported,
+		BytesExported:     job.BytesExported,
+		ErrorMessage:      job.ErrorMessage.String,
+		WindowStart:       timestampOrNil(job.WindowStart),
+		WindowEnd:         timestampOrNil(job.WindowEnd),
+		StartedAt:         nullTimestamp(job.StartedAt),
+		CompletedAt:       nullTimestamp(job.CompletedAt),
+	}
+}
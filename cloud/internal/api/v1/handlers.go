@@ -1,33 +1,36 @@
-// Package api provides gRPC API handlers for the Cloud API.
+// Package api provides Connect-RPC handlers for the Cloud API.
 package api
 
 import (
 	"net/http"
 
 	"connectrpc.com/connect"
+	"go.temporal.io/cloud/api/cloud/v1/cloudv1connect"
 	"go.temporal.io/cloud/internal/service"
 )
 
 // OrganizationHandler handles organization API requests.
 type OrganizationHandler struct {
-	service *service.OrganizationService
+	service     *service.OrganizationService
+	identity    *service.IdentityService
+	invitations *service.InvitationService
 }
 
-// NewOrganizationHandler creates a new organization handler.
-func NewOrganizationHandler(svc *service.OrganizationService) *OrganizationHandler {
-	return &OrganizationHandler{service: svc}
+// NewOrganizationHandler creates a new organization handler. The identity
+// service stores organizations' SSO settings.
+func NewOrganizationHandler(svc *service.OrganizationService, identitySvc *service.IdentityService, invitationSvc *service.InvitationService) *OrganizationHandler {
+	return &OrganizationHandler{service: svc, identity: identitySvc, invitations: invitationSvc}
 }
 
 // Path returns the base path for the handler.
 func (h *OrganizationHandler) Path() string {
-	return "/temporal.cloud.api.v1.OrganizationService/"
+	return "/" + cloudv1connect.OrganizationServiceName + "/"
 }
 
 // Handler returns the HTTP handler with interceptors.
 func (h *OrganizationHandler) Handler(opts ...connect.HandlerOption) http.Handler {
-	mux := http.NewServeMux()
-	// TODO: Register Connect handlers when proto is generated
-	return mux
+	_, handler := cloudv1connect.NewOrganizationServiceHandler(h, opts...)
+	return handler
 }
 
 // NamespaceHandler handles namespace API requests.
@@ -42,17 +45,19 @@ func NewNamespaceHandler(svc *service.NamespaceService) *NamespaceHandler {
 
 // Path returns the base path for the handler.
 func (h *NamespaceHandler) Path() string {
-	return "/temporal.cloud.api.v1.NamespaceService/"
+	return "/" + cloudv1connect.NamespaceServiceName + "/"
 }
 
 // Handler returns the HTTP handler with interceptors.
 func (h *NamespaceHandler) Handler(opts ...connect.HandlerOption) http.Handler {
-	mux := http.NewServeMux()
-	return mux
+	_, handler := cloudv1connect.NewNamespaceServiceHandler(h, opts...)
+	return handler
 }
 
 // BillingHandler handles billing API requests.
 type BillingHandler struct {
+	cloudv1connect.UnimplementedBillingServiceHandler
+
 	service *service.BillingService
 }
 
@@ -63,17 +68,19 @@ func NewBillingHandler(svc *service.BillingService) *BillingHandler {
 
 // Path returns the base path for the handler.
 func (h *BillingHandler) Path() string {
-	return "/temporal.cloud.api.v1.BillingService/"
+	return "/" + cloudv1connect.BillingServiceName + "/"
 }
 
 // Handler returns the HTTP handler with interceptors.
 func (h *BillingHandler) Handler(opts ...connect.HandlerOption) http.Handler {
-	mux := http.NewServeMux()
-	return mux
+	_, handler := cloudv1connect.NewBillingServiceHandler(h, opts...)
+	return handler
 }
 
 // IdentityHandler handles identity API requests.
 type IdentityHandler struct {
+	cloudv1connect.UnimplementedIdentityServiceHandler
+
 	service *service.IdentityService
 }
 
@@ -84,17 +91,19 @@ func NewIdentityHandler(svc *service.IdentityService) *IdentityHandler {
 
 // Path returns the base path for the handler.
 func (h *IdentityHandler) Path() string {
-	return "/temporal.cloud.api.v1.IdentityService/"
+	return "/" + cloudv1connect.IdentityServiceName + "/"
 }
 
 // Handler returns the HTTP handler with interceptors.
 func (h *IdentityHandler) Handler(opts ...connect.HandlerOption) http.Handler {
-	mux := http.NewServeMux()
-	return mux
+	_, handler := cloudv1connect.NewIdentityServiceHandler(h, opts...)
+	return handler
 }
 
 // AuditHandler handles audit API requests.
 type AuditHandler struct {
+	cloudv1connect.UnimplementedAuditServiceHandler
+
 	service *service.AuditService
 }
 
@@ -105,11 +114,11 @@ func NewAuditHandler(svc *service.AuditService) *AuditHandler {
 
 // Path returns the base path for the handler.
 func (h *AuditHandler) Path() string {
-	return "/temporal.cloud.api.v1.AuditService/"
+	return "/" + cloudv1connect.AuditServiceName + "/"
 }
 
 // Handler returns the HTTP handler with interceptors.
 func (h *AuditHandler) Handler(opts ...connect.HandlerOption) http.Handler {
-	mux := http.NewServeMux()
-	return mux
+	_, handler := cloudv1connect.NewAuditServiceHandler(h, opts...)
+	return handler
 }
@@ -0,0 +1,443 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+const (
+	permissionTypePrefix = "PERMISSION_TYPE_"
+
+	ownerTypeUser           = "user"
+	ownerTypeServiceAccount = service.APIKeyOwnerServiceAccount
+)
+
+// CreateAPIKey implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) CreateAPIKey(ctx context.Context, req *connect.Request[cloudv1.CreateAPIKeyRequest]) (*connect.Response[cloudv1.CreateAPIKeyResponse], error) {
+	ownerType, ownerID, err := resolveAPIKeyOwner(ctx, req.Msg.GetOwnerType(), req.Msg.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	input := &service.CreateAPIKeyInput{
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		Name:        req.Msg.GetName(),
+		Permissions: permissionsToStrings(req.Msg.GetPermissions()),
+	}
+	if req.Msg.GetExpiresAt() != nil {
+		expiresAt := req.Msg.GetExpiresAt().AsTime()
+		if !expiresAt.After(time.Now()) {
+			return nil, invalidArgument("expires_at must be in the future")
+		}
+		input.ExpiresAt = &expiresAt
+	}
+
+	key, secret, err := h.service.CreateAPIKey(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateAPIKeyResponse{
+		ApiKey:    apiKeyToProto(key),
+		SecretKey: secret,
+	}), nil
+}
+
+// GetAPIKey implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) GetAPIKey(ctx context.Context, req *connect.Request[cloudv1.GetAPIKeyRequest]) (*connect.Response[cloudv1.GetAPIKeyResponse], error) {
+	keyID, err := parseUUID("api_key_id", req.Msg.GetApiKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := h.service.GetAPIKey(ctx, keyID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if key == nil {
+		return nil, notFound("API key not found")
+	}
+
+	return connect.NewResponse(&cloudv1.GetAPIKeyResponse{ApiKey: apiKeyToProto(key)}), nil
+}
+
+// ListAPIKeys implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) ListAPIKeys(ctx context.Context, req *connect.Request[cloudv1.ListAPIKeysRequest]) (*connect.Response[cloudv1.ListAPIKeysResponse], error) {
+	ownerType, ownerID, err := resolveAPIKeyOwner(ctx, req.Msg.GetOwnerType(), req.Msg.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := h.service.ListAPIKeys(ctx, ownerType, ownerID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	keys, nextPageToken := trimPage(page, keys)
+
+	resp := &cloudv1.ListAPIKeysResponse{NextPageToken: nextPageToken}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyToProto(key))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeAPIKey implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) RevokeAPIKey(ctx context.Context, req *connect.Request[cloudv1.RevokeAPIKeyRequest]) (*connect.Response[cloudv1.RevokeAPIKeyResponse], error) {
+	keyID, err := parseUUID("api_key_id", req.Msg.GetApiKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RevokeAPIKey(ctx, keyID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RevokeAPIKeyResponse{}), nil
+}
+
+// RotateAPIKey implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) RotateAPIKey(ctx context.Context, req *connect.Request[cloudv1.RotateAPIKeyRequest]) (*connect.Response[cloudv1.RotateAPIKeyResponse], error) {
+	keyID, err := parseUUID("api_key_id", req.Msg.GetApiKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	key, secret, err := h.service.RotateAPIKey(ctx, keyID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RotateAPIKeyResponse{
+		ApiKey:    apiKeyToProto(key),
+		SecretKey: secret,
+	}), nil
+}
+
+// GetUser implements cloudv1connect.IdentityServiceHandler. It returns the
+// calling user.
+func (h *IdentityHandler) GetUser(ctx context.Context, req *connect.Request[cloudv1.GetUserRequest]) (*connect.Response[cloudv1.GetUserResponse], error) {
+	caller, err := authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.GetUser(ctx, caller.UserID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if user == nil {
+		return nil, notFound("user not found")
+	}
+
+	return connect.NewResponse(&cloudv1.GetUserResponse{User: userToProto(user)}), nil
+}
+
+// UpdateUser implements cloudv1connect.IdentityServiceHandler. It updates the
+// calling user.
+func (h *IdentityHandler) UpdateUser(ctx context.Context, req *connect.Request[cloudv1.UpdateUserRequest]) (*connect.Response[cloudv1.UpdateUserResponse], error) {
+	caller, err := authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.UpdateUser(ctx, caller.UserID, req.Msg.GetName())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateUserResponse{User: userToProto(user)}), nil
+}
+
+// InitiateSAMLLogin implements cloudv1connect.IdentityServiceHandler. It is
+// called before the user is authenticated.
+func (h *IdentityHandler) InitiateSAMLLogin(ctx context.Context, req *connect.Request[cloudv1.InitiateSAMLLoginRequest]) (*connect.Response[cloudv1.InitiateSAMLLoginResponse], error) {
+	if req.Msg.GetOrganizationSlug() == "" {
+		return nil, invalidArgument("organization_slug is required")
+	}
+
+	requestURL, err := h.service.InitiateSAMLLogin(ctx, req.Msg.GetOrganizationSlug(), req.Msg.GetRedirectUrl())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.InitiateSAMLLoginResponse{SamlRequestUrl: requestURL}), nil
+}
+
+// CompleteSAMLLogin implements cloudv1connect.IdentityServiceHandler. It is
+// called before the user is authenticated.
+func (h *IdentityHandler) CompleteSAMLLogin(ctx context.Context, req *connect.Request[cloudv1.CompleteSAMLLoginRequest]) (*connect.Response[cloudv1.CompleteSAMLLoginResponse], error) {
+	if req.Msg.GetSamlResponse() == "" {
+		return nil, invalidArgument("saml_response is required")
+	}
+
+	result, err := h.service.CompleteSAMLLogin(ctx, req.Msg.GetSamlResponse(), req.Msg.GetRelayState())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CompleteSAMLLoginResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		ExpiresAt:    timestampOrNil(result.ExpiresAt),
+	}), nil
+}
+
+// RotateSCIMToken implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) RotateSCIMToken(ctx context.Context, req *connect.Request[cloudv1.RotateSCIMTokenRequest]) (*connect.Response[cloudv1.RotateSCIMTokenResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := h.service.RotateSCIMToken(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RotateSCIMTokenResponse{
+		Token:       token,
+		ScimBaseUrl: h.service.SCIMBaseURL(),
+	}), nil
+}
+
+// ListUserGroups implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) ListUserGroups(ctx context.Context, req *connect.Request[cloudv1.ListUserGroupsRequest]) (*connect.Response[cloudv1.ListUserGroupsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := h.service.ListUserGroups(ctx, orgID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	groups, next := trimPage(page, groups)
+
+	resp := &cloudv1.ListUserGroupsResponse{NextPageToken: next}
+	for _, group := range groups {
+		resp.UserGroups = append(resp.UserGroups, userGroupToProto(group))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SetUserGroupNamespacePermissions implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) SetUserGroupNamespacePermissions(ctx context.Context, req *connect.Request[cloudv1.SetUserGroupNamespacePermissionsRequest]) (*connect.Response[cloudv1.SetUserGroupNamespacePermissionsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	groupID, err := parseUUID("group_id", req.Msg.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+
+	perms := make([]*repository.GroupNamespacePermission, 0, len(req.Msg.GetNamespacePermissions()))
+	for _, perm := range req.Msg.GetNamespacePermissions() {
+		if perm.GetNamespaceId() == "" {
+			return nil, invalidArgument("namespace_id is required")
+		}
+		perms = append(perms, &repository.GroupNamespacePermission{
+			NamespaceID: perm.GetNamespaceId(),
+			Permission:  perm.GetPermission(),
+		})
+	}
+
+	group, err := h.service.SetUserGroupNamespacePermissions(ctx, orgID, groupID, perms)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.SetUserGroupNamespacePermissionsResponse{
+		UserGroup: userGroupToProto(group),
+	}), nil
+}
+
+// resolveAPIKeyOwner defaults the API key owner to the caller.
+func resolveAPIKeyOwner(ctx context.Context, ownerType, ownerID string) (string, uuid.UUID, error) {
+	if ownerID == "" {
+		caller, err := authInfo(ctx)
+		if err != nil {
+			return "", uuid.Nil, err
+		}
+		if caller.ServiceAccountID != uuid.Nil {
+			return ownerTypeServiceAccount, caller.ServiceAccountID, nil
+		}
+		return ownerTypeUser, caller.UserID, nil
+	}
+
+	if ownerType == "" {
+		ownerType = ownerTypeUser
+	}
+	if ownerType != ownerTypeUser && ownerType != ownerTypeServiceAccount {
+		return "", uuid.Nil, invalidArgument("owner_type must be user or service_account")
+	}
+	id, err := parseUUID("owner_id", ownerID)
+	if err != nil {
+		return "", uuid.Nil, err
+	}
+	return ownerType, id, nil
+}
+
+// permissionsToStrings encodes permissions as "<type>" or "<type>:<resource_id>",
+// the form stored in api_keys.permissions.
+func permissionsToStrings(perms []*cloudv1.Permission) []string {
+	out := make([]string, 0, len(perms))
+	for _, perm := range perms {
+		s := enumToString(perm.GetType().String(), permissionTypePrefix)
+		if perm.GetResourceId() != "" {
+			s += ":" + perm.GetResourceId()
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func permissionsFromStrings(perms []string) []*cloudv1.Permission {
+	out := make([]*cloudv1.Permission, 0, len(perms))
+	for _, perm := range perms {
+		typ, resourceID, _ := strings.Cut(perm, ":")
+		out = append(out, &cloudv1.Permission{
+			Type:       cloudv1.PermissionType(stringToEnum(typ, permissionTypePrefix, cloudv1.PermissionType_value)),
+			ResourceId: resourceID,
+		})
+	}
+	return out
+}
+
+func apiKeyToProto(key *repository.APIKey) *cloudv1.APIKey {
+	var perms []string
+	if len(key.Permissions) > 0 {
+		_ = json.Unmarshal(key.Permissions, &perms)
+	}
+	return &cloudv1.APIKey{
+		Id:          key.ID.String(),
+		OwnerType:   key.OwnerType,
+		OwnerId:     key.OwnerID.String(),
+		Name:        key.Name.String,
+		KeyPrefix:   key.KeyPrefix,
+		Permissions: permissionsFromStrings(perms),
+		Disabled:    key.Disabled,
+		ExpiresAt:   nullTimestamp(key.ExpiresAt),
+		LastUsedAt:  nullTimestamp(key.LastUsedAt),
+		CreatedAt:   timestampOrNil(key.CreatedAt),
+	}
+}
+
+func userToProto(user *repository.User) *cloudv1.User {
+	return &cloudv1.User{
+		Id:        user.ID.String(),
+		Email:     user.Email,
+		Name:      user.Name.String,
+		AvatarUrl: user.AvatarURL.String,
+		CreatedAt: timestampOrNil(user.CreatedAt),
+		UpdatedAt: timestampOrNil(user.UpdatedAt),
+	}
+}
+
+// AddDomain implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) AddDomain(ctx context.Context, req *connect.Request[cloudv1.AddDomainRequest]) (*connect.Response[cloudv1.AddDomainResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := h.service.AddDomain(ctx, orgID, req.Msg.GetDomain())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.AddDomainResponse{Domain: domainToProto(domain)}), nil
+}
+
+// VerifyDomain implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) VerifyDomain(ctx context.Context, req *connect.Request[cloudv1.VerifyDomainRequest]) (*connect.Response[cloudv1.VerifyDomainResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := h.service.VerifyDomain(ctx, orgID, req.Msg.GetDomain())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.VerifyDomainResponse{Domain: domainToProto(domain)}), nil
+}
+
+// ListDomains implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) ListDomains(ctx context.Context, req *connect.Request[cloudv1.ListDomainsRequest]) (*connect.Response[cloudv1.ListDomainsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := h.service.ListDomains(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.ListDomainsResponse{}
+	for _, domain := range domains {
+		resp.Domains = append(resp.Domains, domainToProto(domain))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RemoveDomain implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) RemoveDomain(ctx context.Context, req *connect.Request[cloudv1.RemoveDomainRequest]) (*connect.Response[cloudv1.RemoveDomainResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RemoveDomain(ctx, orgID, req.Msg.GetDomain()); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RemoveDomainResponse{}), nil
+}
+
+func domainToProto(domain *repository.OrganizationDomain) *cloudv1.Domain {
+	return &cloudv1.Domain{
+		Domain:             domain.Domain,
+		VerificationRecord: service.DomainVerificationRecord(domain.Domain),
+		VerificationToken:  service.DomainVerificationValue(domain),
+		VerifiedAt:         nullTimestamp(domain.VerifiedAt),
+		CreatedAt:          timestampOrNil(domain.CreatedAt),
+	}
+}
+
+func userGroupToProto(group *service.UserGroupWithPermissions) *cloudv1.UserGroup {
+	pb := &cloudv1.UserGroup{
+		Id:             group.ID.String(),
+		OrganizationId: group.OrganizationID.String(),
+		Name:           group.Name,
+		ExternalId:     group.ExternalID.String,
+		CreatedAt:      timestampOrNil(group.CreatedAt),
+		UpdatedAt:      timestampOrNil(group.UpdatedAt),
+	}
+	for _, perm := range group.Permissions {
+		pb.NamespacePermissions = append(pb.NamespacePermissions, &cloudv1.NamespacePermission{
+			NamespaceId: perm.NamespaceID,
+			Permission:  perm.Permission,
+		})
+	}
+	return pb
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+const invitationStatusPrefix = "INVITATION_STATUS_"
+
+// InviteUser implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) InviteUser(ctx context.Context, req *connect.Request[cloudv1.InviteUserRequest]) (*connect.Response[cloudv1.InviteUserResponse], error) {
+	caller, err := authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetEmail() == "" {
+		return nil, invalidArgument("email is required")
+	}
+
+	inv, err := h.invitations.InviteUser(ctx, &service.InviteUserInput{
+		OrganizationID: orgID,
+		Email:          req.Msg.GetEmail(),
+		Role:           enumToString(req.Msg.GetRole().String(), orgRolePrefix),
+		InvitedBy:      caller.UserID,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.InviteUserResponse{
+		InvitationId: inv.ID.String(),
+		Invitation:   invitationToProto(inv),
+	}), nil
+}
+
+// ListInvitations implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) ListInvitations(ctx context.Context, req *connect.Request[cloudv1.ListInvitationsRequest]) (*connect.Response[cloudv1.ListInvitationsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	invs, err := h.invitations.ListInvitations(ctx, orgID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	invs, nextPageToken := trimPage(page, invs)
+
+	resp := &cloudv1.ListInvitationsResponse{NextPageToken: nextPageToken}
+	for _, inv := range invs {
+		resp.Invitations = append(resp.Invitations, invitationToProto(inv))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ResendInvitation implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) ResendInvitation(ctx context.Context, req *connect.Request[cloudv1.ResendInvitationRequest]) (*connect.Response[cloudv1.ResendInvitationResponse], error) {
+	id, err := parseUUID("invitation_id", req.Msg.GetInvitationId())
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := h.invitations.ResendInvitation(ctx, id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.ResendInvitationResponse{Invitation: invitationToProto(inv)}), nil
+}
+
+// RevokeInvitation implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) RevokeInvitation(ctx context.Context, req *connect.Request[cloudv1.RevokeInvitationRequest]) (*connect.Response[cloudv1.RevokeInvitationResponse], error) {
+	id, err := parseUUID("invitation_id", req.Msg.GetInvitationId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.invitations.RevokeInvitation(ctx, id); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RevokeInvitationResponse{}), nil
+}
+
+// AcceptInvitation implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) AcceptInvitation(ctx context.Context, req *connect.Request[cloudv1.AcceptInvitationRequest]) (*connect.Response[cloudv1.AcceptInvitationResponse], error) {
+	caller, err := authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetToken() == "" {
+		return nil, invalidArgument("token is required")
+	}
+
+	inv, err := h.invitations.AcceptInvitation(ctx, req.Msg.GetToken(), caller.UserID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	org, err := h.service.GetOrganization(ctx, inv.OrganizationID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &cloudv1.AcceptInvitationResponse{Invitation: invitationToProto(inv)}
+	if org != nil {
+		resp.Organization = organizationToProto(org)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DeclineInvitation implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) DeclineInvitation(ctx context.Context, req *connect.Request[cloudv1.DeclineInvitationRequest]) (*connect.Response[cloudv1.DeclineInvitationResponse], error) {
+	caller, err := authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetToken() == "" {
+		return nil, invalidArgument("token is required")
+	}
+
+	if err := h.invitations.DeclineInvitation(ctx, req.Msg.GetToken(), caller.UserID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeclineInvitationResponse{}), nil
+}
+
+func invitationToProto(inv *repository.UserInvitation) *cloudv1.Invitation {
+	status := service.InvitationStatus(inv, time.Now())
+	return &cloudv1.Invitation{
+		Id:             inv.ID.String(),
+		OrganizationId: inv.OrganizationID.String(),
+		Email:          inv.Email,
+		Role:           cloudv1.OrganizationRole(stringToEnum(inv.Role, orgRolePrefix, cloudv1.OrganizationRole_value)),
+		Status:         cloudv1.InvitationStatus(stringToEnum(status, invitationStatusPrefix, cloudv1.InvitationStatus_value)),
+		InvitedBy:      inv.InvitedBy.String(),
+		ExpiresAt:      timestampOrNil(inv.ExpiresAt),
+		LastSentAt:     nullTimestamp(inv.LastSentAt),
+		SendCount:      int32(inv.SendCount),
+		CreatedAt:      timestampOrNil(inv.CreatedAt),
+	}
+}
@@ -0,0 +1,403 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"connectrpc.com/connect"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	namespaceStatePrefix      = "NAMESPACE_STATE_"
+	searchAttributeTypePrefix = "SEARCH_ATTRIBUTE_TYPE_"
+	retentionDayDuration      = 24 * time.Hour
+)
+
+// CreateNamespace implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) CreateNamespace(ctx context.Context, req *connect.Request[cloudv1.CreateNamespaceRequest]) (*connect.Response[cloudv1.CreateNamespaceResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := req.Msg.GetConfig()
+	input := &service.CreateNamespaceInput{
+		OrganizationID:    orgID,
+		Name:              req.Msg.GetName(),
+		Region:            req.Msg.GetRegion(),
+		DeletionProtected: cfg.GetDeletionProtected(),
+		HAEnabled:         cfg.GetHaConfig().GetEnabled(),
+		StandbyRegion:     cfg.GetHaConfig().GetStandbyRegion(),
+		Tags:              req.Msg.GetTags(),
+	}
+	if cfg.GetRetentionPeriod() != nil {
+		input.RetentionDays, err = retentionDays(cfg.GetRetentionPeriod())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ns, operationID, err := h.service.CreateNamespace(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	pb, err := h.namespaceToProto(ctx, ns)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateNamespaceResponse{
+		Namespace:   pb,
+		OperationId: operationID,
+	}), nil
+}
+
+// GetNamespace implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) GetNamespace(ctx context.Context, req *connect.Request[cloudv1.GetNamespaceRequest]) (*connect.Response[cloudv1.GetNamespaceResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+
+	ns, err := h.service.GetNamespace(ctx, req.Msg.GetNamespaceId())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if ns == nil {
+		return nil, notFound("namespace not found")
+	}
+	pb, err := h.namespaceToProto(ctx, ns)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetNamespaceResponse{Namespace: pb}), nil
+}
+
+// UpdateNamespace implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) UpdateNamespace(ctx context.Context, req *connect.Request[cloudv1.UpdateNamespaceRequest]) (*connect.Response[cloudv1.UpdateNamespaceResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	update := req.Msg.GetNamespace()
+	if update == nil {
+		return nil, invalidArgument("namespace is required")
+	}
+
+	input := &service.UpdateNamespaceInput{ID: req.Msg.GetNamespaceId()}
+	paths := req.Msg.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		cfg := update.GetConfig()
+		if cfg.GetRetentionPeriod() != nil {
+			paths = append(paths, "config.retention_period")
+		}
+		if cfg != nil {
+			paths = append(paths, "config.deletion_protected")
+		}
+		if cfg.GetCodecServer() != nil {
+			paths = append(paths, "config.codec_server")
+		}
+		if update.GetTags() != nil {
+			paths = append(paths, "tags")
+		}
+	}
+	for _, path := range paths {
+		switch path {
+		case "config.retention_period":
+			days, err := retentionDays(update.GetConfig().GetRetentionPeriod())
+			if err != nil {
+				return nil, err
+			}
+			input.RetentionDays = &days
+		case "config.deletion_protected":
+			protected := update.GetConfig().GetDeletionProtected()
+			input.DeletionProtected = &protected
+		case "config.codec_server":
+			codec := update.GetConfig().GetCodecServer()
+			endpoint := codec.GetEndpoint()
+			passToken := codec.GetPassAccessToken()
+			input.CodecEndpoint = &endpoint
+			input.CodecPassToken = &passToken
+		case "tags":
+			input.Tags = update.GetTags()
+			if input.Tags == nil {
+				input.Tags = map[string]string{}
+			}
+		default:
+			return nil, invalidArgument("unsupported update_mask path: " + path)
+		}
+	}
+
+	ns, operationID, err := h.service.UpdateNamespace(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	pb, err := h.namespaceToProto(ctx, ns)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateNamespaceResponse{
+		Namespace:   pb,
+		OperationId: operationID,
+	}), nil
+}
+
+// DeleteNamespace implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) DeleteNamespace(ctx context.Context, req *connect.Request[cloudv1.DeleteNamespaceRequest]) (*connect.Response[cloudv1.DeleteNamespaceResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+
+	operationID, err := h.service.DeleteNamespace(ctx, req.Msg.GetNamespaceId())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeleteNamespaceResponse{OperationId: operationID}), nil
+}
+
+// ListNamespaces implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) ListNamespaces(ctx context.Context, req *connect.Request[cloudv1.ListNamespacesRequest]) (*connect.Response[cloudv1.ListNamespacesResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.NamespaceFilter{
+		OrganizationID: orgID,
+		Region:         req.Msg.GetRegionFilter(),
+	}
+	if state := req.Msg.GetStateFilter(); state != cloudv1.NamespaceState_NAMESPACE_STATE_UNSPECIFIED {
+		filter.State = enumToString(state.String(), namespaceStatePrefix)
+	}
+
+	namespaces, err := h.service.ListNamespaces(ctx, filter, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	namespaces, nextPageToken := trimPage(page, namespaces)
+
+	resp := &cloudv1.ListNamespacesResponse{NextPageToken: nextPageToken}
+	for _, ns := range namespaces {
+		pb, err := h.namespaceToProto(ctx, ns)
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+		resp.Namespaces = append(resp.Namespaces, pb)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// AddSearchAttributes implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) AddSearchAttributes(ctx context.Context, req *connect.Request[cloudv1.AddSearchAttributesRequest]) (*connect.Response[cloudv1.AddSearchAttributesResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	if len(req.Msg.GetSearchAttributes()) == 0 {
+		return nil, invalidArgument("search_attributes is required")
+	}
+
+	attrs := make(map[string]string, len(req.Msg.GetSearchAttributes()))
+	for _, attr := range req.Msg.GetSearchAttributes() {
+		if attr.GetName() == "" {
+			return nil, invalidArgument("search attribute name is required")
+		}
+		if attr.GetType() == cloudv1.SearchAttributeType_SEARCH_ATTRIBUTE_TYPE_UNSPECIFIED {
+			return nil, invalidArgument("search attribute type is required for " + attr.GetName())
+		}
+		attrs[attr.GetName()] = enumToString(attr.GetType().String(), searchAttributeTypePrefix)
+	}
+
+	operationID, err := h.service.AddSearchAttributes(ctx, req.Msg.GetNamespaceId(), attrs)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.AddSearchAttributesResponse{OperationId: operationID}), nil
+}
+
+// RemoveSearchAttribute implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) RemoveSearchAttribute(ctx context.Context, req *connect.Request[cloudv1.RemoveSearchAttributeRequest]) (*connect.Response[cloudv1.RemoveSearchAttributeResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	if req.Msg.GetName() == "" {
+		return nil, invalidArgument("name is required")
+	}
+
+	operationID, err := h.service.RemoveSearchAttribute(ctx, req.Msg.GetNamespaceId(), req.Msg.GetName())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RemoveSearchAttributeResponse{OperationId: operationID}), nil
+}
+
+// AddCertificateFilter implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) AddCertificateFilter(ctx context.Context, req *connect.Request[cloudv1.AddCertificateFilterRequest]) (*connect.Response[cloudv1.AddCertificateFilterResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	filter := req.Msg.GetCertificateFilter()
+	if filter == nil {
+		return nil, invalidArgument("certificate_filter is required")
+	}
+
+	created, err := h.service.AddCertificateFilter(ctx, &service.AddCertificateFilterInput{
+		NamespaceID:            req.Msg.GetNamespaceId(),
+		CommonName:             filter.GetCommonName(),
+		Organization:           filter.GetOrganization(),
+		OrganizationalUnit:     filter.GetOrganizationalUnit(),
+		SubjectAlternativeName: filter.GetSubjectAlternativeName(),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.AddCertificateFilterResponse{
+		CertificateFilter: certificateFilterToProto(created),
+	}), nil
+}
+
+// RemoveCertificateFilter implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) RemoveCertificateFilter(ctx context.Context, req *connect.Request[cloudv1.RemoveCertificateFilterRequest]) (*connect.Response[cloudv1.RemoveCertificateFilterResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	filterID, err := parseUUID("filter_id", req.Msg.GetFilterId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RemoveCertificateFilter(ctx, req.Msg.GetNamespaceId(), filterID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RemoveCertificateFilterResponse{}), nil
+}
+
+// FailoverNamespace implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) FailoverNamespace(ctx context.Context, req *connect.Request[cloudv1.FailoverNamespaceRequest]) (*connect.Response[cloudv1.FailoverNamespaceResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+
+	operationID, err := h.service.FailoverNamespace(ctx, req.Msg.GetNamespaceId(), req.Msg.GetTargetRegion())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.FailoverNamespaceResponse{OperationId: operationID}), nil
+}
+
+// IssueClientCertificate implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) IssueClientCertificate(ctx context.Context, req *connect.Request[cloudv1.IssueClientCertificateRequest]) (*connect.Response[cloudv1.IssueClientCertificateResponse], error) {
+	if req.Msg.GetNamespaceId() == "" {
+		return nil, invalidArgument("namespace_id is required")
+	}
+	if req.Msg.GetCommonName() == "" {
+		return nil, invalidArgument("common_name is required")
+	}
+
+	issued, err := h.service.IssueClientCertificate(ctx, req.Msg.GetNamespaceId(), req.Msg.GetCommonName())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.IssueClientCertificateResponse{
+		CertificatePem: issued.CertificatePEM,
+		PrivateKeyPem:  issued.PrivateKeyPEM,
+		Fingerprint:    issued.Fingerprint,
+		ExpiresAt:      timestampOrNil(issued.ExpiresAt),
+	}), nil
+}
+
+func (h *NamespaceHandler) namespaceToProto(ctx context.Context, ns *repository.Namespace) (*cloudv1.Namespace, error) {
+	pb := &cloudv1.Namespace{
+		Id:             ns.ID,
+		Name:           ns.Name,
+		OrganizationId: ns.OrganizationID.String(),
+		Region:         ns.Region,
+		State:          cloudv1.NamespaceState(stringToEnum(ns.State, namespaceStatePrefix, cloudv1.NamespaceState_value)),
+		Config: &cloudv1.NamespaceConfig{
+			RetentionPeriod:   durationpb.New(time.Duration(ns.RetentionDays) * retentionDayDuration),
+			DeletionProtected: ns.DeletionProtected,
+		},
+		Endpoints: &cloudv1.NamespaceEndpoints{
+			GrpcEndpoint:    ns.GRPCEndpoint.String,
+			WebEndpoint:     ns.WebEndpoint.String,
+			MetricsEndpoint: ns.MetricsEndpoint.String,
+		},
+		CreatedAt: timestampOrNil(ns.CreatedAt),
+		UpdatedAt: timestampOrNil(ns.UpdatedAt),
+	}
+	if ns.HAEnabled {
+		pb.Config.HaConfig = &cloudv1.HighAvailabilityConfig{
+			Enabled:       true,
+			StandbyRegion: ns.StandbyRegion.String,
+		}
+	}
+	if ns.CodecEndpoint.Valid {
+		pb.Config.CodecServer = &cloudv1.CodecServerConfig{
+			Endpoint:           ns.CodecEndpoint.String,
+			PassAccessToken:    ns.CodecPassToken,
+			IncludeCredentials: ns.CodecIncludeCredentials,
+		}
+	}
+	if len(ns.Tags) > 0 {
+		_ = json.Unmarshal(ns.Tags, &pb.Tags)
+	}
+
+	attrs, err := h.service.ListSearchAttributes(ctx, ns.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range attrs {
+		pb.SearchAttributes = append(pb.SearchAttributes, &cloudv1.SearchAttribute{
+			Name: attr.Name,
+			Type: cloudv1.SearchAttributeType(stringToEnum(attr.Type, searchAttributeTypePrefix, cloudv1.SearchAttributeType_value)),
+		})
+	}
+
+	filters, err := h.service.ListCertificateFilters(ctx, ns.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, filter := range filters {
+		pb.CertificateFilters = append(pb.CertificateFilters, certificateFilterToProto(filter))
+	}
+
+	return pb, nil
+}
+
+func certificateFilterToProto(filter *repository.NamespaceCertificateFilter) *cloudv1.CertificateFilter {
+	return &cloudv1.CertificateFilter{
+		Id:                     filter.ID.String(),
+		CommonName:             filter.CommonName.String,
+		Organization:           filter.Organization.String,
+		OrganizationalUnit:     filter.OrganizationalUnit.String,
+		SubjectAlternativeName: filter.SubjectAlternativeName.String,
+	}
+}
+
+// retentionDays converts a retention duration into whole days, rejecting
+// values that are not a positive multiple of a day.
+func retentionDays(d *durationpb.Duration) (int, error) {
+	if err := d.CheckValid(); err != nil {
+		return 0, invalidArgument("invalid retention_period")
+	}
+	retention := d.AsDuration()
+	if retention <= 0 || retention%retentionDayDuration != 0 {
+		return 0, invalidArgument("retention_period must be a positive number of days")
+	}
+	return int(retention / retentionDayDuration), nil
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/server/common/log"
+)
+
+// The requests below are rejected before the service touches the database.
+func TestNamespaceHandlerValidation(t *testing.T) {
+	h := NewNamespaceHandler(service.NewNamespaceService(nil, nil, nil, nil, log.NewNoopLogger()))
+	ctx := context.Background()
+
+	_, err := h.CreateNamespace(ctx, connect.NewRequest(&cloudv1.CreateNamespaceRequest{Name: "orders"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = h.GetNamespace(ctx, connect.NewRequest(&cloudv1.GetNamespaceRequest{}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = h.UpdateNamespace(ctx, connect.NewRequest(&cloudv1.UpdateNamespaceRequest{NamespaceId: "orders.1234abcd"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = h.RemoveSearchAttribute(ctx, connect.NewRequest(&cloudv1.RemoveSearchAttributeRequest{NamespaceId: "orders.1234abcd"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = h.AddCertificateFilter(ctx, connect.NewRequest(&cloudv1.AddCertificateFilterRequest{NamespaceId: "orders.1234abcd"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = h.RemoveCertificateFilter(ctx, connect.NewRequest(&cloudv1.RemoveCertificateFilterRequest{NamespaceId: "orders.1234abcd", FilterId: "x"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = h.FailoverNamespace(ctx, connect.NewRequest(&cloudv1.FailoverNamespaceRequest{}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = h.IssueClientCertificate(ctx, connect.NewRequest(&cloudv1.IssueClientCertificateRequest{NamespaceId: "orders.1234abcd"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestRemoveSearchAttributeUnimplemented(t *testing.T) {
+	h := NewNamespaceHandler(service.NewNamespaceService(nil, nil, nil, nil, log.NewNoopLogger()))
+	_, err := h.RemoveSearchAttribute(context.Background(), connect.NewRequest(&cloudv1.RemoveSearchAttributeRequest{
+		NamespaceId: "orders.1234abcd",
+		Name:        "CustomerId",
+	}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+const nexusEndpointStatePrefix = "NEXUS_ENDPOINT_STATE_"
+
+// CreateNexusEndpoint implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) CreateNexusEndpoint(ctx context.Context, req *connect.Request[cloudv1.CreateNexusEndpointRequest]) (*connect.Response[cloudv1.CreateNexusEndpointResponse], error) {
+	input, err := nexusEndpointInput(req.Msg.GetOrganizationId(), req.Msg.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := h.service.CreateNexusEndpoint(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateNexusEndpointResponse{Endpoint: nexusEndpointToProto(endpoint)}), nil
+}
+
+// GetNexusEndpoint implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) GetNexusEndpoint(ctx context.Context, req *connect.Request[cloudv1.GetNexusEndpointRequest]) (*connect.Response[cloudv1.GetNexusEndpointResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	endpointID, err := parseUUID("endpoint_id", req.Msg.GetEndpointId())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := h.service.GetNexusEndpoint(ctx, orgID, endpointID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetNexusEndpointResponse{Endpoint: nexusEndpointToProto(endpoint)}), nil
+}
+
+// ListNexusEndpoints implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) ListNexusEndpoints(ctx context.Context, req *connect.Request[cloudv1.ListNexusEndpointsRequest]) (*connect.Response[cloudv1.ListNexusEndpointsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := h.service.ListNexusEndpoints(ctx, orgID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	endpoints, nextPageToken := trimPage(page, endpoints)
+
+	resp := &cloudv1.ListNexusEndpointsResponse{NextPageToken: nextPageToken}
+	for _, endpoint := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, nexusEndpointToProto(endpoint))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateNexusEndpoint implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) UpdateNexusEndpoint(ctx context.Context, req *connect.Request[cloudv1.UpdateNexusEndpointRequest]) (*connect.Response[cloudv1.UpdateNexusEndpointResponse], error) {
+	endpointID, err := parseUUID("endpoint_id", req.Msg.GetEndpointId())
+	if err != nil {
+		return nil, err
+	}
+	input, err := nexusEndpointInput(req.Msg.GetOrganizationId(), req.Msg.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := h.service.UpdateNexusEndpoint(ctx, endpointID, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateNexusEndpointResponse{Endpoint: nexusEndpointToProto(endpoint)}), nil
+}
+
+// DeleteNexusEndpoint implements cloudv1connect.NamespaceServiceHandler.
+func (h *NamespaceHandler) DeleteNexusEndpoint(ctx context.Context, req *connect.Request[cloudv1.DeleteNexusEndpointRequest]) (*connect.Response[cloudv1.DeleteNexusEndpointResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	endpointID, err := parseUUID("endpoint_id", req.Msg.GetEndpointId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteNexusEndpoint(ctx, orgID, endpointID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeleteNexusEndpointResponse{}), nil
+}
+
+// nexusEndpointInput converts an endpoint spec from the API into service
+// input.
+func nexusEndpointInput(orgID string, spec *cloudv1.NexusEndpointSpec) (*service.NexusEndpointInput, error) {
+	id, err := parseUUID("organization_id", orgID)
+	if err != nil {
+		return nil, err
+	}
+	if spec == nil {
+		return nil, invalidArgument("spec is required")
+	}
+	return &service.NexusEndpointInput{
+		OrganizationID:            id,
+		Name:                      spec.GetName(),
+		Description:               spec.GetDescription(),
+		TargetNamespaceID:         spec.GetTargetNamespaceId(),
+		TargetTaskQueue:           spec.GetTargetTaskQueue(),
+		AllowedCallerNamespaceIDs: spec.GetAllowedCallerNamespaceIds(),
+	}, nil
+}
+
+func nexusEndpointToProto(endpoint *repository.NexusEndpoint) *cloudv1.NexusEndpoint {
+	return &cloudv1.NexusEndpoint{
+		Id: endpoint.ID.String(),
+		Spec: &cloudv1.NexusEndpointSpec{
+			Name:                      endpoint.Name,
+			Description:               endpoint.Description.String,
+			TargetNamespaceId:         endpoint.TargetNamespaceID,
+			TargetTaskQueue:           endpoint.TargetTaskQueue,
+			AllowedCallerNamespaceIds: endpoint.AllowedCallerNamespaceIDs,
+		},
+		State:             cloudv1.NexusEndpointState(stringToEnum(service.NexusEndpointState(endpoint), nexusEndpointStatePrefix, cloudv1.NexusEndpointState_value)),
+		SyncError:         endpoint.SyncError.String,
+		ClusterEndpointId: endpoint.ClusterEndpointID.String,
+		CreatedAt:         timestampOrNil(endpoint.CreatedAt),
+		UpdatedAt:         timestampOrNil(endpoint.UpdatedAt),
+	}
+}
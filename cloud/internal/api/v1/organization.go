@@ -0,0 +1,304 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const orgRolePrefix = "ORGANIZATION_ROLE_"
+
+// CreateOrganization implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req *connect.Request[cloudv1.CreateOrganizationRequest]) (*connect.Response[cloudv1.CreateOrganizationResponse], error) {
+	caller, err := authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := h.service.CreateOrganization(ctx, &service.CreateOrganizationInput{
+		Name:    req.Msg.GetName(),
+		Slug:    req.Msg.GetSlug(),
+		OwnerID: caller.UserID,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateOrganizationResponse{
+		Organization: organizationToProto(org),
+	}), nil
+}
+
+// GetOrganization implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) GetOrganization(ctx context.Context, req *connect.Request[cloudv1.GetOrganizationRequest]) (*connect.Response[cloudv1.GetOrganizationResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := h.service.GetOrganization(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if org == nil {
+		return nil, notFound("organization not found")
+	}
+
+	return connect.NewResponse(&cloudv1.GetOrganizationResponse{
+		Organization: organizationToProto(org),
+	}), nil
+}
+
+// UpdateOrganization implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) UpdateOrganization(ctx context.Context, req *connect.Request[cloudv1.UpdateOrganizationRequest]) (*connect.Response[cloudv1.UpdateOrganizationResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	update := req.Msg.GetOrganization()
+	if update == nil {
+		return nil, invalidArgument("organization is required")
+	}
+
+	input := &service.UpdateOrganizationInput{ID: orgID}
+	paths := req.Msg.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if update.GetName() != "" {
+			paths = append(paths, "name")
+		}
+		if update.GetSettings() != nil {
+			paths = append(paths, "settings")
+		}
+	}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			name := update.GetName()
+			input.Name = &name
+		case "settings":
+			settingsMsg, err := h.configureSAML(ctx, orgID, update.GetSettings())
+			if err != nil {
+				return nil, err
+			}
+			if err := h.identity.SetSCIMEnabled(ctx, orgID, settingsMsg.GetScimEnabled()); err != nil {
+				return nil, toConnectError(err)
+			}
+			settings, err := protojson.Marshal(settingsMsg)
+			if err != nil {
+				return nil, invalidArgument("invalid settings")
+			}
+			input.Settings = settings
+		default:
+			return nil, invalidArgument("unsupported update_mask path: " + path)
+		}
+	}
+
+	org, err := h.service.UpdateOrganization(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateOrganizationResponse{
+		Organization: organizationToProto(org),
+	}), nil
+}
+
+// configureSAML applies the SAML part of organization settings. It returns a
+// copy of the settings completed with the stored SAML configuration,
+// including the service provider identifiers to register with the IdP.
+func (h *OrganizationHandler) configureSAML(ctx context.Context, orgID uuid.UUID, settings *cloudv1.OrganizationSettings) (*cloudv1.OrganizationSettings, error) {
+	if settings == nil {
+		settings = &cloudv1.OrganizationSettings{}
+	}
+	settings = proto.CloneOf(settings)
+	input := &service.ConfigureSAMLInput{OrganizationID: orgID, Enabled: settings.GetSsoEnabled()}
+	if c := settings.GetSamlConfig(); c != nil {
+		input.IdPEntityID = c.GetIdpEntityId()
+		input.IdPSSOURL = c.GetIdpSsoUrl()
+		input.IdPCertificate = c.GetIdpCertificate()
+	}
+
+	cfg, err := h.identity.ConfigureSAML(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	if cfg != nil {
+		settings.SamlConfig = &cloudv1.SAMLConfig{
+			IdpEntityId:    cfg.IdPEntityID,
+			IdpSsoUrl:      cfg.IdPSSOURL,
+			IdpCertificate: cfg.IdPCertificate,
+			SpEntityId:     cfg.SPEntityID,
+			SpAcsUrl:       cfg.SPACSURL,
+		}
+	}
+	return settings, nil
+}
+
+// DeleteOrganization implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) DeleteOrganization(ctx context.Context, req *connect.Request[cloudv1.DeleteOrganizationRequest]) (*connect.Response[cloudv1.DeleteOrganizationResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := h.service.DeleteOrganization(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeleteOrganizationResponse{
+		Organization: organizationToProto(org),
+	}), nil
+}
+
+// CancelOrganizationDeletion implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) CancelOrganizationDeletion(ctx context.Context, req *connect.Request[cloudv1.CancelOrganizationDeletionRequest]) (*connect.Response[cloudv1.CancelOrganizationDeletionResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := h.service.CancelOrganizationDeletion(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CancelOrganizationDeletionResponse{
+		Organization: organizationToProto(org),
+	}), nil
+}
+
+// ListOrganizations implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) ListOrganizations(ctx context.Context, req *connect.Request[cloudv1.ListOrganizationsRequest]) (*connect.Response[cloudv1.ListOrganizationsResponse], error) {
+	caller, err := authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, err := h.service.ListOrganizations(ctx, caller.UserID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	orgs, nextPageToken := trimPage(page, orgs)
+
+	resp := &cloudv1.ListOrganizationsResponse{NextPageToken: nextPageToken}
+	for _, org := range orgs {
+		resp.Organizations = append(resp.Organizations, organizationToProto(org))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListMembers implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) ListMembers(ctx context.Context, req *connect.Request[cloudv1.ListMembersRequest]) (*connect.Response[cloudv1.ListMembersResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.service.ListMembers(ctx, orgID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	members, nextPageToken := trimPage(page, members)
+
+	resp := &cloudv1.ListMembersResponse{NextPageToken: nextPageToken}
+	for _, member := range members {
+		pb, err := h.memberToProto(ctx, member)
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+		resp.Members = append(resp.Members, pb)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateMemberRole implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) UpdateMemberRole(ctx context.Context, req *connect.Request[cloudv1.UpdateMemberRoleRequest]) (*connect.Response[cloudv1.UpdateMemberRoleResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUUID("user_id", req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := h.service.UpdateMemberRole(ctx, orgID, userID, enumToString(req.Msg.GetRole().String(), orgRolePrefix))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	pb, err := h.memberToProto(ctx, member)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateMemberRoleResponse{Member: pb}), nil
+}
+
+// RemoveMember implements cloudv1connect.OrganizationServiceHandler.
+func (h *OrganizationHandler) RemoveMember(ctx context.Context, req *connect.Request[cloudv1.RemoveMemberRequest]) (*connect.Response[cloudv1.RemoveMemberResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUUID("user_id", req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RemoveMember(ctx, orgID, userID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.RemoveMemberResponse{}), nil
+}
+
+func (h *OrganizationHandler) memberToProto(ctx context.Context, member *repository.OrganizationMember) (*cloudv1.OrganizationMember, error) {
+	pb := &cloudv1.OrganizationMember{
+		UserId:   member.UserID.String(),
+		Role:     cloudv1.OrganizationRole(stringToEnum(member.Role, orgRolePrefix, cloudv1.OrganizationRole_value)),
+		JoinedAt: timestampOrNil(member.CreatedAt),
+	}
+	user, err := h.service.GetMemberUser(ctx, member.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		pb.Email = user.Email
+		pb.Name = user.Name.String
+	}
+	return pb, nil
+}
+
+func organizationToProto(org *repository.Organization) *cloudv1.Organization {
+	pb := &cloudv1.Organization{
+		Id:                org.ID.String(),
+		Name:              org.Name,
+		Slug:              org.Slug,
+		CreatedAt:         timestampOrNil(org.CreatedAt),
+		UpdatedAt:         timestampOrNil(org.UpdatedAt),
+		DeleteAfter:       nullTimestamp(org.DeleteAfter),
+		DeletionStartedAt: nullTimestamp(org.DeletionStartedAt),
+	}
+	if len(org.Settings) > 0 && string(org.Settings) != "{}" {
+		settings := &cloudv1.OrganizationSettings{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(org.Settings, settings); err == nil {
+			pb.Settings = settings
+		}
+	}
+	return pb
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// pageRequest is a decoded page_size/page_token pair. Page tokens are opaque to
+// clients and encode the offset of the next page.
+type pageRequest struct {
+	Size   int
+	Offset int
+}
+
+func parsePageRequest(pageSize int32, pageToken string) (pageRequest, error) {
+	size := int(pageSize)
+	switch {
+	case size < 0:
+		return pageRequest{}, invalidArgument("page_size must not be negative")
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	offset := 0
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return pageRequest{}, invalidArgument("invalid page_token")
+		}
+		offset, err = strconv.Atoi(string(raw))
+		if err != nil || offset < 0 {
+			return pageRequest{}, invalidArgument("invalid page_token")
+		}
+	}
+
+	return pageRequest{Size: size, Offset: offset}, nil
+}
+
+// Limit is the number of rows to fetch. One extra row is requested so that the
+// presence of a next page can be detected without a count query.
+func (p pageRequest) Limit() int {
+	return p.Size + 1
+}
+
+// trimPage cuts an over-fetched page down to the requested size and returns the
+// token for the next page, or "" if this is the last page.
+func trimPage[T any](p pageRequest, items []T) ([]T, string) {
+	if len(items) <= p.Size {
+		return items, ""
+	}
+	next := strconv.Itoa(p.Offset + p.Size)
+	return items[:p.Size], base64.RawURLEncoding.EncodeToString([]byte(next))
+}
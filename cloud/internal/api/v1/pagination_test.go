@@ -0,0 +1,42 @@
+package api
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePageRequest(t *testing.T) {
+	page, err := parsePageRequest(0, "")
+	require.NoError(t, err)
+	require.Equal(t, pageRequest{Size: defaultPageSize}, page)
+
+	page, err = parsePageRequest(maxPageSize+1, "")
+	require.NoError(t, err)
+	require.Equal(t, maxPageSize, page.Size)
+
+	_, err = parsePageRequest(-1, "")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = parsePageRequest(10, "not a token")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestTrimPage(t *testing.T) {
+	page, err := parsePageRequest(2, "")
+	require.NoError(t, err)
+	require.Equal(t, 3, page.Limit())
+
+	items, next := trimPage(page, []int{1, 2, 3})
+	require.Equal(t, []int{1, 2}, items)
+	require.NotEmpty(t, next)
+
+	page, err = parsePageRequest(2, next)
+	require.NoError(t, err)
+	require.Equal(t, 2, page.Offset)
+
+	items, next = trimPage(page, []int{3})
+	require.Equal(t, []int{3}, items)
+	require.Empty(t, next)
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// maxSAMLResponseBytes bounds the size of SAML responses posted to the ACS.
+const maxSAMLResponseBytes = 1 << 20
+
+// SAMLHandler serves the browser-facing SAML SSO endpoints: starting a login,
+// the assertion consumer service the identity provider posts back to, and
+// service provider metadata.
+type SAMLHandler struct {
+	identityService *service.IdentityService
+	logger          log.Logger
+}
+
+// NewSAMLHandler creates a new SAML handler.
+func NewSAMLHandler(identityService *service.IdentityService, logger log.Logger) *SAMLHandler {
+	return &SAMLHandler{identityService: identityService, logger: logger}
+}
+
+// HandleLogin redirects the browser to the identity provider of the
+// organization given by the "organization" query parameter. After the login
+// the user is sent to "redirect_url".
+func (h *SAMLHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
+	slug := r.URL.Query().Get("organization")
+	if slug == "" {
+		http.Error(w, "missing organization", http.StatusBadRequest)
+		return
+	}
+
+	requestURL, err := h.identityService.InitiateSAMLLogin(r.Context(), slug, r.URL.Query().Get("redirect_url"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, requestURL, http.StatusFound)
+}
+
+// HandleACS is the assertion consumer service. It completes the login, sets
+// the auth cookies and redirects to where the login asked to go.
+func (h *SAMLHandler) HandleACS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxSAMLResponseBytes)
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+	samlResponse := r.PostForm.Get("SAMLResponse")
+	if samlResponse == "" {
+		http.Error(w, "missing SAMLResponse", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.identityService.CompleteSAMLLogin(r.Context(), samlResponse, r.PostForm.Get("RelayState"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "access_token",
+		Value:    result.AccessToken,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   int(time.Until(result.ExpiresAt).Seconds()),
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "refresh_token",
+		Value:    result.RefreshToken,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   int(time.Until(result.RefreshExpiresAt).Seconds()),
+	})
+
+	// The IdP posted here, so redirect with a GET.
+	http.Redirect(w, r, result.RedirectURL, http.StatusSeeOther)
+}
+
+// HandleMetadata serves the SAML metadata of the service provider of the
+// organization whose ID ends the path.
+func (h *SAMLHandler) HandleMetadata(w http.ResponseWriter, r *http.Request) {
+	orgID, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, service.SAMLMetadataPath))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	metadata, err := h.identityService.SAMLServiceProviderMetadata(r.Context(), orgID)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write(metadata)
+}
+
+// writeError reports a service error with a matching HTTP status. Anything
+// that is not a service error is logged and not shown to the browser.
+func (h *SAMLHandler) writeError(w http.ResponseWriter, err error) {
+	var (
+		invalidArgument    *serviceerror.InvalidArgument
+		notFound           *serviceerror.NotFound
+		failedPrecondition *serviceerror.FailedPrecondition
+		permissionDenied   *serviceerror.PermissionDenied
+	)
+	switch {
+	case errors.As(err, &invalidArgument):
+		http.Error(w, invalidArgument.Error(), http.StatusBadRequest)
+	case errors.As(err, &failedPrecondition):
+		http.Error(w, failedPrecondition.Error(), http.StatusBadRequest)
+	case errors.As(err, &notFound):
+		http.Error(w, notFound.Error(), http.StatusNotFound)
+	case errors.As(err, &permissionDenied):
+		http.Error(w, permissionDenied.Error(), http.StatusForbidden)
+	default:
+		h.logger.Error("SAML request failed", tag.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,449 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/scim"
+	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// scimMaxResults bounds the page size of SCIM queries.
+	scimMaxResults = 100
+	// maxSCIMRequestBytes bounds the size of SCIM request bodies.
+	maxSCIMRequestBytes = 1 << 20
+)
+
+// SCIMHandler serves the SCIM 2.0 API identity providers provision an
+// organization's users and groups through. Requests authenticate with the
+// organization's SCIM bearer token.
+type SCIMHandler struct {
+	scimService *service.SCIMService
+	logger      log.Logger
+	mux         *http.ServeMux
+}
+
+// NewSCIMHandler creates a new SCIM handler. Mount it at
+// service.SCIMBasePath + "/".
+func NewSCIMHandler(scimService *service.SCIMService, logger log.Logger) *SCIMHandler {
+	h := &SCIMHandler{scimService: scimService, logger: logger, mux: http.NewServeMux()}
+	base := service.SCIMBasePath
+	h.mux.HandleFunc("GET "+base+"/ServiceProviderConfig", h.handleServiceProviderConfig)
+	h.mux.HandleFunc("GET "+base+"/Users", h.handleListUsers)
+	h.mux.HandleFunc("POST "+base+"/Users", h.handleCreateUser)
+	h.mux.HandleFunc("GET "+base+"/Users/{id}", h.handleGetUser)
+	h.mux.HandleFunc("PUT "+base+"/Users/{id}", h.handleReplaceUser)
+	h.mux.HandleFunc("PATCH "+base+"/Users/{id}", h.handlePatchUser)
+	h.mux.HandleFunc("DELETE "+base+"/Users/{id}", h.handleDeleteUser)
+	h.mux.HandleFunc("GET "+base+"/Groups", h.handleListGroups)
+	h.mux.HandleFunc("POST "+base+"/Groups", h.handleCreateGroup)
+	h.mux.HandleFunc("GET "+base+"/Groups/{id}", h.handleGetGroup)
+	h.mux.HandleFunc("PUT "+base+"/Groups/{id}", h.handleReplaceGroup)
+	h.mux.HandleFunc("PATCH "+base+"/Groups/{id}", h.handlePatchGroup)
+	h.mux.HandleFunc("DELETE "+base+"/Groups/{id}", h.handleDeleteGroup)
+	return h
+}
+
+type scimOrgKey struct{}
+
+// ServeHTTP implements http.Handler.
+func (h *SCIMHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		h.writeError(w, scim.NewError(http.StatusUnauthorized, "", "missing bearer token"))
+		return
+	}
+	orgID, err := h.scimService.Authenticate(r.Context(), token)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if orgID == uuid.Nil {
+		h.writeError(w, scim.NewError(http.StatusUnauthorized, "", "invalid bearer token"))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxSCIMRequestBytes)
+	h.mux.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), scimOrgKey{}, orgID)))
+}
+
+func scimOrganization(r *http.Request) uuid.UUID {
+	orgID, _ := r.Context().Value(scimOrgKey{}).(uuid.UUID)
+	return orgID
+}
+
+func (h *SCIMHandler) handleServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, scim.ServiceProviderConfig(scimMaxResults))
+}
+
+func (h *SCIMHandler) handleListUsers(w http.ResponseWriter, r *http.Request) {
+	q, err := parseSCIMQuery(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	orgID := scimOrganization(r)
+	users, total, err := h.scimService.ListUsers(r.Context(), orgID, q.filter, q.count, q.startIndex-1)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	resources := make([]*scim.User, 0, len(users))
+	for _, user := range users {
+		resource, err := h.userResource(r, user)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		resources = append(resources, resource)
+	}
+	h.writeJSON(w, http.StatusOK, scim.NewListResponse(resources, total, q.startIndex))
+}
+
+func (h *SCIMHandler) handleCreateUser(w http.ResponseWriter, r *http.Request) {
+	var in scim.User
+	if err := decodeSCIM(r, &in); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	user, err := h.scimService.CreateUser(r.Context(), scimOrganization(r), &in)
+	h.writeUser(w, r, http.StatusCreated, user, err)
+}
+
+func (h *SCIMHandler) handleGetUser(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	user, err := h.scimService.GetUser(r.Context(), scimOrganization(r), id)
+	h.writeUser(w, r, http.StatusOK, user, err)
+}
+
+func (h *SCIMHandler) handleReplaceUser(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	var in scim.User
+	if err := decodeSCIM(r, &in); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	user, err := h.scimService.ReplaceUser(r.Context(), scimOrganization(r), id, &in)
+	h.writeUser(w, r, http.StatusOK, user, err)
+}
+
+func (h *SCIMHandler) handlePatchUser(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	var req scim.PatchRequest
+	if err := decodeSCIM(r, &req); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	user, err := h.scimService.PatchUser(r.Context(), scimOrganization(r), id, &req)
+	h.writeUser(w, r, http.StatusOK, user, err)
+}
+
+func (h *SCIMHandler) handleDeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := h.scimService.DeleteUser(r.Context(), scimOrganization(r), id); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SCIMHandler) handleListGroups(w http.ResponseWriter, r *http.Request) {
+	q, err := parseSCIMQuery(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	groups, total, err := h.scimService.ListGroups(r.Context(), scimOrganization(r), q.filter, q.count, q.startIndex-1)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	resources := make([]*scim.Group, 0, len(groups))
+	for _, group := range groups {
+		resource, err := h.groupResource(r, group, !q.excludesMembers)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		resources = append(resources, resource)
+	}
+	h.writeJSON(w, http.StatusOK, scim.NewListResponse(resources, total, q.startIndex))
+}
+
+func (h *SCIMHandler) handleCreateGroup(w http.ResponseWriter, r *http.Request) {
+	var in scim.Group
+	if err := decodeSCIM(r, &in); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	group, err := h.scimService.CreateGroup(r.Context(), scimOrganization(r), &in)
+	h.writeGroup(w, r, http.StatusCreated, group, err)
+}
+
+func (h *SCIMHandler) handleGetGroup(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	group, err := h.scimService.GetGroup(r.Context(), scimOrganization(r), id)
+	h.writeGroup(w, r, http.StatusOK, group, err)
+}
+
+func (h *SCIMHandler) handleReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	var in scim.Group
+	if err := decodeSCIM(r, &in); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	group, err := h.scimService.ReplaceGroup(r.Context(), scimOrganization(r), id, &in)
+	h.writeGroup(w, r, http.StatusOK, group, err)
+}
+
+func (h *SCIMHandler) handlePatchGroup(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	var req scim.PatchRequest
+	if err := decodeSCIM(r, &req); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	group, err := h.scimService.PatchGroup(r.Context(), scimOrganization(r), id, &req)
+	h.writeGroup(w, r, http.StatusOK, group, err)
+}
+
+func (h *SCIMHandler) handleDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	id, err := scimResourceID(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := h.scimService.DeleteGroup(r.Context(), scimOrganization(r), id); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeUser writes a user resource, or err if it is not nil.
+func (h *SCIMHandler) writeUser(w http.ResponseWriter, r *http.Request, status int, user *repository.OrganizationUser, err error) {
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	resource, err := h.userResource(r, user)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if status == http.StatusCreated {
+		w.Header().Set("Location", resource.Meta.Location)
+	}
+	h.writeJSON(w, status, resource)
+}
+
+// writeGroup writes a group resource, or err if it is not nil.
+func (h *SCIMHandler) writeGroup(w http.ResponseWriter, r *http.Request, status int, group *repository.UserGroup, err error) {
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	resource, err := h.groupResource(r, group, true)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if status == http.StatusCreated {
+		w.Header().Set("Location", resource.Meta.Location)
+	}
+	h.writeJSON(w, status, resource)
+}
+
+func (h *SCIMHandler) userResource(r *http.Request, user *repository.OrganizationUser) (*scim.User, error) {
+	groups, err := h.scimService.ListUserGroups(r.Context(), user.OrganizationID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	active := user.Active
+	resource := &scim.User{
+		Schemas:     []string{scim.SchemaUser},
+		ID:          user.ID.String(),
+		ExternalID:  user.ExternalID.String,
+		UserName:    user.Email,
+		DisplayName: user.Name.String,
+		Emails:      []scim.Email{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &scim.Meta{
+			ResourceType: "User",
+			Created:      user.JoinedAt,
+			LastModified: user.UpdatedAt,
+			Location:     scimLocation(r, "Users", user.ID),
+		},
+	}
+	if user.Name.Valid {
+		resource.Name = &scim.Name{Formatted: user.Name.String}
+	}
+	for _, group := range groups {
+		resource.Groups = append(resource.Groups, scim.Reference{
+			Value:   group.ID.String(),
+			Display: group.Name,
+			Ref:     scimLocation(r, "Groups", group.ID),
+		})
+	}
+	return resource, nil
+}
+
+func (h *SCIMHandler) groupResource(r *http.Request, group *repository.UserGroup, withMembers bool) (*scim.Group, error) {
+	resource := &scim.Group{
+		Schemas:     []string{scim.SchemaGroup},
+		ID:          group.ID.String(),
+		ExternalID:  group.ExternalID.String,
+		DisplayName: group.Name,
+		Meta: &scim.Meta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+			Location:     scimLocation(r, "Groups", group.ID),
+		},
+	}
+	if !withMembers {
+		return resource, nil
+	}
+	members, err := h.scimService.ListGroupMembers(r.Context(), group.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		resource.Members = append(resource.Members, scim.Reference{
+			Value:   member.UserID.String(),
+			Display: member.Email,
+			Ref:     scimLocation(r, "Users", member.UserID),
+		})
+	}
+	return resource, nil
+}
+
+// scimLocation returns the URL of a resource, relative to the URL the client
+// reached the API at.
+func scimLocation(r *http.Request, resourceType string, id uuid.UUID) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return fmt.Sprintf("%s://%s%s/%s/%s", scheme, r.Host, service.SCIMBasePath, resourceType, id)
+}
+
+// scimQuery holds the query parameters of a list request.
+type scimQuery struct {
+	filter *scim.Filter
+	// startIndex is 1-based.
+	startIndex int
+	count      int
+	// excludesMembers is set by excludedAttributes=members, which identity
+	// providers send to look up large groups cheaply.
+	excludesMembers bool
+}
+
+func parseSCIMQuery(r *http.Request) (*scimQuery, error) {
+	params := r.URL.Query()
+	q := &scimQuery{startIndex: 1, count: scimMaxResults}
+	if s := params.Get("filter"); s != "" {
+		filter, err := scim.ParseFilter(s)
+		if err != nil {
+			return nil, err
+		}
+		q.filter = filter
+	}
+	// Out of range values are clamped as RFC 7644 section 3.4.2.4 requires.
+	if s := params.Get("startIndex"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, scim.BadRequest(scim.ErrorInvalidValue, "invalid startIndex %q", s)
+		}
+		q.startIndex = max(n, 1)
+	}
+	if s := params.Get("count"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, scim.BadRequest(scim.ErrorInvalidValue, "invalid count %q", s)
+		}
+		q.count = min(max(n, 0), scimMaxResults)
+	}
+	for _, attr := range strings.Split(params.Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			q.excludesMembers = true
+		}
+	}
+	return q, nil
+}
+
+func scimResourceID(r *http.Request) (uuid.UUID, error) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		return uuid.Nil, scim.NewError(http.StatusNotFound, "", fmt.Sprintf("resource %q not found", r.PathValue("id")))
+	}
+	return id, nil
+}
+
+func decodeSCIM(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return scim.BadRequest(scim.ErrorInvalidSyntax, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func (h *SCIMHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Warn("Failed to write SCIM response", tag.Error(err))
+	}
+}
+
+// writeError writes a SCIM error response. Errors other than *scim.Error are
+// logged and reported as internal errors.
+func (h *SCIMHandler) writeError(w http.ResponseWriter, err error) {
+	var scimErr *scim.Error
+	if !errors.As(err, &scimErr) {
+		h.logger.Error("SCIM request failed", tag.Error(err))
+		scimErr = scim.NewError(http.StatusInternalServerError, "", "internal error")
+	}
+	h.writeJSON(w, scimErr.StatusCode(), scimErr)
+}
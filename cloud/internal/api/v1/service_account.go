@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+)
+
+// CreateServiceAccount implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) CreateServiceAccount(ctx context.Context, req *connect.Request[cloudv1.CreateServiceAccountRequest]) (*connect.Response[cloudv1.CreateServiceAccountResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	perms, err := serviceAccountPermissions(req.Msg.GetNamespacePermissions())
+	if err != nil {
+		return nil, err
+	}
+
+	sa, err := h.service.CreateServiceAccount(ctx, &service.ServiceAccountInput{
+		OrganizationID: orgID,
+		Name:           req.Msg.GetName(),
+		Description:    req.Msg.GetDescription(),
+		AccountRole:    req.Msg.GetAccountRole(),
+		Permissions:    perms,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.CreateServiceAccountResponse{ServiceAccount: serviceAccountToProto(sa)}), nil
+}
+
+// GetServiceAccount implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) GetServiceAccount(ctx context.Context, req *connect.Request[cloudv1.GetServiceAccountRequest]) (*connect.Response[cloudv1.GetServiceAccountResponse], error) {
+	id, err := parseUUID("service_account_id", req.Msg.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	sa, err := h.service.GetServiceAccount(ctx, id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.GetServiceAccountResponse{ServiceAccount: serviceAccountToProto(sa)}), nil
+}
+
+// ListServiceAccounts implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) ListServiceAccounts(ctx context.Context, req *connect.Request[cloudv1.ListServiceAccountsRequest]) (*connect.Response[cloudv1.ListServiceAccountsResponse], error) {
+	orgID, err := parseUUID("organization_id", req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	page, err := parsePageRequest(req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := h.service.ListServiceAccounts(ctx, orgID, page.Limit(), page.Offset)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	accounts, next := trimPage(page, accounts)
+
+	resp := &cloudv1.ListServiceAccountsResponse{NextPageToken: next}
+	for _, sa := range accounts {
+		resp.ServiceAccounts = append(resp.ServiceAccounts, serviceAccountToProto(sa))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateServiceAccount implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) UpdateServiceAccount(ctx context.Context, req *connect.Request[cloudv1.UpdateServiceAccountRequest]) (*connect.Response[cloudv1.UpdateServiceAccountResponse], error) {
+	id, err := parseUUID("service_account_id", req.Msg.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+	perms, err := serviceAccountPermissions(req.Msg.GetNamespacePermissions())
+	if err != nil {
+		return nil, err
+	}
+
+	sa, err := h.service.UpdateServiceAccount(ctx, id, &service.ServiceAccountInput{
+		Name:        req.Msg.GetName(),
+		Description: req.Msg.GetDescription(),
+		AccountRole: req.Msg.GetAccountRole(),
+		Permissions: perms,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.UpdateServiceAccountResponse{ServiceAccount: serviceAccountToProto(sa)}), nil
+}
+
+// DeleteServiceAccount implements cloudv1connect.IdentityServiceHandler.
+func (h *IdentityHandler) DeleteServiceAccount(ctx context.Context, req *connect.Request[cloudv1.DeleteServiceAccountRequest]) (*connect.Response[cloudv1.DeleteServiceAccountResponse], error) {
+	id, err := parseUUID("service_account_id", req.Msg.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteServiceAccount(ctx, id); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&cloudv1.DeleteServiceAccountResponse{}), nil
+}
+
+func serviceAccountPermissions(perms []*cloudv1.NamespacePermission) ([]*repository.ServiceAccountNamespacePermission, error) {
+	out := make([]*repository.ServiceAccountNamespacePermission, 0, len(perms))
+	for _, perm := range perms {
+		if perm.GetNamespaceId() == "" {
+			return nil, invalidArgument("namespace_id is required")
+		}
+		out = append(out, &repository.ServiceAccountNamespacePermission{
+			NamespaceID: perm.GetNamespaceId(),
+			Permission:  perm.GetPermission(),
+		})
+	}
+	return out, nil
+}
+
+func serviceAccountToProto(sa *service.ServiceAccountWithPermissions) *cloudv1.ServiceAccount {
+	pb := &cloudv1.ServiceAccount{
+		Id:             sa.ID.String(),
+		OrganizationId: sa.OrganizationID.String(),
+		Name:           sa.Name,
+		Description:    sa.Description.String,
+		AccountRole:    sa.AccountRole.String,
+		CreatedAt:      timestampOrNil(sa.CreatedAt),
+		UpdatedAt:      timestampOrNil(sa.UpdatedAt),
+	}
+	for _, perm := range sa.Permissions {
+		pb.NamespacePermissions = append(pb.NamespacePermissions, &cloudv1.NamespacePermission{
+			NamespaceId: perm.NamespaceID,
+			Permission:  perm.Permission,
+		})
+	}
+	return pb
+}
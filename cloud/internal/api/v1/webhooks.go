@@ -0,0 +1,51 @@
+package api
+
+import (
+	"io"
+	"net/http"
+
+	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/cloud/internal/stripe"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// maxWebhookBodyBytes bounds the size of webhook payloads that are read.
+const maxWebhookBodyBytes = 1 << 20
+
+// StripeWebhookHandler receives Stripe webhook events.
+type StripeWebhookHandler struct {
+	billingService *service.BillingService
+	logger         log.Logger
+}
+
+// NewStripeWebhookHandler creates a new Stripe webhook handler.
+func NewStripeWebhookHandler(billingService *service.BillingService, logger log.Logger) *StripeWebhookHandler {
+	return &StripeWebhookHandler{billingService: billingService, logger: logger}
+}
+
+// ServeHTTP handles a webhook delivery. Requests without a valid signature are
+// rejected; processing failures return a 500 so that Stripe retries them.
+func (h *StripeWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	err = h.billingService.HandleStripeWebhook(r.Context(), payload, r.Header.Get(stripe.SignatureHeader))
+	if service.IsInvalidWebhookSignature(err) {
+		http.Error(w, "invalid signature", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to handle Stripe webhook", tag.Error(err))
+		http.Error(w, "failed to process event", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,320 @@
+package auditexport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+var (
+	testOrgID    = uuid.MustParse("6a1f0c9e-3a49-4c3b-9df1-0f3c2b9b7c11")
+	testChainKey = ChainKey("test-secret", testOrgID)
+)
+
+func testEvents() []*repository.AuditEvent {
+	ip := net.ParseIP("203.0.113.7")
+	created := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+	return []*repository.AuditEvent{
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			OrganizationID: testOrgID,
+			ActorType:      "user",
+			ActorID:        "user-1",
+			ActorEmail:     sql.NullString{String: "alice@example.com", Valid: true},
+			Action:         "namespace.create",
+			Result:         "success",
+			ResourceType:   "namespace",
+			ResourceID:     sql.NullString{String: "ns-1", Valid: true},
+			ResourceName:   sql.NullString{String: "payments|prod", Valid: true},
+			IPAddress:      &ip,
+			Method:         sql.NullString{String: "POST", Valid: true},
+			Path:           sql.NullString{String: "/cloud.v1.NamespaceService/CreateNamespace", Valid: true},
+			Details:        json.RawMessage(`{"region":"us-east-1"}`),
+			CreatedAt:      created,
+		},
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			OrganizationID: testOrgID,
+			ActorType:      "service_account",
+			ActorID:        "sa-1",
+			Action:         "DeleteNamespace",
+			Result:         "denied",
+			ResourceType:   "namespace",
+			CreatedAt:      created.Add(time.Second),
+		},
+	}
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	data, err := Encode(FormatNDJSON, testEvents())
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var event ndjsonEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &event))
+	require.Equal(t, "00000000-0000-0000-0000-000000000001", event.ID)
+	require.Equal(t, "2024-05-01T10:00:00.000000Z", event.Time)
+	require.Equal(t, "alice@example.com", event.Actor.Email)
+	require.Equal(t, "203.0.113.7", event.Request.IPAddress)
+	require.JSONEq(t, `{"region":"us-east-1"}`, string(event.Details))
+}
+
+func TestEncodeCEF(t *testing.T) {
+	data, err := Encode(FormatCEF, testEvents())
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	require.True(t, strings.HasPrefix(lines[0], "CEF:0|Temporal|Temporal Cloud|1.0|namespace.create|namespace.create|3|"), lines[0])
+	require.Contains(t, lines[0], "rt=1714557600000")
+	require.Contains(t, lines[0], "src=203.0.113.7")
+	// Pipes are only escaped in the header; equal signs in extensions.
+	require.Contains(t, lines[0], "cs5=payments|prod")
+	require.Contains(t, lines[0], `request=/cloud.v1.NamespaceService/CreateNamespace`)
+	require.True(t, strings.HasPrefix(lines[1], "CEF:0|Temporal|Temporal Cloud|1.0|DeleteNamespace|DeleteNamespace|7|"), lines[1])
+	require.NotContains(t, lines[1], "suser=")
+}
+
+func TestEncodeCEFEscapes(t *testing.T) {
+	event := testEvents()[1]
+	event.Action = `a|b\c`
+	event.ResourceName = sql.NullString{String: "x=y\nz", Valid: true}
+	data, err := Encode(FormatCEF, []*repository.AuditEvent{event})
+	require.NoError(t, err)
+	require.Contains(t, string(data), `|a\|b\\c|a\|b\\c|`)
+	require.Contains(t, string(data), `cs5=x\=y\nz`)
+	require.Equal(t, 1, strings.Count(string(data), "\n"))
+}
+
+func TestEncodeOCSF(t *testing.T) {
+	data, err := Encode(FormatOCSF, testEvents())
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var created, deleted ocsfEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &created))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &deleted))
+	require.Equal(t, ocsfClassAPIActivity, created.ClassUID)
+	require.Equal(t, ocsfActivityCreate, created.ActivityID)
+	require.Equal(t, 600301, created.TypeUID)
+	require.Equal(t, testOrgID.String(), created.Metadata.TenantUID)
+	require.Equal(t, "203.0.113.7", created.SrcEndpoint.IP)
+	require.Equal(t, 1, created.StatusID)
+	require.Equal(t, ocsfActivityDelete, deleted.ActivityID)
+	require.Equal(t, "Failure", deleted.Status)
+	require.Nil(t, deleted.HTTPRequest)
+}
+
+func TestEncodeUnknownFormat(t *testing.T) {
+	_, err := Encode("csv", testEvents())
+	require.Error(t, err)
+	require.Error(t, ValidateFormat("csv"))
+}
+
+func TestVerifyChain(t *testing.T) {
+	events := testEvents()
+	first, err := NewBatch(testChainKey, testOrgID, FormatNDJSON, 1, "", events[:1])
+	require.NoError(t, err)
+	second, err := NewBatch(testChainKey, testOrgID, FormatNDJSON, 2, first.Hash, events[1:])
+	require.NoError(t, err)
+	require.NoError(t, VerifyChain(testChainKey, "", []*Batch{first, second}))
+	// A chain can be verified from any batch on.
+	require.NoError(t, VerifyChain(testChainKey, first.Hash, []*Batch{second}))
+
+	tampered := *first
+	tampered.Data = []byte(strings.Replace(string(first.Data), "success", "failure", 1))
+	require.ErrorContains(t, VerifyChain(testChainKey, "", []*Batch{&tampered, second}), "batch 1 does not match its hash")
+
+	// The hash covers the manifest.
+	tampered = *first
+	tampered.Events++
+	require.ErrorContains(t, VerifyChain(testChainKey, "", []*Batch{&tampered, second}), "batch 1 does not match its hash")
+
+	// Recomputing the tampered batch's hash breaks the link to the next one.
+	tampered.Hash = ChainHash(testChainKey, tampered.Manifest, tampered.Data)
+	require.ErrorContains(t, VerifyChain(testChainKey, "", []*Batch{&tampered, second}), "batch 2 does not follow the previous batch")
+
+	// Hashes cannot be computed without the organization's key.
+	require.ErrorContains(t, VerifyChain(ChainKey("other-secret", testOrgID), "", []*Batch{first}), "batch 1 does not match its hash")
+	require.ErrorContains(t, VerifyChain(ChainKey("test-secret", uuid.New()), "", []*Batch{first}), "batch 1 does not match its hash")
+
+	require.ErrorContains(t, VerifyChain(testChainKey, "", []*Batch{second}), "batch 2 does not follow")
+	third, err := NewBatch(testChainKey, testOrgID, FormatNDJSON, 3, second.Hash, events)
+	require.NoError(t, err)
+	require.ErrorContains(t, VerifyChain(testChainKey, "", []*Batch{first, third}), "batch 3 follows batch 1")
+}
+
+func TestValidateSink(t *testing.T) {
+	for _, tc := range []struct {
+		sinkType string
+		config   string
+		valid    bool
+	}{
+		{SinkTypeS3, `{"bucket":"audit","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/audit"}`, true},
+		{SinkTypeS3, `{"bucket":"audit","region":"us-east-1"}`, false},
+		{SinkTypeS3, `{"bucket":"audit","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/audit","endpoint":"http://127.0.0.1:9000"}`, false},
+		{SinkTypeS3, `{"region":"us-east-1"}`, false},
+		{SinkTypeWebhook, `{"url":"https://siem.example.com/ingest"}`, true},
+		{SinkTypeWebhook, `{"url":"https://203.0.113.7/ingest"}`, true},
+		{SinkTypeWebhook, `{"url":"http://127.0.0.1:8080/ingest"}`, false},
+		{SinkTypeWebhook, `{"url":"http://169.254.169.254/latest/meta-data"}`, false},
+		{SinkTypeWebhook, `{"url":"http://10.0.0.1/ingest"}`, false},
+		{SinkTypeWebhook, `{"url":"http://[::1]/ingest"}`, false},
+		{SinkTypeWebhook, `{"url":"http://[::ffff:192.168.0.1]/ingest"}`, false},
+		{SinkTypeWebhook, `{"url":"ftp://siem.example.com"}`, false},
+		{SinkTypeWebhook, `{"url":"/ingest"}`, false},
+		{SinkTypeFile, `{"dir":"/var/audit"}`, true},
+		{SinkTypeFile, `{"dir":"audit"}`, false},
+		{"syslog", `{}`, false},
+	} {
+		err := ValidateSink(tc.sinkType, json.RawMessage(tc.config))
+		if tc.valid {
+			require.NoError(t, err, "%s %s", tc.sinkType, tc.config)
+		} else {
+			require.Error(t, err, "%s %s", tc.sinkType, tc.config)
+		}
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	config, _ := json.Marshal(FileConfig{Dir: dir})
+	sink, err := NewSink(SinkTypeFile, config, nil)
+	require.NoError(t, err)
+
+	batch, err := NewBatch(testChainKey, testOrgID, FormatCEF, 7, "abc", testEvents())
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), batch))
+
+	name := filepath.Join(dir, testOrgID.String(), "000000000007.cef")
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, batch.Data, data)
+	raw, err := os.ReadFile(name + ".manifest.json")
+	require.NoError(t, err)
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(raw, &manifest))
+	require.Equal(t, batch.Manifest, manifest)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	config, _ := json.Marshal(WebhookConfig{
+		URL:     server.URL,
+		Secret:  "s3cret",
+		Headers: map[string]string{"Authorization": "Splunk token"},
+	})
+	sink, err := NewSink(SinkTypeWebhook, config, server.Client())
+	require.NoError(t, err)
+	batch, err := NewBatch(testChainKey, testOrgID, FormatNDJSON, 1, "", testEvents())
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), batch))
+
+	require.Equal(t, http.MethodPost, got.Method)
+	require.Equal(t, batch.Data, body)
+	require.Equal(t, "application/x-ndjson", got.Header.Get("Content-Type"))
+	require.Equal(t, "Splunk token", got.Header.Get("Authorization"))
+	require.Equal(t, testOrgID.String(), got.Header.Get(HeaderOrganizationID))
+	require.Equal(t, "1", got.Header.Get(HeaderSequence))
+	require.Equal(t, batch.Hash, got.Header.Get(HeaderHash))
+	require.Equal(t, "2", got.Header.Get(HeaderEventCount))
+	require.Equal(t, "sha256="+Sign("s3cret", body), got.Header.Get(HeaderSignature))
+}
+
+func TestWebhookSinkFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config, _ := json.Marshal(WebhookConfig{URL: server.URL})
+	sink, err := NewSink(SinkTypeWebhook, config, server.Client())
+	require.NoError(t, err)
+	batch, err := NewBatch(testChainKey, testOrgID, FormatNDJSON, 1, "", testEvents())
+	require.NoError(t, err)
+	require.ErrorContains(t, sink.Write(context.Background(), batch), "status 503")
+}
+
+func TestWebhookClientRefusesPrivateAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	config, _ := json.Marshal(WebhookConfig{URL: server.URL})
+	sink, err := NewSink(SinkTypeWebhook, config, nil)
+	require.NoError(t, err)
+	batch, err := NewBatch(testChainKey, testOrgID, FormatNDJSON, 1, "", testEvents())
+	require.NoError(t, err)
+	require.ErrorContains(t, sink.Write(context.Background(), batch), "is not public")
+	require.False(t, called)
+}
+
+func TestS3Sink(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	config, _ := json.Marshal(S3Config{
+		Bucket:  "audit",
+		Prefix:  "temporal",
+		Region:  "us-east-1",
+		RoleARN: "arn:aws:iam::123456789012:role/audit",
+	})
+	sink, err := NewSink(SinkTypeS3, config, nil)
+	require.NoError(t, err)
+	var externalID string
+	sink.(*s3Sink).newClient = func(cfg *S3Config, id string) (s3iface.S3API, error) {
+		externalID = id
+		sess, err := session.NewSession(&aws.Config{
+			Region:           aws.String(cfg.Region),
+			Endpoint:         aws.String(server.URL),
+			S3ForcePathStyle: aws.Bool(true),
+			Credentials:      credentials.NewStaticCredentials("test", "test", ""),
+		})
+		return s3.New(sess), err
+	}
+	batch, err := NewBatch(testChainKey, testOrgID, FormatOCSF, 3, "abc", testEvents())
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), batch))
+
+	require.Equal(t, testOrgID.String(), externalID)
+	require.Equal(t, http.MethodPut, got.Method)
+	require.Equal(t, "/audit/temporal/"+testOrgID.String()+"/000000000003.ndjson", got.URL.Path)
+	require.Equal(t, batch.Data, body)
+	require.Equal(t, "3", got.Header.Get("X-Amz-Meta-Audit-Sequence"))
+	require.Equal(t, "abc", got.Header.Get("X-Amz-Meta-Audit-Previous-Hash"))
+	require.Equal(t, batch.Hash, got.Header.Get("X-Amz-Meta-Audit-Hash"))
+}
@@ -0,0 +1,107 @@
+package auditexport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// Batch is a run of consecutive audit events of an organization, encoded in
+// an export format. Batches are numbered from 1, and each batch's hash covers
+// its manifest, including the hash of the batch before it, so a batch that is
+// altered, dropped or reordered breaks the chain from that batch on. Hashes
+// are keyed with the organization's chain key, so that whoever can write to
+// a sink cannot forge a chain.
+type Batch struct {
+	Manifest
+	Data []byte
+}
+
+// Manifest describes a batch. It is delivered alongside the batch's data.
+type Manifest struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Format         string    `json:"format"`
+	Sequence       int64     `json:"sequence"`
+	// PreviousHash is the hash of the batch before, empty for the first
+	// batch.
+	PreviousHash string    `json:"previous_hash"`
+	Hash         string    `json:"hash"`
+	Events       int       `json:"events"`
+	FirstEventID uuid.UUID `json:"first_event_id"`
+	LastEventID  uuid.UUID `json:"last_event_id"`
+}
+
+// ChainKey derives the key of an organization's hash chain from secret.
+func ChainKey(secret string, orgID uuid.UUID) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("audit-chain:" + orgID.String()))
+	return mac.Sum(nil)
+}
+
+// NewBatch encodes events as the batch with the sequence number, following
+// the batch with previousHash, and hashes it with key. Events must not be
+// empty.
+func NewBatch(key []byte, orgID uuid.UUID, format string, sequence int64, previousHash string, events []*repository.AuditEvent) (*Batch, error) {
+	data, err := Encode(format, events)
+	if err != nil {
+		return nil, err
+	}
+	b := &Batch{
+		Manifest: Manifest{
+			OrganizationID: orgID,
+			Format:         format,
+			Sequence:       sequence,
+			PreviousHash:   previousHash,
+			Events:         len(events),
+			FirstEventID:   events[0].ID,
+			LastEventID:    events[len(events)-1].ID,
+		},
+		Data: data,
+	}
+	b.Hash = ChainHash(key, b.Manifest, data)
+	return b, nil
+}
+
+// ChainHash is the hex HMAC-SHA256, keyed with key, of a batch's manifest
+// fields other than its hash, in the order they are declared and each
+// followed by a newline, and then its data.
+func ChainHash(key []byte, m Manifest, data []byte) string {
+	mac := hmac.New(sha256.New, key)
+	for _, field := range []string{
+		m.OrganizationID.String(),
+		m.Format,
+		strconv.FormatInt(m.Sequence, 10),
+		m.PreviousHash,
+		strconv.Itoa(m.Events),
+		m.FirstEventID.String(),
+		m.LastEventID.String(),
+	} {
+		mac.Write([]byte(field + "\n"))
+	}
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyChain checks, with key, that batches are consecutive, that each one
+// follows the one before, the first following previousHash, and that their
+// manifests and data match their hashes.
+func VerifyChain(key []byte, previousHash string, batches []*Batch) error {
+	for i, b := range batches {
+		if i > 0 && b.Sequence != batches[i-1].Sequence+1 {
+			return fmt.Errorf("batch %d follows batch %d", b.Sequence, batches[i-1].Sequence)
+		}
+		if b.PreviousHash != previousHash {
+			return fmt.Errorf("batch %d does not follow the previous batch", b.Sequence)
+		}
+		if !hmac.Equal([]byte(ChainHash(key, b.Manifest, b.Data)), []byte(b.Hash)) {
+			return fmt.Errorf("batch %d does not match its hash", b.Sequence)
+		}
+		previousHash = b.Hash
+	}
+	return nil
+}
@@ -0,0 +1,379 @@
+// Package auditexport encodes audit events for SIEMs and delivers them to
+// export sinks, in batches linked by a hash chain.
+package auditexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// Export formats. Each event is encoded as one line.
+const (
+	// FormatNDJSON is newline-delimited JSON, one object per event.
+	FormatNDJSON = "ndjson"
+	// FormatCEF is ArcSight Common Event Format.
+	FormatCEF = "cef"
+	// FormatOCSF is Open Cybersecurity Schema Framework API Activity events,
+	// one JSON object per line.
+	FormatOCSF = "ocsf"
+)
+
+const (
+	productVendor  = "Temporal"
+	productName    = "Temporal Cloud"
+	productVersion = "1.0"
+	ocsfVersion    = "1.1.0"
+)
+
+// ValidateFormat checks that format is a known export format.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatNDJSON, FormatCEF, FormatOCSF:
+		return nil
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+// Encode encodes events in the format, one line per event.
+func Encode(format string, events []*repository.AuditEvent) ([]byte, error) {
+	var encode func(*repository.AuditEvent) ([]byte, error)
+	switch format {
+	case FormatNDJSON:
+		encode = encodeNDJSON
+	case FormatCEF:
+		encode = encodeCEF
+	case FormatOCSF:
+		encode = encodeOCSF
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+
+	var data []byte
+	for _, event := range events {
+		line, err := encode(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode audit event %s: %w", event.ID, err)
+		}
+		data = append(data, line...)
+		data = append(data, '\n')
+	}
+	return data, nil
+}
+
+// fileExtension is the extension of the files and objects batches are
+// written to.
+func fileExtension(format string) string {
+	if format == FormatCEF {
+		return "cef"
+	}
+	return "ndjson"
+}
+
+// contentType is the media type of a batch.
+func contentType(format string) string {
+	if format == FormatCEF {
+		return "text/plain; charset=utf-8"
+	}
+	return "application/x-ndjson"
+}
+
+type ndjsonEvent struct {
+	ID             string          `json:"id"`
+	OrganizationID string          `json:"organization_id"`
+	Time           string          `json:"time"`
+	Actor          ndjsonActor     `json:"actor"`
+	Action         string          `json:"action"`
+	Result         string          `json:"result"`
+	Resource       ndjsonResource  `json:"resource"`
+	Request        ndjsonRequest   `json:"request"`
+	Details        json.RawMessage `json:"details,omitempty"`
+}
+
+type ndjsonActor struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+type ndjsonResource struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type ndjsonRequest struct {
+	ID        string `json:"id,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	Method    string `json:"method,omitempty"`
+	Path      string `json:"path,omitempty"`
+}
+
+func encodeNDJSON(event *repository.AuditEvent) ([]byte, error) {
+	return json.Marshal(ndjsonEvent{
+		ID:             event.ID.String(),
+		OrganizationID: event.OrganizationID.String(),
+		Time:           event.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		Actor: ndjsonActor{
+			Type:  event.ActorType,
+			ID:    event.ActorID,
+			Email: event.ActorEmail.String,
+			Name:  event.ActorName.String,
+		},
+		Action: event.Action,
+		Result: event.Result,
+		Resource: ndjsonResource{
+			Type: event.ResourceType,
+			ID:   event.ResourceID.String,
+			Name: event.ResourceName.String,
+		},
+		Request: ndjsonRequest{
+			ID:        event.RequestID.String,
+			IPAddress: ipAddress(event),
+			UserAgent: event.UserAgent.String,
+			Method:    event.Method.String,
+			Path:      event.Path.String,
+		},
+		Details: details(event),
+	})
+}
+
+// encodeCEF encodes an event as a CEF line. The signature ID and name are the
+// action; the organization and resource go in custom string fields.
+func encodeCEF(event *repository.AuditEvent) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("CEF:0")
+	for _, field := range []string{productVendor, productName, productVersion, event.Action, event.Action} {
+		b.WriteByte('|')
+		b.WriteString(cefHeaderEscaper.Replace(field))
+	}
+	b.WriteByte('|')
+	b.WriteString(strconv.Itoa(cefSeverity(event.Result)))
+	b.WriteByte('|')
+
+	ext := [][2]string{
+		{"rt", strconv.FormatInt(event.CreatedAt.UnixMilli(), 10)},
+		{"externalId", event.ID.String()},
+		{"outcome", event.Result},
+		{"suid", event.ActorID},
+		{"suser", event.ActorEmail.String},
+		{"cs1Label", "organizationId"},
+		{"cs1", event.OrganizationID.String()},
+		{"cs2Label", "actorType"},
+		{"cs2", event.ActorType},
+		{"cs3Label", "resourceType"},
+		{"cs3", event.ResourceType},
+		{"cs4Label", "resourceId"},
+		{"cs4", event.ResourceID.String},
+		{"cs5Label", "resourceName"},
+		{"cs5", event.ResourceName.String},
+		{"src", ipAddress(event)},
+		{"requestClientApplication", event.UserAgent.String},
+		{"requestMethod", event.Method.String},
+		{"request", event.Path.String},
+		{"cs6Label", "requestId"},
+		{"cs6", event.RequestID.String},
+	}
+	first := true
+	for _, kv := range ext {
+		if kv[1] == "" {
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		b.WriteString(kv[0])
+		b.WriteByte('=')
+		b.WriteString(cefExtensionEscaper.Replace(kv[1]))
+	}
+	return []byte(b.String()), nil
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// cefSeverity maps a result to a CEF severity from 0 to 10.
+func cefSeverity(result string) int {
+	switch result {
+	case "denied":
+		return 7
+	case "failure":
+		return 5
+	default:
+		return 3
+	}
+}
+
+// OCSF API Activity class and its activities.
+const (
+	ocsfCategoryApplication = 6
+	ocsfClassAPIActivity    = 6003
+
+	ocsfActivityCreate = 1
+	ocsfActivityRead   = 2
+	ocsfActivityUpdate = 3
+	ocsfActivityDelete = 4
+	ocsfActivityOther  = 99
+)
+
+type ocsfEvent struct {
+	ActivityID  int             `json:"activity_id"`
+	CategoryUID int             `json:"category_uid"`
+	ClassUID    int             `json:"class_uid"`
+	TypeUID     int             `json:"type_uid"`
+	Time        int64           `json:"time"`
+	SeverityID  int             `json:"severity_id"`
+	StatusID    int             `json:"status_id"`
+	Status      string          `json:"status"`
+	Metadata    ocsfMetadata    `json:"metadata"`
+	Actor       ocsfActor       `json:"actor"`
+	API         ocsfAPI         `json:"api"`
+	SrcEndpoint *ocsfEndpoint   `json:"src_endpoint,omitempty"`
+	HTTPRequest *ocsfHTTP       `json:"http_request,omitempty"`
+	Resources   []ocsfResource  `json:"resources,omitempty"`
+	Unmapped    json.RawMessage `json:"unmapped,omitempty"`
+}
+
+type ocsfMetadata struct {
+	UID       string      `json:"uid"`
+	TenantUID string      `json:"tenant_uid"`
+	Version   string      `json:"version"`
+	Product   ocsfProduct `json:"product"`
+}
+
+type ocsfProduct struct {
+	Name       string `json:"name"`
+	VendorName string `json:"vendor_name"`
+	Version    string `json:"version"`
+}
+
+type ocsfActor struct {
+	User ocsfUser `json:"user"`
+}
+
+type ocsfUser struct {
+	UID       string `json:"uid"`
+	Type      string `json:"type"`
+	EmailAddr string `json:"email_addr,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+type ocsfAPI struct {
+	Operation string       `json:"operation"`
+	Request   *ocsfRequest `json:"request,omitempty"`
+}
+
+type ocsfRequest struct {
+	UID string `json:"uid"`
+}
+
+type ocsfEndpoint struct {
+	IP string `json:"ip"`
+}
+
+type ocsfHTTP struct {
+	UserAgent  string   `json:"user_agent,omitempty"`
+	HTTPMethod string   `json:"http_method,omitempty"`
+	URL        *ocsfURL `json:"url,omitempty"`
+}
+
+type ocsfURL struct {
+	Path string `json:"path"`
+}
+
+type ocsfResource struct {
+	UID  string `json:"uid,omitempty"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+func encodeOCSF(event *repository.AuditEvent) ([]byte, error) {
+	activity := ocsfActivity(event.Action)
+	out := ocsfEvent{
+		ActivityID:  activity,
+		CategoryUID: ocsfCategoryApplication,
+		ClassUID:    ocsfClassAPIActivity,
+		TypeUID:     ocsfClassAPIActivity*100 + activity,
+		Time:        event.CreatedAt.UnixMilli(),
+		SeverityID:  1,
+		StatusID:    1,
+		Status:      "Success",
+		Metadata: ocsfMetadata{
+			UID:       event.ID.String(),
+			TenantUID: event.OrganizationID.String(),
+			Version:   ocsfVersion,
+			Product:   ocsfProduct{Name: productName, VendorName: productVendor, Version: productVersion},
+		},
+		Actor: ocsfActor{User: ocsfUser{
+			UID:       event.ActorID,
+			Type:      event.ActorType,
+			EmailAddr: event.ActorEmail.String,
+			Name:      event.ActorName.String,
+		}},
+		API:       ocsfAPI{Operation: event.Action},
+		Resources: []ocsfResource{{UID: event.ResourceID.String, Type: event.ResourceType, Name: event.ResourceName.String}},
+		Unmapped:  details(event),
+	}
+	if event.Result != "success" {
+		out.StatusID, out.Status, out.SeverityID = 2, "Failure", 3
+	}
+	if event.RequestID.String != "" {
+		out.API.Request = &ocsfRequest{UID: event.RequestID.String}
+	}
+	if ip := ipAddress(event); ip != "" {
+		out.SrcEndpoint = &ocsfEndpoint{IP: ip}
+	}
+	if event.UserAgent.String != "" || event.Method.String != "" || event.Path.String != "" {
+		out.HTTPRequest = &ocsfHTTP{UserAgent: event.UserAgent.String, HTTPMethod: event.Method.String}
+		if event.Path.String != "" {
+			out.HTTPRequest.URL = &ocsfURL{Path: event.Path.String}
+		}
+	}
+	return json.Marshal(out)
+}
+
+// ocsfActivity derives the API activity from the verb of an action, e.g.
+// "namespace.create", or of an RPC name, e.g. "CreateNamespace".
+func ocsfActivity(action string) int {
+	verb := action
+	if i := strings.LastIndexByte(action, '.'); i >= 0 {
+		verb = action[i+1:]
+	}
+	verb = strings.ToLower(verb)
+	switch {
+	case strings.HasPrefix(verb, "create"), strings.HasPrefix(verb, "add"), strings.HasPrefix(verb, "invite"):
+		return ocsfActivityCreate
+	case strings.HasPrefix(verb, "get"), strings.HasPrefix(verb, "list"), strings.HasPrefix(verb, "export"):
+		return ocsfActivityRead
+	case strings.HasPrefix(verb, "update"), strings.HasPrefix(verb, "set"), strings.HasPrefix(verb, "rotate"):
+		return ocsfActivityUpdate
+	case strings.HasPrefix(verb, "delete"), strings.HasPrefix(verb, "remove"), strings.HasPrefix(verb, "revoke"):
+		return ocsfActivityDelete
+	default:
+		return ocsfActivityOther
+	}
+}
+
+func ipAddress(event *repository.AuditEvent) string {
+	if event.IPAddress == nil {
+		return ""
+	}
+	return event.IPAddress.String()
+}
+
+// details returns the event's details if they are valid JSON.
+func details(event *repository.AuditEvent) json.RawMessage {
+	if len(event.Details) == 0 || !json.Valid(event.Details) {
+		return nil
+	}
+	return event.Details
+}
@@ -0,0 +1,288 @@
+package auditexport
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.temporal.io/cloud/internal/objectstore"
+)
+
+// Sink types.
+const (
+	SinkTypeS3      = "s3"
+	SinkTypeWebhook = "webhook"
+	// SinkTypeFile writes to the local filesystem of the worker. It is meant
+	// for development and tests and is not offered through the API.
+	SinkTypeFile = "file"
+)
+
+// Headers of webhook deliveries.
+const (
+	HeaderOrganizationID = "X-Audit-Organization-Id"
+	HeaderSequence       = "X-Audit-Sequence"
+	HeaderPreviousHash   = "X-Audit-Previous-Hash"
+	HeaderHash           = "X-Audit-Hash"
+	HeaderEventCount     = "X-Audit-Event-Count"
+	// HeaderSignature is "sha256=" followed by the hex HMAC-SHA256 of the
+	// body keyed with the webhook's secret. It is only set when the webhook
+	// has a secret.
+	HeaderSignature = "X-Audit-Signature"
+)
+
+// S3Config is the configuration of an S3 sink. Batches are written with the
+// role it names, assumed with the organization's ID as the external ID.
+type S3Config = objectstore.S3Config
+
+// WebhookConfig is the configuration of a webhook sink.
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Secret signs deliveries, see HeaderSignature.
+	Secret string `json:"secret,omitempty"`
+	// Headers are added to each delivery, e.g. for authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// FileConfig is the configuration of a file sink.
+type FileConfig struct {
+	// Dir is the directory batches are written to.
+	Dir string `json:"dir"`
+}
+
+// Sink is a destination of audit event batches. Writing a batch again, as a
+// retry does, replaces it on sinks that store batches; webhooks receive it
+// again and can recognize it by its sequence number.
+type Sink interface {
+	Write(ctx context.Context, batch *Batch) error
+}
+
+// ValidateSink checks that config is a valid configuration for the sink type.
+// Webhook URLs naming an address that is not public are rejected here, and
+// names resolving to one when they are called.
+func ValidateSink(sinkType string, config json.RawMessage) error {
+	sink, err := NewSink(sinkType, config, nil)
+	if err != nil {
+		return err
+	}
+	if webhook, ok := sink.(*webhookSink); ok {
+		u, _ := url.Parse(webhook.cfg.URL)
+		if ip, err := netip.ParseAddr(u.Hostname()); err == nil && !isPublicAddr(ip) {
+			return errors.New("webhook sink requires a public address")
+		}
+	}
+	return nil
+}
+
+// NewSink creates the sink described by its type and configuration. Webhooks
+// are called with httpClient, or a client from NewWebhookClient if it is nil.
+func NewSink(sinkType string, config json.RawMessage, httpClient *http.Client) (Sink, error) {
+	switch sinkType {
+	case SinkTypeS3:
+		cfg, err := objectstore.ParseS3Config(config)
+		if err != nil {
+			return nil, err
+		}
+		return &s3Sink{cfg: cfg, newClient: objectstore.NewS3Client}, nil
+	case SinkTypeWebhook:
+		var cfg WebhookConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid webhook sink config: %w", err)
+		}
+		u, err := url.Parse(cfg.URL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, errors.New("webhook sink requires an http or https url")
+		}
+		if httpClient == nil {
+			httpClient = NewWebhookClient(30 * time.Second)
+		}
+		return &webhookSink{cfg: cfg, client: httpClient}, nil
+	case SinkTypeFile:
+		var cfg FileConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid file sink config: %w", err)
+		}
+		if !filepath.IsAbs(cfg.Dir) {
+			return nil, errors.New("file sink requires an absolute dir")
+		}
+		return &fileSink{cfg: cfg}, nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", sinkType)
+	}
+}
+
+// batchName is the name batches are stored under, relative to the
+// organization's directory. Zero-padding keeps listings in sequence order.
+func batchName(b *Batch) string {
+	return fmt.Sprintf("%012d.%s", b.Sequence, fileExtension(b.Format))
+}
+
+// fileSink writes each batch to a file in the organization's directory, with
+// its manifest next to it.
+type fileSink struct {
+	cfg FileConfig
+}
+
+func (s *fileSink) Write(_ context.Context, b *Batch) error {
+	dir := filepath.Join(s.cfg.Dir, b.OrganizationID.String())
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create export directory: %w", err)
+	}
+	manifest, err := json.Marshal(b.Manifest)
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(dir, batchName(b))
+	if err := writeFileAtomic(name, b.Data); err != nil {
+		return err
+	}
+	return writeFileAtomic(name+".manifest.json", manifest)
+}
+
+func writeFileAtomic(name string, data []byte) error {
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// webhookSink posts each batch to a URL, with its manifest in headers.
+type webhookSink struct {
+	cfg    WebhookConfig
+	client *http.Client
+}
+
+func (s *webhookSink) Write(ctx context.Context, b *Batch) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(b.Data))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	for name, value := range s.cfg.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", contentType(b.Format))
+	req.Header.Set(HeaderOrganizationID, b.OrganizationID.String())
+	req.Header.Set(HeaderSequence, strconv.FormatInt(b.Sequence, 10))
+	req.Header.Set(HeaderPreviousHash, b.PreviousHash)
+	req.Header.Set(HeaderHash, b.Hash)
+	req.Header.Set(HeaderEventCount, strconv.Itoa(b.Events))
+	if s.cfg.Secret != "" {
+		req.Header.Set(HeaderSignature, "sha256="+Sign(s.cfg.Secret, b.Data))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of data keyed with secret.
+func Sign(secret string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewWebhookClient returns a client for calling webhooks. Webhook URLs are
+// chosen by customers, so it only connects to public addresses, checked
+// after name resolution so that a name cannot resolve to the control plane's
+// own network, and ignores proxy settings.
+func NewWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addr, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublicAddr(addr.Addr()) {
+				return fmt.Errorf("webhook address %s is not public", addr.Addr())
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which some clouds use
+// for internal services.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// isPublicAddr reports whether ip is a global unicast address outside the
+// private, loopback, link-local and shared ranges.
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// s3Sink writes each batch to an object under the organization's prefix,
+// with its manifest in the object's metadata. The bucket is written with the
+// configured role, assumed on behalf of the batch's organization.
+type s3Sink struct {
+	cfg       *S3Config
+	newClient func(cfg *S3Config, externalID string) (s3iface.S3API, error)
+}
+
+func (s *s3Sink) Write(ctx context.Context, b *Batch) error {
+	client, err := s.newClient(s.cfg, b.OrganizationID.String())
+	if err != nil {
+		return err
+	}
+
+	key := path.Join(s.cfg.Prefix, b.OrganizationID.String(), batchName(b))
+	_, err = client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.cfg.Bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(b.Data),
+		ContentType: aws.String(contentType(b.Format)),
+		Metadata: map[string]*string{
+			"audit-sequence":       aws.String(strconv.FormatInt(b.Sequence, 10)),
+			"audit-previous-hash":  aws.String(b.PreviousHash),
+			"audit-hash":           aws.String(b.Hash),
+			"audit-event-count":    aws.String(strconv.Itoa(b.Events)),
+			"audit-first-event-id": aws.String(b.FirstEventID.String()),
+			"audit-last-event-id":  aws.String(b.LastEventID.String()),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write s3://%s/%s: %w", s.cfg.Bucket, key, err)
+	}
+	return nil
+}
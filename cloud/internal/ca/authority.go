@@ -0,0 +1,217 @@
+// Package ca implements the per-namespace client certificate authorities used
+// for mTLS between workers and namespaces.
+package ca
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// CAStore stores namespace CAs. It is implemented by
+// repository.CertificateAuthorityRepository.
+type CAStore interface {
+	GetCurrent(ctx context.Context, namespaceID string) (*repository.CertificateAuthority, error)
+	ListTrusted(ctx context.Context, namespaceID string, at time.Time) ([]*repository.CertificateAuthority, error)
+	Rotate(ctx context.Context, ca *repository.CertificateAuthority, retireAt time.Time) error
+	DeleteRetired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// CertificateStore stores the certificates issued for namespaces and their
+// certificate filters. It is implemented by repository.NamespaceRepository.
+type CertificateStore interface {
+	AddCertificate(ctx context.Context, cert *repository.NamespaceCertificate) error
+	ListCertificateFilters(ctx context.Context, namespaceID string) ([]*repository.NamespaceCertificateFilter, error)
+	AddCertificateFilter(ctx context.Context, filter *repository.NamespaceCertificateFilter) error
+}
+
+// Authority issues and rotates namespace client CAs and the leaf certificates
+// they sign.
+type Authority struct {
+	cas    CAStore
+	certs  CertificateStore
+	cipher *KeyCipher
+	cfg    config.CAConfig
+	logger log.Logger
+	now    func() time.Time
+}
+
+// IssuedCertificate is a leaf certificate together with its private key. The
+// key is only ever returned to the caller; it is not stored.
+type IssuedCertificate struct {
+	CertificatePEM string
+	PrivateKeyPEM  string
+	Fingerprint    string
+	ExpiresAt      time.Time
+}
+
+// NewAuthority creates a new certificate authority.
+func NewAuthority(cas CAStore, certs CertificateStore, cfg config.CAConfig, logger log.Logger) (*Authority, error) {
+	cipher, err := NewKeyCipher(cfg.KeyEncryptionSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &Authority{
+		cas:    cas,
+		certs:  certs,
+		cipher: cipher,
+		cfg:    cfg,
+		logger: logger,
+		now:    time.Now,
+	}, nil
+}
+
+// EnsureCA returns the namespace's current CA, creating one if needed.
+func (a *Authority) EnsureCA(ctx context.Context, namespaceID string) (*repository.CertificateAuthority, error) {
+	current, err := a.cas.GetCurrent(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		return current, nil
+	}
+	return a.rotate(ctx, namespaceID, a.now())
+}
+
+// Rotate replaces the namespace's current CA. The previous CA stays trusted
+// for the configured overlap window so that certificates it issued keep
+// working while clients move to the new CA.
+func (a *Authority) Rotate(ctx context.Context, namespaceID string) (*repository.CertificateAuthority, error) {
+	return a.rotate(ctx, namespaceID, a.now().Add(a.cfg.RotationOverlap))
+}
+
+func (a *Authority) rotate(ctx context.Context, namespaceID string, retirePrevious time.Time) (*repository.CertificateAuthority, error) {
+	now := a.now()
+	pair, err := newCA(namespaceID, now, a.cfg.CAValidity)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := marshalKey(pair.key)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := a.cipher.Seal(keyDER)
+	if err != nil {
+		return nil, err
+	}
+
+	ca := &repository.CertificateAuthority{
+		NamespaceID:         namespaceID,
+		CertificatePEM:      pair.certPEM,
+		EncryptedPrivateKey: sealed,
+		Fingerprint:         Fingerprint(pair.cert),
+		NotBefore:           pair.cert.NotBefore,
+		NotAfter:            pair.cert.NotAfter,
+	}
+	if err := a.cas.Rotate(ctx, ca, retirePrevious); err != nil {
+		return nil, err
+	}
+
+	a.logger.Info("Created namespace client CA",
+		tag.WorkflowNamespace(namespaceID), tag.NewStringTag("fingerprint", ca.Fingerprint))
+	return ca, nil
+}
+
+// Bundle returns the PEM bundle of every CA the namespace currently trusts.
+func (a *Authority) Bundle(ctx context.Context, namespaceID string) (string, error) {
+	cas, err := a.cas.ListTrusted(ctx, namespaceID, a.now())
+	if err != nil {
+		return "", err
+	}
+	var bundle strings.Builder
+	for _, ca := range cas {
+		bundle.WriteString(ca.CertificatePEM)
+	}
+	return bundle.String(), nil
+}
+
+// IssueClientCertificate issues a client certificate for the namespace, signed
+// by its current CA.
+func (a *Authority) IssueClientCertificate(ctx context.Context, namespaceID, commonName string) (*IssuedCertificate, error) {
+	current, err := a.EnsureCA(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := a.cipher.Open(current.EncryptedPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	issuer, err := parseKeyPair(current.CertificatePEM, keyDER)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf, err := newLeaf(issuer, namespaceID, commonName, a.now(), a.cfg.LeafValidity)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyPEM, err := keyPEM(leaf.key)
+	if err != nil {
+		return nil, err
+	}
+
+	issued := &IssuedCertificate{
+		CertificatePEM: leaf.certPEM,
+		PrivateKeyPEM:  privateKeyPEM,
+		Fingerprint:    Fingerprint(leaf.cert),
+		ExpiresAt:      leaf.cert.NotAfter,
+	}
+	err = a.certs.AddCertificate(ctx, &repository.NamespaceCertificate{
+		NamespaceID:    namespaceID,
+		CertificatePEM: issued.CertificatePEM,
+		Fingerprint:    issued.Fingerprint,
+		Issuer:         sql.NullString{String: issuer.cert.Subject.String(), Valid: true},
+		Subject:        sql.NullString{String: leaf.cert.Subject.String(), Valid: true},
+		ExpiresAt:      issued.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issued, nil
+}
+
+// PublishCertificateFilter adds the certificate filter matching every client
+// certificate issued for the namespace, unless it is already present.
+func (a *Authority) PublishCertificateFilter(ctx context.Context, namespaceID string) (*repository.NamespaceCertificateFilter, error) {
+	filters, err := a.certs.ListCertificateFilters(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, filter := range filters {
+		if IsIssuedCertificateFilter(filter, namespaceID) {
+			return filter, nil
+		}
+	}
+
+	filter := &repository.NamespaceCertificateFilter{
+		NamespaceID:  namespaceID,
+		Organization: sql.NullString{String: namespaceID, Valid: true},
+	}
+	if err := a.certs.AddCertificateFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// RotationOverlap is how long a superseded CA stays trusted after rotation.
+func (a *Authority) RotationOverlap() time.Duration {
+	return a.cfg.RotationOverlap
+}
+
+// PruneRetired deletes CAs whose overlap window has ended.
+func (a *Authority) PruneRetired(ctx context.Context) (int64, error) {
+	return a.cas.DeleteRetired(ctx, a.now())
+}
+
+// IsIssuedCertificateFilter reports whether filter is the one published by
+// PublishCertificateFilter for the namespace.
+func IsIssuedCertificateFilter(filter *repository.NamespaceCertificateFilter, namespaceID string) bool {
+	return filter.Organization.String == namespaceID &&
+		!filter.CommonName.Valid && !filter.OrganizationalUnit.Valid && !filter.SubjectAlternativeName.Valid
+}
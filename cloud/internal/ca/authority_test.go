@@ -0,0 +1,198 @@
+package ca
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/server/common/log"
+)
+
+type memoryCAStore struct {
+	cas []*repository.CertificateAuthority
+}
+
+func (s *memoryCAStore) GetCurrent(_ context.Context, namespaceID string) (*repository.CertificateAuthority, error) {
+	for _, ca := range s.cas {
+		if ca.NamespaceID == namespaceID && !ca.RetireAt.Valid {
+			return ca, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryCAStore) ListTrusted(_ context.Context, namespaceID string, at time.Time) ([]*repository.CertificateAuthority, error) {
+	var trusted []*repository.CertificateAuthority
+	for _, ca := range slices.Backward(s.cas) {
+		if ca.NamespaceID == namespaceID && (!ca.RetireAt.Valid || ca.RetireAt.Time.After(at)) && ca.NotAfter.After(at) {
+			trusted = append(trusted, ca)
+		}
+	}
+	return trusted, nil
+}
+
+func (s *memoryCAStore) Rotate(_ context.Context, ca *repository.CertificateAuthority, retireAt time.Time) error {
+	for _, previous := range s.cas {
+		if previous.NamespaceID == ca.NamespaceID && !previous.RetireAt.Valid {
+			previous.RetireAt.Time, previous.RetireAt.Valid = retireAt, true
+		}
+	}
+	s.cas = append(s.cas, ca)
+	return nil
+}
+
+func (s *memoryCAStore) DeleteRetired(_ context.Context, before time.Time) (int64, error) {
+	n := len(s.cas)
+	s.cas = slices.DeleteFunc(s.cas, func(ca *repository.CertificateAuthority) bool {
+		return ca.RetireAt.Valid && !ca.RetireAt.Time.After(before)
+	})
+	return int64(n - len(s.cas)), nil
+}
+
+type memoryCertificateStore struct {
+	certs   []*repository.NamespaceCertificate
+	filters []*repository.NamespaceCertificateFilter
+}
+
+func (s *memoryCertificateStore) AddCertificate(_ context.Context, cert *repository.NamespaceCertificate) error {
+	s.certs = append(s.certs, cert)
+	return nil
+}
+
+func (s *memoryCertificateStore) ListCertificateFilters(_ context.Context, namespaceID string) ([]*repository.NamespaceCertificateFilter, error) {
+	var filters []*repository.NamespaceCertificateFilter
+	for _, f := range s.filters {
+		if f.NamespaceID == namespaceID {
+			filters = append(filters, f)
+		}
+	}
+	return filters, nil
+}
+
+func (s *memoryCertificateStore) AddCertificateFilter(_ context.Context, filter *repository.NamespaceCertificateFilter) error {
+	s.filters = append(s.filters, filter)
+	return nil
+}
+
+func newTestAuthority(t *testing.T) (*Authority, *memoryCAStore, *memoryCertificateStore, *time.Time) {
+	cas, certs := &memoryCAStore{}, &memoryCertificateStore{}
+	a, err := NewAuthority(cas, certs, config.CAConfig{
+		KeyEncryptionSecret: "test-secret",
+		CAValidity:          365 * 24 * time.Hour,
+		LeafValidity:        24 * time.Hour,
+		RotationOverlap:     time.Hour,
+	}, log.NewNoopLogger())
+	require.NoError(t, err)
+	now := time.Now()
+	a.now = func() time.Time { return now }
+	return a, cas, certs, &now
+}
+
+func bundlePool(t *testing.T, bundle string) *x509.CertPool {
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM([]byte(bundle)))
+	return pool
+}
+
+func parseCertificatePEM(t *testing.T, certPEM string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(certPEM))
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestEnsureCAIsIdempotent(t *testing.T) {
+	a, cas, _, _ := newTestAuthority(t)
+	ctx := context.Background()
+
+	first, err := a.EnsureCA(ctx, "ns-1")
+	require.NoError(t, err)
+	again, err := a.EnsureCA(ctx, "ns-1")
+	require.NoError(t, err)
+	require.Equal(t, first.Fingerprint, again.Fingerprint)
+	require.Len(t, cas.cas, 1)
+	require.NotContains(t, string(first.EncryptedPrivateKey), "PRIVATE KEY")
+}
+
+func TestRotateKeepsPreviousCATrustedDuringOverlap(t *testing.T) {
+	a, cas, _, now := newTestAuthority(t)
+	ctx := context.Background()
+
+	first, err := a.EnsureCA(ctx, "ns-1")
+	require.NoError(t, err)
+	second, err := a.Rotate(ctx, "ns-1")
+	require.NoError(t, err)
+	require.NotEqual(t, first.Fingerprint, second.Fingerprint)
+
+	bundle, err := a.Bundle(ctx, "ns-1")
+	require.NoError(t, err)
+	require.Equal(t, second.CertificatePEM+first.CertificatePEM, bundle)
+
+	// Pruning before the overlap ends keeps the previous CA.
+	pruned, err := a.PruneRetired(ctx)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	*now = now.Add(a.RotationOverlap())
+	bundle, err = a.Bundle(ctx, "ns-1")
+	require.NoError(t, err)
+	require.Equal(t, second.CertificatePEM, bundle)
+	pruned, err = a.PruneRetired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pruned)
+	require.Len(t, cas.cas, 1)
+}
+
+func TestIssueClientCertificate(t *testing.T) {
+	a, _, certs, _ := newTestAuthority(t)
+	ctx := context.Background()
+
+	issued, err := a.IssueClientCertificate(ctx, "ns-1", "worker")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(issued.PrivateKeyPEM, "-----BEGIN"))
+
+	cert := parseCertificatePEM(t, issued.CertificatePEM)
+	require.Equal(t, "worker", cert.Subject.CommonName)
+	require.Equal(t, issued.Fingerprint, Fingerprint(cert))
+	require.True(t, issued.ExpiresAt.Equal(cert.NotAfter))
+
+	bundle, err := a.Bundle(ctx, "ns-1")
+	require.NoError(t, err)
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:     bundlePool(t, bundle),
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+
+	// The certificate is recorded, the private key is not.
+	require.Len(t, certs.certs, 1)
+	require.Equal(t, issued.Fingerprint, certs.certs[0].Fingerprint)
+	require.NotContains(t, certs.certs[0].CertificatePEM, "PRIVATE KEY")
+
+	// The certificate matches the published filter.
+	filter, err := a.PublishCertificateFilter(ctx, "ns-1")
+	require.NoError(t, err)
+	require.Contains(t, cert.Subject.Organization, filter.Organization.String)
+}
+
+func TestPublishCertificateFilterIsIdempotent(t *testing.T) {
+	a, _, certs, _ := newTestAuthority(t)
+	ctx := context.Background()
+
+	first, err := a.PublishCertificateFilter(ctx, "ns-1")
+	require.NoError(t, err)
+	require.True(t, IsIssuedCertificateFilter(first, "ns-1"))
+	again, err := a.PublishCertificateFilter(ctx, "ns-1")
+	require.NoError(t, err)
+	require.Same(t, first, again)
+	require.Len(t, certs.filters, 1)
+	require.False(t, IsIssuedCertificateFilter(first, "ns-2"))
+}
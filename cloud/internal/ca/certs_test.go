@@ -0,0 +1,78 @@
+package ca
+
+import (
+	"crypto/x509"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeafVerifiesAgainstCA(t *testing.T) {
+	now := time.Now()
+	authority, err := newCA("ns-1", now, 365*24*time.Hour)
+	require.NoError(t, err)
+	require.True(t, authority.cert.IsCA)
+
+	leaf, err := newLeaf(authority, "ns-1", "worker", now, 24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ns-1"}, leaf.cert.Subject.Organization)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(authority.cert)
+	_, err = leaf.cert.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+
+	other, err := newCA("ns-2", now, 365*24*time.Hour)
+	require.NoError(t, err)
+	otherRoots := x509.NewCertPool()
+	otherRoots.AddCert(other.cert)
+	_, err = leaf.cert.Verify(x509.VerifyOptions{
+		Roots:     otherRoots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.Error(t, err)
+}
+
+func TestLeafCappedAtCAExpiry(t *testing.T) {
+	now := time.Now()
+	authority, err := newCA("ns-1", now, time.Hour)
+	require.NoError(t, err)
+
+	leaf, err := newLeaf(authority, "ns-1", "worker", now, 24*time.Hour)
+	require.NoError(t, err)
+	require.False(t, leaf.cert.NotAfter.After(authority.cert.NotAfter))
+}
+
+func TestLeafRequiresCommonName(t *testing.T) {
+	authority, err := newCA("ns-1", time.Now(), time.Hour)
+	require.NoError(t, err)
+
+	_, err = newLeaf(authority, "ns-1", "", time.Now(), time.Hour)
+	require.Error(t, err)
+}
+
+func TestParseKeyPairRoundTrip(t *testing.T) {
+	authority, err := newCA("ns-1", time.Now(), time.Hour)
+	require.NoError(t, err)
+	keyDER, err := marshalKey(authority.key)
+	require.NoError(t, err)
+
+	parsed, err := parseKeyPair(authority.certPEM, keyDER)
+	require.NoError(t, err)
+	require.True(t, parsed.cert.Equal(authority.cert))
+	require.True(t, parsed.key.Equal(authority.key))
+}
+
+func TestFingerprint(t *testing.T) {
+	authority, err := newCA("ns-1", time.Now(), time.Hour)
+	require.NoError(t, err)
+
+	fp := Fingerprint(authority.cert)
+	require.True(t, strings.HasPrefix(fp, "sha256:"))
+	require.Len(t, fp, len("sha256:")+64)
+}
@@ -0,0 +1,55 @@
+package ca
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// KeyCipher encrypts CA private keys at rest with AES-256-GCM. The sealed form
+// is the random nonce followed by the ciphertext.
+type KeyCipher struct {
+	aead cipher.AEAD
+}
+
+// NewKeyCipher creates a cipher whose key is derived from secret.
+func NewKeyCipher(secret string) (*KeyCipher, error) {
+	if secret == "" {
+		return nil, errors.New("key encryption secret is required")
+	}
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return &KeyCipher{aead: aead}, nil
+}
+
+// Seal encrypts plaintext.
+func (c *KeyCipher) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open decrypts a value produced by Seal.
+func (c *KeyCipher) Open(sealed []byte) ([]byte, error) {
+	if len(sealed) < c.aead.NonceSize() {
+		return nil, errors.New("sealed key is too short")
+	}
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt key: %w", err)
+	}
+	return plaintext, nil
+}
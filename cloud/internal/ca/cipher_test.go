@@ -0,0 +1,38 @@
+package ca
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyCipherRoundTrip(t *testing.T) {
+	cipher, err := NewKeyCipher("secret")
+	require.NoError(t, err)
+
+	sealed, err := cipher.Seal([]byte("private key"))
+	require.NoError(t, err)
+	require.NotContains(t, string(sealed), "private key")
+
+	opened, err := cipher.Open(sealed)
+	require.NoError(t, err)
+	require.Equal(t, "private key", string(opened))
+}
+
+func TestKeyCipherRejectsTampering(t *testing.T) {
+	cipher, err := NewKeyCipher("secret")
+	require.NoError(t, err)
+	sealed, err := cipher.Seal([]byte("private key"))
+	require.NoError(t, err)
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = cipher.Open(sealed)
+	require.Error(t, err)
+
+	other, err := NewKeyCipher("other secret")
+	require.NoError(t, err)
+	sealed, err = cipher.Seal([]byte("private key"))
+	require.NoError(t, err)
+	_, err = other.Open(sealed)
+	require.Error(t, err)
+}
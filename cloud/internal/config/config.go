@@ -2,7 +2,9 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/netip"
 	"os"
 	"strconv"
 	"strings"
@@ -11,13 +13,22 @@ import (
 
 // Config holds all configuration for the cloud API service.
 type Config struct {
-	Port      int
-	Database  DatabaseConfig
-	Stripe    StripeConfig
-	JWT       JWTConfig
-	RateLimit RateLimitConfig
-	CORS      CORSConfig
-	Temporal  TemporalConfig
+	Port       int
+	Database   DatabaseConfig
+	Stripe     StripeConfig
+	JWT        JWTConfig
+	RateLimit  RateLimitConfig
+	CORS       CORSConfig
+	Temporal   TemporalConfig
+	CA         CAConfig
+	DNS        DNSConfig
+	SAML       SAMLConfig
+	Mail       MailConfig
+	Invitation InvitationConfig
+	// AuditExport configures audit log exports and streams.
+	AuditExport AuditExportConfig
+	// OrganizationDeletion configures DeleteOrganizationWorkflow.
+	OrganizationDeletion OrganizationDeletionConfig
 }
 
 // DatabaseConfig holds database configuration.
@@ -46,6 +57,9 @@ type StripeConfig struct {
 	SecretKey      string
 	WebhookSecret  string
 	PublishableKey string
+	// APIBase overrides the Stripe API URL, e.g. to point at a fake in tests.
+	APIBase  string
+	Currency string
 }
 
 // JWTConfig holds JWT configuration.
@@ -59,9 +73,128 @@ type JWTConfig struct {
 
 // RateLimitConfig holds rate limiting configuration.
 type RateLimitConfig struct {
-	Enabled        bool
-	RequestsPerSec int
-	BurstSize      int
+	Enabled bool
+	// Backend is where request counters are kept, "redis" to share them
+	// between API servers or "memory".
+	Backend string
+	Redis   RedisConfig
+	Window  time.Duration
+	// IPRequests is the number of requests per window allowed from each
+	// client address, checked before authentication.
+	IPRequests int
+	// TrustedProxies are the networks of the proxies in front of the API
+	// servers. X-Forwarded-For and X-Real-IP are only honoured from them.
+	TrustedProxies []netip.Prefix
+	// Requests is the number of requests per window allowed to callers that
+	// do not belong to an organization.
+	Requests int
+	// PlanRequests is the number of requests per window allowed to each
+	// organization, by subscription plan. Plans not listed get Requests.
+	PlanRequests map[string]int
+}
+
+// RedisConfig holds Redis connection configuration.
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+}
+
+// CAConfig holds configuration for the namespace certificate authorities.
+type CAConfig struct {
+	// KeyEncryptionSecret is used to derive the key that encrypts CA private
+	// keys at rest.
+	KeyEncryptionSecret string
+	CAValidity          time.Duration
+	LeafValidity        time.Duration
+	// RotationOverlap is how long a superseded CA stays trusted after rotation.
+	RotationOverlap time.Duration
+}
+
+// DNSConfig holds configuration for namespace DNS records.
+type DNSConfig struct {
+	// Provider is one of "route53", "zonefile" or "memory".
+	Provider string
+	Zone     string
+	// HostedZoneID is the Route 53 hosted zone that serves Zone.
+	HostedZoneID string
+	// ZoneFile is the zone file written by the zonefile provider.
+	ZoneFile string
+}
+
+// SAMLConfig holds configuration for organizations' SAML service providers.
+type SAMLConfig struct {
+	// BaseURL is the externally visible URL of the API server, from which
+	// service provider entity IDs and the assertion consumer service URL are
+	// derived.
+	BaseURL    string
+	RequestTTL time.Duration
+	ClockSkew  time.Duration
+	// DefaultRedirectURL is where users land after a login that did not ask
+	// for a redirect.
+	DefaultRedirectURL string
+	// AllowedRedirectOrigins are the origins logins may redirect to. They
+	// default to the CORS allowed origins.
+	AllowedRedirectOrigins []string
+}
+
+// MailConfig holds outgoing email configuration.
+type MailConfig struct {
+	// Backend is how messages are sent: "smtp", "file" to write them to Dir,
+	// or "log" to log them.
+	Backend string
+	// From is the sender address, e.g. "Temporal Cloud <noreply@example.com>".
+	From string
+	SMTP SMTPConfig
+	Dir  string
+}
+
+// SMTPConfig holds SMTP server configuration. Connections are upgraded with
+// STARTTLS when the server supports it.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// InvitationConfig holds organization invitation configuration.
+type InvitationConfig struct {
+	// AcceptURL is the console page invitation emails link to, with the
+	// invitation token in its "token" query parameter.
+	AcceptURL string
+	// Expiry is how long an invitation can be accepted after it is sent.
+	Expiry time.Duration
+	// SecretKey signs invitation tokens. It must differ from the JWT secret
+	// key, so that invitation tokens are never valid session tokens.
+	SecretKey string
+}
+
+// AuditExportConfig holds audit export configuration.
+type AuditExportConfig struct {
+	// ChainSecret is used to derive the keys of the organizations' export
+	// hash chains. Changing it breaks existing chains.
+	ChainSecret string
+}
+
+// OrganizationDeletionConfig holds organization deletion configuration.
+type OrganizationDeletionConfig struct {
+	// GracePeriod is how long after deletion is requested an organization is
+	// torn down. Deletion can be cancelled until then.
+	GracePeriod time.Duration
+	// AuditArchive is where a deleted organization's audit log is exported.
+	// The export is skipped if its SinkType is empty.
+	AuditArchive AuditArchiveConfig
+}
+
+// AuditArchiveConfig describes an audit export sink; see auditexport.NewSink.
+// An S3 sink's role is assumed with the deleted organization's ID as the
+// external ID.
+type AuditArchiveConfig struct {
+	SinkType string
+	Config   json.RawMessage
+	// Format is "ndjson", "cef" or "ocsf".
+	Format string
 }
 
 // CORSConfig holds CORS configuration.
@@ -73,6 +206,38 @@ type CORSConfig struct {
 type TemporalConfig struct {
 	HostPort  string
 	Namespace string
+	// TaskQueue is the task queue of the control plane workers.
+	TaskQueue string
+	// Clusters are the Temporal clusters that host cloud namespaces.
+	Clusters []ClusterConfig
+}
+
+// ClusterConfig describes a Temporal cluster that cloud namespaces can be
+// placed on.
+type ClusterConfig struct {
+	ID string `json:"id"`
+	// Name is the cluster's name in the Temporal cluster metadata, used in
+	// namespace replication configs. It defaults to ID.
+	Name     string `json:"name,omitempty"`
+	Region   string `json:"region"`
+	HostPort string `json:"host_port"`
+	// Capacity is the maximum number of namespaces the cluster should host.
+	Capacity int `json:"capacity"`
+	// TLS secures the connections to the cluster's frontend. Without it
+	// connections are not encrypted, which is only suitable for development.
+	TLS *ClusterTLSConfig `json:"tls,omitempty"`
+}
+
+// ClusterTLSConfig holds the TLS settings used to connect to a cluster.
+type ClusterTLSConfig struct {
+	// CertFile and KeyFile hold the control plane's client certificate.
+	CertFile string `json:"cert_file,omitempty"`
+	KeyFile  string `json:"key_file,omitempty"`
+	// CAFile holds the CAs that sign the cluster's server certificate. The
+	// system roots are used if it is empty.
+	CAFile string `json:"ca_file,omitempty"`
+	// ServerName overrides the name the server certificate is verified for.
+	ServerName string `json:"server_name,omitempty"`
 }
 
 // Load loads configuration from environment variables.
@@ -94,6 +259,8 @@ func Load() (*Config, error) {
 			SecretKey:      getEnv("STRIPE_SECRET_KEY", ""),
 			WebhookSecret:  getEnv("STRIPE_WEBHOOK_SECRET", ""),
 			PublishableKey: getEnv("STRIPE_PUBLISHABLE_KEY", ""),
+			APIBase:        getEnv("STRIPE_API_BASE", ""),
+			Currency:       getEnv("STRIPE_CURRENCY", "usd"),
 		},
 		JWT: JWTConfig{
 			SecretKey:     getEnv("JWT_SECRET_KEY", "dev-secret-key-change-in-production"),
@@ -103,9 +270,23 @@ func Load() (*Config, error) {
 			RefreshExpiry: getEnvDuration("JWT_REFRESH_EXPIRY", 7*24*time.Hour),
 		},
 		RateLimit: RateLimitConfig{
-			Enabled:        getEnvBool("RATE_LIMIT_ENABLED", true),
-			RequestsPerSec: getEnvInt("RATE_LIMIT_RPS", 100),
-			BurstSize:      getEnvInt("RATE_LIMIT_BURST", 200),
+			Enabled: getEnvBool("RATE_LIMIT_ENABLED", true),
+			Backend: getEnv("RATE_LIMIT_BACKEND", "memory"),
+			Redis: RedisConfig{
+				Addr:     getEnv("REDIS_HOST", "localhost") + ":" + getEnv("REDIS_PORT", "6379"),
+				Password: getEnv("REDIS_PASSWORD", ""),
+				DB:       getEnvInt("REDIS_DB", 0),
+			},
+			Window:     getEnvDuration("RATE_LIMIT_WINDOW", time.Minute),
+			IPRequests: getEnvInt("RATE_LIMIT_IP_REQUESTS", 1200),
+			Requests:   getEnvInt("RATE_LIMIT_REQUESTS", 600),
+			PlanRequests: map[string]int{
+				"free":             600,
+				"essentials":       3000,
+				"business":         12000,
+				"enterprise":       60000,
+				"mission_critical": 120000,
+			},
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: getEnvSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
@@ -113,8 +294,71 @@ func Load() (*Config, error) {
 		Temporal: TemporalConfig{
 			HostPort:  getEnv("TEMPORAL_HOST_PORT", "localhost:7233"),
 			Namespace: getEnv("TEMPORAL_NAMESPACE", "default"),
+			TaskQueue: getEnv("TEMPORAL_TASK_QUEUE", "cloud-control-plane"),
+		},
+		CA: CAConfig{
+			KeyEncryptionSecret: getEnv("CA_KEY_ENCRYPTION_SECRET", "dev-ca-secret-change-in-production"),
+			CAValidity:          getEnvDuration("CA_VALIDITY", 5*365*24*time.Hour),
+			LeafValidity:        getEnvDuration("CA_LEAF_VALIDITY", 90*24*time.Hour),
+			RotationOverlap:     getEnvDuration("CA_ROTATION_OVERLAP", 30*24*time.Hour),
+		},
+		DNS: DNSConfig{
+			Provider:     getEnv("DNS_PROVIDER", "memory"),
+			Zone:         getEnv("DNS_ZONE", "tmprl.cloud"),
+			HostedZoneID: getEnv("DNS_HOSTED_ZONE_ID", ""),
+			ZoneFile:     getEnv("DNS_ZONE_FILE", ""),
+		},
+		SAML: SAMLConfig{
+			BaseURL:            getEnv("SAML_SP_BASE_URL", "http://localhost:8081"),
+			RequestTTL:         getEnvDuration("SAML_REQUEST_TTL", 10*time.Minute),
+			ClockSkew:          getEnvDuration("SAML_CLOCK_SKEW", 3*time.Minute),
+			DefaultRedirectURL: getEnv("SAML_DEFAULT_REDIRECT_URL", "http://localhost:5173/console/namespaces"),
+		},
+		Mail: MailConfig{
+			Backend: getEnv("MAIL_BACKEND", "log"),
+			From:    getEnv("MAIL_FROM", "Temporal Cloud <noreply@localhost>"),
+			SMTP: SMTPConfig{
+				Host:     getEnv("SMTP_HOST", "localhost"),
+				Port:     getEnvInt("SMTP_PORT", 587),
+				Username: getEnv("SMTP_USERNAME", ""),
+				Password: getEnv("SMTP_PASSWORD", ""),
+			},
+			Dir: getEnv("MAIL_DIR", ""),
+		},
+		Invitation: InvitationConfig{
+			AcceptURL: getEnv("INVITATION_ACCEPT_URL", "http://localhost:5173/console/invitations/accept"),
+			Expiry:    getEnvDuration("INVITATION_EXPIRY", 7*24*time.Hour),
+			SecretKey: getEnv("INVITATION_SECRET_KEY", "dev-invitation-secret-change-in-production"),
+		},
+		AuditExport: AuditExportConfig{
+			ChainSecret: getEnv("AUDIT_CHAIN_SECRET", "dev-audit-chain-secret-change-in-production"),
+		},
+		OrganizationDeletion: OrganizationDeletionConfig{
+			GracePeriod: getEnvDuration("ORG_DELETION_GRACE_PERIOD", 7*24*time.Hour),
+			AuditArchive: AuditArchiveConfig{
+				SinkType: getEnv("ORG_DELETION_AUDIT_SINK", ""),
+				Format:   getEnv("ORG_DELETION_AUDIT_FORMAT", "ndjson"),
+			},
 		},
 	}
+	cfg.SAML.AllowedRedirectOrigins = getEnvSlice("SAML_ALLOWED_REDIRECT_ORIGINS", cfg.CORS.AllowedOrigins)
+
+	if err := getEnvJSON("TEMPORAL_CLUSTERS", &cfg.Temporal.Clusters); err != nil {
+		return nil, err
+	}
+	if err := getEnvJSON("RATE_LIMIT_PLAN_REQUESTS", &cfg.RateLimit.PlanRequests); err != nil {
+		return nil, err
+	}
+	for _, cidr := range getEnvSlice("RATE_LIMIT_TRUSTED_PROXIES", nil) {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_TRUSTED_PROXIES: %w", err)
+		}
+		cfg.RateLimit.TrustedProxies = append(cfg.RateLimit.TrustedProxies, prefix)
+	}
+	if err := getEnvJSON("ORG_DELETION_AUDIT_SINK_CONFIG", &cfg.OrganizationDeletion.AuditArchive.Config); err != nil {
+		return nil, err
+	}
 
 	return cfg, nil
 }
@@ -159,3 +403,12 @@ func getEnvSlice(key string, defaultValue []string) []string {
 	}
 	return defaultValue
 }
+
+func getEnvJSON(key string, target any) error {
+	if value := os.Getenv(key); value != "" {
+		if err := json.Unmarshal([]byte(value), target); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Package export writes the histories of closed workflow executions of cloud
+// namespaces to customer-owned storage, reusing the server's history
+// archivers and their encoding.
+package export
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const listPageSize = 100
+
+// Clients returns the Temporal client of a cluster.
+type Clients interface {
+	Client(clusterID string) (client.Client, error)
+}
+
+// Progress is how far an export has got. Exports resume from it.
+type Progress struct {
+	// PageToken continues the listing of the executions to export; it is
+	// empty before the first page.
+	PageToken []byte
+	Workflows int64
+	Bytes     int64
+}
+
+// Exporter writes workflow histories to export sinks.
+type Exporter struct {
+	clients Clients
+	logger  log.Logger
+}
+
+// NewExporter creates a new exporter.
+func NewExporter(clients Clients, logger log.Logger) *Exporter {
+	return &Exporter{
+		clients: clients,
+		logger:  logger,
+	}
+}
+
+// Export writes the histories of the namespace's executions that closed
+// between start and end to the sink, continuing from progress. Report is
+// called with the progress after each page of executions. Exporting an
+// execution again overwrites the previous copy, so exports are safe to retry.
+func (e *Exporter) Export(ctx context.Context, ns *repository.Namespace, exportSink *repository.ExportSink, start, end time.Time, progress Progress, report func(context.Context, Progress) error) (Progress, error) {
+	if !ns.ClusterID.Valid {
+		return progress, fmt.Errorf("namespace %s is not placed on a cluster", ns.ID)
+	}
+	cl, err := e.clients.Client(ns.ClusterID.String)
+	if err != nil {
+		return progress, err
+	}
+	s, err := parseSink(exportSink.SinkType, exportSink.Config)
+	if err != nil {
+		return progress, err
+	}
+	reader := newHistoryReader(cl.WorkflowService())
+	historyArchiver, err := s.newArchiver(ctx, reader, ns.OrganizationID.String(), e.logger)
+	if err != nil {
+		return progress, fmt.Errorf("failed to create archiver for sink %s: %w", exportSink.ID, err)
+	}
+
+	query := fmt.Sprintf("CloseTime >= %q AND CloseTime < %q",
+		start.UTC().Format(time.RFC3339Nano), end.UTC().Format(time.RFC3339Nano))
+	for {
+		resp, err := cl.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     ns.ID,
+			PageSize:      listPageSize,
+			NextPageToken: progress.PageToken,
+			Query:         query,
+		})
+		if err != nil {
+			return progress, fmt.Errorf("failed to list workflows of namespace %s: %w", ns.ID, err)
+		}
+		for _, execution := range resp.GetExecutions() {
+			bytes, err := e.exportExecution(ctx, cl, reader, historyArchiver, s.uri, ns.ID, execution)
+			if err != nil {
+				return progress, err
+			}
+			if bytes > 0 {
+				progress.Workflows++
+				progress.Bytes += bytes
+			}
+		}
+		progress.PageToken = resp.GetNextPageToken()
+		if len(progress.PageToken) == 0 {
+			break
+		}
+		if err := report(ctx, progress); err != nil {
+			return progress, err
+		}
+	}
+
+	e.logger.Debug("Exported workflow histories",
+		tag.WorkflowNamespace(ns.ID), tag.NewStringTag("sink", exportSink.ID.String()), tag.NewInt64("workflows", progress.Workflows))
+	return progress, nil
+}
+
+// exportExecution archives the history of an execution and returns the number
+// of bytes of history written, or zero if the execution no longer exists.
+func (e *Exporter) exportExecution(ctx context.Context, cl client.Client, reader *historyReader, historyArchiver archiver.HistoryArchiver, uri archiver.URI, namespace string, execution *workflowpb.WorkflowExecutionInfo) (int64, error) {
+	workflowID := execution.GetExecution().GetWorkflowId()
+	runID := execution.GetExecution().GetRunId()
+
+	// The archivers check the history they read against its last event.
+	last, err := cl.WorkflowService().GetWorkflowExecutionHistoryReverse(ctx, &workflowservice.GetWorkflowExecutionHistoryReverseRequest{
+		Namespace:       namespace,
+		Execution:       execution.GetExecution(),
+		MaximumPageSize: 1,
+	})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read history of workflow %s/%s: %w", workflowID, runID, err)
+	}
+	events := last.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	token := encodeBranchToken(namespace, workflowID, runID)
+	err = historyArchiver.Archive(ctx, uri, &archiver.ArchiveHistoryRequest{
+		NamespaceID:          namespace,
+		Namespace:            namespace,
+		WorkflowID:           workflowID,
+		RunID:                runID,
+		BranchToken:          token,
+		NextEventID:          events[0].GetEventId() + 1,
+		CloseFailoverVersion: events[0].GetVersion(),
+	})
+	bytes := reader.forget(token)
+	if err != nil {
+		return 0, fmt.Errorf("failed to export workflow %s/%s: %w", workflowID, runID, err)
+	}
+	return bytes, nil
+}
@@ -0,0 +1,151 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/temporaltest"
+)
+
+type testClients struct {
+	client client.Client
+}
+
+func (c testClients) Client(clusterID string) (client.Client, error) {
+	return c.client, nil
+}
+
+func exportedWorkflow(ctx workflow.Context, activities int) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+	for i := 0; i < activities; i++ {
+		if err := workflow.ExecuteActivity(ctx, exportedActivity, i).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportedActivity(_ context.Context, i int) (string, error) {
+	return fmt.Sprint(i), nil
+}
+
+func TestExport(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t))
+	ts.NewWorker("export", func(registry worker.Registry) {
+		registry.RegisterWorkflow(exportedWorkflow)
+		registry.RegisterActivity(exportedActivity)
+	})
+	cl := ts.GetDefaultClient()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	start := time.Now().Add(-time.Minute)
+	var runs []client.WorkflowRun
+	// The long history spans several frontend pages.
+	for _, activities := range []int{1, 100} {
+		run, err := cl.ExecuteWorkflow(ctx, client.StartWorkflowOptions{TaskQueue: "export"}, exportedWorkflow, activities)
+		require.NoError(t, err)
+		require.NoError(t, run.Get(ctx, nil))
+		runs = append(runs, run)
+	}
+	end := time.Now().Add(time.Minute)
+
+	dir := t.TempDir()
+	config, err := json.Marshal(FileConfig{Path: dir})
+	require.NoError(t, err)
+	ns := &repository.Namespace{ID: ts.GetDefaultNamespace(), ClusterID: sql.NullString{String: "test", Valid: true}}
+	sink := &repository.ExportSink{ID: uuid.New(), SinkType: SinkTypeFile, Config: config}
+	exporter := NewExporter(testClients{client: cl}, log.NewNoopLogger())
+	noReport := func(context.Context, Progress) error { return nil }
+
+	var progress Progress
+	require.Eventually(t, func() bool {
+		progress, err = exporter.Export(ctx, ns, sink, start, end, Progress{}, noReport)
+		require.NoError(t, err)
+		return progress.Workflows == int64(len(runs))
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Positive(t, progress.Bytes)
+	require.Empty(t, progress.PageToken)
+
+	s, err := parseSink(sink.SinkType, sink.Config)
+	require.NoError(t, err)
+	historyArchiver, err := s.newArchiver(ctx, newHistoryReader(cl.WorkflowService()), "", log.NewNoopLogger())
+	require.NoError(t, err)
+	for _, run := range runs {
+		var want []*historypb.HistoryEvent
+		iter := cl.GetWorkflowHistory(ctx, run.GetID(), run.GetRunID(), false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		for iter.HasNext() {
+			event, err := iter.Next()
+			require.NoError(t, err)
+			want = append(want, event)
+		}
+
+		resp, err := historyArchiver.Get(ctx, s.uri, &archiver.GetHistoryRequest{
+			NamespaceID: ns.ID,
+			WorkflowID:  run.GetID(),
+			RunID:       run.GetRunID(),
+			PageSize:    1000,
+		})
+		require.NoError(t, err)
+		var got []*historypb.HistoryEvent
+		for _, batch := range resp.HistoryBatches {
+			got = append(got, batch.GetEvents()...)
+		}
+		require.Len(t, got, len(want))
+		for i := range want {
+			require.Equal(t, want[i].GetEventId(), got[i].GetEventId())
+			require.Equal(t, want[i].GetEventType(), got[i].GetEventType())
+		}
+	}
+
+	// Nothing closed in a later window.
+	progress, err = exporter.Export(ctx, ns, sink, end, end.Add(time.Hour), Progress{}, noReport)
+	require.NoError(t, err)
+	require.Zero(t, progress.Workflows)
+}
+
+func TestValidateSink(t *testing.T) {
+	for _, tc := range []struct {
+		sinkType string
+		config   string
+		uri      string
+		valid    bool
+	}{
+		{SinkTypeS3, `{"bucket":"histories","prefix":"prod/ns","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/export"}`, "s3://histories/prod/ns", true},
+		{SinkTypeS3, `{"bucket":"histories","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/export"}`, "s3://histories/", true},
+		{SinkTypeS3, `{"bucket":"histories","region":"us-east-1"}`, "", false},
+		{SinkTypeS3, `{"bucket":"histories","region":"us-east-1","role_arn":"export"}`, "", false},
+		{SinkTypeS3, `{"bucket":"histories","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/export","endpoint":"http://169.254.169.254"}`, "", false},
+		{SinkTypeS3, `{"bucket":"histories"}`, "", false},
+		{SinkTypeGCS, `{"bucket":"histories","prefix":"ns","service_account":"export@customer-project.iam.gserviceaccount.com"}`, "gs://histories/ns", true},
+		{SinkTypeGCS, `{"bucket":"histories","prefix":"ns"}`, "", false},
+		{SinkTypeGCS, `{"prefix":"ns"}`, "", false},
+		{SinkTypeFile, `{"path":"/var/export/../histories"}`, "file:///var/histories", true},
+		{SinkTypeFile, `{"path":"histories"}`, "", false},
+		{"azure", `{}`, "", false},
+		{SinkTypeS3, `[]`, "", false},
+	} {
+		s, err := parseSink(tc.sinkType, json.RawMessage(tc.config))
+		if !tc.valid {
+			require.Error(t, err, tc.config)
+			continue
+		}
+		require.NoError(t, err, tc.config)
+		require.Equal(t, tc.uri, s.uri.String())
+		require.NoError(t, ValidateSink(tc.sinkType, json.RawMessage(tc.config)))
+	}
+}
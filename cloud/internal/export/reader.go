@@ -0,0 +1,149 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/proto"
+)
+
+// historyPageSize is the number of events read from the frontend at a time.
+const historyPageSize = 250
+
+// branchToken identifies the execution an archive request reads. The
+// archivers pass it through to the history reader untouched.
+type branchToken struct {
+	Namespace  string `json:"namespace"`
+	WorkflowID string `json:"workflow_id"`
+	RunID      string `json:"run_id"`
+}
+
+func encodeBranchToken(namespace, workflowID, runID string) []byte {
+	// A struct of strings always marshals.
+	token, _ := json.Marshal(branchToken{Namespace: namespace, WorkflowID: workflowID, RunID: runID})
+	return token
+}
+
+// historyReader serves the history reads of the archivers from a cluster's
+// frontend instead of its persistence.
+//
+// The iterator reads a history in order, asking for the events from a given
+// ID on, and may repeat a read to look ahead. The reader keeps a cursor per
+// history holding the last page read from the frontend, so each page is
+// fetched once.
+type historyReader struct {
+	service workflowservice.WorkflowServiceClient
+
+	mu      sync.Mutex
+	cursors map[string]*historyCursor
+}
+
+type historyCursor struct {
+	// page is the last page read, if it has events.
+	page *historypb.History
+	// from is the first event ID the cursor can still serve.
+	from      int64
+	pageToken []byte
+	done      bool
+	bytes     int64
+}
+
+var _ persistence.HistoryBranchReader = (*historyReader)(nil)
+
+func newHistoryReader(service workflowservice.WorkflowServiceClient) *historyReader {
+	return &historyReader{
+		service: service,
+		cursors: make(map[string]*historyCursor),
+	}
+}
+
+// ReadHistoryBranchByBatch returns the page of history containing
+// request.MinEventID, or NotFound when the history has no such event.
+func (r *historyReader) ReadHistoryBranchByBatch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	var token branchToken
+	if err := json.Unmarshal(request.BranchToken, &token); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid branch token: %v", err))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := string(request.BranchToken)
+	cursor := r.cursors[key]
+	if cursor == nil || request.MinEventID < cursor.from {
+		cursor = &historyCursor{from: 1}
+		r.cursors[key] = cursor
+	}
+
+	for {
+		if cursor.page != nil {
+			events := cursor.page.GetEvents()
+			if events[len(events)-1].GetEventId() >= request.MinEventID {
+				batch := cursor.page
+				if events[0].GetEventId() < request.MinEventID {
+					batch = &historypb.History{}
+					for _, event := range events {
+						if event.GetEventId() >= request.MinEventID {
+							batch.Events = append(batch.Events, event)
+						}
+					}
+				}
+				return &persistence.ReadHistoryBranchByBatchResponse{
+					History: []*historypb.History{batch},
+					Size:    proto.Size(batch),
+				}, nil
+			}
+			cursor.from = events[len(events)-1].GetEventId() + 1
+			cursor.page = nil
+		}
+		if cursor.done {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("no history events from event %d", request.MinEventID))
+		}
+		if err := r.readPage(ctx, token, cursor); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *historyReader) readPage(ctx context.Context, token branchToken, cursor *historyCursor) error {
+	resp, err := r.service.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace:              token.Namespace,
+		Execution:              &commonpb.WorkflowExecution{WorkflowId: token.WorkflowID, RunId: token.RunID},
+		MaximumPageSize:        historyPageSize,
+		NextPageToken:          cursor.pageToken,
+		HistoryEventFilterType: enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT,
+	})
+	if err != nil {
+		return err
+	}
+	cursor.pageToken = resp.GetNextPageToken()
+	cursor.done = len(cursor.pageToken) == 0
+	if len(resp.GetHistory().GetEvents()) > 0 {
+		cursor.page = resp.GetHistory()
+		cursor.bytes += int64(proto.Size(cursor.page))
+	}
+	return nil
+}
+
+// forget drops the cursor of a history and returns the number of bytes read
+// for it from the frontend.
+func (r *historyReader) forget(token []byte) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := string(token)
+	var bytes int64
+	if cursor := r.cursors[key]; cursor != nil {
+		bytes = cursor.bytes
+	}
+	delete(r.cursors, key)
+	return bytes
+}
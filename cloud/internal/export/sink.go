@@ -0,0 +1,121 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	"go.temporal.io/cloud/internal/objectstore"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/gcloud"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+// Sink types.
+const (
+	SinkTypeS3  = "s3"
+	SinkTypeGCS = "gcs"
+	// SinkTypeFile writes to the local filesystem of the worker. It is meant
+	// for development and tests and is not offered through the API.
+	SinkTypeFile = "file"
+)
+
+// S3Config is the configuration of an S3 sink.
+type S3Config = objectstore.S3Config
+
+// GCSConfig is the configuration of a Google Cloud Storage sink.
+type GCSConfig = objectstore.GCSConfig
+
+// FileConfig is the configuration of a file sink.
+type FileConfig struct {
+	Path string `json:"path"`
+}
+
+// ValidateSink checks that config is a valid configuration for the sink type.
+func ValidateSink(sinkType string, config json.RawMessage) error {
+	_, err := parseSink(sinkType, config)
+	return err
+}
+
+// sink is a parsed sink configuration.
+type sink struct {
+	sinkType string
+	// uri is the archival URI histories are written to.
+	uri archiver.URI
+	s3  *S3Config
+	gcs *GCSConfig
+}
+
+func parseSink(sinkType string, raw json.RawMessage) (*sink, error) {
+	s := &sink{sinkType: sinkType}
+	var uri string
+	var err error
+	switch sinkType {
+	case SinkTypeS3:
+		if s.s3, err = objectstore.ParseS3Config(raw); err != nil {
+			return nil, err
+		}
+		uri = bucketURI(s3store.URIScheme, s.s3.Bucket, s.s3.Prefix)
+	case SinkTypeGCS:
+		if s.gcs, err = objectstore.ParseGCSConfig(raw); err != nil {
+			return nil, err
+		}
+		uri = bucketURI(gcloud.URIScheme, s.gcs.Bucket, s.gcs.Prefix)
+	case SinkTypeFile:
+		var cfg FileConfig
+		if err := json.Unmarshal(raw, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid file sink config: %w", err)
+		}
+		if !path.IsAbs(cfg.Path) {
+			return nil, errors.New("file sink requires an absolute path")
+		}
+		uri = filestore.URIScheme + "://" + path.Clean(cfg.Path)
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", sinkType)
+	}
+
+	if s.uri, err = archiver.NewURI(uri); err != nil {
+		return nil, fmt.Errorf("invalid sink location: %w", err)
+	}
+	return s, nil
+}
+
+// bucketURI builds a bucket URI. The GCS archiver requires a path, so the
+// bucket root is written as "/".
+func bucketURI(scheme, bucket, prefix string) string {
+	u := url.URL{Scheme: scheme, Host: bucket, Path: path.Join("/", prefix)}
+	return u.String()
+}
+
+// newArchiver creates the archiver writing to the sink, reading histories
+// through reader. Buckets are written with the credentials of the customer
+// identified by customerID, never the worker's own.
+func (s *sink) newArchiver(ctx context.Context, reader persistence.HistoryBranchReader, customerID string, logger log.Logger) (archiver.HistoryArchiver, error) {
+	switch s.sinkType {
+	case SinkTypeS3:
+		client, err := objectstore.NewS3Client(s.s3, customerID)
+		if err != nil {
+			return nil, err
+		}
+		return s3store.NewHistoryArchiverWithClient(reader, logger, metrics.NoopMetricsHandler, client), nil
+	case SinkTypeGCS:
+		client, err := objectstore.NewGCSClient(ctx, s.gcs)
+		if err != nil {
+			return nil, err
+		}
+		return gcloud.NewHistoryArchiverWithClient(reader, logger, metrics.NoopMetricsHandler, client), nil
+	default:
+		return filestore.NewHistoryArchiver(reader, logger, metrics.NoopMetricsHandler, &config.FilestoreArchiver{
+			FileMode: "0644",
+			DirMode:  "0755",
+		})
+	}
+}
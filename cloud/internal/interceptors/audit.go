@@ -90,8 +90,8 @@ func (i *AuditInterceptor) logAuditEvent(ctx context.Context, req connect.AnyReq
 
 	event := &service.AuditEventInput{
 		OrganizationID: authInfo.OrganizationID,
-		ActorType:      i.getActorType(authInfo),
-		ActorID:        authInfo.UserID.String(),
+		ActorType:      actorType(authInfo),
+		ActorID:        actorID(authInfo).String(),
 		ActorEmail:     authInfo.Email,
 		Action:         action,
 		Result:         result,
@@ -113,13 +113,20 @@ func (i *AuditInterceptor) logAuditEvent(ctx context.Context, req connect.AnyReq
 	}
 }
 
-func (i *AuditInterceptor) getActorType(authInfo *AuthInfo) string {
-	if authInfo.IsAPIKey {
+func actorType(authInfo *AuthInfo) string {
+	if authInfo.ServiceAccountID != uuid.Nil {
 		return "service_account"
 	}
 	return "user"
 }
 
+func actorID(authInfo *AuthInfo) uuid.UUID {
+	if authInfo.ServiceAccountID != uuid.Nil {
+		return authInfo.ServiceAccountID
+	}
+	return authInfo.UserID
+}
+
 func (i *AuditInterceptor) extractResourceType(procedure string) string {
 	if strings.Contains(procedure, "Organization") {
 		return "organization"
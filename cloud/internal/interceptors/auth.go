@@ -10,6 +10,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"go.temporal.io/cloud/api/cloud/v1/cloudv1connect"
 	"go.temporal.io/cloud/internal/service"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -27,6 +28,22 @@ type AuthInfo struct {
 	Permissions    []string
 	IsAPIKey       bool
 	APIKeyID       uuid.UUID
+	// ServiceAccountID is set when the caller is a service account, using
+	// one of its API keys. UserID is then unset.
+	ServiceAccountID uuid.UUID
+}
+
+// publicProcedures are served without authentication, because they are how
+// users obtain credentials.
+var publicProcedures = map[string]bool{
+	cloudv1connect.IdentityServiceInitiateSAMLLoginProcedure: true,
+	cloudv1connect.IdentityServiceCompleteSAMLLoginProcedure: true,
+}
+
+// isPublicProcedure reports whether a procedure is served without
+// authentication.
+func isPublicProcedure(procedure string) bool {
+	return strings.HasPrefix(procedure, "/grpc.health") || publicProcedures[procedure]
 }
 
 // AuthInterceptor handles authentication for gRPC requests.
@@ -46,8 +63,8 @@ func NewAuthInterceptor(identityService *service.IdentityService, logger log.Log
 // WrapUnary implements connect.Interceptor.
 func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		// Skip auth for health checks
-		if strings.HasPrefix(req.Spec().Procedure, "/grpc.health") {
+		// Skip auth for health checks and logins
+		if isPublicProcedure(req.Spec().Procedure) {
 			return next(ctx, req)
 		}
 
@@ -110,6 +127,17 @@ func (i *AuthInterceptor) validateAPIKey(ctx context.Context, key string) (*Auth
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
+	if apiKey.OwnerType == service.APIKeyOwnerServiceAccount {
+		return &AuthInfo{
+			OrganizationID:   apiKey.OrganizationID,
+			Role:             apiKey.Role,
+			Permissions:      apiKey.Permissions,
+			IsAPIKey:         true,
+			APIKeyID:         apiKey.ID,
+			ServiceAccountID: apiKey.OwnerID,
+		}, nil
+	}
+
 	return &AuthInfo{
 		UserID:      apiKey.OwnerID,
 		IsAPIKey:    true,
@@ -127,11 +155,23 @@ func (i *AuthInterceptor) validateJWT(ctx context.Context, tokenString string) (
 	userID, _ := uuid.Parse(claims["sub"].(string))
 	orgID, _ := uuid.Parse(claims["org_id"].(string))
 
+	// Tokens scoped to an organization carry the namespace permissions the
+	// user holds there, directly or through groups. Resolving them per
+	// request means SCIM deprovisioning takes effect immediately.
+	var permissions []string
+	if orgID != uuid.Nil {
+		permissions, err = i.identityService.UserPermissions(ctx, userID, orgID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &AuthInfo{
 		UserID:         userID,
 		Email:          claims["email"].(string),
 		OrganizationID: orgID,
 		Role:           claims["role"].(string),
+		Permissions:    permissions,
 		IsAPIKey:       false,
 	}, nil
 }
@@ -0,0 +1,284 @@
+package interceptors
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/api/cloud/v1/cloudv1connect"
+	"go.temporal.io/cloud/internal/service"
+)
+
+// roleSet is a set of organization roles.
+type roleSet map[string]bool
+
+func roles(names ...string) roleSet {
+	set := make(roleSet, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
+
+var (
+	readRoles         = roles("owner", "admin", "developer", "read_only", "finance")
+	developerRoles    = roles("owner", "admin", "developer")
+	adminRoles        = roles("owner", "admin")
+	ownerRoles        = roles("owner")
+	billingReadRoles  = roles("owner", "admin", "finance")
+	billingWriteRoles = roles("owner", "finance")
+)
+
+// resourceRef identifies the resource a request acts on.
+type resourceRef struct {
+	kind service.ResourceKind
+	// field is the request field holding the ID, for error messages.
+	field string
+	id    string
+}
+
+// policy is the authorization policy of an API method.
+type policy struct {
+	// resource extracts the resource the request acts on. Methods without a
+	// resource, or requests whose resource is the caller itself, are allowed
+	// for any authenticated caller.
+	resource func(req any) resourceRef
+	// roles are the organization roles that may call the method on resources
+	// of the organization.
+	roles roleSet
+	// namespacePermission, if set, is the namespace permission level that
+	// also allows calling the method on a namespace.
+	namespacePermission string
+	// allowOwner allows the principal a resource belongs to, such as the
+	// owner of an API key, regardless of role.
+	allowOwner bool
+	// usersOnly denies service accounts.
+	usersOnly bool
+	// check, if set, further restricts callers allowed by role. It returns
+	// why the call is denied, or "" if it is allowed.
+	check func(role string, req any) string
+	// entitlement, if set, is what the method requires of the plan of the
+	// organization the resource belongs to. Every method that changes a
+	// namespace or billing declares one.
+	entitlement service.Entitlement
+}
+
+func byOrganization(req any) resourceRef {
+	r := req.(interface{ GetOrganizationId() string })
+	return resourceRef{kind: service.ResourceOrganization, field: "organization_id", id: r.GetOrganizationId()}
+}
+
+func byNamespace(req any) resourceRef {
+	r := req.(interface{ GetNamespaceId() string })
+	return resourceRef{kind: service.ResourceNamespace, field: "namespace_id", id: r.GetNamespaceId()}
+}
+
+func byAPIKey(req any) resourceRef {
+	r := req.(interface{ GetApiKeyId() string })
+	return resourceRef{kind: service.ResourceAPIKey, field: "api_key_id", id: r.GetApiKeyId()}
+}
+
+// byAPIKeyOwner resolves the owner of the API keys a request creates or lists.
+// Without an owner, it is the caller.
+func byAPIKeyOwner(req any) resourceRef {
+	r := req.(interface {
+		GetOwnerType() string
+		GetOwnerId() string
+	})
+	switch {
+	case r.GetOwnerId() == "":
+		return resourceRef{}
+	case r.GetOwnerType() == service.APIKeyOwnerServiceAccount:
+		return resourceRef{kind: service.ResourceServiceAccount, field: "owner_id", id: r.GetOwnerId()}
+	default:
+		return resourceRef{kind: service.ResourceUser, field: "owner_id", id: r.GetOwnerId()}
+	}
+}
+
+func byServiceAccount(req any) resourceRef {
+	r := req.(interface{ GetServiceAccountId() string })
+	return resourceRef{kind: service.ResourceServiceAccount, field: "service_account_id", id: r.GetServiceAccountId()}
+}
+
+func byInvitation(req any) resourceRef {
+	r := req.(interface{ GetInvitationId() string })
+	return resourceRef{kind: service.ResourceInvitation, field: "invitation_id", id: r.GetInvitationId()}
+}
+
+func byInvoice(req any) resourceRef {
+	r := req.(interface{ GetInvoiceId() string })
+	return resourceRef{kind: service.ResourceInvoice, field: "invoice_id", id: r.GetInvoiceId()}
+}
+
+func byAuditEvent(req any) resourceRef {
+	r := req.(interface{ GetEventId() string })
+	return resourceRef{kind: service.ResourceAuditEvent, field: "event_id", id: r.GetEventId()}
+}
+
+func byAuditStream(req any) resourceRef {
+	r := req.(interface{ GetStreamId() string })
+	return resourceRef{kind: service.ResourceAuditStream, field: "stream_id", id: r.GetStreamId()}
+}
+
+// onlyOwnersGrantOwner keeps admins from making anyone, themselves included,
+// an owner.
+func onlyOwnersGrantOwner(role string, req any) string {
+	r := req.(interface {
+		GetRole() cloudv1.OrganizationRole
+	})
+	if r.GetRole() == cloudv1.OrganizationRole_ORGANIZATION_ROLE_OWNER && role != "owner" {
+		return "only owners can grant the owner role"
+	}
+	return ""
+}
+
+// policies maps every authenticated API method to its authorization policy.
+// Methods without a policy are denied.
+var policies = map[string]*policy{
+	// Organizations
+	cloudv1connect.OrganizationServiceCreateOrganizationProcedure: {usersOnly: true},
+	cloudv1connect.OrganizationServiceListOrganizationsProcedure:  {usersOnly: true},
+	cloudv1connect.OrganizationServiceGetOrganizationProcedure:    {resource: byOrganization, roles: readRoles},
+	cloudv1connect.OrganizationServiceUpdateOrganizationProcedure: {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.OrganizationServiceDeleteOrganizationProcedure: {resource: byOrganization, roles: ownerRoles},
+	cloudv1connect.OrganizationServiceListMembersProcedure:        {resource: byOrganization, roles: readRoles},
+	cloudv1connect.OrganizationServiceUpdateMemberRoleProcedure:   {resource: byOrganization, roles: adminRoles, check: onlyOwnersGrantOwner},
+	cloudv1connect.OrganizationServiceRemoveMemberProcedure:       {resource: byOrganization, roles: adminRoles},
+
+	// Deleting is for owners, but any admin can cancel a deletion during its
+	// grace period.
+	cloudv1connect.OrganizationServiceCancelOrganizationDeletionProcedure: {resource: byOrganization, roles: adminRoles},
+
+	// Invitations. Only users can invite, as invitations name their sender.
+	cloudv1connect.OrganizationServiceInviteUserProcedure:       {resource: byOrganization, roles: adminRoles, usersOnly: true, check: onlyOwnersGrantOwner},
+	cloudv1connect.OrganizationServiceListInvitationsProcedure:  {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.OrganizationServiceResendInvitationProcedure: {resource: byInvitation, roles: adminRoles},
+	cloudv1connect.OrganizationServiceRevokeInvitationProcedure: {resource: byInvitation, roles: adminRoles},
+	// Invitations are accepted and declined by the user they were sent to,
+	// who is not yet a member.
+	cloudv1connect.OrganizationServiceAcceptInvitationProcedure:  {usersOnly: true},
+	cloudv1connect.OrganizationServiceDeclineInvitationProcedure: {usersOnly: true},
+
+	// Namespaces
+	cloudv1connect.NamespaceServiceCreateNamespaceProcedure:                 {resource: byOrganization, roles: developerRoles, entitlement: service.EntitlementChange},
+	cloudv1connect.NamespaceServiceListNamespacesProcedure:                  {resource: byOrganization, roles: readRoles},
+	cloudv1connect.NamespaceServiceGetNamespaceProcedure:                    {resource: byNamespace, roles: readRoles, namespacePermission: "read"},
+	cloudv1connect.NamespaceServiceUpdateNamespaceProcedure:                 {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementChange},
+	cloudv1connect.NamespaceServiceDeleteNamespaceProcedure:                 {resource: byNamespace, roles: adminRoles, namespacePermission: "admin", entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceAddSearchAttributesProcedure:             {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementChange},
+	cloudv1connect.NamespaceServiceRemoveSearchAttributeProcedure:           {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceAddCertificateFilterProcedure:            {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementChange},
+	cloudv1connect.NamespaceServiceRemoveCertificateFilterProcedure:         {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceFailoverNamespaceProcedure:               {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceIssueClientCertificateProcedure:          {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceCreateExportSinkProcedure:                {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementHistoryExport},
+	cloudv1connect.NamespaceServiceGetExportSinkProcedure:                   {resource: byNamespace, roles: readRoles, namespacePermission: "read"},
+	cloudv1connect.NamespaceServiceListExportSinksProcedure:                 {resource: byNamespace, roles: readRoles, namespacePermission: "read"},
+	cloudv1connect.NamespaceServiceUpdateExportSinkProcedure:                {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementHistoryExport},
+	cloudv1connect.NamespaceServiceDeleteExportSinkProcedure:                {resource: byNamespace, roles: developerRoles, namespacePermission: "admin", entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceListExportJobsProcedure:                  {resource: byNamespace, roles: readRoles, namespacePermission: "read"},
+	cloudv1connect.NamespaceServiceCreateConnectivityRuleProcedure:          {resource: byOrganization, roles: adminRoles, entitlement: service.EntitlementChange},
+	cloudv1connect.NamespaceServiceGetConnectivityRuleProcedure:             {resource: byOrganization, roles: readRoles},
+	cloudv1connect.NamespaceServiceListConnectivityRulesProcedure:           {resource: byOrganization, roles: readRoles},
+	cloudv1connect.NamespaceServiceUpdateConnectivityRuleProcedure:          {resource: byOrganization, roles: adminRoles, entitlement: service.EntitlementChange},
+	cloudv1connect.NamespaceServiceDeleteConnectivityRuleProcedure:          {resource: byOrganization, roles: adminRoles, entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceAddNamespaceConnectivityRuleProcedure:    {resource: byNamespace, roles: adminRoles, namespacePermission: "admin", entitlement: service.EntitlementChange},
+	cloudv1connect.NamespaceServiceRemoveNamespaceConnectivityRuleProcedure: {resource: byNamespace, roles: adminRoles, namespacePermission: "admin", entitlement: service.EntitlementNone},
+	cloudv1connect.NamespaceServiceListNamespaceConnectivityRulesProcedure:  {resource: byNamespace, roles: readRoles, namespacePermission: "read"},
+	cloudv1connect.NamespaceServiceCreateNexusEndpointProcedure:             {resource: byOrganization, roles: adminRoles, entitlement: service.EntitlementNexus},
+	cloudv1connect.NamespaceServiceGetNexusEndpointProcedure:                {resource: byOrganization, roles: readRoles},
+	cloudv1connect.NamespaceServiceListNexusEndpointsProcedure:              {resource: byOrganization, roles: readRoles},
+	cloudv1connect.NamespaceServiceUpdateNexusEndpointProcedure:             {resource: byOrganization, roles: adminRoles, entitlement: service.EntitlementNexus},
+	cloudv1connect.NamespaceServiceDeleteNexusEndpointProcedure:             {resource: byOrganization, roles: adminRoles, entitlement: service.EntitlementNone},
+
+	// Billing. Billing changes are allowed whatever the plan's standing, so
+	// that organizations can always pay or change plans.
+	cloudv1connect.BillingServiceGetSubscriptionProcedure:     {resource: byOrganization, roles: billingReadRoles},
+	cloudv1connect.BillingServiceUpdateSubscriptionProcedure:  {resource: byOrganization, roles: billingWriteRoles, entitlement: service.EntitlementNone},
+	cloudv1connect.BillingServiceGetUsageProcedure:            {resource: byOrganization, roles: billingReadRoles},
+	cloudv1connect.BillingServiceGetUsageByNamespaceProcedure: {resource: byNamespace, roles: billingReadRoles, namespacePermission: "admin"},
+	cloudv1connect.BillingServiceListInvoicesProcedure:        {resource: byOrganization, roles: billingReadRoles},
+	cloudv1connect.BillingServiceGetInvoiceProcedure:          {resource: byInvoice, roles: billingReadRoles},
+	cloudv1connect.BillingServiceGetCreditBalanceProcedure:    {resource: byOrganization, roles: billingReadRoles},
+	cloudv1connect.BillingServicePurchaseCreditsProcedure:     {resource: byOrganization, roles: billingWriteRoles, entitlement: service.EntitlementNone},
+	cloudv1connect.BillingServiceUpdatePaymentMethodProcedure: {resource: byOrganization, roles: billingWriteRoles, entitlement: service.EntitlementNone},
+	cloudv1connect.BillingServiceListPlansProcedure:           {},
+	cloudv1connect.BillingServiceValidatePlanChangeProcedure:  {resource: byOrganization, roles: billingReadRoles},
+
+	// Identity
+	cloudv1connect.IdentityServiceCreateAPIKeyProcedure:                     {resource: byAPIKeyOwner, roles: adminRoles, allowOwner: true},
+	cloudv1connect.IdentityServiceListAPIKeysProcedure:                      {resource: byAPIKeyOwner, roles: adminRoles, allowOwner: true},
+	cloudv1connect.IdentityServiceGetAPIKeyProcedure:                        {resource: byAPIKey, roles: adminRoles, allowOwner: true},
+	cloudv1connect.IdentityServiceRevokeAPIKeyProcedure:                     {resource: byAPIKey, roles: adminRoles, allowOwner: true},
+	cloudv1connect.IdentityServiceRotateAPIKeyProcedure:                     {resource: byAPIKey, roles: adminRoles, allowOwner: true},
+	cloudv1connect.IdentityServiceCreateServiceAccountProcedure:             {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.IdentityServiceListServiceAccountsProcedure:              {resource: byOrganization, roles: readRoles},
+	cloudv1connect.IdentityServiceGetServiceAccountProcedure:                {resource: byServiceAccount, roles: readRoles, allowOwner: true},
+	cloudv1connect.IdentityServiceUpdateServiceAccountProcedure:             {resource: byServiceAccount, roles: adminRoles},
+	cloudv1connect.IdentityServiceDeleteServiceAccountProcedure:             {resource: byServiceAccount, roles: adminRoles},
+	cloudv1connect.IdentityServiceGetUserProcedure:                          {usersOnly: true},
+	cloudv1connect.IdentityServiceUpdateUserProcedure:                       {usersOnly: true},
+	cloudv1connect.IdentityServiceRotateSCIMTokenProcedure:                  {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.IdentityServiceListUserGroupsProcedure:                   {resource: byOrganization, roles: readRoles},
+	cloudv1connect.IdentityServiceSetUserGroupNamespacePermissionsProcedure: {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.IdentityServiceAddDomainProcedure:                        {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.IdentityServiceVerifyDomainProcedure:                     {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.IdentityServiceListDomainsProcedure:                      {resource: byOrganization, roles: readRoles},
+	cloudv1connect.IdentityServiceRemoveDomainProcedure:                     {resource: byOrganization, roles: adminRoles},
+
+	// Audit
+	cloudv1connect.AuditServiceListAuditEventsProcedure:   {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.AuditServiceGetAuditEventProcedure:     {resource: byAuditEvent, roles: adminRoles},
+	cloudv1connect.AuditServiceExportAuditEventsProcedure: {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.AuditServiceGetAuditChainKeyProcedure:  {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.AuditServiceCreateAuditStreamProcedure: {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.AuditServiceGetAuditStreamProcedure:    {resource: byAuditStream, roles: adminRoles},
+	cloudv1connect.AuditServiceListAuditStreamsProcedure:  {resource: byOrganization, roles: adminRoles},
+	cloudv1connect.AuditServiceUpdateAuditStreamProcedure: {resource: byAuditStream, roles: adminRoles},
+	cloudv1connect.AuditServiceDeleteAuditStreamProcedure: {resource: byAuditStream, roles: adminRoles},
+}
+
+// authorize decides whether caller may call a method with this policy on a
+// resource belonging to owner, given the caller's access to the owning
+// organization. Both are nil for methods without a resource. It returns why
+// the call is denied, or "" if it is allowed.
+func (p *policy) authorize(caller *AuthInfo, owner *service.ResourceOwner, access *service.OrganizationAccess, req any) string {
+	if p.usersOnly && caller.UserID == uuid.Nil {
+		return "service accounts cannot call this method"
+	}
+	if owner == nil {
+		return ""
+	}
+	if p.allowOwner && isPrincipal(caller, owner) {
+		return ""
+	}
+	if owner.OrganizationID == uuid.Nil {
+		return "the resource belongs to another user"
+	}
+	if access == nil {
+		return fmt.Sprintf("caller is not a member of organization %s", owner.OrganizationID)
+	}
+	if p.roles[access.Role] {
+		if p.check != nil {
+			return p.check(access.Role, req)
+		}
+		return ""
+	}
+	if p.namespacePermission != "" && owner.NamespaceID != "" &&
+		service.HasNamespacePermission(access.Permissions, owner.NamespaceID, p.namespacePermission) {
+		return ""
+	}
+	if access.Role == "" {
+		return "caller has no organization role"
+	}
+	return fmt.Sprintf("role %s is not allowed", access.Role)
+}
+
+// isPrincipal reports whether the caller is the principal a resource belongs
+// to.
+func isPrincipal(caller *AuthInfo, owner *service.ResourceOwner) bool {
+	if caller.ServiceAccountID != uuid.Nil {
+		return owner.ServiceAccountID == caller.ServiceAccountID
+	}
+	return owner.UserID != uuid.Nil && owner.UserID == caller.UserID
+}
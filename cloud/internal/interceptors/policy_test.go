@@ -0,0 +1,196 @@
+package interceptors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/api/cloud/v1/cloudv1connect"
+	"go.temporal.io/cloud/internal/service"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestPoliciesCoverAllProcedures(t *testing.T) {
+	var procedures []string
+	protoregistry.GlobalFiles.RangeFilesByPackage("temporal.cloud.api.v1", func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			sd := fd.Services().Get(i)
+			for j := 0; j < sd.Methods().Len(); j++ {
+				procedures = append(procedures, "/"+string(sd.FullName())+"/"+string(sd.Methods().Get(j).Name()))
+			}
+		}
+		return true
+	})
+	require.NotEmpty(t, procedures)
+
+	for _, procedure := range procedures {
+		if isPublicProcedure(procedure) {
+			require.NotContains(t, policies, procedure)
+			continue
+		}
+		require.Contains(t, policies, procedure)
+	}
+	require.Len(t, policies, len(procedures)-len(publicProcedures))
+}
+
+func TestPoliciesDeclareEntitlements(t *testing.T) {
+	for _, svc := range []string{"NamespaceService", "BillingService"} {
+		prefix := "/temporal.cloud.api.v1." + svc + "/"
+		for procedure, p := range policies {
+			method, ok := strings.CutPrefix(procedure, prefix)
+			if !ok {
+				continue
+			}
+			readOnly := strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Validate")
+			if readOnly {
+				require.Empty(t, p.entitlement, procedure)
+			} else {
+				require.NotEmpty(t, p.entitlement, procedure)
+			}
+		}
+	}
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	orgID := uuid.New()
+	userID := uuid.New()
+	saID := uuid.New()
+	user := &AuthInfo{UserID: userID}
+	serviceAccount := &AuthInfo{ServiceAccountID: saID, OrganizationID: orgID}
+	orgOwner := &service.ResourceOwner{OrganizationID: orgID}
+	nsOwner := &service.ResourceOwner{OrganizationID: orgID, NamespaceID: "orders.a1b2c"}
+
+	testCases := []struct {
+		name      string
+		procedure string
+		caller    *AuthInfo
+		owner     *service.ResourceOwner
+		access    *service.OrganizationAccess
+		req       any
+		denied    string
+	}{
+		{
+			name:      "no resource",
+			procedure: cloudv1connect.OrganizationServiceCreateOrganizationProcedure,
+			caller:    user,
+		},
+		{
+			name:      "users only",
+			procedure: cloudv1connect.OrganizationServiceCreateOrganizationProcedure,
+			caller:    serviceAccount,
+			denied:    "service accounts cannot call this method",
+		},
+		{
+			name:      "role allowed",
+			procedure: cloudv1connect.OrganizationServiceDeleteOrganizationProcedure,
+			caller:    user,
+			owner:     orgOwner,
+			access:    &service.OrganizationAccess{Role: "owner"},
+		},
+		{
+			name:      "role denied",
+			procedure: cloudv1connect.OrganizationServiceDeleteOrganizationProcedure,
+			caller:    user,
+			owner:     orgOwner,
+			access:    &service.OrganizationAccess{Role: "admin"},
+			denied:    "role admin is not allowed",
+		},
+		{
+			name:      "not a member",
+			procedure: cloudv1connect.OrganizationServiceGetOrganizationProcedure,
+			caller:    user,
+			owner:     orgOwner,
+			denied:    "caller is not a member of organization",
+		},
+		{
+			name:      "admin granting owner",
+			procedure: cloudv1connect.OrganizationServiceUpdateMemberRoleProcedure,
+			caller:    user,
+			owner:     orgOwner,
+			access:    &service.OrganizationAccess{Role: "admin"},
+			req:       &cloudv1.UpdateMemberRoleRequest{Role: cloudv1.OrganizationRole_ORGANIZATION_ROLE_OWNER},
+			denied:    "only owners can grant the owner role",
+		},
+		{
+			name:      "admin granting developer",
+			procedure: cloudv1connect.OrganizationServiceUpdateMemberRoleProcedure,
+			caller:    user,
+			owner:     orgOwner,
+			access:    &service.OrganizationAccess{Role: "admin"},
+			req:       &cloudv1.UpdateMemberRoleRequest{Role: cloudv1.OrganizationRole_ORGANIZATION_ROLE_DEVELOPER},
+		},
+		{
+			name:      "namespace permission",
+			procedure: cloudv1connect.NamespaceServiceUpdateNamespaceProcedure,
+			caller:    user,
+			owner:     nsOwner,
+			access:    &service.OrganizationAccess{Role: "read_only", Permissions: []string{"namespace_admin:orders.a1b2c"}},
+		},
+		{
+			name:      "namespace permission too low",
+			procedure: cloudv1connect.NamespaceServiceUpdateNamespaceProcedure,
+			caller:    user,
+			owner:     nsOwner,
+			access:    &service.OrganizationAccess{Role: "read_only", Permissions: []string{"namespace_write:orders.a1b2c"}},
+			denied:    "role read_only is not allowed",
+		},
+		{
+			name:      "namespace permission on another namespace",
+			procedure: cloudv1connect.NamespaceServiceGetNamespaceProcedure,
+			caller:    serviceAccount,
+			owner:     nsOwner,
+			access:    &service.OrganizationAccess{Permissions: []string{"namespace_admin:billing.a1b2c"}},
+			denied:    "caller has no organization role",
+		},
+		{
+			name:      "own API key",
+			procedure: cloudv1connect.IdentityServiceRevokeAPIKeyProcedure,
+			caller:    user,
+			owner:     &service.ResourceOwner{UserID: userID},
+		},
+		{
+			name:      "another user's API key",
+			procedure: cloudv1connect.IdentityServiceRevokeAPIKeyProcedure,
+			caller:    user,
+			owner:     &service.ResourceOwner{UserID: uuid.New()},
+			denied:    "the resource belongs to another user",
+		},
+		{
+			name:      "service account's own API key",
+			procedure: cloudv1connect.IdentityServiceRotateAPIKeyProcedure,
+			caller:    serviceAccount,
+			owner:     &service.ResourceOwner{OrganizationID: orgID, ServiceAccountID: saID},
+			access:    &service.OrganizationAccess{},
+		},
+		{
+			name:      "admin managing a service account's API key",
+			procedure: cloudv1connect.IdentityServiceRotateAPIKeyProcedure,
+			caller:    user,
+			owner:     &service.ResourceOwner{OrganizationID: orgID, ServiceAccountID: saID},
+			access:    &service.OrganizationAccess{Role: "admin"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := policies[tc.procedure]
+			require.NotNil(t, p)
+			denied := p.authorize(tc.caller, tc.owner, tc.access, tc.req)
+			if tc.denied == "" {
+				require.Empty(t, denied)
+				return
+			}
+			require.Contains(t, denied, tc.denied)
+		})
+	}
+}
+
+func TestByAPIKeyOwner(t *testing.T) {
+	require.Equal(t, resourceRef{}, byAPIKeyOwner(&cloudv1.CreateAPIKeyRequest{}))
+	require.Equal(t, resourceRef{kind: service.ResourceUser, field: "owner_id", id: "u"},
+		byAPIKeyOwner(&cloudv1.ListAPIKeysRequest{OwnerId: "u"}))
+	require.Equal(t, resourceRef{kind: service.ResourceServiceAccount, field: "owner_id", id: "sa"},
+		byAPIKeyOwner(&cloudv1.CreateAPIKeyRequest{OwnerType: "service_account", OwnerId: "sa"}))
+}
@@ -2,28 +2,87 @@ package interceptors
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/ratelimit"
+	"go.temporal.io/cloud/internal/service"
 	"go.temporal.io/server/common/log"
-	"golang.org/x/time/rate"
+	"go.temporal.io/server/common/log/tag"
 )
 
-// RateLimitInterceptor handles rate limiting for gRPC requests.
+// planCacheTTL is how long an organization's plan is cached, and so how long
+// a plan change takes to affect its quota.
+const planCacheTTL = time.Minute
+
+// RateLimitInterceptor enforces request quotas. There are two: one per client
+// address, checked before authentication so that unauthenticated floods are
+// turned away cheaply, and one per principal, checked after it.
 type RateLimitInterceptor struct {
-	config   config.RateLimitConfig
-	logger   log.Logger
-	limiters sync.Map // map[string]*rate.Limiter
+	config  config.RateLimitConfig
+	limiter *ratelimit.Limiter
+	logger  log.Logger
+	// quota returns the key and quota a request counts against, or false if
+	// it is not limited.
+	quota func(ctx context.Context, header http.Header, peer connect.Peer) (string, ratelimit.Quota, bool)
+	// planOf returns an organization's subscription plan.
+	planOf func(ctx context.Context, orgID uuid.UUID) (string, error)
+
+	mu        sync.Mutex
+	plans     map[uuid.UUID]cachedPlan
+	nextSweep time.Time
+}
+
+type cachedPlan struct {
+	plan    string
+	expires time.Time
+}
+
+// NewIPRateLimitInterceptor creates the interceptor enforcing the quota of
+// each client address. It must run before the AuthInterceptor.
+func NewIPRateLimitInterceptor(cfg config.RateLimitConfig, store ratelimit.Store, logger log.Logger) *RateLimitInterceptor {
+	i := &RateLimitInterceptor{
+		config:  cfg,
+		limiter: ratelimit.NewLimiter(store),
+		logger:  logger,
+	}
+	i.quota = func(_ context.Context, header http.Header, peer connect.Peer) (string, ratelimit.Quota, bool) {
+		return "ip:" + clientIP(header, peer, cfg.TrustedProxies), ratelimit.Quota{Limit: int64(cfg.IPRequests), Window: cfg.Window}, true
+	}
+	return i
 }
 
-// NewRateLimitInterceptor creates a new rate limit interceptor.
-func NewRateLimitInterceptor(cfg config.RateLimitConfig, logger log.Logger) *RateLimitInterceptor {
-	return &RateLimitInterceptor{
-		config: cfg,
-		logger: logger,
+// NewRateLimitInterceptor creates the interceptor enforcing the quota of
+// each principal. Organizations get the quota of their subscription plan,
+// and other callers the default quota. Unauthenticated requests are left to
+// the address quota. It must run after the AuthInterceptor.
+func NewRateLimitInterceptor(cfg config.RateLimitConfig, store ratelimit.Store, billingService *service.BillingService, logger log.Logger) *RateLimitInterceptor {
+	i := &RateLimitInterceptor{
+		config:  cfg,
+		limiter: ratelimit.NewLimiter(store),
+		logger:  logger,
+		planOf: func(ctx context.Context, orgID uuid.UUID) (string, error) {
+			sub, err := billingService.GetSubscription(ctx, orgID)
+			if err != nil || sub == nil {
+				return "", err
+			}
+			return sub.Plan, nil
+		},
+		plans: make(map[uuid.UUID]cachedPlan),
+	}
+	i.quota = func(ctx context.Context, _ http.Header, _ connect.Peer) (string, ratelimit.Quota, bool) {
+		return i.principalQuota(ctx)
 	}
+	return i
 }
 
 // WrapUnary implements connect.Interceptor.
@@ -33,21 +92,24 @@ func (i *RateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFu
 			return next(ctx, req)
 		}
 
-		// Get client identifier (from auth or IP)
-		clientID := i.getClientID(ctx, req)
-
-		// Get or create limiter for this client
-		limiter := i.getLimiter(clientID)
-
-		// Check rate limit
-		if !limiter.Allow() {
-			return nil, connect.NewError(
-				connect.CodeResourceExhausted,
-				nil,
-			)
+		result := i.allow(ctx, req.Spec().Procedure, req.Header(), req.Peer())
+		if result != nil && !result.Allowed {
+			return nil, rateLimitError(result)
 		}
 
-		return next(ctx, req)
+		resp, err := next(ctx, req)
+		if result == nil {
+			return resp, err
+		}
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				setRateLimitHeaders(connectErr.Meta(), result)
+			}
+			return resp, err
+		}
+		setRateLimitHeaders(resp.Header(), result)
+		return resp, nil
 	}
 }
 
@@ -56,48 +118,165 @@ func (i *RateLimitInterceptor) WrapStreamingClient(next connect.StreamingClientF
 	return next
 }
 
-// WrapStreamingHandler implements connect.Interceptor.
+// WrapStreamingHandler implements connect.Interceptor. A stream counts as a
+// single request.
 func (i *RateLimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !i.config.Enabled {
+			return next(ctx, conn)
+		}
+
+		result := i.allow(ctx, conn.Spec().Procedure, conn.RequestHeader(), conn.Peer())
+		if result == nil {
+			return next(ctx, conn)
+		}
+		if !result.Allowed {
+			return rateLimitError(result)
+		}
+		setRateLimitHeaders(conn.ResponseHeader(), result)
+		return next(ctx, conn)
+	}
 }
 
-func (i *RateLimitInterceptor) getClientID(ctx context.Context, req connect.AnyRequest) string {
-	// Try to get from auth context
-	if authInfo := GetAuthInfo(ctx); authInfo != nil {
-		if authInfo.OrganizationID.String() != "" {
-			return "org:" + authInfo.OrganizationID.String()
+// allow counts the request against its quota. It returns nil when the
+// request is not limited or the quota could not be checked, in which case
+// the request is let through rather than failing because of the rate limit
+// backend.
+func (i *RateLimitInterceptor) allow(ctx context.Context, procedure string, header http.Header, peer connect.Peer) *ratelimit.Result {
+	key, quota, ok := i.quota(ctx, header, peer)
+	if !ok {
+		return nil
+	}
+	result, err := i.limiter.Allow(ctx, key, quota)
+	if err != nil {
+		i.logger.Warn("Rate limit check failed", tag.Error(err), tag.NewStringTag("procedure", procedure))
+		return nil
+	}
+	return result
+}
+
+// principalQuota returns the quota of the caller's organization, else of the
+// caller.
+func (i *RateLimitInterceptor) principalQuota(ctx context.Context) (string, ratelimit.Quota, bool) {
+	quota := ratelimit.Quota{Limit: int64(i.config.Requests), Window: i.config.Window}
+	authInfo := GetAuthInfo(ctx)
+	switch {
+	case authInfo == nil:
+		return "", quota, false
+	case authInfo.OrganizationID != uuid.Nil:
+		plan, err := i.plan(ctx, authInfo.OrganizationID)
+		if err != nil {
+			i.logger.Warn("Failed to look up plan for rate limiting", tag.Error(err), tag.NewStringTag("organization_id", authInfo.OrganizationID.String()))
+		}
+		if requests, ok := i.config.PlanRequests[plan]; ok {
+			quota.Limit = int64(requests)
 		}
-		return "user:" + authInfo.UserID.String()
+		return "org:" + authInfo.OrganizationID.String(), quota, true
+	case authInfo.ServiceAccountID != uuid.Nil:
+		return "service_account:" + authInfo.ServiceAccountID.String(), quota, true
+	case authInfo.UserID != uuid.Nil:
+		return "user:" + authInfo.UserID.String(), quota, true
+	default:
+		return "", quota, false
 	}
+}
 
-	// Fall back to IP address
-	if ip := req.Header().Get("X-Forwarded-For"); ip != "" {
-		return "ip:" + ip
+// clientIP returns the address of the client. Forwarding headers are only
+// honoured when the peer is a trusted proxy, and X-Forwarded-For is read from
+// the right, skipping trusted proxies, since entries to the left of the
+// first untrusted one can be forged by the client.
+func clientIP(header http.Header, peer connect.Peer, trustedProxies []netip.Prefix) string {
+	addr, err := netip.ParseAddrPort(peer.Addr)
+	if err != nil {
+		return "unknown"
 	}
-	if ip := req.Header().Get("X-Real-IP"); ip != "" {
-		return "ip:" + ip
+	ip := addr.Addr().Unmap()
+	if !isTrustedProxy(ip, trustedProxies) {
+		return ip.String()
 	}
 
-	return "unknown"
+	var hops []string
+	for _, value := range header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	if len(hops) == 0 {
+		if realIP, err := netip.ParseAddr(strings.TrimSpace(header.Get("X-Real-IP"))); err == nil {
+			return realIP.Unmap().String()
+		}
+	}
+	for _, hop := range slices.Backward(hops) {
+		hopIP, err := netip.ParseAddr(strings.TrimSpace(hop))
+		if err != nil {
+			break
+		}
+		ip = hopIP.Unmap()
+		if !isTrustedProxy(ip, trustedProxies) {
+			break
+		}
+	}
+	return ip.String()
+}
+
+func isTrustedProxy(ip netip.Addr, trustedProxies []netip.Prefix) bool {
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
 }
 
-func (i *RateLimitInterceptor) getLimiter(clientID string) *rate.Limiter {
-	if limiter, ok := i.limiters.Load(clientID); ok {
-		return limiter.(*rate.Limiter)
+// plan returns an organization's plan, cached for planCacheTTL. Expired
+// entries are swept at most once per TTL.
+func (i *RateLimitInterceptor) plan(ctx context.Context, orgID uuid.UUID) (string, error) {
+	now := time.Now()
+	i.mu.Lock()
+	cached, ok := i.plans[orgID]
+	i.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.plan, nil
 	}
 
-	// Create new limiter
-	limiter := rate.NewLimiter(
-		rate.Limit(i.config.RequestsPerSec),
-		i.config.BurstSize,
-	)
-	i.limiters.Store(clientID, limiter)
+	plan, err := i.planOf(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
 
-	// Schedule cleanup after 1 hour of inactivity
-	go func() {
-		time.Sleep(1 * time.Hour)
-		i.limiters.Delete(clientID)
-	}()
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if !now.Before(i.nextSweep) {
+		for id, p := range i.plans {
+			if !now.Before(p.expires) {
+				delete(i.plans, id)
+			}
+		}
+		i.nextSweep = now.Add(planCacheTTL)
+	}
+	i.plans[orgID] = cachedPlan{plan: plan, expires: now.Add(planCacheTTL)}
+	return plan, nil
+}
+
+func rateLimitError(result *ratelimit.Result) error {
+	err := connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
+	setRateLimitHeaders(err.Meta(), result)
+	err.Meta().Set("Retry-After", resetSeconds(result))
+	return err
+}
+
+// setRateLimitHeaders sets the RateLimit-* headers of the IETF rate limit
+// headers draft. When a request counts against several quotas, the headers
+// report the one with the fewest requests remaining.
+func setRateLimitHeaders(header http.Header, result *ratelimit.Result) {
+	if remaining, err := strconv.ParseInt(header.Get("RateLimit-Remaining"), 10, 64); err == nil && remaining <= result.Remaining {
+		return
+	}
+	header.Set("RateLimit-Limit", strconv.FormatInt(result.Limit, 10))
+	header.Set("RateLimit-Remaining", strconv.FormatInt(result.Remaining, 10))
+	header.Set("RateLimit-Reset", resetSeconds(result))
+}
 
-	return limiter
+// resetSeconds is the time until the quota is replenished, in whole seconds
+// rounded up.
+func resetSeconds(result *ratelimit.Result) string {
+	return strconv.FormatInt(int64((result.Reset+time.Second-1)/time.Second), 10)
 }
@@ -0,0 +1,110 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	cloudv1 "go.temporal.io/cloud/api/cloud/v1"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/ratelimit"
+	"go.temporal.io/server/common/log"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	orgID := uuid.New()
+	lookups := 0
+	i := NewRateLimitInterceptor(config.RateLimitConfig{
+		Enabled:      true,
+		Window:       time.Minute,
+		Requests:     1,
+		PlanRequests: map[string]int{"business": 2},
+	}, ratelimit.NewMemoryStore(), nil, log.NewNoopLogger())
+	i.planOf = func(_ context.Context, id uuid.UUID) (string, error) {
+		require.Equal(t, orgID, id)
+		lookups++
+		return "business", nil
+	}
+	call := i.WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&cloudv1.GetOrganizationResponse{}), nil
+	})
+	ctx := context.WithValue(context.Background(), authContextKey{}, &AuthInfo{UserID: uuid.New(), OrganizationID: orgID})
+	req := connect.NewRequest(&cloudv1.GetOrganizationRequest{})
+
+	resp, err := call(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "2", resp.Header().Get("RateLimit-Limit"))
+	require.Equal(t, "1", resp.Header().Get("RateLimit-Remaining"))
+	require.Equal(t, "60", resp.Header().Get("RateLimit-Reset"))
+
+	resp, err = call(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "0", resp.Header().Get("RateLimit-Remaining"))
+
+	_, err = call(ctx, req)
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	require.Equal(t, connect.CodeResourceExhausted, connectErr.Code())
+	require.Equal(t, "0", connectErr.Meta().Get("RateLimit-Remaining"))
+	require.Equal(t, "60", connectErr.Meta().Get("Retry-After"))
+	require.Equal(t, 1, lookups, "plan should be cached")
+
+	// Unauthenticated requests are left to the address quota.
+	resp, err = call(context.Background(), req)
+	require.NoError(t, err)
+	require.Empty(t, resp.Header().Get("RateLimit-Limit"))
+}
+
+func TestIPRateLimitInterceptor(t *testing.T) {
+	cfg := config.RateLimitConfig{Enabled: true, Window: time.Minute, IPRequests: 2, Requests: 1}
+	store := ratelimit.NewMemoryStore()
+	ipLimit := NewIPRateLimitInterceptor(cfg, store, log.NewNoopLogger())
+	principalLimit := NewRateLimitInterceptor(cfg, store, nil, log.NewNoopLogger())
+	call := ipLimit.WrapUnary(principalLimit.WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&cloudv1.GetOrganizationResponse{}), nil
+	}))
+	req := connect.NewRequest(&cloudv1.GetOrganizationRequest{})
+
+	// The headers report the quota with the fewest requests remaining.
+	ctx := context.WithValue(context.Background(), authContextKey{}, &AuthInfo{UserID: uuid.New()})
+	resp, err := call(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "1", resp.Header().Get("RateLimit-Limit"))
+	require.Equal(t, "0", resp.Header().Get("RateLimit-Remaining"))
+
+	resp, err = call(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "2", resp.Header().Get("RateLimit-Limit"))
+	require.Equal(t, "0", resp.Header().Get("RateLimit-Remaining"))
+
+	// The address quota applies before authentication.
+	_, err = call(context.Background(), req)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
+
+func TestClientIP(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	for _, tc := range []struct {
+		name    string
+		peer    string
+		headers map[string][]string
+		want    string
+	}{
+		{name: "direct", peer: "203.0.113.7:1234", want: "203.0.113.7"},
+		{name: "untrusted peer", peer: "203.0.113.7:1234", headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1"}, "X-Real-Ip": {"198.51.100.2"}}, want: "203.0.113.7"},
+		{name: "trusted proxy", peer: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1"}}, want: "198.51.100.1"},
+		{name: "forged hops", peer: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"192.0.2.1, 198.51.100.1", "10.0.0.2"}}, want: "198.51.100.1"},
+		{name: "real ip", peer: "10.0.0.1:1234", headers: map[string][]string{"X-Real-Ip": {"198.51.100.2"}}, want: "198.51.100.2"},
+		{name: "only proxies", peer: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"10.0.0.2"}}, want: "10.0.0.2"},
+		{name: "no peer", want: "unknown"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, clientIP(tc.headers, connect.Peer{Addr: tc.peer}, trusted))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// FileMailer writes each message to a .eml file in a directory instead of
+// sending it. It is meant for development and tests.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer creates a mailer that writes messages to dir, creating it if
+// needed.
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	if dir == "" {
+		return nil, errors.New("file mailer requires a directory")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	return &FileMailer{dir: dir, from: from}, nil
+}
+
+// Send implements Mailer.
+func (m *FileMailer) Send(_ context.Context, msg *Message) error {
+	now := time.Now()
+	data, err := encode(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+	// The timestamp keeps listings in the order messages were sent.
+	name := filepath.Join(m.dir, now.UTC().Format("20060102T150405.000000000Z")+"-"+uuid.NewString()+".eml")
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	return nil
+}
+
+// LogMailer logs messages instead of sending them. It is meant for local
+// development: message bodies, including any links in them, are logged.
+type LogMailer struct {
+	from   string
+	logger log.Logger
+}
+
+// NewLogMailer creates a mailer that logs messages.
+func NewLogMailer(from string, logger log.Logger) *LogMailer {
+	return &LogMailer{from: from, logger: logger}
+}
+
+// Send implements Mailer.
+func (m *LogMailer) Send(_ context.Context, msg *Message) error {
+	if _, err := encode(m.from, msg, time.Now()); err != nil {
+		return err
+	}
+	m.logger.Info("Email not sent: mail backend is log",
+		tag.NewStringTag("to", msg.To),
+		tag.NewStringTag("subject", msg.Subject),
+		tag.NewStringTag("body", msg.Text))
+	return nil
+}
@@ -0,0 +1,102 @@
+// Package mail sends transactional email, such as organization invitations,
+// through a Mailer selected by configuration.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/server/common/log"
+)
+
+// Message is a plain-text email to a single recipient.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+}
+
+// Mailer sends messages.
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New creates the mailer selected by the configuration.
+func New(cfg config.MailConfig, logger log.Logger) (Mailer, error) {
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return nil, fmt.Errorf("invalid mail sender %q: %w", cfg.From, err)
+	}
+	switch cfg.Backend {
+	case "smtp":
+		return NewSMTPMailer(SMTPOptions{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.From,
+		}), nil
+	case "file":
+		return NewFileMailer(cfg.Dir, cfg.From)
+	case "log", "":
+		return NewLogMailer(cfg.From, logger), nil
+	default:
+		return nil, fmt.Errorf("unknown mail backend %q", cfg.Backend)
+	}
+}
+
+// encode encodes a message from the sender as an RFC 5322 message with a
+// quoted-printable UTF-8 body.
+func encode(from string, msg *Message, now time.Time) ([]byte, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender %q: %w", from, err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient %q: %w", msg.To, err)
+	}
+
+	var b bytes.Buffer
+	header := func(name, value string) {
+		b.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", sender.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", messageID(sender.Address))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	b.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&b)
+	text := strings.ReplaceAll(msg.Text, "\r\n", "\n")
+	if _, err := w.Write([]byte(strings.ReplaceAll(text, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// messageID returns a unique message ID in the domain of the sender.
+func messageID(sender string) string {
+	domain := "localhost"
+	if i := strings.LastIndexByte(sender, '@'); i >= 0 {
+		domain = sender[i+1:]
+	}
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return "<" + hex.EncodeToString(id[:]) + "@" + domain + ">"
+}
@@ -0,0 +1,220 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/server/common/log"
+)
+
+const testFrom = "Temporal Cloud <noreply@cloud.example.com>"
+
+func testMessage() *Message {
+	return &Message{
+		To:      "bob@example.com",
+		Subject: "Rejoignez l’équipe",
+		Text:    "Hello,\nAccept: https://cloud.example.com/invitations/accept?token=abc\n",
+	}
+}
+
+// parse parses an encoded message and decodes its body.
+func parse(t *testing.T, data []byte) (*mail.Message, string) {
+	t.Helper()
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	return msg, string(body)
+}
+
+func TestEncode(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+	data, err := encode(testFrom, testMessage(), now)
+	require.NoError(t, err)
+
+	msg, body := parse(t, data)
+	require.Equal(t, `"Temporal Cloud" <noreply@cloud.example.com>`, msg.Header.Get("From"))
+	require.Equal(t, "<bob@example.com>", msg.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Rejoignez l’équipe", subject)
+	require.Equal(t, "Wed, 01 May 2024 10:00:00 +0000", msg.Header.Get("Date"))
+	require.True(t, strings.HasSuffix(msg.Header.Get("Message-ID"), "@cloud.example.com>"))
+	require.Equal(t, "Hello,\r\nAccept: https://cloud.example.com/invitations/accept?token=abc\r\n", body)
+
+	_, err = encode(testFrom, &Message{To: "not an address"}, now)
+	require.ErrorContains(t, err, "invalid recipient")
+	// Headers cannot be injected through the recipient.
+	_, err = encode(testFrom, &Message{To: "bob@example.com\r\nBcc: eve@example.com"}, now)
+	require.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	logger := log.NewNoopLogger()
+	m, err := New(config.MailConfig{Backend: "log", From: testFrom}, logger)
+	require.NoError(t, err)
+	require.IsType(t, &LogMailer{}, m)
+	m, err = New(config.MailConfig{Backend: "file", From: testFrom, Dir: t.TempDir()}, logger)
+	require.NoError(t, err)
+	require.IsType(t, &FileMailer{}, m)
+	m, err = New(config.MailConfig{Backend: "smtp", From: testFrom}, logger)
+	require.NoError(t, err)
+	require.IsType(t, &SMTPMailer{}, m)
+
+	_, err = New(config.MailConfig{Backend: "file", From: testFrom}, logger)
+	require.Error(t, err)
+	_, err = New(config.MailConfig{Backend: "pigeon", From: testFrom}, logger)
+	require.ErrorContains(t, err, "unknown mail backend")
+	_, err = New(config.MailConfig{Backend: "log", From: "nobody"}, logger)
+	require.ErrorContains(t, err, "invalid mail sender")
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	m, err := NewFileMailer(dir, testFrom)
+	require.NoError(t, err)
+	require.NoError(t, m.Send(context.Background(), testMessage()))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	msg, body := parse(t, data)
+	require.Equal(t, "<bob@example.com>", msg.Header.Get("To"))
+	require.Contains(t, body, "token=abc")
+}
+
+// smtpServer is a minimal SMTP server that records the messages it receives.
+type smtpServer struct {
+	ln net.Listener
+
+	mu       sync.Mutex
+	auth     string
+	from     string
+	rcpt     string
+	messages []string
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &smtpServer{ln: ln}
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpServer) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP test")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		s.mu.Lock()
+		switch verb {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			// AUTH PLAIN <base64 of "\x00user\x00pass">
+			raw, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH PLAIN "))
+			s.auth = string(raw)
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			s.from = line
+			reply("250 OK")
+		case "RCPT":
+			s.rcpt = line
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					s.mu.Unlock()
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.messages = append(s.messages, data.String())
+			reply("250 OK: queued")
+		case "QUIT":
+			reply("221 Bye")
+			s.mu.Unlock()
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+		s.mu.Unlock()
+	}
+}
+
+func TestSMTPMailer(t *testing.T) {
+	server := newSMTPServer(t)
+	m := NewSMTPMailer(SMTPOptions{
+		Host:     "127.0.0.1",
+		Port:     server.port(),
+		Username: "mailer",
+		Password: "s3cret",
+		From:     testFrom,
+		Timeout:  5 * time.Second,
+	})
+	require.NoError(t, m.Send(context.Background(), testMessage()))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Equal(t, "\x00mailer\x00s3cret", server.auth)
+	require.Equal(t, "MAIL FROM:<noreply@cloud.example.com>", server.from)
+	require.Equal(t, "RCPT TO:<bob@example.com>", server.rcpt)
+	require.Len(t, server.messages, 1)
+	_, body := parse(t, []byte(server.messages[0]))
+	require.Contains(t, body, "token=abc")
+}
+
+func TestSMTPMailerUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+
+	m := NewSMTPMailer(SMTPOptions{Host: "127.0.0.1", Port: port, From: testFrom, Timeout: time.Second})
+	require.ErrorContains(t, m.Send(context.Background(), testMessage()), "failed to connect to SMTP server")
+}
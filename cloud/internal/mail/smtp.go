@@ -0,0 +1,103 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPOptions configure an SMTP mailer.
+type SMTPOptions struct {
+	Host string
+	Port int
+	// Username and Password authenticate with PLAIN auth if Username is set.
+	// Credentials are only sent over TLS, or to localhost.
+	Username string
+	Password string
+	From     string
+	// Timeout bounds each message's delivery. It defaults to 30 seconds.
+	Timeout time.Duration
+	// TLSConfig is used for STARTTLS. It defaults to verifying the server's
+	// certificate for Host.
+	TLSConfig *tls.Config
+}
+
+// SMTPMailer sends messages through an SMTP server, upgrading the connection
+// with STARTTLS when the server supports it.
+type SMTPMailer struct {
+	opts SMTPOptions
+}
+
+// NewSMTPMailer creates a mailer that sends through an SMTP server.
+func NewSMTPMailer(opts SMTPOptions) *SMTPMailer {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 30 * time.Second
+	}
+	if opts.TLSConfig == nil {
+		opts.TLSConfig = &tls.Config{ServerName: opts.Host}
+	}
+	return &SMTPMailer{opts: opts}
+}
+
+// Send implements Mailer.
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	data, err := encode(m.opts.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+	// encode validated both addresses.
+	from, _ := mail.ParseAddress(m.opts.From)
+	to, _ := mail.ParseAddress(msg.To)
+
+	ctx, cancel := context.WithTimeout(ctx, m.opts.Timeout)
+	defer cancel()
+	addr := net.JoinHostPort(m.opts.Host, strconv.Itoa(m.opts.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, m.opts.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to connect to SMTP server %s: %w", addr, err)
+	}
+	defer func() { _ = c.Close() }()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(m.opts.TLSConfig); err != nil {
+			return fmt.Errorf("failed to start TLS with SMTP server: %w", err)
+		}
+	}
+	if m.opts.Username != "" {
+		auth := smtp.PlainAuth("", m.opts.Username, m.opts.Password, m.opts.Host)
+		if err := c.Auth(auth); err != nil {
+			return fmt.Errorf("failed to authenticate with SMTP server: %w", err)
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return fmt.Errorf("SMTP server rejected sender: %w", err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("SMTP server rejected recipient: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return c.Quit()
+}
@@ -0,0 +1,110 @@
+package metering
+
+import (
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// scheduledByIDSearchAttribute is set on workflows started by a schedule.
+const scheduledByIDSearchAttribute = "TemporalScheduledById"
+
+// countEvent adds the billable actions represented by a history event to the
+// record. Queries and activity heartbeats are not written to history and are
+// not counted here.
+func countEvent(record *repository.UsageRecord, event *historypb.HistoryEvent) {
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		attrs := event.GetWorkflowExecutionStartedEventAttributes()
+		// Child workflows are counted when the parent starts them.
+		if attrs.GetParentWorkflowExecution() == nil {
+			record.WorkflowStarted++
+		}
+		if _, ok := attrs.GetSearchAttributes().GetIndexedFields()[scheduledByIDSearchAttribute]; ok {
+			record.ScheduleExecution++
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED:
+		if event.GetWorkflowTaskFailedEventAttributes().GetCause() == enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW {
+			record.WorkflowReset++
+		}
+	case enumspb.EVENT_TYPE_TIMER_STARTED:
+		record.TimerStarted++
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		record.SignalSent++
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+		record.UpdateReceived++
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		record.ActivityStarted++
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED:
+		// Only the last attempt of an activity is written to history; every
+		// earlier attempt is a retry that is billed as well.
+		if attempt := event.GetActivityTaskStartedEventAttributes().GetAttempt(); attempt > 1 {
+			record.ActivityStarted += int64(attempt - 1)
+		}
+	case enumspb.EVENT_TYPE_MARKER_RECORDED:
+		switch event.GetMarkerRecordedEventAttributes().GetMarkerName() {
+		case "LocalActivity":
+			record.LocalActivityBatch++
+		case "SideEffect", "MutableSideEffect":
+			record.SideEffectRecorded++
+		}
+	case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		record.ChildWorkflowStarted++
+	case enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		record.SearchAttributeUpsert++
+	case enumspb.EVENT_TYPE_NEXUS_OPERATION_SCHEDULED:
+		record.NexusOperation++
+	}
+}
+
+// Breakdown returns the record's action counts keyed by action type.
+func Breakdown(record *repository.UsageRecord) map[string]int64 {
+	return map[string]int64{
+		"workflow_started":        record.WorkflowStarted,
+		"workflow_reset":          record.WorkflowReset,
+		"timer_started":           record.TimerStarted,
+		"signal_sent":             record.SignalSent,
+		"query_received":          record.QueryReceived,
+		"update_received":         record.UpdateReceived,
+		"activity_started":        record.ActivityStarted,
+		"activity_heartbeat":      record.ActivityHeartbeat,
+		"local_activity_batch":    record.LocalActivityBatch,
+		"child_workflow_started":  record.ChildWorkflowStarted,
+		"schedule_execution":      record.ScheduleExecution,
+		"nexus_operation":         record.NexusOperation,
+		"search_attribute_upsert": record.SearchAttributeUpsert,
+		"side_effect_recorded":    record.SideEffectRecorded,
+		"workflow_exported":       record.WorkflowExported,
+	}
+}
+
+// totalActions sums the record's action counts.
+func totalActions(record *repository.UsageRecord) int64 {
+	var total int64
+	for _, count := range Breakdown(record) {
+		total += count
+	}
+	return total
+}
+
+// addRecord adds src's actions and storage to dst.
+func addRecord(dst, src *repository.UsageRecord) {
+	dst.ActionCount += src.ActionCount
+	dst.WorkflowStarted += src.WorkflowStarted
+	dst.WorkflowReset += src.WorkflowReset
+	dst.TimerStarted += src.TimerStarted
+	dst.SignalSent += src.SignalSent
+	dst.QueryReceived += src.QueryReceived
+	dst.UpdateReceived += src.UpdateReceived
+	dst.ActivityStarted += src.ActivityStarted
+	dst.ActivityHeartbeat += src.ActivityHeartbeat
+	dst.LocalActivityBatch += src.LocalActivityBatch
+	dst.ChildWorkflowStarted += src.ChildWorkflowStarted
+	dst.ScheduleExecution += src.ScheduleExecution
+	dst.NexusOperation += src.NexusOperation
+	dst.SearchAttributeUpsert += src.SearchAttributeUpsert
+	dst.SideEffectRecorded += src.SideEffectRecorded
+	dst.WorkflowExported += src.WorkflowExported
+	dst.ActiveStorageGBH = dst.ActiveStorageGBH.Add(src.ActiveStorageGBH)
+	dst.RetainedStorageGBH = dst.RetainedStorageGBH.Add(src.RetainedStorageGBH)
+}
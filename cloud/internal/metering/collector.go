@@ -0,0 +1,169 @@
+// Package metering derives billable usage of cloud namespaces from the
+// Temporal clusters that host them.
+package metering
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	listPageSize = 1000
+	bytesPerGB   = 1e9
+)
+
+// Collector measures the usage of a namespace over a period.
+type Collector struct{}
+
+// Collect returns the usage of the namespace between start and end, and the
+// history size of the executions that closed during that period.
+//
+// Only executions open at some point in the period are listed, by a
+// visibility query on their close time. Actions are counted from the history
+// events they wrote during the period. Storage is the history size of each
+// execution, weighted by how long during the period it was open (active
+// storage) or closed but still retained (retained storage). The storage of
+// executions that closed before the period is not included; Meter adds it
+// from the history sizes recorded when they closed.
+func (Collector) Collect(ctx context.Context, cl client.Client, namespace string, start, end time.Time) (*repository.UsageRecord, int64, error) {
+	record := &repository.UsageRecord{
+		NamespaceID: namespace,
+		PeriodStart: start,
+		PeriodEnd:   end,
+	}
+	query := fmt.Sprintf("StartTime < %q AND (ExecutionStatus = 'Running' OR CloseTime >= %q)",
+		end.UTC().Format(time.RFC3339Nano), start.UTC().Format(time.RFC3339Nano))
+
+	var closedBytes int64
+	err := listWorkflows(ctx, cl, namespace, query, func(execution *workflowpb.WorkflowExecutionInfo) error {
+		size, err := collectExecution(ctx, cl, record, execution, start, end)
+		if err != nil {
+			return err
+		}
+		if closedDuring(execution, start, end) {
+			closedBytes += size
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	record.ActionCount = totalActions(record)
+	return record, closedBytes, nil
+}
+
+// ClosedHistory returns the history size of the namespace's executions that
+// closed between from and to, by the hour they closed in. Sizes come from
+// visibility, so no history is read.
+func (Collector) ClosedHistory(ctx context.Context, cl client.Client, namespace string, from, to time.Time) (map[time.Time]int64, error) {
+	query := fmt.Sprintf("CloseTime >= %q AND CloseTime < %q",
+		from.UTC().Format(time.RFC3339Nano), to.UTC().Format(time.RFC3339Nano))
+
+	sizes := make(map[time.Time]int64)
+	err := listWorkflows(ctx, cl, namespace, query, func(execution *workflowpb.WorkflowExecutionInfo) error {
+		hour := execution.GetCloseTime().AsTime().UTC().Truncate(time.Hour)
+		sizes[hour] += execution.GetHistorySizeBytes()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sizes, nil
+}
+
+func listWorkflows(ctx context.Context, cl client.Client, namespace, query string, fn func(*workflowpb.WorkflowExecutionInfo) error) error {
+	var token []byte
+	for {
+		resp, err := cl.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			PageSize:      listPageSize,
+			NextPageToken: token,
+			Query:         query,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list workflows of namespace %s: %w", namespace, err)
+		}
+		for _, execution := range resp.GetExecutions() {
+			if err := fn(execution); err != nil {
+				return err
+			}
+		}
+		token = resp.GetNextPageToken()
+		if len(token) == 0 {
+			return nil
+		}
+	}
+}
+
+func closedDuring(execution *workflowpb.WorkflowExecutionInfo, start, end time.Time) bool {
+	if execution.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING || execution.GetCloseTime() == nil {
+		return false
+	}
+	closeTime := execution.GetCloseTime().AsTime()
+	return !closeTime.Before(start) && closeTime.Before(end)
+}
+
+// collectExecution adds the usage of an execution open during the period to
+// record and returns the size of its history at the end of the period.
+func collectExecution(ctx context.Context, cl client.Client, record *repository.UsageRecord, execution *workflowpb.WorkflowExecutionInfo, start, end time.Time) (int64, error) {
+	closed := execution.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING && execution.GetCloseTime() != nil
+	closeTime := execution.GetCloseTime().AsTime()
+
+	iter := cl.GetWorkflowHistory(ctx, execution.GetExecution().GetWorkflowId(), execution.GetExecution().GetRunId(),
+		false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	var size int64
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return 0, fmt.Errorf("failed to read history of workflow %s: %w", execution.GetExecution().GetWorkflowId(), err)
+		}
+		eventTime := event.GetEventTime().AsTime()
+		if !eventTime.Before(end) {
+			// Events written after the period neither count nor take up
+			// storage during it.
+			continue
+		}
+		size += int64(proto.Size(event))
+		if !eventTime.Before(start) {
+			countEvent(record, event)
+		}
+	}
+
+	from := start
+	if startTime := execution.GetStartTime().AsTime(); startTime.After(start) {
+		from = startTime
+	}
+	activeUntil := end
+	if closed && closeTime.Before(end) {
+		activeUntil = closeTime
+	}
+	addStorage(record, size, from, end, activeUntil)
+	return size, nil
+}
+
+// addStorage adds size bytes of storage held during [from, end), active until
+// activeUntil and retained from then on.
+func addStorage(record *repository.UsageRecord, size int64, from, end, activeUntil time.Time) {
+	gb := decimal.NewFromInt(size).Div(decimal.NewFromFloat(bytesPerGB))
+	active := gbHours(gb, from, activeUntil)
+	retained := gbHours(gb, activeUntil, end)
+	record.ActiveStorageGBH = record.ActiveStorageGBH.Add(active)
+	record.RetainedStorageGBH = record.RetainedStorageGBH.Add(retained)
+}
+
+func gbHours(gb decimal.Decimal, from, to time.Time) decimal.Decimal {
+	if !to.After(from) {
+		return decimal.Zero
+	}
+	return gb.Mul(decimal.NewFromFloat(to.Sub(from).Hours()))
+}
@@ -0,0 +1,95 @@
+package metering
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/temporaltest"
+)
+
+func meteredWorkflow(ctx workflow.Context) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+	if err := workflow.ExecuteActivity(ctx, meteredActivity).Get(ctx, nil); err != nil {
+		return err
+	}
+	if err := workflow.Sleep(ctx, time.Millisecond); err != nil {
+		return err
+	}
+	workflow.GetSignalChannel(ctx, "done").Receive(ctx, nil)
+	return nil
+}
+
+func meteredActivity(context.Context) error {
+	return nil
+}
+
+func TestCollect(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t))
+	ts.NewWorker("metering", func(registry worker.Registry) {
+		registry.RegisterWorkflow(meteredWorkflow)
+		registry.RegisterActivity(meteredActivity)
+	})
+	cl := ts.GetDefaultClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start := time.Now().Add(-time.Minute)
+	run, err := cl.ExecuteWorkflow(ctx, client.StartWorkflowOptions{TaskQueue: "metering"}, meteredWorkflow)
+	require.NoError(t, err)
+	require.NoError(t, cl.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "done", nil))
+	require.NoError(t, run.Get(ctx, nil))
+	end := time.Now().Add(time.Minute)
+
+	var (
+		record      *repository.UsageRecord
+		closedBytes int64
+	)
+	require.Eventually(t, func() bool {
+		record, closedBytes, err = Collector{}.Collect(ctx, cl, ts.GetDefaultNamespace(), start, end)
+		require.NoError(t, err)
+		return record.RetainedStorageGBH.IsPositive()
+	}, 10*time.Second, 100*time.Millisecond)
+
+	require.Equal(t, int64(1), record.WorkflowStarted)
+	require.Equal(t, int64(1), record.ActivityStarted)
+	require.Equal(t, int64(1), record.TimerStarted)
+	require.Equal(t, int64(1), record.SignalSent)
+	require.Equal(t, int64(4), record.ActionCount)
+	require.True(t, record.ActiveStorageGBH.IsPositive())
+	require.Positive(t, closedBytes)
+
+	// Re-collecting the same period gives the same usage.
+	again, againClosedBytes, err := Collector{}.Collect(ctx, cl, ts.GetDefaultNamespace(), start, end)
+	require.NoError(t, err)
+	require.Equal(t, record.ActionCount, again.ActionCount)
+	require.True(t, record.ActiveStorageGBH.Equal(again.ActiveStorageGBH))
+	require.Equal(t, closedBytes, againClosedBytes)
+
+	// Executions closed before a period are not listed for it; their size is
+	// available from visibility by the hour they closed in.
+	later, laterClosedBytes, err := Collector{}.Collect(ctx, cl, ts.GetDefaultNamespace(), end, end.Add(time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, later.ActionCount)
+	require.True(t, later.ActiveStorageGBH.IsZero())
+	require.True(t, later.RetainedStorageGBH.IsZero())
+	require.Zero(t, laterClosedBytes)
+
+	var sizes map[time.Time]int64
+	require.Eventually(t, func() bool {
+		sizes, err = Collector{}.ClosedHistory(ctx, cl, ts.GetDefaultNamespace(), start, end)
+		require.NoError(t, err)
+		return len(sizes) > 0
+	}, 10*time.Second, 100*time.Millisecond)
+	var total int64
+	for hour, bytes := range sizes {
+		require.Equal(t, hour.Truncate(time.Hour), hour)
+		total += bytes
+	}
+	require.Positive(t, total)
+}
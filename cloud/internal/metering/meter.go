@@ -0,0 +1,188 @@
+package metering
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// Period types of usage aggregates.
+const (
+	PeriodDaily   = "daily"
+	PeriodMonthly = "monthly"
+)
+
+// Clients returns the Temporal client of a cluster.
+type Clients interface {
+	Client(clusterID string) (client.Client, error)
+}
+
+// Meter records hourly namespace usage and rolls it up into aggregates.
+// Recording an hour or aggregating a period again replaces the previous
+// result, so both are safe to retry.
+type Meter struct {
+	repos     *repository.Repositories
+	clients   Clients
+	collector Collector
+	logger    log.Logger
+}
+
+// NewMeter creates a new meter.
+func NewMeter(repos *repository.Repositories, clients Clients, logger log.Logger) *Meter {
+	return &Meter{
+		repos:   repos,
+		clients: clients,
+		logger:  logger,
+	}
+}
+
+// RecordHour measures the namespace's usage during the hour containing hour
+// and stores it as the usage record of that hour.
+func (m *Meter) RecordHour(ctx context.Context, ns *repository.Namespace, hour time.Time) (*repository.UsageRecord, error) {
+	if !ns.ClusterID.Valid {
+		return nil, fmt.Errorf("namespace %s is not placed on a cluster", ns.ID)
+	}
+	cl, err := m.clients.Client(ns.ClusterID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	start := hour.UTC().Truncate(time.Hour)
+	end := start.Add(time.Hour)
+	record, closedBytes, err := m.collector.Collect(ctx, cl, ns.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// Executions that closed before the hour are retained for all of it,
+	// unless their retention period has ended. Their history sizes were
+	// recorded in the hours they closed in, or are taken from visibility the
+	// first time the namespace is metered.
+	retainedFrom := start.Add(-time.Duration(ns.RetentionDays) * 24 * time.Hour)
+	seeded, err := m.repos.Usage.HasClosedHistory(ctx, ns.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !seeded {
+		sizes, err := m.collector.ClosedHistory(ctx, cl, ns.ID, retainedFrom, start)
+		if err != nil {
+			return nil, err
+		}
+		for closeHour, bytes := range sizes {
+			if err := m.repos.Usage.SetClosedHistory(ctx, ns.ID, closeHour, bytes); err != nil {
+				return nil, err
+			}
+		}
+	}
+	retainedBytes, err := m.repos.Usage.SumClosedHistory(ctx, ns.ID, retainedFrom, start)
+	if err != nil {
+		return nil, err
+	}
+	addStorage(record, retainedBytes, start, end, start)
+
+	record.OrganizationID = ns.OrganizationID
+	if err := m.repos.Usage.SetClosedHistory(ctx, ns.ID, start, closedBytes); err != nil {
+		return nil, err
+	}
+	if err := m.repos.Usage.Create(ctx, record); err != nil {
+		return nil, err
+	}
+	// Keep a day more than the retention period so that recent hours can be
+	// recorded again.
+	if err := m.repos.Usage.DeleteClosedHistory(ctx, ns.ID, retainedFrom.Add(-24*time.Hour)); err != nil {
+		return nil, err
+	}
+
+	m.logger.Debug("Recorded namespace usage",
+		tag.WorkflowNamespace(ns.ID), tag.NewTimeTag("hour", start), tag.NewInt64("actions", record.ActionCount))
+	return record, nil
+}
+
+// Aggregate rolls the organization's hourly usage records in the daily or
+// monthly period containing date up into one aggregate per namespace and one
+// for the whole organization.
+func (m *Meter) Aggregate(ctx context.Context, orgID uuid.UUID, periodType string, date time.Time) error {
+	start, end, err := PeriodBounds(periodType, date)
+	if err != nil {
+		return err
+	}
+	records, err := m.repos.Usage.GetByOrganizationAndPeriod(ctx, orgID, start, end)
+	if err != nil {
+		return err
+	}
+
+	for _, agg := range aggregate(orgID, periodType, start, end, records) {
+		if err := m.repos.Usage.CreateAggregate(ctx, agg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PeriodBounds returns the start and end of the daily or monthly period
+// containing date, in UTC.
+func PeriodBounds(periodType string, date time.Time) (time.Time, time.Time, error) {
+	date = date.UTC()
+	switch periodType {
+	case PeriodDaily:
+		start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1), nil
+	case PeriodMonthly:
+		start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0), nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("unknown usage period type %q", periodType)
+	}
+}
+
+// aggregate sums records per namespace. The organization-wide aggregate, with
+// no namespace, comes last.
+func aggregate(orgID uuid.UUID, periodType string, start, end time.Time, records []*repository.UsageRecord) []*repository.UsageAggregate {
+	total := &repository.UsageRecord{}
+	byNamespace := make(map[string]*repository.UsageRecord)
+	for _, record := range records {
+		sum, ok := byNamespace[record.NamespaceID]
+		if !ok {
+			sum = &repository.UsageRecord{}
+			byNamespace[record.NamespaceID] = sum
+		}
+		addRecord(sum, record)
+		addRecord(total, record)
+	}
+
+	namespaces := make([]string, 0, len(byNamespace))
+	for ns := range byNamespace {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	newAggregate := func(namespaceID sql.NullString, sum *repository.UsageRecord) *repository.UsageAggregate {
+		// A map of int64 always marshals.
+		breakdown, _ := json.Marshal(Breakdown(sum))
+		return &repository.UsageAggregate{
+			OrganizationID:     orgID,
+			NamespaceID:        namespaceID,
+			PeriodType:         periodType,
+			PeriodStart:        start,
+			PeriodEnd:          end,
+			TotalActions:       sum.ActionCount,
+			ActiveStorageGBH:   sum.ActiveStorageGBH,
+			RetainedStorageGBH: sum.RetainedStorageGBH,
+			ActionBreakdown:    breakdown,
+		}
+	}
+	aggs := make([]*repository.UsageAggregate, 0, len(namespaces)+1)
+	for _, ns := range namespaces {
+		aggs = append(aggs, newAggregate(sql.NullString{String: ns, Valid: true}, byNamespace[ns]))
+	}
+	return append(aggs, newAggregate(sql.NullString{}, total))
+}
@@ -0,0 +1,131 @@
+package metering
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+func TestCountEvent(t *testing.T) {
+	events := []*historypb.HistoryEvent{
+		{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					SearchAttributes: &commonpb.SearchAttributes{
+						IndexedFields: map[string]*commonpb.Payload{scheduledByIDSearchAttribute: {}},
+					},
+				},
+			},
+		},
+		{
+			// Started by a parent, which is billed for it.
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					ParentWorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "parent"},
+				},
+			},
+		},
+		{EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED},
+		{
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskStartedEventAttributes{
+				ActivityTaskStartedEventAttributes: &historypb.ActivityTaskStartedEventAttributes{Attempt: 3},
+			},
+		},
+		{
+			EventType: enumspb.EVENT_TYPE_MARKER_RECORDED,
+			Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+				MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{MarkerName: "LocalActivity"},
+			},
+		},
+		{
+			EventType: enumspb.EVENT_TYPE_MARKER_RECORDED,
+			Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+				MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{MarkerName: "Version"},
+			},
+		},
+		{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskFailedEventAttributes{
+				WorkflowTaskFailedEventAttributes: &historypb.WorkflowTaskFailedEventAttributes{
+					Cause: enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW,
+				},
+			},
+		},
+		{EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED},
+		{EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+	}
+
+	record := &repository.UsageRecord{}
+	for _, event := range events {
+		countEvent(record, event)
+	}
+	require.Equal(t, int64(1), record.WorkflowStarted)
+	require.Equal(t, int64(1), record.ScheduleExecution)
+	require.Equal(t, int64(3), record.ActivityStarted)
+	require.Equal(t, int64(1), record.LocalActivityBatch)
+	require.Zero(t, record.SideEffectRecorded)
+	require.Equal(t, int64(1), record.WorkflowReset)
+	require.Equal(t, int64(7), totalActions(record))
+}
+
+func TestPeriodBounds(t *testing.T) {
+	date := time.Date(2024, time.February, 29, 13, 30, 0, 0, time.UTC)
+
+	start, end, err := PeriodBounds(PeriodDaily, date)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), end)
+
+	start, end, err = PeriodBounds(PeriodMonthly, date)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), end)
+
+	_, _, err = PeriodBounds("weekly", date)
+	require.Error(t, err)
+}
+
+func TestAggregate(t *testing.T) {
+	orgID := uuid.New()
+	start, end, err := PeriodBounds(PeriodDaily, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	records := []*repository.UsageRecord{
+		{NamespaceID: "ns-b.acct", ActionCount: 2, SignalSent: 2, ActiveStorageGBH: decimal.RequireFromString("0.5")},
+		{NamespaceID: "ns-a.acct", ActionCount: 1, TimerStarted: 1, RetainedStorageGBH: decimal.RequireFromString("1.25")},
+		{NamespaceID: "ns-b.acct", ActionCount: 3, SignalSent: 3, ActiveStorageGBH: decimal.RequireFromString("0.25")},
+	}
+
+	aggs := aggregate(orgID, PeriodDaily, start, end, records)
+	require.Len(t, aggs, 3)
+
+	require.Equal(t, "ns-a.acct", aggs[0].NamespaceID.String)
+	require.Equal(t, int64(1), aggs[0].TotalActions)
+
+	require.Equal(t, "ns-b.acct", aggs[1].NamespaceID.String)
+	require.Equal(t, int64(5), aggs[1].TotalActions)
+	require.Equal(t, "0.75", aggs[1].ActiveStorageGBH.String())
+	var breakdown map[string]int64
+	require.NoError(t, json.Unmarshal(aggs[1].ActionBreakdown, &breakdown))
+	require.Equal(t, int64(5), breakdown["signal_sent"])
+
+	total := aggs[2]
+	require.False(t, total.NamespaceID.Valid)
+	require.Equal(t, orgID, total.OrganizationID)
+	require.Equal(t, PeriodDaily, total.PeriodType)
+	require.Equal(t, start, total.PeriodStart)
+	require.Equal(t, end, total.PeriodEnd)
+	require.Equal(t, int64(6), total.TotalActions)
+	require.Equal(t, "0.75", total.ActiveStorageGBH.String())
+	require.Equal(t, "1.25", total.RetainedStorageGBH.String())
+}
@@ -0,0 +1,123 @@
+// Package objectstore connects to customer-owned buckets. Writes never use
+// the worker's own storage permissions: S3 buckets are written with a role
+// assumed in the customer's account, and GCS buckets by impersonating a
+// customer's service account.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+)
+
+// roleSessionName names the sessions of assumed roles in the customer's
+// CloudTrail.
+const roleSessionName = "temporal-cloud"
+
+var (
+	roleARNPattern        = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/[\w+=,.@/-]{1,512}$`)
+	serviceAccountPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]@[a-z0-9-]+\.iam\.gserviceaccount\.com$`)
+)
+
+// S3Config is the configuration of an S3 bucket.
+type S3Config struct {
+	Bucket string `json:"bucket"`
+	Prefix string `json:"prefix,omitempty"`
+	Region string `json:"region"`
+	// RoleARN is the IAM role in the customer's account assumed to write to
+	// the bucket. Its trust policy must require the organization's ID as the
+	// external ID, so that other customers cannot name it.
+	RoleARN string `json:"role_arn"`
+}
+
+// GCSConfig is the configuration of a Google Cloud Storage bucket.
+type GCSConfig struct {
+	Bucket string `json:"bucket"`
+	Prefix string `json:"prefix,omitempty"`
+	// ServiceAccount is the customer's service account impersonated to write
+	// to the bucket.
+	ServiceAccount string `json:"service_account"`
+}
+
+// ParseS3Config parses and validates an S3 configuration. Unknown fields,
+// such as endpoint overrides, are rejected.
+func ParseS3Config(raw json.RawMessage) (*S3Config, error) {
+	var cfg S3Config
+	if err := decodeStrict(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid s3 config: %w", err)
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("s3 config requires a bucket")
+	}
+	if cfg.Region == "" {
+		return nil, errors.New("s3 config requires a region")
+	}
+	if !roleARNPattern.MatchString(cfg.RoleARN) {
+		return nil, errors.New("s3 config requires the ARN of an IAM role to assume")
+	}
+	return &cfg, nil
+}
+
+// ParseGCSConfig parses and validates a GCS configuration.
+func ParseGCSConfig(raw json.RawMessage) (*GCSConfig, error) {
+	var cfg GCSConfig
+	if err := decodeStrict(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid gcs config: %w", err)
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("gcs config requires a bucket")
+	}
+	if !serviceAccountPattern.MatchString(cfg.ServiceAccount) {
+		return nil, errors.New("gcs config requires the email of a service account to impersonate")
+	}
+	return &cfg, nil
+}
+
+func decodeStrict(raw json.RawMessage, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// NewS3Client returns a client writing to the bucket as the configured role,
+// assumed with externalID, which must identify the customer.
+func NewS3Client(cfg *S3Config, externalID string) (s3iface.S3API, error) {
+	if externalID == "" {
+		return nil, errors.New("an external ID is required to assume a customer role")
+	}
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(cfg.Region)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+	creds := stscreds.NewCredentials(sess, cfg.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.ExternalID = aws.String(externalID)
+		p.RoleSessionName = roleSessionName
+	})
+	return s3.New(sess, &aws.Config{Credentials: creds}), nil
+}
+
+// NewGCSClient returns a client writing to buckets as the configured service
+// account.
+func NewGCSClient(ctx context.Context, cfg *GCSConfig) (connector.Client, error) {
+	tokens, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: cfg.ServiceAccount,
+		Scopes:          []string{storage.ScopeReadWrite},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to impersonate %s: %w", cfg.ServiceAccount, err)
+	}
+	return connector.NewClientWithOptions(ctx, option.WithTokenSource(tokens))
+}
@@ -0,0 +1,57 @@
+package objectstore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseS3Config(t *testing.T) {
+	for _, tc := range []struct {
+		config string
+		valid  bool
+	}{
+		{`{"bucket":"audit","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/temporal-export"}`, true},
+		{`{"bucket":"audit","region":"us-gov-west-1","role_arn":"arn:aws-us-gov:iam::123456789012:role/path/export"}`, true},
+		{`{"bucket":"audit","region":"us-east-1"}`, false},
+		{`{"bucket":"audit","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:user/export"}`, false},
+		{`{"bucket":"audit","region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/export","endpoint":"http://127.0.0.1:9000"}`, false},
+		{`{"region":"us-east-1","role_arn":"arn:aws:iam::123456789012:role/export"}`, false},
+		{`{"bucket":"audit","role_arn":"arn:aws:iam::123456789012:role/export"}`, false},
+	} {
+		_, err := ParseS3Config(json.RawMessage(tc.config))
+		if tc.valid {
+			require.NoError(t, err, tc.config)
+		} else {
+			require.Error(t, err, tc.config)
+		}
+	}
+}
+
+func TestParseGCSConfig(t *testing.T) {
+	for _, tc := range []struct {
+		config string
+		valid  bool
+	}{
+		{`{"bucket":"audit","service_account":"export@customer-project.iam.gserviceaccount.com"}`, true},
+		{`{"bucket":"audit"}`, false},
+		{`{"bucket":"audit","service_account":"someone@example.com"}`, false},
+		{`{"service_account":"export@customer-project.iam.gserviceaccount.com"}`, false},
+	} {
+		_, err := ParseGCSConfig(json.RawMessage(tc.config))
+		if tc.valid {
+			require.NoError(t, err, tc.config)
+		} else {
+			require.Error(t, err, tc.config)
+		}
+	}
+}
+
+func TestNewS3ClientRequiresExternalID(t *testing.T) {
+	cfg := &S3Config{Bucket: "audit", Region: "us-east-1", RoleARN: "arn:aws:iam::123456789012:role/export"}
+	_, err := NewS3Client(cfg, "")
+	require.Error(t, err)
+	_, err = NewS3Client(cfg, "org-1")
+	require.NoError(t, err)
+}
@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps counters in memory. Each API server then enforces quotas
+// on its own, so it is meant for development and single-server deployments.
+type MemoryStore struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	windows map[string]*memoryWindow
+	// nextSweep is when ended windows are next removed.
+	nextSweep time.Time
+}
+
+type memoryWindow struct {
+	count int64
+	ends  time.Time
+}
+
+// NewMemoryStore creates an in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{now: time.Now, windows: make(map[string]*memoryWindow)}
+}
+
+// Increment implements Store.
+func (s *MemoryStore) Increment(_ context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	// Ended windows of idle keys are removed at most once per window, which
+	// bounds the cost of the sweep per increment.
+	if !now.Before(s.nextSweep) {
+		for k, w := range s.windows {
+			if !now.Before(w.ends) {
+				delete(s.windows, k)
+			}
+		}
+		s.nextSweep = now.Add(window)
+	}
+
+	w, ok := s.windows[key]
+	if !ok || !now.Before(w.ends) {
+		w = &memoryWindow{ends: now.Add(window)}
+		s.windows[key] = w
+	}
+	w.count++
+	return w.count, w.ends.Sub(now), nil
+}
+
+// Len returns the number of keys tracked, including ended windows that have
+// not been swept yet.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.windows)
+}
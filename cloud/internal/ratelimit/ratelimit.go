@@ -0,0 +1,74 @@
+// Package ratelimit implements fixed-window request quotas. Counters are kept
+// in a Store, which can be shared by all API servers.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/cloud/internal/config"
+)
+
+// Store counts requests in fixed windows.
+type Store interface {
+	// Increment adds one to the counter of key. A key's window starts with
+	// its first increment and lasts window, after which the counter starts
+	// over. It returns the counter and the time until the window ends.
+	Increment(ctx context.Context, key string, window time.Duration) (count int64, reset time.Duration, err error)
+}
+
+// NewStore creates the store selected by the configuration.
+func NewStore(cfg config.RateLimitConfig) (Store, error) {
+	switch cfg.Backend {
+	case "redis":
+		return NewRedisStore(RedisOptions{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		}), nil
+	case "memory", "":
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend %q", cfg.Backend)
+	}
+}
+
+// Quota is the number of requests allowed per window.
+type Quota struct {
+	Limit  int64
+	Window time.Duration
+}
+
+// Result is the outcome of counting a request against a quota.
+type Result struct {
+	Allowed   bool
+	Limit     int64
+	Remaining int64
+	// Reset is the time until the quota is replenished.
+	Reset time.Duration
+}
+
+// Limiter counts requests against quotas.
+type Limiter struct {
+	store Store
+}
+
+// NewLimiter creates a limiter that keeps its counters in store.
+func NewLimiter(store Store) *Limiter {
+	return &Limiter{store: store}
+}
+
+// Allow counts a request of key against quota.
+func (l *Limiter) Allow(ctx context.Context, key string, quota Quota) (*Result, error) {
+	count, reset, err := l.store.Increment(ctx, "ratelimit:"+key, quota.Window)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Allowed:   count <= quota.Limit,
+		Limit:     quota.Limit,
+		Remaining: max(quota.Limit-count, 0),
+		Reset:     reset,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterMemoryStore(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limiter := NewLimiter(store)
+	ctx := context.Background()
+	quota := Quota{Limit: 2, Window: time.Minute}
+
+	result, err := limiter.Allow(ctx, "org:a", quota)
+	require.NoError(t, err)
+	require.Equal(t, &Result{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Minute}, result)
+
+	now = now.Add(10 * time.Second)
+	result, err = limiter.Allow(ctx, "org:a", quota)
+	require.NoError(t, err)
+	require.Equal(t, &Result{Allowed: true, Limit: 2, Remaining: 0, Reset: 50 * time.Second}, result)
+
+	result, err = limiter.Allow(ctx, "org:a", quota)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.Zero(t, result.Remaining)
+
+	// Other keys have their own counters.
+	result, err = limiter.Allow(ctx, "org:b", quota)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	require.Equal(t, 2, store.Len())
+
+	// The window of org:a ends, and with it the one of org:b, which is idle
+	// and gets swept.
+	now = now.Add(time.Minute)
+	result, err = limiter.Allow(ctx, "org:a", quota)
+	require.NoError(t, err)
+	require.Equal(t, &Result{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Minute}, result)
+	require.Equal(t, 1, store.Len())
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisOptions configures a RedisStore.
+type RedisOptions struct {
+	// Addr is the host:port of the server.
+	Addr     string
+	Password string
+	DB       int
+	// PoolSize is the maximum number of connections kept open.
+	PoolSize int
+	// Timeout bounds dialing and each round trip, when the context has no
+	// earlier deadline.
+	Timeout time.Duration
+}
+
+// RedisStore keeps counters in Redis so that all API servers share them.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a store that connects to opts.Addr on first use.
+func NewRedisStore(opts RedisOptions) *RedisStore {
+	if opts.PoolSize <= 0 {
+		opts.PoolSize = 10
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = time.Second
+	}
+	return &RedisStore{client: redis.NewClient(&redis.Options{
+		Addr:         opts.Addr,
+		Password:     opts.Password,
+		DB:           opts.DB,
+		PoolSize:     opts.PoolSize,
+		DialTimeout:  opts.Timeout,
+		ReadTimeout:  opts.Timeout,
+		WriteTimeout: opts.Timeout,
+	})}
+}
+
+// Increment implements Store. The window is created with its expiry and
+// incremented in one transaction, so a counter cannot outlive its window.
+func (s *RedisStore) Increment(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	var incr *redis.IntCmd
+	var pttl *redis.DurationCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetNX(ctx, key, 0, window)
+		incr = pipe.Incr(ctx, key)
+		pttl = pipe.PTTL(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to increment rate limit counter: %w", err)
+	}
+
+	reset := window
+	if ttl := pttl.Val(); ttl >= 0 {
+		reset = ttl
+	}
+	return incr.Val(), reset, nil
+}
+
+// Close closes the connections to the server.
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	store := NewRedisStore(RedisOptions{Addr: server.Addr(), Password: "secret", DB: 2})
+	defer func() { _ = store.Close() }()
+	ctx := context.Background()
+
+	count, reset, err := store.Increment(ctx, "ratelimit:org:a", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	require.Equal(t, time.Minute, reset)
+
+	server.FastForward(15 * time.Second)
+	count, reset, err = store.Increment(ctx, "ratelimit:org:a", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+	require.Equal(t, 45*time.Second, reset)
+
+	server.FastForward(45 * time.Second)
+	count, reset, err = store.Increment(ctx, "ratelimit:org:a", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	require.Equal(t, time.Minute, reset)
+
+	server.Select(2)
+	require.True(t, server.Exists("ratelimit:org:a"))
+
+	wrong := NewRedisStore(RedisOptions{Addr: server.Addr(), Password: "wrong"})
+	defer func() { _ = wrong.Close() }()
+	_, _, err = wrong.Increment(ctx, "ratelimit:org:a", time.Minute)
+	require.ErrorContains(t, err, "WRONGPASS")
+}
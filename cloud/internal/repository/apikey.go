@@ -179,6 +179,25 @@ func (r *APIKeyRepository) Disable(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// DisableByOrganization disables the API keys of an organization's service
+// accounts and returns how many were disabled.
+func (r *APIKeyRepository) DisableByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	query := `
+		UPDATE api_keys SET disabled = true
+		WHERE owner_type = 'service_account' AND NOT disabled
+			AND owner_id IN (SELECT id FROM service_accounts WHERE organization_id = $1)
+	`
+	result, err := r.db.DB().ExecContext(ctx, query, orgID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to disable organization API keys: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to disable organization API keys: %w", err)
+	}
+	return n, nil
+}
+
 // Delete deletes an API key.
 func (r *APIKeyRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM api_keys WHERE id = $1`
@@ -117,6 +117,37 @@ func (r *AuditRepository) ListByOrganization(ctx context.Context, orgID uuid.UUI
 	}
 	defer rows.Close()
 
+	return scanAuditEvents(rows)
+}
+
+// AuditCursor is a position in an organization's audit events, ordered by
+// creation time and then ID. The zero cursor is before all events.
+type AuditCursor struct {
+	Time time.Time
+	ID   uuid.UUID
+}
+
+// ListAfter lists an organization's audit events after the cursor and created
+// before end, oldest first.
+func (r *AuditRepository) ListAfter(ctx context.Context, orgID uuid.UUID, after AuditCursor, end time.Time, limit int) ([]*AuditEvent, error) {
+	query := `
+		SELECT id, organization_id, actor_type, actor_id, actor_email, actor_name,
+			action, result, resource_type, resource_id, resource_name,
+			request_id, ip_address, user_agent, method, path, details, created_at
+		FROM audit_events
+		WHERE organization_id = $1 AND (created_at, id) > ($2, $3) AND created_at < $4
+		ORDER BY created_at, id LIMIT $5
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, orgID, after.Time, after.ID, end, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAuditEvents(rows)
+}
+
+func scanAuditEvents(rows *sql.Rows) ([]*AuditEvent, error) {
 	var events []*AuditEvent
 	for rows.Next() {
 		event := &AuditEvent{}
@@ -134,5 +165,5 @@ func (r *AuditRepository) ListByOrganization(ctx context.Context, orgID uuid.UUI
 		}
 		events = append(events, event)
 	}
-	return events, nil
+	return events, rows.Err()
 }
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditStream delivers an organization's audit events to a sink as they are
+// recorded.
+type AuditStream struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Format         string
+	SinkType       string
+	Config         json.RawMessage
+	Enabled        bool
+	// Cursor is the last event delivered.
+	Cursor AuditCursor
+	// Sequence and LastHash identify the last batch delivered.
+	Sequence        int64
+	LastHash        string
+	LastDeliveredAt sql.NullTime
+	// LastError is the error of the last failed delivery, cleared by the next
+	// successful one.
+	LastError sql.NullString
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AuditStreamRepository handles audit stream data access.
+type AuditStreamRepository struct {
+	db *PostgresDB
+}
+
+// NewAuditStreamRepository creates a new audit stream repository.
+func NewAuditStreamRepository(db *PostgresDB) *AuditStreamRepository {
+	return &AuditStreamRepository{db: db}
+}
+
+const auditStreamColumns = `id, organization_id, name, format, sink_type, config, enabled,
+	cursor_time, cursor_id, sequence, last_hash, last_delivered_at, last_error, created_at, updated_at`
+
+func scanAuditStream(row interface{ Scan(...any) error }) (*AuditStream, error) {
+	stream := &AuditStream{}
+	err := row.Scan(
+		&stream.ID, &stream.OrganizationID, &stream.Name, &stream.Format, &stream.SinkType, &stream.Config, &stream.Enabled,
+		&stream.Cursor.Time, &stream.Cursor.ID, &stream.Sequence, &stream.LastHash, &stream.LastDeliveredAt, &stream.LastError,
+		&stream.CreatedAt, &stream.UpdatedAt,
+	)
+	return stream, err
+}
+
+// Create creates a new audit stream. Its cursor starts at its creation, so
+// that it delivers the events recorded from then on.
+func (r *AuditStreamRepository) Create(ctx context.Context, stream *AuditStream) error {
+	query := `
+		INSERT INTO audit_streams (id, organization_id, name, format, sink_type, config, enabled, cursor_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING cursor_time, cursor_id, created_at, updated_at
+	`
+	if stream.ID == uuid.Nil {
+		stream.ID = uuid.New()
+	}
+	err := r.db.DB().QueryRowContext(ctx, query,
+		stream.ID, stream.OrganizationID, stream.Name, stream.Format, stream.SinkType, stream.Config, stream.Enabled,
+	).Scan(&stream.Cursor.Time, &stream.Cursor.ID, &stream.CreatedAt, &stream.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create audit stream: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves an audit stream by ID.
+func (r *AuditStreamRepository) GetByID(ctx context.Context, id uuid.UUID) (*AuditStream, error) {
+	query := `SELECT ` + auditStreamColumns + ` FROM audit_streams WHERE id = $1`
+	return r.get(ctx, query, id)
+}
+
+// GetByName retrieves an audit stream of an organization by name.
+func (r *AuditStreamRepository) GetByName(ctx context.Context, orgID uuid.UUID, name string) (*AuditStream, error) {
+	query := `SELECT ` + auditStreamColumns + ` FROM audit_streams WHERE organization_id = $1 AND name = $2`
+	return r.get(ctx, query, orgID, name)
+}
+
+func (r *AuditStreamRepository) get(ctx context.Context, query string, args ...any) (*AuditStream, error) {
+	stream, err := scanAuditStream(r.db.DB().QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit stream: %w", err)
+	}
+	return stream, nil
+}
+
+// List lists the audit streams of an organization.
+func (r *AuditStreamRepository) List(ctx context.Context, orgID uuid.UUID) ([]*AuditStream, error) {
+	query := `SELECT ` + auditStreamColumns + ` FROM audit_streams WHERE organization_id = $1 ORDER BY name`
+	return r.list(ctx, query, orgID)
+}
+
+// ListEnabled lists the enabled audit streams of all organizations.
+func (r *AuditStreamRepository) ListEnabled(ctx context.Context) ([]*AuditStream, error) {
+	query := `SELECT ` + auditStreamColumns + ` FROM audit_streams WHERE enabled ORDER BY created_at`
+	return r.list(ctx, query)
+}
+
+func (r *AuditStreamRepository) list(ctx context.Context, query string, args ...any) ([]*AuditStream, error) {
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit streams: %w", err)
+	}
+	defer rows.Close()
+
+	var streams []*AuditStream
+	for rows.Next() {
+		stream, err := scanAuditStream(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit stream: %w", err)
+		}
+		streams = append(streams, stream)
+	}
+	return streams, rows.Err()
+}
+
+// Update updates an audit stream's name, format, sink and enabled state.
+func (r *AuditStreamRepository) Update(ctx context.Context, stream *AuditStream) error {
+	query := `
+		UPDATE audit_streams SET name = $2, format = $3, sink_type = $4, config = $5, enabled = $6
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err := r.db.DB().QueryRowContext(ctx, query,
+		stream.ID, stream.Name, stream.Format, stream.SinkType, stream.Config, stream.Enabled,
+	).Scan(&stream.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update audit stream: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes an audit stream. It reports whether the stream existed.
+func (r *AuditStreamRepository) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		DELETE FROM audit_streams WHERE id = $1
+	`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete audit stream: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete audit stream: %w", err)
+	}
+	return n > 0, nil
+}
+
+// Advance records the delivery of the batch following the stream's last one,
+// ending at cursor. It reports false, without changing anything, if another
+// batch has been recorded since the stream was read.
+func (r *AuditStreamRepository) Advance(ctx context.Context, id uuid.UUID, cursor AuditCursor, sequence int64, hash string) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		UPDATE audit_streams
+		SET cursor_time = $2, cursor_id = $3, sequence = $4, last_hash = $5,
+			last_delivered_at = NOW(), last_error = NULL
+		WHERE id = $1 AND sequence = $4 - 1
+	`, id, cursor.Time, cursor.ID, sequence, hash)
+	if err != nil {
+		return false, fmt.Errorf("failed to advance audit stream: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to advance audit stream: %w", err)
+	}
+	return n > 0, nil
+}
+
+// SetError records a failed delivery.
+func (r *AuditStreamRepository) SetError(ctx context.Context, id uuid.UUID, message string) error {
+	_, err := r.db.DB().ExecContext(ctx, `
+		UPDATE audit_streams SET last_error = $2 WHERE id = $1
+	`, id, message)
+	if err != nil {
+		return fmt.Errorf("failed to update audit stream: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CertificateAuthority is a namespace's client CA. The private key is stored
+// encrypted; the repository never sees it in plaintext.
+type CertificateAuthority struct {
+	ID                  uuid.UUID
+	NamespaceID         string
+	CertificatePEM      string
+	EncryptedPrivateKey []byte
+	Fingerprint         string
+	NotBefore           time.Time
+	NotAfter            time.Time
+	RetireAt            sql.NullTime
+	CreatedAt           time.Time
+}
+
+// CertificateAuthorityRepository handles namespace CA data access.
+type CertificateAuthorityRepository struct {
+	db *PostgresDB
+}
+
+// NewCertificateAuthorityRepository creates a new certificate authority repository.
+func NewCertificateAuthorityRepository(db *PostgresDB) *CertificateAuthorityRepository {
+	return &CertificateAuthorityRepository{db: db}
+}
+
+const certificateAuthorityColumns = `
+	id, namespace_id, certificate_pem, encrypted_private_key, fingerprint,
+	not_before, not_after, retire_at, created_at
+`
+
+// GetCurrent returns the namespace's current (non-retiring) CA.
+func (r *CertificateAuthorityRepository) GetCurrent(ctx context.Context, namespaceID string) (*CertificateAuthority, error) {
+	query := `SELECT ` + certificateAuthorityColumns + `
+		FROM namespace_certificate_authorities
+		WHERE namespace_id = $1 AND retire_at IS NULL
+	`
+	ca := &CertificateAuthority{}
+	err := r.db.DB().QueryRowContext(ctx, query, namespaceID).Scan(
+		&ca.ID, &ca.NamespaceID, &ca.CertificatePEM, &ca.EncryptedPrivateKey, &ca.Fingerprint,
+		&ca.NotBefore, &ca.NotAfter, &ca.RetireAt, &ca.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate authority: %w", err)
+	}
+	return ca, nil
+}
+
+// ListTrusted lists the CAs whose certificates should still be accepted at the
+// given time: the current CA and any retiring CA still inside its overlap window.
+func (r *CertificateAuthorityRepository) ListTrusted(ctx context.Context, namespaceID string, at time.Time) ([]*CertificateAuthority, error) {
+	query := `SELECT ` + certificateAuthorityColumns + `
+		FROM namespace_certificate_authorities
+		WHERE namespace_id = $1 AND (retire_at IS NULL OR retire_at > $2) AND not_after > $2
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, namespaceID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list certificate authorities: %w", err)
+	}
+	defer rows.Close()
+
+	var cas []*CertificateAuthority
+	for rows.Next() {
+		ca := &CertificateAuthority{}
+		if err := rows.Scan(
+			&ca.ID, &ca.NamespaceID, &ca.CertificatePEM, &ca.EncryptedPrivateKey, &ca.Fingerprint,
+			&ca.NotBefore, &ca.NotAfter, &ca.RetireAt, &ca.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan certificate authority: %w", err)
+		}
+		cas = append(cas, ca)
+	}
+	return cas, rows.Err()
+}
+
+// Rotate makes ca the namespace's current CA. The previous current CA, if any,
+// is retired at retireAt. Both changes happen in one transaction.
+func (r *CertificateAuthorityRepository) Rotate(ctx context.Context, ca *CertificateAuthority, retireAt time.Time) error {
+	if ca.ID == uuid.Nil {
+		ca.ID = uuid.New()
+	}
+	ca.CreatedAt = time.Now()
+
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE namespace_certificate_authorities
+		SET retire_at = $2
+		WHERE namespace_id = $1 AND retire_at IS NULL
+	`, ca.NamespaceID, retireAt)
+	if err != nil {
+		return fmt.Errorf("failed to retire certificate authority: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO namespace_certificate_authorities (`+certificateAuthorityColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULL, $8)
+	`,
+		ca.ID, ca.NamespaceID, ca.CertificatePEM, ca.EncryptedPrivateKey, ca.Fingerprint,
+		ca.NotBefore, ca.NotAfter, ca.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate authority: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit certificate authority rotation: %w", err)
+	}
+	return nil
+}
+
+// DeleteRetired deletes CAs that were retired before the given time.
+func (r *CertificateAuthorityRepository) DeleteRetired(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM namespace_certificate_authorities WHERE retire_at IS NOT NULL AND retire_at <= $1`
+	result, err := r.db.DB().ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete retired certificate authorities: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Connectivity rule types.
+const (
+	ConnectivityRuleTypeIPAllowlist = "ip_allowlist"
+	ConnectivityRuleTypePrivateLink = "private_link"
+	ConnectivityRuleTypeVPCPeering  = "vpc_peering"
+)
+
+// ConnectivityRule controls how clients reach the namespaces it is bound to.
+type ConnectivityRule struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Type           string
+	Config         json.RawMessage
+	Enabled        bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ConnectivityRepository handles connectivity rule and namespace binding data
+// access.
+type ConnectivityRepository struct {
+	db *PostgresDB
+}
+
+// NewConnectivityRepository creates a new connectivity repository.
+func NewConnectivityRepository(db *PostgresDB) *ConnectivityRepository {
+	return &ConnectivityRepository{db: db}
+}
+
+const connectivityRuleColumns = `id, organization_id, name, type, config, enabled, created_at, updated_at`
+
+func scanConnectivityRule(row interface{ Scan(...any) error }) (*ConnectivityRule, error) {
+	rule := &ConnectivityRule{}
+	err := row.Scan(
+		&rule.ID, &rule.OrganizationID, &rule.Name, &rule.Type, &rule.Config, &rule.Enabled,
+		&rule.CreatedAt, &rule.UpdatedAt,
+	)
+	return rule, err
+}
+
+// CreateRule creates a new connectivity rule.
+func (r *ConnectivityRepository) CreateRule(ctx context.Context, rule *ConnectivityRule) error {
+	query := `
+		INSERT INTO connectivity_rules (id, organization_id, name, type, config, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at
+	`
+	if rule.ID == uuid.Nil {
+		rule.ID = uuid.New()
+	}
+	err := r.db.DB().QueryRowContext(ctx, query,
+		rule.ID, rule.OrganizationID, rule.Name, rule.Type, rule.Config, rule.Enabled,
+	).Scan(&rule.CreatedAt, &rule.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create connectivity rule: %w", err)
+	}
+	return nil
+}
+
+// GetRule retrieves a connectivity rule of an organization by ID.
+func (r *ConnectivityRepository) GetRule(ctx context.Context, orgID, id uuid.UUID) (*ConnectivityRule, error) {
+	query := `SELECT ` + connectivityRuleColumns + ` FROM connectivity_rules WHERE organization_id = $1 AND id = $2`
+	return r.getRule(ctx, query, orgID, id)
+}
+
+// GetRuleByName retrieves a connectivity rule of an organization by name.
+func (r *ConnectivityRepository) GetRuleByName(ctx context.Context, orgID uuid.UUID, name string) (*ConnectivityRule, error) {
+	query := `SELECT ` + connectivityRuleColumns + ` FROM connectivity_rules WHERE organization_id = $1 AND name = $2`
+	return r.getRule(ctx, query, orgID, name)
+}
+
+func (r *ConnectivityRepository) getRule(ctx context.Context, query string, args ...any) (*ConnectivityRule, error) {
+	rule, err := scanConnectivityRule(r.db.DB().QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connectivity rule: %w", err)
+	}
+	return rule, nil
+}
+
+// ListRules lists the connectivity rules of an organization.
+func (r *ConnectivityRepository) ListRules(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*ConnectivityRule, error) {
+	query := `
+		SELECT ` + connectivityRuleColumns + `
+		FROM connectivity_rules
+		WHERE organization_id = $1
+		ORDER BY name
+		LIMIT $2 OFFSET $3
+	`
+	return r.listRules(ctx, query, orgID, limit, offset)
+}
+
+// ListNamespaceRules lists the connectivity rules bound to a namespace.
+func (r *ConnectivityRepository) ListNamespaceRules(ctx context.Context, namespaceID string) ([]*ConnectivityRule, error) {
+	query := `
+		SELECT r.id, r.organization_id, r.name, r.type, r.config, r.enabled, r.created_at, r.updated_at
+		FROM connectivity_rules r
+		JOIN namespace_connectivity_bindings b ON b.connectivity_rule_id = r.id
+		WHERE b.namespace_id = $1
+		ORDER BY r.name
+	`
+	return r.listRules(ctx, query, namespaceID)
+}
+
+func (r *ConnectivityRepository) listRules(ctx context.Context, query string, args ...any) ([]*ConnectivityRule, error) {
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connectivity rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*ConnectivityRule
+	for rows.Next() {
+		rule, err := scanConnectivityRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan connectivity rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+// UpdateRule updates a connectivity rule's name, configuration and enabled
+// state.
+func (r *ConnectivityRepository) UpdateRule(ctx context.Context, rule *ConnectivityRule) error {
+	query := `
+		UPDATE connectivity_rules SET name = $3, config = $4, enabled = $5
+		WHERE organization_id = $1 AND id = $2
+		RETURNING updated_at
+	`
+	err := r.db.DB().QueryRowContext(ctx, query,
+		rule.OrganizationID, rule.ID, rule.Name, rule.Config, rule.Enabled,
+	).Scan(&rule.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update connectivity rule: %w", err)
+	}
+	return nil
+}
+
+// DeleteRule deletes a connectivity rule and its namespace bindings. It
+// reports whether the rule existed.
+func (r *ConnectivityRepository) DeleteRule(ctx context.Context, orgID, id uuid.UUID) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		DELETE FROM connectivity_rules WHERE organization_id = $1 AND id = $2
+	`, orgID, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete connectivity rule: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete connectivity rule: %w", err)
+	}
+	return n > 0, nil
+}
+
+// AddBinding binds a connectivity rule to a namespace. Binding a rule again
+// is a no-op.
+func (r *ConnectivityRepository) AddBinding(ctx context.Context, namespaceID string, ruleID uuid.UUID) error {
+	_, err := r.db.DB().ExecContext(ctx, `
+		INSERT INTO namespace_connectivity_bindings (namespace_id, connectivity_rule_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, namespaceID, ruleID)
+	if err != nil {
+		return fmt.Errorf("failed to bind connectivity rule: %w", err)
+	}
+	return nil
+}
+
+// RemoveBinding unbinds a connectivity rule from a namespace. It reports
+// whether the rule was bound.
+func (r *ConnectivityRepository) RemoveBinding(ctx context.Context, namespaceID string, ruleID uuid.UUID) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		DELETE FROM namespace_connectivity_bindings WHERE namespace_id = $1 AND connectivity_rule_id = $2
+	`, namespaceID, ruleID)
+	if err != nil {
+		return false, fmt.Errorf("failed to unbind connectivity rule: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to unbind connectivity rule: %w", err)
+	}
+	return n > 0, nil
+}
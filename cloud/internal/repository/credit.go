@@ -0,0 +1,450 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Credit ledger accounts. Every credit transaction posts its amount to the
+// organization's customer credits account and the negated amount to one
+// counter account, so the postings of a transaction always sum to zero and
+// the customer credits postings sum to the balance.
+const (
+	CreditAccountCustomer    = "customer_credits"
+	CreditAccountPayments    = "payments"
+	CreditAccountInvoices    = "invoices"
+	CreditAccountExpired     = "expired"
+	CreditAccountAdjustments = "adjustments"
+)
+
+// Credit transaction types.
+const (
+	CreditTransactionPurchase = "purchase"
+	CreditTransactionUsage    = "usage"
+	CreditTransactionExpiry   = "expiry"
+)
+
+// ErrInsufficientCredits is returned when a transaction would take the
+// credit balance below zero.
+var ErrInsufficientCredits = errors.New("insufficient credit balance")
+
+// CreditPurchase represents a purchase of prepaid credits.
+type CreditPurchase struct {
+	ID                    uuid.UUID
+	OrganizationID        uuid.UUID
+	AmountCents           int64
+	RemainingCents        int64
+	StripePaymentIntentID sql.NullString
+	PurchasedAt           time.Time
+	ExpiresAt             time.Time
+}
+
+// CreditTransaction is a journal entry in the credit ledger. Transactions are
+// append-only; corrections are made with further transactions.
+type CreditTransaction struct {
+	ID                uuid.UUID
+	OrganizationID    uuid.UUID
+	AmountCents       int64
+	BalanceAfterCents int64
+	TransactionType   string
+	ReferenceType     sql.NullString
+	ReferenceID       sql.NullString
+	Description       sql.NullString
+	CreatedAt         time.Time
+}
+
+// CreditLedgerEntry is one posting of a credit transaction to an account.
+type CreditLedgerEntry struct {
+	ID             uuid.UUID
+	TransactionID  uuid.UUID
+	OrganizationID uuid.UUID
+	Account        string
+	AmountCents    int64
+	CreatedAt      time.Time
+}
+
+// CreditRepository handles credit data access.
+type CreditRepository struct {
+	db *PostgresDB
+}
+
+// NewCreditRepository creates a new credit repository.
+func NewCreditRepository(db *PostgresDB) *CreditRepository {
+	return &CreditRepository{db: db}
+}
+
+// GetBalance returns the organization's credit balance snapshot.
+func (r *CreditRepository) GetBalance(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	query := `SELECT balance_cents FROM credit_balance WHERE organization_id = $1`
+	var balance int64
+	err := r.db.DB().QueryRowContext(ctx, query, orgID).Scan(&balance)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get credit balance: %w", err)
+	}
+	return balance, nil
+}
+
+// RecordPurchase records a credit purchase and posts it to the ledger in one
+// transaction. A purchase whose payment intent was already recorded is
+// ignored; the returned bool reports whether it was new.
+func (r *CreditRepository) RecordPurchase(ctx context.Context, purchase *CreditPurchase) (bool, error) {
+	if purchase.ID == uuid.Nil {
+		purchase.ID = uuid.New()
+	}
+	purchase.RemainingCents = purchase.AmountCents
+
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	balance, err := lockCreditBalance(ctx, tx, purchase.OrganizationID)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO credit_purchases (
+			id, organization_id, amount_cents, remaining_cents, stripe_payment_intent_id, purchased_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (stripe_payment_intent_id) WHERE stripe_payment_intent_id IS NOT NULL DO NOTHING
+	`,
+		purchase.ID, purchase.OrganizationID, purchase.AmountCents, purchase.RemainingCents,
+		purchase.StripePaymentIntentID, purchase.PurchasedAt, purchase.ExpiresAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create credit purchase: %w", err)
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	_, err = postCreditTransaction(ctx, tx, balance, &CreditTransaction{
+		OrganizationID:  purchase.OrganizationID,
+		AmountCents:     purchase.AmountCents,
+		TransactionType: CreditTransactionPurchase,
+		ReferenceType:   sql.NullString{String: "purchase", Valid: true},
+		ReferenceID:     sql.NullString{String: purchase.ID.String(), Valid: true},
+		Description:     sql.NullString{String: "Credit purchase", Valid: true},
+		CreatedAt:       purchase.PurchasedAt,
+	}, CreditAccountPayments)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit credit purchase: %w", err)
+	}
+	return true, nil
+}
+
+// ListOrganizationsWithLapsedCredits lists organizations holding unconsumed
+// credits that expired at or before the given time.
+func (r *CreditRepository) ListOrganizationsWithLapsedCredits(ctx context.Context, at time.Time) ([]uuid.UUID, error) {
+	query := `
+		SELECT DISTINCT organization_id FROM credit_purchases
+		WHERE remaining_cents > 0 AND expires_at <= $1
+		ORDER BY organization_id
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lapsed credits: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan organization ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ExpireCredits writes off the organization's unconsumed credits that expired
+// at or before the given time and returns the amount expired.
+func (r *CreditRepository) ExpireCredits(ctx context.Context, orgID uuid.UUID, at time.Time) (int64, error) {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	balance, err := lockCreditBalance(ctx, tx, orgID)
+	if err != nil {
+		return 0, err
+	}
+	newBalance, err := expireLapsedCredits(ctx, tx, orgID, balance, at)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit credit expiry: %w", err)
+	}
+	return balance - newBalance, nil
+}
+
+// ListTransactions lists the organization's credit transactions, newest first.
+func (r *CreditRepository) ListTransactions(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*CreditTransaction, error) {
+	query := `
+		SELECT id, organization_id, amount_cents, balance_after_cents, transaction_type,
+			reference_type, reference_id, description, created_at
+		FROM credit_transactions
+		WHERE organization_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, orgID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credit transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var txns []*CreditTransaction
+	for rows.Next() {
+		t := &CreditTransaction{}
+		if err := rows.Scan(
+			&t.ID, &t.OrganizationID, &t.AmountCents, &t.BalanceAfterCents, &t.TransactionType,
+			&t.ReferenceType, &t.ReferenceID, &t.Description, &t.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan credit transaction: %w", err)
+		}
+		txns = append(txns, t)
+	}
+	return txns, rows.Err()
+}
+
+// ListEntries lists the ledger postings of a credit transaction.
+func (r *CreditRepository) ListEntries(ctx context.Context, transactionID uuid.UUID) ([]*CreditLedgerEntry, error) {
+	query := `
+		SELECT id, transaction_id, organization_id, account, amount_cents, created_at
+		FROM credit_ledger_entries
+		WHERE transaction_id = $1
+		ORDER BY account
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credit ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*CreditLedgerEntry
+	for rows.Next() {
+		e := &CreditLedgerEntry{}
+		if err := rows.Scan(&e.ID, &e.TransactionID, &e.OrganizationID, &e.Account, &e.AmountCents, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan credit ledger entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// creditLot is the unconsumed part of a credit purchase.
+type creditLot struct {
+	PurchaseID     uuid.UUID
+	RemainingCents int64
+}
+
+// drawDown takes up to amount from lots in order and returns the lots with the
+// amount taken from each. Lots nothing was taken from are omitted.
+func drawDown(lots []creditLot, amount int64) []creditLot {
+	var taken []creditLot
+	for _, lot := range lots {
+		if amount <= 0 {
+			break
+		}
+		n := min(lot.RemainingCents, amount)
+		if n <= 0 {
+			continue
+		}
+		taken = append(taken, creditLot{PurchaseID: lot.PurchaseID, RemainingCents: n})
+		amount -= n
+	}
+	return taken
+}
+
+// lockCreditBalance returns the organization's balance snapshot, locking it
+// for the rest of the transaction so that ledger postings are serialized per
+// organization.
+func lockCreditBalance(ctx context.Context, tx *sql.Tx, orgID uuid.UUID) (int64, error) {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO credit_balance (organization_id, balance_cents, updated_at)
+		VALUES ($1, 0, NOW())
+		ON CONFLICT (organization_id) DO NOTHING
+	`, orgID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create credit balance: %w", err)
+	}
+
+	var balance int64
+	err = tx.QueryRowContext(ctx,
+		`SELECT balance_cents FROM credit_balance WHERE organization_id = $1 FOR UPDATE`, orgID,
+	).Scan(&balance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock credit balance: %w", err)
+	}
+	return balance, nil
+}
+
+// postCreditTransaction appends txn to the ledger with its two postings and
+// moves the balance snapshot on from balance. The balance must have been
+// locked with lockCreditBalance. It returns the new balance.
+func postCreditTransaction(ctx context.Context, tx *sql.Tx, balance int64, txn *CreditTransaction, counterAccount string) (int64, error) {
+	if txn.ID == uuid.Nil {
+		txn.ID = uuid.New()
+	}
+	if txn.CreatedAt.IsZero() {
+		txn.CreatedAt = time.Now()
+	}
+	txn.BalanceAfterCents = balance + txn.AmountCents
+	if txn.BalanceAfterCents < 0 {
+		return 0, ErrInsufficientCredits
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO credit_transactions (
+			id, organization_id, amount_cents, balance_after_cents, transaction_type,
+			reference_type, reference_id, description, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`,
+		txn.ID, txn.OrganizationID, txn.AmountCents, txn.BalanceAfterCents, txn.TransactionType,
+		txn.ReferenceType, txn.ReferenceID, txn.Description, txn.CreatedAt,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record credit transaction: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO credit_ledger_entries (transaction_id, organization_id, account, amount_cents, created_at)
+		VALUES ($1, $2, $3, $4, $6), ($1, $2, $5, -$4::BIGINT, $6)
+	`, txn.ID, txn.OrganizationID, CreditAccountCustomer, txn.AmountCents, counterAccount, txn.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record credit ledger entries: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE credit_balance
+		SET balance_cents = $2, last_transaction_id = $3, updated_at = NOW()
+		WHERE organization_id = $1
+	`, txn.OrganizationID, txn.BalanceAfterCents, txn.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update credit balance: %w", err)
+	}
+	return txn.BalanceAfterCents, nil
+}
+
+// expireLapsedCredits posts an expiry transaction for each of the
+// organization's purchases that expired at or before the given time with
+// credits left, and returns the new balance.
+func expireLapsedCredits(ctx context.Context, tx *sql.Tx, orgID uuid.UUID, balance int64, at time.Time) (int64, error) {
+	lots, err := lockCreditLots(ctx, tx, `
+		SELECT id, remaining_cents FROM credit_purchases
+		WHERE organization_id = $1 AND remaining_cents > 0 AND expires_at <= $2
+		ORDER BY expires_at, purchased_at
+		FOR UPDATE
+	`, orgID, at)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, lot := range lots {
+		if err := consumeCreditLot(ctx, tx, lot); err != nil {
+			return 0, err
+		}
+		balance, err = postCreditTransaction(ctx, tx, balance, &CreditTransaction{
+			OrganizationID:  orgID,
+			AmountCents:     -lot.RemainingCents,
+			TransactionType: CreditTransactionExpiry,
+			ReferenceType:   sql.NullString{String: "purchase", Valid: true},
+			ReferenceID:     sql.NullString{String: lot.PurchaseID.String(), Valid: true},
+			Description:     sql.NullString{String: "Credit expiry", Valid: true},
+			CreatedAt:       at,
+		}, CreditAccountExpired)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return balance, nil
+}
+
+// applyCreditsToInvoice draws up to amount from the organization's unexpired
+// credits, soonest-expiring first, and posts it against the invoice. It
+// returns the amount applied.
+func applyCreditsToInvoice(ctx context.Context, tx *sql.Tx, orgID, invoiceID uuid.UUID, balance, amount int64, at time.Time) (int64, error) {
+	lots, err := lockCreditLots(ctx, tx, `
+		SELECT id, remaining_cents FROM credit_purchases
+		WHERE organization_id = $1 AND remaining_cents > 0 AND expires_at > $2
+		ORDER BY expires_at, purchased_at
+		FOR UPDATE
+	`, orgID, at)
+	if err != nil {
+		return 0, err
+	}
+
+	var applied int64
+	for _, lot := range drawDown(lots, amount) {
+		if err := consumeCreditLot(ctx, tx, lot); err != nil {
+			return 0, err
+		}
+		applied += lot.RemainingCents
+	}
+	if applied == 0 {
+		return 0, nil
+	}
+
+	_, err = postCreditTransaction(ctx, tx, balance, &CreditTransaction{
+		OrganizationID:  orgID,
+		AmountCents:     -applied,
+		TransactionType: CreditTransactionUsage,
+		ReferenceType:   sql.NullString{String: "invoice", Valid: true},
+		ReferenceID:     sql.NullString{String: invoiceID.String(), Valid: true},
+		Description:     sql.NullString{String: "Credits applied to invoice", Valid: true},
+		CreatedAt:       at,
+	}, CreditAccountInvoices)
+	if err != nil {
+		return 0, err
+	}
+	return applied, nil
+}
+
+func lockCreditLots(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]creditLot, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credit purchases: %w", err)
+	}
+	defer rows.Close()
+
+	var lots []creditLot
+	for rows.Next() {
+		var lot creditLot
+		if err := rows.Scan(&lot.PurchaseID, &lot.RemainingCents); err != nil {
+			return nil, fmt.Errorf("failed to scan credit purchase: %w", err)
+		}
+		lots = append(lots, lot)
+	}
+	return lots, rows.Err()
+}
+
+// consumeCreditLot takes lot.RemainingCents from the purchase.
+func consumeCreditLot(ctx context.Context, tx *sql.Tx, lot creditLot) error {
+	_, err := tx.ExecContext(ctx,
+		`UPDATE credit_purchases SET remaining_cents = remaining_cents - $2 WHERE id = $1`,
+		lot.PurchaseID, lot.RemainingCents,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to consume credit purchase: %w", err)
+	}
+	return nil
+}
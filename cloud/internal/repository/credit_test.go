@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrawDown(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	lots := []creditLot{
+		{PurchaseID: a, RemainingCents: 1000},
+		{PurchaseID: b, RemainingCents: 0},
+		{PurchaseID: c, RemainingCents: 2500},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		amount int64
+		want   []creditLot
+	}{
+		{name: "nothing", amount: 0, want: nil},
+		{name: "part of first lot", amount: 400, want: []creditLot{{a, 400}}},
+		{name: "spans lots", amount: 1500, want: []creditLot{{a, 1000}, {c, 500}}},
+		{name: "more than available", amount: 9000, want: []creditLot{{a, 1000}, {c, 2500}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, drawDown(lots, tc.amount))
+		})
+	}
+}
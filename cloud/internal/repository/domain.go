@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrDomainVerifiedElsewhere is returned when verifying a domain another
+// organization has already verified.
+var ErrDomainVerifiedElsewhere = errors.New("domain is verified by another organization")
+
+// OrganizationDomain is an email domain claimed by an organization.
+type OrganizationDomain struct {
+	ID                uuid.UUID
+	OrganizationID    uuid.UUID
+	Domain            string
+	VerificationToken string
+	VerifiedAt        sql.NullTime
+	CreatedAt         time.Time
+}
+
+// DomainRepository handles organization domain data access.
+type DomainRepository struct {
+	db *PostgresDB
+}
+
+// NewDomainRepository creates a new domain repository.
+func NewDomainRepository(db *PostgresDB) *DomainRepository {
+	return &DomainRepository{db: db}
+}
+
+// Create claims a domain for an organization. Claiming a domain the
+// organization already claimed returns the existing claim.
+func (r *DomainRepository) Create(ctx context.Context, d *OrganizationDomain) error {
+	query := `
+		INSERT INTO organization_domains (id, organization_id, domain, verification_token)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (organization_id, domain) DO UPDATE SET domain = EXCLUDED.domain
+		RETURNING id, verification_token, verified_at, created_at
+	`
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	err := r.db.DB().QueryRowContext(ctx, query, d.ID, d.OrganizationID, d.Domain, d.VerificationToken).Scan(
+		&d.ID, &d.VerificationToken, &d.VerifiedAt, &d.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create organization domain: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves an organization's claim of a domain.
+func (r *DomainRepository) Get(ctx context.Context, orgID uuid.UUID, domain string) (*OrganizationDomain, error) {
+	query := `
+		SELECT id, organization_id, domain, verification_token, verified_at, created_at
+		FROM organization_domains
+		WHERE organization_id = $1 AND domain = $2
+	`
+	d := &OrganizationDomain{}
+	err := r.db.DB().QueryRowContext(ctx, query, orgID, domain).Scan(
+		&d.ID, &d.OrganizationID, &d.Domain, &d.VerificationToken, &d.VerifiedAt, &d.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization domain: %w", err)
+	}
+	return d, nil
+}
+
+// ListByOrganization lists the domains claimed by an organization.
+func (r *DomainRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*OrganizationDomain, error) {
+	query := `
+		SELECT id, organization_id, domain, verification_token, verified_at, created_at
+		FROM organization_domains
+		WHERE organization_id = $1
+		ORDER BY domain
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization domains: %w", err)
+	}
+	defer rows.Close()
+
+	var domains []*OrganizationDomain
+	for rows.Next() {
+		d := &OrganizationDomain{}
+		if err := rows.Scan(&d.ID, &d.OrganizationID, &d.Domain, &d.VerificationToken, &d.VerifiedAt, &d.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan organization domain: %w", err)
+		}
+		domains = append(domains, d)
+	}
+	return domains, rows.Err()
+}
+
+// MarkVerified records that an organization proved control of a domain. It
+// returns ErrDomainVerifiedElsewhere if another organization verified the
+// domain first.
+func (r *DomainRepository) MarkVerified(ctx context.Context, d *OrganizationDomain, at time.Time) error {
+	err := r.db.DB().QueryRowContext(ctx, `
+		UPDATE organization_domains d
+		SET verified_at = COALESCE(d.verified_at, $2)
+		WHERE d.id = $1 AND NOT EXISTS (
+			SELECT 1 FROM organization_domains o
+			WHERE o.domain = d.domain AND o.id <> d.id AND o.verified_at IS NOT NULL
+		)
+		RETURNING verified_at
+	`, d.ID, at).Scan(&d.VerifiedAt)
+	if err == sql.ErrNoRows {
+		return ErrDomainVerifiedElsewhere
+	}
+	if err != nil {
+		return fmt.Errorf("failed to verify organization domain: %w", err)
+	}
+	return nil
+}
+
+// IsVerified reports whether an organization has verified a domain.
+func (r *DomainRepository) IsVerified(ctx context.Context, orgID uuid.UUID, domain string) (bool, error) {
+	var verified bool
+	err := r.db.DB().QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM organization_domains
+			WHERE organization_id = $1 AND domain = $2 AND verified_at IS NOT NULL
+		)
+	`, orgID, domain).Scan(&verified)
+	if err != nil {
+		return false, fmt.Errorf("failed to check organization domain: %w", err)
+	}
+	return verified, nil
+}
+
+// Delete removes an organization's claim of a domain. It returns false if the
+// organization had not claimed it.
+func (r *DomainRepository) Delete(ctx context.Context, orgID uuid.UUID, domain string) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx,
+		`DELETE FROM organization_domains WHERE organization_id = $1 AND domain = $2`, orgID, domain,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete organization domain: %w", err)
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
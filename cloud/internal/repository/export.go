@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Export job statuses.
+const (
+	ExportJobStatusPending   = "pending"
+	ExportJobStatusRunning   = "running"
+	ExportJobStatusCompleted = "completed"
+	ExportJobStatusFailed    = "failed"
+)
+
+// ExportSink is a destination the histories of a namespace's closed workflows
+// are exported to.
+type ExportSink struct {
+	ID          uuid.UUID
+	NamespaceID string
+	Name        string
+	SinkType    string
+	Config      json.RawMessage
+	Enabled     bool
+	// LastExportAt is the end of the period covered by the sink's last
+	// completed export job.
+	LastExportAt sql.NullTime
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// ExportJob is one run of an export sink, covering the workflows that closed
+// between WindowStart and WindowEnd.
+type ExportJob struct {
+	ID                uuid.UUID
+	SinkID            uuid.UUID
+	Status            string
+	WorkflowsExported int64
+	BytesExported     int64
+	ErrorMessage      sql.NullString
+	WindowStart       time.Time
+	WindowEnd         time.Time
+	StartedAt         sql.NullTime
+	CompletedAt       sql.NullTime
+	CreatedAt         time.Time
+}
+
+// ExportRepository handles export sink and job data access.
+type ExportRepository struct {
+	db *PostgresDB
+}
+
+// NewExportRepository creates a new export repository.
+func NewExportRepository(db *PostgresDB) *ExportRepository {
+	return &ExportRepository{db: db}
+}
+
+const exportSinkColumns = `id, namespace_id, name, sink_type, config, enabled, last_export_at, created_at, updated_at`
+
+func scanExportSink(row interface{ Scan(...any) error }) (*ExportSink, error) {
+	sink := &ExportSink{}
+	err := row.Scan(
+		&sink.ID, &sink.NamespaceID, &sink.Name, &sink.SinkType, &sink.Config, &sink.Enabled,
+		&sink.LastExportAt, &sink.CreatedAt, &sink.UpdatedAt,
+	)
+	return sink, err
+}
+
+// CreateSink creates a new export sink.
+func (r *ExportRepository) CreateSink(ctx context.Context, sink *ExportSink) error {
+	query := `
+		INSERT INTO export_sinks (id, namespace_id, name, sink_type, config, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at
+	`
+	if sink.ID == uuid.Nil {
+		sink.ID = uuid.New()
+	}
+	err := r.db.DB().QueryRowContext(ctx, query,
+		sink.ID, sink.NamespaceID, sink.Name, sink.SinkType, sink.Config, sink.Enabled,
+	).Scan(&sink.CreatedAt, &sink.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create export sink: %w", err)
+	}
+	return nil
+}
+
+// GetSink retrieves an export sink of a namespace by ID.
+func (r *ExportRepository) GetSink(ctx context.Context, namespaceID string, id uuid.UUID) (*ExportSink, error) {
+	query := `SELECT ` + exportSinkColumns + ` FROM export_sinks WHERE namespace_id = $1 AND id = $2`
+	return r.getSink(ctx, query, namespaceID, id)
+}
+
+// GetSinkByID retrieves an export sink by ID.
+func (r *ExportRepository) GetSinkByID(ctx context.Context, id uuid.UUID) (*ExportSink, error) {
+	query := `SELECT ` + exportSinkColumns + ` FROM export_sinks WHERE id = $1`
+	return r.getSink(ctx, query, id)
+}
+
+// GetSinkByName retrieves an export sink of a namespace by name.
+func (r *ExportRepository) GetSinkByName(ctx context.Context, namespaceID, name string) (*ExportSink, error) {
+	query := `SELECT ` + exportSinkColumns + ` FROM export_sinks WHERE namespace_id = $1 AND name = $2`
+	return r.getSink(ctx, query, namespaceID, name)
+}
+
+func (r *ExportRepository) getSink(ctx context.Context, query string, args ...any) (*ExportSink, error) {
+	sink, err := scanExportSink(r.db.DB().QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export sink: %w", err)
+	}
+	return sink, nil
+}
+
+// ListSinks lists the export sinks of a namespace.
+func (r *ExportRepository) ListSinks(ctx context.Context, namespaceID string) ([]*ExportSink, error) {
+	query := `SELECT ` + exportSinkColumns + ` FROM export_sinks WHERE namespace_id = $1 ORDER BY name`
+	return r.listSinks(ctx, query, namespaceID)
+}
+
+// ListEnabledSinks lists the enabled export sinks of all namespaces.
+func (r *ExportRepository) ListEnabledSinks(ctx context.Context) ([]*ExportSink, error) {
+	query := `SELECT ` + exportSinkColumns + ` FROM export_sinks WHERE enabled ORDER BY created_at`
+	return r.listSinks(ctx, query)
+}
+
+func (r *ExportRepository) listSinks(ctx context.Context, query string, args ...any) ([]*ExportSink, error) {
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list export sinks: %w", err)
+	}
+	defer rows.Close()
+
+	var sinks []*ExportSink
+	for rows.Next() {
+		sink, err := scanExportSink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan export sink: %w", err)
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, rows.Err()
+}
+
+// UpdateSink updates an export sink's name, destination and enabled state.
+func (r *ExportRepository) UpdateSink(ctx context.Context, sink *ExportSink) error {
+	query := `
+		UPDATE export_sinks SET name = $3, sink_type = $4, config = $5, enabled = $6
+		WHERE namespace_id = $1 AND id = $2
+		RETURNING updated_at
+	`
+	err := r.db.DB().QueryRowContext(ctx, query,
+		sink.NamespaceID, sink.ID, sink.Name, sink.SinkType, sink.Config, sink.Enabled,
+	).Scan(&sink.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update export sink: %w", err)
+	}
+	return nil
+}
+
+// DeleteSink deletes an export sink and its jobs. It reports whether the sink
+// existed.
+func (r *ExportRepository) DeleteSink(ctx context.Context, namespaceID string, id uuid.UUID) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		DELETE FROM export_sinks WHERE namespace_id = $1 AND id = $2
+	`, namespaceID, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete export sink: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete export sink: %w", err)
+	}
+	return n > 0, nil
+}
+
+const exportJobColumns = `id, sink_id, status, workflows_exported, bytes_exported, error_message,
+	window_start, window_end, started_at, completed_at, created_at`
+
+func scanExportJob(row interface{ Scan(...any) error }) (*ExportJob, error) {
+	job := &ExportJob{}
+	err := row.Scan(
+		&job.ID, &job.SinkID, &job.Status, &job.WorkflowsExported, &job.BytesExported, &job.ErrorMessage,
+		&job.WindowStart, &job.WindowEnd, &job.StartedAt, &job.CompletedAt, &job.CreatedAt,
+	)
+	return job, err
+}
+
+// CreateJob creates a pending export job.
+func (r *ExportRepository) CreateJob(ctx context.Context, job *ExportJob) error {
+	query := `
+		INSERT INTO export_jobs (id, sink_id, status, window_start, window_end)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`
+	if job.ID == uuid.Nil {
+		job.ID = uuid.New()
+	}
+	job.Status = ExportJobStatusPending
+	err := r.db.DB().QueryRowContext(ctx, query,
+		job.ID, job.SinkID, job.Status, job.WindowStart, job.WindowEnd,
+	).Scan(&job.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create export job: %w", err)
+	}
+	return nil
+}
+
+// GetJob retrieves an export job by ID.
+func (r *ExportRepository) GetJob(ctx context.Context, id uuid.UUID) (*ExportJob, error) {
+	query := `SELECT ` + exportJobColumns + ` FROM export_jobs WHERE id = $1`
+	job, err := scanExportJob(r.db.DB().QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export job: %w", err)
+	}
+	return job, nil
+}
+
+// ListJobs lists the export jobs of a sink, most recent first.
+func (r *ExportRepository) ListJobs(ctx context.Context, sinkID uuid.UUID, limit, offset int) ([]*ExportJob, error) {
+	query := `
+		SELECT ` + exportJobColumns + `
+		FROM export_jobs WHERE sink_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, sinkID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list export jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*ExportJob
+	for rows.Next() {
+		job, err := scanExportJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan export job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+// StartJob marks an export job as running. Starting a running job again, as
+// a retried activity does, keeps its original start time.
+func (r *ExportRepository) StartJob(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.DB().ExecContext(ctx, `
+		UPDATE export_jobs SET status = $2, started_at = COALESCE(started_at, NOW())
+		WHERE id = $1
+	`, id, ExportJobStatusRunning)
+	if err != nil {
+		return fmt.Errorf("failed to start export job: %w", err)
+	}
+	return nil
+}
+
+// UpdateProgress records how many workflows and bytes an export job has
+// exported so far.
+func (r *ExportRepository) UpdateProgress(ctx context.Context, id uuid.UUID, workflows, bytes int64) error {
+	_, err := r.db.DB().ExecContext(ctx, `
+		UPDATE export_jobs SET workflows_exported = $2, bytes_exported = $3
+		WHERE id = $1
+	`, id, workflows, bytes)
+	if err != nil {
+		return fmt.Errorf("failed to update export job progress: %w", err)
+	}
+	return nil
+}
+
+// CompleteJob marks an export job as completed and advances its sink's last
+// export time to the end of the job's window.
+func (r *ExportRepository) CompleteJob(ctx context.Context, id uuid.UUID, workflows, bytes int64) error {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var sinkID uuid.UUID
+	var windowEnd time.Time
+	err = tx.QueryRowContext(ctx, `
+		UPDATE export_jobs SET status = $2, workflows_exported = $3, bytes_exported = $4,
+			error_message = NULL, completed_at = NOW()
+		WHERE id = $1
+		RETURNING sink_id, window_end
+	`, id, ExportJobStatusCompleted, workflows, bytes).Scan(&sinkID, &windowEnd)
+	if err != nil {
+		return fmt.Errorf("failed to complete export job: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE export_sinks SET last_export_at = $2
+		WHERE id = $1 AND (last_export_at IS NULL OR last_export_at < $2)
+	`, sinkID, windowEnd)
+	if err != nil {
+		return fmt.Errorf("failed to update export sink: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// FailJob marks an export job as failed. The sink's last export time is left
+// alone, so the next job covers the failed job's window again.
+func (r *ExportRepository) FailJob(ctx context.Context, id uuid.UUID, message string) error {
+	_, err := r.db.DB().ExecContext(ctx, `
+		UPDATE export_jobs SET status = $2, error_message = $3, completed_at = NOW()
+		WHERE id = $1
+	`, id, ExportJobStatusFailed, message)
+	if err != nil {
+		return fmt.Errorf("failed to fail export job: %w", err)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // Invoice represents an invoice in the database.
@@ -54,6 +55,62 @@ func NewInvoiceRepository(db *PostgresDB) *InvoiceRepository {
 
 // Create creates a new invoice.
 func (r *InvoiceRepository) Create(ctx context.Context, inv *Invoice) error {
+	return insertInvoice(ctx, r.db.DB(), inv)
+}
+
+// CreateWithCredits creates a new invoice and applies the organization's
+// prepaid credits to it in one transaction, so that an invoice never exists
+// without its credit ledger postings or vice versa. Lapsed credits are
+// expired first. applyCredits is called with the unexpired balance before the
+// invoice is inserted; it returns the amount to apply and may adjust the
+// invoice (line items, totals) to account for it.
+func (r *InvoiceRepository) CreateWithCredits(ctx context.Context, inv *Invoice, applyCredits func(inv *Invoice, availableCents int64) int64) error {
+	if inv.ID == uuid.Nil {
+		inv.ID = uuid.New()
+	}
+	now := time.Now()
+
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	balance, err := lockCreditBalance(ctx, tx, inv.OrganizationID)
+	if err != nil {
+		return err
+	}
+	balance, err = expireLapsedCredits(ctx, tx, inv.OrganizationID, balance, now)
+	if err != nil {
+		return err
+	}
+
+	amount := applyCredits(inv, balance)
+	if amount < 0 || amount > balance {
+		return fmt.Errorf("failed to apply credits: %d cents requested, %d available", amount, balance)
+	}
+	inv.CreditsAppliedCents = amount
+	if err := insertInvoice(ctx, tx, inv); err != nil {
+		return err
+	}
+	applied, err := applyCreditsToInvoice(ctx, tx, inv.OrganizationID, inv.ID, balance, amount, now)
+	if err != nil {
+		return err
+	}
+	if applied != amount {
+		// The balance snapshot and the purchases it is made of disagree.
+		return fmt.Errorf("failed to apply credits: %d of %d cents applied", applied, amount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit invoice: %w", err)
+	}
+	return nil
+}
+
+func insertInvoice(ctx context.Context, db interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}, inv *Invoice) error {
 	query := `
 		INSERT INTO invoices (
 			id, organization_id, invoice_number, period_start, period_end,
@@ -69,7 +126,7 @@ func (r *InvoiceRepository) Create(ctx context.Context, inv *Invoice) error {
 	}
 	inv.CreatedAt = time.Now()
 
-	_, err := r.db.DB().ExecContext(ctx, query,
+	_, err := db.ExecContext(ctx, query,
 		inv.ID, inv.OrganizationID, inv.InvoiceNumber, inv.PeriodStart, inv.PeriodEnd,
 		inv.LineItems, inv.SubtotalCents, inv.TaxCents, inv.CreditsAppliedCents, inv.TotalCents,
 		inv.Status, inv.StripeInvoiceID, inv.PDFURL, inv.CreatedAt, inv.PaidAt, inv.DueAt,
@@ -162,6 +219,63 @@ func (r *InvoiceRepository) UpdateStatus(ctx context.Context, id uuid.UUID, stat
 	return nil
 }
 
+// TransitionStatus sets the invoice status if it is currently one of from,
+// so that out-of-order updates cannot move an invoice backwards. It reports
+// whether the invoice was updated.
+func (r *InvoiceRepository) TransitionStatus(ctx context.Context, id uuid.UUID, from []string, to string, paidAt *time.Time) (bool, error) {
+	query := `UPDATE invoices SET status = $2, paid_at = COALESCE($3, paid_at) WHERE id = $1 AND status::text = ANY($4)`
+	var paidAtVal sql.NullTime
+	if paidAt != nil {
+		paidAtVal = sql.NullTime{Time: *paidAt, Valid: true}
+	}
+	result, err := r.db.DB().ExecContext(ctx, query, id, to, paidAtVal, pq.Array(from))
+	if err != nil {
+		return false, fmt.Errorf("failed to update invoice status: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update invoice status: %w", err)
+	}
+	return n > 0, nil
+}
+
+// SetStripeInvoiceID links the invoice to its Stripe invoice.
+func (r *InvoiceRepository) SetStripeInvoiceID(ctx context.Context, id uuid.UUID, stripeID string) error {
+	query := `UPDATE invoices SET stripe_invoice_id = $2 WHERE id = $1`
+	_, err := r.db.DB().ExecContext(ctx, query, id, stripeID)
+	if err != nil {
+		return fmt.Errorf("failed to set Stripe invoice ID: %w", err)
+	}
+	return nil
+}
+
+// GetByPeriod retrieves the organization's invoice for the period starting at
+// periodStart.
+func (r *InvoiceRepository) GetByPeriod(ctx context.Context, orgID uuid.UUID, periodStart time.Time) (*Invoice, error) {
+	query := `
+		SELECT id, organization_id, invoice_number, period_start, period_end,
+			line_items, subtotal_cents, tax_cents, credits_applied_cents, total_cents,
+			status, stripe_invoice_id, pdf_url, created_at, paid_at, due_at
+		FROM invoices
+		WHERE organization_id = $1 AND period_start = $2 AND status <> 'void'
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	inv := &Invoice{}
+	err := r.db.DB().QueryRowContext(ctx, query, orgID, periodStart).Scan(
+		&inv.ID, &inv.OrganizationID, &inv.InvoiceNumber, &inv.PeriodStart, &inv.PeriodEnd,
+		&inv.LineItems, &inv.SubtotalCents, &inv.TaxCents, &inv.CreditsAppliedCents, &inv.TotalCents,
+		&inv.Status, &inv.StripeInvoiceID, &inv.PDFURL, &inv.CreatedAt, &inv.PaidAt, &inv.DueAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoice by period: %w", err)
+	}
+	return inv, nil
+}
+
 // ListByOrganization lists invoices for an organization.
 func (r *InvoiceRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*Invoice, error) {
 	query := `
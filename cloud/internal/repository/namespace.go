@@ -198,6 +198,77 @@ func (r *NamespaceRepository) UpdateState(ctx context.Context, id string, state
 	return nil
 }
 
+// UpdatePlacement records the cluster hosting a namespace and its endpoints.
+func (r *NamespaceRepository) UpdatePlacement(ctx context.Context, id, clusterID, grpcEndpoint, webEndpoint, metricsEndpoint string) error {
+	query := `
+		UPDATE cloud_namespaces
+		SET cluster_id = $2, grpc_endpoint = $3, web_endpoint = $4, metrics_endpoint = $5, updated_at = $6
+		WHERE id = $1
+	`
+	_, err := r.db.DB().ExecContext(ctx, query, id,
+		sql.NullString{String: clusterID, Valid: clusterID != ""},
+		sql.NullString{String: grpcEndpoint, Valid: grpcEndpoint != ""},
+		sql.NullString{String: webEndpoint, Valid: webEndpoint != ""},
+		sql.NullString{String: metricsEndpoint, Valid: metricsEndpoint != ""},
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update namespace placement: %w", err)
+	}
+	return nil
+}
+
+// CountByCluster returns the number of live namespaces placed on each cluster.
+func (r *NamespaceRepository) CountByCluster(ctx context.Context) (map[string]int, error) {
+	query := `
+		SELECT cluster_id, COUNT(*)
+		FROM cloud_namespaces
+		WHERE cluster_id IS NOT NULL AND state <> 'deleted'
+		GROUP BY cluster_id
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count namespaces by cluster: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var clusterID string
+		var count int
+		if err := rows.Scan(&clusterID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan namespace count: %w", err)
+		}
+		counts[clusterID] = count
+	}
+	return counts, rows.Err()
+}
+
+// ListPlacedIDs returns the IDs of the live namespaces placed on a cluster.
+func (r *NamespaceRepository) ListPlacedIDs(ctx context.Context) ([]string, error) {
+	query := `
+		SELECT id
+		FROM cloud_namespaces
+		WHERE cluster_id IS NOT NULL AND state <> 'deleted'
+		ORDER BY id
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list placed namespaces: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan namespace id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
 // Delete deletes a namespace.
 func (r *NamespaceRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM cloud_namespaces WHERE id = $1`
@@ -244,6 +315,51 @@ func (r *NamespaceRepository) ListByOrganization(ctx context.Context, orgID uuid
 	return namespaces, nil
 }
 
+// NamespaceFilter narrows a namespace listing. Empty fields match everything.
+type NamespaceFilter struct {
+	OrganizationID uuid.UUID
+	Region         string
+	State          string
+}
+
+// List lists namespaces for an organization matching the filter.
+func (r *NamespaceRepository) List(ctx context.Context, filter NamespaceFilter, limit, offset int) ([]*Namespace, error) {
+	query := `
+		SELECT id, organization_id, name, region, cluster_id, state,
+			retention_days, deletion_protected, ha_enabled, standby_region,
+			codec_endpoint, codec_pass_token, codec_include_credentials,
+			grpc_endpoint, web_endpoint, metrics_endpoint, tags,
+			created_at, updated_at
+		FROM cloud_namespaces
+		WHERE organization_id = $1
+			AND ($2::text = '' OR region = $2::text)
+			AND ($3::text = '' OR state::text = $3::text)
+		ORDER BY created_at DESC
+		LIMIT $4 OFFSET $5
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, filter.OrganizationID, filter.Region, filter.State, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	defer rows.Close()
+
+	var namespaces []*Namespace
+	for rows.Next() {
+		ns := &Namespace{}
+		if err := rows.Scan(
+			&ns.ID, &ns.OrganizationID, &ns.Name, &ns.Region, &ns.ClusterID, &ns.State,
+			&ns.RetentionDays, &ns.DeletionProtected, &ns.HAEnabled, &ns.StandbyRegion,
+			&ns.CodecEndpoint, &ns.CodecPassToken, &ns.CodecIncludeCredentials,
+			&ns.GRPCEndpoint, &ns.WebEndpoint, &ns.MetricsEndpoint, &ns.Tags,
+			&ns.CreatedAt, &ns.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan namespace: %w", err)
+		}
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces, nil
+}
+
 // AddCertificate adds a certificate to a namespace.
 func (r *NamespaceRepository) AddCertificate(ctx context.Context, cert *NamespaceCertificate) error {
 	query := `
@@ -293,6 +409,71 @@ func (r *NamespaceRepository) ListCertificates(ctx context.Context, namespaceID
 	return certs, nil
 }
 
+// AddCertificateFilter adds a certificate filter to a namespace.
+func (r *NamespaceRepository) AddCertificateFilter(ctx context.Context, filter *NamespaceCertificateFilter) error {
+	query := `
+		INSERT INTO namespace_certificate_filters (
+			id, namespace_id, common_name, organization, organizational_unit, subject_alternative_name, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	if filter.ID == uuid.Nil {
+		filter.ID = uuid.New()
+	}
+	filter.CreatedAt = time.Now()
+
+	_, err := r.db.DB().ExecContext(ctx, query,
+		filter.ID, filter.NamespaceID, filter.CommonName, filter.Organization,
+		filter.OrganizationalUnit, filter.SubjectAlternativeName, filter.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add certificate filter: %w", err)
+	}
+	return nil
+}
+
+// RemoveCertificateFilter removes a certificate filter from a namespace.
+// It reports whether a filter was removed.
+func (r *NamespaceRepository) RemoveCertificateFilter(ctx context.Context, namespaceID string, id uuid.UUID) (bool, error) {
+	query := `DELETE FROM namespace_certificate_filters WHERE namespace_id = $1 AND id = $2`
+	result, err := r.db.DB().ExecContext(ctx, query, namespaceID, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove certificate filter: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to remove certificate filter: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// ListCertificateFilters lists certificate filters for a namespace.
+func (r *NamespaceRepository) ListCertificateFilters(ctx context.Context, namespaceID string) ([]*NamespaceCertificateFilter, error) {
+	query := `
+		SELECT id, namespace_id, common_name, organization, organizational_unit, subject_alternative_name, created_at
+		FROM namespace_certificate_filters
+		WHERE namespace_id = $1
+		ORDER BY created_at ASC
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, namespaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list certificate filters: %w", err)
+	}
+	defer rows.Close()
+
+	var filters []*NamespaceCertificateFilter
+	for rows.Next() {
+		filter := &NamespaceCertificateFilter{}
+		if err := rows.Scan(
+			&filter.ID, &filter.NamespaceID, &filter.CommonName, &filter.Organization,
+			&filter.OrganizationalUnit, &filter.SubjectAlternativeName, &filter.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan certificate filter: %w", err)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
 // AddSearchAttribute adds a search attribute to a namespace.
 func (r *NamespaceRepository) AddSearchAttribute(ctx context.Context, attr *NamespaceSearchAttribute) error {
 	query := `
@@ -308,16 +489,6 @@ func (r *NamespaceRepository) AddSearchAttribute(ctx context.Context, attr *Name
 	return nil
 }
 
-// RemoveSearchAttribute removes a search attribute from a namespace.
-func (r *NamespaceRepository) RemoveSearchAttribute(ctx context.Context, namespaceID, name string) error {
-	query := `DELETE FROM namespace_search_attributes WHERE namespace_id = $1 AND name = $2`
-	_, err := r.db.DB().ExecContext(ctx, query, namespaceID, name)
-	if err != nil {
-		return fmt.Errorf("failed to remove search attribute: %w", err)
-	}
-	return nil
-}
-
 // ListSearchAttributes lists search attributes for a namespace.
 func (r *NamespaceRepository) ListSearchAttributes(ctx context.Context, namespaceID string) ([]*NamespaceSearchAttribute, error) {
 	query := `
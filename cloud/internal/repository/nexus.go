@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// NexusEndpoint is a Nexus endpoint routing requests to a task queue of a
+// target namespace, callable from the workflows of its allowed caller
+// namespaces. It is provisioned on the cluster hosting the target namespace.
+type NexusEndpoint struct {
+	ID                uuid.UUID
+	OrganizationID    uuid.UUID
+	Name              string
+	Description       sql.NullString
+	TargetNamespaceID string
+	TargetTaskQueue   string
+	// AllowedCallerNamespaceIDs is sorted.
+	AllowedCallerNamespaceIDs []string
+	// SpecVersion is bumped by every change to the endpoint, and
+	// SyncedVersion is the version last provisioned on the cluster.
+	SpecVersion   int64
+	SyncedVersion int64
+	// ClusterID and ClusterEndpointID identify the endpoint on the cluster
+	// it was last provisioned on, and SyncedTargetNamespaceID is the target
+	// namespace it was provisioned for.
+	ClusterID               sql.NullString
+	ClusterEndpointID       sql.NullString
+	SyncedTargetNamespaceID sql.NullString
+	SyncError               sql.NullString
+	SyncedAt                sql.NullTime
+	// DeletedAt is set once the endpoint is deleted, until it is removed from
+	// its cluster.
+	DeletedAt sql.NullTime
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NexusRepository handles Nexus endpoint data access.
+type NexusRepository struct {
+	db *PostgresDB
+}
+
+// NewNexusRepository creates a new Nexus repository.
+func NewNexusRepository(db *PostgresDB) *NexusRepository {
+	return &NexusRepository{db: db}
+}
+
+const nexusEndpointColumns = `
+	e.id, e.organization_id, e.name, e.description, e.target_namespace_id, e.target_task_queue,
+	ARRAY(SELECT a.caller_namespace_id FROM nexus_endpoint_allowlist a WHERE a.endpoint_id = e.id ORDER BY 1),
+	e.spec_version, e.synced_version, e.cluster_id, e.cluster_endpoint_id,
+	e.synced_target_namespace_id, e.sync_error, e.synced_at, e.deleted_at, e.created_at, e.updated_at`
+
+func scanNexusEndpoint(row interface{ Scan(...any) error }) (*NexusEndpoint, error) {
+	endpoint := &NexusEndpoint{}
+	err := row.Scan(
+		&endpoint.ID, &endpoint.OrganizationID, &endpoint.Name, &endpoint.Description,
+		&endpoint.TargetNamespaceID, &endpoint.TargetTaskQueue, pq.Array(&endpoint.AllowedCallerNamespaceIDs),
+		&endpoint.SpecVersion, &endpoint.SyncedVersion, &endpoint.ClusterID, &endpoint.ClusterEndpointID,
+		&endpoint.SyncedTargetNamespaceID, &endpoint.SyncError, &endpoint.SyncedAt, &endpoint.DeletedAt,
+		&endpoint.CreatedAt, &endpoint.UpdatedAt,
+	)
+	return endpoint, err
+}
+
+// CreateEndpoint creates a Nexus endpoint and its allowlist.
+func (r *NexusRepository) CreateEndpoint(ctx context.Context, endpoint *NexusEndpoint) error {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if endpoint.ID == uuid.Nil {
+		endpoint.ID = uuid.New()
+	}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO nexus_endpoints (id, organization_id, name, description, target_namespace_id, target_task_queue)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING spec_version, synced_version, created_at, updated_at
+	`, endpoint.ID, endpoint.OrganizationID, endpoint.Name, endpoint.Description,
+		endpoint.TargetNamespaceID, endpoint.TargetTaskQueue,
+	).Scan(&endpoint.SpecVersion, &endpoint.SyncedVersion, &endpoint.CreatedAt, &endpoint.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create Nexus endpoint: %w", err)
+	}
+	if err := setNexusAllowlist(ctx, tx, endpoint.ID, endpoint.AllowedCallerNamespaceIDs); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetEndpoint retrieves a Nexus endpoint of an organization by ID, including
+// a deleted endpoint not yet removed from its cluster.
+func (r *NexusRepository) GetEndpoint(ctx context.Context, orgID, id uuid.UUID) (*NexusEndpoint, error) {
+	query := `SELECT ` + nexusEndpointColumns + ` FROM nexus_endpoints e WHERE e.organization_id = $1 AND e.id = $2`
+	return r.getEndpoint(ctx, query, orgID, id)
+}
+
+// GetEndpointByID retrieves a Nexus endpoint by ID.
+func (r *NexusRepository) GetEndpointByID(ctx context.Context, id uuid.UUID) (*NexusEndpoint, error) {
+	query := `SELECT ` + nexusEndpointColumns + ` FROM nexus_endpoints e WHERE e.id = $1`
+	return r.getEndpoint(ctx, query, id)
+}
+
+// GetEndpointByName retrieves a Nexus endpoint by name. Names are unique
+// across organizations.
+func (r *NexusRepository) GetEndpointByName(ctx context.Context, name string) (*NexusEndpoint, error) {
+	query := `SELECT ` + nexusEndpointColumns + ` FROM nexus_endpoints e WHERE e.name = $1`
+	return r.getEndpoint(ctx, query, name)
+}
+
+func (r *NexusRepository) getEndpoint(ctx context.Context, query string, args ...any) (*NexusEndpoint, error) {
+	endpoint, err := scanNexusEndpoint(r.db.DB().QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Nexus endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+// ListEndpoints lists the Nexus endpoints of an organization.
+func (r *NexusRepository) ListEndpoints(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*NexusEndpoint, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT `+nexusEndpointColumns+`
+		FROM nexus_endpoints e
+		WHERE e.organization_id = $1
+		ORDER BY e.name
+		LIMIT $2 OFFSET $3
+	`, orgID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Nexus endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var endpoints []*NexusEndpoint
+	for rows.Next() {
+		endpoint, err := scanNexusEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan Nexus endpoint: %w", err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, rows.Err()
+}
+
+// ListUnsyncedEndpoints lists the IDs of the Nexus endpoints changed or
+// deleted since they were last provisioned.
+func (r *NexusRepository) ListUnsyncedEndpoints(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT id FROM nexus_endpoints
+		WHERE synced_version < spec_version OR deleted_at IS NOT NULL
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unsynced Nexus endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan Nexus endpoint: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// UpdateEndpoint replaces a Nexus endpoint's description, target and
+// allowlist, bumps its spec version and clears its sync error. It reports
+// whether the endpoint exists and is not deleted.
+func (r *NexusRepository) UpdateEndpoint(ctx context.Context, endpoint *NexusEndpoint) (bool, error) {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE nexus_endpoints
+		SET description = $3, target_namespace_id = $4, target_task_queue = $5, spec_version = spec_version + 1,
+			sync_error = NULL
+		WHERE organization_id = $1 AND id = $2 AND deleted_at IS NULL
+		RETURNING spec_version, updated_at
+	`, endpoint.OrganizationID, endpoint.ID, endpoint.Description, endpoint.TargetNamespaceID, endpoint.TargetTaskQueue,
+	).Scan(&endpoint.SpecVersion, &endpoint.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to update Nexus endpoint: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM nexus_endpoint_allowlist WHERE endpoint_id = $1`, endpoint.ID); err != nil {
+		return false, fmt.Errorf("failed to update Nexus endpoint allowlist: %w", err)
+	}
+	if err := setNexusAllowlist(ctx, tx, endpoint.ID, endpoint.AllowedCallerNamespaceIDs); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+func setNexusAllowlist(ctx context.Context, tx *sql.Tx, endpointID uuid.UUID, callerNamespaceIDs []string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO nexus_endpoint_allowlist (endpoint_id, caller_namespace_id)
+		SELECT $1, unnest($2::varchar[])
+		ON CONFLICT DO NOTHING
+	`, endpointID, pq.Array(callerNamespaceIDs))
+	if err != nil {
+		return fmt.Errorf("failed to update Nexus endpoint allowlist: %w", err)
+	}
+	return nil
+}
+
+// MarkEndpointDeleted marks a Nexus endpoint deleted, to be removed from its
+// cluster. It reports whether the endpoint exists and was not already
+// deleted.
+func (r *NexusRepository) MarkEndpointDeleted(ctx context.Context, orgID, id uuid.UUID) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		UPDATE nexus_endpoints SET deleted_at = NOW(), spec_version = spec_version + 1
+		WHERE organization_id = $1 AND id = $2 AND deleted_at IS NULL
+	`, orgID, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete Nexus endpoint: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete Nexus endpoint: %w", err)
+	}
+	return n > 0, nil
+}
+
+// NexusEndpointSync is a version of a Nexus endpoint provisioned on a
+// cluster.
+type NexusEndpointSync struct {
+	Version           int64
+	ClusterID         string
+	ClusterEndpointID string
+	TargetNamespaceID string
+}
+
+// MarkEndpointSynced records that a version of a Nexus endpoint was
+// provisioned. The endpoint stays unsynced if it changed since.
+func (r *NexusRepository) MarkEndpointSynced(ctx context.Context, id uuid.UUID, synced NexusEndpointSync) error {
+	_, err := r.db.DB().ExecContext(ctx, `
+		UPDATE nexus_endpoints
+		SET synced_version = $2, cluster_id = $3, cluster_endpoint_id = $4, synced_target_namespace_id = $5,
+			sync_error = NULL, synced_at = NOW()
+		WHERE id = $1
+	`, id, synced.Version, synced.ClusterID, synced.ClusterEndpointID, synced.TargetNamespaceID)
+	if err != nil {
+		return fmt.Errorf("failed to mark Nexus endpoint synced: %w", err)
+	}
+	return nil
+}
+
+// SetEndpointSyncError records why a Nexus endpoint could not be
+// provisioned.
+func (r *NexusRepository) SetEndpointSyncError(ctx context.Context, id uuid.UUID, message string) error {
+	_, err := r.db.DB().ExecContext(ctx, `UPDATE nexus_endpoints SET sync_error = $2 WHERE id = $1`, id, message)
+	if err != nil {
+		return fmt.Errorf("failed to record Nexus endpoint sync error: %w", err)
+	}
+	return nil
+}
+
+// DeleteEndpoint removes a deleted Nexus endpoint once it was removed from
+// its cluster.
+func (r *NexusRepository) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.DB().ExecContext(ctx, `DELETE FROM nexus_endpoints WHERE id = $1 AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete Nexus endpoint: %w", err)
+	}
+	return nil
+}
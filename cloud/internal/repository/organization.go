@@ -12,12 +12,17 @@ import (
 
 // Organization represents an organization in the database.
 type Organization struct {
-	ID        uuid.UUID
-	Name      string
-	Slug      string
-	Settings  json.RawMessage
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       uuid.UUID
+	Name     string
+	Slug     string
+	Settings json.RawMessage
+	// DeleteAfter is when the organization's scheduled deletion begins,
+	// unless it is cancelled first.
+	DeleteAfter sql.NullTime
+	// DeletionStartedAt is when teardown of the organization began.
+	DeletionStartedAt sql.NullTime
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 // OrganizationMember represents an organization member.
@@ -26,7 +31,9 @@ type OrganizationMember struct {
 	OrganizationID uuid.UUID
 	UserID         uuid.UUID
 	Role           string
-	CreatedAt      time.Time
+	// Active is false for members deactivated by SCIM provisioning.
+	Active    bool
+	CreatedAt time.Time
 }
 
 // OrganizationRepository handles organization data access.
@@ -64,17 +71,22 @@ func (r *OrganizationRepository) Create(ctx context.Context, org *Organization)
 	return nil
 }
 
-// GetByID retrieves an organization by ID.
-func (r *OrganizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*Organization, error) {
-	query := `
-		SELECT id, name, slug, settings, created_at, updated_at
-		FROM organizations
-		WHERE id = $1
-	`
+const organizationColumns = `id, name, slug, settings, delete_after, deletion_started_at, created_at, updated_at`
+
+func scanOrganization(row interface{ Scan(...any) error }) (*Organization, error) {
 	org := &Organization{}
-	err := r.db.DB().QueryRowContext(ctx, query, id).Scan(
-		&org.ID, &org.Name, &org.Slug, &org.Settings, &org.CreatedAt, &org.UpdatedAt,
+	err := row.Scan(
+		&org.ID, &org.Name, &org.Slug, &org.Settings, &org.DeleteAfter, &org.DeletionStartedAt,
+		&org.CreatedAt, &org.UpdatedAt,
 	)
+	return org, err
+}
+
+// GetByID retrieves an organization by ID. Deleted organizations are not
+// found.
+func (r *OrganizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	query := `SELECT ` + organizationColumns + ` FROM organizations WHERE id = $1 AND deleted_at IS NULL`
+	org, err := scanOrganization(r.db.DB().QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -86,15 +98,8 @@ func (r *OrganizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*Or
 
 // GetBySlug retrieves an organization by slug.
 func (r *OrganizationRepository) GetBySlug(ctx context.Context, slug string) (*Organization, error) {
-	query := `
-		SELECT id, name, slug, settings, created_at, updated_at
-		FROM organizations
-		WHERE slug = $1
-	`
-	org := &Organization{}
-	err := r.db.DB().QueryRowContext(ctx, query, slug).Scan(
-		&org.ID, &org.Name, &org.Slug, &org.Settings, &org.CreatedAt, &org.UpdatedAt,
-	)
+	query := `SELECT ` + organizationColumns + ` FROM organizations WHERE slug = $1 AND deleted_at IS NULL`
+	org, err := scanOrganization(r.db.DB().QueryRowContext(ctx, query, slug))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -121,21 +126,96 @@ func (r *OrganizationRepository) Update(ctx context.Context, org *Organization)
 	return nil
 }
 
-// Delete deletes an organization.
-func (r *OrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM organizations WHERE id = $1`
-	_, err := r.db.DB().ExecContext(ctx, query, id)
+// ScheduleDeletion schedules an organization's deletion for deleteAfter. It
+// returns false if the organization's deletion is already scheduled.
+func (r *OrganizationRepository) ScheduleDeletion(ctx context.Context, id uuid.UUID, requestedAt, deleteAfter time.Time) (bool, error) {
+	query := `
+		UPDATE organizations
+		SET deletion_requested_at = $2, delete_after = $3, updated_at = $2
+		WHERE id = $1 AND deletion_requested_at IS NULL AND deleted_at IS NULL
+	`
+	result, err := r.db.DB().ExecContext(ctx, query, id, requestedAt, deleteAfter)
+	if err != nil {
+		return false, fmt.Errorf("failed to schedule organization deletion: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to schedule organization deletion: %w", err)
+	}
+	return n > 0, nil
+}
+
+// CancelDeletion cancels an organization's scheduled deletion. It returns
+// false if no deletion is scheduled or its grace period is over.
+func (r *OrganizationRepository) CancelDeletion(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	query := `
+		UPDATE organizations
+		SET deletion_requested_at = NULL, delete_after = NULL, updated_at = $2
+		WHERE id = $1 AND delete_after > $2 AND deletion_started_at IS NULL
+	`
+	result, err := r.db.DB().ExecContext(ctx, query, id, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel organization deletion: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel organization deletion: %w", err)
+	}
+	return n > 0, nil
+}
+
+// BeginDeletion records that teardown of an organization began, after which
+// its deletion can no longer be cancelled. It returns false if the
+// organization's deletion was cancelled or it is already deleted.
+func (r *OrganizationRepository) BeginDeletion(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	query := `
+		UPDATE organizations
+		SET deletion_started_at = COALESCE(deletion_started_at, $2), updated_at = $2
+		WHERE id = $1 AND delete_after IS NOT NULL AND deleted_at IS NULL
+	`
+	result, err := r.db.DB().ExecContext(ctx, query, id, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin organization deletion: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin organization deletion: %w", err)
+	}
+	return n > 0, nil
+}
+
+// MarkDeleted marks an organization deleted, removes its members and
+// disables its audit streams. The row is kept for its invoices.
+func (r *OrganizationRepository) MarkDeleted(ctx context.Context, id uuid.UUID, now time.Time) error {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete organization: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM organization_members WHERE organization_id = $1`, id); err != nil {
+		return fmt.Errorf("failed to remove organization members: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE audit_streams SET enabled = false WHERE organization_id = $1`, id); err != nil {
+		return fmt.Errorf("failed to disable audit streams: %w", err)
+	}
+	query := `UPDATE organizations SET deleted_at = COALESCE(deleted_at, $2), updated_at = $2 WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, query, id, now); err != nil {
+		return fmt.Errorf("failed to mark organization deleted: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 	return nil
 }
 
-// List lists organizations with pagination.
+// List lists organizations with pagination. Deleted organizations are not
+// listed.
 func (r *OrganizationRepository) List(ctx context.Context, limit, offset int) ([]*Organization, error) {
 	query := `
-		SELECT id, name, slug, settings, created_at, updated_at
+		SELECT ` + organizationColumns + `
 		FROM organizations
+		WHERE deleted_at IS NULL
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
 	`
@@ -144,28 +224,17 @@ func (r *OrganizationRepository) List(ctx context.Context, limit, offset int) ([
 		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 	defer rows.Close()
-
-	var orgs []*Organization
-	for rows.Next() {
-		org := &Organization{}
-		if err := rows.Scan(
-			&org.ID, &org.Name, &org.Slug, &org.Settings, &org.CreatedAt, &org.UpdatedAt,
-		); err != nil {
-			return nil, fmt.Errorf("failed to scan organization: %w", err)
-		}
-		orgs = append(orgs, org)
-	}
-	return orgs, nil
+	return scanOrganizations(rows)
 }
 
 // ListByUserID lists organizations for a user.
 func (r *OrganizationRepository) ListByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Organization, error) {
 	query := `
-		SELECT o.id, o.name, o.slug, o.settings, o.created_at, o.updated_at
-		FROM organizations o
-		JOIN organization_members om ON o.id = om.organization_id
-		WHERE om.user_id = $1
-		ORDER BY o.created_at DESC
+		SELECT ` + organizationColumns + `
+		FROM organizations
+		WHERE deleted_at IS NULL
+			AND id IN (SELECT organization_id FROM organization_members WHERE user_id = $1)
+		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 	rows, err := r.db.DB().QueryContext(ctx, query, userID, limit, offset)
@@ -173,18 +242,19 @@ func (r *OrganizationRepository) ListByUserID(ctx context.Context, userID uuid.U
 		return nil, fmt.Errorf("failed to list organizations by user: %w", err)
 	}
 	defer rows.Close()
+	return scanOrganizations(rows)
+}
 
+func scanOrganizations(rows *sql.Rows) ([]*Organization, error) {
 	var orgs []*Organization
 	for rows.Next() {
-		org := &Organization{}
-		if err := rows.Scan(
-			&org.ID, &org.Name, &org.Slug, &org.Settings, &org.CreatedAt, &org.UpdatedAt,
-		); err != nil {
+		org, err := scanOrganization(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan organization: %w", err)
 		}
 		orgs = append(orgs, org)
 	}
-	return orgs, nil
+	return orgs, rows.Err()
 }
 
 // AddMember adds a member to an organization.
@@ -221,13 +291,13 @@ func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID, userID
 // GetMember retrieves a member from an organization.
 func (r *OrganizationRepository) GetMember(ctx context.Context, orgID, userID uuid.UUID) (*OrganizationMember, error) {
 	query := `
-		SELECT id, organization_id, user_id, role, created_at
+		SELECT id, organization_id, user_id, role, active, created_at
 		FROM organization_members
 		WHERE organization_id = $1 AND user_id = $2
 	`
 	member := &OrganizationMember{}
 	err := r.db.DB().QueryRowContext(ctx, query, orgID, userID).Scan(
-		&member.ID, &member.OrganizationID, &member.UserID, &member.Role, &member.CreatedAt,
+		&member.ID, &member.OrganizationID, &member.UserID, &member.Role, &member.Active, &member.CreatedAt,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -241,7 +311,7 @@ func (r *OrganizationRepository) GetMember(ctx context.Context, orgID, userID uu
 // ListMembers lists members of an organization.
 func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*OrganizationMember, error) {
 	query := `
-		SELECT id, organization_id, user_id, role, created_at
+		SELECT id, organization_id, user_id, role, active, created_at
 		FROM organization_members
 		WHERE organization_id = $1
 		ORDER BY created_at ASC
@@ -257,7 +327,7 @@ func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID uuid.UUI
 	for rows.Next() {
 		member := &OrganizationMember{}
 		if err := rows.Scan(
-			&member.ID, &member.OrganizationID, &member.UserID, &member.Role, &member.CreatedAt,
+			&member.ID, &member.OrganizationID, &member.UserID, &member.Role, &member.Active, &member.CreatedAt,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan organization member: %w", err)
 		}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+// Plan is a version of a plan tier's price and entitlements.
+type Plan struct {
+	Tier              string
+	Version           int
+	Rank              int
+	BaseFeeCents      int64
+	ActionsIncluded   int64
+	ActiveStorageGB   decimal.Decimal
+	RetainedStorageGB decimal.Decimal
+	// HardUsageLimits makes usage beyond the included amounts block changes
+	// instead of being billed as overage.
+	HardUsageLimits  bool
+	MaxNamespaces    int
+	MaxRetentionDays int
+	// MaxHANamespaces is how many namespaces may be replicated to a standby
+	// region.
+	MaxHANamespaces int
+	Features        []string
+	CreatedAt       time.Time
+}
+
+// HasFeature reports whether the plan includes a feature.
+func (p *Plan) HasFeature(feature string) bool {
+	return slices.Contains(p.Features, feature)
+}
+
+// PlanRepository handles plan data access.
+type PlanRepository struct {
+	db *PostgresDB
+}
+
+// NewPlanRepository creates a new plan repository.
+func NewPlanRepository(db *PostgresDB) *PlanRepository {
+	return &PlanRepository{db: db}
+}
+
+const planColumns = `
+	tier, version, rank, base_fee_cents, actions_included, active_storage_gb, retained_storage_gb,
+	hard_usage_limits, max_namespaces, max_retention_days, max_ha_namespaces, features, created_at`
+
+func scanPlan(row interface{ Scan(...any) error }) (*Plan, error) {
+	plan := &Plan{}
+	err := row.Scan(
+		&plan.Tier, &plan.Version, &plan.Rank, &plan.BaseFeeCents, &plan.ActionsIncluded,
+		&plan.ActiveStorageGB, &plan.RetainedStorageGB, &plan.HardUsageLimits, &plan.MaxNamespaces,
+		&plan.MaxRetentionDays, &plan.MaxHANamespaces, pq.Array(&plan.Features), &plan.CreatedAt,
+	)
+	return plan, err
+}
+
+// Get retrieves a version of a plan.
+func (r *PlanRepository) Get(ctx context.Context, tier string, version int) (*Plan, error) {
+	query := `SELECT ` + planColumns + ` FROM plans WHERE tier = $1 AND version = $2`
+	return r.getPlan(ctx, query, tier, version)
+}
+
+// GetLatest retrieves the latest version of a plan, which new subscriptions
+// to it get.
+func (r *PlanRepository) GetLatest(ctx context.Context, tier string) (*Plan, error) {
+	query := `SELECT ` + planColumns + ` FROM plans WHERE tier = $1 ORDER BY version DESC LIMIT 1`
+	return r.getPlan(ctx, query, tier)
+}
+
+func (r *PlanRepository) getPlan(ctx context.Context, query string, args ...any) (*Plan, error) {
+	plan, err := scanPlan(r.db.DB().QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+	return plan, nil
+}
+
+// ListLatest lists the latest version of every plan, by rank.
+func (r *PlanRepository) ListLatest(ctx context.Context) ([]*Plan, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT DISTINCT ON (tier) `+planColumns+`
+		FROM plans
+		ORDER BY tier, version DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []*Plan
+	for rows.Next() {
+		plan, err := scanPlan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan plan: %w", err)
+		}
+		plans = append(plans, plan)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(plans, func(a, b *Plan) int { return a.Rank - b.Rank })
+	return plans, nil
+}
@@ -56,26 +56,48 @@ func (p *PostgresDB) DB() *sql.DB {
 
 // Repositories holds all repository instances.
 type Repositories struct {
-	Organizations *OrganizationRepository
-	Namespaces    *NamespaceRepository
-	Users         *UserRepository
-	Subscriptions *SubscriptionRepository
-	Usage         *UsageRepository
-	Invoices      *InvoiceRepository
-	APIKeys       *APIKeyRepository
-	Audit         *AuditRepository
+	Organizations   *OrganizationRepository
+	Namespaces      *NamespaceRepository
+	Users           *UserRepository
+	Subscriptions   *SubscriptionRepository
+	Plans           *PlanRepository
+	Usage           *UsageRepository
+	Invoices        *InvoiceRepository
+	APIKeys         *APIKeyRepository
+	Audit           *AuditRepository
+	AuditStreams    *AuditStreamRepository
+	CAs             *CertificateAuthorityRepository
+	Credits         *CreditRepository
+	Domains         *DomainRepository
+	SAML            *SAMLRepository
+	SCIM            *SCIMRepository
+	Exports         *ExportRepository
+	Connectivity    *ConnectivityRepository
+	ServiceAccounts *ServiceAccountRepository
+	Nexus           *NexusRepository
 }
 
 // NewRepositories creates all repository instances.
 func NewRepositories(db *PostgresDB) *Repositories {
 	return &Repositories{
-		Organizations: NewOrganizationRepository(db),
-		Namespaces:    NewNamespaceRepository(db),
-		Users:         NewUserRepository(db),
-		Subscriptions: NewSubscriptionRepository(db),
-		Usage:         NewUsageRepository(db),
-		Invoices:      NewInvoiceRepository(db),
-		APIKeys:       NewAPIKeyRepository(db),
-		Audit:         NewAuditRepository(db),
+		Organizations:   NewOrganizationRepository(db),
+		Namespaces:      NewNamespaceRepository(db),
+		Users:           NewUserRepository(db),
+		Subscriptions:   NewSubscriptionRepository(db),
+		Plans:           NewPlanRepository(db),
+		Usage:           NewUsageRepository(db),
+		Invoices:        NewInvoiceRepository(db),
+		APIKeys:         NewAPIKeyRepository(db),
+		Audit:           NewAuditRepository(db),
+		AuditStreams:    NewAuditStreamRepository(db),
+		CAs:             NewCertificateAuthorityRepository(db),
+		Credits:         NewCreditRepository(db),
+		Domains:         NewDomainRepository(db),
+		SAML:            NewSAMLRepository(db),
+		SCIM:            NewSCIMRepository(db),
+		Exports:         NewExportRepository(db),
+		Connectivity:    NewConnectivityRepository(db),
+		ServiceAccounts: NewServiceAccountRepository(db),
+		Nexus:           NewNexusRepository(db),
 	}
 }
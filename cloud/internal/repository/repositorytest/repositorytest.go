@@ -0,0 +1,79 @@
+// Package repositorytest creates throwaway Postgres databases for tests.
+package repositorytest
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// EnvVar enables the tests that need Postgres. They run with the end-to-end
+// tests; the database connection is taken from the usual DB_* environment
+// variables.
+const EnvVar = "CLOUD_API_E2E"
+
+// NewDB creates a database with the schema migrations applied, on the server
+// of cfg, and drops it when the test ends. The test is skipped unless EnvVar
+// is set.
+func NewDB(t *testing.T, cfg config.DatabaseConfig) *repository.PostgresDB {
+	t.Helper()
+	if os.Getenv(EnvVar) == "" {
+		t.Skipf("%s not set", EnvVar)
+	}
+
+	cfg.Database = createDatabase(t, cfg)
+	db, err := repository.NewPostgresDB(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	applyMigrations(t, db.DB())
+	return db
+}
+
+// NewRepositories is NewDB with the database configuration loaded from the
+// environment, returning the repositories.
+func NewRepositories(t *testing.T) *repository.Repositories {
+	t.Helper()
+	if os.Getenv(EnvVar) == "" {
+		t.Skipf("%s not set", EnvVar)
+	}
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	return repository.NewRepositories(NewDB(t, cfg.Database))
+}
+
+func createDatabase(t *testing.T, cfg config.DatabaseConfig) string {
+	t.Helper()
+	admin, err := sql.Open("postgres", cfg.DSN())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = admin.Close() })
+
+	name := "cloud_e2e_" + strings.ReplaceAll(uuid.NewString()[:8], "-", "")
+	_, err = admin.Exec("CREATE DATABASE " + name)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = admin.Exec("DROP DATABASE IF EXISTS " + name + " WITH (FORCE)") })
+	return name
+}
+
+func applyMigrations(t *testing.T, db *sql.DB) {
+	t.Helper()
+	_, file, _, _ := runtime.Caller(0)
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(file), "..", "..", "..", "schema", "migrations", "*.up.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	sort.Strings(files)
+	for _, file := range files {
+		stmts, err := os.ReadFile(file)
+		require.NoError(t, err)
+		_, err = db.Exec(string(stmts))
+		require.NoError(t, err, file)
+	}
+}
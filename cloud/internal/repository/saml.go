@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SAMLConfiguration is an organization's SAML SSO configuration: the identity
+// provider it trusts and the service provider identifiers registered with it.
+type SAMLConfiguration struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Enabled        bool
+	IdPEntityID    string
+	IdPSSOURL      string
+	// IdPCertificate holds the IdP's PEM-encoded signing certificates.
+	IdPCertificate   string
+	SPEntityID       string
+	SPACSURL         string
+	AttributeMapping json.RawMessage
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// SAMLRequest is an AuthnRequest awaiting its response.
+type SAMLRequest struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	RequestID      string
+	RedirectURL    string
+	ExpiresAt      time.Time
+	ConsumedAt     sql.NullTime
+	CreatedAt      time.Time
+}
+
+// SAMLRepository handles SAML configuration and login request data access.
+type SAMLRepository struct {
+	db *PostgresDB
+}
+
+// NewSAMLRepository creates a new SAML repository.
+func NewSAMLRepository(db *PostgresDB) *SAMLRepository {
+	return &SAMLRepository{db: db}
+}
+
+// GetByOrganizationID retrieves an organization's SAML configuration.
+func (r *SAMLRepository) GetByOrganizationID(ctx context.Context, orgID uuid.UUID) (*SAMLConfiguration, error) {
+	query := `
+		SELECT id, organization_id, enabled, idp_entity_id, idp_sso_url, idp_certificate,
+		       sp_entity_id, sp_acs_url, COALESCE(attribute_mapping, '{}'), created_at, updated_at
+		FROM saml_configurations
+		WHERE organization_id = $1
+	`
+	cfg := &SAMLConfiguration{}
+	err := r.db.DB().QueryRowContext(ctx, query, orgID).Scan(
+		&cfg.ID, &cfg.OrganizationID, &cfg.Enabled, &cfg.IdPEntityID, &cfg.IdPSSOURL, &cfg.IdPCertificate,
+		&cfg.SPEntityID, &cfg.SPACSURL, &cfg.AttributeMapping, &cfg.CreatedAt, &cfg.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SAML configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// Upsert creates or replaces an organization's SAML configuration.
+func (r *SAMLRepository) Upsert(ctx context.Context, cfg *SAMLConfiguration) error {
+	query := `
+		INSERT INTO saml_configurations (
+			id, organization_id, enabled, idp_entity_id, idp_sso_url, idp_certificate,
+			sp_entity_id, sp_acs_url, attribute_mapping
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (organization_id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			idp_entity_id = EXCLUDED.idp_entity_id,
+			idp_sso_url = EXCLUDED.idp_sso_url,
+			idp_certificate = EXCLUDED.idp_certificate,
+			sp_entity_id = EXCLUDED.sp_entity_id,
+			sp_acs_url = EXCLUDED.sp_acs_url,
+			attribute_mapping = EXCLUDED.attribute_mapping
+		RETURNING id, created_at, updated_at
+	`
+	if cfg.ID == uuid.Nil {
+		cfg.ID = uuid.New()
+	}
+	if cfg.AttributeMapping == nil {
+		cfg.AttributeMapping = json.RawMessage("{}")
+	}
+
+	err := r.db.DB().QueryRowContext(ctx, query,
+		cfg.ID, cfg.OrganizationID, cfg.Enabled, cfg.IdPEntityID, cfg.IdPSSOURL, cfg.IdPCertificate,
+		cfg.SPEntityID, cfg.SPACSURL, cfg.AttributeMapping,
+	).Scan(&cfg.ID, &cfg.CreatedAt, &cfg.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save SAML configuration: %w", err)
+	}
+	return nil
+}
+
+// CreateRequest records an AuthnRequest sent to an identity provider. Requests
+// that expired more than a day ago are deleted on the way.
+func (r *SAMLRepository) CreateRequest(ctx context.Context, req *SAMLRequest) error {
+	if req.ID == uuid.Nil {
+		req.ID = uuid.New()
+	}
+	req.CreatedAt = time.Now()
+
+	if _, err := r.db.DB().ExecContext(ctx,
+		`DELETE FROM saml_requests WHERE expires_at < $1`, req.CreatedAt.Add(-24*time.Hour),
+	); err != nil {
+		return fmt.Errorf("failed to delete expired SAML requests: %w", err)
+	}
+
+	query := `
+		INSERT INTO saml_requests (id, organization_id, request_id, redirect_url, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.DB().ExecContext(ctx, query,
+		req.ID, req.OrganizationID, req.RequestID, req.RedirectURL, req.ExpiresAt, req.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create SAML request: %w", err)
+	}
+	return nil
+}
+
+// ConsumeRequest marks a pending request as answered and returns it. It
+// returns nil if the request does not exist, has expired or was already
+// consumed, so a response can complete at most one login.
+func (r *SAMLRepository) ConsumeRequest(ctx context.Context, id uuid.UUID, at time.Time) (*SAMLRequest, error) {
+	query := `
+		UPDATE saml_requests
+		SET consumed_at = $2
+		WHERE id = $1 AND consumed_at IS NULL AND expires_at > $2
+		RETURNING id, organization_id, request_id, redirect_url, expires_at, consumed_at, created_at
+	`
+	req := &SAMLRequest{}
+	err := r.db.DB().QueryRowContext(ctx, query, id, at).Scan(
+		&req.ID, &req.OrganizationID, &req.RequestID, &req.RedirectURL, &req.ExpiresAt, &req.ConsumedAt, &req.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume SAML request: %w", err)
+	}
+	return req, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SCIMConfiguration is an organization's SCIM provisioning configuration.
+type SCIMConfiguration struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Enabled        bool
+	// TokenHash is the hex SHA-256 hash of the bearer token SCIM clients
+	// authenticate with.
+	TokenHash string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SCIMRepository handles SCIM configuration data access.
+type SCIMRepository struct {
+	db *PostgresDB
+}
+
+// NewSCIMRepository creates a new SCIM repository.
+func NewSCIMRepository(db *PostgresDB) *SCIMRepository {
+	return &SCIMRepository{db: db}
+}
+
+const scimConfigurationColumns = `id, organization_id, enabled, token_hash, created_at, updated_at`
+
+// GetByOrganizationID retrieves an organization's SCIM configuration.
+func (r *SCIMRepository) GetByOrganizationID(ctx context.Context, orgID uuid.UUID) (*SCIMConfiguration, error) {
+	query := `SELECT ` + scimConfigurationColumns + ` FROM scim_configurations WHERE organization_id = $1`
+	return r.get(ctx, query, orgID)
+}
+
+// GetByTokenHash retrieves the SCIM configuration a token belongs to.
+func (r *SCIMRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*SCIMConfiguration, error) {
+	query := `SELECT ` + scimConfigurationColumns + ` FROM scim_configurations WHERE token_hash = $1`
+	return r.get(ctx, query, tokenHash)
+}
+
+func (r *SCIMRepository) get(ctx context.Context, query string, arg any) (*SCIMConfiguration, error) {
+	cfg := &SCIMConfiguration{}
+	err := r.db.DB().QueryRowContext(ctx, query, arg).Scan(
+		&cfg.ID, &cfg.OrganizationID, &cfg.Enabled, &cfg.TokenHash, &cfg.CreatedAt, &cfg.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SCIM configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// Upsert creates or replaces an organization's SCIM configuration.
+func (r *SCIMRepository) Upsert(ctx context.Context, cfg *SCIMConfiguration) error {
+	query := `
+		INSERT INTO scim_configurations (id, organization_id, enabled, token_hash)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (organization_id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			token_hash = EXCLUDED.token_hash
+		RETURNING id, created_at, updated_at
+	`
+	if cfg.ID == uuid.Nil {
+		cfg.ID = uuid.New()
+	}
+	err := r.db.DB().QueryRowContext(ctx, query, cfg.ID, cfg.OrganizationID, cfg.Enabled, cfg.TokenHash).Scan(
+		&cfg.ID, &cfg.CreatedAt, &cfg.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert SCIM configuration: %w", err)
+	}
+	return nil
+}
+
+// SetEnabled enables or disables an organization's SCIM provisioning. It
+// reports whether the organization has a SCIM configuration.
+func (r *SCIMRepository) SetEnabled(ctx context.Context, orgID uuid.UUID, enabled bool) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		UPDATE scim_configurations SET enabled = $2 WHERE organization_id = $1
+	`, orgID, enabled)
+	if err != nil {
+		return false, fmt.Errorf("failed to update SCIM configuration: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update SCIM configuration: %w", err)
+	}
+	return n > 0, nil
+}
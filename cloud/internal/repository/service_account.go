@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ServiceAccount is a non-human principal of an organization. It owns API
+// keys and holds an account-level role and namespace permissions.
+type ServiceAccount struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Description    sql.NullString
+	AccountRole    sql.NullString
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ServiceAccountNamespacePermission is a permission a service account holds on
+// a namespace.
+type ServiceAccountNamespacePermission struct {
+	ServiceAccountID uuid.UUID
+	NamespaceID      string
+	Permission       string
+	CreatedAt        time.Time
+}
+
+// ServiceAccountRepository handles service account data access.
+type ServiceAccountRepository struct {
+	db *PostgresDB
+}
+
+// NewServiceAccountRepository creates a new service account repository.
+func NewServiceAccountRepository(db *PostgresDB) *ServiceAccountRepository {
+	return &ServiceAccountRepository{db: db}
+}
+
+const serviceAccountColumns = `id, organization_id, name, description, account_role, created_at, updated_at`
+
+func scanServiceAccount(row interface{ Scan(...any) error }) (*ServiceAccount, error) {
+	sa := &ServiceAccount{}
+	err := row.Scan(
+		&sa.ID, &sa.OrganizationID, &sa.Name, &sa.Description, &sa.AccountRole, &sa.CreatedAt, &sa.UpdatedAt,
+	)
+	return sa, err
+}
+
+// Create creates a service account with its namespace permissions.
+func (r *ServiceAccountRepository) Create(ctx context.Context, sa *ServiceAccount, perms []*ServiceAccountNamespacePermission) error {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if sa.ID == uuid.Nil {
+		sa.ID = uuid.New()
+	}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO service_accounts (id, organization_id, name, description, account_role)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at
+	`, sa.ID, sa.OrganizationID, sa.Name, sa.Description, sa.AccountRole).Scan(&sa.CreatedAt, &sa.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create service account: %w", err)
+	}
+	if err := insertServiceAccountPermissions(ctx, tx, sa.ID, perms); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a service account by ID.
+func (r *ServiceAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*ServiceAccount, error) {
+	query := `SELECT ` + serviceAccountColumns + ` FROM service_accounts WHERE id = $1`
+	sa, err := scanServiceAccount(r.db.DB().QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	return sa, nil
+}
+
+// ListByOrganization lists the service accounts of an organization.
+func (r *ServiceAccountRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*ServiceAccount, error) {
+	query := `
+		SELECT ` + serviceAccountColumns + `
+		FROM service_accounts
+		WHERE organization_id = $1
+		ORDER BY name, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, orgID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []*ServiceAccount
+	for rows.Next() {
+		sa, err := scanServiceAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan service account: %w", err)
+		}
+		accounts = append(accounts, sa)
+	}
+	return accounts, rows.Err()
+}
+
+// Update updates a service account and replaces its namespace permissions.
+func (r *ServiceAccountRepository) Update(ctx context.Context, sa *ServiceAccount, perms []*ServiceAccountNamespacePermission) error {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE service_accounts SET name = $2, description = $3, account_role = $4
+		WHERE id = $1
+		RETURNING updated_at
+	`, sa.ID, sa.Name, sa.Description, sa.AccountRole).Scan(&sa.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update service account: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM service_account_namespace_permissions WHERE service_account_id = $1
+	`, sa.ID); err != nil {
+		return fmt.Errorf("failed to clear service account namespace permissions: %w", err)
+	}
+	if err := insertServiceAccountPermissions(ctx, tx, sa.ID, perms); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func insertServiceAccountPermissions(ctx context.Context, tx *sql.Tx, id uuid.UUID, perms []*ServiceAccountNamespacePermission) error {
+	now := time.Now()
+	for _, perm := range perms {
+		perm.ServiceAccountID = id
+		perm.CreatedAt = now
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO service_account_namespace_permissions (service_account_id, namespace_id, permission, created_at)
+			VALUES ($1, $2, $3, $4)
+		`, perm.ServiceAccountID, perm.NamespaceID, perm.Permission, perm.CreatedAt); err != nil {
+			return fmt.Errorf("failed to set service account namespace permission: %w", err)
+		}
+	}
+	return nil
+}
+
+// Delete deletes a service account, its namespace permissions and its API
+// keys. It reports whether the service account existed.
+func (r *ServiceAccountRepository) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// API keys reference their owner without a foreign key.
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM api_keys WHERE owner_type = 'service_account' AND owner_id = $1
+	`, id); err != nil {
+		return false, fmt.Errorf("failed to delete service account API keys: %w", err)
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM service_accounts WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete service account: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete service account: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return n > 0, nil
+}
+
+// ListNamespacePermissions lists the namespace permissions of a service
+// account.
+func (r *ServiceAccountRepository) ListNamespacePermissions(ctx context.Context, id uuid.UUID) ([]*ServiceAccountNamespacePermission, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT service_account_id, namespace_id, permission, created_at
+		FROM service_account_namespace_permissions
+		WHERE service_account_id = $1
+		ORDER BY namespace_id
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service account namespace permissions: %w", err)
+	}
+	defer rows.Close()
+
+	var perms []*ServiceAccountNamespacePermission
+	for rows.Next() {
+		perm := &ServiceAccountNamespacePermission{}
+		if err := rows.Scan(&perm.ServiceAccountID, &perm.NamespaceID, &perm.Permission, &perm.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan service account namespace permission: %w", err)
+		}
+		perms = append(perms, perm)
+	}
+	return perms, rows.Err()
+}
@@ -0,0 +1,91 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/repository/repositorytest"
+)
+
+func createTestOrganization(t *testing.T, repos *repository.Repositories, slug string) *repository.Organization {
+	t.Helper()
+	org := &repository.Organization{Name: slug, Slug: slug}
+	require.NoError(t, repos.Organizations.Create(context.Background(), org))
+	return org
+}
+
+func TestServiceAccountRepository(t *testing.T) {
+	repos := repositorytest.NewRepositories(t)
+	ctx := context.Background()
+	org := createTestOrganization(t, repos, "acme")
+	other := createTestOrganization(t, repos, "globex")
+
+	sa := &repository.ServiceAccount{
+		OrganizationID: org.ID,
+		Name:           "ci",
+		AccountRole:    sql.NullString{String: "read_only", Valid: true},
+	}
+	require.NoError(t, repos.ServiceAccounts.Create(ctx, sa, []*repository.ServiceAccountNamespacePermission{
+		{NamespaceID: "orders.acme", Permission: "write"},
+		{NamespaceID: "billing.acme", Permission: "read"},
+	}))
+	require.NotEqual(t, uuid.Nil, sa.ID)
+	require.NoError(t, repos.ServiceAccounts.Create(ctx, &repository.ServiceAccount{OrganizationID: other.ID, Name: "deploy"}, nil))
+
+	got, err := repos.ServiceAccounts.GetByID(ctx, sa.ID)
+	require.NoError(t, err)
+	require.Equal(t, org.ID, got.OrganizationID)
+	require.Equal(t, "read_only", got.AccountRole.String)
+	perms, err := repos.ServiceAccounts.ListNamespacePermissions(ctx, sa.ID)
+	require.NoError(t, err)
+	require.Len(t, perms, 2)
+	require.Equal(t, "billing.acme", perms[0].NamespaceID)
+	require.Equal(t, "write", perms[1].Permission)
+
+	// Listing is scoped to the organization.
+	accounts, err := repos.ServiceAccounts.ListByOrganization(ctx, org.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, sa.ID, accounts[0].ID)
+
+	// Updating replaces the permissions.
+	sa.Name = "ci-prod"
+	sa.AccountRole = sql.NullString{}
+	require.NoError(t, repos.ServiceAccounts.Update(ctx, sa, []*repository.ServiceAccountNamespacePermission{
+		{NamespaceID: "orders.acme", Permission: "admin"},
+	}))
+	got, err = repos.ServiceAccounts.GetByID(ctx, sa.ID)
+	require.NoError(t, err)
+	require.Equal(t, "ci-prod", got.Name)
+	require.False(t, got.AccountRole.Valid)
+	perms, err = repos.ServiceAccounts.ListNamespacePermissions(ctx, sa.ID)
+	require.NoError(t, err)
+	require.Len(t, perms, 1)
+	require.Equal(t, "admin", perms[0].Permission)
+
+	// Deleting removes the service account's API keys.
+	_, _, hash, err := repository.GenerateAPIKey()
+	require.NoError(t, err)
+	key := &repository.APIKey{OwnerType: "service_account", OwnerID: sa.ID, KeyHash: hash, KeyPrefix: "tmprl_"}
+	require.NoError(t, repos.APIKeys.Create(ctx, key))
+	deleted, err := repos.ServiceAccounts.Delete(ctx, sa.ID)
+	require.NoError(t, err)
+	require.True(t, deleted)
+	got, err = repos.ServiceAccounts.GetByID(ctx, sa.ID)
+	require.NoError(t, err)
+	require.Nil(t, got)
+	gotKey, err := repos.APIKeys.GetByID(ctx, key.ID)
+	require.NoError(t, err)
+	require.Nil(t, gotKey)
+	perms, err = repos.ServiceAccounts.ListNamespacePermissions(ctx, sa.ID)
+	require.NoError(t, err)
+	require.Empty(t, perms)
+
+	deleted, err = repos.ServiceAccounts.Delete(ctx, sa.ID)
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
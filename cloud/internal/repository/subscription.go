@@ -15,6 +15,7 @@ type Subscription struct {
 	ID                   uuid.UUID
 	OrganizationID       uuid.UUID
 	Plan                 string
+	PlanVersion          int
 	Status               string
 	ActionsIncluded      int64
 	ActiveStorageGB      decimal.Decimal
@@ -41,10 +42,10 @@ func NewSubscriptionRepository(db *PostgresDB) *SubscriptionRepository {
 func (r *SubscriptionRepository) Create(ctx context.Context, sub *Subscription) error {
 	query := `
 		INSERT INTO subscriptions (
-			id, organization_id, plan, status, actions_included,
+			id, organization_id, plan, plan_version, status, actions_included,
 			active_storage_gb, retained_storage_gb, stripe_customer_id, stripe_subscription_id,
 			current_period_start, current_period_end, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 	if sub.ID == uuid.Nil {
 		sub.ID = uuid.New()
@@ -54,7 +55,7 @@ func (r *SubscriptionRepository) Create(ctx context.Context, sub *Subscription)
 	sub.UpdatedAt = now
 
 	_, err := r.db.DB().ExecContext(ctx, query,
-		sub.ID, sub.OrganizationID, sub.Plan, sub.Status, sub.ActionsIncluded,
+		sub.ID, sub.OrganizationID, sub.Plan, sub.PlanVersion, sub.Status, sub.ActionsIncluded,
 		sub.ActiveStorageGB, sub.RetainedStorageGB, sub.StripeCustomerID, sub.StripeSubscriptionID,
 		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, sub.CreatedAt, sub.UpdatedAt,
 	)
@@ -67,7 +68,7 @@ func (r *SubscriptionRepository) Create(ctx context.Context, sub *Subscription)
 // GetByOrganizationID retrieves a subscription by organization ID.
 func (r *SubscriptionRepository) GetByOrganizationID(ctx context.Context, orgID uuid.UUID) (*Subscription, error) {
 	query := `
-		SELECT id, organization_id, plan, status, actions_included,
+		SELECT id, organization_id, plan, plan_version, status, actions_included,
 			active_storage_gb, retained_storage_gb, stripe_customer_id, stripe_subscription_id,
 			current_period_start, current_period_end, created_at, updated_at
 		FROM subscriptions
@@ -75,7 +76,7 @@ func (r *SubscriptionRepository) GetByOrganizationID(ctx context.Context, orgID
 	`
 	sub := &Subscription{}
 	err := r.db.DB().QueryRowContext(ctx, query, orgID).Scan(
-		&sub.ID, &sub.OrganizationID, &sub.Plan, &sub.Status, &sub.ActionsIncluded,
+		&sub.ID, &sub.OrganizationID, &sub.Plan, &sub.PlanVersion, &sub.Status, &sub.ActionsIncluded,
 		&sub.ActiveStorageGB, &sub.RetainedStorageGB, &sub.StripeCustomerID, &sub.StripeSubscriptionID,
 		&sub.CurrentPeriodStart, &sub.CurrentPeriodEnd, &sub.CreatedAt, &sub.UpdatedAt,
 	)
@@ -91,7 +92,7 @@ func (r *SubscriptionRepository) GetByOrganizationID(ctx context.Context, orgID
 // GetByStripeCustomerID retrieves a subscription by Stripe customer ID.
 func (r *SubscriptionRepository) GetByStripeCustomerID(ctx context.Context, customerID string) (*Subscription, error) {
 	query := `
-		SELECT id, organization_id, plan, status, actions_included,
+		SELECT id, organization_id, plan, plan_version, status, actions_included,
 			active_storage_gb, retained_storage_gb, stripe_customer_id, stripe_subscription_id,
 			current_period_start, current_period_end, created_at, updated_at
 		FROM subscriptions
@@ -99,7 +100,7 @@ func (r *SubscriptionRepository) GetByStripeCustomerID(ctx context.Context, cust
 	`
 	sub := &Subscription{}
 	err := r.db.DB().QueryRowContext(ctx, query, customerID).Scan(
-		&sub.ID, &sub.OrganizationID, &sub.Plan, &sub.Status, &sub.ActionsIncluded,
+		&sub.ID, &sub.OrganizationID, &sub.Plan, &sub.PlanVersion, &sub.Status, &sub.ActionsIncluded,
 		&sub.ActiveStorageGB, &sub.RetainedStorageGB, &sub.StripeCustomerID, &sub.StripeSubscriptionID,
 		&sub.CurrentPeriodStart, &sub.CurrentPeriodEnd, &sub.CreatedAt, &sub.UpdatedAt,
 	)
@@ -116,15 +117,15 @@ func (r *SubscriptionRepository) GetByStripeCustomerID(ctx context.Context, cust
 func (r *SubscriptionRepository) Update(ctx context.Context, sub *Subscription) error {
 	query := `
 		UPDATE subscriptions
-		SET plan = $2, status = $3, actions_included = $4,
-			active_storage_gb = $5, retained_storage_gb = $6,
-			stripe_customer_id = $7, stripe_subscription_id = $8,
-			current_period_start = $9, current_period_end = $10, updated_at = $11
+		SET plan = $2, plan_version = $3, status = $4, actions_included = $5,
+			active_storage_gb = $6, retained_storage_gb = $7,
+			stripe_customer_id = $8, stripe_subscription_id = $9,
+			current_period_start = $10, current_period_end = $11, updated_at = $12
 		WHERE id = $1
 	`
 	sub.UpdatedAt = time.Now()
 	_, err := r.db.DB().ExecContext(ctx, query,
-		sub.ID, sub.Plan, sub.Status, sub.ActionsIncluded,
+		sub.ID, sub.Plan, sub.PlanVersion, sub.Status, sub.ActionsIncluded,
 		sub.ActiveStorageGB, sub.RetainedStorageGB,
 		sub.StripeCustomerID, sub.StripeSubscriptionID,
 		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, sub.UpdatedAt,
@@ -154,21 +155,3 @@ func (r *SubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error
 	}
 	return nil
 }
-
-// GetPlanLimits returns the limits for a plan tier.
-func GetPlanLimits(plan string) (actionsIncluded int64, activeStorageGB, retainedStorageGB decimal.Decimal) {
-	switch plan {
-	case "free":
-		return 100000, decimal.NewFromFloat(0.1), decimal.NewFromFloat(4)
-	case "essentials":
-		return 1000000, decimal.NewFromFloat(1), decimal.NewFromFloat(40)
-	case "business":
-		return 2500000, decimal.NewFromFloat(2.5), decimal.NewFromFloat(100)
-	case "enterprise":
-		return 10000000, decimal.NewFromFloat(10), decimal.NewFromFloat(400)
-	case "mission_critical":
-		return 100000000, decimal.NewFromFloat(100), decimal.NewFromFloat(4000)
-	default:
-		return 100000, decimal.NewFromFloat(0.1), decimal.NewFromFloat(4)
-	}
-}
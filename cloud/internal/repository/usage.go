@@ -63,7 +63,8 @@ func NewUsageRepository(db *PostgresDB) *UsageRepository {
 	return &UsageRepository{db: db}
 }
 
-// Create creates a new usage record.
+// Create creates a usage record, replacing any existing record for the same
+// namespace and period so that re-recording a period never double-counts.
 func (r *UsageRepository) Create(ctx context.Context, record *UsageRecord) error {
 	query := `
 		INSERT INTO usage_records (
@@ -78,24 +79,24 @@ func (r *UsageRepository) Create(ctx context.Context, record *UsageRecord) error
 			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24
 		)
 		ON CONFLICT (organization_id, namespace_id, period_start) DO UPDATE SET
-			action_count = usage_records.action_count + EXCLUDED.action_count,
-			workflow_started = usage_records.workflow_started + EXCLUDED.workflow_started,
-			workflow_reset = usage_records.workflow_reset + EXCLUDED.workflow_reset,
-			timer_started = usage_records.timer_started + EXCLUDED.timer_started,
-			signal_sent = usage_records.signal_sent + EXCLUDED.signal_sent,
-			query_received = usage_records.query_received + EXCLUDED.query_received,
-			update_received = usage_records.update_received + EXCLUDED.update_received,
-			activity_started = usage_records.activity_started + EXCLUDED.activity_started,
-			activity_heartbeat = usage_records.activity_heartbeat + EXCLUDED.activity_heartbeat,
-			local_activity_batch = usage_records.local_activity_batch + EXCLUDED.local_activity_batch,
-			child_workflow_started = usage_records.child_workflow_started + EXCLUDED.child_workflow_started,
-			schedule_execution = usage_records.schedule_execution + EXCLUDED.schedule_execution,
-			nexus_operation = usage_records.nexus_operation + EXCLUDED.nexus_operation,
-			search_attribute_upsert = usage_records.search_attribute_upsert + EXCLUDED.search_attribute_upsert,
-			side_effect_recorded = usage_records.side_effect_recorded + EXCLUDED.side_effect_recorded,
-			workflow_exported = usage_records.workflow_exported + EXCLUDED.workflow_exported,
-			active_storage_gbh = usage_records.active_storage_gbh + EXCLUDED.active_storage_gbh,
-			retained_storage_gbh = usage_records.retained_storage_gbh + EXCLUDED.retained_storage_gbh
+			action_count = EXCLUDED.action_count,
+			workflow_started = EXCLUDED.workflow_started,
+			workflow_reset = EXCLUDED.workflow_reset,
+			timer_started = EXCLUDED.timer_started,
+			signal_sent = EXCLUDED.signal_sent,
+			query_received = EXCLUDED.query_received,
+			update_received = EXCLUDED.update_received,
+			activity_started = EXCLUDED.activity_started,
+			activity_heartbeat = EXCLUDED.activity_heartbeat,
+			local_activity_batch = EXCLUDED.local_activity_batch,
+			child_workflow_started = EXCLUDED.child_workflow_started,
+			schedule_execution = EXCLUDED.schedule_execution,
+			nexus_operation = EXCLUDED.nexus_operation,
+			search_attribute_upsert = EXCLUDED.search_attribute_upsert,
+			side_effect_recorded = EXCLUDED.side_effect_recorded,
+			workflow_exported = EXCLUDED.workflow_exported,
+			active_storage_gbh = EXCLUDED.active_storage_gbh,
+			retained_storage_gbh = EXCLUDED.retained_storage_gbh
 	`
 	if record.ID == uuid.Nil {
 		record.ID = uuid.New()
@@ -224,18 +225,20 @@ func (r *UsageRepository) GetSummaryByNamespace(ctx context.Context, namespaceID
 	return record, nil
 }
 
-// CreateAggregate creates a usage aggregate.
+// CreateAggregate creates a usage aggregate, replacing any existing aggregate
+// for the same namespace, or the whole organization, and period.
 func (r *UsageRepository) CreateAggregate(ctx context.Context, agg *UsageAggregate) error {
 	query := `
 		INSERT INTO usage_aggregates (
 			id, organization_id, namespace_id, period_type, period_start, period_end,
 			total_actions, active_storage_gbh, retained_storage_gbh, action_breakdown, created_at
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-		ON CONFLICT (organization_id, namespace_id, period_type, period_start) DO UPDATE SET
+		ON CONFLICT (organization_id, (COALESCE(namespace_id, '')), period_type, period_start) DO UPDATE SET
 			total_actions = EXCLUDED.total_actions,
 			active_storage_gbh = EXCLUDED.active_storage_gbh,
 			retained_storage_gbh = EXCLUDED.retained_storage_gbh,
-			action_breakdown = EXCLUDED.action_breakdown
+			action_breakdown = EXCLUDED.action_breakdown,
+			period_end = EXCLUDED.period_end
 	`
 	if agg.ID == uuid.Nil {
 		agg.ID = uuid.New()
@@ -251,3 +254,53 @@ func (r *UsageRepository) CreateAggregate(ctx context.Context, agg *UsageAggrega
 	}
 	return nil
 }
+
+// SetClosedHistory records the history size of the namespace's executions
+// that closed during the hour, replacing any previous value for the hour.
+func (r *UsageRepository) SetClosedHistory(ctx context.Context, namespaceID string, hour time.Time, bytes int64) error {
+	query := `
+		INSERT INTO namespace_closed_history (namespace_id, close_hour, history_bytes)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (namespace_id, close_hour) DO UPDATE SET history_bytes = EXCLUDED.history_bytes
+	`
+	if _, err := r.db.DB().ExecContext(ctx, query, namespaceID, hour, bytes); err != nil {
+		return fmt.Errorf("failed to record closed history size: %w", err)
+	}
+	return nil
+}
+
+// HasClosedHistory reports whether any closed history size was recorded for
+// the namespace.
+func (r *UsageRepository) HasClosedHistory(ctx context.Context, namespaceID string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM namespace_closed_history WHERE namespace_id = $1)`
+	if err := r.db.DB().QueryRowContext(ctx, query, namespaceID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check closed history size: %w", err)
+	}
+	return exists, nil
+}
+
+// SumClosedHistory returns the history size of the namespace's executions
+// that closed in [from, to).
+func (r *UsageRepository) SumClosedHistory(ctx context.Context, namespaceID string, from, to time.Time) (int64, error) {
+	var bytes int64
+	query := `
+		SELECT COALESCE(SUM(history_bytes), 0)
+		FROM namespace_closed_history
+		WHERE namespace_id = $1 AND close_hour >= $2 AND close_hour < $3
+	`
+	if err := r.db.DB().QueryRowContext(ctx, query, namespaceID, from, to).Scan(&bytes); err != nil {
+		return 0, fmt.Errorf("failed to sum closed history size: %w", err)
+	}
+	return bytes, nil
+}
+
+// DeleteClosedHistory deletes the closed history sizes recorded for the
+// namespace's hours before the given time.
+func (r *UsageRepository) DeleteClosedHistory(ctx context.Context, namespaceID string, before time.Time) error {
+	query := `DELETE FROM namespace_closed_history WHERE namespace_id = $1 AND close_hour < $2`
+	if _, err := r.db.DB().ExecContext(ctx, query, namespaceID, before); err != nil {
+		return fmt.Errorf("failed to delete closed history sizes: %w", err)
+	}
+	return nil
+}
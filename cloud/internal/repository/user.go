@@ -36,6 +36,13 @@ type UserNamespacePermission struct {
 	CreatedAt   time.Time
 }
 
+// Invitation statuses. Pending invitations past their expiry are expired.
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+)
+
 // UserInvitation represents a user invitation.
 type UserInvitation struct {
 	ID             uuid.UUID
@@ -43,10 +50,15 @@ type UserInvitation struct {
 	Email          string
 	Role           string
 	InvitedBy      uuid.UUID
-	Token          string
-	ExpiresAt      time.Time
-	AcceptedAt     sql.NullTime
-	CreatedAt      time.Time
+	// Token is the SHA-256 hash of the invitation's current token.
+	Token      string
+	Status     string
+	ExpiresAt  time.Time
+	AcceptedAt sql.NullTime
+	DeclinedAt sql.NullTime
+	SendCount  int
+	LastSentAt sql.NullTime
+	CreatedAt  time.Time
 }
 
 // UserRepository handles user data access.
@@ -148,39 +160,58 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// CreateInvitation creates a user invitation.
+const invitationColumns = `id, organization_id, email, role, invited_by, token, status,
+	expires_at, accepted_at, declined_at, send_count, last_sent_at, created_at`
+
+func scanInvitation(row interface{ Scan(...any) error }) (*UserInvitation, error) {
+	inv := &UserInvitation{}
+	err := row.Scan(
+		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.InvitedBy, &inv.Token, &inv.Status,
+		&inv.ExpiresAt, &inv.AcceptedAt, &inv.DeclinedAt, &inv.SendCount, &inv.LastSentAt, &inv.CreatedAt,
+	)
+	return inv, err
+}
+
+// CreateInvitation creates a pending user invitation. An existing invitation
+// of the email to the organization is replaced, keeping its ID.
 func (r *UserRepository) CreateInvitation(ctx context.Context, inv *UserInvitation) error {
 	query := `
 		INSERT INTO user_invitations (id, organization_id, email, role, invited_by, token, expires_at, created_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		ON CONFLICT (organization_id, email) DO UPDATE SET
-			role = $4, invited_by = $5, token = $6, expires_at = $7, accepted_at = NULL
+			role = $4, invited_by = $5, token = $6, expires_at = $7, created_at = $8,
+			status = 'pending', accepted_at = NULL, declined_at = NULL, send_count = 0, last_sent_at = NULL
+		RETURNING id
 	`
 	if inv.ID == uuid.Nil {
 		inv.ID = uuid.New()
 	}
+	inv.Status = InvitationStatusPending
 	inv.CreatedAt = time.Now()
 
-	_, err := r.db.DB().ExecContext(ctx, query,
+	err := r.db.DB().QueryRowContext(ctx, query,
 		inv.ID, inv.OrganizationID, inv.Email, inv.Role, inv.InvitedBy, inv.Token, inv.ExpiresAt, inv.CreatedAt,
-	)
+	).Scan(&inv.ID)
 	if err != nil {
 		return fmt.Errorf("failed to create invitation: %w", err)
 	}
 	return nil
 }
 
-// GetInvitationByToken retrieves an invitation by token.
-func (r *UserRepository) GetInvitationByToken(ctx context.Context, token string) (*UserInvitation, error) {
-	query := `
-		SELECT id, organization_id, email, role, invited_by, token, expires_at, accepted_at, created_at
-		FROM user_invitations
-		WHERE token = $1
-	`
-	inv := &UserInvitation{}
-	err := r.db.DB().QueryRowContext(ctx, query, token).Scan(
-		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.InvitedBy, &inv.Token, &inv.ExpiresAt, &inv.AcceptedAt, &inv.CreatedAt,
-	)
+// GetInvitation retrieves an invitation by ID.
+func (r *UserRepository) GetInvitation(ctx context.Context, id uuid.UUID) (*UserInvitation, error) {
+	query := `SELECT ` + invitationColumns + ` FROM user_invitations WHERE id = $1`
+	return r.getInvitation(ctx, query, id)
+}
+
+// GetInvitationByToken retrieves an invitation by the hash of its token.
+func (r *UserRepository) GetInvitationByToken(ctx context.Context, tokenHash string) (*UserInvitation, error) {
+	query := `SELECT ` + invitationColumns + ` FROM user_invitations WHERE token = $1`
+	return r.getInvitation(ctx, query, tokenHash)
+}
+
+func (r *UserRepository) getInvitation(ctx context.Context, query string, args ...any) (*UserInvitation, error) {
+	inv, err := scanInvitation(r.db.DB().QueryRowContext(ctx, query, args...))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -190,16 +221,133 @@ func (r *UserRepository) GetInvitationByToken(ctx context.Context, token string)
 	return inv, nil
 }
 
-// AcceptInvitation marks an invitation as accepted.
-func (r *UserRepository) AcceptInvitation(ctx context.Context, id uuid.UUID) error {
-	query := `UPDATE user_invitations SET accepted_at = $2 WHERE id = $1`
-	_, err := r.db.DB().ExecContext(ctx, query, id, time.Now())
+// ListInvitations lists the invitations of an organization, newest first.
+func (r *UserRepository) ListInvitations(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*UserInvitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM user_invitations
+		WHERE organization_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.DB().QueryContext(ctx, query, orgID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	defer rows.Close()
+
+	var invs []*UserInvitation
+	for rows.Next() {
+		inv, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+		invs = append(invs, inv)
+	}
+	return invs, rows.Err()
+}
+
+// RecordInvitationSent records that a pending invitation was sent with a new
+// token, which replaces the previous one, and expiry.
+func (r *UserRepository) RecordInvitationSent(ctx context.Context, inv *UserInvitation) error {
+	query := `
+		UPDATE user_invitations
+		SET token = $2, expires_at = $3, send_count = send_count + 1, last_sent_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING send_count, last_sent_at
+	`
+	err := r.db.DB().QueryRowContext(ctx, query, inv.ID, inv.Token, inv.ExpiresAt).Scan(&inv.SendCount, &inv.LastSentAt)
 	if err != nil {
-		return fmt.Errorf("failed to accept invitation: %w", err)
+		return fmt.Errorf("failed to record invitation sent: %w", err)
 	}
 	return nil
 }
 
+// AcceptInvitation accepts a pending, unexpired invitation and adds the user
+// to its organization with its role. A user who already is a member keeps
+// their role. It reports false, without changing anything, if the invitation
+// is no longer pending or has expired.
+func (r *UserRepository) AcceptInvitation(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var orgID uuid.UUID
+	var role string
+	err = tx.QueryRowContext(ctx, `
+		UPDATE user_invitations SET status = 'accepted', accepted_at = NOW()
+		WHERE id = $1 AND status = 'pending' AND expires_at > NOW()
+		RETURNING organization_id, role
+	`, id).Scan(&orgID, &role)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_members (id, organization_id, user_id, role, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (organization_id, user_id) DO NOTHING
+	`, uuid.New(), orgID, userID, role)
+	if err != nil {
+		return false, fmt.Errorf("failed to add organization member: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	return true, nil
+}
+
+// DeclineInvitation declines a pending invitation. It reports false if the
+// invitation is no longer pending.
+func (r *UserRepository) DeclineInvitation(ctx context.Context, id uuid.UUID) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		UPDATE user_invitations SET status = 'declined', declined_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to decline invitation: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to decline invitation: %w", err)
+	}
+	return n > 0, nil
+}
+
+// DeleteInvitation deletes an invitation. It reports whether the invitation
+// existed.
+func (r *UserRepository) DeleteInvitation(ctx context.Context, id uuid.UUID) (bool, error) {
+	result, err := r.db.DB().ExecContext(ctx, `DELETE FROM user_invitations WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete invitation: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete invitation: %w", err)
+	}
+	return n > 0, nil
+}
+
+// DeleteExpiredInvitations deletes the invitations that were not accepted and
+// expired before the given time. It returns how many it deleted.
+func (r *UserRepository) DeleteExpiredInvitations(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.DB().ExecContext(ctx, `
+		DELETE FROM user_invitations WHERE status <> 'accepted' AND expires_at < $1
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired invitations: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired invitations: %w", err)
+	}
+	return n, nil
+}
+
 // SetAccountRole sets a user's role in an organization.
 func (r *UserRepository) SetAccountRole(ctx context.Context, role *UserAccountRole) error {
 	query := `
@@ -0,0 +1,134 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"sort"
+)
+
+// canonicalize serializes the subtree rooted at apex using Exclusive XML
+// Canonicalization 1.0 without comments
+// (http://www.w3.org/2001/10/xml-exc-c14n#). The omit element, if set, is
+// left out, as the enveloped-signature transform requires. inclusive lists
+// prefixes ("#default" for the default namespace) that are rendered as in
+// inclusive canonicalization.
+func canonicalize(apex, omit *element, inclusive []string) []byte {
+	c := &canonicalizer{omit: omit, inclusive: inclusive}
+	c.element(apex, map[string]string{})
+	return c.buf.Bytes()
+}
+
+type canonicalizer struct {
+	buf       bytes.Buffer
+	omit      *element
+	inclusive []string
+}
+
+type namespaceDecl struct {
+	prefix, uri string
+}
+
+type canonicalAttr struct {
+	uri, name string
+	attr      xml.Attr
+}
+
+func (c *canonicalizer) element(e *element, rendered map[string]string) {
+	// Namespaces visibly utilized by the element and its attributes, plus
+	// the inclusive ones, are rendered unless an output ancestor already
+	// declared them with the same value.
+	utilized := []string{e.prefix}
+	var attrs []canonicalAttr
+	for _, a := range e.attrs {
+		if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+			continue
+		}
+		uri := ""
+		if a.Name.Space != "" {
+			uri, _ = e.lookupNamespace(a.Name.Space)
+			utilized = append(utilized, a.Name.Space)
+		}
+		attrs = append(attrs, canonicalAttr{uri: uri, name: a.Name.Local, attr: a})
+	}
+	for _, p := range c.inclusive {
+		if p == "#default" {
+			p = ""
+		}
+		utilized = append(utilized, p)
+	}
+
+	var decls []namespaceDecl
+	seen := map[string]bool{}
+	for _, p := range utilized {
+		if seen[p] || p == "xml" {
+			continue
+		}
+		seen[p] = true
+		uri, ok := e.lookupNamespace(p)
+		if !ok {
+			continue
+		}
+		prev, wasRendered := rendered[p]
+		if p == "" && !wasRendered && uri == "" {
+			continue
+		}
+		if wasRendered && prev == uri {
+			continue
+		}
+		decls = append(decls, namespaceDecl{prefix: p, uri: uri})
+	}
+	sort.Slice(decls, func(i, j int) bool { return decls[i].prefix < decls[j].prefix })
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].uri != attrs[j].uri {
+			return attrs[i].uri < attrs[j].uri
+		}
+		return attrs[i].name < attrs[j].name
+	})
+
+	if len(decls) > 0 {
+		scope := make(map[string]string, len(rendered)+len(decls))
+		for p, uri := range rendered {
+			scope[p] = uri
+		}
+		for _, d := range decls {
+			scope[d.prefix] = d.uri
+		}
+		rendered = scope
+	}
+
+	name := qualifiedName(e.prefix, e.local)
+	c.buf.WriteByte('<')
+	c.buf.WriteString(name)
+	for _, d := range decls {
+		if d.prefix == "" {
+			c.buf.WriteString(` xmlns="`)
+		} else {
+			c.buf.WriteString(` xmlns:`)
+			c.buf.WriteString(d.prefix)
+			c.buf.WriteString(`="`)
+		}
+		escapeAttr(&c.buf, d.uri)
+		c.buf.WriteByte('"')
+	}
+	for _, a := range attrs {
+		c.buf.WriteByte(' ')
+		c.buf.WriteString(qualifiedName(a.attr.Name.Space, a.attr.Name.Local))
+		c.buf.WriteString(`="`)
+		escapeAttr(&c.buf, a.attr.Value)
+		c.buf.WriteByte('"')
+	}
+	c.buf.WriteByte('>')
+	for _, child := range e.children {
+		switch child := child.(type) {
+		case *element:
+			if child != c.omit {
+				c.element(child, rendered)
+			}
+		case string:
+			escapeText(&c.buf, child)
+		}
+	}
+	c.buf.WriteString("</")
+	c.buf.WriteString(name)
+	c.buf.WriteByte('>')
+}
@@ -0,0 +1,62 @@
+package saml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		doc       string
+		apexID    string
+		inclusive []string
+		want      string
+	}{
+		{
+			name: "empty elements, attribute order and escaping",
+			doc:  `<a z="1" b="x&amp;&quot;y&#9;"><e/>t&gt;&lt;&amp;</a>`,
+			want: `<a b="x&amp;&quot;y&#x9;" z="1"><e></e>t&gt;&lt;&amp;</a>`,
+		},
+		{
+			name:   "only utilized namespaces of ancestors",
+			doc:    `<r:root xmlns:r="urn:r" xmlns:s="urn:s" xmlns:u="urn:unused"><s:child ID="c" r:a="1" b="2"><s:x/></s:child></r:root>`,
+			apexID: "c",
+			want:   `<s:child xmlns:r="urn:r" xmlns:s="urn:s" ID="c" b="2" r:a="1"><s:x></s:x></s:child>`,
+		},
+		{
+			name: "redundant declarations dropped",
+			doc:  `<a xmlns="urn:d" xmlns:p="urn:p"><p:b xmlns:p="urn:p"><c xmlns="urn:d"/></p:b></a>`,
+			want: `<a xmlns="urn:d"><p:b xmlns:p="urn:p"><c></c></p:b></a>`,
+		},
+		{
+			name: "default namespace undeclared",
+			doc:  `<a xmlns="urn:d"><b xmlns=""/></a>`,
+			want: `<a xmlns="urn:d"><b xmlns=""></b></a>`,
+		},
+		{
+			name:      "inclusive prefixes",
+			doc:       `<r xmlns:xs="urn:xs" xmlns:xsi="urn:xsi"><v ID="v" xsi:type="xs:string">1</v></r>`,
+			apexID:    "v",
+			inclusive: []string{"xs"},
+			want:      `<v xmlns:xs="urn:xs" xmlns:xsi="urn:xsi" ID="v" xsi:type="xs:string">1</v>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			root, err := parseXML([]byte(tc.doc))
+			require.NoError(t, err)
+			apex := root
+			if tc.apexID != "" {
+				apex = root.findByID(tc.apexID)
+				require.NotNil(t, apex)
+			}
+			require.Equal(t, tc.want, string(canonicalize(apex, nil, tc.inclusive)))
+		})
+	}
+}
+
+func TestParseXMLRejectsDTD(t *testing.T) {
+	_, err := parseXML([]byte(`<!DOCTYPE a [<!ENTITY x "y">]><a>&x;</a>`))
+	require.Error(t, err)
+}
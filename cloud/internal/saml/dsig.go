@@ -0,0 +1,215 @@
+package saml
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	// Register the digests used by supported signature algorithms.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// XML signature namespaces and algorithms. Only exclusive canonicalization
+// and RSA with SHA-256 or SHA-512 are accepted.
+const (
+	nsDSig    = "http://www.w3.org/2000/09/xmldsig#"
+	nsExcC14N = "http://www.w3.org/2001/10/xml-exc-c14n#"
+
+	algExcC14N      = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	algEnveloped    = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	algRSASHA256    = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algRSASHA512    = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	algDigestSHA256 = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algDigestSHA512 = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+var (
+	signatureAlgorithms = map[string]crypto.Hash{algRSASHA256: crypto.SHA256, algRSASHA512: crypto.SHA512}
+	digestAlgorithms    = map[string]crypto.Hash{algDigestSHA256: crypto.SHA256, algDigestSHA512: crypto.SHA512}
+)
+
+// errNotSigned is returned by verifySignature if the element has no
+// signature.
+var errNotSigned = errors.New("element is not signed")
+
+// verifySignature checks the enveloped XML signature of e against the
+// trusted certificates. The signature must reference e itself by its ID, so
+// that what was verified is the element the caller goes on to read.
+func verifySignature(e *element, certs []*x509.Certificate) error {
+	sigs := e.childElements(nsDSig, "Signature")
+	switch len(sigs) {
+	case 0:
+		return errNotSigned
+	case 1:
+	default:
+		return errors.New("multiple signatures")
+	}
+	sig := sigs[0]
+
+	signedInfo := sig.child(nsDSig, "SignedInfo")
+	if signedInfo == nil {
+		return errors.New("missing SignedInfo")
+	}
+	canonMethod := signedInfo.child(nsDSig, "CanonicalizationMethod")
+	if canonMethod == nil || canonMethod.attr("Algorithm") != algExcC14N {
+		return errors.New("unsupported canonicalization method")
+	}
+	sigMethod := signedInfo.child(nsDSig, "SignatureMethod")
+	if sigMethod == nil {
+		return errors.New("missing SignatureMethod")
+	}
+	sigHash, ok := signatureAlgorithms[sigMethod.attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("unsupported signature method %q", sigMethod.attr("Algorithm"))
+	}
+
+	ref := signedInfo.child(nsDSig, "Reference")
+	if ref == nil {
+		return errors.New("signature must have exactly one reference")
+	}
+	if id := e.attr("ID"); id == "" || ref.attr("URI") != "#"+id {
+		return errors.New("signature does not reference the signed element")
+	}
+	inclusive, err := referenceTransforms(ref)
+	if err != nil {
+		return err
+	}
+	digestMethod := ref.child(nsDSig, "DigestMethod")
+	if digestMethod == nil {
+		return errors.New("missing DigestMethod")
+	}
+	digestHash, ok := digestAlgorithms[digestMethod.attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("unsupported digest method %q", digestMethod.attr("Algorithm"))
+	}
+	digestValue := ref.child(nsDSig, "DigestValue")
+	if digestValue == nil {
+		return errors.New("missing DigestValue")
+	}
+	wantDigest, err := decodeBase64(digestValue.text())
+	if err != nil {
+		return fmt.Errorf("invalid DigestValue: %w", err)
+	}
+	h := digestHash.New()
+	h.Write(canonicalize(e, sig, inclusive))
+	if subtle.ConstantTimeCompare(h.Sum(nil), wantDigest) != 1 {
+		return errors.New("digest mismatch")
+	}
+
+	sigValue := sig.child(nsDSig, "SignatureValue")
+	if sigValue == nil {
+		return errors.New("missing SignatureValue")
+	}
+	signature, err := decodeBase64(sigValue.text())
+	if err != nil {
+		return fmt.Errorf("invalid SignatureValue: %w", err)
+	}
+	h = sigHash.New()
+	h.Write(canonicalize(signedInfo, nil, inclusivePrefixes(canonMethod)))
+	hashed := h.Sum(nil)
+	for _, cert := range certs {
+		key, ok := cert.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			continue
+		}
+		if rsa.VerifyPKCS1v15(key, sigHash, hashed, signature) == nil {
+			return nil
+		}
+	}
+	return errors.New("signature not made by a trusted certificate")
+}
+
+// referenceTransforms checks that the reference's transforms are the
+// enveloped-signature transform followed by exclusive canonicalization, and
+// returns the canonicalization's inclusive prefixes.
+func referenceTransforms(ref *element) ([]string, error) {
+	transforms := ref.child(nsDSig, "Transforms")
+	if transforms == nil {
+		return nil, errors.New("missing Transforms")
+	}
+	list := transforms.childElements(nsDSig, "Transform")
+	if len(list) != 2 || list[0].attr("Algorithm") != algEnveloped || list[1].attr("Algorithm") != algExcC14N {
+		return nil, errors.New("unsupported transforms")
+	}
+	return inclusivePrefixes(list[1]), nil
+}
+
+// inclusivePrefixes returns the InclusiveNamespaces PrefixList of an exclusive
+// canonicalization method or transform.
+func inclusivePrefixes(method *element) []string {
+	if in := method.child(nsExcC14N, "InclusiveNamespaces"); in != nil {
+		return strings.Fields(in.attr("PrefixList"))
+	}
+	return nil
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
+
+// Sign adds an enveloped RSA-SHA256 signature to the element of doc whose ID
+// attribute is id, placing it after the element's Issuer as SAML requires,
+// and returns the signed document. It exists for test identity providers;
+// the service provider only verifies signatures.
+func Sign(doc []byte, id string, key *rsa.PrivateKey, cert *x509.Certificate) ([]byte, error) {
+	root, err := parseXML(doc)
+	if err != nil {
+		return nil, err
+	}
+	e := root.findByID(id)
+	if e == nil {
+		return nil, fmt.Errorf("no element with ID %q", id)
+	}
+
+	digest := crypto.SHA256.New()
+	digest.Write(canonicalize(e, nil, nil))
+	signedInfo := `<ds:SignedInfo>` +
+		`<ds:CanonicalizationMethod Algorithm="` + algExcC14N + `"/>` +
+		`<ds:SignatureMethod Algorithm="` + algRSASHA256 + `"/>` +
+		`<ds:Reference URI="#` + id + `"><ds:Transforms>` +
+		`<ds:Transform Algorithm="` + algEnveloped + `"/>` +
+		`<ds:Transform Algorithm="` + algExcC14N + `"/>` +
+		`</ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="` + algDigestSHA256 + `"/>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest.Sum(nil)) + `</ds:DigestValue>` +
+		`</ds:Reference></ds:SignedInfo>`
+	sigDoc := `<ds:Signature xmlns:ds="` + nsDSig + `">` + signedInfo + `</ds:Signature>`
+	sig, err := parseXML([]byte(sigDoc))
+	if err != nil {
+		return nil, err
+	}
+	hashed := crypto.SHA256.New()
+	hashed.Write(canonicalize(sig.child(nsDSig, "SignedInfo"), nil, nil))
+	value, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed.Sum(nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+	sigDoc = `<ds:Signature xmlns:ds="` + nsDSig + `">` + signedInfo +
+		`<ds:SignatureValue>` + base64.StdEncoding.EncodeToString(value) + `</ds:SignatureValue>` +
+		`<ds:KeyInfo><ds:X509Data><ds:X509Certificate>` + base64.StdEncoding.EncodeToString(cert.Raw) +
+		`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature>`
+	if sig, err = parseXML([]byte(sigDoc)); err != nil {
+		return nil, err
+	}
+
+	pos := 0
+	for i, c := range e.children {
+		if ce, ok := c.(*element); ok && ce.is(nsAssertion, "Issuer") {
+			pos = i + 1
+			break
+		}
+	}
+	e.insertChild(pos, sig)
+
+	var out bytes.Buffer
+	root.render(&out)
+	return out.Bytes(), nil
+}
@@ -0,0 +1,130 @@
+package saml
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IdPMetadata is what the service provider needs to know about an identity
+// provider, taken from its SAML metadata.
+type IdPMetadata struct {
+	EntityID     string
+	SSOURL       string
+	Certificates []*x509.Certificate
+}
+
+type entityDescriptor struct {
+	XMLName          xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID         string   `xml:"entityID,attr"`
+	IDPSSODescriptor *struct {
+		KeyDescriptors []struct {
+			Use          string   `xml:"use,attr"`
+			Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+		SingleSignOnServices []struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+}
+
+// ParseMetadata parses an identity provider's SAML metadata. The IdP must
+// offer the HTTP-Redirect binding and at least one signing certificate.
+func ParseMetadata(data []byte) (*IdPMetadata, error) {
+	if _, err := parseXML(data); err != nil {
+		return nil, err
+	}
+	var ed entityDescriptor
+	if err := xml.Unmarshal(data, &ed); err != nil {
+		return nil, fmt.Errorf("invalid IdP metadata: %w", err)
+	}
+	if ed.EntityID == "" || ed.IDPSSODescriptor == nil {
+		return nil, errors.New("invalid IdP metadata: missing IDPSSODescriptor")
+	}
+
+	md := &IdPMetadata{EntityID: ed.EntityID}
+	for _, sso := range ed.IDPSSODescriptor.SingleSignOnServices {
+		if sso.Binding == BindingHTTPRedirect {
+			md.SSOURL = sso.Location
+			break
+		}
+	}
+	if md.SSOURL == "" {
+		return nil, errors.New("invalid IdP metadata: no HTTP-Redirect SingleSignOnService")
+	}
+	for _, kd := range ed.IDPSSODescriptor.KeyDescriptors {
+		if kd.Use != "" && kd.Use != "signing" {
+			continue
+		}
+		for _, c := range kd.Certificates {
+			der, err := decodeBase64(c)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IdP certificate: %w", err)
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IdP certificate: %w", err)
+			}
+			md.Certificates = append(md.Certificates, cert)
+		}
+	}
+	if len(md.Certificates) == 0 {
+		return nil, errors.New("invalid IdP metadata: no signing certificate")
+	}
+	return md, nil
+}
+
+// EncodeCertificates PEM-encodes certificates for storage.
+func EncodeCertificates(certs []*x509.Certificate) string {
+	var b strings.Builder
+	for _, cert := range certs {
+		_ = pem.Encode(&b, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	return b.String()
+}
+
+// ParseCertificates parses PEM-encoded certificates.
+func ParseCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+	return certs, nil
+}
+
+// Metadata returns the service provider's SAML metadata, for registration
+// with the identity provider.
+func (sp *ServiceProvider) Metadata() []byte {
+	var b bytes.Buffer
+	b.WriteString(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"`)
+	writeAttr(&b, "entityID", sp.EntityID)
+	b.WriteString(`><md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true"`)
+	writeAttr(&b, "protocolSupportEnumeration", nsProtocol)
+	b.WriteString(`><md:NameIDFormat>` + NameIDFormatEmail + `</md:NameIDFormat>`)
+	b.WriteString(`<md:AssertionConsumerService index="0" isDefault="true"`)
+	writeAttr(&b, "Binding", BindingHTTPPost)
+	writeAttr(&b, "Location", sp.ACSURL)
+	b.WriteString(`/></md:SPSSODescriptor></md:EntityDescriptor>`)
+	return b.Bytes()
+}
@@ -0,0 +1,308 @@
+// Package samltest provides a SAML identity provider for tests. It accepts
+// AuthnRequests over the HTTP-Redirect binding and answers with signed
+// responses for a configurable user, as a browser would see them.
+package samltest
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/cloud/internal/saml"
+)
+
+// User is the identity the IdP asserts.
+type User struct {
+	NameID     string
+	Attributes map[string][]string
+}
+
+// Response holds the contents of a SAML response before it is signed and
+// encoded. Tests may change any field to produce invalid responses.
+type Response struct {
+	ID           string
+	AssertionID  string
+	InResponseTo string
+	Destination  string
+	Issuer       string
+	Audience     string
+	IssueInstant time.Time
+	NotBefore    time.Time
+	NotOnOrAfter time.Time
+	User         User
+	// SignResponse and SignAssertion choose which elements are signed.
+	SignResponse  bool
+	SignAssertion bool
+}
+
+// IdP is a SAML identity provider served over HTTP.
+type IdP struct {
+	*httptest.Server
+
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+
+	mu   sync.Mutex
+	user User
+	now  func() time.Time
+}
+
+// NewIdP starts an identity provider that signs with a new self-signed key.
+// Close it when done.
+func NewIdP() *IdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "samltest IdP"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+
+	idp := &IdP{key: key, cert: cert, now: time.Now}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metadata", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/samlmetadata+xml")
+		_, _ = w.Write(idp.Metadata())
+	})
+	mux.HandleFunc("/sso", idp.handleSSO)
+	idp.Server = httptest.NewServer(mux)
+	return idp
+}
+
+// EntityID returns the IdP's entity ID.
+func (i *IdP) EntityID() string {
+	return i.URL + "/metadata"
+}
+
+// SSOURL returns the IdP's single sign-on endpoint.
+func (i *IdP) SSOURL() string {
+	return i.URL + "/sso"
+}
+
+// Certificate returns the IdP's signing certificate.
+func (i *IdP) Certificate() *x509.Certificate {
+	return i.cert
+}
+
+// SetUser sets the user the IdP logs in.
+func (i *IdP) SetUser(u User) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.user = u
+}
+
+// SetClock overrides the IdP's clock.
+func (i *IdP) SetClock(now func() time.Time) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.now = now
+}
+
+// Metadata returns the IdP's SAML metadata.
+func (i *IdP) Metadata() []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">`, xmlEscape(i.EntityID()))
+	b.WriteString(`<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">`)
+	b.WriteString(`<md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>`)
+	b.WriteString(base64.StdEncoding.EncodeToString(i.cert.Raw))
+	b.WriteString(`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>`)
+	fmt.Fprintf(&b, `<md:NameIDFormat>%s</md:NameIDFormat>`, saml.NameIDFormatEmail)
+	fmt.Fprintf(&b, `<md:SingleSignOnService Binding="%s" Location="%s"/>`, saml.BindingHTTPRedirect, xmlEscape(i.SSOURL()))
+	b.WriteString(`</md:IDPSSODescriptor></md:EntityDescriptor>`)
+	return b.Bytes()
+}
+
+// AuthnRequest is the part of an AuthnRequest the IdP acts on.
+type AuthnRequest struct {
+	ID       string `xml:"ID,attr"`
+	ACSURL   string `xml:"AssertionConsumerServiceURL,attr"`
+	Issuer   string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Relay    string `xml:"-"`
+	Original string `xml:"-"`
+}
+
+// ParseAuthnRequestURL decodes the AuthnRequest carried by an HTTP-Redirect
+// binding URL.
+func ParseAuthnRequestURL(authnURL string) (*AuthnRequest, error) {
+	u, err := url.Parse(authnURL)
+	if err != nil {
+		return nil, err
+	}
+	deflated, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAMLRequest: %w", err)
+	}
+	raw, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAMLRequest: %w", err)
+	}
+	req := &AuthnRequest{Relay: u.Query().Get("RelayState"), Original: string(raw)}
+	if err := xml.Unmarshal(raw, req); err != nil {
+		return nil, fmt.Errorf("invalid AuthnRequest: %w", err)
+	}
+	return req, nil
+}
+
+// NewResponse returns a valid, assertion-signed response to req for the
+// current user.
+func (i *IdP) NewResponse(req *AuthnRequest) *Response {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	now := i.now()
+	return &Response{
+		ID:            newID(),
+		AssertionID:   newID(),
+		InResponseTo:  req.ID,
+		Destination:   req.ACSURL,
+		Issuer:        i.EntityID(),
+		Audience:      req.Issuer,
+		IssueInstant:  now,
+		NotBefore:     now.Add(-time.Minute),
+		NotOnOrAfter:  now.Add(5 * time.Minute),
+		User:          i.user,
+		SignAssertion: true,
+	}
+}
+
+// Encode signs r as requested and returns it base64-encoded, as posted to
+// the assertion consumer service.
+func (i *IdP) Encode(r *Response) (string, error) {
+	doc := []byte(r.xml())
+	var err error
+	if r.SignAssertion {
+		if doc, err = saml.Sign(doc, r.AssertionID, i.key, i.cert); err != nil {
+			return "", err
+		}
+	}
+	if r.SignResponse {
+		if doc, err = saml.Sign(doc, r.ID, i.key, i.cert); err != nil {
+			return "", err
+		}
+	}
+	return base64.StdEncoding.EncodeToString(doc), nil
+}
+
+// Login follows an AuthnRequest URL as a browser would and returns the
+// SAMLResponse and RelayState the IdP posts back.
+func (i *IdP) Login(authnURL string) (samlResponse, relayState string, err error) {
+	resp, err := i.Client().Get(authnURL)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("IdP login failed: %s: %s", resp.Status, body)
+	}
+	fields := map[string]string{}
+	for _, m := range formInput.FindAllStringSubmatch(string(body), -1) {
+		fields[m[1]] = html.UnescapeString(m[2])
+	}
+	return fields["SAMLResponse"], fields["RelayState"], nil
+}
+
+var formInput = regexp.MustCompile(`<input type="hidden" name="(\w+)" value="([^"]*)"`)
+
+var postForm = template.Must(template.New("post").Parse(`<!DOCTYPE html>
+<html><body onload="document.forms[0].submit()">
+<form method="POST" action="{{.ACSURL}}">
+<input type="hidden" name="SAMLResponse" value="{{.SAMLResponse}}">
+<input type="hidden" name="RelayState" value="{{.RelayState}}">
+</form></body></html>
+`))
+
+func (i *IdP) handleSSO(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseAuthnRequestURL(r.URL.String())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	encoded, err := i.Encode(i.NewResponse(req))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = postForm.Execute(w, map[string]string{
+		"ACSURL":       req.ACSURL,
+		"SAMLResponse": encoded,
+		"RelayState":   req.Relay,
+	})
+}
+
+func (r *Response) xml() string {
+	ts := func(t time.Time) string { return t.UTC().Format(time.RFC3339) }
+	var b strings.Builder
+	fmt.Fprintf(&b, `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="%s" Version="2.0" IssueInstant="%s" Destination="%s" InResponseTo="%s">`,
+		r.ID, ts(r.IssueInstant), xmlEscape(r.Destination), xmlEscape(r.InResponseTo))
+	fmt.Fprintf(&b, `<saml:Issuer>%s</saml:Issuer>`, xmlEscape(r.Issuer))
+	b.WriteString(`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>`)
+	fmt.Fprintf(&b, `<saml:Assertion xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" ID="%s" Version="2.0" IssueInstant="%s">`,
+		r.AssertionID, ts(r.IssueInstant))
+	fmt.Fprintf(&b, `<saml:Issuer>%s</saml:Issuer>`, xmlEscape(r.Issuer))
+	fmt.Fprintf(&b, `<saml:Subject><saml:NameID Format="%s">%s</saml:NameID>`, saml.NameIDFormatEmail, xmlEscape(r.User.NameID))
+	fmt.Fprintf(&b, `<saml:SubjectConfirmation Method="%s"><saml:SubjectConfirmationData InResponseTo="%s" Recipient="%s" NotOnOrAfter="%s"/></saml:SubjectConfirmation></saml:Subject>`,
+		saml.MethodBearer, xmlEscape(r.InResponseTo), xmlEscape(r.Destination), ts(r.NotOnOrAfter))
+	fmt.Fprintf(&b, `<saml:Conditions NotBefore="%s" NotOnOrAfter="%s"><saml:AudienceRestriction><saml:Audience>%s</saml:Audience></saml:AudienceRestriction></saml:Conditions>`,
+		ts(r.NotBefore), ts(r.NotOnOrAfter), xmlEscape(r.Audience))
+	fmt.Fprintf(&b, `<saml:AuthnStatement AuthnInstant="%s" SessionIndex="%s"><saml:AuthnContext><saml:AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport</saml:AuthnContextClassRef></saml:AuthnContext></saml:AuthnStatement>`,
+		ts(r.IssueInstant), r.AssertionID)
+	if len(r.User.Attributes) > 0 {
+		names := make([]string, 0, len(r.User.Attributes))
+		for name := range r.User.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		b.WriteString(`<saml:AttributeStatement>`)
+		for _, name := range names {
+			fmt.Fprintf(&b, `<saml:Attribute Name="%s">`, xmlEscape(name))
+			for _, v := range r.User.Attributes[name] {
+				fmt.Fprintf(&b, `<saml:AttributeValue xsi:type="xs:string">%s</saml:AttributeValue>`, xmlEscape(v))
+			}
+			b.WriteString(`</saml:Attribute>`)
+		}
+		b.WriteString(`</saml:AttributeStatement>`)
+	}
+	b.WriteString(`</saml:Assertion></samlp:Response>`)
+	return b.String()
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return "_" + hex.EncodeToString(b)
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
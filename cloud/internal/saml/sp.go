@@ -0,0 +1,295 @@
+// Package saml implements the service provider side of SAML 2.0 web browser
+// SSO: SP-initiated logins sent over the HTTP-Redirect binding and signed
+// responses received over the HTTP-POST binding.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SAML namespaces, bindings and identifiers.
+const (
+	nsAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	BindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	NameIDFormatEmail   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	StatusSuccess       = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	MethodBearer        = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+)
+
+// DefaultClockSkew is the clock skew tolerated between the service provider
+// and identity providers when checking validity windows.
+const DefaultClockSkew = 3 * time.Minute
+
+// ErrInvalidResponse is wrapped by all errors that reject a SAML response.
+var ErrInvalidResponse = errors.New("invalid SAML response")
+
+// ServiceProvider is one organization's SAML service provider, trusting one
+// identity provider.
+type ServiceProvider struct {
+	EntityID  string
+	ACSURL    string
+	IdP       IdPMetadata
+	ClockSkew time.Duration
+}
+
+// Assertion is the validated content of a SAML assertion.
+type Assertion struct {
+	ID           string
+	Issuer       string
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	// Attributes maps attribute names, and friendly names where given, to
+	// their values.
+	Attributes map[string][]string
+}
+
+// Attribute returns the first value of the named attribute.
+func (a *Assertion) Attribute(name string) string {
+	if v := a.Attributes[name]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// NewRequestID returns a random ID for an AuthnRequest.
+func NewRequestID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	// IDs must be XML names, which cannot start with a digit.
+	return "id" + hex.EncodeToString(b), nil
+}
+
+// AuthnRequestURL returns the identity provider URL that starts a login with
+// an AuthnRequest of the given ID, using the HTTP-Redirect binding.
+func (sp *ServiceProvider) AuthnRequestURL(requestID, relayState string, now time.Time) (string, error) {
+	var req bytes.Buffer
+	req.WriteString(`<samlp:AuthnRequest xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssertion + `"`)
+	writeAttr(&req, "ID", requestID)
+	writeAttr(&req, "Version", "2.0")
+	writeAttr(&req, "IssueInstant", now.UTC().Format(time.RFC3339))
+	writeAttr(&req, "Destination", sp.IdP.SSOURL)
+	writeAttr(&req, "AssertionConsumerServiceURL", sp.ACSURL)
+	writeAttr(&req, "ProtocolBinding", BindingHTTPPost)
+	req.WriteString(`><saml:Issuer>`)
+	escapeText(&req, sp.EntityID)
+	req.WriteString(`</saml:Issuer><samlp:NameIDPolicy`)
+	writeAttr(&req, "Format", NameIDFormatEmail)
+	writeAttr(&req, "AllowCreate", "true")
+	req.WriteString(`/></samlp:AuthnRequest>`)
+
+	var deflated bytes.Buffer
+	w, _ := flate.NewWriter(&deflated, flate.BestCompression)
+	if _, err := w.Write(req.Bytes()); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(sp.IdP.SSOURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid IdP SSO URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("SAMLRequest", base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	if relayState != "" {
+		q.Set("RelayState", relayState)
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func writeAttr(w *bytes.Buffer, name, value string) {
+	w.WriteString(" " + name + `="`)
+	escapeAttr(w, value)
+	w.WriteByte('"')
+}
+
+// ParseResponse validates a base64-encoded SAML response received at the
+// assertion consumer service in reply to the AuthnRequest with the given ID,
+// and returns its assertion. The response or the assertion must be signed by
+// the identity provider; unsolicited responses are rejected.
+func (sp *ServiceProvider) ParseResponse(encoded, requestID string, now time.Time) (*Assertion, error) {
+	a, err := sp.parseResponse(encoded, requestID, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	return a, nil
+}
+
+func (sp *ServiceProvider) parseResponse(encoded, requestID string, now time.Time) (*Assertion, error) {
+	raw, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encoding: %w", err)
+	}
+	resp, err := parseXML(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.is(nsProtocol, "Response") || resp.attr("Version") != "2.0" {
+		return nil, errors.New("not a SAML 2.0 response")
+	}
+	if requestID == "" || resp.attr("InResponseTo") != requestID {
+		return nil, errors.New("response is not for this login")
+	}
+	if dest := resp.attr("Destination"); dest != "" && dest != sp.ACSURL {
+		return nil, fmt.Errorf("response destination %q is not this service provider", dest)
+	}
+	if issuer := resp.child(nsAssertion, "Issuer"); issuer != nil && strings.TrimSpace(issuer.text()) != sp.IdP.EntityID {
+		return nil, errors.New("response is not from the configured identity provider")
+	}
+	status := resp.child(nsProtocol, "Status")
+	if status == nil {
+		return nil, errors.New("missing status")
+	}
+	if code := status.child(nsProtocol, "StatusCode"); code == nil || code.attr("Value") != StatusSuccess {
+		return nil, errors.New("login failed at the identity provider")
+	}
+	if len(resp.childElements(nsAssertion, "EncryptedAssertion")) > 0 {
+		return nil, errors.New("encrypted assertions are not supported")
+	}
+	assertion := resp.child(nsAssertion, "Assertion")
+	if assertion == nil {
+		return nil, errors.New("response must contain exactly one assertion")
+	}
+
+	// Either signature covers the assertion; any signature present must be
+	// valid.
+	signed := false
+	for _, e := range []*element{resp, assertion} {
+		switch err := verifySignature(e, sp.IdP.Certificates); {
+		case err == nil:
+			signed = true
+		case !errors.Is(err, errNotSigned):
+			return nil, fmt.Errorf("invalid signature on %s: %w", e.local, err)
+		}
+	}
+	if !signed {
+		return nil, errors.New("assertion is not signed")
+	}
+
+	return sp.readAssertion(assertion, requestID, now)
+}
+
+func (sp *ServiceProvider) readAssertion(e *element, requestID string, now time.Time) (*Assertion, error) {
+	a := &Assertion{ID: e.attr("ID"), Attributes: map[string][]string{}}
+	issuer := e.child(nsAssertion, "Issuer")
+	if issuer == nil || strings.TrimSpace(issuer.text()) != sp.IdP.EntityID {
+		return nil, errors.New("assertion is not from the configured identity provider")
+	}
+	a.Issuer = sp.IdP.EntityID
+
+	subject := e.child(nsAssertion, "Subject")
+	if subject == nil {
+		return nil, errors.New("missing subject")
+	}
+	nameID := subject.child(nsAssertion, "NameID")
+	if nameID == nil || strings.TrimSpace(nameID.text()) == "" {
+		return nil, errors.New("missing NameID")
+	}
+	a.NameID = strings.TrimSpace(nameID.text())
+	a.NameIDFormat = nameID.attr("Format")
+	if err := sp.checkSubjectConfirmation(subject, requestID, now); err != nil {
+		return nil, err
+	}
+
+	conditions := e.child(nsAssertion, "Conditions")
+	if conditions == nil {
+		return nil, errors.New("missing conditions")
+	}
+	if err := sp.checkWindow(conditions, now); err != nil {
+		return nil, err
+	}
+	for _, restriction := range conditions.childElements(nsAssertion, "AudienceRestriction") {
+		found := false
+		for _, audience := range restriction.childElements(nsAssertion, "Audience") {
+			if strings.TrimSpace(audience.text()) == sp.EntityID {
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.New("assertion is not intended for this service provider")
+		}
+	}
+
+	if authn := e.child(nsAssertion, "AuthnStatement"); authn != nil {
+		a.SessionIndex = authn.attr("SessionIndex")
+	}
+	for _, statement := range e.childElements(nsAssertion, "AttributeStatement") {
+		for _, attr := range statement.childElements(nsAssertion, "Attribute") {
+			var values []string
+			for _, v := range attr.childElements(nsAssertion, "AttributeValue") {
+				values = append(values, strings.TrimSpace(v.text()))
+			}
+			a.Attributes[attr.attr("Name")] = append(a.Attributes[attr.attr("Name")], values...)
+			if friendly := attr.attr("FriendlyName"); friendly != "" && friendly != attr.attr("Name") {
+				a.Attributes[friendly] = append(a.Attributes[friendly], values...)
+			}
+		}
+	}
+	return a, nil
+}
+
+// checkSubjectConfirmation requires a bearer confirmation for this service
+// provider and login that has not expired.
+func (sp *ServiceProvider) checkSubjectConfirmation(subject *element, requestID string, now time.Time) error {
+	for _, sc := range subject.childElements(nsAssertion, "SubjectConfirmation") {
+		if sc.attr("Method") != MethodBearer {
+			continue
+		}
+		data := sc.child(nsAssertion, "SubjectConfirmationData")
+		if data == nil || data.attr("Recipient") != sp.ACSURL {
+			continue
+		}
+		if irt := data.attr("InResponseTo"); irt != "" && irt != requestID {
+			continue
+		}
+		if sp.checkWindow(data, now) != nil || data.attr("NotOnOrAfter") == "" {
+			continue
+		}
+		return nil
+	}
+	return errors.New("no valid bearer subject confirmation")
+}
+
+// checkWindow checks the NotBefore and NotOnOrAfter attributes of e.
+func (sp *ServiceProvider) checkWindow(e *element, now time.Time) error {
+	skew := sp.ClockSkew
+	if skew == 0 {
+		skew = DefaultClockSkew
+	}
+	if v := e.attr("NotBefore"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid NotBefore: %w", err)
+		}
+		if now.Add(skew).Before(t) {
+			return errors.New("assertion is not yet valid")
+		}
+	}
+	if v := e.attr("NotOnOrAfter"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid NotOnOrAfter: %w", err)
+		}
+		if !now.Add(-skew).Before(t) {
+			return errors.New("assertion has expired")
+		}
+	}
+	return nil
+}
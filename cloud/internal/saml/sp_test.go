@@ -0,0 +1,232 @@
+package saml_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/cloud/internal/saml"
+	"go.temporal.io/cloud/internal/saml/samltest"
+)
+
+const (
+	spEntityID = "https://cloud.example.com/saml/acme"
+	spACSURL   = "https://cloud.example.com/auth/saml/acs"
+)
+
+func newServiceProvider(t *testing.T) (*samltest.IdP, *saml.ServiceProvider) {
+	idp := samltest.NewIdP()
+	t.Cleanup(idp.Close)
+	idp.SetUser(samltest.User{
+		NameID: "alice@example.com",
+		Attributes: map[string][]string{
+			"email":  {"alice@example.com"},
+			"groups": {"engineering", "temporal-admins"},
+		},
+	})
+	md, err := saml.ParseMetadata(idp.Metadata())
+	require.NoError(t, err)
+	return idp, &saml.ServiceProvider{EntityID: spEntityID, ACSURL: spACSURL, IdP: *md}
+}
+
+// newResponse starts a login and returns the IdP's unencoded response to it.
+func newResponse(t *testing.T, idp *samltest.IdP, sp *saml.ServiceProvider) (string, *samltest.Response) {
+	requestID, err := saml.NewRequestID()
+	require.NoError(t, err)
+	authnURL, err := sp.AuthnRequestURL(requestID, "relay", time.Now())
+	require.NoError(t, err)
+	req, err := samltest.ParseAuthnRequestURL(authnURL)
+	require.NoError(t, err)
+	return requestID, idp.NewResponse(req)
+}
+
+func TestParseMetadata(t *testing.T) {
+	idp, sp := newServiceProvider(t)
+	require.Equal(t, idp.EntityID(), sp.IdP.EntityID)
+	require.Equal(t, idp.SSOURL(), sp.IdP.SSOURL)
+	require.Len(t, sp.IdP.Certificates, 1)
+	require.True(t, sp.IdP.Certificates[0].Equal(idp.Certificate()))
+
+	certs, err := saml.ParseCertificates(saml.EncodeCertificates(sp.IdP.Certificates))
+	require.NoError(t, err)
+	require.True(t, certs[0].Equal(idp.Certificate()))
+
+	_, err = saml.ParseMetadata([]byte(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="x"/>`))
+	require.Error(t, err)
+}
+
+func TestLogin(t *testing.T) {
+	idp, sp := newServiceProvider(t)
+	requestID, err := saml.NewRequestID()
+	require.NoError(t, err)
+	authnURL, err := sp.AuthnRequestURL(requestID, "relay-123", time.Now())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(authnURL, idp.SSOURL()+"?"))
+
+	req, err := samltest.ParseAuthnRequestURL(authnURL)
+	require.NoError(t, err)
+	require.Equal(t, requestID, req.ID)
+	require.Equal(t, spACSURL, req.ACSURL)
+	require.Equal(t, spEntityID, req.Issuer)
+
+	samlResponse, relayState, err := idp.Login(authnURL)
+	require.NoError(t, err)
+	require.Equal(t, "relay-123", relayState)
+
+	a, err := sp.ParseResponse(samlResponse, requestID, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", a.NameID)
+	require.Equal(t, saml.NameIDFormatEmail, a.NameIDFormat)
+	require.Equal(t, idp.EntityID(), a.Issuer)
+	require.Equal(t, "alice@example.com", a.Attribute("email"))
+	require.Equal(t, []string{"engineering", "temporal-admins"}, a.Attributes["groups"])
+	require.Empty(t, a.Attribute("missing"))
+}
+
+func TestParseResponseSignatures(t *testing.T) {
+	idp, sp := newServiceProvider(t)
+	for _, tc := range []struct {
+		name           string
+		signResponse   bool
+		signAssertion  bool
+		wantErrContent string
+	}{
+		{name: "assertion signed", signAssertion: true},
+		{name: "response signed", signResponse: true},
+		{name: "both signed", signResponse: true, signAssertion: true},
+		{name: "unsigned", wantErrContent: "not signed"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			requestID, resp := newResponse(t, idp, sp)
+			resp.SignResponse, resp.SignAssertion = tc.signResponse, tc.signAssertion
+			encoded, err := idp.Encode(resp)
+			require.NoError(t, err)
+			_, err = sp.ParseResponse(encoded, requestID, time.Now())
+			if tc.wantErrContent == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, saml.ErrInvalidResponse)
+			require.ErrorContains(t, err, tc.wantErrContent)
+		})
+	}
+}
+
+func TestParseResponseRejects(t *testing.T) {
+	idp, sp := newServiceProvider(t)
+	now := time.Now()
+	for _, tc := range []struct {
+		name      string
+		modify    func(r *samltest.Response)
+		requestID string
+		tamper    func(doc string) string
+		now       time.Time
+		wantErr   string
+	}{
+		{
+			name:    "wrong audience",
+			modify:  func(r *samltest.Response) { r.Audience = "https://other.example.com" },
+			wantErr: "not intended for this service provider",
+		},
+		{
+			name:    "wrong recipient",
+			modify:  func(r *samltest.Response) { r.Destination = "https://other.example.com/acs" },
+			wantErr: "destination",
+		},
+		{
+			name:    "wrong issuer",
+			modify:  func(r *samltest.Response) { r.Issuer = "https://evil.example.com" },
+			wantErr: "not from the configured identity provider",
+		},
+		{
+			name:      "other login",
+			requestID: "id-other",
+			wantErr:   "not for this login",
+		},
+		{
+			name:    "expired",
+			now:     now.Add(time.Hour),
+			wantErr: "no valid bearer subject confirmation",
+		},
+		{
+			name: "not yet valid",
+			modify: func(r *samltest.Response) {
+				r.NotBefore = now.Add(10 * time.Minute)
+			},
+			wantErr: "not yet valid",
+		},
+		{
+			name: "tampered attribute",
+			tamper: func(doc string) string {
+				return strings.Replace(doc, "engineering", "temporal-owners", 1)
+			},
+			wantErr: "digest mismatch",
+		},
+		{
+			name: "tampered name ID",
+			tamper: func(doc string) string {
+				return strings.Replace(doc, ">alice@example.com<", ">mallory@example.com<", 1)
+			},
+			wantErr: "digest mismatch",
+		},
+		{
+			name: "injected unsigned assertion",
+			tamper: func(doc string) string {
+				i := strings.Index(doc, "<saml:Assertion")
+				j := strings.Index(doc, "</saml:Assertion>") + len("</saml:Assertion>")
+				return doc[:j] + doc[i:j] + doc[j:]
+			},
+			wantErr: "exactly one assertion",
+		},
+		{
+			name: "DTD",
+			tamper: func(doc string) string {
+				return `<!DOCTYPE x [<!ENTITY e "e">]>` + doc
+			},
+			wantErr: "DTD",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			requestID, resp := newResponse(t, idp, sp)
+			if tc.modify != nil {
+				tc.modify(resp)
+			}
+			encoded, err := idp.Encode(resp)
+			require.NoError(t, err)
+			if tc.tamper != nil {
+				raw, err := base64.StdEncoding.DecodeString(encoded)
+				require.NoError(t, err)
+				encoded = base64.StdEncoding.EncodeToString([]byte(tc.tamper(string(raw))))
+			}
+			if tc.requestID != "" {
+				requestID = tc.requestID
+			}
+			at := tc.now
+			if at.IsZero() {
+				at = now
+			}
+			_, err = sp.ParseResponse(encoded, requestID, at)
+			require.ErrorIs(t, err, saml.ErrInvalidResponse)
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestParseResponseUntrustedCertificate(t *testing.T) {
+	_, sp := newServiceProvider(t)
+	other := samltest.NewIdP()
+	defer other.Close()
+	other.SetUser(samltest.User{NameID: "alice@example.com"})
+
+	requestID, resp := newResponse(t, other, sp)
+	// Claim to be the configured IdP but sign with another key.
+	resp.Issuer = sp.IdP.EntityID
+	encoded, err := other.Encode(resp)
+	require.NoError(t, err)
+	_, err = sp.ParseResponse(encoded, requestID, time.Now())
+	require.ErrorIs(t, err, saml.ErrInvalidResponse)
+	require.ErrorContains(t, err, "not made by a trusted certificate")
+}
@@ -0,0 +1,231 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const nsXML = "http://www.w3.org/XML/1998/namespace"
+
+// element is a node of a parsed XML document. Names and attributes are kept
+// as written (prefix and local name) so that the document can be
+// canonicalized and signatures checked against exactly what was sent.
+type element struct {
+	parent   *element
+	prefix   string
+	local    string
+	attrs    []xml.Attr
+	children []any // *element or string (character data)
+}
+
+// parseXML parses an XML document into a tree. Comments and processing
+// instructions are dropped; DTDs are rejected.
+func parseXML(data []byte) (*element, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var root, cur *element
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid XML: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &element{parent: cur, prefix: t.Name.Space, local: t.Name.Local, attrs: t.Copy().Attr}
+			if cur != nil {
+				cur.children = append(cur.children, e)
+			} else if root != nil {
+				return nil, errors.New("invalid XML: multiple root elements")
+			} else {
+				root = e
+			}
+			cur = e
+		case xml.EndElement:
+			if cur == nil || t.Name.Space != cur.prefix || t.Name.Local != cur.local {
+				return nil, fmt.Errorf("invalid XML: unexpected end element %s", t.Name.Local)
+			}
+			cur = cur.parent
+		case xml.CharData:
+			if cur != nil {
+				cur.children = append(cur.children, string(t))
+			} else if len(bytes.TrimSpace(t)) > 0 {
+				return nil, errors.New("invalid XML: text outside the root element")
+			}
+		case xml.Directive:
+			return nil, errors.New("invalid XML: DTDs are not allowed")
+		}
+	}
+	if root == nil || cur != nil {
+		return nil, errors.New("invalid XML: incomplete document")
+	}
+	return root, nil
+}
+
+// lookupNamespace resolves a prefix ("" for the default namespace) in the
+// scope of e.
+func (e *element) lookupNamespace(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return nsXML, true
+	}
+	for n := e; n != nil; n = n.parent {
+		for _, a := range n.attrs {
+			if (prefix == "" && a.Name.Space == "" && a.Name.Local == "xmlns") ||
+				(prefix != "" && a.Name.Space == "xmlns" && a.Name.Local == prefix) {
+				return a.Value, true
+			}
+		}
+	}
+	return "", prefix == ""
+}
+
+// namespace returns the namespace URI of e.
+func (e *element) namespace() string {
+	ns, _ := e.lookupNamespace(e.prefix)
+	return ns
+}
+
+func (e *element) is(ns, local string) bool {
+	return e.local == local && e.namespace() == ns
+}
+
+// attr returns the value of the unqualified attribute name.
+func (e *element) attr(name string) string {
+	for _, a := range e.attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// children returns e's child elements with the given name.
+func (e *element) childElements(ns, local string) []*element {
+	var out []*element
+	for _, c := range e.children {
+		if ce, ok := c.(*element); ok && ce.is(ns, local) {
+			out = append(out, ce)
+		}
+	}
+	return out
+}
+
+// child returns e's only child element with the given name, or nil if there
+// is not exactly one.
+func (e *element) child(ns, local string) *element {
+	if c := e.childElements(ns, local); len(c) == 1 {
+		return c[0]
+	}
+	return nil
+}
+
+// text returns the character data directly inside e.
+func (e *element) text() string {
+	var b strings.Builder
+	for _, c := range e.children {
+		if s, ok := c.(string); ok {
+			b.WriteString(s)
+		}
+	}
+	return b.String()
+}
+
+// findByID returns the element in the tree rooted at e whose ID attribute is
+// id.
+func (e *element) findByID(id string) *element {
+	if e.attr("ID") == id {
+		return e
+	}
+	for _, c := range e.children {
+		if ce, ok := c.(*element); ok {
+			if found := ce.findByID(id); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// insertChild inserts c as e's child at position i (counting all nodes).
+func (e *element) insertChild(i int, c *element) {
+	c.parent = e
+	e.children = append(e.children[:i], append([]any{c}, e.children[i:]...)...)
+}
+
+// render serializes the tree rooted at e as written, keeping its namespace
+// declarations.
+func (e *element) render(w *bytes.Buffer) {
+	w.WriteByte('<')
+	w.WriteString(qualifiedName(e.prefix, e.local))
+	for _, a := range e.attrs {
+		w.WriteByte(' ')
+		w.WriteString(qualifiedName(a.Name.Space, a.Name.Local))
+		w.WriteString(`="`)
+		escapeAttr(w, a.Value)
+		w.WriteByte('"')
+	}
+	w.WriteByte('>')
+	for _, c := range e.children {
+		switch c := c.(type) {
+		case *element:
+			c.render(w)
+		case string:
+			escapeText(w, c)
+		}
+	}
+	w.WriteString("</")
+	w.WriteString(qualifiedName(e.prefix, e.local))
+	w.WriteByte('>')
+}
+
+func qualifiedName(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+// escapeText escapes character data as canonical XML requires.
+func escapeText(w *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			w.WriteString("&amp;")
+		case '<':
+			w.WriteString("&lt;")
+		case '>':
+			w.WriteString("&gt;")
+		case '\r':
+			w.WriteString("&#xD;")
+		default:
+			w.WriteRune(r)
+		}
+	}
+}
+
+// escapeAttr escapes an attribute value as canonical XML requires.
+func escapeAttr(w *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			w.WriteString("&amp;")
+		case '<':
+			w.WriteString("&lt;")
+		case '"':
+			w.WriteString("&quot;")
+		case '\t':
+			w.WriteString("&#x9;")
+		case '\n':
+			w.WriteString("&#xA;")
+		case '\r':
+			w.WriteString("&#xD;")
+		default:
+			w.WriteRune(r)
+		}
+	}
+}
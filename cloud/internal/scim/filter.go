@@ -0,0 +1,97 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Filter is an equality filter, `attribute eq "value"`, the only form of
+// filter identity providers use to look up users and groups.
+type Filter struct {
+	// Attribute is the attribute path, e.g. "userName" or "emails.value".
+	// Attribute names are case-insensitive; compare with strings.EqualFold.
+	Attribute string
+	Value     string
+}
+
+// ParseFilter parses a filter query parameter.
+func ParseFilter(s string) (*Filter, error) {
+	attr, rest, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok || attr == "" {
+		return nil, BadRequest(ErrorInvalidFilter, "invalid filter %q", s)
+	}
+	op, rest, ok := strings.Cut(strings.TrimSpace(rest), " ")
+	if !ok || !strings.EqualFold(op, "eq") {
+		return nil, BadRequest(ErrorInvalidFilter, "unsupported filter %q: only eq is supported", s)
+	}
+	value, err := filterValue(strings.TrimSpace(rest))
+	if err != nil {
+		return nil, BadRequest(ErrorInvalidFilter, "invalid filter %q: %v", s, err)
+	}
+	return &Filter{Attribute: attr, Value: value}, nil
+}
+
+// filterValue decodes a filter's comparison value, which must be a JSON
+// string; the filters this package supports compare strings only.
+func filterValue(s string) (string, error) {
+	var value string
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// Path is a PATCH operation path: `attribute`, `attribute.subAttribute`,
+// `attribute[filter]` or `attribute[filter].subAttribute`.
+type Path struct {
+	Attribute    string
+	SubAttribute string
+	// Filter selects values of a multi-valued attribute. Its Attribute is
+	// relative to them, e.g. "value" in `members[value eq "id"]`.
+	Filter *Filter
+}
+
+// ParsePath parses a PATCH path. Schema URN prefixes are dropped, so
+// "urn:ietf:params:scim:schemas:core:2.0:User:userName" parses as "userName".
+func ParsePath(s string) (*Path, error) {
+	rest := s
+	if strings.HasPrefix(strings.ToLower(rest), "urn:") {
+		i := strings.LastIndex(rest, ":")
+		if j := strings.Index(rest, "["); j >= 0 && j < i {
+			return nil, BadRequest(ErrorInvalidPath, "invalid path %q", s)
+		}
+		rest = rest[i+1:]
+	}
+
+	p := &Path{}
+	if i := strings.Index(rest, "["); i >= 0 {
+		j := strings.LastIndex(rest, "]")
+		if j < i {
+			return nil, BadRequest(ErrorInvalidPath, "invalid path %q", s)
+		}
+		filter, err := ParseFilter(rest[i+1 : j])
+		if err != nil {
+			return nil, BadRequest(ErrorInvalidPath, "invalid filter in path %q", s)
+		}
+		p.Filter = filter
+		p.Attribute = rest[:i]
+		rest = rest[j+1:]
+		if rest != "" {
+			if rest[0] != '.' {
+				return nil, BadRequest(ErrorInvalidPath, "invalid path %q", s)
+			}
+			p.SubAttribute = rest[1:]
+		}
+	} else {
+		p.Attribute, p.SubAttribute, _ = strings.Cut(rest, ".")
+	}
+	if p.Attribute == "" || strings.ContainsAny(p.Attribute+p.SubAttribute, " []\"") {
+		return nil, BadRequest(ErrorInvalidPath, "invalid path %q", s)
+	}
+	return p, nil
+}
+
+// Is reports whether the path addresses the named attribute, ignoring case.
+func (p *Path) Is(attribute string) bool {
+	return strings.EqualFold(p.Attribute, attribute)
+}
@@ -0,0 +1,112 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	f, err := ParseFilter(`userName eq "alice@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, &Filter{Attribute: "userName", Value: "alice@example.com"}, f)
+
+	f, err = ParseFilter(`  externalId EQ "a \"quoted\" id" `)
+	require.NoError(t, err)
+	require.Equal(t, &Filter{Attribute: "externalId", Value: `a "quoted" id`}, f)
+
+	for _, s := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName eq alice`,
+		`userName co "alice"`,
+		`userName eq "a" and active eq true`,
+	} {
+		_, err := ParseFilter(s)
+		var scimErr *Error
+		require.ErrorAs(t, err, &scimErr, s)
+		require.Equal(t, ErrorInvalidFilter, scimErr.ScimType)
+		require.Equal(t, http.StatusBadRequest, scimErr.StatusCode())
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	for _, tc := range []struct {
+		path string
+		want *Path
+	}{
+		{path: "active", want: &Path{Attribute: "active"}},
+		{path: "name.givenName", want: &Path{Attribute: "name", SubAttribute: "givenName"}},
+		{
+			path: `members[value eq "2819c223"]`,
+			want: &Path{Attribute: "members", Filter: &Filter{Attribute: "value", Value: "2819c223"}},
+		},
+		{
+			path: `emails[type eq "work"].value`,
+			want: &Path{Attribute: "emails", SubAttribute: "value", Filter: &Filter{Attribute: "type", Value: "work"}},
+		},
+		{
+			path: "urn:ietf:params:scim:schemas:core:2.0:User:userName",
+			want: &Path{Attribute: "userName"},
+		},
+	} {
+		got, err := ParsePath(tc.path)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.want, got, tc.path)
+	}
+
+	for _, path := range []string{``, `members[value eq "x"`, `members[value eq "x"]value`, `a b`, `members[value co "x"]`} {
+		_, err := ParsePath(path)
+		var scimErr *Error
+		require.ErrorAs(t, err, &scimErr, path)
+		require.Equal(t, ErrorInvalidPath, scimErr.ScimType, path)
+	}
+}
+
+func TestPatchRequestValidate(t *testing.T) {
+	valid := &PatchRequest{
+		Schemas: []string{SchemaPatchOp},
+		Operations: []PatchOperation{
+			{Op: "Replace", Path: "active", Value: json.RawMessage(`false`)},
+			{Op: "remove", Path: `members[value eq "x"]`},
+		},
+	}
+	require.NoError(t, valid.Validate())
+
+	for _, req := range []*PatchRequest{
+		{Operations: []PatchOperation{{Op: "add", Path: "active", Value: json.RawMessage(`true`)}}},
+		{Schemas: []string{SchemaPatchOp}, Operations: []PatchOperation{{Op: "add", Path: "active"}}},
+		{Schemas: []string{SchemaPatchOp}, Operations: []PatchOperation{{Op: "remove"}}},
+		{Schemas: []string{SchemaPatchOp}, Operations: []PatchOperation{{Op: "move", Path: "active"}}},
+	} {
+		require.Error(t, req.Validate())
+	}
+}
+
+func TestPatchValues(t *testing.T) {
+	for raw, want := range map[string]bool{`true`: true, `false`: false, `"False"`: false, `"true"`: true} {
+		got, err := Bool(json.RawMessage(raw))
+		require.NoError(t, err, raw)
+		require.Equal(t, want, got, raw)
+	}
+	_, err := Bool(json.RawMessage(`"yes please"`))
+	require.Error(t, err)
+
+	refs, err := References(json.RawMessage(`[{"value":"a"},{"value":"b","display":"B"}]`))
+	require.NoError(t, err)
+	require.Equal(t, []Reference{{Value: "a"}, {Value: "b", Display: "B"}}, refs)
+	refs, err = References(json.RawMessage(`{"value":"a"}`))
+	require.NoError(t, err)
+	require.Equal(t, []Reference{{Value: "a"}}, refs)
+	_, err = References(json.RawMessage(`"a"`))
+	require.Error(t, err)
+}
+
+func TestNameString(t *testing.T) {
+	require.Equal(t, "Alice Smith", (&Name{GivenName: "Alice", FamilyName: "Smith"}).String())
+	require.Equal(t, "Dr. Alice", (&Name{Formatted: "Dr. Alice", GivenName: "Alice"}).String())
+	require.Equal(t, "", (*Name)(nil).String())
+}
@@ -0,0 +1,286 @@
+// Package scim implements the wire format of SCIM 2.0 (RFC 7643 and RFC 7644)
+// for the User and Group resources: resource representations, list and error
+// responses, and the filter and PATCH path expressions identity providers
+// send.
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema URIs.
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// ContentType is the media type of SCIM requests and responses.
+const ContentType = "application/scim+json"
+
+// Error types, sent as scimType in 400 and 409 errors.
+const (
+	ErrorInvalidFilter = "invalidFilter"
+	ErrorInvalidPath   = "invalidPath"
+	ErrorInvalidSyntax = "invalidSyntax"
+	ErrorInvalidValue  = "invalidValue"
+	ErrorMutability    = "mutability"
+	ErrorNoTarget      = "noTarget"
+	ErrorUniqueness    = "uniqueness"
+)
+
+// Error is a SCIM error response. It is also returned as a Go error by the
+// parsing functions in this package.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// NewError returns a SCIM error with the given HTTP status.
+func NewError(status int, scimType, detail string) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+// BadRequest returns a 400 SCIM error.
+func BadRequest(scimType, format string, args ...any) *Error {
+	return NewError(http.StatusBadRequest, scimType, fmt.Sprintf(format, args...))
+}
+
+func (e *Error) Error() string {
+	if e.ScimType != "" {
+		return fmt.Sprintf("scim %s (%s): %s", e.Status, e.ScimType, e.Detail)
+	}
+	return fmt.Sprintf("scim %s: %s", e.Status, e.Detail)
+}
+
+// StatusCode returns the error's HTTP status.
+func (e *Error) StatusCode() int {
+	code, err := strconv.Atoi(e.Status)
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+	return code
+}
+
+// Meta is a resource's metadata.
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location,omitempty"`
+}
+
+// User is a User resource.
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	// Active is nil if the client did not send it.
+	Active *bool `json:"active,omitempty"`
+	// Groups is read-only.
+	Groups []Reference `json:"groups,omitempty"`
+	Meta   *Meta       `json:"meta,omitempty"`
+}
+
+// Name is a user's name.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// String returns the name for display: the formatted name, or else the given
+// and family names.
+func (n *Name) String() string {
+	if n == nil {
+		return ""
+	}
+	if n.Formatted != "" {
+		return n.Formatted
+	}
+	return strings.TrimSpace(n.GivenName + " " + n.FamilyName)
+}
+
+// Email is one of a user's email addresses.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Group is a Group resource.
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// Reference refers to another resource, such as a group member.
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// ListResponse is the response to a query.
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// NewListResponse returns a page of query results.
+func NewListResponse[T any](resources []T, total, startIndex int) *ListResponse {
+	resp := &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    make([]any, 0, len(resources)),
+	}
+	for _, r := range resources {
+		resp.Resources = append(resp.Resources, r)
+	}
+	return resp
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is one operation of a PATCH request.
+type PatchOperation struct {
+	// Op is "add", "remove" or "replace". Clients differ in case, so compare
+	// with strings.EqualFold.
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Validate checks the request's schema and operations.
+func (p *PatchRequest) Validate() error {
+	if !hasSchema(p.Schemas, SchemaPatchOp) {
+		return BadRequest(ErrorInvalidSyntax, "PATCH request must use the %s schema", SchemaPatchOp)
+	}
+	for _, op := range p.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if len(op.Value) == 0 {
+				return BadRequest(ErrorInvalidValue, "%s operation requires a value", op.Op)
+			}
+		case "remove":
+			if op.Path == "" {
+				return BadRequest(ErrorNoTarget, "remove operation requires a path")
+			}
+		default:
+			return BadRequest(ErrorInvalidSyntax, "unsupported PATCH operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+func hasSchema(schemas []string, schema string) bool {
+	for _, s := range schemas {
+		if s == schema {
+			return true
+		}
+	}
+	return false
+}
+
+// Bool decodes a boolean PATCH value. Some identity providers send booleans
+// as strings.
+func Bool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, BadRequest(ErrorInvalidValue, "expected a boolean, got %s", value)
+}
+
+// String decodes a string PATCH value.
+func String(value json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", BadRequest(ErrorInvalidValue, "expected a string, got %s", value)
+	}
+	return s, nil
+}
+
+// References decodes a PATCH value holding references, such as group
+// members. A single reference is accepted as well as a list.
+func References(value json.RawMessage) ([]Reference, error) {
+	var refs []Reference
+	if err := json.Unmarshal(value, &refs); err == nil {
+		return refs, nil
+	}
+	var ref Reference
+	if err := json.Unmarshal(value, &ref); err != nil || ref.Value == "" {
+		return nil, BadRequest(ErrorInvalidValue, "expected references, got %s", value)
+	}
+	return []Reference{ref}, nil
+}
+
+// Attributes decodes a PATCH value without a path, which holds the
+// attributes to set keyed by path.
+func Attributes(value json.RawMessage) (map[string]json.RawMessage, error) {
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(value, &attrs); err != nil {
+		return nil, BadRequest(ErrorInvalidValue, "operation without a path requires an object value")
+	}
+	return attrs, nil
+}
+
+// ServiceProviderConfig returns the service provider configuration document
+// for a server that supports PATCH and filtering but not bulk operations,
+// sorting, ETags or password changes.
+func ServiceProviderConfig(maxResults int) map[string]any {
+	unsupported := map[string]bool{"supported": false}
+	return map[string]any{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxResults},
+		"changePassword": unsupported,
+		"sort":           unsupported,
+		"etag":           unsupported,
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the organization's SCIM token",
+			"primary":     true,
+		}},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.temporal.io/cloud/internal/config"
 	"go.temporal.io/cloud/internal/repository"
 	"go.temporal.io/server/common/log"
 )
@@ -15,12 +16,13 @@ import (
 // AuditService handles audit logging business logic.
 type AuditService struct {
 	repos  *repository.Repositories
+	config config.AuditExportConfig
 	logger log.Logger
 }
 
 // NewAuditService creates a new audit service.
-func NewAuditService(repos *repository.Repositories, logger log.Logger) *AuditService {
-	return &AuditService{repos: repos, logger: logger}
+func NewAuditService(repos *repository.Repositories, cfg config.AuditExportConfig, logger log.Logger) *AuditService {
+	return &AuditService{repos: repos, config: cfg, logger: logger}
 }
 
 // AuditEventInput is the input for logging an audit event.
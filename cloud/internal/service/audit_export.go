@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/auditexport"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// AuditExportDelay is how long after being recorded events become
+// exportable. Events are timestamped before they are written, so an event
+// can appear after later-timestamped ones; holding exports back keeps them
+// from skipping it.
+const AuditExportDelay = 10 * time.Second
+
+// AuditExportPosition is where an export continues from: after the cursor,
+// with the batch following the one with Sequence and Hash.
+type AuditExportPosition struct {
+	Cursor   repository.AuditCursor
+	Sequence int64
+	Hash     string
+}
+
+// ExportEventsInput is the input for exporting audit events.
+type ExportEventsInput struct {
+	OrganizationID uuid.UUID
+	Format         string
+	// StartTime is where a new export starts. It is ignored when After is
+	// set.
+	StartTime time.Time
+	// EndTime defaults to, and is capped at, AuditExportDelay ago.
+	EndTime time.Time
+	Limit   int
+	After   *AuditExportPosition
+}
+
+// ExportEventsOutput is a batch of exported audit events.
+type ExportEventsOutput struct {
+	// Batch is nil if there are no events to export.
+	Batch *auditexport.Batch
+	// Next is where the export continues from.
+	Next AuditExportPosition
+	// More is whether more events can be exported right away.
+	More bool
+}
+
+// ExportEvents exports the organization's events after the input's position,
+// oldest first, as the next batch of the export.
+func (s *AuditService) ExportEvents(ctx context.Context, input *ExportEventsInput) (*ExportEventsOutput, error) {
+	if err := auditexport.ValidateFormat(input.Format); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if input.Limit <= 0 {
+		input.Limit = 1000
+	}
+	if input.Limit > 10000 {
+		input.Limit = 10000
+	}
+	end := time.Now().Add(-AuditExportDelay)
+	if !input.EndTime.IsZero() && input.EndTime.Before(end) {
+		end = input.EndTime
+	}
+	pos := AuditExportPosition{Cursor: repository.AuditCursor{Time: input.StartTime}}
+	if input.After != nil {
+		pos = *input.After
+	}
+
+	events, err := s.repos.Audit.ListAfter(ctx, input.OrganizationID, pos.Cursor, end, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+	out := &ExportEventsOutput{Next: pos, More: len(events) == input.Limit}
+	if len(events) == 0 {
+		return out, nil
+	}
+
+	out.Batch, err = auditexport.NewBatch(s.ChainKey(input.OrganizationID), input.OrganizationID, input.Format, pos.Sequence+1, pos.Hash, events)
+	if err != nil {
+		return nil, err
+	}
+	last := events[len(events)-1]
+	out.Next = AuditExportPosition{
+		Cursor:   repository.AuditCursor{Time: last.CreatedAt, ID: last.ID},
+		Sequence: out.Batch.Sequence,
+		Hash:     out.Batch.Hash,
+	}
+	return out, nil
+}
+
+// ChainKey returns the key of the organization's export hash chain.
+func (s *AuditService) ChainKey(orgID uuid.UUID) []byte {
+	return auditexport.ChainKey(s.config.ChainSecret, orgID)
+}
+
+// AuditStreamInput is the input for creating or updating an audit stream.
+type AuditStreamInput struct {
+	OrganizationID uuid.UUID
+	Name           string
+	Format         string
+	SinkType       string
+	Config         json.RawMessage
+	Enabled        bool
+}
+
+func (input *AuditStreamInput) validate() error {
+	if input.Name == "" {
+		return serviceerror.NewInvalidArgument("audit stream name is required")
+	}
+	if err := auditexport.ValidateFormat(input.Format); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	// File sinks write to the workers' disks and are for development only.
+	if input.SinkType != auditexport.SinkTypeS3 && input.SinkType != auditexport.SinkTypeWebhook {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("unsupported audit stream sink type %q", input.SinkType))
+	}
+	if err := auditexport.ValidateSink(input.SinkType, input.Config); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	return nil
+}
+
+// CreateAuditStream creates an audit stream. It delivers the events recorded
+// after its creation.
+func (s *AuditService) CreateAuditStream(ctx context.Context, input *AuditStreamInput) (*repository.AuditStream, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	org, err := s.repos.Organizations.GetByID(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+	if err := s.checkAuditStreamName(ctx, input.OrganizationID, input.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	stream := &repository.AuditStream{
+		OrganizationID: input.OrganizationID,
+		Name:           input.Name,
+		Format:         input.Format,
+		SinkType:       input.SinkType,
+		Config:         input.Config,
+		Enabled:        input.Enabled,
+	}
+	if err := s.repos.AuditStreams.Create(ctx, stream); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// GetAuditStream retrieves an audit stream.
+func (s *AuditService) GetAuditStream(ctx context.Context, id uuid.UUID) (*repository.AuditStream, error) {
+	stream, err := s.repos.AuditStreams.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if stream == nil {
+		return nil, serviceerror.NewNotFound("audit stream not found")
+	}
+	return stream, nil
+}
+
+// ListAuditStreams lists the audit streams of an organization.
+func (s *AuditService) ListAuditStreams(ctx context.Context, orgID uuid.UUID) ([]*repository.AuditStream, error) {
+	return s.repos.AuditStreams.List(ctx, orgID)
+}
+
+// UpdateAuditStream replaces an audit stream's name, format, destination and
+// enabled state. The stream continues from the last event it delivered, and
+// its hash chain continues across the change. A webhook destination without a
+// secret keeps the secret of the current webhook.
+func (s *AuditService) UpdateAuditStream(ctx context.Context, id uuid.UUID, input *AuditStreamInput) (*repository.AuditStream, error) {
+	stream, err := s.GetAuditStream(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	input.OrganizationID = stream.OrganizationID
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkAuditStreamName(ctx, stream.OrganizationID, input.Name, id); err != nil {
+		return nil, err
+	}
+	if input.SinkType == auditexport.SinkTypeWebhook && stream.SinkType == auditexport.SinkTypeWebhook {
+		if input.Config, err = keepWebhookSecret(stream.Config, input.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	stream.Name = input.Name
+	stream.Format = input.Format
+	stream.SinkType = input.SinkType
+	stream.Config = input.Config
+	stream.Enabled = input.Enabled
+	if err := s.repos.AuditStreams.Update(ctx, stream); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// DeleteAuditStream deletes an audit stream. Events already delivered are
+// left in place.
+func (s *AuditService) DeleteAuditStream(ctx context.Context, id uuid.UUID) error {
+	deleted, err := s.repos.AuditStreams.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return serviceerror.NewNotFound("audit stream not found")
+	}
+	return nil
+}
+
+// checkAuditStreamName fails if another stream of the organization than
+// streamID has the name.
+func (s *AuditService) checkAuditStreamName(ctx context.Context, orgID uuid.UUID, name string, streamID uuid.UUID) error {
+	existing, err := s.repos.AuditStreams.GetByName(ctx, orgID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != streamID {
+		return serviceerror.NewAlreadyExists(fmt.Sprintf("audit stream %q already exists", name))
+	}
+	return nil
+}
+
+// keepWebhookSecret sets the secret of the current webhook config in the
+// updated one if it has none.
+func keepWebhookSecret(current, updated json.RawMessage) (json.RawMessage, error) {
+	var cur, upd auditexport.WebhookConfig
+	if err := json.Unmarshal(current, &cur); err != nil {
+		return nil, fmt.Errorf("invalid webhook config: %w", err)
+	}
+	if err := json.Unmarshal(updated, &upd); err != nil {
+		return nil, fmt.Errorf("invalid webhook config: %w", err)
+	}
+	if upd.Secret != "" || cur.Secret == "" {
+		return updated, nil
+	}
+	upd.Secret = cur.Secret
+	return json.Marshal(upd)
+}
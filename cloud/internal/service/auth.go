@@ -64,7 +64,7 @@ func (s *AuthService) CompleteGoogleLogin(ctx context.Context, code string) (str
 			return "", "", fmt.Errorf("failed to create user: %w", err)
 		}
 	}
-	accessToken, err := s.generateAccessToken(user.ID.String(), user.Email)
+	accessToken, err := s.generateAccessToken(user.ID.String(), user.Email, "", "")
 	if err != nil {
 		return "", "", fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -100,8 +100,8 @@ func (s *AuthService) getGoogleUserInfo(ctx context.Context, accessToken string)
 	return userInfo, nil
 }
 
-func (s *AuthService) generateAccessToken(userID, email string) (string, error) {
-	claims := jwt.MapClaims{"sub": userID, "email": email, "iss": s.jwtConfig.Issuer, "aud": s.jwtConfig.Audience, "exp": time.Now().Add(time.Hour * 24).Unix(), "iat": time.Now().Unix()}
+func (s *AuthService) generateAccessToken(userID, email, orgID, role string) (string, error) {
+	claims := jwt.MapClaims{"sub": userID, "email": email, "org_id": orgID, "role": role, "iss": s.jwtConfig.Issuer, "aud": s.jwtConfig.Audience, "exp": time.Now().Add(time.Hour * 24).Unix(), "iat": time.Now().Unix()}
 	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.jwtConfig.SecretKey))
 }
 
@@ -120,7 +120,26 @@ func (s *AuthService) RefreshAccessToken(ctx context.Context, refreshToken strin
 	if err != nil || user == nil {
 		return "", fmt.Errorf("user not found")
 	}
-	return s.generateAccessToken(user.ID.String(), user.Email)
+	// Sessions scoped to an organization, such as SAML logins, stay scoped to
+	// it and only last while the user is an active member. The role is the
+	// member's current one.
+	orgID := uuid.Nil
+	if orgClaim, _ := claims["org_id"].(string); orgClaim != "" {
+		if orgID, err = uuid.Parse(orgClaim); err != nil {
+			return "", fmt.Errorf("invalid token")
+		}
+	}
+	if orgID == uuid.Nil {
+		return s.generateAccessToken(user.ID.String(), user.Email, "", "")
+	}
+	member, err := s.repos.Organizations.GetMember(ctx, orgID, user.ID)
+	if err != nil {
+		return "", err
+	}
+	if member == nil || !member.Active {
+		return "", fmt.Errorf("user is not a member of the organization")
+	}
+	return s.generateAccessToken(user.ID.String(), user.Email, orgID.String(), member.Role)
 }
 
 func (s *AuthService) validateToken(tokenString string) (jwt.MapClaims, error) {
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/server/common/log"
+)
+
+// ResourceKind is a kind of resource that API methods act on.
+type ResourceKind string
+
+const (
+	ResourceOrganization   ResourceKind = "organization"
+	ResourceNamespace      ResourceKind = "namespace"
+	ResourceAPIKey         ResourceKind = "api_key"
+	ResourceServiceAccount ResourceKind = "service_account"
+	ResourceUser           ResourceKind = "user"
+	ResourceInvitation     ResourceKind = "invitation"
+	ResourceInvoice        ResourceKind = "invoice"
+	ResourceAuditEvent     ResourceKind = "audit_event"
+	ResourceAuditStream    ResourceKind = "audit_stream"
+)
+
+// ResourceOwner is who a resource belongs to.
+type ResourceOwner struct {
+	// OrganizationID is unset for resources that belong to a user rather than
+	// an organization, such as the user's own API keys.
+	OrganizationID uuid.UUID
+	// NamespaceID is set for namespaces.
+	NamespaceID string
+	// UserID and ServiceAccountID are set for resources that belong to a
+	// principal: a user, a service account, or their API keys.
+	UserID           uuid.UUID
+	ServiceAccountID uuid.UUID
+}
+
+// OrganizationAccess is what a principal may do in an organization.
+type OrganizationAccess struct {
+	// Role is the principal's organization role. It may be empty for service
+	// accounts that only hold namespace permissions.
+	Role string
+	// Permissions are "namespace_<level>:<namespace>" strings.
+	Permissions []string
+}
+
+// AuthorizationService resolves what API callers may act on.
+type AuthorizationService struct {
+	repos  *repository.Repositories
+	logger log.Logger
+}
+
+// NewAuthorizationService creates a new authorization service.
+func NewAuthorizationService(repos *repository.Repositories, logger log.Logger) *AuthorizationService {
+	return &AuthorizationService{repos: repos, logger: logger}
+}
+
+// ResourceOwner resolves who a resource belongs to. It returns nil if the
+// resource does not exist.
+func (s *AuthorizationService) ResourceOwner(ctx context.Context, kind ResourceKind, id string) (*ResourceOwner, error) {
+	if kind == ResourceNamespace {
+		ns, err := s.repos.Namespaces.GetByID(ctx, id)
+		if err != nil || ns == nil {
+			return nil, err
+		}
+		return &ResourceOwner{OrganizationID: ns.OrganizationID, NamespaceID: ns.ID}, nil
+	}
+
+	// All other resources have UUIDs.
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, nil
+	}
+	switch kind {
+	case ResourceOrganization:
+		org, err := s.repos.Organizations.GetByID(ctx, uid)
+		if err != nil || org == nil {
+			return nil, err
+		}
+		return &ResourceOwner{OrganizationID: org.ID}, nil
+	case ResourceUser:
+		return &ResourceOwner{UserID: uid}, nil
+	case ResourceServiceAccount:
+		return s.serviceAccountOwner(ctx, uid)
+	case ResourceAPIKey:
+		key, err := s.repos.APIKeys.GetByID(ctx, uid)
+		if err != nil || key == nil {
+			return nil, err
+		}
+		if key.OwnerType == APIKeyOwnerServiceAccount {
+			return s.serviceAccountOwner(ctx, key.OwnerID)
+		}
+		return &ResourceOwner{UserID: key.OwnerID}, nil
+	case ResourceInvitation:
+		inv, err := s.repos.Users.GetInvitation(ctx, uid)
+		if err != nil || inv == nil {
+			return nil, err
+		}
+		return &ResourceOwner{OrganizationID: inv.OrganizationID}, nil
+	case ResourceInvoice:
+		inv, err := s.repos.Invoices.GetByID(ctx, uid)
+		if err != nil || inv == nil {
+			return nil, err
+		}
+		return &ResourceOwner{OrganizationID: inv.OrganizationID}, nil
+	case ResourceAuditEvent:
+		event, err := s.repos.Audit.GetByID(ctx, uid)
+		if err != nil || event == nil {
+			return nil, err
+		}
+		return &ResourceOwner{OrganizationID: event.OrganizationID}, nil
+	case ResourceAuditStream:
+		stream, err := s.repos.AuditStreams.GetByID(ctx, uid)
+		if err != nil || stream == nil {
+			return nil, err
+		}
+		return &ResourceOwner{OrganizationID: stream.OrganizationID}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (s *AuthorizationService) serviceAccountOwner(ctx context.Context, id uuid.UUID) (*ResourceOwner, error) {
+	sa, err := s.repos.ServiceAccounts.GetByID(ctx, id)
+	if err != nil || sa == nil {
+		return nil, err
+	}
+	return &ResourceOwner{OrganizationID: sa.OrganizationID, ServiceAccountID: sa.ID}, nil
+}
+
+// UserAccess resolves a user's role and namespace permissions in an
+// organization. It returns nil if the user is not an active member.
+func (s *AuthorizationService) UserAccess(ctx context.Context, userID, orgID uuid.UUID) (*OrganizationAccess, error) {
+	member, err := s.repos.Organizations.GetMember(ctx, orgID, userID)
+	if err != nil || member == nil || !member.Active {
+		return nil, err
+	}
+	perms, err := effectiveNamespacePermissions(ctx, s.repos, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &OrganizationAccess{Role: member.Role, Permissions: perms}, nil
+}
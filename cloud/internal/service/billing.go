@@ -5,25 +5,42 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/cloud/internal/config"
 	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/stripe"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
 // BillingService handles billing business logic.
 type BillingService struct {
 	repos        *repository.Repositories
+	stripe       stripe.Client
 	stripeConfig config.StripeConfig
+	payments     PaymentNotifier
+	entitlements *EntitlementService
 	logger       log.Logger
+	now          func() time.Time
 }
 
-// NewBillingService creates a new billing service.
-func NewBillingService(repos *repository.Repositories, stripeCfg config.StripeConfig, logger log.Logger) *BillingService {
-	return &BillingService{repos: repos, stripeConfig: stripeCfg, logger: logger}
+// NewBillingService creates a new billing service. stripeClient may be nil if
+// Stripe is not configured, in which case operations that need it fail.
+func NewBillingService(repos *repository.Repositories, stripeClient stripe.Client, stripeCfg config.StripeConfig, payments PaymentNotifier, logger log.Logger) *BillingService {
+	return &BillingService{
+		repos:        repos,
+		stripe:       stripeClient,
+		stripeConfig: stripeCfg,
+		payments:     payments,
+		entitlements: NewEntitlementService(repos),
+		logger:       logger,
+		now:          time.Now,
+	}
 }
 
 // GetSubscription retrieves the subscription for an organization.
@@ -37,27 +54,31 @@ type UpdateSubscriptionInput struct {
 	Plan           string
 }
 
-// UpdateSubscription updates the subscription plan.
+// UpdateSubscription moves the subscription to the latest version of a plan.
+// The organization's resources and usage must be within the plan, so a
+// downgrade fails listing what has to be removed first.
 func (s *BillingService) UpdateSubscription(ctx context.Context, input *UpdateSubscriptionInput) (*repository.Subscription, error) {
 	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, input.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
 	if sub == nil {
-		return nil, fmt.Errorf("subscription not found")
+		return nil, serviceerror.NewNotFound("subscription not found")
 	}
 
-	// Validate plan transition
-	if !isValidPlanTransition(sub.Plan, input.Plan) {
-		return nil, fmt.Errorf("invalid plan transition from %s to %s", sub.Plan, input.Plan)
+	plan, violations, err := s.ValidatePlanChange(ctx, input.OrganizationID, input.Plan)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return nil, planViolationsError(plan, violations)
 	}
 
-	// Update plan limits
-	actions, activeGB, retainedGB := repository.GetPlanLimits(input.Plan)
-	sub.Plan = input.Plan
-	sub.ActionsIncluded = actions
-	sub.ActiveStorageGB = activeGB
-	sub.RetainedStorageGB = retainedGB
+	sub.Plan = plan.Tier
+	sub.PlanVersion = plan.Version
+	sub.ActionsIncluded = plan.ActionsIncluded
+	sub.ActiveStorageGB = plan.ActiveStorageGB
+	sub.RetainedStorageGB = plan.RetainedStorageGB
 
 	// TODO: Update Stripe subscription if exists
 
@@ -68,6 +89,45 @@ func (s *BillingService) UpdateSubscription(ctx context.Context, input *UpdateSu
 	return sub, nil
 }
 
+// ValidatePlanChange returns the latest version of a plan and the resources
+// and usage of an organization that it does not allow.
+func (s *BillingService) ValidatePlanChange(ctx context.Context, orgID uuid.UUID, tier string) (*repository.Plan, []PlanViolation, error) {
+	plan, err := s.repos.Plans.GetLatest(ctx, tier)
+	if err != nil {
+		return nil, nil, err
+	}
+	if plan == nil {
+		return nil, nil, serviceerror.NewInvalidArgumentf("unknown plan: %s", tier)
+	}
+	violations, err := s.entitlements.PlanViolations(ctx, orgID, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan, violations, nil
+}
+
+// GetSubscriptionPlan retrieves the plan version a subscription is on.
+func (s *BillingService) GetSubscriptionPlan(ctx context.Context, sub *repository.Subscription) (*repository.Plan, error) {
+	return s.entitlements.GetPlan(ctx, sub.Plan, sub.PlanVersion)
+}
+
+// ListPlans lists the latest version of every plan.
+func (s *BillingService) ListPlans(ctx context.Context) ([]*repository.Plan, error) {
+	return s.entitlements.ListPlans(ctx)
+}
+
+func planViolationsError(plan *repository.Plan, violations []PlanViolation) error {
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		if v.ResourceID == "" {
+			messages[i] = v.Message
+		} else {
+			messages[i] = fmt.Sprintf("%s %s: %s", v.ResourceType, v.ResourceID, v.Message)
+		}
+	}
+	return serviceerror.NewFailedPreconditionf("cannot change to the %s plan: %s", plan.Tier, strings.Join(messages, "; "))
+}
+
 // UsageSummary represents usage data for a period.
 type UsageSummary struct {
 	OrganizationID     uuid.UUID
@@ -171,29 +231,74 @@ func (s *BillingService) GetInvoice(ctx context.Context, invoiceID uuid.UUID) (*
 type CreditBalance struct {
 	OrganizationID uuid.UUID
 	BalanceCents   int64
+	Transactions   []*repository.CreditTransaction
 }
 
-// GetCreditBalance retrieves the credit balance for an organization.
+// creditTransactionsShown is how many of the most recent credit transactions
+// are returned with the balance.
+const creditTransactionsShown = 50
+
+// GetCreditBalance retrieves the credit balance for an organization along
+// with its most recent ledger transactions.
 func (s *BillingService) GetCreditBalance(ctx context.Context, orgID uuid.UUID) (*CreditBalance, error) {
-	// TODO: Implement credit balance retrieval
+	balance, err := s.repos.Credits.GetBalance(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	txns, err := s.repos.Credits.ListTransactions(ctx, orgID, creditTransactionsShown, 0)
+	if err != nil {
+		return nil, err
+	}
 	return &CreditBalance{
 		OrganizationID: orgID,
-		BalanceCents:   0,
+		BalanceCents:   balance,
+		Transactions:   txns,
 	}, nil
 }
 
+// ExpireCredits writes off all credits that expired at or before the given
+// time and returns the number of organizations affected.
+func (s *BillingService) ExpireCredits(ctx context.Context, at time.Time) (int, error) {
+	orgIDs, err := s.repos.Credits.ListOrganizationsWithLapsedCredits(ctx, at)
+	if err != nil {
+		return 0, err
+	}
+	for _, orgID := range orgIDs {
+		expired, err := s.repos.Credits.ExpireCredits(ctx, orgID, at)
+		if err != nil {
+			return 0, err
+		}
+		s.logger.Info("Expired prepaid credits",
+			tag.NewStringTag("organization_id", orgID.String()),
+			tag.NewInt64("amount_cents", expired))
+	}
+	return len(orgIDs), nil
+}
+
 // RecordUsage records usage for billing.
 func (s *BillingService) RecordUsage(ctx context.Context, record *repository.UsageRecord) error {
 	return s.repos.Usage.Create(ctx, record)
 }
 
-// GenerateInvoice generates an invoice for a billing period.
+// GenerateInvoice generates an invoice for a billing period. If the period was
+// already invoiced, the existing invoice is returned.
 func (s *BillingService) GenerateInvoice(ctx context.Context, orgID uuid.UUID, periodStart, periodEnd time.Time) (*repository.Invoice, error) {
+	existing, err := s.repos.Invoices.GetByPeriod(ctx, orgID, periodStart)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
 	// Get subscription
 	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, orgID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get subscription: %w", err)
 	}
+	if sub == nil {
+		return nil, serviceerror.NewNotFound("subscription not found")
+	}
 
 	// Get usage
 	usage, err := s.repos.Usage.GetSummaryByOrganization(ctx, orgID, periodStart, periodEnd)
@@ -201,8 +306,13 @@ func (s *BillingService) GenerateInvoice(ctx context.Context, orgID uuid.UUID, p
 		return nil, fmt.Errorf("failed to get usage: %w", err)
 	}
 
+	plan, err := s.entitlements.GetPlan(ctx, sub.Plan, sub.PlanVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	// Calculate charges
-	lineItems := s.calculateLineItems(sub, usage)
+	lineItems := s.calculateLineItems(sub, plan, usage)
 	lineItemsJSON, _ := json.Marshal(lineItems)
 
 	var subtotal int64
@@ -228,7 +338,24 @@ func (s *BillingService) GenerateInvoice(ctx context.Context, orgID uuid.UUID, p
 		DueAt:          sql.NullTime{Time: periodEnd.AddDate(0, 0, 30), Valid: true},
 	}
 
-	if err := s.repos.Invoices.Create(ctx, invoice); err != nil {
+	// Prepaid credits are drawn down in the same transaction that creates
+	// the invoice and show on it as a negative line item.
+	err = s.repos.Invoices.CreateWithCredits(ctx, invoice, func(inv *repository.Invoice, availableCents int64) int64 {
+		credits := min(availableCents, inv.SubtotalCents+inv.TaxCents)
+		if credits <= 0 {
+			return 0
+		}
+		inv.LineItems, _ = json.Marshal(append(lineItems, InvoiceLineItem{
+			Description:    "Prepaid credits",
+			Quantity:       1,
+			Unit:           "credit",
+			UnitPriceCents: -credits,
+			AmountCents:    -credits,
+		}))
+		inv.TotalCents = inv.SubtotalCents + inv.TaxCents - credits
+		return credits
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to create invoice: %w", err)
 	}
 
@@ -244,11 +371,11 @@ type InvoiceLineItem struct {
 	AmountCents    int64   `json:"amount_cents"`
 }
 
-func (s *BillingService) calculateLineItems(sub *repository.Subscription, usage *repository.UsageRecord) []InvoiceLineItem {
+func (s *BillingService) calculateLineItems(sub *repository.Subscription, plan *repository.Plan, usage *repository.UsageRecord) []InvoiceLineItem {
 	var items []InvoiceLineItem
 
 	// Base plan fee
-	planFee := getPlanFee(sub.Plan)
+	planFee := plan.BaseFeeCents
 	if planFee > 0 {
 		items = append(items, InvoiceLineItem{
 			Description:    fmt.Sprintf("%s Plan", sub.Plan),
@@ -305,20 +432,6 @@ func (s *BillingService) calculateLineItems(sub *repository.Subscription, usage
 	return items
 }
 
-func isValidPlanTransition(from, to string) bool {
-	planOrder := map[string]int{
-		"free": 0, "essentials": 1, "business": 2, "enterprise": 3, "mission_critical": 4,
-	}
-	return planOrder[to] >= planOrder[from]
-}
-
-func getPlanFee(plan string) int64 {
-	fees := map[string]int64{
-		"free": 0, "essentials": 10000, "business": 50000, "enterprise": 0, "mission_critical": 0,
-	}
-	return fees[plan]
-}
-
 func getActionPrice(overage int64) int64 {
 	millions := overage / 1000000
 	switch {
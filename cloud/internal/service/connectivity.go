@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// maxIPAllowlistEntries bounds the size of an IP allowlist rule.
+const maxIPAllowlistEntries = 256
+
+// IPAllowlistConfig is the configuration of an IP allowlist rule.
+type IPAllowlistConfig struct {
+	CIDRs []string `json:"cidrs"`
+}
+
+// PrivateLinkConfig is the configuration of a PrivateLink rule.
+type PrivateLinkConfig struct {
+	ConnectionID string `json:"connection_id"`
+	Region       string `json:"region"`
+}
+
+// VPCPeeringConfig is the configuration of a VPC peering rule.
+type VPCPeeringConfig struct {
+	VPCID     string `json:"vpc_id"`
+	AccountID string `json:"account_id"`
+	Region    string `json:"region"`
+	CIDR      string `json:"cidr"`
+}
+
+// ConnectivityRuleInput is the input for creating or updating a connectivity
+// rule.
+type ConnectivityRuleInput struct {
+	OrganizationID uuid.UUID
+	Name           string
+	Type           string
+	Config         json.RawMessage
+	Enabled        bool
+}
+
+func (input *ConnectivityRuleInput) validate() error {
+	if input.Name == "" {
+		return serviceerror.NewInvalidArgument("connectivity rule name is required")
+	}
+	switch input.Type {
+	case repository.ConnectivityRuleTypeIPAllowlist:
+		var cfg IPAllowlistConfig
+		if err := json.Unmarshal(input.Config, &cfg); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid IP allowlist: %v", err)
+		}
+		if len(cfg.CIDRs) == 0 {
+			return serviceerror.NewInvalidArgument("IP allowlist must have at least one CIDR")
+		}
+		if len(cfg.CIDRs) > maxIPAllowlistEntries {
+			return serviceerror.NewInvalidArgumentf("IP allowlist may have at most %d CIDRs", maxIPAllowlistEntries)
+		}
+		for _, cidr := range cfg.CIDRs {
+			if _, err := ParseCIDR(cidr); err != nil {
+				return serviceerror.NewInvalidArgument(err.Error())
+			}
+		}
+	case repository.ConnectivityRuleTypePrivateLink:
+		var cfg PrivateLinkConfig
+		if err := json.Unmarshal(input.Config, &cfg); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid PrivateLink connection: %v", err)
+		}
+		if cfg.ConnectionID == "" || !isValidRegion(cfg.Region) {
+			return serviceerror.NewInvalidArgument("PrivateLink connection requires a connection ID and a supported region")
+		}
+	case repository.ConnectivityRuleTypeVPCPeering:
+		var cfg VPCPeeringConfig
+		if err := json.Unmarshal(input.Config, &cfg); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid VPC peering connection: %v", err)
+		}
+		if cfg.VPCID == "" || cfg.AccountID == "" || !isValidRegion(cfg.Region) {
+			return serviceerror.NewInvalidArgument("VPC peering connection requires a VPC ID, an account ID and a supported region")
+		}
+		if _, err := netip.ParsePrefix(cfg.CIDR); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid VPC CIDR %q", cfg.CIDR)
+		}
+	default:
+		return serviceerror.NewInvalidArgumentf("unsupported connectivity rule type %q", input.Type)
+	}
+	return nil
+}
+
+// ParseCIDR parses an IP allowlist entry. A single address allows just that
+// address.
+func ParseCIDR(cidr string) (netip.Prefix, error) {
+	cidr = strings.TrimSpace(cidr)
+	if !strings.Contains(cidr, "/") {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR %q", cidr)
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q", cidr)
+	}
+	return prefix.Masked(), nil
+}
+
+// IPAllowlist returns the sorted union of the CIDRs of the enabled IP
+// allowlist rules among rules. It is empty if there are none, in which case
+// the namespace accepts requests from any address.
+func IPAllowlist(rules []*repository.ConnectivityRule) ([]string, error) {
+	seen := make(map[netip.Prefix]bool)
+	var prefixes []netip.Prefix
+	for _, rule := range rules {
+		if !rule.Enabled || rule.Type != repository.ConnectivityRuleTypeIPAllowlist {
+			continue
+		}
+		var cfg IPAllowlistConfig
+		if err := json.Unmarshal(rule.Config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid IP allowlist rule %s: %w", rule.ID, err)
+		}
+		for _, cidr := range cfg.CIDRs {
+			prefix, err := ParseCIDR(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IP allowlist rule %s: %w", rule.ID, err)
+			}
+			if !seen[prefix] {
+				seen[prefix] = true
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+
+	cidrs := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		cidrs[i] = prefix.String()
+	}
+	return cidrs, nil
+}
+
+// CreateConnectivityRule creates a connectivity rule in an organization. The
+// rule has no effect until it is bound to a namespace.
+func (s *NamespaceService) CreateConnectivityRule(ctx context.Context, input *ConnectivityRuleInput) (*repository.ConnectivityRule, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	org, err := s.repos.Organizations.GetByID(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+	if err := s.checkConnectivityRuleName(ctx, input.OrganizationID, input.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+	if isPrivateConnectivity(input.Type) {
+		if err := s.entitlements.RequireFeature(ctx, input.OrganizationID, FeaturePrivateConnectivity); err != nil {
+			return nil, err
+		}
+	}
+
+	rule := &repository.ConnectivityRule{
+		OrganizationID: input.OrganizationID,
+		Name:           input.Name,
+		Type:           input.Type,
+		Config:         input.Config,
+		Enabled:        input.Enabled,
+	}
+	if err := s.repos.Connectivity.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// GetConnectivityRule retrieves a connectivity rule of an organization.
+func (s *NamespaceService) GetConnectivityRule(ctx context.Context, orgID, ruleID uuid.UUID) (*repository.ConnectivityRule, error) {
+	rule, err := s.repos.Connectivity.GetRule(ctx, orgID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, serviceerror.NewNotFound("connectivity rule not found")
+	}
+	return rule, nil
+}
+
+// ListConnectivityRules lists the connectivity rules of an organization.
+func (s *NamespaceService) ListConnectivityRules(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*repository.ConnectivityRule, error) {
+	return s.repos.Connectivity.ListRules(ctx, orgID, limit, offset)
+}
+
+// UpdateConnectivityRule replaces a connectivity rule's name, configuration
+// and enabled state. Namespaces the rule is bound to pick up the change when
+// their connectivity is next synced.
+func (s *NamespaceService) UpdateConnectivityRule(ctx context.Context, ruleID uuid.UUID, input *ConnectivityRuleInput) (*repository.ConnectivityRule, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	rule, err := s.GetConnectivityRule(ctx, input.OrganizationID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if rule.Type != input.Type {
+		return nil, serviceerror.NewInvalidArgument("the type of a connectivity rule cannot be changed")
+	}
+	if isPrivateConnectivity(input.Type) {
+		if err := s.entitlements.RequireFeature(ctx, input.OrganizationID, FeaturePrivateConnectivity); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkConnectivityRuleName(ctx, input.OrganizationID, input.Name, ruleID); err != nil {
+		return nil, err
+	}
+
+	rule.Name = input.Name
+	rule.Config = input.Config
+	rule.Enabled = input.Enabled
+	if err := s.repos.Connectivity.UpdateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// DeleteConnectivityRule deletes a connectivity rule and unbinds it from its
+// namespaces.
+func (s *NamespaceService) DeleteConnectivityRule(ctx context.Context, orgID, ruleID uuid.UUID) error {
+	deleted, err := s.repos.Connectivity.DeleteRule(ctx, orgID, ruleID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return serviceerror.NewNotFound("connectivity rule not found")
+	}
+	return nil
+}
+
+// AddNamespaceConnectivityRule binds a connectivity rule of the namespace's
+// organization to the namespace.
+func (s *NamespaceService) AddNamespaceConnectivityRule(ctx context.Context, namespaceID string, ruleID uuid.UUID) error {
+	ns, err := s.getExistingNamespace(ctx, namespaceID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.GetConnectivityRule(ctx, ns.OrganizationID, ruleID); err != nil {
+		return err
+	}
+	return s.repos.Connectivity.AddBinding(ctx, namespaceID, ruleID)
+}
+
+// RemoveNamespaceConnectivityRule unbinds a connectivity rule from a
+// namespace.
+func (s *NamespaceService) RemoveNamespaceConnectivityRule(ctx context.Context, namespaceID string, ruleID uuid.UUID) error {
+	removed, err := s.repos.Connectivity.RemoveBinding(ctx, namespaceID, ruleID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return serviceerror.NewNotFound("connectivity rule is not bound to the namespace")
+	}
+	return nil
+}
+
+// ListNamespaceConnectivityRules lists the connectivity rules bound to a
+// namespace.
+func (s *NamespaceService) ListNamespaceConnectivityRules(ctx context.Context, namespaceID string) ([]*repository.ConnectivityRule, error) {
+	if _, err := s.getExistingNamespace(ctx, namespaceID); err != nil {
+		return nil, err
+	}
+	return s.repos.Connectivity.ListNamespaceRules(ctx, namespaceID)
+}
+
+// checkConnectivityRuleName fails if another rule of the organization than
+// ruleID has the name.
+func (s *NamespaceService) checkConnectivityRuleName(ctx context.Context, orgID uuid.UUID, name string, ruleID uuid.UUID) error {
+	existing, err := s.repos.Connectivity.GetRuleByName(ctx, orgID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != ruleID {
+		return serviceerror.NewAlreadyExists(fmt.Sprintf("connectivity rule %q already exists", name))
+	}
+	return nil
+}
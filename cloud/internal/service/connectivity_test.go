@@ -0,0 +1,53 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+func TestIPAllowlist(t *testing.T) {
+	rule := func(ruleType string, enabled bool, config any) *repository.ConnectivityRule {
+		raw, err := json.Marshal(config)
+		require.NoError(t, err)
+		return &repository.ConnectivityRule{Type: ruleType, Enabled: enabled, Config: raw}
+	}
+
+	cidrs, err := IPAllowlist([]*repository.ConnectivityRule{
+		rule(repository.ConnectivityRuleTypeIPAllowlist, true, IPAllowlistConfig{CIDRs: []string{"203.0.113.7", "10.1.2.3/8"}}),
+		rule(repository.ConnectivityRuleTypeIPAllowlist, true, IPAllowlistConfig{CIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}}),
+		rule(repository.ConnectivityRuleTypeIPAllowlist, false, IPAllowlistConfig{CIDRs: []string{"0.0.0.0/0"}}),
+		rule(repository.ConnectivityRuleTypeVPCPeering, true, VPCPeeringConfig{CIDR: "172.16.0.0/12"}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.0/8", "203.0.113.7/32", "2001:db8::/32"}, cidrs)
+
+	cidrs, err = IPAllowlist(nil)
+	require.NoError(t, err)
+	require.Empty(t, cidrs)
+
+	_, err = IPAllowlist([]*repository.ConnectivityRule{
+		rule(repository.ConnectivityRuleTypeIPAllowlist, true, IPAllowlistConfig{CIDRs: []string{"10.0.0.0/33"}}),
+	})
+	require.ErrorContains(t, err, `invalid CIDR "10.0.0.0/33"`)
+}
+
+func TestConnectivityRuleInputValidate(t *testing.T) {
+	input := func(ruleType string, config any) *ConnectivityRuleInput {
+		raw, err := json.Marshal(config)
+		require.NoError(t, err)
+		return &ConnectivityRuleInput{Name: "office", Type: ruleType, Config: raw}
+	}
+
+	require.NoError(t, input(repository.ConnectivityRuleTypeIPAllowlist, IPAllowlistConfig{CIDRs: []string{"192.0.2.0/24", "::1"}}).validate())
+	require.ErrorContains(t, input(repository.ConnectivityRuleTypeIPAllowlist, IPAllowlistConfig{}).validate(), "at least one CIDR")
+	require.ErrorContains(t, input(repository.ConnectivityRuleTypeIPAllowlist, IPAllowlistConfig{CIDRs: []string{"office"}}).validate(), `invalid CIDR "office"`)
+	require.NoError(t, input(repository.ConnectivityRuleTypePrivateLink, PrivateLinkConfig{ConnectionID: "vpce-123", Region: "us-east-1"}).validate())
+	require.Error(t, input(repository.ConnectivityRuleTypePrivateLink, PrivateLinkConfig{ConnectionID: "vpce-123", Region: "mars-1"}).validate())
+	require.NoError(t, input(repository.ConnectivityRuleTypeVPCPeering, VPCPeeringConfig{
+		VPCID: "vpc-1", AccountID: "123456789012", Region: "us-west-2", CIDR: "172.16.0.0/12",
+	}).validate())
+	require.ErrorContains(t, input("direct_connect", struct{}{}).validate(), "unsupported connectivity rule type")
+}
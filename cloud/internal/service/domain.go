@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+const (
+	// domainVerificationPrefix is prepended to a domain to name the DNS TXT
+	// record that proves control of it.
+	domainVerificationPrefix = "_temporal-cloud-verification."
+	// domainVerificationValuePrefix starts the value of verification records.
+	domainVerificationValuePrefix = "temporal-cloud-verification="
+)
+
+// DomainVerificationRecord returns the name of the DNS TXT record that
+// verifies a domain.
+func DomainVerificationRecord(domain string) string {
+	return domainVerificationPrefix + domain
+}
+
+// DomainVerificationValue returns the value the verification record of a
+// claimed domain must hold.
+func DomainVerificationValue(d *repository.OrganizationDomain) string {
+	return domainVerificationValuePrefix + d.VerificationToken
+}
+
+// normalizeDomain lowercases a domain name and checks that it is a plausible
+// registrable name.
+func normalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if len(domain) == 0 || len(domain) > 253 || !strings.Contains(domain, ".") {
+		return "", serviceerror.NewInvalidArgument("domain must be a fully qualified domain name")
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return "", serviceerror.NewInvalidArgument("domain must be a fully qualified domain name")
+		}
+		for _, c := range label {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+				return "", serviceerror.NewInvalidArgument("domain must be a fully qualified domain name")
+			}
+		}
+	}
+	return domain, nil
+}
+
+// emailDomain returns the normalized domain of an email address.
+func emailDomain(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.ToLower(email[at+1:]), ".")
+}
+
+// AddDomain claims a domain for an organization. The domain is unverified
+// until its verification record is published and VerifyDomain is called.
+func (s *IdentityService) AddDomain(ctx context.Context, orgID uuid.UUID, domain string) (*repository.OrganizationDomain, error) {
+	domain, err := normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.repos.Organizations.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return nil, fmt.Errorf("failed to generate domain verification token: %w", err)
+	}
+	d := &repository.OrganizationDomain{
+		OrganizationID:    orgID,
+		Domain:            domain,
+		VerificationToken: hex.EncodeToString(random),
+	}
+	if err := s.repos.Domains.Create(ctx, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// VerifyDomain marks a claimed domain verified if its verification record is
+// published. A domain can only be verified by one organization.
+func (s *IdentityService) VerifyDomain(ctx context.Context, orgID uuid.UUID, domain string) (*repository.OrganizationDomain, error) {
+	domain, err := normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.repos.Domains.Get(ctx, orgID, domain)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, serviceerror.NewNotFound("domain not found")
+	}
+	if d.VerifiedAt.Valid {
+		return d, nil
+	}
+
+	records, err := s.lookupTXT(ctx, DomainVerificationRecord(domain))
+	if err != nil || !slices.Contains(records, DomainVerificationValue(d)) {
+		return nil, serviceerror.NewFailedPreconditionf("TXT record %s does not contain %s", DomainVerificationRecord(domain), DomainVerificationValue(d))
+	}
+	if err := s.repos.Domains.MarkVerified(ctx, d, time.Now()); err != nil {
+		if errors.Is(err, repository.ErrDomainVerifiedElsewhere) {
+			return nil, serviceerror.NewAlreadyExists("domain is verified by another organization")
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+// ListDomains lists the domains claimed by an organization.
+func (s *IdentityService) ListDomains(ctx context.Context, orgID uuid.UUID) ([]*repository.OrganizationDomain, error) {
+	return s.repos.Domains.ListByOrganization(ctx, orgID)
+}
+
+// RemoveDomain removes a domain from an organization. Members with addresses
+// in the domain stay members.
+func (s *IdentityService) RemoveDomain(ctx context.Context, orgID uuid.UUID, domain string) error {
+	domain, err := normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.repos.Domains.Delete(ctx, orgID, domain)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return serviceerror.NewNotFound("domain not found")
+	}
+	return nil
+}
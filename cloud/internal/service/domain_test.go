@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDomain(t *testing.T) {
+	for input, want := range map[string]string{
+		"example.com":        "example.com",
+		" Example.COM. ":     "example.com",
+		"sso.corp-1.example": "sso.corp-1.example",
+	} {
+		got, err := normalizeDomain(input)
+		require.NoError(t, err, input)
+		require.Equal(t, want, got)
+	}
+	for _, input := range []string{"", "localhost", "user@example.com", "-bad.example", "a..example", "*.example.com"} {
+		_, err := normalizeDomain(input)
+		require.Error(t, err, input)
+	}
+}
+
+func TestEmailDomain(t *testing.T) {
+	require.Equal(t, "example.com", emailDomain("User@Example.COM"))
+	require.Equal(t, "example.com", emailDomain(`"a@b"@example.com`))
+	require.Empty(t, emailDomain("no-at-sign"))
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// Plan features, as listed in a plan's features.
+const (
+	FeatureHistoryExport       = "history_export"
+	FeaturePrivateConnectivity = "private_connectivity"
+	FeatureNexus               = "nexus"
+)
+
+// Entitlement is what an API method requires of the plan of the organization
+// it acts in.
+type Entitlement string
+
+const (
+	// EntitlementNone allows the method on any plan and in any standing.
+	// Methods that remove resources or settle billing need it, so that an
+	// organization can always get back within its plan.
+	EntitlementNone Entitlement = "none"
+	// EntitlementChange requires a subscription that is not suspended or
+	// canceled and, on plans with hard usage limits, usage within them.
+	EntitlementChange Entitlement = "change"
+	// EntitlementHistoryExport and EntitlementNexus additionally require the
+	// plan to include the feature.
+	EntitlementHistoryExport Entitlement = FeatureHistoryExport
+	EntitlementNexus         Entitlement = FeatureNexus
+)
+
+// planResourceLimit bounds how many resources of each kind are checked
+// against a plan.
+const planResourceLimit = 10000
+
+// hoursPerBillingPeriod converts included storage into GB-hours, as invoices
+// do.
+var hoursPerBillingPeriod = decimal.NewFromInt(744)
+
+// PlanViolation is a resource, or usage, that a plan does not allow.
+type PlanViolation struct {
+	ResourceType string
+	ResourceID   string
+	Message      string
+}
+
+// EntitlementService checks what organizations are entitled to by their plans.
+type EntitlementService struct {
+	repos *repository.Repositories
+	now   func() time.Time
+}
+
+// NewEntitlementService creates a new entitlement service.
+func NewEntitlementService(repos *repository.Repositories) *EntitlementService {
+	return &EntitlementService{repos: repos, now: time.Now}
+}
+
+// ListPlans lists the latest version of every plan tier.
+func (s *EntitlementService) ListPlans(ctx context.Context) ([]*repository.Plan, error) {
+	return s.repos.Plans.ListLatest(ctx)
+}
+
+// GetPlan retrieves a version of a plan tier.
+func (s *EntitlementService) GetPlan(ctx context.Context, tier string, version int) (*repository.Plan, error) {
+	plan, err := s.repos.Plans.Get(ctx, tier, version)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, fmt.Errorf("plan %s version %d not found", tier, version)
+	}
+	return plan, nil
+}
+
+// SubscriptionPlan retrieves an organization's subscription and the plan
+// version it is on.
+func (s *EntitlementService) SubscriptionPlan(ctx context.Context, orgID uuid.UUID) (*repository.Subscription, *repository.Plan, error) {
+	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, orgID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if sub == nil {
+		return nil, nil, serviceerror.NewFailedPrecondition("no subscription found")
+	}
+	plan, err := s.GetPlan(ctx, sub.Plan, sub.PlanVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub, plan, nil
+}
+
+// Check returns why an organization is not entitled to call a method
+// requiring e, or "" if it is.
+func (s *EntitlementService) Check(ctx context.Context, orgID uuid.UUID, e Entitlement) (string, error) {
+	if e == EntitlementNone {
+		return "", nil
+	}
+	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, orgID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if sub == nil {
+		return "organization has no subscription", nil
+	}
+	if sub.Status == "suspended" || sub.Status == "canceled" {
+		return fmt.Sprintf("subscription is %s", sub.Status), nil
+	}
+	plan, err := s.GetPlan(ctx, sub.Plan, sub.PlanVersion)
+	if err != nil {
+		return "", err
+	}
+	if e != EntitlementChange && !plan.HasFeature(string(e)) {
+		return fmt.Sprintf("the %s plan does not include %s", plan.Tier, e), nil
+	}
+	if plan.HardUsageLimits {
+		usage, err := s.currentUsage(ctx, orgID)
+		if err != nil {
+			return "", err
+		}
+		if violations := usageViolations(plan, usage); len(violations) > 0 {
+			return violations[0].Message, nil
+		}
+	}
+	return "", nil
+}
+
+// RequireFeature returns a FailedPrecondition error if an organization's plan
+// does not include a feature.
+func (s *EntitlementService) RequireFeature(ctx context.Context, orgID uuid.UUID, feature string) error {
+	_, plan, err := s.SubscriptionPlan(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !plan.HasFeature(feature) {
+		return serviceerror.NewFailedPreconditionf("the %s plan does not include %s", plan.Tier, feature)
+	}
+	return nil
+}
+
+// PlanViolations lists the resources and usage of an organization that a plan
+// does not allow, which must be removed or reduced before moving to it.
+func (s *EntitlementService) PlanViolations(ctx context.Context, orgID uuid.UUID, plan *repository.Plan) ([]PlanViolation, error) {
+	resources := &planResources{}
+	var err error
+	resources.namespaces, err = s.repos.Namespaces.ListByOrganization(ctx, orgID, planResourceLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	for _, ns := range resources.namespaces {
+		sinks, err := s.repos.Exports.ListSinks(ctx, ns.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list export sinks: %w", err)
+		}
+		resources.exportSinks = append(resources.exportSinks, sinks...)
+	}
+	resources.connectivityRules, err = s.repos.Connectivity.ListRules(ctx, orgID, planResourceLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connectivity rules: %w", err)
+	}
+	resources.nexusEndpoints, err = s.repos.Nexus.ListEndpoints(ctx, orgID, planResourceLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Nexus endpoints: %w", err)
+	}
+	if plan.HardUsageLimits {
+		resources.usage, err = s.currentUsage(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return planViolations(plan, resources), nil
+}
+
+// currentUsage sums an organization's usage in the current billing period.
+func (s *EntitlementService) currentUsage(ctx context.Context, orgID uuid.UUID) (*repository.UsageRecord, error) {
+	now := s.now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	usage, err := s.repos.Usage.GetSummaryByOrganization(ctx, orgID, start, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+	return usage, nil
+}
+
+// planResources are the resources of an organization that plans limit.
+type planResources struct {
+	namespaces        []*repository.Namespace
+	exportSinks       []*repository.ExportSink
+	connectivityRules []*repository.ConnectivityRule
+	nexusEndpoints    []*repository.NexusEndpoint
+	// usage is only set if the plan has hard usage limits.
+	usage *repository.UsageRecord
+}
+
+func planViolations(plan *repository.Plan, resources *planResources) []PlanViolation {
+	var violations []PlanViolation
+	add := func(resourceType, resourceID, format string, args ...any) {
+		violations = append(violations, PlanViolation{
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      fmt.Sprintf(format, args...),
+		})
+	}
+
+	var haNamespaces []*repository.Namespace
+	for _, ns := range resources.namespaces {
+		if ns.RetentionDays > plan.MaxRetentionDays {
+			add("namespace", ns.ID, "retention of %d days exceeds the %s plan limit of %d days", ns.RetentionDays, plan.Tier, plan.MaxRetentionDays)
+		}
+		if ns.HAEnabled || ns.StandbyRegion.Valid {
+			haNamespaces = append(haNamespaces, ns)
+		}
+	}
+	if len(resources.namespaces) > plan.MaxNamespaces {
+		add("namespace", "", "organization has %d namespaces; the %s plan allows %d", len(resources.namespaces), plan.Tier, plan.MaxNamespaces)
+	}
+	if len(haNamespaces) > plan.MaxHANamespaces {
+		for _, ns := range haNamespaces {
+			add("namespace", ns.ID, "namespace has a standby region; the %s plan allows %d such namespaces", plan.Tier, plan.MaxHANamespaces)
+		}
+	}
+
+	if !plan.HasFeature(FeatureHistoryExport) {
+		for _, sink := range resources.exportSinks {
+			if sink.Enabled {
+				add("export_sink", sink.ID.String(), "the %s plan does not include history export", plan.Tier)
+			}
+		}
+	}
+	if !plan.HasFeature(FeaturePrivateConnectivity) {
+		for _, rule := range resources.connectivityRules {
+			if isPrivateConnectivity(rule.Type) {
+				add("connectivity_rule", rule.ID.String(), "the %s plan does not include private connectivity", plan.Tier)
+			}
+		}
+	}
+	if !plan.HasFeature(FeatureNexus) {
+		for _, endpoint := range resources.nexusEndpoints {
+			if !endpoint.DeletedAt.Valid {
+				add("nexus_endpoint", endpoint.ID.String(), "the %s plan does not include Nexus", plan.Tier)
+			}
+		}
+	}
+
+	if plan.HardUsageLimits && resources.usage != nil {
+		violations = append(violations, usageViolations(plan, resources.usage)...)
+	}
+	return violations
+}
+
+// usageViolations reports usage beyond what a plan includes.
+func usageViolations(plan *repository.Plan, usage *repository.UsageRecord) []PlanViolation {
+	var violations []PlanViolation
+	if usage.ActionCount > plan.ActionsIncluded {
+		violations = append(violations, PlanViolation{
+			ResourceType: "usage",
+			ResourceID:   "actions",
+			Message:      fmt.Sprintf("%d actions this period exceed the %d included in the %s plan", usage.ActionCount, plan.ActionsIncluded, plan.Tier),
+		})
+	}
+	if included := plan.ActiveStorageGB.Mul(hoursPerBillingPeriod); usage.ActiveStorageGBH.GreaterThan(included) {
+		violations = append(violations, PlanViolation{
+			ResourceType: "usage",
+			ResourceID:   "active_storage",
+			Message:      fmt.Sprintf("%s active storage GB-hours this period exceed the %s included in the %s plan", usage.ActiveStorageGBH, included, plan.Tier),
+		})
+	}
+	if included := plan.RetainedStorageGB.Mul(hoursPerBillingPeriod); usage.RetainedStorageGBH.GreaterThan(included) {
+		violations = append(violations, PlanViolation{
+			ResourceType: "usage",
+			ResourceID:   "retained_storage",
+			Message:      fmt.Sprintf("%s retained storage GB-hours this period exceed the %s included in the %s plan", usage.RetainedStorageGBH, included, plan.Tier),
+		})
+	}
+	return violations
+}
+
+func isPrivateConnectivity(ruleType string) bool {
+	return ruleType == repository.ConnectivityRuleTypePrivateLink || ruleType == repository.ConnectivityRuleTypeVPCPeering
+}
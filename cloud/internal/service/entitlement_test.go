@@ -0,0 +1,76 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+func TestPlanViolations(t *testing.T) {
+	free := &repository.Plan{
+		Tier:              "free",
+		ActionsIncluded:   100000,
+		ActiveStorageGB:   decimal.RequireFromString("0.1"),
+		RetainedStorageGB: decimal.NewFromInt(4),
+		HardUsageLimits:   true,
+		MaxNamespaces:     1,
+		MaxRetentionDays:  7,
+	}
+	business := &repository.Plan{
+		Tier:              "business",
+		ActionsIncluded:   2500000,
+		ActiveStorageGB:   decimal.RequireFromString("2.5"),
+		RetainedStorageGB: decimal.NewFromInt(100),
+		MaxNamespaces:     20,
+		MaxRetentionDays:  90,
+		MaxHANamespaces:   5,
+		Features:          []string{FeatureHistoryExport, FeaturePrivateConnectivity, FeatureNexus},
+	}
+
+	sinkID := uuid.New()
+	ruleID := uuid.New()
+	endpointID := uuid.New()
+	resources := &planResources{
+		namespaces: []*repository.Namespace{
+			{ID: "orders.a1b2c3d4", RetentionDays: 30, HAEnabled: true, StandbyRegion: sql.NullString{String: "us-west-2", Valid: true}},
+			{ID: "payments.a1b2c3d4", RetentionDays: 7},
+		},
+		exportSinks: []*repository.ExportSink{
+			{ID: sinkID, Enabled: true},
+			{ID: uuid.New(), Enabled: false},
+		},
+		connectivityRules: []*repository.ConnectivityRule{
+			{ID: ruleID, Type: repository.ConnectivityRuleTypePrivateLink},
+			{ID: uuid.New(), Type: repository.ConnectivityRuleTypeIPAllowlist},
+		},
+		nexusEndpoints: []*repository.NexusEndpoint{
+			{ID: endpointID},
+			{ID: uuid.New(), DeletedAt: sql.NullTime{Valid: true}},
+		},
+		usage: &repository.UsageRecord{
+			ActionCount:        250000,
+			ActiveStorageGBH:   decimal.NewFromInt(10),
+			RetainedStorageGBH: decimal.NewFromInt(10),
+		},
+	}
+
+	require.Empty(t, planViolations(business, resources))
+
+	var got [][2]string
+	for _, v := range planViolations(free, resources) {
+		got = append(got, [2]string{v.ResourceType, v.ResourceID})
+	}
+	require.Equal(t, [][2]string{
+		{"namespace", "orders.a1b2c3d4"},
+		{"namespace", ""},
+		{"namespace", "orders.a1b2c3d4"},
+		{"export_sink", sinkID.String()},
+		{"connectivity_rule", ruleID.String()},
+		{"nexus_endpoint", endpointID.String()},
+		{"usage", "actions"},
+	}, got)
+}
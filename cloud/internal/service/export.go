@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/export"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// ExportSinkInput is the input for creating or updating an export sink.
+type ExportSinkInput struct {
+	NamespaceID string
+	Name        string
+	SinkType    string
+	Config      json.RawMessage
+	Enabled     bool
+}
+
+func (input *ExportSinkInput) validate() error {
+	if input.Name == "" {
+		return serviceerror.NewInvalidArgument("export sink name is required")
+	}
+	// File sinks write to the workers' disks and are for development only.
+	if input.SinkType != export.SinkTypeS3 && input.SinkType != export.SinkTypeGCS {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("unsupported export sink type %q", input.SinkType))
+	}
+	if err := export.ValidateSink(input.SinkType, input.Config); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	return nil
+}
+
+// CreateExportSink adds an export sink to a namespace. Its first export covers
+// the workflows that close after it is created.
+func (s *NamespaceService) CreateExportSink(ctx context.Context, input *ExportSinkInput) (*repository.ExportSink, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	if _, err := s.getExistingNamespace(ctx, input.NamespaceID); err != nil {
+		return nil, err
+	}
+	if err := s.checkExportSinkName(ctx, input.NamespaceID, input.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	sink := &repository.ExportSink{
+		NamespaceID: input.NamespaceID,
+		Name:        input.Name,
+		SinkType:    input.SinkType,
+		Config:      input.Config,
+		Enabled:     input.Enabled,
+	}
+	if err := s.repos.Exports.CreateSink(ctx, sink); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+// GetExportSink retrieves an export sink of a namespace.
+func (s *NamespaceService) GetExportSink(ctx context.Context, namespaceID string, sinkID uuid.UUID) (*repository.ExportSink, error) {
+	sink, err := s.repos.Exports.GetSink(ctx, namespaceID, sinkID)
+	if err != nil {
+		return nil, err
+	}
+	if sink == nil {
+		return nil, serviceerror.NewNotFound("export sink not found")
+	}
+	return sink, nil
+}
+
+// ListExportSinks lists the export sinks of a namespace.
+func (s *NamespaceService) ListExportSinks(ctx context.Context, namespaceID string) ([]*repository.ExportSink, error) {
+	if _, err := s.getExistingNamespace(ctx, namespaceID); err != nil {
+		return nil, err
+	}
+	return s.repos.Exports.ListSinks(ctx, namespaceID)
+}
+
+// UpdateExportSink replaces an export sink's name, destination and enabled
+// state. Changing the destination does not re-export earlier histories.
+func (s *NamespaceService) UpdateExportSink(ctx context.Context, sinkID uuid.UUID, input *ExportSinkInput) (*repository.ExportSink, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	sink, err := s.GetExportSink(ctx, input.NamespaceID, sinkID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkExportSinkName(ctx, input.NamespaceID, input.Name, sinkID); err != nil {
+		return nil, err
+	}
+
+	sink.Name = input.Name
+	sink.SinkType = input.SinkType
+	sink.Config = input.Config
+	sink.Enabled = input.Enabled
+	if err := s.repos.Exports.UpdateSink(ctx, sink); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+// DeleteExportSink deletes an export sink and its jobs. Histories already
+// exported are left in place.
+func (s *NamespaceService) DeleteExportSink(ctx context.Context, namespaceID string, sinkID uuid.UUID) error {
+	deleted, err := s.repos.Exports.DeleteSink(ctx, namespaceID, sinkID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return serviceerror.NewNotFound("export sink not found")
+	}
+	return nil
+}
+
+// ListExportJobs lists the jobs of an export sink, most recent first.
+func (s *NamespaceService) ListExportJobs(ctx context.Context, namespaceID string, sinkID uuid.UUID, limit, offset int) ([]*repository.ExportJob, error) {
+	if _, err := s.GetExportSink(ctx, namespaceID, sinkID); err != nil {
+		return nil, err
+	}
+	return s.repos.Exports.ListJobs(ctx, sinkID, limit, offset)
+}
+
+// ExportExternalID returns the external ID the namespace's exports present
+// when assuming a role in the bucket owner's account: the ID of the
+// organization owning the namespace. Bucket owners require it in the role's
+// trust policy so that other organizations cannot write with the role.
+func (s *NamespaceService) ExportExternalID(ctx context.Context, namespaceID string) (string, error) {
+	ns, err := s.getExistingNamespace(ctx, namespaceID)
+	if err != nil {
+		return "", err
+	}
+	return ns.OrganizationID.String(), nil
+}
+
+// checkExportSinkName fails if another sink of the namespace than sinkID has
+// the name.
+func (s *NamespaceService) checkExportSinkName(ctx context.Context, namespaceID, name string, sinkID uuid.UUID) error {
+	existing, err := s.repos.Exports.GetSinkByName(ctx, namespaceID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != sinkID {
+		return serviceerror.NewAlreadyExists(fmt.Sprintf("export sink %q already exists", name))
+	}
+	return nil
+}
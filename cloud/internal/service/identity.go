@@ -5,10 +5,12 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/cloud/internal/config"
 	"go.temporal.io/cloud/internal/repository"
 	"go.temporal.io/server/common/log"
@@ -16,22 +18,40 @@ import (
 
 // IdentityService handles identity and authentication business logic.
 type IdentityService struct {
-	repos     *repository.Repositories
-	jwtConfig config.JWTConfig
-	logger    log.Logger
+	repos      *repository.Repositories
+	jwtConfig  config.JWTConfig
+	samlConfig config.SAMLConfig
+	logger     log.Logger
+
+	// lookupTXT resolves domain verification records.
+	lookupTXT func(ctx context.Context, name string) ([]string, error)
 }
 
 // NewIdentityService creates a new identity service.
-func NewIdentityService(repos *repository.Repositories, jwtCfg config.JWTConfig, logger log.Logger) *IdentityService {
-	return &IdentityService{repos: repos, jwtConfig: jwtCfg, logger: logger}
+func NewIdentityService(repos *repository.Repositories, jwtCfg config.JWTConfig, samlCfg config.SAMLConfig, logger log.Logger) *IdentityService {
+	return &IdentityService{
+		repos:      repos,
+		jwtConfig:  jwtCfg,
+		samlConfig: samlCfg,
+		logger:     logger,
+		lookupTXT:  net.DefaultResolver.LookupTXT,
+	}
 }
 
+// APIKeyOwnerServiceAccount is the owner type of API keys owned by service
+// accounts.
+const APIKeyOwnerServiceAccount = "service_account"
+
 // APIKeyInfo contains validated API key information.
 type APIKeyInfo struct {
 	ID          uuid.UUID
 	OwnerID     uuid.UUID
 	OwnerType   string
 	Permissions []string
+	// OrganizationID and Role are set for keys owned by service accounts,
+	// whose namespace permissions are included in Permissions.
+	OrganizationID uuid.UUID
+	Role           string
 }
 
 // ValidateAPIKey validates an API key hash and returns key info.
@@ -58,12 +78,27 @@ func (s *IdentityService) ValidateAPIKey(ctx context.Context, keyHash string) (*
 		_ = json.Unmarshal(key.Permissions, &permissions)
 	}
 
-	return &APIKeyInfo{
+	info := &APIKeyInfo{
 		ID:          key.ID,
 		OwnerID:     key.OwnerID,
 		OwnerType:   key.OwnerType,
 		Permissions: permissions,
-	}, nil
+	}
+	if key.OwnerType == APIKeyOwnerServiceAccount {
+		// Resolved on every use, so that changes to the service account's
+		// permissions take effect immediately.
+		sa, err := s.ServiceAccountPermissions(ctx, key.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		if sa == nil {
+			return nil, nil
+		}
+		info.OrganizationID = sa.OrganizationID
+		info.Role = sa.Role
+		info.Permissions = append(info.Permissions, sa.Permissions...)
+	}
+	return info, nil
 }
 
 // ValidateToken validates a JWT token and returns claims.
@@ -123,12 +158,15 @@ func (s *IdentityService) GenerateTokens(ctx context.Context, userID uuid.UUID,
 	}
 
 	// Refresh token
+	// The refresh token is bound to the organization, so refreshing cannot
+	// widen the session beyond it.
 	refreshClaims := jwt.MapClaims{
-		"sub":  userID.String(),
-		"type": "refresh",
-		"iss":  s.jwtConfig.Issuer,
-		"iat":  now.Unix(),
-		"exp":  now.Add(s.jwtConfig.RefreshExpiry).Unix(),
+		"sub":    userID.String(),
+		"type":   "refresh",
+		"org_id": orgID.String(),
+		"iss":    s.jwtConfig.Issuer,
+		"iat":    now.Unix(),
+		"exp":    now.Add(s.jwtConfig.RefreshExpiry).Unix(),
 	}
 
 	refreshJWT := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
@@ -151,6 +189,16 @@ type CreateAPIKeyInput struct {
 
 // CreateAPIKey creates a new API key.
 func (s *IdentityService) CreateAPIKey(ctx context.Context, input *CreateAPIKeyInput) (*repository.APIKey, string, error) {
+	if input.OwnerType == APIKeyOwnerServiceAccount {
+		sa, err := s.repos.ServiceAccounts.GetByID(ctx, input.OwnerID)
+		if err != nil {
+			return nil, "", err
+		}
+		if sa == nil {
+			return nil, "", serviceerror.NewNotFound("service account not found")
+		}
+	}
+
 	// Generate key
 	plaintext, prefix, hash, err := repository.GenerateAPIKey()
 	if err != nil {
@@ -202,7 +250,7 @@ func (s *IdentityService) RotateAPIKey(ctx context.Context, id uuid.UUID) (*repo
 		return nil, "", err
 	}
 	if existing == nil {
-		return nil, "", fmt.Errorf("API key not found")
+		return nil, "", serviceerror.NewNotFound("API key not found")
 	}
 
 	// Disable old key
@@ -251,3 +299,21 @@ func (s *IdentityService) CreateUser(ctx context.Context, email, name string) (*
 
 	return user, nil
 }
+
+// UpdateUser updates a user's profile.
+func (s *IdentityService) UpdateUser(ctx context.Context, id uuid.UUID, name string) (*repository.User, error) {
+	user, err := s.repos.Users.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, serviceerror.NewNotFound("user not found")
+	}
+
+	user.Name = sql.NullString{String: name, Valid: name != ""}
+	if err := s.repos.Users.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return user, nil
+}
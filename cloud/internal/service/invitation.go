@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/config"
+	cloudmail "go.temporal.io/cloud/internal/mail"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// invitationTokenAudience is the audience of invitation tokens.
+	invitationTokenAudience = "temporal-cloud-invitation"
+	// invitationResendInterval is how soon an invitation can be sent again.
+	invitationResendInterval = time.Minute
+)
+
+// InvitationStatusExpired is the status of pending invitations past their
+// expiry. It is not stored.
+const InvitationStatusExpired = "expired"
+
+// InvitationStatus returns the status of an invitation at a time.
+func InvitationStatus(inv *repository.UserInvitation, now time.Time) string {
+	if inv.Status == repository.InvitationStatusPending && !now.Before(inv.ExpiresAt) {
+		return InvitationStatusExpired
+	}
+	return inv.Status
+}
+
+// InvitationService handles organization invitations: inviting users by
+// email, and their acceptance or refusal. Invitation emails carry a signed
+// token; only its hash is stored.
+type InvitationService struct {
+	repos     *repository.Repositories
+	jwtConfig config.JWTConfig
+	config    config.InvitationConfig
+	mailer    cloudmail.Mailer
+	logger    log.Logger
+}
+
+// NewInvitationService creates a new invitation service. Tokens are signed
+// with the invitation secret key, never the JWT one, so that they cannot be
+// used as session tokens, and are issued by the JWT issuer.
+func NewInvitationService(repos *repository.Repositories, jwtCfg config.JWTConfig, cfg config.InvitationConfig, mailer cloudmail.Mailer, logger log.Logger) *InvitationService {
+	return &InvitationService{repos: repos, jwtConfig: jwtCfg, config: cfg, mailer: mailer, logger: logger}
+}
+
+// InviteUserInput is the input for inviting a user.
+type InviteUserInput struct {
+	OrganizationID uuid.UUID
+	Email          string
+	Role           string
+	InvitedBy      uuid.UUID
+}
+
+// InviteUser invites a user to an organization and emails them the
+// invitation. Inviting an email again replaces its invitation, and the
+// tokens sent before no longer work.
+func (s *InvitationService) InviteUser(ctx context.Context, input *InviteUserInput) (*repository.UserInvitation, error) {
+	if !isValidOrgRole(input.Role) {
+		return nil, serviceerror.NewInvalidArgumentf("invalid role: %s", input.Role)
+	}
+	email, err := normalizeEmail(input.Email)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.repos.Organizations.GetByID(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+	user, err := s.repos.Users.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		member, err := s.repos.Organizations.GetMember(ctx, org.ID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if member != nil {
+			return nil, serviceerror.NewAlreadyExists("user is already a member of the organization")
+		}
+	}
+
+	inv := &repository.UserInvitation{
+		OrganizationID: org.ID,
+		Email:          email,
+		Role:           input.Role,
+		InvitedBy:      input.InvitedBy,
+		// The invitation is created with an unusable token, replaced by the
+		// token that is sent, which names the invitation's ID.
+		Token:     hashInvitationToken(uuid.NewString()),
+		ExpiresAt: time.Now().Add(s.config.Expiry),
+	}
+	if err := s.repos.Users.CreateInvitation(ctx, inv); err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+	if err := s.send(ctx, org, inv); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// GetInvitation retrieves an invitation.
+func (s *InvitationService) GetInvitation(ctx context.Context, id uuid.UUID) (*repository.UserInvitation, error) {
+	inv, err := s.repos.Users.GetInvitation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil {
+		return nil, serviceerror.NewNotFound("invitation not found")
+	}
+	return inv, nil
+}
+
+// ListInvitations lists the invitations of an organization, newest first.
+func (s *InvitationService) ListInvitations(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*repository.UserInvitation, error) {
+	return s.repos.Users.ListInvitations(ctx, orgID, limit, offset)
+}
+
+// ResendInvitation emails a pending or expired invitation again with a new
+// token and expiry.
+func (s *InvitationService) ResendInvitation(ctx context.Context, id uuid.UUID) (*repository.UserInvitation, error) {
+	inv, err := s.GetInvitation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv.Status != repository.InvitationStatusPending {
+		return nil, serviceerror.NewFailedPreconditionf("invitation was already %s", inv.Status)
+	}
+	if inv.LastSentAt.Valid && time.Since(inv.LastSentAt.Time) < invitationResendInterval {
+		return nil, serviceerror.NewFailedPrecondition("invitation was sent less than a minute ago")
+	}
+	org, err := s.repos.Organizations.GetByID(ctx, inv.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+
+	inv.ExpiresAt = time.Now().Add(s.config.Expiry)
+	if err := s.send(ctx, org, inv); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// RevokeInvitation deletes an invitation that was not accepted.
+func (s *InvitationService) RevokeInvitation(ctx context.Context, id uuid.UUID) error {
+	inv, err := s.GetInvitation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inv.Status == repository.InvitationStatusAccepted {
+		return serviceerror.NewFailedPrecondition("invitation was already accepted")
+	}
+	if _, err := s.repos.Users.DeleteInvitation(ctx, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AcceptInvitation accepts the invitation with the token on behalf of the
+// user it was sent to, making them a member of its organization with its
+// role. A user who already is a member keeps their role.
+func (s *InvitationService) AcceptInvitation(ctx context.Context, token string, userID uuid.UUID) (*repository.UserInvitation, error) {
+	inv, err := s.pendingInvitation(ctx, token, userID)
+	if err != nil {
+		return nil, err
+	}
+	accepted, err := s.repos.Users.AcceptInvitation(ctx, inv.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, serviceerror.NewFailedPrecondition("invitation is no longer pending")
+	}
+	return s.GetInvitation(ctx, inv.ID)
+}
+
+// DeclineInvitation declines the invitation with the token on behalf of the
+// user it was sent to.
+func (s *InvitationService) DeclineInvitation(ctx context.Context, token string, userID uuid.UUID) error {
+	inv, err := s.pendingInvitation(ctx, token, userID)
+	if err != nil {
+		return err
+	}
+	declined, err := s.repos.Users.DeclineInvitation(ctx, inv.ID)
+	if err != nil {
+		return err
+	}
+	if !declined {
+		return serviceerror.NewFailedPrecondition("invitation is no longer pending")
+	}
+	return nil
+}
+
+// pendingInvitation returns the pending invitation with the token, checking
+// that it was sent to the user.
+func (s *InvitationService) pendingInvitation(ctx context.Context, token string, userID uuid.UUID) (*repository.UserInvitation, error) {
+	id, err := s.parseToken(token)
+	if err != nil {
+		return nil, err
+	}
+	// Tokens replaced by a resend are not found.
+	inv, err := s.repos.Users.GetInvitationByToken(ctx, hashInvitationToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil || inv.ID != id {
+		return nil, serviceerror.NewNotFound("invitation not found")
+	}
+	switch InvitationStatus(inv, time.Now()) {
+	case repository.InvitationStatusPending:
+	case InvitationStatusExpired:
+		return nil, serviceerror.NewFailedPrecondition("invitation has expired")
+	default:
+		return nil, serviceerror.NewFailedPreconditionf("invitation was already %s", inv.Status)
+	}
+
+	user, err := s.repos.Users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !strings.EqualFold(user.Email, inv.Email) {
+		return nil, serviceerror.NewPermissionDenied("invitation was sent to another email address", "")
+	}
+	return inv, nil
+}
+
+// send emails an invitation with a new token and records it, replacing the
+// invitation's previous token.
+func (s *InvitationService) send(ctx context.Context, org *repository.Organization, inv *repository.UserInvitation) error {
+	token, err := s.signToken(inv)
+	if err != nil {
+		return err
+	}
+	inviter := "A member"
+	if user, err := s.repos.Users.GetByID(ctx, inv.InvitedBy); err == nil && user != nil {
+		inviter = user.Email
+		if user.Name.String != "" {
+			inviter = user.Name.String
+		}
+	}
+	link, err := url.Parse(s.config.AcceptURL)
+	if err != nil {
+		return fmt.Errorf("invalid invitation accept URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	msg := &cloudmail.Message{
+		To:      inv.Email,
+		Subject: fmt.Sprintf("You're invited to join %s on Temporal Cloud", org.Name),
+		Text: fmt.Sprintf(`%s invited you to join the %s organization on Temporal Cloud as %s.
+
+Accept or decline the invitation:
+%s
+
+The invitation expires on %s. If you were not expecting it, you can ignore this email.
+`, inviter, org.Name, strings.ReplaceAll(inv.Role, "_", " "), link, inv.ExpiresAt.UTC().Format("January 2, 2006 at 15:04 MST")),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		s.logger.Error("Failed to send invitation email", tag.NewStringTag("invitation", inv.ID.String()), tag.Error(err))
+		return serviceerror.NewUnavailable("failed to send invitation email")
+	}
+
+	inv.Token = hashInvitationToken(token)
+	return s.repos.Users.RecordInvitationSent(ctx, inv)
+}
+
+func (s *InvitationService) signToken(inv *repository.UserInvitation) (string, error) {
+	claims := jwt.MapClaims{
+		"sub": inv.ID.String(),
+		"org": inv.OrganizationID.String(),
+		"iss": s.jwtConfig.Issuer,
+		"aud": invitationTokenAudience,
+		"iat": time.Now().Unix(),
+		"exp": inv.ExpiresAt.Unix(),
+		// Tokens of the same invitation differ, so that a resend replaces
+		// the previous token even within the same second.
+		"jti": uuid.NewString(),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.config.SecretKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign invitation token: %w", err)
+	}
+	return token, nil
+}
+
+// parseToken verifies an invitation token and returns the invitation ID it
+// names.
+func (s *InvitationService) parseToken(token string) (uuid.UUID, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return []byte(s.config.SecretKey), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.jwtConfig.Issuer),
+		jwt.WithAudience(invitationTokenAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return uuid.Nil, serviceerror.NewFailedPrecondition("invitation has expired")
+	}
+	if err != nil {
+		return uuid.Nil, serviceerror.NewInvalidArgument("invalid invitation token")
+	}
+	sub, _ := claims.GetSubject()
+	id, err := uuid.Parse(sub)
+	if err != nil {
+		return uuid.Nil, serviceerror.NewInvalidArgument("invalid invitation token")
+	}
+	return id, nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeEmail validates a bare email address and lowercases it.
+func normalizeEmail(email string) (string, error) {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Name != "" || addr.Address != strings.TrimSpace(email) {
+		return "", serviceerror.NewInvalidArgumentf("invalid email: %s", email)
+	}
+	return strings.ToLower(addr.Address), nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/server/common/log"
+)
+
+func TestInvitationTokensAreNotSessionTokens(t *testing.T) {
+	jwtCfg := config.JWTConfig{SecretKey: "session-secret", Issuer: "temporal-cloud", Audience: "temporal-cloud-api", AccessExpiry: time.Hour}
+	invitations := NewInvitationService(nil, jwtCfg, config.InvitationConfig{SecretKey: "invitation-secret"}, nil, log.NewNoopLogger())
+	identity := NewIdentityService(nil, jwtCfg, config.SAMLConfig{}, log.NewNoopLogger())
+
+	inv := &repository.UserInvitation{ID: uuid.New(), OrganizationID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour)}
+	token, err := invitations.signToken(inv)
+	require.NoError(t, err)
+	id, err := invitations.parseToken(token)
+	require.NoError(t, err)
+	require.Equal(t, inv.ID, id)
+	_, err = identity.ValidateToken(context.Background(), token)
+	require.Error(t, err)
+
+	session, _, _, err := identity.GenerateTokens(context.Background(), uuid.New(), "alice@example.com", uuid.Nil, "")
+	require.NoError(t, err)
+	_, err = invitations.parseToken(session)
+	require.Error(t, err)
+}
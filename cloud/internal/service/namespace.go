@@ -9,19 +9,66 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/ca"
 	"go.temporal.io/cloud/internal/repository"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
+// NamespaceFailoverStarter runs the workflows that fail namespaces over.
+type NamespaceFailoverStarter interface {
+	// StartFailover starts failing the namespace, which is replicated to
+	// clusterID, over to targetRegion and returns the operation ID.
+	StartFailover(ctx context.Context, namespaceID, targetRegion, clusterID string) (string, error)
+}
+
+// NamespaceOperationStarter runs the workflows that apply namespace changes to
+// the Temporal clusters. The operation ID of a change is the ID of its
+// workflow.
+type NamespaceOperationStarter interface {
+	// StartProvisioning starts placing the namespace on a cluster and
+	// registering it there. The workflow makes the namespace active, or
+	// failed if it cannot be provisioned.
+	StartProvisioning(ctx context.Context, operationID string, ns *repository.Namespace) error
+	// StartUpdate starts applying the namespace's retention and the given new
+	// search attributes to the cluster hosting it. The workflow makes the
+	// namespace active again when it is done.
+	StartUpdate(ctx context.Context, operationID string, ns *repository.Namespace, searchAttributes map[string]string) error
+	// StartDeletion starts deleting the namespace from its cluster, if any.
+	// The workflow marks the namespace deleted.
+	StartDeletion(ctx context.Context, operationID string, ns *repository.Namespace) error
+}
+
+// ClientCertificateIssuer issues client certificates signed by a namespace's
+// CA. It is implemented by ca.Authority.
+type ClientCertificateIssuer interface {
+	IssueClientCertificate(ctx context.Context, namespaceID, commonName string) (*ca.IssuedCertificate, error)
+}
+
 // NamespaceService handles namespace business logic.
 type NamespaceService struct {
-	repos  *repository.Repositories
-	logger log.Logger
+	repos        *repository.Repositories
+	entitlements *EntitlementService
+	operations   NamespaceOperationStarter
+	failover     NamespaceFailoverStarter
+	certificates ClientCertificateIssuer
+	logger       log.Logger
 }
 
-// NewNamespaceService creates a new namespace service.
-func NewNamespaceService(repos *repository.Repositories, logger log.Logger) *NamespaceService {
-	return &NamespaceService{repos: repos, logger: logger}
+// NewNamespaceService creates a new namespace service. Creating and deleting
+// namespaces and changes that need the namespace's cluster to be updated are
+// unavailable if operations is nil, failover if failover is nil, and issuing client certificates if
+// certificates is nil.
+func NewNamespaceService(repos *repository.Repositories, operations NamespaceOperationStarter, failover NamespaceFailoverStarter, certificates ClientCertificateIssuer, logger log.Logger) *NamespaceService {
+	return &NamespaceService{
+		repos:        repos,
+		entitlements: NewEntitlementService(repos),
+		operations:   operations,
+		failover:     failover,
+		certificates: certificates,
+		logger:       logger,
+	}
 }
 
 // CreateNamespaceInput is the input for creating a namespace.
@@ -36,35 +83,47 @@ type CreateNamespaceInput struct {
 	Tags              map[string]string
 }
 
-// CreateNamespace creates a new namespace.
+// CreateNamespace creates a new namespace and starts the workflow that
+// provisions it. The ID of the workflow is returned as the operation ID.
 func (s *NamespaceService) CreateNamespace(ctx context.Context, input *CreateNamespaceInput) (*repository.Namespace, string, error) {
+	if s.operations == nil {
+		return nil, "", serviceerror.NewUnimplemented("creating namespaces is not available")
+	}
+
 	// Validate name
 	if !isValidNamespaceName(input.Name) {
-		return nil, "", fmt.Errorf("invalid namespace name: must be 2-63 lowercase alphanumeric characters, hyphens, or underscores")
+		return nil, "", serviceerror.NewInvalidArgument("invalid namespace name: must be 2-63 lowercase alphanumeric characters, hyphens, or underscores")
 	}
 
 	// Validate region
 	if !isValidRegion(input.Region) {
-		return nil, "", fmt.Errorf("invalid region: %s", input.Region)
+		return nil, "", serviceerror.NewInvalidArgumentf("invalid region: %s", input.Region)
 	}
 
-	// Check subscription limits
-	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, input.OrganizationID)
+	// Check plan limits
+	_, plan, err := s.entitlements.SubscriptionPlan(ctx, input.OrganizationID)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to get subscription: %w", err)
-	}
-	if sub == nil {
-		return nil, "", fmt.Errorf("no subscription found")
+		return nil, "", err
 	}
 
-	// Check namespace count limit
-	namespaces, err := s.repos.Namespaces.ListByOrganization(ctx, input.OrganizationID, 1000, 0)
+	// Check namespace count limits
+	namespaces, err := s.repos.Namespaces.ListByOrganization(ctx, input.OrganizationID, planResourceLimit, 0)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to list namespaces: %w", err)
 	}
-	maxNamespaces := getMaxNamespaces(sub.Plan)
-	if len(namespaces) >= maxNamespaces {
-		return nil, "", fmt.Errorf("namespace limit reached for plan %s", sub.Plan)
+	if len(namespaces) >= plan.MaxNamespaces {
+		return nil, "", serviceerror.NewFailedPreconditionf("namespace limit reached for plan %s", plan.Tier)
+	}
+	if input.HAEnabled || input.StandbyRegion != "" {
+		var haNamespaces int
+		for _, ns := range namespaces {
+			if ns.HAEnabled || ns.StandbyRegion.Valid {
+				haNamespaces++
+			}
+		}
+		if haNamespaces >= plan.MaxHANamespaces {
+			return nil, "", serviceerror.NewFailedPreconditionf("standby region limit reached for plan %s", plan.Tier)
+		}
 	}
 
 	// Check if name is taken
@@ -73,7 +132,7 @@ func (s *NamespaceService) CreateNamespace(ctx context.Context, input *CreateNam
 		return nil, "", fmt.Errorf("failed to check namespace name: %w", err)
 	}
 	if existing != nil {
-		return nil, "", fmt.Errorf("namespace name already exists")
+		return nil, "", serviceerror.NewAlreadyExists("namespace name already exists")
 	}
 
 	// Set defaults
@@ -81,9 +140,8 @@ func (s *NamespaceService) CreateNamespace(ctx context.Context, input *CreateNam
 	if retentionDays == 0 {
 		retentionDays = 7
 	}
-	maxRetention := getMaxRetention(sub.Plan)
-	if retentionDays > maxRetention {
-		return nil, "", fmt.Errorf("retention period exceeds plan limit of %d days", maxRetention)
+	if retentionDays > plan.MaxRetentionDays {
+		return nil, "", serviceerror.NewInvalidArgumentf("retention period exceeds plan limit of %d days", plan.MaxRetentionDays)
 	}
 
 	// Generate namespace ID
@@ -112,17 +170,15 @@ func (s *NamespaceService) CreateNamespace(ctx context.Context, input *CreateNam
 		return nil, "", fmt.Errorf("failed to create namespace: %w", err)
 	}
 
-	// Generate operation ID for async provisioning
 	operationID := uuid.New().String()
-
-	// TODO: Start provisioning workflow
-	// This would trigger a Temporal workflow to:
-	// 1. Select target cluster
-	// 2. Generate mTLS certificates
-	// 3. Call Temporal RegisterNamespace API
-	// 4. Configure retention, limits, search attrs
-	// 5. Create DNS record
-	// 6. Update namespace state to 'active'
+	if err := s.operations.StartProvisioning(ctx, operationID, ns); err != nil {
+		// Nothing was provisioned; free the name so that creating the
+		// namespace can be retried.
+		if deleteErr := s.repos.Namespaces.Delete(ctx, ns.ID); deleteErr != nil {
+			s.logger.Error("Failed to remove unprovisioned namespace", tag.WorkflowNamespace(ns.ID), tag.Error(deleteErr))
+		}
+		return nil, "", err
+	}
 
 	return ns, operationID, nil
 }
@@ -142,20 +198,33 @@ type UpdateNamespaceInput struct {
 	Tags              map[string]string
 }
 
-// UpdateNamespace updates a namespace.
+// UpdateNamespace updates a namespace. Settings kept by the cloud API are
+// updated in place. Changing the retention period starts a workflow that
+// applies it to the namespace's cluster; its ID is returned as the operation
+// ID, which is empty if no workflow was started.
 func (s *NamespaceService) UpdateNamespace(ctx context.Context, input *UpdateNamespaceInput) (*repository.Namespace, string, error) {
 	ns, err := s.repos.Namespaces.GetByID(ctx, input.ID)
 	if err != nil {
 		return nil, "", err
 	}
 	if ns == nil {
-		return nil, "", fmt.Errorf("namespace not found")
+		return nil, "", serviceerror.NewNotFound("namespace not found")
 	}
 
+	previousRetentionDays := ns.RetentionDays
 	if input.RetentionDays != nil {
 		// Can only increase retention
 		if *input.RetentionDays < ns.RetentionDays {
-			return nil, "", fmt.Errorf("retention period can only be increased")
+			return nil, "", serviceerror.NewInvalidArgument("retention period can only be increased")
+		}
+		if *input.RetentionDays > ns.RetentionDays {
+			_, plan, err := s.entitlements.SubscriptionPlan(ctx, ns.OrganizationID)
+			if err != nil {
+				return nil, "", err
+			}
+			if *input.RetentionDays > plan.MaxRetentionDays {
+				return nil, "", serviceerror.NewInvalidArgumentf("retention period exceeds plan limit of %d days", plan.MaxRetentionDays)
+			}
 		}
 		ns.RetentionDays = *input.RetentionDays
 	}
@@ -177,49 +246,94 @@ func (s *NamespaceService) UpdateNamespace(ctx context.Context, input *UpdateNam
 		ns.Tags = tagsJSON
 	}
 
-	ns.State = "updating"
+	updateCluster := ns.RetentionDays != previousRetentionDays
+	if updateCluster {
+		if err := s.checkClusterUpdatable(ns); err != nil {
+			return nil, "", err
+		}
+		ns.State = "updating"
+	}
 	if err := s.repos.Namespaces.Update(ctx, ns); err != nil {
 		return nil, "", fmt.Errorf("failed to update namespace: %w", err)
 	}
+	if !updateCluster {
+		return ns, "", nil
+	}
 
 	operationID := uuid.New().String()
-	// TODO: Start update workflow
+	if err := s.operations.StartUpdate(ctx, operationID, ns, nil); err != nil {
+		ns.State = "active"
+		ns.RetentionDays = previousRetentionDays
+		if rollbackErr := s.repos.Namespaces.Update(ctx, ns); rollbackErr != nil {
+			s.logger.Error("Failed to roll back namespace update", tag.WorkflowNamespace(ns.ID), tag.Error(rollbackErr))
+		}
+		return nil, "", err
+	}
 
 	return ns, operationID, nil
 }
 
-// DeleteNamespace deletes a namespace.
+// DeleteNamespace starts the workflow that deletes a namespace. The ID of the
+// workflow is returned as the operation ID. Namespaces that are still being
+// provisioned or updated cannot be deleted until their workflow is done.
 func (s *NamespaceService) DeleteNamespace(ctx context.Context, id string) (string, error) {
-	ns, err := s.repos.Namespaces.GetByID(ctx, id)
+	ns, err := s.getExistingNamespace(ctx, id)
 	if err != nil {
 		return "", err
 	}
-	if ns == nil {
-		return "", fmt.Errorf("namespace not found")
-	}
 
+	if s.operations == nil {
+		return "", serviceerror.NewUnimplemented("deleting namespaces is not available")
+	}
 	if ns.DeletionProtected {
-		return "", fmt.Errorf("namespace is deletion protected")
+		return "", serviceerror.NewFailedPrecondition("namespace is deletion protected")
 	}
-
-	ns.State = "deleting"
-	if err := s.repos.Namespaces.Update(ctx, ns); err != nil {
-		return "", fmt.Errorf("failed to update namespace state: %w", err)
+	if ns.State != "active" && ns.State != "failed" {
+		return "", serviceerror.NewFailedPreconditionf("namespace is %s and cannot be deleted", ns.State)
 	}
 
+	previousState := ns.State
+	if err := s.repos.Namespaces.UpdateState(ctx, ns.ID, "deleting"); err != nil {
+		return "", err
+	}
 	operationID := uuid.New().String()
-	// TODO: Start deletion workflow
+	if err := s.operations.StartDeletion(ctx, operationID, ns); err != nil {
+		if rollbackErr := s.repos.Namespaces.UpdateState(ctx, ns.ID, previousState); rollbackErr != nil {
+			s.logger.Error("Failed to roll back namespace state", tag.WorkflowNamespace(ns.ID), tag.Error(rollbackErr))
+		}
+		return "", err
+	}
 
 	return operationID, nil
 }
 
-// ListNamespaces lists namespaces for an organization.
-func (s *NamespaceService) ListNamespaces(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*repository.Namespace, error) {
-	return s.repos.Namespaces.ListByOrganization(ctx, orgID, limit, offset)
+// ListNamespaces lists namespaces for an organization matching the filter.
+func (s *NamespaceService) ListNamespaces(ctx context.Context, filter repository.NamespaceFilter, limit, offset int) ([]*repository.Namespace, error) {
+	return s.repos.Namespaces.List(ctx, filter, limit, offset)
+}
+
+// ListSearchAttributes lists the custom search attributes of a namespace.
+func (s *NamespaceService) ListSearchAttributes(ctx context.Context, namespaceID string) ([]*repository.NamespaceSearchAttribute, error) {
+	return s.repos.Namespaces.ListSearchAttributes(ctx, namespaceID)
+}
+
+// ListCertificateFilters lists the certificate filters of a namespace.
+func (s *NamespaceService) ListCertificateFilters(ctx context.Context, namespaceID string) ([]*repository.NamespaceCertificateFilter, error) {
+	return s.repos.Namespaces.ListCertificateFilters(ctx, namespaceID)
 }
 
-// AddSearchAttributes adds search attributes to a namespace.
+// AddSearchAttributes adds search attributes to a namespace and starts a
+// workflow that adds them to the namespace's cluster. The ID of the workflow
+// is returned as the operation ID.
 func (s *NamespaceService) AddSearchAttributes(ctx context.Context, namespaceID string, attrs map[string]string) (string, error) {
+	ns, err := s.getExistingNamespace(ctx, namespaceID)
+	if err != nil {
+		return "", err
+	}
+	if err := s.checkClusterUpdatable(ns); err != nil {
+		return "", err
+	}
+
 	for name, attrType := range attrs {
 		attr := &repository.NamespaceSearchAttribute{
 			NamespaceID: namespaceID,
@@ -232,12 +346,138 @@ func (s *NamespaceService) AddSearchAttributes(ctx context.Context, namespaceID
 		}
 	}
 
+	if err := s.repos.Namespaces.UpdateState(ctx, ns.ID, "updating"); err != nil {
+		return "", err
+	}
 	operationID := uuid.New().String()
-	// TODO: Start workflow to add search attributes to Temporal cluster
+	if err := s.operations.StartUpdate(ctx, operationID, ns, attrs); err != nil {
+		if rollbackErr := s.repos.Namespaces.UpdateState(ctx, ns.ID, "active"); rollbackErr != nil {
+			s.logger.Error("Failed to roll back namespace state", tag.WorkflowNamespace(ns.ID), tag.Error(rollbackErr))
+		}
+		return "", err
+	}
 
 	return operationID, nil
 }
 
+// RemoveSearchAttribute removes a search attribute from a namespace. Nothing
+// removes search attributes from the Temporal clusters yet, so it is rejected
+// rather than leaving the attribute registered on the cluster.
+func (s *NamespaceService) RemoveSearchAttribute(ctx context.Context, namespaceID, name string) (string, error) {
+	return "", serviceerror.NewUnimplemented("removing search attributes is not supported")
+}
+
+// AddCertificateFilterInput is the input for adding a certificate filter.
+type AddCertificateFilterInput struct {
+	NamespaceID            string
+	CommonName             string
+	Organization           string
+	OrganizationalUnit     string
+	SubjectAlternativeName string
+}
+
+// AddCertificateFilter adds a certificate filter to a namespace.
+func (s *NamespaceService) AddCertificateFilter(ctx context.Context, input *AddCertificateFilterInput) (*repository.NamespaceCertificateFilter, error) {
+	if input.CommonName == "" && input.Organization == "" && input.OrganizationalUnit == "" && input.SubjectAlternativeName == "" {
+		return nil, serviceerror.NewInvalidArgument("certificate filter must set at least one field")
+	}
+	if _, err := s.getExistingNamespace(ctx, input.NamespaceID); err != nil {
+		return nil, err
+	}
+
+	filter := &repository.NamespaceCertificateFilter{
+		NamespaceID:            input.NamespaceID,
+		CommonName:             sql.NullString{String: input.CommonName, Valid: input.CommonName != ""},
+		Organization:           sql.NullString{String: input.Organization, Valid: input.Organization != ""},
+		OrganizationalUnit:     sql.NullString{String: input.OrganizationalUnit, Valid: input.OrganizationalUnit != ""},
+		SubjectAlternativeName: sql.NullString{String: input.SubjectAlternativeName, Valid: input.SubjectAlternativeName != ""},
+	}
+	if err := s.repos.Namespaces.AddCertificateFilter(ctx, filter); err != nil {
+		return nil, fmt.Errorf("failed to add certificate filter: %w", err)
+	}
+
+	return filter, nil
+}
+
+// RemoveCertificateFilter removes a certificate filter from a namespace.
+func (s *NamespaceService) RemoveCertificateFilter(ctx context.Context, namespaceID string, filterID uuid.UUID) error {
+	removed, err := s.repos.Namespaces.RemoveCertificateFilter(ctx, namespaceID, filterID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return serviceerror.NewNotFound("certificate filter not found")
+	}
+	return nil
+}
+
+// FailoverNamespace initiates a failover of an HA namespace to its standby region.
+func (s *NamespaceService) FailoverNamespace(ctx context.Context, namespaceID, targetRegion string) (string, error) {
+	ns, err := s.getExistingNamespace(ctx, namespaceID)
+	if err != nil {
+		return "", err
+	}
+
+	if s.failover == nil {
+		return "", serviceerror.NewUnimplemented("namespace failover is not available")
+	}
+	if !ns.HAEnabled || !ns.StandbyRegion.Valid {
+		return "", serviceerror.NewFailedPrecondition("namespace does not have a standby region")
+	}
+	if !ns.ClusterID.Valid {
+		return "", serviceerror.NewFailedPrecondition("namespace is not provisioned")
+	}
+	if targetRegion == "" {
+		targetRegion = ns.StandbyRegion.String
+	}
+	if targetRegion != ns.StandbyRegion.String {
+		return "", serviceerror.NewInvalidArgumentf("target region %s is not the standby region of the namespace", targetRegion)
+	}
+
+	return s.failover.StartFailover(ctx, ns.ID, targetRegion, ns.ClusterID.String)
+}
+
+// IssueClientCertificate issues a client certificate for a provisioned
+// namespace, signed by the namespace's CA. The private key is returned once
+// and not stored.
+func (s *NamespaceService) IssueClientCertificate(ctx context.Context, namespaceID, commonName string) (*ca.IssuedCertificate, error) {
+	ns, err := s.getExistingNamespace(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.certificates == nil {
+		return nil, serviceerror.NewUnimplemented("issuing client certificates is not available")
+	}
+	if !ns.ClusterID.Valid {
+		return nil, serviceerror.NewFailedPrecondition("namespace is not provisioned")
+	}
+	return s.certificates.IssueClientCertificate(ctx, ns.ID, commonName)
+}
+
+// checkClusterUpdatable returns an error unless changes can be applied to the
+// cluster hosting the namespace. Only one change is applied at a time.
+func (s *NamespaceService) checkClusterUpdatable(ns *repository.Namespace) error {
+	if s.operations == nil {
+		return serviceerror.NewUnimplemented("updating namespaces on their cluster is not available")
+	}
+	if ns.State != "active" || !ns.ClusterID.Valid {
+		return serviceerror.NewFailedPreconditionf("namespace is %s, changes can only be applied to active namespaces", ns.State)
+	}
+	return nil
+}
+
+func (s *NamespaceService) getExistingNamespace(ctx context.Context, id string) (*repository.Namespace, error) {
+	ns, err := s.repos.Namespaces.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ns == nil {
+		return nil, serviceerror.NewNotFound("namespace not found")
+	}
+	return ns, nil
+}
+
 // Helper functions
 
 func isValidNamespaceName(name string) bool {
@@ -256,33 +496,3 @@ func isValidRegion(region string) bool {
 	}
 	return validRegions[region]
 }
-
-func getMaxNamespaces(plan string) int {
-	switch plan {
-	case "free":
-		return 1
-	case "essentials":
-		return 5
-	case "business":
-		return 20
-	case "enterprise", "mission_critical":
-		return 1000
-	default:
-		return 1
-	}
-}
-
-func getMaxRetention(plan string) int {
-	switch plan {
-	case "free":
-		return 7
-	case "essentials":
-		return 30
-	case "business":
-		return 90
-	case "enterprise", "mission_critical":
-		return 365
-	default:
-		return 7
-	}
-}
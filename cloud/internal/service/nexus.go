@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// Nexus endpoint states, derived from how far the endpoint was provisioned.
+const (
+	NexusEndpointStatePending  = "pending"
+	NexusEndpointStateActive   = "active"
+	NexusEndpointStateFailed   = "failed"
+	NexusEndpointStateDeleting = "deleting"
+)
+
+// maxNexusAllowedCallers bounds the allowlist of a Nexus endpoint.
+const maxNexusAllowedCallers = 100
+
+// nexusEndpointNameRE matches the endpoint names the server accepts.
+var nexusEndpointNameRE = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{0,198}[a-zA-Z0-9]$`)
+
+// NexusEndpointInput is the input for creating or updating a Nexus endpoint.
+type NexusEndpointInput struct {
+	OrganizationID            uuid.UUID
+	Name                      string
+	Description               string
+	TargetNamespaceID         string
+	TargetTaskQueue           string
+	AllowedCallerNamespaceIDs []string
+}
+
+func (input *NexusEndpointInput) validate() error {
+	if !nexusEndpointNameRE.MatchString(input.Name) {
+		return serviceerror.NewInvalidArgumentf("invalid Nexus endpoint name %q: names start with a letter, "+
+			"contain only letters, digits and hyphens, and are at most 200 characters long", input.Name)
+	}
+	if input.TargetNamespaceID == "" {
+		return serviceerror.NewInvalidArgument("target namespace is required")
+	}
+	if input.TargetTaskQueue == "" {
+		return serviceerror.NewInvalidArgument("target task queue is required")
+	}
+	if len(input.AllowedCallerNamespaceIDs) == 0 {
+		return serviceerror.NewInvalidArgument("at least one caller namespace must be allowed")
+	}
+	if len(input.AllowedCallerNamespaceIDs) > maxNexusAllowedCallers {
+		return serviceerror.NewInvalidArgumentf("at most %d caller namespaces may be allowed", maxNexusAllowedCallers)
+	}
+	input.AllowedCallerNamespaceIDs = slices.Compact(slices.Sorted(slices.Values(input.AllowedCallerNamespaceIDs)))
+	return nil
+}
+
+// NexusEndpointState returns the state of a Nexus endpoint.
+func NexusEndpointState(endpoint *repository.NexusEndpoint) string {
+	switch {
+	case endpoint.DeletedAt.Valid:
+		return NexusEndpointStateDeleting
+	case endpoint.SyncedVersion >= endpoint.SpecVersion:
+		return NexusEndpointStateActive
+	case endpoint.SyncError.Valid:
+		return NexusEndpointStateFailed
+	default:
+		return NexusEndpointStatePending
+	}
+}
+
+// CreateNexusEndpoint creates a Nexus endpoint in an organization. It is
+// provisioned on the cluster hosting its target namespace when Nexus
+// endpoints are next synced.
+func (s *NamespaceService) CreateNexusEndpoint(ctx context.Context, input *NexusEndpointInput) (*repository.NexusEndpoint, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	org, err := s.repos.Organizations.GetByID(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+	existing, err := s.repos.Nexus.GetEndpointByName(ctx, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, serviceerror.NewAlreadyExists(fmt.Sprintf("Nexus endpoint %q already exists", input.Name))
+	}
+	if err := s.checkNexusEndpointNamespaces(ctx, input); err != nil {
+		return nil, err
+	}
+
+	endpoint := &repository.NexusEndpoint{
+		OrganizationID:            input.OrganizationID,
+		Name:                      input.Name,
+		Description:               sql.NullString{String: input.Description, Valid: input.Description != ""},
+		TargetNamespaceID:         input.TargetNamespaceID,
+		TargetTaskQueue:           input.TargetTaskQueue,
+		AllowedCallerNamespaceIDs: input.AllowedCallerNamespaceIDs,
+	}
+	if err := s.repos.Nexus.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+// GetNexusEndpoint retrieves a Nexus endpoint of an organization.
+func (s *NamespaceService) GetNexusEndpoint(ctx context.Context, orgID, endpointID uuid.UUID) (*repository.NexusEndpoint, error) {
+	endpoint, err := s.repos.Nexus.GetEndpoint(ctx, orgID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == nil {
+		return nil, serviceerror.NewNotFound("Nexus endpoint not found")
+	}
+	return endpoint, nil
+}
+
+// ListNexusEndpoints lists the Nexus endpoints of an organization.
+func (s *NamespaceService) ListNexusEndpoints(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*repository.NexusEndpoint, error) {
+	return s.repos.Nexus.ListEndpoints(ctx, orgID, limit, offset)
+}
+
+// UpdateNexusEndpoint replaces a Nexus endpoint's description, target and
+// allowlist. The name of an endpoint cannot be changed.
+func (s *NamespaceService) UpdateNexusEndpoint(ctx context.Context, endpointID uuid.UUID, input *NexusEndpointInput) (*repository.NexusEndpoint, error) {
+	endpoint, err := s.GetNexusEndpoint(ctx, input.OrganizationID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.DeletedAt.Valid {
+		return nil, serviceerror.NewFailedPrecondition("Nexus endpoint is being deleted")
+	}
+	if input.Name == "" {
+		input.Name = endpoint.Name
+	}
+	if input.Name != endpoint.Name {
+		return nil, serviceerror.NewInvalidArgument("the name of a Nexus endpoint cannot be changed")
+	}
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkNexusEndpointNamespaces(ctx, input); err != nil {
+		return nil, err
+	}
+
+	endpoint.Description = sql.NullString{String: input.Description, Valid: input.Description != ""}
+	endpoint.TargetNamespaceID = input.TargetNamespaceID
+	endpoint.TargetTaskQueue = input.TargetTaskQueue
+	endpoint.AllowedCallerNamespaceIDs = input.AllowedCallerNamespaceIDs
+	updated, err := s.repos.Nexus.UpdateEndpoint(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, serviceerror.NewNotFound("Nexus endpoint not found")
+	}
+	endpoint.SyncError = sql.NullString{}
+	return endpoint, nil
+}
+
+// DeleteNexusEndpoint deletes a Nexus endpoint. It stops accepting requests
+// once it is removed from its cluster.
+func (s *NamespaceService) DeleteNexusEndpoint(ctx context.Context, orgID, endpointID uuid.UUID) error {
+	deleted, err := s.repos.Nexus.MarkEndpointDeleted(ctx, orgID, endpointID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return serviceerror.NewNotFound("Nexus endpoint not found")
+	}
+	return nil
+}
+
+// checkNexusEndpointNamespaces fails unless the endpoint's target and caller
+// namespaces belong to its organization.
+func (s *NamespaceService) checkNexusEndpointNamespaces(ctx context.Context, input *NexusEndpointInput) error {
+	for _, id := range append([]string{input.TargetNamespaceID}, input.AllowedCallerNamespaceIDs...) {
+		ns, err := s.repos.Namespaces.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if ns == nil || ns.OrganizationID != input.OrganizationID {
+			return serviceerror.NewInvalidArgumentf("namespace %q not found in the organization", id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+func TestNexusEndpointInputValidate(t *testing.T) {
+	valid := func() *NexusEndpointInput {
+		return &NexusEndpointInput{
+			Name:                      "orders-api",
+			TargetNamespaceID:         "orders.abcd1234",
+			TargetTaskQueue:           "orders-nexus",
+			AllowedCallerNamespaceIDs: []string{"shipping.abcd1234", "billing.abcd1234", "shipping.abcd1234"},
+		}
+	}
+
+	input := valid()
+	require.NoError(t, input.validate())
+	require.Equal(t, []string{"billing.abcd1234", "shipping.abcd1234"}, input.AllowedCallerNamespaceIDs)
+
+	for name, mutate := range map[string]func(*NexusEndpointInput){
+		"empty name":          func(in *NexusEndpointInput) { in.Name = "" },
+		"name with dots":      func(in *NexusEndpointInput) { in.Name = "orders.api" },
+		"name ending in dash": func(in *NexusEndpointInput) { in.Name = "orders-" },
+		"no target namespace": func(in *NexusEndpointInput) { in.TargetNamespaceID = "" },
+		"no task queue":       func(in *NexusEndpointInput) { in.TargetTaskQueue = "" },
+		"no callers":          func(in *NexusEndpointInput) { in.AllowedCallerNamespaceIDs = nil },
+		"too many callers": func(in *NexusEndpointInput) {
+			in.AllowedCallerNamespaceIDs = make([]string, maxNexusAllowedCallers+1)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			input := valid()
+			mutate(input)
+			require.Error(t, input.validate())
+		})
+	}
+}
+
+func TestNexusEndpointState(t *testing.T) {
+	now := sql.NullTime{Time: time.Now(), Valid: true}
+	failed := sql.NullString{String: "target namespace is not placed on a cluster", Valid: true}
+
+	require.Equal(t, NexusEndpointStatePending, NexusEndpointState(&repository.NexusEndpoint{SpecVersion: 1}))
+	require.Equal(t, NexusEndpointStateActive, NexusEndpointState(&repository.NexusEndpoint{SpecVersion: 2, SyncedVersion: 2}))
+	require.Equal(t, NexusEndpointStateFailed, NexusEndpointState(&repository.NexusEndpoint{SpecVersion: 2, SyncedVersion: 1, SyncError: failed}))
+	require.Equal(t, NexusEndpointStateDeleting, NexusEndpointState(&repository.NexusEndpoint{SpecVersion: 3, SyncedVersion: 2, DeletedAt: now}))
+}
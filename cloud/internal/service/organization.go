@@ -3,25 +3,40 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/config"
 	"go.temporal.io/cloud/internal/repository"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
+// OrganizationDeleter runs the workflows that delete organizations.
+type OrganizationDeleter interface {
+	// ScheduleDeletion starts tearing down the organization at deleteAfter.
+	ScheduleDeletion(ctx context.Context, orgID uuid.UUID, deleteAfter time.Time) error
+	// CancelDeletion stops a scheduled deletion that has not begun.
+	CancelDeletion(ctx context.Context, orgID uuid.UUID) error
+}
+
 // OrganizationService handles organization business logic.
 type OrganizationService struct {
-	repos  *repository.Repositories
-	logger log.Logger
+	repos    *repository.Repositories
+	deletion config.OrganizationDeletionConfig
+	deleter  OrganizationDeleter
+	logger   log.Logger
 }
 
 // NewOrganizationService creates a new organization service.
-func NewOrganizationService(repos *repository.Repositories, logger log.Logger) *OrganizationService {
-	return &OrganizationService{repos: repos, logger: logger}
+func NewOrganizationService(repos *repository.Repositories, deletion config.OrganizationDeletionConfig, deleter OrganizationDeleter, logger log.Logger) *OrganizationService {
+	return &OrganizationService{repos: repos, deletion: deletion, deleter: deleter, logger: logger}
 }
 
 // CreateOrganizationInput is the input for creating an organization.
@@ -35,7 +50,7 @@ type CreateOrganizationInput struct {
 func (s *OrganizationService) CreateOrganization(ctx context.Context, input *CreateOrganizationInput) (*repository.Organization, error) {
 	// Validate name
 	if len(input.Name) < 2 || len(input.Name) > 255 {
-		return nil, fmt.Errorf("organization name must be between 2 and 255 characters")
+		return nil, serviceerror.NewInvalidArgument("organization name must be between 2 and 255 characters")
 	}
 
 	// Generate slug if not provided
@@ -46,7 +61,7 @@ func (s *OrganizationService) CreateOrganization(ctx context.Context, input *Cre
 
 	// Validate slug
 	if !isValidSlug(slug) {
-		return nil, fmt.Errorf("invalid slug: must be 2-63 lowercase alphanumeric characters or hyphens")
+		return nil, serviceerror.NewInvalidArgument("invalid slug: must be 2-63 lowercase alphanumeric characters or hyphens")
 	}
 
 	// Check if slug is taken
@@ -55,7 +70,7 @@ func (s *OrganizationService) CreateOrganization(ctx context.Context, input *Cre
 		return nil, fmt.Errorf("failed to check slug availability: %w", err)
 	}
 	if existing != nil {
-		return nil, fmt.Errorf("organization slug already exists")
+		return nil, serviceerror.NewAlreadyExists("organization slug already exists")
 	}
 
 	// Create organization
@@ -80,14 +95,21 @@ func (s *OrganizationService) CreateOrganization(ctx context.Context, input *Cre
 	}
 
 	// Create default subscription (free tier)
-	actions, activeGB, retainedGB := repository.GetPlanLimits("free")
+	plan, err := s.repos.Plans.GetLatest(ctx, "free")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get free plan: %w", err)
+	}
+	if plan == nil {
+		return nil, fmt.Errorf("free plan not found")
+	}
 	sub := &repository.Subscription{
 		OrganizationID:    org.ID,
-		Plan:              "free",
+		Plan:              plan.Tier,
+		PlanVersion:       plan.Version,
 		Status:            "active",
-		ActionsIncluded:   actions,
-		ActiveStorageGB:   activeGB,
-		RetainedStorageGB: retainedGB,
+		ActionsIncluded:   plan.ActionsIncluded,
+		ActiveStorageGB:   plan.ActiveStorageGB,
+		RetainedStorageGB: plan.RetainedStorageGB,
 	}
 	if err := s.repos.Subscriptions.Create(ctx, sub); err != nil {
 		return nil, fmt.Errorf("failed to create subscription: %w", err)
@@ -120,7 +142,7 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, input *Upd
 		return nil, err
 	}
 	if org == nil {
-		return nil, fmt.Errorf("organization not found")
+		return nil, serviceerror.NewNotFound("organization not found")
 	}
 
 	if input.Name != nil {
@@ -137,57 +159,86 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, input *Upd
 	return org, nil
 }
 
-// DeleteOrganization deletes an organization.
-func (s *OrganizationService) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
-	// Check for active namespaces
-	namespaces, err := s.repos.Namespaces.ListByOrganization(ctx, id, 1, 0)
+// DeleteOrganization schedules an organization's deletion at the end of the
+// grace period. Until then, CancelOrganizationDeletion cancels it.
+func (s *OrganizationService) DeleteOrganization(ctx context.Context, id uuid.UUID) (*repository.Organization, error) {
+	org, err := s.repos.Organizations.GetByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to check namespaces: %w", err)
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
 	}
-	if len(namespaces) > 0 {
-		return fmt.Errorf("cannot delete organization with active namespaces")
+	if org.DeleteAfter.Valid {
+		return nil, serviceerror.NewFailedPrecondition("organization deletion is already scheduled")
 	}
 
-	return s.repos.Organizations.Delete(ctx, id)
-}
+	// Teardown deletes every namespace, so protected ones must be unprotected
+	// first.
+	namespaces, err := s.repos.Namespaces.ListByOrganization(ctx, id, planResourceLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check namespaces: %w", err)
+	}
+	for _, ns := range namespaces {
+		if ns.DeletionProtected && ns.State != "deleted" {
+			return nil, serviceerror.NewFailedPreconditionf("namespace %s is deletion protected", ns.ID)
+		}
+	}
 
-// ListOrganizations lists organizations for a user.
-func (s *OrganizationService) ListOrganizations(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*repository.Organization, error) {
-	return s.repos.Organizations.ListByUserID(ctx, userID, limit, offset)
-}
+	now := time.Now().UTC()
+	deleteAfter := now.Add(s.deletion.GracePeriod)
+	scheduled, err := s.repos.Organizations.ScheduleDeletion(ctx, id, now, deleteAfter)
+	if err != nil {
+		return nil, err
+	}
+	if !scheduled {
+		return nil, serviceerror.NewFailedPrecondition("organization deletion is already scheduled")
+	}
+	if err := s.deleter.ScheduleDeletion(ctx, id, deleteAfter); err != nil {
+		// The zero time unschedules it even with no grace period.
+		if _, cancelErr := s.repos.Organizations.CancelDeletion(ctx, id, time.Time{}); cancelErr != nil {
+			s.logger.Error("Failed to unschedule organization deletion", tag.NewStringTag("organization_id", id.String()), tag.Error(cancelErr))
+		}
+		return nil, fmt.Errorf("failed to schedule organization deletion: %w", err)
+	}
 
-// InviteUserInput is the input for inviting a user.
-type InviteUserInput struct {
-	OrganizationID uuid.UUID
-	Email          string
-	Role           string
-	InvitedBy      uuid.UUID
+	org.DeleteAfter = sql.NullTime{Time: deleteAfter, Valid: true}
+	return org, nil
 }
 
-// InviteUser invites a user to an organization.
-func (s *OrganizationService) InviteUser(ctx context.Context, input *InviteUserInput) (*repository.UserInvitation, error) {
-	// Validate role
-	validRoles := map[string]bool{"owner": true, "admin": true, "developer": true, "read_only": true}
-	if !validRoles[input.Role] {
-		return nil, fmt.Errorf("invalid role: %s", input.Role)
+// CancelOrganizationDeletion cancels an organization's scheduled deletion.
+// It fails once the grace period is over.
+func (s *OrganizationService) CancelOrganizationDeletion(ctx context.Context, id uuid.UUID) (*repository.Organization, error) {
+	org, err := s.repos.Organizations.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
-
-	// Generate invitation token
-	token := uuid.New().String()
-
-	inv := &repository.UserInvitation{
-		OrganizationID: input.OrganizationID,
-		Email:          input.Email,
-		Role:           input.Role,
-		InvitedBy:      input.InvitedBy,
-		Token:          token,
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
 	}
-
-	if err := s.repos.Users.CreateInvitation(ctx, inv); err != nil {
-		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	cancelled, err := s.repos.Organizations.CancelDeletion(ctx, id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !cancelled {
+		if org.DeleteAfter.Valid {
+			return nil, serviceerror.NewFailedPrecondition("organization deletion has already begun")
+		}
+		return nil, serviceerror.NewFailedPrecondition("organization deletion is not scheduled")
 	}
+	// The workflow checks that deletion is still scheduled before it begins,
+	// so a failure to stop it early is harmless.
+	if err := s.deleter.CancelDeletion(ctx, id); err != nil {
+		s.logger.Warn("Failed to cancel organization deletion workflow", tag.NewStringTag("organization_id", id.String()), tag.Error(err))
+	}
+
+	org.DeleteAfter = sql.NullTime{}
+	return org, nil
+}
 
-	return inv, nil
+// ListOrganizations lists organizations for a user.
+func (s *OrganizationService) ListOrganizations(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*repository.Organization, error) {
+	return s.repos.Organizations.ListByUserID(ctx, userID, limit, offset)
 }
 
 // ListMembers lists members of an organization.
@@ -196,13 +247,31 @@ func (s *OrganizationService) ListMembers(ctx context.Context, orgID uuid.UUID,
 }
 
 // UpdateMemberRole updates a member's role.
-func (s *OrganizationService) UpdateMemberRole(ctx context.Context, orgID, userID uuid.UUID, role string) error {
-	member := &repository.OrganizationMember{
-		OrganizationID: orgID,
-		UserID:         userID,
-		Role:           role,
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, orgID, userID uuid.UUID, role string) (*repository.OrganizationMember, error) {
+	if !isValidOrgRole(role) {
+		return nil, serviceerror.NewInvalidArgumentf("invalid role: %s", role)
 	}
-	return s.repos.Organizations.AddMember(ctx, member)
+
+	member, err := s.repos.Organizations.GetMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, serviceerror.NewNotFound("organization member not found")
+	}
+
+	joinedAt := member.CreatedAt
+	member.Role = role
+	if err := s.repos.Organizations.AddMember(ctx, member); err != nil {
+		return nil, err
+	}
+	member.CreatedAt = joinedAt
+	return member, nil
+}
+
+// GetMemberUser retrieves the user record backing an organization member.
+func (s *OrganizationService) GetMemberUser(ctx context.Context, userID uuid.UUID) (*repository.User, error) {
+	return s.repos.Users.GetByID(ctx, userID)
 }
 
 // RemoveMember removes a member from an organization.
@@ -222,6 +291,15 @@ func generateSlug(name string) string {
 	return slug
 }
 
+func isValidOrgRole(role string) bool {
+	switch role {
+	case "owner", "admin", "developer", "read_only", "finance":
+		return true
+	default:
+		return false
+	}
+}
+
 func isValidSlug(slug string) bool {
 	if len(slug) < 2 || len(slug) > 63 {
 		return false
@@ -0,0 +1,470 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/stripe"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// Metadata keys set on Stripe objects created by billing.
+	stripeMetadataOrganizationID = "organization_id"
+	stripeMetadataInvoiceID      = "cloud_invoice_id"
+	stripeMetadataPurpose        = "purpose"
+
+	stripePurposeCredits = "credits"
+
+	// minCreditPurchaseCents is the smallest charge Stripe accepts in USD.
+	minCreditPurchaseCents = 50
+	// creditValidity is how long purchased credits can be used.
+	creditValidity = 365 * 24 * time.Hour
+)
+
+// PaymentNotifier tells billing workflows about the outcome of invoice
+// payments.
+type PaymentNotifier interface {
+	InvoicePaymentFailed(ctx context.Context, orgID, invoiceID uuid.UUID) error
+	InvoicePaid(ctx context.Context, orgID, invoiceID uuid.UUID) error
+}
+
+var errStripeNotConfigured = serviceerror.NewFailedPrecondition("payments are not configured")
+
+// UpdatePaymentMethod makes the Stripe payment method the organization's
+// default for invoices and credit purchases.
+func (s *BillingService) UpdatePaymentMethod(ctx context.Context, orgID uuid.UUID, paymentMethodID string) error {
+	sub, err := s.ensureStripeCustomer(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.stripe.SetDefaultPaymentMethod(ctx, sub.StripeCustomerID.String, paymentMethodID); err != nil {
+		return stripeError(err)
+	}
+	return nil
+}
+
+// PurchaseCredits charges the organization's default payment method and adds
+// the amount to its credit balance. It returns the updated balance and the
+// Stripe payment intent.
+func (s *BillingService) PurchaseCredits(ctx context.Context, orgID uuid.UUID, amountCents int64) (*CreditBalance, string, error) {
+	if amountCents < minCreditPurchaseCents {
+		return nil, "", serviceerror.NewInvalidArgumentf("amount must be at least %d cents", minCreditPurchaseCents)
+	}
+	sub, err := s.ensureStripeCustomer(ctx, orgID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	intent, err := s.stripe.CreatePaymentIntent(ctx, &stripe.PaymentIntentParams{
+		CustomerID:  sub.StripeCustomerID.String,
+		AmountCents: amountCents,
+		Currency:    s.currency(),
+		Description: "Temporal Cloud credits",
+		Metadata: map[string]string{
+			stripeMetadataOrganizationID: orgID.String(),
+			stripeMetadataPurpose:        stripePurposeCredits,
+		},
+	})
+	if err != nil {
+		return nil, "", stripeError(err)
+	}
+	// The payment_intent.succeeded webhook records the purchase as well;
+	// recording is idempotent so whichever comes first wins.
+	if intent.Status == stripe.PaymentIntentStatusSucceeded {
+		if err := s.recordCreditPurchase(ctx, intent); err != nil {
+			return nil, "", err
+		}
+	}
+
+	balance, err := s.GetCreditBalance(ctx, orgID)
+	if err != nil {
+		return nil, "", err
+	}
+	return balance, intent.ID, nil
+}
+
+// SubmitInvoice sends a generated invoice to Stripe and finalizes it there, so
+// that Stripe charges the organization's default payment method. Payment
+// results arrive through webhooks. Submitting an invoice again is safe.
+func (s *BillingService) SubmitInvoice(ctx context.Context, invoiceID uuid.UUID) (*repository.Invoice, error) {
+	inv, err := s.repos.Invoices.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil {
+		return nil, serviceerror.NewNotFound("invoice not found")
+	}
+	if inv.Status != stripe.InvoiceStatusDraft {
+		return inv, nil
+	}
+	if inv.TotalCents <= 0 {
+		// Nothing to collect.
+		now := s.now()
+		if _, err := s.repos.Invoices.TransitionStatus(ctx, inv.ID, []string{stripe.InvoiceStatusDraft}, stripe.InvoiceStatusPaid, &now); err != nil {
+			return nil, err
+		}
+		return s.repos.Invoices.GetByID(ctx, inv.ID)
+	}
+
+	sub, err := s.ensureStripeCustomer(ctx, inv.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	stripeInvoiceID := inv.StripeInvoiceID.String
+	if !inv.StripeInvoiceID.Valid {
+		created, err := s.stripe.CreateInvoice(ctx, &stripe.InvoiceParams{
+			CustomerID:  sub.StripeCustomerID.String,
+			Description: fmt.Sprintf("Temporal Cloud invoice %s", inv.InvoiceNumber),
+			Metadata: map[string]string{
+				stripeMetadataOrganizationID: inv.OrganizationID.String(),
+				stripeMetadataInvoiceID:      inv.ID.String(),
+			},
+			IdempotencyKey: "invoice-" + inv.ID.String(),
+		})
+		if err != nil {
+			return nil, stripeError(err)
+		}
+		stripeInvoiceID = created.ID
+		if err := s.repos.Invoices.SetStripeInvoiceID(ctx, inv.ID, stripeInvoiceID); err != nil {
+			return nil, err
+		}
+	}
+
+	stripeInvoice, err := s.stripe.GetInvoice(ctx, stripeInvoiceID)
+	if err != nil {
+		return nil, stripeError(err)
+	}
+	if stripeInvoice.Status == stripe.InvoiceStatusDraft {
+		if err := s.addInvoiceItems(ctx, inv, sub.StripeCustomerID.String, stripeInvoiceID); err != nil {
+			return nil, err
+		}
+		if _, err := s.stripe.FinalizeInvoice(ctx, stripeInvoiceID); err != nil {
+			return nil, stripeError(err)
+		}
+	}
+	// The invoice.finalized webhook may already have opened the invoice, or
+	// a payment webhook moved it further.
+	if _, err := s.repos.Invoices.TransitionStatus(ctx, inv.ID, []string{stripe.InvoiceStatusDraft}, stripe.InvoiceStatusOpen, nil); err != nil {
+		return nil, err
+	}
+	return s.repos.Invoices.GetByID(ctx, inv.ID)
+}
+
+// addInvoiceItems reports the invoice's usage line items to Stripe.
+func (s *BillingService) addInvoiceItems(ctx context.Context, inv *repository.Invoice, customerID, stripeInvoiceID string) error {
+	var items []InvoiceLineItem
+	if err := json.Unmarshal(inv.LineItems, &items); err != nil {
+		return fmt.Errorf("failed to decode invoice line items: %w", err)
+	}
+	for i, item := range items {
+		_, err := s.stripe.CreateInvoiceItem(ctx, &stripe.InvoiceItemParams{
+			CustomerID:  customerID,
+			InvoiceID:   stripeInvoiceID,
+			AmountCents: item.AmountCents,
+			Currency:    s.currency(),
+			Description: item.Description,
+			Metadata: map[string]string{
+				"quantity": strconv.FormatFloat(item.Quantity, 'f', -1, 64),
+				"unit":     item.Unit,
+			},
+			// Items are keyed by position so that a retry after a partial
+			// failure does not add them twice.
+			IdempotencyKey: fmt.Sprintf("invoice-%s-item-%d", inv.ID, i),
+		})
+		if err != nil {
+			return stripeError(err)
+		}
+	}
+	return nil
+}
+
+// CheckInvoicePaid reports whether an invoice has been paid, asking Stripe if
+// no payment webhook has been received yet.
+func (s *BillingService) CheckInvoicePaid(ctx context.Context, invoiceID uuid.UUID) (bool, error) {
+	inv, err := s.repos.Invoices.GetByID(ctx, invoiceID)
+	if err != nil {
+		return false, err
+	}
+	if inv == nil {
+		return false, serviceerror.NewNotFound("invoice not found")
+	}
+	if inv.Status == stripe.InvoiceStatusPaid {
+		return true, nil
+	}
+	if !inv.StripeInvoiceID.Valid || s.stripe == nil {
+		return false, nil
+	}
+
+	stripeInvoice, err := s.stripe.GetInvoice(ctx, inv.StripeInvoiceID.String)
+	if err != nil {
+		return false, stripeError(err)
+	}
+	if stripeInvoice.Status != stripe.InvoiceStatusPaid {
+		return false, nil
+	}
+	if err := s.invoicePaid(ctx, inv); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// HandleStripeWebhook verifies and processes a Stripe webhook request. Events
+// may be delivered more than once and out of order; processing them again has
+// no further effect.
+func (s *BillingService) HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error {
+	event, err := stripe.ConstructEvent(payload, signature, s.stripeConfig.WebhookSecret, stripe.DefaultWebhookTolerance, s.now())
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case stripe.EventInvoiceFinalized, stripe.EventInvoicePaid, stripe.EventInvoicePaymentFailed,
+		stripe.EventInvoiceVoided, stripe.EventInvoiceUncollectible:
+		return s.handleInvoiceEvent(ctx, event)
+	case stripe.EventPaymentIntentSucceeded:
+		var intent stripe.PaymentIntent
+		if err := json.Unmarshal(event.Data.Object, &intent); err != nil {
+			return fmt.Errorf("failed to decode payment intent: %w", err)
+		}
+		if intent.Metadata[stripeMetadataPurpose] != stripePurposeCredits {
+			return nil
+		}
+		return s.recordCreditPurchase(ctx, &intent)
+	case stripe.EventSubscriptionUpdated, stripe.EventSubscriptionDeleted:
+		return s.handleSubscriptionEvent(ctx, event)
+	default:
+		s.logger.Debug("Ignoring Stripe event", tag.NewStringTag("event_type", event.Type))
+		return nil
+	}
+}
+
+func (s *BillingService) handleInvoiceEvent(ctx context.Context, event *stripe.Event) error {
+	var stripeInvoice stripe.Invoice
+	if err := json.Unmarshal(event.Data.Object, &stripeInvoice); err != nil {
+		return fmt.Errorf("failed to decode invoice: %w", err)
+	}
+
+	var inv *repository.Invoice
+	if id, err := uuid.Parse(stripeInvoice.Metadata[stripeMetadataInvoiceID]); err == nil {
+		if inv, err = s.repos.Invoices.GetByID(ctx, id); err != nil {
+			return err
+		}
+	}
+	if inv == nil {
+		var err error
+		if inv, err = s.repos.Invoices.GetByStripeInvoiceID(ctx, stripeInvoice.ID); err != nil {
+			return err
+		}
+	}
+	if inv == nil {
+		s.logger.Warn("Ignoring Stripe event for unknown invoice",
+			tag.NewStringTag("event_type", event.Type), tag.NewStringTag("stripe_invoice_id", stripeInvoice.ID))
+		return nil
+	}
+
+	switch event.Type {
+	case stripe.EventInvoiceFinalized:
+		_, err := s.repos.Invoices.TransitionStatus(ctx, inv.ID, []string{stripe.InvoiceStatusDraft}, stripe.InvoiceStatusOpen, nil)
+		return err
+	case stripe.EventInvoicePaid:
+		return s.invoicePaid(ctx, inv)
+	case stripe.EventInvoicePaymentFailed:
+		return s.invoicePaymentFailed(ctx, inv)
+	case stripe.EventInvoiceVoided:
+		_, err := s.repos.Invoices.TransitionStatus(ctx, inv.ID,
+			[]string{stripe.InvoiceStatusDraft, stripe.InvoiceStatusOpen}, stripe.InvoiceStatusVoid, nil)
+		return err
+	case stripe.EventInvoiceUncollectible:
+		_, err := s.repos.Invoices.TransitionStatus(ctx, inv.ID,
+			[]string{stripe.InvoiceStatusOpen}, stripe.InvoiceStatusUncollectible, nil)
+		return err
+	}
+	return nil
+}
+
+func (s *BillingService) invoicePaid(ctx context.Context, inv *repository.Invoice) error {
+	now := s.now()
+	_, err := s.repos.Invoices.TransitionStatus(ctx, inv.ID,
+		[]string{stripe.InvoiceStatusDraft, stripe.InvoiceStatusOpen, stripe.InvoiceStatusUncollectible},
+		stripe.InvoiceStatusPaid, &now)
+	if err != nil {
+		return err
+	}
+
+	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, inv.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if sub != nil && sub.Status == "past_due" {
+		if err := s.repos.Subscriptions.UpdateStatus(ctx, sub.ID, "active"); err != nil {
+			return err
+		}
+	}
+	if s.payments != nil {
+		return s.payments.InvoicePaid(ctx, inv.OrganizationID, inv.ID)
+	}
+	return nil
+}
+
+func (s *BillingService) invoicePaymentFailed(ctx context.Context, inv *repository.Invoice) error {
+	if inv.Status == stripe.InvoiceStatusPaid || inv.Status == stripe.InvoiceStatusVoid {
+		return nil
+	}
+	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, inv.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if sub != nil && sub.Status == "active" {
+		if err := s.repos.Subscriptions.UpdateStatus(ctx, sub.ID, "past_due"); err != nil {
+			return err
+		}
+	}
+	if s.payments != nil {
+		return s.payments.InvoicePaymentFailed(ctx, inv.OrganizationID, inv.ID)
+	}
+	return nil
+}
+
+func (s *BillingService) handleSubscriptionEvent(ctx context.Context, event *stripe.Event) error {
+	var stripeSub stripe.Subscription
+	if err := json.Unmarshal(event.Data.Object, &stripeSub); err != nil {
+		return fmt.Errorf("failed to decode subscription: %w", err)
+	}
+	sub, err := s.repos.Subscriptions.GetByStripeCustomerID(ctx, stripeSub.Customer)
+	if err != nil {
+		return err
+	}
+	if sub == nil {
+		s.logger.Warn("Ignoring Stripe event for unknown customer",
+			tag.NewStringTag("event_type", event.Type), tag.NewStringTag("stripe_customer_id", stripeSub.Customer))
+		return nil
+	}
+
+	status := stripeSub.Status
+	if event.Type == stripe.EventSubscriptionDeleted {
+		status = "canceled"
+	}
+	switch status {
+	case "active", "trialing", "past_due", "canceled":
+	case "unpaid":
+		status = "suspended"
+	default:
+		// Incomplete and paused subscriptions keep their current status.
+		return nil
+	}
+
+	sub.Status = status
+	sub.StripeSubscriptionID = sql.NullString{String: stripeSub.ID, Valid: stripeSub.ID != ""}
+	return s.repos.Subscriptions.Update(ctx, sub)
+}
+
+func (s *BillingService) recordCreditPurchase(ctx context.Context, intent *stripe.PaymentIntent) error {
+	orgID, err := uuid.Parse(intent.Metadata[stripeMetadataOrganizationID])
+	if err != nil {
+		return fmt.Errorf("credit payment intent %s has no organization: %w", intent.ID, err)
+	}
+	// Credits are valid from the payment, not from when its webhook arrives,
+	// which can be days later after retries.
+	purchasedAt := s.now()
+	if intent.Created > 0 {
+		purchasedAt = time.Unix(intent.Created, 0).UTC()
+	}
+	added, err := s.repos.Credits.RecordPurchase(ctx, &repository.CreditPurchase{
+		OrganizationID:        orgID,
+		AmountCents:           intent.Amount,
+		StripePaymentIntentID: sql.NullString{String: intent.ID, Valid: true},
+		PurchasedAt:           purchasedAt,
+		ExpiresAt:             purchasedAt.Add(creditValidity),
+	})
+	if err != nil {
+		return err
+	}
+	if added {
+		s.logger.Info("Recorded credit purchase",
+			tag.NewStringTag("organization_id", orgID.String()), tag.NewInt64("amount_cents", intent.Amount))
+	}
+	return nil
+}
+
+// ensureStripeCustomer returns the organization's subscription, creating its
+// Stripe customer first if needed.
+func (s *BillingService) ensureStripeCustomer(ctx context.Context, orgID uuid.UUID) (*repository.Subscription, error) {
+	if s.stripe == nil {
+		return nil, errStripeNotConfigured
+	}
+	sub, err := s.repos.Subscriptions.GetByOrganizationID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, serviceerror.NewNotFound("subscription not found")
+	}
+	if sub.StripeCustomerID.Valid {
+		return sub, nil
+	}
+
+	org, err := s.repos.Organizations.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+	customer, err := s.stripe.CreateCustomer(ctx, &stripe.CustomerParams{
+		Name:           org.Name,
+		Metadata:       map[string]string{stripeMetadataOrganizationID: orgID.String()},
+		IdempotencyKey: "customer-" + orgID.String(),
+	})
+	if err != nil {
+		return nil, stripeError(err)
+	}
+
+	sub.StripeCustomerID = sql.NullString{String: customer.ID, Valid: true}
+	if err := s.repos.Subscriptions.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *BillingService) currency() string {
+	if s.stripeConfig.Currency == "" {
+		return "usd"
+	}
+	return s.stripeConfig.Currency
+}
+
+// stripeError turns errors Stripe reports about the request into service
+// errors. Other failures are returned as is.
+func stripeError(err error) error {
+	stripeErr, ok := stripe.AsError(err)
+	if !ok {
+		return err
+	}
+	switch {
+	case stripeErr.IsCardError():
+		return serviceerror.NewFailedPreconditionf("payment declined: %s", stripeErr.Message)
+	case stripeErr.Code == "payment_method_missing":
+		return serviceerror.NewFailedPrecondition("no payment method on file")
+	case stripeErr.Code == "resource_missing":
+		return serviceerror.NewInvalidArgument(stripeErr.Message)
+	case stripeErr.HTTPStatus == 400:
+		return serviceerror.NewInvalidArgument(stripeErr.Message)
+	}
+	return err
+}
+
+// IsInvalidWebhookSignature reports whether err rejects a webhook request as
+// not coming from Stripe.
+func IsInvalidWebhookSignature(err error) bool {
+	return errors.Is(err, stripe.ErrInvalidSignature)
+}
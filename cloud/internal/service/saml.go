@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/saml"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// SAML SSO endpoints, relative to the configured SAML base URL.
+const (
+	SAMLACSPath      = "/auth/saml/acs"
+	SAMLMetadataPath = "/auth/saml/metadata/"
+)
+
+// samlRoleRank orders the roles SAML logins may grant. Owner is deliberately
+// absent: it is never granted or taken away by an identity provider.
+var samlRoleRank = map[string]int{"read_only": 1, "developer": 2, "admin": 3}
+
+// SAMLAttributeMapping says which SAML attributes carry a user's profile and
+// role. It is stored as saml_configurations.attribute_mapping.
+type SAMLAttributeMapping struct {
+	// Email and Name name the attributes holding the user's email address and
+	// display name. The email defaults to the assertion's NameID.
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+	// Role names the attribute listing the user's roles or groups, and Roles
+	// maps its values to organization roles. Values that are themselves
+	// organization roles map to that role. The highest mapped role wins.
+	Role  string            `json:"role,omitempty"`
+	Roles map[string]string `json:"roles,omitempty"`
+	// DefaultRole is given to new members when no attribute value maps to a
+	// role. Existing members keep their role.
+	DefaultRole string `json:"default_role,omitempty"`
+}
+
+func (m *SAMLAttributeMapping) withDefaults() *SAMLAttributeMapping {
+	out := *m
+	if out.Name == "" {
+		out.Name = "name"
+	}
+	if out.Role == "" {
+		out.Role = "role"
+	}
+	if out.DefaultRole == "" {
+		out.DefaultRole = "read_only"
+	}
+	return &out
+}
+
+func (m *SAMLAttributeMapping) validate() error {
+	if m.DefaultRole != "" && samlRoleRank[m.DefaultRole] == 0 {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid SAML default role %q", m.DefaultRole))
+	}
+	for value, role := range m.Roles {
+		if samlRoleRank[role] == 0 {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid SAML role %q for %q", role, value))
+		}
+	}
+	return nil
+}
+
+// role returns the organization role for a login, given the member's current
+// role, if any.
+func (m *SAMLAttributeMapping) role(a *saml.Assertion, current string) string {
+	best := ""
+	for _, value := range a.Attributes[m.Role] {
+		role, ok := m.Roles[value]
+		if !ok {
+			role = value
+		}
+		if samlRoleRank[role] > samlRoleRank[best] {
+			best = role
+		}
+	}
+	switch {
+	case current == "owner":
+		return current
+	case best != "":
+		return best
+	case current != "":
+		return current
+	default:
+		return m.DefaultRole
+	}
+}
+
+// ConfigureSAMLInput is the input for configuring an organization's SAML SSO.
+type ConfigureSAMLInput struct {
+	OrganizationID uuid.UUID
+	Enabled        bool
+	// Metadata is the identity provider's SAML metadata. If empty, the IdP
+	// is described by IdPEntityID, IdPSSOURL and IdPCertificate instead; if
+	// those are empty too, the stored IdP is kept.
+	Metadata       []byte
+	IdPEntityID    string
+	IdPSSOURL      string
+	IdPCertificate string
+	// AttributeMapping replaces the stored mapping unless nil.
+	AttributeMapping *SAMLAttributeMapping
+}
+
+// ConfigureSAML creates or updates an organization's SAML configuration. It
+// returns nil if SSO is being disabled for an organization that never
+// configured it.
+func (s *IdentityService) ConfigureSAML(ctx context.Context, input *ConfigureSAMLInput) (*repository.SAMLConfiguration, error) {
+	org, err := s.repos.Organizations.GetByID(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+	cfg, err := s.repos.SAML.GetByOrganizationID(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(input.Metadata) > 0:
+		md, err := saml.ParseMetadata(input.Metadata)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		if cfg == nil {
+			cfg = &repository.SAMLConfiguration{OrganizationID: org.ID}
+		}
+		cfg.IdPEntityID, cfg.IdPSSOURL, cfg.IdPCertificate = md.EntityID, md.SSOURL, saml.EncodeCertificates(md.Certificates)
+	case input.IdPEntityID != "" || input.IdPSSOURL != "" || input.IdPCertificate != "":
+		if input.IdPEntityID == "" || input.IdPSSOURL == "" || input.IdPCertificate == "" {
+			return nil, serviceerror.NewInvalidArgument("idp_entity_id, idp_sso_url and idp_certificate are required")
+		}
+		if u, err := url.Parse(input.IdPSSOURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, serviceerror.NewInvalidArgument("idp_sso_url must be an absolute HTTP(S) URL")
+		}
+		if _, err := saml.ParseCertificates(input.IdPCertificate); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid idp_certificate: %v", err))
+		}
+		if cfg == nil {
+			cfg = &repository.SAMLConfiguration{OrganizationID: org.ID}
+		}
+		cfg.IdPEntityID, cfg.IdPSSOURL, cfg.IdPCertificate = input.IdPEntityID, input.IdPSSOURL, input.IdPCertificate
+	case cfg == nil && !input.Enabled:
+		return nil, nil
+	case cfg == nil:
+		return nil, serviceerror.NewInvalidArgument("an identity provider is required to enable SAML SSO")
+	}
+
+	// The SP identifiers are registered with the IdP, so they never change
+	// once set.
+	if cfg.SPEntityID == "" {
+		cfg.SPEntityID = s.samlConfig.BaseURL + SAMLMetadataPath + org.ID.String()
+	}
+	if cfg.SPACSURL == "" {
+		cfg.SPACSURL = s.samlConfig.BaseURL + SAMLACSPath
+	}
+	cfg.Enabled = input.Enabled
+	if input.AttributeMapping != nil {
+		if err := input.AttributeMapping.validate(); err != nil {
+			return nil, err
+		}
+		if cfg.AttributeMapping, err = json.Marshal(input.AttributeMapping); err != nil {
+			return nil, fmt.Errorf("failed to encode SAML attribute mapping: %w", err)
+		}
+	}
+
+	if err := s.repos.SAML.Upsert(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// SAMLServiceProviderMetadata returns the SAML metadata of an organization's
+// service provider.
+func (s *IdentityService) SAMLServiceProviderMetadata(ctx context.Context, orgID uuid.UUID) ([]byte, error) {
+	cfg, err := s.repos.SAML.GetByOrganizationID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, serviceerror.NewNotFound("SAML is not configured for this organization")
+	}
+	sp := &saml.ServiceProvider{EntityID: cfg.SPEntityID, ACSURL: cfg.SPACSURL}
+	return sp.Metadata(), nil
+}
+
+// InitiateSAMLLogin starts an SP-initiated SAML login to an organization and
+// returns the identity provider URL to send the user to. After the login the
+// user is sent to redirectURL, which must be on an allowed origin, or to the
+// default redirect URL.
+func (s *IdentityService) InitiateSAMLLogin(ctx context.Context, orgSlug, redirectURL string) (string, error) {
+	org, err := s.repos.Organizations.GetBySlug(ctx, orgSlug)
+	if err != nil {
+		return "", err
+	}
+	if org == nil {
+		return "", serviceerror.NewNotFound("organization not found")
+	}
+	_, sp, err := s.samlServiceProvider(ctx, org.ID)
+	if err != nil {
+		return "", err
+	}
+	if redirectURL, err = s.samlRedirectURL(redirectURL); err != nil {
+		return "", err
+	}
+
+	requestID, err := saml.NewRequestID()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate SAML request ID: %w", err)
+	}
+	now := time.Now()
+	req := &repository.SAMLRequest{
+		OrganizationID: org.ID,
+		RequestID:      requestID,
+		RedirectURL:    redirectURL,
+		ExpiresAt:      now.Add(s.samlConfig.RequestTTL),
+	}
+	if err := s.repos.SAML.CreateRequest(ctx, req); err != nil {
+		return "", err
+	}
+	return sp.AuthnRequestURL(requestID, req.ID.String(), now)
+}
+
+// SAMLLoginResult is the outcome of a completed SAML login.
+type SAMLLoginResult struct {
+	User             *repository.User
+	OrganizationID   uuid.UUID
+	Role             string
+	AccessToken      string
+	RefreshToken     string
+	ExpiresAt        time.Time
+	RefreshExpiresAt time.Time
+	// RedirectURL is where the login asked the user to be sent.
+	RedirectURL string
+}
+
+// CompleteSAMLLogin validates the identity provider's response to a login
+// started by InitiateSAMLLogin. Users who are not yet members must have an
+// email address in one of the organization's verified domains; they are
+// created if the address is new and join the organization with the role
+// mapped from the assertion's attributes. Each login can be completed once.
+func (s *IdentityService) CompleteSAMLLogin(ctx context.Context, samlResponse, relayState string) (*SAMLLoginResult, error) {
+	requestID, err := uuid.Parse(relayState)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument("invalid SAML relay state")
+	}
+	now := time.Now()
+	req, err := s.repos.SAML.ConsumeRequest(ctx, requestID, now)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, serviceerror.NewPermissionDenied("SAML login has expired or was already completed", "")
+	}
+	cfg, sp, err := s.samlServiceProvider(ctx, req.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	assertion, err := sp.ParseResponse(samlResponse, req.RequestID, now)
+	if err != nil {
+		s.logger.Warn("Rejected SAML response",
+			tag.NewStringTag("organization_id", req.OrganizationID.String()), tag.Error(err))
+		if errors.Is(err, saml.ErrInvalidResponse) {
+			return nil, serviceerror.NewPermissionDenied("invalid SAML response", "")
+		}
+		return nil, err
+	}
+
+	mapping := &SAMLAttributeMapping{}
+	if len(cfg.AttributeMapping) > 0 {
+		if err := json.Unmarshal(cfg.AttributeMapping, mapping); err != nil {
+			return nil, fmt.Errorf("invalid SAML attribute mapping: %w", err)
+		}
+	}
+	mapping = mapping.withDefaults()
+
+	email := assertion.NameID
+	if mapping.Email != "" {
+		email = assertion.Attribute(mapping.Email)
+	}
+	email = strings.TrimSpace(email)
+	if !strings.Contains(email, "@") {
+		return nil, serviceerror.NewPermissionDenied("SAML assertion does not identify the user by email address", "")
+	}
+
+	// An organization's IdP is only trusted for its own members and for
+	// addresses in domains the organization has verified. Otherwise any
+	// organization could configure an IdP asserting someone else's email and
+	// take over their account.
+	user, err := s.repos.Users.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	var member *repository.OrganizationMember
+	if user != nil {
+		if member, err = s.repos.Organizations.GetMember(ctx, req.OrganizationID, user.ID); err != nil {
+			return nil, err
+		}
+	}
+	if member == nil {
+		verified, err := s.repos.Domains.IsVerified(ctx, req.OrganizationID, emailDomain(email))
+		if err != nil {
+			return nil, err
+		}
+		if !verified {
+			s.logger.Warn("Rejected SAML login outside the organization's verified domains",
+				tag.NewStringTag("organization_id", req.OrganizationID.String()))
+			return nil, serviceerror.NewPermissionDenied("email domain is not verified for this organization", "")
+		}
+	}
+	if user == nil {
+		name := assertion.Attribute(mapping.Name)
+		user = &repository.User{
+			Email:         email,
+			Name:          sql.NullString{String: name, Valid: name != ""},
+			EmailVerified: true,
+		}
+		if err := s.repos.Users.Create(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+	if member != nil && !member.Active {
+		return nil, serviceerror.NewPermissionDenied("user has been deactivated in this organization", "")
+	}
+	current := ""
+	if member != nil {
+		current = member.Role
+	}
+	role := mapping.role(assertion, current)
+	if role != current {
+		if err := s.repos.Organizations.AddMember(ctx, &repository.OrganizationMember{
+			OrganizationID: req.OrganizationID,
+			UserID:         user.ID,
+			Role:           role,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	accessToken, refreshToken, expiresAt, err := s.GenerateTokens(ctx, user.ID, user.Email, req.OrganizationID, role)
+	if err != nil {
+		return nil, err
+	}
+	return &SAMLLoginResult{
+		User:             user,
+		OrganizationID:   req.OrganizationID,
+		Role:             role,
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresAt:        expiresAt,
+		RefreshExpiresAt: now.Add(s.jwtConfig.RefreshExpiry),
+		RedirectURL:      req.RedirectURL,
+	}, nil
+}
+
+// samlServiceProvider returns an organization's enabled SAML configuration
+// and the service provider built from it.
+func (s *IdentityService) samlServiceProvider(ctx context.Context, orgID uuid.UUID) (*repository.SAMLConfiguration, *saml.ServiceProvider, error) {
+	cfg, err := s.repos.SAML.GetByOrganizationID(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil, serviceerror.NewFailedPrecondition("SAML SSO is not enabled for this organization")
+	}
+	certs, err := saml.ParseCertificates(cfg.IdPCertificate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid stored IdP certificate: %w", err)
+	}
+	return cfg, &saml.ServiceProvider{
+		EntityID: cfg.SPEntityID,
+		ACSURL:   cfg.SPACSURL,
+		IdP: saml.IdPMetadata{
+			EntityID:     cfg.IdPEntityID,
+			SSOURL:       cfg.IdPSSOURL,
+			Certificates: certs,
+		},
+		ClockSkew: s.samlConfig.ClockSkew,
+	}, nil
+}
+
+// samlRedirectURL checks that a post-login redirect stays on an allowed
+// origin, so logins cannot be used to send users elsewhere.
+func (s *IdentityService) samlRedirectURL(redirectURL string) (string, error) {
+	if redirectURL == "" {
+		return s.samlConfig.DefaultRedirectURL, nil
+	}
+	u, err := url.Parse(redirectURL)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return "", serviceerror.NewInvalidArgument("redirect_url must be an absolute HTTP(S) URL")
+	}
+	origin := u.Scheme + "://" + u.Host
+	allowed := append([]string{s.samlConfig.DefaultRedirectURL}, s.samlConfig.AllowedRedirectOrigins...)
+	for _, a := range allowed {
+		if au, err := url.Parse(a); err == nil && au.Scheme+"://"+au.Host == origin {
+			return redirectURL, nil
+		}
+	}
+	return "", serviceerror.NewInvalidArgument("redirect_url is not on an allowed origin")
+}
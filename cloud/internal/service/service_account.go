@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+)
+
+// ServiceAccountWithPermissions is a service account and its namespace
+// permissions.
+type ServiceAccountWithPermissions struct {
+	*repository.ServiceAccount
+	Permissions []*repository.ServiceAccountNamespacePermission
+}
+
+// ServiceAccountInput is the input for creating or updating a service account.
+type ServiceAccountInput struct {
+	OrganizationID uuid.UUID
+	Name           string
+	Description    string
+	// AccountRole is the service account's organization role. It is empty for
+	// service accounts that only have namespace permissions.
+	AccountRole string
+	Permissions []*repository.ServiceAccountNamespacePermission
+}
+
+// ServiceAccountAuth is what a service account is allowed to do, as resolved
+// when one of its API keys is used.
+type ServiceAccountAuth struct {
+	OrganizationID uuid.UUID
+	Role           string
+	// Permissions are "namespace_<level>:<namespace>" strings.
+	Permissions []string
+}
+
+// CreateServiceAccount creates a service account in an organization.
+func (s *IdentityService) CreateServiceAccount(ctx context.Context, input *ServiceAccountInput) (*ServiceAccountWithPermissions, error) {
+	if err := s.validateServiceAccount(ctx, input); err != nil {
+		return nil, err
+	}
+	org, err := s.repos.Organizations.GetByID(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, serviceerror.NewNotFound("organization not found")
+	}
+
+	sa := &repository.ServiceAccount{
+		OrganizationID: input.OrganizationID,
+		Name:           input.Name,
+		Description:    sql.NullString{String: input.Description, Valid: input.Description != ""},
+		AccountRole:    sql.NullString{String: input.AccountRole, Valid: input.AccountRole != ""},
+	}
+	if err := s.repos.ServiceAccounts.Create(ctx, sa, input.Permissions); err != nil {
+		return nil, err
+	}
+	return &ServiceAccountWithPermissions{ServiceAccount: sa, Permissions: input.Permissions}, nil
+}
+
+// GetServiceAccount retrieves a service account and its namespace permissions.
+func (s *IdentityService) GetServiceAccount(ctx context.Context, id uuid.UUID) (*ServiceAccountWithPermissions, error) {
+	sa, err := s.repos.ServiceAccounts.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sa == nil {
+		return nil, serviceerror.NewNotFound("service account not found")
+	}
+	perms, err := s.repos.ServiceAccounts.ListNamespacePermissions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceAccountWithPermissions{ServiceAccount: sa, Permissions: perms}, nil
+}
+
+// ListServiceAccounts lists an organization's service accounts.
+func (s *IdentityService) ListServiceAccounts(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*ServiceAccountWithPermissions, error) {
+	accounts, err := s.repos.ServiceAccounts.ListByOrganization(ctx, orgID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*ServiceAccountWithPermissions, 0, len(accounts))
+	for _, sa := range accounts {
+		perms, err := s.repos.ServiceAccounts.ListNamespacePermissions(ctx, sa.ID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &ServiceAccountWithPermissions{ServiceAccount: sa, Permissions: perms})
+	}
+	return out, nil
+}
+
+// UpdateServiceAccount replaces a service account's name, description, role
+// and namespace permissions. Its API keys pick up the change on their next
+// use.
+func (s *IdentityService) UpdateServiceAccount(ctx context.Context, id uuid.UUID, input *ServiceAccountInput) (*ServiceAccountWithPermissions, error) {
+	sa, err := s.repos.ServiceAccounts.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sa == nil {
+		return nil, serviceerror.NewNotFound("service account not found")
+	}
+	input.OrganizationID = sa.OrganizationID
+	if err := s.validateServiceAccount(ctx, input); err != nil {
+		return nil, err
+	}
+
+	sa.Name = input.Name
+	sa.Description = sql.NullString{String: input.Description, Valid: input.Description != ""}
+	sa.AccountRole = sql.NullString{String: input.AccountRole, Valid: input.AccountRole != ""}
+	if err := s.repos.ServiceAccounts.Update(ctx, sa, input.Permissions); err != nil {
+		return nil, err
+	}
+	return &ServiceAccountWithPermissions{ServiceAccount: sa, Permissions: input.Permissions}, nil
+}
+
+// DeleteServiceAccount deletes a service account and its API keys.
+func (s *IdentityService) DeleteServiceAccount(ctx context.Context, id uuid.UUID) error {
+	deleted, err := s.repos.ServiceAccounts.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return serviceerror.NewNotFound("service account not found")
+	}
+	return nil
+}
+
+// ServiceAccountPermissions resolves the organization, role and namespace
+// permissions of a service account. It returns nil if the service account no
+// longer exists.
+func (s *IdentityService) ServiceAccountPermissions(ctx context.Context, id uuid.UUID) (*ServiceAccountAuth, error) {
+	sa, err := s.repos.ServiceAccounts.GetByID(ctx, id)
+	if err != nil || sa == nil {
+		return nil, err
+	}
+	perms, err := s.repos.ServiceAccounts.ListNamespacePermissions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := &ServiceAccountAuth{
+		OrganizationID: sa.OrganizationID,
+		Role:           sa.AccountRole.String,
+		Permissions:    make([]string, 0, len(perms)),
+	}
+	for _, perm := range perms {
+		auth.Permissions = append(auth.Permissions, "namespace_"+perm.Permission+":"+perm.NamespaceID)
+	}
+	return auth, nil
+}
+
+// validateServiceAccount checks a service account's fields and that its
+// namespace permissions are for namespaces of its organization.
+func (s *IdentityService) validateServiceAccount(ctx context.Context, input *ServiceAccountInput) error {
+	if input.Name == "" {
+		return serviceerror.NewInvalidArgument("service account name is required")
+	}
+	// Service accounts cannot own organizations.
+	if input.AccountRole != "" && (input.AccountRole == "owner" || !isValidOrgRole(input.AccountRole)) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid service account role %q", input.AccountRole))
+	}
+
+	seen := make(map[string]bool, len(input.Permissions))
+	for _, perm := range input.Permissions {
+		if namespacePermissionRank[perm.Permission] == 0 {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid namespace permission %q", perm.Permission))
+		}
+		if seen[perm.NamespaceID] {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("duplicate permission for namespace %q", perm.NamespaceID))
+		}
+		seen[perm.NamespaceID] = true
+		ns, err := s.repos.Namespaces.GetByID(ctx, perm.NamespaceID)
+		if err != nil {
+			return err
+		}
+		if ns == nil || ns.OrganizationID != input.OrganizationID {
+			return serviceerror.NewNotFound(fmt.Sprintf("namespace %q not found", perm.NamespaceID))
+		}
+	}
+	return nil
+}
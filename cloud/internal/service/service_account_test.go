@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/repository/repositorytest"
+	"go.temporal.io/server/common/log"
+)
+
+func newTestIdentityService(t *testing.T) (*IdentityService, *repository.Repositories) {
+	t.Helper()
+	repos := repositorytest.NewRepositories(t)
+	return NewIdentityService(repos, config.JWTConfig{SecretKey: "test-secret"}, config.SAMLConfig{}, log.NewNoopLogger()), repos
+}
+
+// createTestNamespace creates an organization with a namespace.
+func createTestNamespace(t *testing.T, repos *repository.Repositories, slug string) (*repository.Organization, *repository.Namespace) {
+	t.Helper()
+	ctx := context.Background()
+	org := &repository.Organization{Name: slug, Slug: slug}
+	require.NoError(t, repos.Organizations.Create(ctx, org))
+	ns := &repository.Namespace{ID: "orders." + slug, OrganizationID: org.ID, Name: "orders", Region: "us-east-1", State: "active", RetentionDays: 7}
+	require.NoError(t, repos.Namespaces.Create(ctx, ns))
+	return org, ns
+}
+
+func apiKeyHash(plaintext string) string {
+	hash := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(hash[:])
+}
+
+func TestCreateServiceAccount(t *testing.T) {
+	s, repos := newTestIdentityService(t)
+	ctx := context.Background()
+	org, ns := createTestNamespace(t, repos, "acme")
+	_, otherNS := createTestNamespace(t, repos, "globex")
+
+	sa, err := s.CreateServiceAccount(ctx, &ServiceAccountInput{
+		OrganizationID: org.ID,
+		Name:           "ci",
+		AccountRole:    "developer",
+		Permissions:    []*repository.ServiceAccountNamespacePermission{{NamespaceID: ns.ID, Permission: "write"}},
+	})
+	require.NoError(t, err)
+	got, err := s.GetServiceAccount(ctx, sa.ID)
+	require.NoError(t, err)
+	require.Equal(t, org.ID, got.OrganizationID)
+	require.Equal(t, "developer", got.AccountRole.String)
+	require.Len(t, got.Permissions, 1)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	var notFound *serviceerror.NotFound
+	for name, input := range map[string]*ServiceAccountInput{
+		"no name":            {OrganizationID: org.ID},
+		"owner role":         {OrganizationID: org.ID, Name: "owner", AccountRole: "owner"},
+		"unknown role":       {OrganizationID: org.ID, Name: "root", AccountRole: "root"},
+		"unknown permission": {OrganizationID: org.ID, Name: "ci", Permissions: []*repository.ServiceAccountNamespacePermission{{NamespaceID: ns.ID, Permission: "owner"}}},
+		"duplicate namespace": {OrganizationID: org.ID, Name: "ci", Permissions: []*repository.ServiceAccountNamespacePermission{
+			{NamespaceID: ns.ID, Permission: "read"}, {NamespaceID: ns.ID, Permission: "write"},
+		}},
+	} {
+		_, err := s.CreateServiceAccount(ctx, input)
+		require.ErrorAs(t, err, &invalidArgument, name)
+	}
+
+	// Namespace permissions are limited to the organization's namespaces.
+	_, err = s.CreateServiceAccount(ctx, &ServiceAccountInput{
+		OrganizationID: org.ID,
+		Name:           "elsewhere",
+		Permissions:    []*repository.ServiceAccountNamespacePermission{{NamespaceID: otherNS.ID, Permission: "read"}},
+	})
+	require.ErrorAs(t, err, &notFound)
+	_, err = s.CreateServiceAccount(ctx, &ServiceAccountInput{OrganizationID: uuid.New(), Name: "orphan"})
+	require.ErrorAs(t, err, &notFound)
+
+	accounts, err := s.ListServiceAccounts(ctx, org.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+}
+
+func TestUpdateServiceAccountKeepsOrganization(t *testing.T) {
+	s, repos := newTestIdentityService(t)
+	ctx := context.Background()
+	org, ns := createTestNamespace(t, repos, "acme")
+	other, otherNS := createTestNamespace(t, repos, "globex")
+
+	sa, err := s.CreateServiceAccount(ctx, &ServiceAccountInput{OrganizationID: org.ID, Name: "ci"})
+	require.NoError(t, err)
+
+	// The organization in the input is ignored, so permissions cannot be
+	// granted on another organization's namespaces.
+	var notFound *serviceerror.NotFound
+	_, err = s.UpdateServiceAccount(ctx, sa.ID, &ServiceAccountInput{
+		OrganizationID: other.ID,
+		Name:           "ci",
+		Permissions:    []*repository.ServiceAccountNamespacePermission{{NamespaceID: otherNS.ID, Permission: "admin"}},
+	})
+	require.ErrorAs(t, err, &notFound)
+
+	updated, err := s.UpdateServiceAccount(ctx, sa.ID, &ServiceAccountInput{
+		OrganizationID: other.ID,
+		Name:           "ci",
+		Permissions:    []*repository.ServiceAccountNamespacePermission{{NamespaceID: ns.ID, Permission: "admin"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, org.ID, updated.OrganizationID)
+
+	_, err = s.UpdateServiceAccount(ctx, uuid.New(), &ServiceAccountInput{Name: "ci"})
+	require.ErrorAs(t, err, &notFound)
+}
+
+func TestServiceAccountAPIKeys(t *testing.T) {
+	s, repos := newTestIdentityService(t)
+	ctx := context.Background()
+	org, ns := createTestNamespace(t, repos, "acme")
+
+	sa, err := s.CreateServiceAccount(ctx, &ServiceAccountInput{
+		OrganizationID: org.ID,
+		Name:           "ci",
+		AccountRole:    "read_only",
+		Permissions:    []*repository.ServiceAccountNamespacePermission{{NamespaceID: ns.ID, Permission: "write"}},
+	})
+	require.NoError(t, err)
+
+	var notFound *serviceerror.NotFound
+	_, _, err = s.CreateAPIKey(ctx, &CreateAPIKeyInput{OwnerType: APIKeyOwnerServiceAccount, OwnerID: uuid.New(), Name: "stray"})
+	require.ErrorAs(t, err, &notFound)
+
+	key, secret, err := s.CreateAPIKey(ctx, &CreateAPIKeyInput{OwnerType: APIKeyOwnerServiceAccount, OwnerID: sa.ID, Name: "ci-key"})
+	require.NoError(t, err)
+	info, err := s.ValidateAPIKey(ctx, apiKeyHash(secret))
+	require.NoError(t, err)
+	require.Equal(t, org.ID, info.OrganizationID)
+	require.Equal(t, "read_only", info.Role)
+	require.Equal(t, []string{"namespace_write:" + ns.ID}, info.Permissions)
+
+	// Rotating replaces the key with one of the same owner.
+	rotated, rotatedSecret, err := s.RotateAPIKey(ctx, key.ID)
+	require.NoError(t, err)
+	require.Equal(t, sa.ID, rotated.OwnerID)
+	require.Equal(t, "ci-key", rotated.Name.String)
+	info, err = s.ValidateAPIKey(ctx, apiKeyHash(secret))
+	require.NoError(t, err)
+	require.Nil(t, info)
+	info, err = s.ValidateAPIKey(ctx, apiKeyHash(rotatedSecret))
+	require.NoError(t, err)
+	require.Equal(t, org.ID, info.OrganizationID)
+
+	// Revoking disables the key.
+	require.NoError(t, s.RevokeAPIKey(ctx, rotated.ID))
+	info, err = s.ValidateAPIKey(ctx, apiKeyHash(rotatedSecret))
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	// Deleting the service account revokes its remaining keys.
+	_, secret, err = s.CreateAPIKey(ctx, &CreateAPIKeyInput{OwnerType: APIKeyOwnerServiceAccount, OwnerID: sa.ID, Name: "ci-key-2"})
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteServiceAccount(ctx, sa.ID))
+	info, err = s.ValidateAPIKey(ctx, apiKeyHash(secret))
+	require.NoError(t, err)
+	require.Nil(t, info)
+	_, err = s.GetServiceAccount(ctx, sa.ID)
+	require.ErrorAs(t, err, &notFound)
+	require.ErrorAs(t, s.DeleteServiceAccount(ctx, sa.ID), &notFound)
+}
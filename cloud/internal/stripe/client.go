@@ -0,0 +1,215 @@
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultAPIBase is the base URL of the Stripe API.
+const DefaultAPIBase = "https://api.stripe.com"
+
+// HTTPClient calls the Stripe REST API.
+type HTTPClient struct {
+	secretKey string
+	apiBase   string
+	http      *http.Client
+}
+
+var _ Client = (*HTTPClient)(nil)
+
+// NewHTTPClient creates a client that authenticates with secretKey. An empty
+// apiBase defaults to DefaultAPIBase.
+func NewHTTPClient(secretKey, apiBase string) *HTTPClient {
+	if apiBase == "" {
+		apiBase = DefaultAPIBase
+	}
+	return &HTTPClient{
+		secretKey: secretKey,
+		apiBase:   strings.TrimSuffix(apiBase, "/"),
+		http:      &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// CreateCustomer implements Client.
+func (c *HTTPClient) CreateCustomer(ctx context.Context, params *CustomerParams) (*Customer, error) {
+	form := url.Values{}
+	form.Set("name", params.Name)
+	setMetadata(form, params.Metadata)
+
+	customer := &Customer{}
+	if err := c.post(ctx, "/v1/customers", form, params.IdempotencyKey, customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
+
+// SetDefaultPaymentMethod implements Client.
+func (c *HTTPClient) SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) (*Customer, error) {
+	form := url.Values{}
+	form.Set("customer", customerID)
+	if err := c.post(ctx, "/v1/payment_methods/"+url.PathEscape(paymentMethodID)+"/attach", form, "", nil); err != nil {
+		return nil, err
+	}
+
+	form = url.Values{}
+	form.Set("invoice_settings[default_payment_method]", paymentMethodID)
+	customer := &Customer{}
+	if err := c.post(ctx, "/v1/customers/"+url.PathEscape(customerID), form, "", customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
+
+// CreatePaymentIntent implements Client.
+func (c *HTTPClient) CreatePaymentIntent(ctx context.Context, params *PaymentIntentParams) (*PaymentIntent, error) {
+	customer := &Customer{}
+	if err := c.get(ctx, "/v1/customers/"+url.PathEscape(params.CustomerID), customer); err != nil {
+		return nil, err
+	}
+	if customer.InvoiceSettings.DefaultPaymentMethod == "" {
+		return nil, &Error{Type: "invalid_request_error", Code: "payment_method_missing",
+			Message: "customer has no default payment method"}
+	}
+
+	form := url.Values{}
+	form.Set("customer", params.CustomerID)
+	form.Set("amount", strconv.FormatInt(params.AmountCents, 10))
+	form.Set("currency", params.Currency)
+	form.Set("description", params.Description)
+	form.Set("payment_method", customer.InvoiceSettings.DefaultPaymentMethod)
+	form.Set("confirm", "true")
+	form.Set("off_session", "true")
+	setMetadata(form, params.Metadata)
+
+	intent := &PaymentIntent{}
+	if err := c.post(ctx, "/v1/payment_intents", form, params.IdempotencyKey, intent); err != nil {
+		return nil, err
+	}
+	return intent, nil
+}
+
+// CreateInvoice implements Client.
+func (c *HTTPClient) CreateInvoice(ctx context.Context, params *InvoiceParams) (*Invoice, error) {
+	form := url.Values{}
+	form.Set("customer", params.CustomerID)
+	form.Set("description", params.Description)
+	form.Set("collection_method", "charge_automatically")
+	form.Set("auto_advance", "true")
+	form.Set("pending_invoice_items_behavior", "exclude")
+	setMetadata(form, params.Metadata)
+
+	invoice := &Invoice{}
+	if err := c.post(ctx, "/v1/invoices", form, params.IdempotencyKey, invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// CreateInvoiceItem implements Client.
+func (c *HTTPClient) CreateInvoiceItem(ctx context.Context, params *InvoiceItemParams) (*InvoiceItem, error) {
+	form := url.Values{}
+	form.Set("customer", params.CustomerID)
+	form.Set("invoice", params.InvoiceID)
+	form.Set("amount", strconv.FormatInt(params.AmountCents, 10))
+	form.Set("currency", params.Currency)
+	form.Set("description", params.Description)
+	setMetadata(form, params.Metadata)
+
+	item := &InvoiceItem{}
+	if err := c.post(ctx, "/v1/invoiceitems", form, params.IdempotencyKey, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// FinalizeInvoice implements Client.
+func (c *HTTPClient) FinalizeInvoice(ctx context.Context, invoiceID string) (*Invoice, error) {
+	invoice := &Invoice{}
+	if err := c.post(ctx, "/v1/invoices/"+url.PathEscape(invoiceID)+"/finalize", url.Values{}, "", invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// GetInvoice implements Client.
+func (c *HTTPClient) GetInvoice(ctx context.Context, invoiceID string) (*Invoice, error) {
+	invoice := &Invoice{}
+	if err := c.get(ctx, "/v1/invoices/"+url.PathEscape(invoiceID), invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (c *HTTPClient) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiBase+path, nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, out)
+}
+
+func (c *HTTPClient) post(ctx context.Context, path string, form url.Values, idempotencyKey string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiBase+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	return c.do(req, out)
+}
+
+func (c *HTTPClient) do(req *http.Request, out any) error {
+	req.Header.Set("Authorization", "Bearer "+c.secretKey)
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("stripe request %s %s failed: %w", req.Method, req.URL.Path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read stripe response: %w", err)
+	}
+	if resp.StatusCode >= 300 {
+		var envelope struct {
+			Error *Error `json:"error"`
+		}
+		if err := json.Unmarshal(body, &envelope); err != nil || envelope.Error == nil {
+			return &Error{HTTPStatus: resp.StatusCode, Type: "api_error", Message: strings.TrimSpace(string(body))}
+		}
+		envelope.Error.HTTPStatus = resp.StatusCode
+		return envelope.Error
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode stripe response: %w", err)
+	}
+	return nil
+}
+
+func setMetadata(form url.Values, metadata map[string]string) {
+	for k, v := range metadata {
+		form.Set("metadata["+k+"]", v)
+	}
+}
+
+// AsError returns the Stripe API error wrapped by err, if any.
+func AsError(err error) (*Error, bool) {
+	var stripeErr *Error
+	if errors.As(err, &stripeErr) {
+		return stripeErr, true
+	}
+	return nil, false
+}
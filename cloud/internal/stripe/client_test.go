@@ -0,0 +1,109 @@
+package stripe_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/stripe"
+	"go.temporal.io/cloud/internal/stripe/stripetest"
+)
+
+func TestHTTPClientAgainstFake(t *testing.T) {
+	const secretKey, webhookSecret = "sk_test_123", "whsec_123"
+	fake := stripetest.NewServer(secretKey, webhookSecret)
+	defer fake.Close()
+
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+	webhooks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := io.ReadAll(r.Body)
+		event, err := stripe.ConstructEvent(payload, r.Header.Get(stripe.SignatureHeader), webhookSecret,
+			stripe.DefaultWebhookTolerance, time.Now())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, event.Type)
+		mu.Unlock()
+	}))
+	defer webhooks.Close()
+	fake.SetWebhookURL(webhooks.URL)
+
+	ctx := context.Background()
+	c := stripe.NewHTTPClient(secretKey, fake.URL)
+
+	_, err := stripe.NewHTTPClient("sk_wrong", fake.URL).CreateCustomer(ctx, &stripe.CustomerParams{Name: "Acme"})
+	stripeErr, ok := stripe.AsError(err)
+	require.True(t, ok)
+	require.Equal(t, http.StatusUnauthorized, stripeErr.HTTPStatus)
+
+	customer, err := c.CreateCustomer(ctx, &stripe.CustomerParams{Name: "Acme", IdempotencyKey: "customer-acme"})
+	require.NoError(t, err)
+	again, err := c.CreateCustomer(ctx, &stripe.CustomerParams{Name: "Acme", IdempotencyKey: "customer-acme"})
+	require.NoError(t, err)
+	require.Equal(t, customer.ID, again.ID)
+
+	_, err = c.CreatePaymentIntent(ctx, &stripe.PaymentIntentParams{CustomerID: customer.ID, AmountCents: 1000, Currency: "usd"})
+	stripeErr, ok = stripe.AsError(err)
+	require.True(t, ok)
+	require.Equal(t, "payment_method_missing", stripeErr.Code)
+
+	customer, err = c.SetDefaultPaymentMethod(ctx, customer.ID, stripe.TestPaymentMethodDeclined)
+	require.NoError(t, err)
+	require.Equal(t, stripe.TestPaymentMethodDeclined, customer.InvoiceSettings.DefaultPaymentMethod)
+	_, err = c.CreatePaymentIntent(ctx, &stripe.PaymentIntentParams{CustomerID: customer.ID, AmountCents: 1000, Currency: "usd"})
+	stripeErr, ok = stripe.AsError(err)
+	require.True(t, ok)
+	require.True(t, stripeErr.IsCardError())
+
+	invoice, err := c.CreateInvoice(ctx, &stripe.InvoiceParams{
+		CustomerID: customer.ID,
+		Metadata:   map[string]string{"cloud_invoice_id": "inv-1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, stripe.InvoiceStatusDraft, invoice.Status)
+	require.Equal(t, "inv-1", invoice.Metadata["cloud_invoice_id"])
+	_, err = c.CreateInvoiceItem(ctx, &stripe.InvoiceItemParams{
+		CustomerID: customer.ID, InvoiceID: invoice.ID, AmountCents: 10000, Currency: "usd", Description: "Essentials Plan",
+	})
+	require.NoError(t, err)
+
+	invoice, err = c.FinalizeInvoice(ctx, invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, stripe.InvoiceStatusOpen, invoice.Status)
+	require.Equal(t, int64(10000), invoice.AmountDue)
+
+	_, err = c.SetDefaultPaymentMethod(ctx, customer.ID, stripe.TestPaymentMethodVisa)
+	require.NoError(t, err)
+	require.NoError(t, fake.PayInvoice(invoice.ID))
+	invoice, err = c.GetInvoice(ctx, invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, stripe.InvoiceStatusPaid, invoice.Status)
+
+	intent, err := c.CreatePaymentIntent(ctx, &stripe.PaymentIntentParams{
+		CustomerID: customer.ID, AmountCents: 2500, Currency: "usd", Metadata: map[string]string{"purpose": "credits"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, stripe.PaymentIntentStatusSucceeded, intent.Status)
+	require.Equal(t, "credits", intent.Metadata["purpose"])
+
+	require.Empty(t, fake.DeliveryErrors())
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{
+		stripe.EventPaymentIntentPaymentFailed,
+		stripe.EventInvoiceFinalized,
+		stripe.EventInvoicePaymentFailed,
+		stripe.EventInvoicePaid,
+		stripe.EventPaymentIntentSucceeded,
+	}, received)
+}
@@ -0,0 +1,142 @@
+// Package stripe is a minimal client for the parts of the Stripe API used for
+// billing, and the verification of the webhooks Stripe sends.
+package stripe
+
+import (
+	"context"
+	"fmt"
+)
+
+// Test payment methods understood by Stripe in test mode and by the fake in
+// stripetest.
+const (
+	TestPaymentMethodVisa     = "pm_card_visa"
+	TestPaymentMethodDeclined = "pm_card_chargeDeclined"
+)
+
+// Invoice and payment intent statuses.
+const (
+	InvoiceStatusDraft         = "draft"
+	InvoiceStatusOpen          = "open"
+	InvoiceStatusPaid          = "paid"
+	InvoiceStatusVoid          = "void"
+	InvoiceStatusUncollectible = "uncollectible"
+
+	PaymentIntentStatusSucceeded = "succeeded"
+)
+
+// Client is the subset of the Stripe API used by billing.
+type Client interface {
+	CreateCustomer(ctx context.Context, params *CustomerParams) (*Customer, error)
+	// SetDefaultPaymentMethod attaches the payment method to the customer and
+	// makes it the default for invoices and payments.
+	SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) (*Customer, error)
+	// CreatePaymentIntent creates and confirms an off-session payment with
+	// the customer's default payment method.
+	CreatePaymentIntent(ctx context.Context, params *PaymentIntentParams) (*PaymentIntent, error)
+	CreateInvoice(ctx context.Context, params *InvoiceParams) (*Invoice, error)
+	CreateInvoiceItem(ctx context.Context, params *InvoiceItemParams) (*InvoiceItem, error)
+	// FinalizeInvoice finalizes a draft invoice. Stripe then collects it
+	// automatically and reports the outcome through webhooks.
+	FinalizeInvoice(ctx context.Context, invoiceID string) (*Invoice, error)
+	GetInvoice(ctx context.Context, invoiceID string) (*Invoice, error)
+}
+
+// Customer is a Stripe customer.
+type Customer struct {
+	ID              string            `json:"id"`
+	Name            string            `json:"name"`
+	Metadata        map[string]string `json:"metadata"`
+	InvoiceSettings struct {
+		DefaultPaymentMethod string `json:"default_payment_method"`
+	} `json:"invoice_settings"`
+}
+
+// CustomerParams are the parameters for creating a customer.
+type CustomerParams struct {
+	Name           string
+	Metadata       map[string]string
+	IdempotencyKey string
+}
+
+// PaymentIntent is a Stripe payment intent.
+type PaymentIntent struct {
+	ID               string            `json:"id"`
+	Amount           int64             `json:"amount"`
+	Currency         string            `json:"currency"`
+	Customer         string            `json:"customer"`
+	Status           string            `json:"status"`
+	Metadata         map[string]string `json:"metadata"`
+	LastPaymentError *Error            `json:"last_payment_error"`
+	// Created is when the payment intent was created, in Unix seconds.
+	Created int64 `json:"created"`
+}
+
+// PaymentIntentParams are the parameters for creating a payment intent.
+type PaymentIntentParams struct {
+	CustomerID     string
+	AmountCents    int64
+	Currency       string
+	Description    string
+	Metadata       map[string]string
+	IdempotencyKey string
+}
+
+// Invoice is a Stripe invoice.
+type Invoice struct {
+	ID         string            `json:"id"`
+	Customer   string            `json:"customer"`
+	Status     string            `json:"status"`
+	Total      int64             `json:"total"`
+	AmountDue  int64             `json:"amount_due"`
+	AmountPaid int64             `json:"amount_paid"`
+	Metadata   map[string]string `json:"metadata"`
+}
+
+// InvoiceParams are the parameters for creating an invoice. Invoices are
+// charged automatically and only contain items added to them explicitly.
+type InvoiceParams struct {
+	CustomerID     string
+	Description    string
+	Metadata       map[string]string
+	IdempotencyKey string
+}
+
+// InvoiceItem is a line on a Stripe invoice.
+type InvoiceItem struct {
+	ID          string `json:"id"`
+	Invoice     string `json:"invoice"`
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+}
+
+// InvoiceItemParams are the parameters for adding an item to an invoice.
+type InvoiceItemParams struct {
+	CustomerID     string
+	InvoiceID      string
+	AmountCents    int64
+	Currency       string
+	Description    string
+	Metadata       map[string]string
+	IdempotencyKey string
+}
+
+// Error is an error returned by the Stripe API.
+type Error struct {
+	HTTPStatus int    `json:"-"`
+	Type       string `json:"type"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("stripe: %s (%s): %s", e.Type, e.Code, e.Message)
+	}
+	return fmt.Sprintf("stripe: %s: %s", e.Type, e.Message)
+}
+
+// IsCardError reports whether the error is a declined payment.
+func (e *Error) IsCardError() bool {
+	return e.Type == "card_error"
+}
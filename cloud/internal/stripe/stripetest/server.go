@@ -0,0 +1,358 @@
+// Package stripetest provides an in-process fake of the Stripe API for tests.
+// It implements the endpoints used by stripe.HTTPClient, charges the test
+// payment methods the way Stripe test mode does, and delivers signed webhooks.
+package stripetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/cloud/internal/stripe"
+)
+
+// Server is a fake Stripe API server.
+type Server struct {
+	*httptest.Server
+
+	secretKey     string
+	webhookSecret string
+
+	mu          sync.Mutex
+	webhookURL  string
+	nextID      int
+	customers   map[string]*stripe.Customer
+	attached    map[string]string // payment method -> customer
+	invoices    map[string]*stripe.Invoice
+	intents     map[string]*stripe.PaymentIntent
+	idempotent  map[string][]byte
+	events      []*stripe.Event
+	deliveryErr []error
+}
+
+// NewServer starts a fake Stripe server that accepts secretKey and signs
+// webhooks with webhookSecret.
+func NewServer(secretKey, webhookSecret string) *Server {
+	s := &Server{
+		secretKey:     secretKey,
+		webhookSecret: webhookSecret,
+		customers:     make(map[string]*stripe.Customer),
+		attached:      make(map[string]string),
+		invoices:      make(map[string]*stripe.Invoice),
+		intents:       make(map[string]*stripe.PaymentIntent),
+		idempotent:    make(map[string][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// SetWebhookURL sets the endpoint webhooks are delivered to. Without one,
+// events are only recorded.
+func (s *Server) SetWebhookURL(url string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.webhookURL = url
+}
+
+// Events returns the events generated so far.
+func (s *Server) Events() []*stripe.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*stripe.Event(nil), s.events...)
+}
+
+// DeliveryErrors returns the errors of failed webhook deliveries.
+func (s *Server) DeliveryErrors() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]error(nil), s.deliveryErr...)
+}
+
+// Invoice returns a copy of an invoice, or nil.
+func (s *Server) Invoice(id string) *stripe.Invoice {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if inv, ok := s.invoices[id]; ok {
+		copied := *inv
+		return &copied
+	}
+	return nil
+}
+
+// PayInvoice retries payment of an open invoice with the customer's current
+// default payment method, as Stripe's automatic collection would.
+func (s *Server) PayInvoice(id string) error {
+	s.mu.Lock()
+	inv, ok := s.invoices[id]
+	if !ok {
+		s.mu.Unlock()
+		return fmt.Errorf("no such invoice %s", id)
+	}
+	events := s.collectLocked(inv)
+	s.mu.Unlock()
+
+	s.deliver(events)
+	return nil
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+s.secretKey {
+		writeError(w, http.StatusUnauthorized, &stripe.Error{Type: "invalid_request_error", Message: "Invalid API Key provided"})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, &stripe.Error{Type: "invalid_request_error", Message: err.Error()})
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if key != "" {
+		s.mu.Lock()
+		cached, ok := s.idempotent[key]
+		s.mu.Unlock()
+		if ok {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(cached)
+			return
+		}
+	}
+
+	status, body, events := s.route(r)
+	if key != "" && status < 300 {
+		s.mu.Lock()
+		s.idempotent[key] = body
+		s.mu.Unlock()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+
+	s.deliver(events)
+}
+
+func (s *Server) route(r *http.Request) (int, []byte, []*stripe.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/customers":
+		customer := &stripe.Customer{ID: s.newID("cus"), Name: r.PostForm.Get("name"), Metadata: metadata(r)}
+		s.customers[customer.ID] = customer
+		return ok(customer)
+
+	case len(parts) == 3 && parts[1] == "customers":
+		customer, found := s.customers[parts[2]]
+		if !found {
+			return notFound("customer", parts[2])
+		}
+		if r.Method == http.MethodPost {
+			if pm := r.PostForm.Get("invoice_settings[default_payment_method]"); pm != "" {
+				if s.attached[pm] != customer.ID {
+					return invalidRequest("payment_method_not_attached", "The payment method must be attached to the customer")
+				}
+				customer.InvoiceSettings.DefaultPaymentMethod = pm
+			}
+		}
+		return ok(customer)
+
+	case r.Method == http.MethodPost && len(parts) == 4 && parts[1] == "payment_methods" && parts[3] == "attach":
+		customerID := r.PostForm.Get("customer")
+		if _, found := s.customers[customerID]; !found {
+			return notFound("customer", customerID)
+		}
+		if !strings.HasPrefix(parts[2], "pm_") {
+			return notFound("payment_method", parts[2])
+		}
+		s.attached[parts[2]] = customerID
+		return ok(map[string]string{"id": parts[2], "customer": customerID})
+
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/payment_intents":
+		return s.createPaymentIntent(r)
+
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/invoices":
+		customerID := r.PostForm.Get("customer")
+		if _, found := s.customers[customerID]; !found {
+			return notFound("customer", customerID)
+		}
+		inv := &stripe.Invoice{ID: s.newID("in"), Customer: customerID, Status: stripe.InvoiceStatusDraft, Metadata: metadata(r)}
+		s.invoices[inv.ID] = inv
+		return ok(inv)
+
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/invoiceitems":
+		inv, found := s.invoices[r.PostForm.Get("invoice")]
+		if !found {
+			return notFound("invoice", r.PostForm.Get("invoice"))
+		}
+		if inv.Status != stripe.InvoiceStatusDraft {
+			return invalidRequest("invoice_not_editable", "Invoice is no longer a draft")
+		}
+		amount, _ := strconv.ParseInt(r.PostForm.Get("amount"), 10, 64)
+		inv.Total += amount
+		inv.AmountDue += amount
+		return ok(&stripe.InvoiceItem{ID: s.newID("ii"), Invoice: inv.ID, Amount: amount, Description: r.PostForm.Get("description")})
+
+	case len(parts) == 3 && parts[1] == "invoices" && r.Method == http.MethodGet:
+		inv, found := s.invoices[parts[2]]
+		if !found {
+			return notFound("invoice", parts[2])
+		}
+		return ok(inv)
+
+	case len(parts) == 4 && parts[1] == "invoices" && parts[3] == "finalize" && r.Method == http.MethodPost:
+		inv, found := s.invoices[parts[2]]
+		if !found {
+			return notFound("invoice", parts[2])
+		}
+		if inv.Status != stripe.InvoiceStatusDraft {
+			return invalidRequest("invoice_not_editable", "Invoice is already finalized")
+		}
+		inv.Status = stripe.InvoiceStatusOpen
+		events := []*stripe.Event{s.eventLocked(stripe.EventInvoiceFinalized, inv)}
+		events = append(events, s.collectLocked(inv)...)
+		status, body, _ := ok(inv)
+		return status, body, events
+	}
+	return notFound("route", r.URL.Path)
+}
+
+func (s *Server) createPaymentIntent(r *http.Request) (int, []byte, []*stripe.Event) {
+	customerID := r.PostForm.Get("customer")
+	if _, found := s.customers[customerID]; !found {
+		return notFound("customer", customerID)
+	}
+	amount, err := strconv.ParseInt(r.PostForm.Get("amount"), 10, 64)
+	if err != nil || amount <= 0 {
+		return invalidRequest("parameter_invalid_integer", "Invalid amount")
+	}
+	pm := r.PostForm.Get("payment_method")
+	if s.attached[pm] != customerID {
+		return invalidRequest("payment_method_not_attached", "The payment method must be attached to the customer")
+	}
+
+	intent := &stripe.PaymentIntent{
+		ID:       s.newID("pi"),
+		Amount:   amount,
+		Currency: r.PostForm.Get("currency"),
+		Customer: customerID,
+		Metadata: metadata(r),
+		Status:   stripe.PaymentIntentStatusSucceeded,
+		Created:  time.Now().Unix(),
+	}
+	s.intents[intent.ID] = intent
+	if declined(pm) {
+		intent.Status = "requires_payment_method"
+		intent.LastPaymentError = declineError()
+		event := s.eventLocked(stripe.EventPaymentIntentPaymentFailed, intent)
+		body, _ := json.Marshal(map[string]any{"error": intent.LastPaymentError})
+		return http.StatusPaymentRequired, body, []*stripe.Event{event}
+	}
+	status, body, _ := ok(intent)
+	return status, body, []*stripe.Event{s.eventLocked(stripe.EventPaymentIntentSucceeded, intent)}
+}
+
+// collectLocked attempts to charge an open invoice.
+func (s *Server) collectLocked(inv *stripe.Invoice) []*stripe.Event {
+	if inv.Status != stripe.InvoiceStatusOpen {
+		return nil
+	}
+	pm := s.customers[inv.Customer].InvoiceSettings.DefaultPaymentMethod
+	if inv.AmountDue > 0 && (pm == "" || declined(pm)) {
+		return []*stripe.Event{s.eventLocked(stripe.EventInvoicePaymentFailed, inv)}
+	}
+	inv.Status = stripe.InvoiceStatusPaid
+	inv.AmountPaid = inv.AmountDue
+	inv.AmountDue = 0
+	return []*stripe.Event{s.eventLocked(stripe.EventInvoicePaid, inv)}
+}
+
+func (s *Server) eventLocked(eventType string, object any) *stripe.Event {
+	data, _ := json.Marshal(object)
+	event := &stripe.Event{ID: s.newID("evt"), Type: eventType, Created: time.Now().Unix()}
+	event.Data.Object = data
+	s.events = append(s.events, event)
+	return event
+}
+
+// deliver posts events to the webhook endpoint synchronously and in order.
+func (s *Server) deliver(events []*stripe.Event) {
+	s.mu.Lock()
+	url := s.webhookURL
+	s.mu.Unlock()
+	if url == "" {
+		return
+	}
+
+	for _, event := range events {
+		payload, _ := json.Marshal(event)
+		req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(stripe.SignatureHeader, stripe.SignPayload(payload, s.webhookSecret, time.Now()))
+
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode >= 300 {
+				err = fmt.Errorf("webhook %s returned %s", event.Type, resp.Status)
+			}
+		}
+		if err != nil {
+			s.mu.Lock()
+			s.deliveryErr = append(s.deliveryErr, err)
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s_test%06d", prefix, s.nextID)
+}
+
+func metadata(r *http.Request) map[string]string {
+	md := make(map[string]string)
+	for key, values := range r.PostForm {
+		if strings.HasPrefix(key, "metadata[") && strings.HasSuffix(key, "]") {
+			md[key[len("metadata["):len(key)-1]] = values[0]
+		}
+	}
+	return md
+}
+
+func declined(paymentMethod string) bool {
+	return paymentMethod == stripe.TestPaymentMethodDeclined
+}
+
+func declineError() *stripe.Error {
+	return &stripe.Error{Type: "card_error", Code: "card_declined", Message: "Your card was declined."}
+}
+
+func ok(object any) (int, []byte, []*stripe.Event) {
+	body, _ := json.Marshal(object)
+	return http.StatusOK, body, nil
+}
+
+func notFound(kind, id string) (int, []byte, []*stripe.Event) {
+	body, _ := json.Marshal(map[string]any{"error": &stripe.Error{
+		Type: "invalid_request_error", Code: "resource_missing", Message: fmt.Sprintf("No such %s: '%s'", kind, id),
+	}})
+	return http.StatusNotFound, body, nil
+}
+
+func invalidRequest(code, message string) (int, []byte, []*stripe.Event) {
+	body, _ := json.Marshal(map[string]any{"error": &stripe.Error{Type: "invalid_request_error", Code: code, Message: message}})
+	return http.StatusBadRequest, body, nil
+}
+
+func writeError(w http.ResponseWriter, status int, err *stripe.Error) {
+	body, _ := json.Marshal(map[string]any{"error": err})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,121 @@
+package stripe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader is the header carrying the signature of a webhook request.
+const SignatureHeader = "Stripe-Signature"
+
+// DefaultWebhookTolerance is how old a webhook signature may be before the
+// request is rejected as a possible replay.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// Webhook event types handled by billing.
+const (
+	EventInvoiceFinalized           = "invoice.finalized"
+	EventInvoicePaid                = "invoice.paid"
+	EventInvoicePaymentFailed       = "invoice.payment_failed"
+	EventInvoiceVoided              = "invoice.voided"
+	EventInvoiceUncollectible       = "invoice.marked_uncollectible"
+	EventPaymentIntentSucceeded     = "payment_intent.succeeded"
+	EventPaymentIntentPaymentFailed = "payment_intent.payment_failed"
+	EventSubscriptionUpdated        = "customer.subscription.updated"
+	EventSubscriptionDeleted        = "customer.subscription.deleted"
+)
+
+// ErrInvalidSignature is returned for webhook requests that were not signed
+// with the endpoint's secret, or whose signature is too old.
+var ErrInvalidSignature = errors.New("invalid stripe webhook signature")
+
+// Event is a webhook event.
+type Event struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// Subscription is the object of customer.subscription.* events.
+type Subscription struct {
+	ID       string `json:"id"`
+	Customer string `json:"customer"`
+	Status   string `json:"status"`
+}
+
+// ConstructEvent verifies the signature of a webhook payload and decodes it.
+func ConstructEvent(payload []byte, header, secret string, tolerance time.Duration, now time.Time) (*Event, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("%w: no webhook secret configured", ErrInvalidSignature)
+	}
+
+	var (
+		timestamp  int64
+		signatures []string
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			t, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: bad timestamp", ErrInvalidSignature)
+			}
+			timestamp = t
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == 0 || len(signatures) == 0 {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidSignature)
+	}
+
+	expected := computeSignature(payload, secret, timestamp)
+	valid := false
+	for _, sig := range signatures {
+		decoded, err := hex.DecodeString(sig)
+		if err == nil && hmac.Equal(decoded, expected) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("%w: no matching signature", ErrInvalidSignature)
+	}
+	if age := now.Sub(time.Unix(timestamp, 0)); tolerance > 0 && (age > tolerance || age < -tolerance) {
+		return nil, fmt.Errorf("%w: timestamp outside tolerance", ErrInvalidSignature)
+	}
+
+	event := &Event{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("failed to decode stripe event: %w", err)
+	}
+	return event, nil
+}
+
+// SignPayload returns the signature header Stripe would send for payload.
+func SignPayload(payload []byte, secret string, now time.Time) string {
+	t := now.Unix()
+	return fmt.Sprintf("t=%d,v1=%s", t, hex.EncodeToString(computeSignature(payload, secret, t)))
+}
+
+func computeSignature(payload []byte, secret string, timestamp int64) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,42 @@
+package stripe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstructEvent(t *testing.T) {
+	payload := []byte(`{"id":"evt_1","type":"invoice.paid","data":{"object":{"id":"in_1","status":"paid"}}}`)
+	now := time.Unix(1700000000, 0)
+	header := SignPayload(payload, "whsec_test", now)
+
+	event, err := ConstructEvent(payload, header, "whsec_test", DefaultWebhookTolerance, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, "evt_1", event.ID)
+	require.Equal(t, EventInvoicePaid, event.Type)
+	require.JSONEq(t, `{"id":"in_1","status":"paid"}`, string(event.Data.Object))
+
+	// Stripe sends several signatures while a secret is being rolled.
+	_, err = ConstructEvent(payload, header+",v1=deadbeef", "whsec_test", DefaultWebhookTolerance, now)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		payload []byte
+		header  string
+		secret  string
+		now     time.Time
+	}{
+		"wrong secret":     {payload, header, "whsec_other", now},
+		"no secret":        {payload, header, "", now},
+		"tampered payload": {[]byte(`{"id":"evt_2"}`), header, "whsec_test", now},
+		"replayed":         {payload, header, "whsec_test", now.Add(DefaultWebhookTolerance + time.Second)},
+		"malformed header": {payload, "garbage", "whsec_test", now},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConstructEvent(tc.payload, tc.header, tc.secret, DefaultWebhookTolerance, tc.now)
+			require.ErrorIs(t, err, ErrInvalidSignature)
+		})
+	}
+}
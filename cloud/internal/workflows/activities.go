@@ -2,58 +2,379 @@ package workflows
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	nexuspb "go.temporal.io/api/nexus/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	replicationpb "go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/cloud/internal/auditexport"
+	"go.temporal.io/cloud/internal/ca"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/cloud/internal/export"
+	"go.temporal.io/cloud/internal/metering"
 	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/cloud/internal/service"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// certificateFiltersDataKey is the namespace data key holding the
+	// namespace's client certificate filters; see
+	// interceptor.CertificateFiltersNamespaceDataKey.
+	certificateFiltersDataKey = "temporal.io/certificate-filters"
+	// clientCABundleDataKey is the namespace data key holding the PEM bundle
+	// of trusted client CAs; see interceptor.ClientCABundleNamespaceDataKey.
+	clientCABundleDataKey = "temporal.io/client-ca-bundle"
+	// ipAllowlistDataKey is the namespace data key holding the CIDRs the
+	// cluster accepts the namespace's requests from; see
+	// interceptor.IPAllowlistNamespaceDataKey.
+	ipAllowlistDataKey = "temporal.io/ip-allowlist"
+	// nexusEndpointAllowlistDataKeyPrefix prefixes the namespace data keys
+	// holding the caller namespaces allowed to call the endpoints targeting
+	// the namespace; see nexus.EndpointAllowlistNamespaceDataKey.
+	nexusEndpointAllowlistDataKeyPrefix = "temporal.io/nexus-endpoint-allowlist/"
+
+	// maxFailoverReplicationLag is how far the standby cluster may be behind
+	// before a failover fences the active cluster.
+	maxFailoverReplicationLag = 30 * time.Second
+
+	failoverProbePrefix       = "cloud-failover-probe-"
+	failoverProbeWorkflowType = "cloud-failover-probe"
+	failoverProbeIdentity     = "cloud-control-plane"
+	failoverProbeTimeout      = 30 * time.Second
+
+	// xdcRedirectionHeader disables forwarding of a request to the namespace's
+	// active cluster; see interceptor.DCRedirectionContextHeaderName.
+	xdcRedirectionHeader = "xdc-redirection"
+
+	errTypeNoClusterCapacity      = "NoClusterCapacity"
+	errTypeUnknownCluster         = "UnknownCluster"
+	errTypeMissingDNSRecord       = "MissingDNSRecord"
+	errTypeFailoverNotPossible    = "FailoverNotPossible"
+	errTypeInvalidSearchAttribute = "InvalidSearchAttribute"
+	errTypeInvalidInput           = "InvalidInput"
+	errTypeNamespaceNotPlaced     = "NamespaceNotPlaced"
 )
 
 // Activities holds dependencies for workflow activities.
 type Activities struct {
-	repos  *repository.Repositories
-	logger log.Logger
+	repos     *repository.Repositories
+	clusters  *ClusterRegistry
+	authority *ca.Authority
+	dns       DNSProvider
+	billing   *service.BillingService
+	meter     *metering.Meter
+	exporter  *export.Exporter
+	audit     *service.AuditService
+	logger    log.Logger
+
+	// httpClient delivers audit events to webhooks.
+	httpClient *http.Client
+
+	replication  ReplicationStatusSource
+	pollInterval time.Duration
 }
 
 // NewActivities creates a new activities instance.
-func NewActivities(repos *repository.Repositories, logger log.Logger) *Activities {
-	return &Activities{repos: repos, logger: logger}
+func NewActivities(repos *repository.Repositories, clusters *ClusterRegistry, authority *ca.Authority, dns DNSProvider, billing *service.BillingService, audit *service.AuditService, logger log.Logger) *Activities {
+	return &Activities{
+		repos:        repos,
+		clusters:     clusters,
+		authority:    authority,
+		dns:          dns,
+		billing:      billing,
+		meter:        metering.NewMeter(repos, clusters, logger),
+		exporter:     export.NewExporter(clusters, logger),
+		audit:        audit,
+		logger:       logger,
+		httpClient:   auditexport.NewWebhookClient(30 * time.Second),
+		replication:  adminReplicationStatus{clusters: clusters},
+		pollInterval: time.Second,
+	}
 }
 
 // SelectClusterActivity selects a cluster for namespace provisioning.
 func (a *Activities) SelectClusterActivity(ctx context.Context, input SelectClusterInput) (string, error) {
-	// TODO: Implement cluster selection logic
-	// For now, return a mock cluster ID based on region
-	clusterID := fmt.Sprintf("cluster-%s-001", input.Region)
-	return clusterID, nil
+	counts, err := a.repos.Namespaces.CountByCluster(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := a.clusters.Select(input.Region, counts)
+	if err != nil {
+		return "", temporal.NewNonRetryableApplicationError(err.Error(), errTypeNoClusterCapacity, err)
+	}
+	return cluster.ID, nil
 }
 
-// GenerateCertificatesActivity generates mTLS certificates.
+// GenerateCertificatesActivity creates the namespace's client CA if it does not
+// have one yet and publishes the certificate filter matching the client
+// certificates it issues.
 func (a *Activities) GenerateCertificatesActivity(ctx context.Context, input GenerateCertificatesInput) (*GenerateCertificatesOutput, error) {
-	// TODO: Implement certificate generation
+	current, err := a.authority.EnsureCA(ctx, input.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := a.authority.PublishCertificateFilter(ctx, input.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := a.authority.Bundle(ctx, input.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &GenerateCertificatesOutput{
-		CertificatePEM: "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----",
-		Fingerprint:    "sha256:abc123...",
+		CertificatePEM: bundle,
+		Fingerprint:    current.Fingerprint,
+		CertificateFilters: []CertificateFilter{{
+			CommonName:             filter.CommonName.String,
+			Organization:           filter.Organization.String,
+			OrganizationalUnit:     filter.OrganizationalUnit.String,
+			SubjectAlternativeName: filter.SubjectAlternativeName.String,
+		}},
 	}, nil
 }
 
-// RegisterNamespaceActivity registers a namespace in the Temporal cluster.
+// RotateCAActivity rotates the namespace's client CA and returns when the
+// previous CA stops being trusted.
+func (a *Activities) RotateCAActivity(ctx context.Context, namespaceID string) (*RotateCAOutput, error) {
+	rotated, err := a.authority.Rotate(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	return &RotateCAOutput{
+		Fingerprint:     rotated.Fingerprint,
+		PreviousRetires: rotated.CreatedAt.Add(a.authority.RotationOverlap()),
+	}, nil
+}
+
+// PublishCABundleActivity pushes the namespace's currently trusted CA bundle to
+// the cluster hosting it.
+func (a *Activities) PublishCABundleActivity(ctx context.Context, input ClusterNamespaceInput) error {
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return err
+	}
+	bundle, err := a.authority.Bundle(ctx, input.NamespaceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: input.NamespaceID,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{clientCABundleDataKey: bundle},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish CA bundle for namespace %s: %w", input.NamespaceID, err)
+	}
+	return nil
+}
+
+// PruneRetiredCAsActivity deletes CAs whose overlap window has ended.
+func (a *Activities) PruneRetiredCAsActivity(ctx context.Context) error {
+	_, err := a.authority.PruneRetired(ctx)
+	return err
+}
+
+// RegisterNamespaceActivity registers a namespace in the Temporal cluster with
+// its retention, certificate filters and search attributes. It is safe to
+// retry: an already registered namespace is updated in place.
 func (a *Activities) RegisterNamespaceActivity(ctx context.Context, input RegisterNamespaceInput) (*RegisterNamespaceOutput, error) {
-	// TODO: Call Temporal Admin API to register namespace
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	searchAttributes, err := indexedValueTypes(input.SearchAttributes)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), errTypeInvalidSearchAttribute, err)
+	}
+
+	data := make(map[string]string)
+	if input.CABundlePEM != "" {
+		data[clientCABundleDataKey] = input.CABundlePEM
+	}
+	if len(input.CertificateFilters) > 0 {
+		filters, err := json.Marshal(input.CertificateFilters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode certificate filters: %w", err)
+		}
+		data[certificateFiltersDataKey] = string(filters)
+	}
+	retention := durationpb.New(time.Duration(input.RetentionDays) * 24 * time.Hour)
+
+	_, err = c.WorkflowService().RegisterNamespace(ctx, &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        input.NamespaceID,
+		Description:                      input.Name,
+		WorkflowExecutionRetentionPeriod: retention,
+		Data:                             data,
+	})
+	var alreadyExists *serviceerror.NamespaceAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		// A previous attempt got this far; bring the existing namespace up to date.
+		_, err = c.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+			Namespace:  input.NamespaceID,
+			UpdateInfo: &namespacepb.UpdateNamespaceInfo{Description: input.Name, Data: data},
+			Config:     &namespacepb.NamespaceConfig{WorkflowExecutionRetentionTtl: retention},
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to register namespace %s on cluster %s: %w", input.NamespaceID, input.ClusterID, err)
+	}
+
+	if len(searchAttributes) > 0 {
+		_, err = c.OperatorService().AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+			Namespace:        input.NamespaceID,
+			SearchAttributes: searchAttributes,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add search attributes to namespace %s: %w", input.NamespaceID, err)
+		}
+	}
+
+	a.logger.Info("Registered namespace",
+		tag.WorkflowNamespace(input.NamespaceID), tag.ClusterName(input.ClusterID))
 	return &RegisterNamespaceOutput{Success: true}, nil
 }
 
-// CreateDNSRecordActivity creates DNS records for the namespace.
+// UpdateClusterNamespaceActivity sets the retention of a namespace on the
+// cluster hosting it and adds the given search attributes, which are left
+// alone if they were already added.
+func (a *Activities) UpdateClusterNamespaceActivity(ctx context.Context, input UpdateNamespaceInput) error {
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return err
+	}
+	searchAttributes, err := indexedValueTypes(input.SearchAttributes)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), errTypeInvalidSearchAttribute, err)
+	}
+
+	_, err = c.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: input.NamespaceID,
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: durationpb.New(time.Duration(input.RetentionDays) * 24 * time.Hour),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update namespace %s on cluster %s: %w", input.NamespaceID, input.ClusterID, err)
+	}
+
+	if len(searchAttributes) > 0 {
+		_, err = c.OperatorService().AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+			Namespace:        input.NamespaceID,
+			SearchAttributes: searchAttributes,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add search attributes to namespace %s: %w", input.NamespaceID, err)
+		}
+	}
+	return nil
+}
+
+// CreateDNSRecordActivity points the namespace's regional record at the
+// cluster hosting it and its global record at the regional record. The
+// regional record is an A or AAAA record if the cluster's address is an IP
+// address and a CNAME otherwise.
 func (a *Activities) CreateDNSRecordActivity(ctx context.Context, input CreateDNSRecordInput) (*CreateDNSRecordOutput, error) {
-	// TODO: Create Route53 DNS records
+	cluster, ok := a.clusters.Cluster(input.ClusterID)
+	if !ok {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("unknown cluster %s", input.ClusterID), errTypeUnknownCluster, nil)
+	}
+	host, port, err := net.SplitHostPort(cluster.HostPort)
+	if err != nil || host == "" {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("invalid host:port for cluster %s", input.ClusterID), errTypeUnknownCluster, err)
+	}
+
+	zone := a.dns.Zone()
+	regional := regionalRecordName(zone, input.NamespaceID, input.Region)
+	if err := a.upsertHostRecord(ctx, hostRecord(regional, host)); err != nil {
+		return nil, err
+	}
+	global := namespaceRecordName(zone, input.NamespaceID)
+	if err := a.upsertCNAME(ctx, global, regional); err != nil {
+		return nil, err
+	}
+
 	return &CreateDNSRecordOutput{
-		GRPCEndpoint:    fmt.Sprintf("%s.%s.tmprl.cloud:7233", input.NamespaceID, input.Region),
-		WebEndpoint:     fmt.Sprintf("https://%s.%s.tmprl.cloud", input.NamespaceID, input.Region),
-		MetricsEndpoint: fmt.Sprintf("https://metrics.%s.tmprl.cloud/prometheus", input.Region),
+		GRPCEndpoint:    net.JoinHostPort(global, port),
+		WebEndpoint:     "https://" + global,
+		MetricsEndpoint: fmt.Sprintf("https://metrics.%s.%s/prometheus", input.Region, zone),
 	}, nil
 }
 
-// UpdateNamespaceStateActivity updates the namespace state in the database.
+// upsertHostRecord writes a record pointing at a host, first deleting the
+// name's records of the other host record types: a CNAME cannot coexist with
+// other records, and the cluster's address may have changed kind.
+func (a *Activities) upsertHostRecord(ctx context.Context, record DNSRecord) error {
+	for _, recordType := range hostRecordTypes {
+		if recordType == record.Type {
+			continue
+		}
+		if err := a.dns.DeleteRecord(ctx, record.Name, recordType); err != nil {
+			return err
+		}
+	}
+	return a.dns.UpsertRecord(ctx, record)
+}
+
+// getHostRecord returns the record pointing name at a host, or nil if there
+// is none.
+func (a *Activities) getHostRecord(ctx context.Context, name string) (*DNSRecord, error) {
+	for _, recordType := range hostRecordTypes {
+		record, err := a.dns.GetRecord(ctx, name, recordType)
+		if err != nil || record != nil {
+			return record, err
+		}
+	}
+	return nil, nil
+}
+
+func (a *Activities) upsertCNAME(ctx context.Context, name, target string) error {
+	return a.dns.UpsertRecord(ctx, DNSRecord{
+		Name:   name,
+		Type:   "CNAME",
+		TTL:    dnsRecordTTL,
+		Values: []string{target},
+	})
+}
+
+// UpdateNamespaceStateActivity updates the namespace state in the database,
+// along with its placement when a cluster is given.
 func (a *Activities) UpdateNamespaceStateActivity(ctx context.Context, input UpdateNamespaceStateInput) error {
+	if input.ClusterID != "" {
+		err := a.repos.Namespaces.UpdatePlacement(ctx, input.NamespaceID,
+			input.ClusterID, input.GRPCEndpoint, input.WebEndpoint, input.MetricsEndpoint)
+		if err != nil {
+			return err
+		}
+	}
 	return a.repos.Namespaces.UpdateState(ctx, input.NamespaceID, input.State)
 }
 
@@ -63,15 +384,68 @@ func (a *Activities) SetupStandbyRegionActivity(ctx context.Context, input Setup
 	return nil
 }
 
-// DeprecateNamespaceActivity deprecates a namespace in the Temporal cluster.
-func (a *Activities) DeprecateNamespaceActivity(ctx context.Context, namespaceID string) error {
-	// TODO: Call Temporal Admin API to deprecate namespace
+// DeprecateNamespaceActivity deprecates a namespace in the Temporal cluster so
+// that no new workflows can be started in it. A namespace that does not exist
+// on the cluster is treated as already deprecated.
+func (a *Activities) DeprecateNamespaceActivity(ctx context.Context, input ClusterNamespaceInput) error {
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace:  input.NamespaceID,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{State: enumspb.NAMESPACE_STATE_DEPRECATED},
+	})
+	if err != nil && !isNamespaceNotFound(err) {
+		return fmt.Errorf("failed to deprecate namespace %s on cluster %s: %w", input.NamespaceID, input.ClusterID, err)
+	}
 	return nil
 }
 
-// RemoveDNSRecordActivity removes DNS records for a namespace.
+// DeleteClusterNamespaceActivity deletes a namespace and its data from the
+// Temporal cluster. A namespace that does not exist is treated as deleted.
+func (a *Activities) DeleteClusterNamespaceActivity(ctx context.Context, input ClusterNamespaceInput) error {
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	// DeleteNamespace reports a missing namespace as an invalid argument, so
+	// check for it up front to keep retries idempotent.
+	_, err = c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: input.NamespaceID,
+	})
+	if isNamespaceNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to describe namespace %s on cluster %s: %w", input.NamespaceID, input.ClusterID, err)
+	}
+
+	_, err = c.OperatorService().DeleteNamespace(ctx, &operatorservice.DeleteNamespaceRequest{
+		Namespace: input.NamespaceID,
+	})
+	if err != nil && !isNamespaceNotFound(err) {
+		return fmt.Errorf("failed to delete namespace %s on cluster %s: %w", input.NamespaceID, input.ClusterID, err)
+	}
+	return nil
+}
+
+// RemoveDNSRecordActivity removes the namespace's global record and its
+// record in every region.
 func (a *Activities) RemoveDNSRecordActivity(ctx context.Context, namespaceID string) error {
-	// TODO: Remove Route53 DNS records
+	zone := a.dns.Zone()
+	if err := a.dns.DeleteRecord(ctx, namespaceRecordName(zone, namespaceID), "CNAME"); err != nil {
+		return err
+	}
+	for _, region := range a.clusters.Regions() {
+		for _, recordType := range hostRecordTypes {
+			if err := a.dns.DeleteRecord(ctx, regionalRecordName(zone, namespaceID, region), recordType); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -81,33 +455,211 @@ func (a *Activities) ArchiveNamespaceActivity(ctx context.Context, namespaceID s
 	return nil
 }
 
-// VerifyStandbyReadyActivity verifies the standby is ready for failover.
-func (a *Activities) VerifyStandbyReadyActivity(ctx context.Context, input FailoverNamespaceInput) error {
-	// TODO: Check replication lag, standby health
-	return nil
+// PlanFailoverActivity resolves the cluster the namespace is active on and the
+// cluster in the target region it fails over to.
+func (a *Activities) PlanFailoverActivity(ctx context.Context, input FailoverNamespaceInput) (*FailoverPlan, error) {
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: input.NamespaceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe namespace %s: %w", input.NamespaceID, err)
+	}
+	if !desc.GetIsGlobalNamespace() {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("namespace %s is not replicated", input.NamespaceID), errTypeFailoverNotPossible, nil)
+	}
+
+	activeName := desc.GetReplicationConfig().GetActiveClusterName()
+	source, ok := a.clusters.ClusterByName(activeName)
+	if !ok {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("unknown cluster %s", activeName), errTypeUnknownCluster, nil)
+	}
+	if source.Region == input.TargetRegion {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("namespace %s is already active in region %s", input.NamespaceID, input.TargetRegion),
+			errTypeFailoverNotPossible, nil)
+	}
+	for _, replica := range desc.GetReplicationConfig().GetClusters() {
+		target, ok := a.clusters.ClusterByName(replica.GetClusterName())
+		if !ok || target.Region != input.TargetRegion {
+			continue
+		}
+		return &FailoverPlan{
+			NamespaceID:       input.NamespaceID,
+			SourceClusterID:   source.ID,
+			SourceClusterName: source.Name,
+			SourceRegion:      source.Region,
+			TargetClusterID:   target.ID,
+			TargetClusterName: target.Name,
+			TargetRegion:      target.Region,
+		}, nil
+	}
+	return nil, temporal.NewNonRetryableApplicationError(
+		fmt.Sprintf("namespace %s is not replicated to region %s", input.NamespaceID, input.TargetRegion),
+		errTypeFailoverNotPossible, nil)
 }
 
-// FencePrimaryActivity fences the primary to stop accepting writes.
-func (a *Activities) FencePrimaryActivity(ctx context.Context, namespaceID string) error {
-	// TODO: Implement primary fencing
-	return nil
+// VerifyStandbyReadyActivity waits until the target cluster's replication lag
+// is within maxFailoverReplicationLag on every shard.
+func (a *Activities) VerifyStandbyReadyActivity(ctx context.Context, plan FailoverPlan) error {
+	source, err := a.clusterConfig(plan.SourceClusterID)
+	if err != nil {
+		return err
+	}
+	return a.pollReplication(ctx, func() (bool, error) {
+		status, err := a.replication.GetReplicationStatus(ctx, source, []string{plan.TargetClusterName})
+		if err != nil {
+			return false, err
+		}
+		lag, err := replicationLag(status, plan.TargetClusterName)
+		if err != nil {
+			return false, err
+		}
+		a.logger.Info("Waiting for standby to catch up",
+			tag.WorkflowNamespace(plan.NamespaceID), tag.TargetCluster(plan.TargetClusterName), tag.NewDurationTag("lag", lag))
+		return lag <= maxFailoverReplicationLag, nil
+	})
+}
+
+// FencePrimaryActivity puts the namespace into handover on the active cluster,
+// which stops it accepting writes, and waits until every replication task
+// generated before the handover has reached the target cluster.
+func (a *Activities) FencePrimaryActivity(ctx context.Context, plan FailoverPlan) error {
+	source, err := a.clusterConfig(plan.SourceClusterID)
+	if err != nil {
+		return err
+	}
+	if err := a.setReplicationState(ctx, plan.SourceClusterID, plan.NamespaceID, enumspb.REPLICATION_STATE_HANDOVER); err != nil {
+		return err
+	}
+	return a.pollReplication(ctx, func() (bool, error) {
+		status, err := a.replication.GetReplicationStatus(ctx, source, []string{plan.TargetClusterName})
+		if err != nil {
+			return false, err
+		}
+		return handoverComplete(status, plan.NamespaceID, plan.TargetClusterName), nil
+	})
+}
+
+// UnfencePrimaryActivity takes the namespace out of handover on the cluster it
+// was active on.
+func (a *Activities) UnfencePrimaryActivity(ctx context.Context, plan FailoverPlan) error {
+	return a.setReplicationState(ctx, plan.SourceClusterID, plan.NamespaceID, enumspb.REPLICATION_STATE_NORMAL)
 }
 
-// PromoteStandbyActivity promotes the standby to primary.
-func (a *Activities) PromoteStandbyActivity(ctx context.Context, input FailoverNamespaceInput) error {
-	// TODO: Promote standby
+// PromoteStandbyActivity makes the target cluster the namespace's active
+// cluster.
+func (a *Activities) PromoteStandbyActivity(ctx context.Context, plan FailoverPlan) error {
+	c, err := a.clusterClient(plan.TargetClusterID)
+	if err != nil {
+		return err
+	}
+	desc, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: plan.NamespaceID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe namespace %s: %w", plan.NamespaceID, err)
+	}
+	if desc.GetReplicationConfig().GetActiveClusterName() == plan.TargetClusterName {
+		return nil
+	}
+
+	_, err = c.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: plan.NamespaceID,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: plan.TargetClusterName,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to make cluster %s active for namespace %s: %w", plan.TargetClusterName, plan.NamespaceID, err)
+	}
+	a.logger.Info("Promoted standby cluster",
+		tag.WorkflowNamespace(plan.NamespaceID), tag.SourceCluster(plan.SourceClusterName), tag.TargetCluster(plan.TargetClusterName))
 	return nil
 }
 
-// UpdateDNSForFailoverActivity updates DNS for failover.
+// UpdateDNSForFailoverActivity points the namespace's global record at its
+// record in the target region, which must already exist.
 func (a *Activities) UpdateDNSForFailoverActivity(ctx context.Context, input FailoverNamespaceInput) error {
-	// TODO: Update DNS records
-	return nil
+	zone := a.dns.Zone()
+	regional := regionalRecordName(zone, input.NamespaceID, input.TargetRegion)
+	record, err := a.getHostRecord(ctx, regional)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("namespace %s has no DNS record in region %s", input.NamespaceID, input.TargetRegion),
+			errTypeMissingDNSRecord, nil)
+	}
+	return a.upsertCNAME(ctx, namespaceRecordName(zone, input.NamespaceID), regional)
 }
 
-// VerifyTrafficSwitchedActivity verifies traffic has switched to new primary.
-func (a *Activities) VerifyTrafficSwitchedActivity(ctx context.Context, input FailoverNamespaceInput) error {
-	// TODO: Verify traffic
+// VerifyTrafficSwitchedActivity checks that the promoted cluster considers
+// itself active for the namespace and that a new workflow task is dispatched
+// by it. A probe workflow is started and its first workflow task polled
+// directly from the promoted cluster, with cross-cluster forwarding disabled.
+func (a *Activities) VerifyTrafficSwitchedActivity(ctx context.Context, plan FailoverPlan) error {
+	c, err := a.clusterClient(plan.TargetClusterID)
+	if err != nil {
+		return err
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, xdcRedirectionHeader, "false")
+
+	desc, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: plan.NamespaceID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe namespace %s: %w", plan.NamespaceID, err)
+	}
+	if active := desc.GetReplicationConfig().GetActiveClusterName(); active != plan.TargetClusterName {
+		return fmt.Errorf("namespace %s is active on cluster %s, expected %s", plan.NamespaceID, active, plan.TargetClusterName)
+	}
+
+	probeID := failoverProbePrefix + uuid.NewString()
+	taskQueue := &taskqueuepb.TaskQueue{Name: probeID, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	started, err := c.WorkflowService().StartWorkflowExecution(ctx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                plan.NamespaceID,
+		WorkflowId:               probeID,
+		WorkflowType:             &commonpb.WorkflowType{Name: failoverProbeWorkflowType},
+		TaskQueue:                taskQueue,
+		WorkflowExecutionTimeout: durationpb.New(time.Minute),
+		RequestId:                probeID,
+		Identity:                 failoverProbeIdentity,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start probe workflow on cluster %s: %w", plan.TargetClusterName, err)
+	}
+	defer func() {
+		_, err := c.WorkflowService().TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace:         plan.NamespaceID,
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: probeID, RunId: started.GetRunId()},
+			Reason:            "failover probe",
+			Identity:          failoverProbeIdentity,
+		})
+		if err != nil {
+			a.logger.Warn("Failed to terminate failover probe workflow", tag.WorkflowID(probeID), tag.Error(err))
+		}
+	}()
+
+	pollCtx, cancel := context.WithTimeout(ctx, failoverProbeTimeout)
+	defer cancel()
+	task, err := c.WorkflowService().PollWorkflowTaskQueue(pollCtx, &workflowservice.PollWorkflowTaskQueueRequest{
+		Namespace: plan.NamespaceID,
+		TaskQueue: taskQueue,
+		Identity:  failoverProbeIdentity,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to poll probe workflow task on cluster %s: %w", plan.TargetClusterName, err)
+	}
+	if task.GetWorkflowExecution().GetRunId() != started.GetRunId() {
+		return fmt.Errorf("probe workflow task was not dispatched by cluster %s", plan.TargetClusterName)
+	}
 	return nil
 }
 
@@ -115,26 +667,303 @@ func (a *Activities) VerifyTrafficSwitchedActivity(ctx context.Context, input Fa
 
 // AggregateUsageActivity aggregates usage for a billing period.
 func (a *Activities) AggregateUsageActivity(ctx context.Context, input AggregateUsageInput) (*UsageSummaryOutput, error) {
-	// TODO: Implement usage aggregation
+	orgID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	summary, err := a.repos.Usage.GetSummaryByOrganization(ctx, orgID, input.PeriodStart, input.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
 	return &UsageSummaryOutput{
-		TotalActions:       0,
-		ActiveStorageGBH:   0,
-		RetainedStorageGBH: 0,
+		TotalActions:       summary.ActionCount,
+		ActiveStorageGBH:   summary.ActiveStorageGBH.InexactFloat64(),
+		RetainedStorageGBH: summary.RetainedStorageGBH.InexactFloat64(),
 	}, nil
 }
 
+// ListPlacedNamespacesActivity lists the namespaces placed on a cluster.
+func (a *Activities) ListPlacedNamespacesActivity(ctx context.Context) ([]string, error) {
+	return a.repos.Namespaces.ListPlacedIDs(ctx)
+}
+
+// MeterNamespaceUsageActivity records a namespace's usage for an hour.
+func (a *Activities) MeterNamespaceUsageActivity(ctx context.Context, input MeterNamespaceUsageInput) error {
+	ns, err := a.repos.Namespaces.GetByID(ctx, input.NamespaceID)
+	if err != nil {
+		return err
+	}
+	if ns == nil || !ns.ClusterID.Valid {
+		// The namespace was deleted or unplaced since it was listed.
+		return nil
+	}
+	_, err = a.meter.RecordHour(ctx, ns, input.Hour)
+	return err
+}
+
 // GenerateInvoiceActivity generates an invoice.
 func (a *Activities) GenerateInvoiceActivity(ctx context.Context, input GenerateInvoiceInput) (string, error) {
-	// TODO: Generate invoice
-	return "inv-123", nil
+	orgID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return "", temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	inv, err := a.billing.GenerateInvoice(ctx, orgID, input.PeriodStart, input.PeriodEnd)
+	if err != nil {
+		return "", billingError(err)
+	}
+	return inv.ID.String(), nil
 }
 
-// ReportStripeUsageActivity reports usage to Stripe.
+// ReportStripeUsageActivity reports the invoiced usage to Stripe, which then
+// collects payment for it.
 func (a *Activities) ReportStripeUsageActivity(ctx context.Context, input ReportStripeUsageInput) error {
-	// TODO: Report to Stripe
+	invoiceID, err := uuid.Parse(input.InvoiceID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid invoice ID", errTypeInvalidInput, err)
+	}
+	_, err = a.billing.SubmitInvoice(ctx, invoiceID)
+	return billingError(err)
+}
+
+// ExpireCreditsActivity writes off credits that expired at or before the given
+// time and returns the number of organizations affected.
+func (a *Activities) ExpireCreditsActivity(ctx context.Context, at time.Time) (int, error) {
+	return a.billing.ExpireCredits(ctx, at)
+}
+
+// GetIPAllowlistActivity computes the IP allowlist of a namespace from the
+// connectivity rules bound to it. It returns nil if the namespace was deleted
+// or unplaced since it was listed.
+func (a *Activities) GetIPAllowlistActivity(ctx context.Context, namespaceID string) (*PublishIPAllowlistInput, error) {
+	ns, err := a.repos.Namespaces.GetByID(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if ns == nil || !ns.ClusterID.Valid {
+		return nil, nil
+	}
+	rules, err := a.repos.Connectivity.ListNamespaceRules(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	cidrs, err := service.IPAllowlist(rules)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), errTypeInvalidInput, err)
+	}
+	return &PublishIPAllowlistInput{ClusterID: ns.ClusterID.String, NamespaceID: namespaceID, CIDRs: cidrs}, nil
+}
+
+// PublishIPAllowlistActivity stores a namespace's IP allowlist on the cluster
+// hosting it, whose frontends then reject requests to the namespace from
+// other addresses. An empty allowlist lifts the restriction.
+func (a *Activities) PublishIPAllowlistActivity(ctx context.Context, input PublishIPAllowlistInput) error {
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return err
+	}
+	changed, err := setNamespaceData(ctx, c, input.NamespaceID, ipAllowlistDataKey, strings.Join(input.CIDRs, ","))
+	if err != nil {
+		return fmt.Errorf("failed to publish IP allowlist for namespace %s: %w", input.NamespaceID, err)
+	}
+	if changed {
+		a.logger.Info("Published namespace IP allowlist",
+			tag.WorkflowNamespace(input.NamespaceID), tag.ClusterName(input.ClusterID), tag.Counter(len(input.CIDRs)))
+	}
 	return nil
 }
 
+// ListExportSinksActivity lists the enabled export sinks.
+func (a *Activities) ListExportSinksActivity(ctx context.Context) ([]string, error) {
+	sinks, err := a.repos.Exports.ListEnabledSinks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(sinks))
+	for i, sink := range sinks {
+		ids[i] = sink.ID.String()
+	}
+	return ids, nil
+}
+
+// CreateExportJobActivity creates an export job covering the period from the
+// sink's last export to input.End. It reports whether there is anything to
+// export: the sink may have been disabled or deleted since it was listed.
+func (a *Activities) CreateExportJobActivity(ctx context.Context, input CreateExportJobInput) (bool, error) {
+	jobID, err := uuid.Parse(input.JobID)
+	if err != nil {
+		return false, temporal.NewNonRetryableApplicationError("invalid export job ID", errTypeInvalidInput, err)
+	}
+	sinkID, err := uuid.Parse(input.SinkID)
+	if err != nil {
+		return false, temporal.NewNonRetryableApplicationError("invalid export sink ID", errTypeInvalidInput, err)
+	}
+
+	// The job may have been created by a previous attempt.
+	job, err := a.repos.Exports.GetJob(ctx, jobID)
+	if err != nil || job != nil {
+		return job != nil, err
+	}
+	sink, err := a.repos.Exports.GetSinkByID(ctx, sinkID)
+	if err != nil {
+		return false, err
+	}
+	if sink == nil || !sink.Enabled {
+		return false, nil
+	}
+	start := sink.CreatedAt
+	if sink.LastExportAt.Valid {
+		start = sink.LastExportAt.Time
+	}
+	if !start.Before(input.End) {
+		return false, nil
+	}
+
+	err = a.repos.Exports.CreateJob(ctx, &repository.ExportJob{
+		ID:          jobID,
+		SinkID:      sinkID,
+		WindowStart: start,
+		WindowEnd:   input.End,
+	})
+	return err == nil, err
+}
+
+// ExportJobActivity runs an export job. It heartbeats its progress and, when
+// retried, resumes from the last page of executions it completed.
+func (a *Activities) ExportJobActivity(ctx context.Context, jobID string) (ExportJobResult, error) {
+	id, err := uuid.Parse(jobID)
+	if err != nil {
+		return ExportJobResult{}, temporal.NewNonRetryableApplicationError("invalid export job ID", errTypeInvalidInput, err)
+	}
+	job, err := a.repos.Exports.GetJob(ctx, id)
+	if err != nil {
+		return ExportJobResult{}, err
+	}
+	if job == nil {
+		return ExportJobResult{}, temporal.NewNonRetryableApplicationError("export job not found", errTypeInvalidInput, nil)
+	}
+	sink, err := a.repos.Exports.GetSinkByID(ctx, job.SinkID)
+	if err != nil {
+		return ExportJobResult{}, err
+	}
+	if sink == nil {
+		return ExportJobResult{}, temporal.NewNonRetryableApplicationError("export sink not found", errTypeInvalidInput, nil)
+	}
+	ns, err := a.repos.Namespaces.GetByID(ctx, sink.NamespaceID)
+	if err != nil {
+		return ExportJobResult{}, err
+	}
+	if ns == nil {
+		return ExportJobResult{}, temporal.NewNonRetryableApplicationError("namespace not found", errTypeInvalidInput, nil)
+	}
+	if err := a.repos.Exports.StartJob(ctx, id); err != nil {
+		return ExportJobResult{}, err
+	}
+
+	var progress export.Progress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			a.logger.Warn("Failed to decode export progress, starting over", tag.Error(err))
+			progress = export.Progress{}
+		}
+	}
+	report := func(ctx context.Context, progress export.Progress) error {
+		activity.RecordHeartbeat(ctx, progress)
+		return a.repos.Exports.UpdateProgress(ctx, id, progress.Workflows, progress.Bytes)
+	}
+	progress, err = a.exporter.Export(ctx, ns, sink, job.WindowStart, job.WindowEnd, progress, report)
+	if err != nil {
+		return ExportJobResult{}, err
+	}
+	return ExportJobResult{Workflows: progress.Workflows, Bytes: progress.Bytes}, nil
+}
+
+// CompleteExportJobActivity records the result of an export job and advances
+// its sink's last export time.
+func (a *Activities) CompleteExportJobActivity(ctx context.Context, input CompleteExportJobInput) error {
+	id, err := uuid.Parse(input.JobID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid export job ID", errTypeInvalidInput, err)
+	}
+	return a.repos.Exports.CompleteJob(ctx, id, input.Result.Workflows, input.Result.Bytes)
+}
+
+// FailExportJobActivity records the failure of an export job.
+func (a *Activities) FailExportJobActivity(ctx context.Context, input FailExportJobInput) error {
+	id, err := uuid.Parse(input.JobID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid export job ID", errTypeInvalidInput, err)
+	}
+	return a.repos.Exports.FailJob(ctx, id, input.Message)
+}
+
+// ListAuditStreamsActivity lists the enabled audit streams.
+func (a *Activities) ListAuditStreamsActivity(ctx context.Context) ([]string, error) {
+	streams, err := a.repos.AuditStreams.ListEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(streams))
+	for i, stream := range streams {
+		ids[i] = stream.ID.String()
+	}
+	return ids, nil
+}
+
+// DeliverAuditBatchActivity delivers the next batch of the stream's events to
+// its sink and advances the stream past it. A retry after the batch was
+// written but before the stream advanced writes the same batch again.
+func (a *Activities) DeliverAuditBatchActivity(ctx context.Context, streamID string) (DeliverAuditBatchResult, error) {
+	id, err := uuid.Parse(streamID)
+	if err != nil {
+		return DeliverAuditBatchResult{}, temporal.NewNonRetryableApplicationError("invalid audit stream ID", errTypeInvalidInput, err)
+	}
+	stream, err := a.repos.AuditStreams.GetByID(ctx, id)
+	if err != nil {
+		return DeliverAuditBatchResult{}, err
+	}
+	if stream == nil || !stream.Enabled {
+		// The stream was disabled or deleted since it was listed.
+		return DeliverAuditBatchResult{}, nil
+	}
+	sink, err := auditexport.NewSink(stream.SinkType, stream.Config, a.httpClient)
+	if err != nil {
+		return DeliverAuditBatchResult{}, temporal.NewNonRetryableApplicationError("invalid audit stream sink", errTypeInvalidInput, err)
+	}
+
+	out, err := a.audit.ExportEvents(ctx, &service.ExportEventsInput{
+		OrganizationID: stream.OrganizationID,
+		Format:         stream.Format,
+		Limit:          auditStreamBatchSize,
+		After: &service.AuditExportPosition{
+			Cursor:   stream.Cursor,
+			Sequence: stream.Sequence,
+			Hash:     stream.LastHash,
+		},
+	})
+	if err != nil {
+		return DeliverAuditBatchResult{}, err
+	}
+	if out.Batch == nil {
+		return DeliverAuditBatchResult{}, nil
+	}
+
+	if err := sink.Write(ctx, out.Batch); err != nil {
+		if err := a.repos.AuditStreams.SetError(ctx, id, err.Error()); err != nil {
+			a.logger.Warn("Failed to record audit stream error", tag.Error(err))
+		}
+		return DeliverAuditBatchResult{}, err
+	}
+	advanced, err := a.repos.AuditStreams.Advance(ctx, id, out.Next.Cursor, out.Next.Sequence, out.Next.Hash)
+	if err != nil {
+		return DeliverAuditBatchResult{}, err
+	}
+	if !advanced {
+		// Another delivery recorded this batch first.
+		return DeliverAuditBatchResult{}, nil
+	}
+	return DeliverAuditBatchResult{Batches: 1, Events: out.Batch.Events, More: out.More}, nil
+}
+
 // SendInvoiceEmailActivity sends an invoice email.
 func (a *Activities) SendInvoiceEmailActivity(ctx context.Context, input SendInvoiceEmailInput) error {
 	// TODO: Send email via SendGrid
@@ -149,24 +978,581 @@ func (a *Activities) SendPaymentReminderActivity(ctx context.Context, input Send
 
 // CheckInvoicePaidActivity checks if an invoice is paid.
 func (a *Activities) CheckInvoicePaidActivity(ctx context.Context, invoiceID string) (bool, error) {
-	// TODO: Check Stripe invoice status
-	return false, nil
+	id, err := uuid.Parse(invoiceID)
+	if err != nil {
+		return false, temporal.NewNonRetryableApplicationError("invalid invoice ID", errTypeInvalidInput, err)
+	}
+	paid, err := a.billing.CheckInvoicePaid(ctx, id)
+	return paid, billingError(err)
 }
 
 // SuspendAccountActivity suspends an account for non-payment.
 func (a *Activities) SuspendAccountActivity(ctx context.Context, orgID string) error {
-	// TODO: Suspend account
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	sub, err := a.repos.Subscriptions.GetByOrganizationID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sub == nil || sub.Status == "suspended" {
+		return nil
+	}
+	if err := a.repos.Subscriptions.UpdateStatus(ctx, sub.ID, "suspended"); err != nil {
+		return err
+	}
+	a.logger.Warn("Suspended organization for non-payment", tag.NewStringTag("organization_id", orgID))
 	return nil
 }
 
 // ListActiveOrganizationsActivity lists all active organizations.
 func (a *Activities) ListActiveOrganizationsActivity(ctx context.Context) ([]string, error) {
-	// TODO: List organizations
-	return []string{}, nil
+	const pageSize = 500
+	var ids []string
+	for offset := 0; ; offset += pageSize {
+		orgs, err := a.repos.Organizations.List(ctx, pageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, org := range orgs {
+			ids = append(ids, org.ID.String())
+		}
+		if len(orgs) < pageSize {
+			return ids, nil
+		}
+	}
 }
 
 // AggregateOrgUsageActivity aggregates usage for a single organization.
 func (a *Activities) AggregateOrgUsageActivity(ctx context.Context, input AggregateOrgUsageInput) error {
-	// TODO: Aggregate usage
+	orgID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	if _, _, err := metering.PeriodBounds(input.PeriodType, input.PeriodDate); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), errTypeInvalidInput, err)
+	}
+	return a.meter.Aggregate(ctx, orgID, input.PeriodType, input.PeriodDate)
+}
+
+// BeginOrganizationDeletionActivity makes an organization's scheduled
+// deletion final. It returns false if the deletion was cancelled.
+func (a *Activities) BeginOrganizationDeletionActivity(ctx context.Context, orgID string) (bool, error) {
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return false, temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	return a.repos.Organizations.BeginDeletion(ctx, id, time.Now())
+}
+
+// RevokeOrganizationAPIKeysActivity disables the API keys of an
+// organization's service accounts and returns how many were disabled.
+func (a *Activities) RevokeOrganizationAPIKeysActivity(ctx context.Context, orgID string) (int64, error) {
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return 0, temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	return a.repos.APIKeys.DisableByOrganization(ctx, id)
+}
+
+// ListNamespacesToDeleteActivity lists an organization's namespaces that are
+// not yet deleted and marks them deleting.
+func (a *Activities) ListNamespacesToDeleteActivity(ctx context.Context, orgID string) ([]DeleteNamespaceInput, error) {
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	const pageSize = 500
+	var inputs []DeleteNamespaceInput
+	for offset := 0; ; offset += pageSize {
+		namespaces, err := a.repos.Namespaces.ListByOrganization(ctx, id, pageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range namespaces {
+			if ns.State == "deleted" {
+				continue
+			}
+			if ns.State != "deleting" {
+				if err := a.repos.Namespaces.UpdateState(ctx, ns.ID, "deleting"); err != nil {
+					return nil, err
+				}
+			}
+			inputs = append(inputs, DeleteNamespaceInput{
+				NamespaceID:    ns.ID,
+				OrganizationID: orgID,
+				ClusterID:      ns.ClusterID.String,
+			})
+		}
+		if len(namespaces) < pageSize {
+			return inputs, nil
+		}
+	}
+}
+
+// GenerateFinalInvoiceActivity invoices an organization's usage this month and
+// cancels its subscription. It returns the invoice's ID, or "" if the
+// organization has no subscription.
+func (a *Activities) GenerateFinalInvoiceActivity(ctx context.Context, orgID string) (string, error) {
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return "", temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	sub, err := a.repos.Subscriptions.GetByOrganizationID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if sub == nil {
+		return "", nil
+	}
+	// Invoices are generated once per period start, so a retry gets the same
+	// invoice.
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	inv, err := a.billing.GenerateInvoice(ctx, id, start, now)
+	if err != nil {
+		return "", billingError(err)
+	}
+	if sub.Status != "canceled" {
+		if err := a.repos.Subscriptions.UpdateStatus(ctx, sub.ID, "canceled"); err != nil {
+			return "", err
+		}
+	}
+	return inv.ID.String(), nil
+}
+
+// ArchiveAuditLogActivity exports an organization's audit events up to
+// input.End to the archive sink and returns how many it exported. It
+// heartbeats its position after each batch and, when retried, resumes from
+// it.
+func (a *Activities) ArchiveAuditLogActivity(ctx context.Context, input ArchiveAuditLogInput) (int, error) {
+	id, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return 0, temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	sink, err := auditexport.NewSink(input.Archive.SinkType, input.Archive.Config, a.httpClient)
+	if err != nil {
+		return 0, temporal.NewNonRetryableApplicationError("invalid audit archive sink", errTypeInvalidInput, err)
+	}
+	if err := auditexport.ValidateFormat(input.Archive.Format); err != nil {
+		return 0, temporal.NewNonRetryableApplicationError("invalid audit archive format", errTypeInvalidInput, err)
+	}
+
+	var progress archiveAuditLogProgress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			a.logger.Warn("Failed to decode audit archive progress, starting over", tag.Error(err))
+			progress = archiveAuditLogProgress{}
+		}
+	}
+	for {
+		out, err := a.audit.ExportEvents(ctx, &service.ExportEventsInput{
+			OrganizationID: id,
+			Format:         input.Archive.Format,
+			EndTime:        input.End,
+			Limit:          auditStreamBatchSize,
+			After:          &progress.Position,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if out.Batch == nil {
+			return progress.Events, nil
+		}
+		if err := sink.Write(ctx, out.Batch); err != nil {
+			return 0, err
+		}
+		progress.Position = out.Next
+		progress.Events += out.Batch.Events
+		activity.RecordHeartbeat(ctx, progress)
+		if !out.More {
+			return progress.Events, nil
+		}
+	}
+}
+
+// archiveAuditLogProgress is how far ArchiveAuditLogActivity got.
+type archiveAuditLogProgress struct {
+	Position service.AuditExportPosition
+	Events   int
+}
+
+// CompleteOrganizationDeletionActivity marks an organization deleted.
+func (a *Activities) CompleteOrganizationDeletionActivity(ctx context.Context, orgID string) error {
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid organization ID", errTypeInvalidInput, err)
+	}
+	if err := a.repos.Organizations.MarkDeleted(ctx, id, time.Now()); err != nil {
+		return err
+	}
+	a.logger.Info("Deleted organization", tag.NewStringTag("organization_id", orgID))
+	return nil
+}
+
+// billingError makes billing errors that retrying cannot fix non-retryable.
+func billingError(err error) error {
+	var svcErr serviceerror.ServiceError
+	if !errors.As(err, &svcErr) {
+		return err
+	}
+	switch svcErr.Status().Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound:
+		return temporal.NewNonRetryableApplicationError(err.Error(), errTypeInvalidInput, err)
+	}
+	return err
+}
+
+func (a *Activities) clusterClient(clusterID string) (client.Client, error) {
+	c, err := a.clusters.Client(clusterID)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), errTypeUnknownCluster, err)
+	}
+	return c, nil
+}
+
+func (a *Activities) clusterConfig(clusterID string) (config.ClusterConfig, error) {
+	cluster, ok := a.clusters.Cluster(clusterID)
+	if !ok {
+		return config.ClusterConfig{}, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("unknown cluster %s", clusterID), errTypeUnknownCluster, nil)
+	}
+	return cluster, nil
+}
+
+// setReplicationState sets the namespace's replication state on a cluster
+// unless it is already in that state.
+func (a *Activities) setReplicationState(ctx context.Context, clusterID, namespaceID string, state enumspb.ReplicationState) error {
+	c, err := a.clusterClient(clusterID)
+	if err != nil {
+		return err
+	}
+	desc, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespaceID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe namespace %s: %w", namespaceID, err)
+	}
+	if desc.GetReplicationConfig().GetState() == state {
+		return nil
+	}
+
+	_, err = c.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace:         namespaceID,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{State: state},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set replication state of namespace %s to %s: %w", namespaceID, state, err)
+	}
 	return nil
 }
+
+// pollReplication calls check until it reports done, heartbeating between
+// attempts. It gives up when the activity times out.
+func (a *Activities) pollReplication(ctx context.Context, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		activity.RecordHeartbeat(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(a.pollInterval):
+		}
+	}
+}
+
+// isNamespaceNotFound reports whether err means the namespace does not exist.
+// Depending on the API, the server returns either NamespaceNotFound or a plain
+// NotFound error.
+func isNamespaceNotFound(err error) bool {
+	var nsNotFound *serviceerror.NamespaceNotFound
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &nsNotFound) || errors.As(err, &notFound)
+}
+
+// indexedValueTypes converts search attribute types as stored by the cloud API
+// ("keyword", "keyword_list", ...) to Temporal indexed value types.
+func indexedValueTypes(attrs map[string]string) (map[string]enumspb.IndexedValueType, error) {
+	out := make(map[string]enumspb.IndexedValueType, len(attrs))
+	for name, typ := range attrs {
+		var valueType enumspb.IndexedValueType
+		switch typ {
+		case "text":
+			valueType = enumspb.INDEXED_VALUE_TYPE_TEXT
+		case "keyword":
+			valueType = enumspb.INDEXED_VALUE_TYPE_KEYWORD
+		case "int":
+			valueType = enumspb.INDEXED_VALUE_TYPE_INT
+		case "double":
+			valueType = enumspb.INDEXED_VALUE_TYPE_DOUBLE
+		case "bool":
+			valueType = enumspb.INDEXED_VALUE_TYPE_BOOL
+		case "datetime":
+			valueType = enumspb.INDEXED_VALUE_TYPE_DATETIME
+		case "keyword_list":
+			valueType = enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST
+		default:
+			return nil, fmt.Errorf("search attribute %s has unsupported type %q", name, typ)
+		}
+		out[name] = valueType
+	}
+	return out, nil
+}
+
+// DeleteExpiredInvitationsActivity deletes the invitations that expired before
+// the given time without being accepted.
+func (a *Activities) DeleteExpiredInvitationsActivity(ctx context.Context, before time.Time) (int64, error) {
+	return a.repos.Users.DeleteExpiredInvitations(ctx, before)
+}
+
+// ListUnsyncedNexusEndpointsActivity lists the Nexus endpoints changed or
+// deleted since they were last provisioned.
+func (a *Activities) ListUnsyncedNexusEndpointsActivity(ctx context.Context) ([]string, error) {
+	ids, err := a.repos.Nexus.ListUnsyncedEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out, nil
+}
+
+// GetNexusEndpointSyncActivity returns the current version of a Nexus endpoint
+// and where to provision it. It returns nil if the endpoint was removed or
+// synced since it was listed.
+func (a *Activities) GetNexusEndpointSyncActivity(ctx context.Context, endpointID string) (*NexusEndpointSyncInput, error) {
+	id, err := uuid.Parse(endpointID)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError("invalid Nexus endpoint ID", errTypeInvalidInput, err)
+	}
+	endpoint, err := a.repos.Nexus.GetEndpointByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == nil || (!endpoint.DeletedAt.Valid && endpoint.SyncedVersion >= endpoint.SpecVersion) {
+		return nil, nil
+	}
+
+	input := &NexusEndpointSyncInput{
+		EndpointID:                endpointID,
+		Version:                   endpoint.SpecVersion,
+		Deleted:                   endpoint.DeletedAt.Valid,
+		Name:                      endpoint.Name,
+		Description:               endpoint.Description.String,
+		TargetNamespaceID:         endpoint.TargetNamespaceID,
+		TargetTaskQueue:           endpoint.TargetTaskQueue,
+		AllowedCallerNamespaceIDs: endpoint.AllowedCallerNamespaceIDs,
+		SyncedClusterID:           endpoint.ClusterID.String,
+		SyncedTargetNamespaceID:   endpoint.SyncedTargetNamespaceID.String,
+	}
+	if !input.Deleted {
+		ns, err := a.repos.Namespaces.GetByID(ctx, endpoint.TargetNamespaceID)
+		if err != nil {
+			return nil, err
+		}
+		if ns != nil {
+			input.ClusterID = ns.ClusterID.String
+		}
+	}
+	return input, nil
+}
+
+// ProvisionNexusEndpointActivity creates or updates a Nexus endpoint on the
+// cluster hosting its target namespace and returns its ID there. The
+// endpoint's allowlist is published to the target namespace first, so the
+// endpoint never accepts requests from namespaces that are not allowed. An
+// endpoint that moved to another cluster or namespace is removed from where
+// it was.
+func (a *Activities) ProvisionNexusEndpointActivity(ctx context.Context, input NexusEndpointSyncInput) (string, error) {
+	if input.ClusterID == "" {
+		return "", temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("target namespace %s is not placed on a cluster", input.TargetNamespaceID), errTypeNamespaceNotPlaced, nil)
+	}
+	c, err := a.clusterClient(input.ClusterID)
+	if err != nil {
+		return "", err
+	}
+
+	allowlistKey := nexusEndpointAllowlistDataKeyPrefix + input.Name
+	_, err = setNamespaceData(ctx, c, input.TargetNamespaceID, allowlistKey, strings.Join(input.AllowedCallerNamespaceIDs, ","))
+	if err != nil {
+		return "", fmt.Errorf("failed to publish allowlist of Nexus endpoint %s: %w", input.Name, err)
+	}
+	switch {
+	case input.SyncedClusterID != "" && input.SyncedClusterID != input.ClusterID:
+		if err := a.removeNexusEndpoint(ctx, input.SyncedClusterID, input.SyncedTargetNamespaceID, input.Name); err != nil {
+			return "", err
+		}
+	case input.SyncedTargetNamespaceID != "" && input.SyncedTargetNamespaceID != input.TargetNamespaceID:
+		if err := clearNamespaceData(ctx, c, input.SyncedTargetNamespaceID, allowlistKey); err != nil {
+			return "", fmt.Errorf("failed to clear allowlist of Nexus endpoint %s: %w", input.Name, err)
+		}
+	}
+
+	spec := &nexuspb.EndpointSpec{
+		Name: input.Name,
+		Target: &nexuspb.EndpointTarget{
+			Variant: &nexuspb.EndpointTarget_Worker_{
+				Worker: &nexuspb.EndpointTarget_Worker{
+					Namespace: input.TargetNamespaceID,
+					TaskQueue: input.TargetTaskQueue,
+				},
+			},
+		},
+	}
+	if input.Description != "" {
+		spec.Description, err = converter.GetDefaultDataConverter().ToPayload(input.Description)
+		if err != nil {
+			return "", temporal.NewNonRetryableApplicationError("invalid Nexus endpoint description", errTypeInvalidInput, err)
+		}
+	}
+
+	existing, err := getNexusEndpointByName(ctx, c, input.Name)
+	if err != nil {
+		return "", err
+	}
+	if existing == nil {
+		resp, err := c.OperatorService().CreateNexusEndpoint(ctx, &operatorservice.CreateNexusEndpointRequest{Spec: spec})
+		if err != nil {
+			return "", fmt.Errorf("failed to create Nexus endpoint %s: %w", input.Name, err)
+		}
+		a.logger.Info("Created Nexus endpoint",
+			tag.NewStringTag("endpoint", input.Name), tag.ClusterName(input.ClusterID))
+		return resp.GetEndpoint().GetId(), nil
+	}
+	if proto.Equal(existing.GetSpec(), spec) {
+		return existing.GetId(), nil
+	}
+	_, err = c.OperatorService().UpdateNexusEndpoint(ctx, &operatorservice.UpdateNexusEndpointRequest{
+		Id:      existing.GetId(),
+		Version: existing.GetVersion(),
+		Spec:    spec,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to update Nexus endpoint %s: %w", input.Name, err)
+	}
+	a.logger.Info("Updated Nexus endpoint",
+		tag.NewStringTag("endpoint", input.Name), tag.ClusterName(input.ClusterID))
+	return existing.GetId(), nil
+}
+
+// RemoveNexusEndpointActivity removes a deleted Nexus endpoint and its
+// allowlist from the cluster it was provisioned on.
+func (a *Activities) RemoveNexusEndpointActivity(ctx context.Context, input NexusEndpointSyncInput) error {
+	if input.SyncedClusterID == "" {
+		// The endpoint was never provisioned.
+		return nil
+	}
+	return a.removeNexusEndpoint(ctx, input.SyncedClusterID, input.SyncedTargetNamespaceID, input.Name)
+}
+
+// CompleteNexusEndpointSyncActivity records the outcome of syncing a Nexus
+// endpoint, forgetting deleted endpoints once they were removed from their
+// cluster.
+func (a *Activities) CompleteNexusEndpointSyncActivity(ctx context.Context, result NexusEndpointSyncResult) error {
+	id, err := uuid.Parse(result.EndpointID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid Nexus endpoint ID", errTypeInvalidInput, err)
+	}
+	switch {
+	case result.Error != "":
+		return a.repos.Nexus.SetEndpointSyncError(ctx, id, result.Error)
+	case result.Deleted:
+		return a.repos.Nexus.DeleteEndpoint(ctx, id)
+	default:
+		return a.repos.Nexus.MarkEndpointSynced(ctx, id, repository.NexusEndpointSync{
+			Version:           result.Version,
+			ClusterID:         result.ClusterID,
+			ClusterEndpointID: result.ClusterEndpointID,
+			TargetNamespaceID: result.TargetNamespaceID,
+		})
+	}
+}
+
+// removeNexusEndpoint deletes a Nexus endpoint from a cluster and clears its
+// allowlist from its target namespace there, tolerating either being gone.
+func (a *Activities) removeNexusEndpoint(ctx context.Context, clusterID, targetNamespaceID, name string) error {
+	c, err := a.clusterClient(clusterID)
+	if err != nil {
+		return err
+	}
+	endpoint, err := getNexusEndpointByName(ctx, c, name)
+	if err != nil {
+		return err
+	}
+	if endpoint != nil {
+		_, err := c.OperatorService().DeleteNexusEndpoint(ctx, &operatorservice.DeleteNexusEndpointRequest{
+			Id:      endpoint.GetId(),
+			Version: endpoint.GetVersion(),
+		})
+		var notFound *serviceerror.NotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return fmt.Errorf("failed to delete Nexus endpoint %s: %w", name, err)
+		}
+		a.logger.Info("Deleted Nexus endpoint", tag.NewStringTag("endpoint", name), tag.ClusterName(clusterID))
+	}
+	if targetNamespaceID == "" {
+		return nil
+	}
+	err = clearNamespaceData(ctx, c, targetNamespaceID, nexusEndpointAllowlistDataKeyPrefix+name)
+	if err != nil && !isNamespaceNotFound(err) {
+		return fmt.Errorf("failed to clear allowlist of Nexus endpoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// getNexusEndpointByName returns the Nexus endpoint with the given name on a
+// cluster, or nil if there is none. Endpoint names are unique per cluster.
+func getNexusEndpointByName(ctx context.Context, c client.Client, name string) (*nexuspb.Endpoint, error) {
+	resp, err := c.OperatorService().ListNexusEndpoints(ctx, &operatorservice.ListNexusEndpointsRequest{
+		PageSize: 1,
+		Name:     name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up Nexus endpoint %s: %w", name, err)
+	}
+	for _, endpoint := range resp.GetEndpoints() {
+		if endpoint.GetSpec().GetName() == name {
+			return endpoint, nil
+		}
+	}
+	return nil, nil
+}
+
+// setNamespaceData sets a namespace data key on a cluster unless it already
+// has the value, and reports whether it changed.
+func setNamespaceData(ctx context.Context, c client.Client, namespaceID, key, value string) (bool, error) {
+	desc, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespaceID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to describe namespace %s: %w", namespaceID, err)
+	}
+	if desc.GetNamespaceInfo().GetData()[key] == value {
+		return false, nil
+	}
+
+	_, err = c.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: namespaceID,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{key: value},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// clearNamespaceData clears a namespace data key on a cluster. The server
+// keeps data keys once set, so the key is left empty.
+func clearNamespaceData(ctx context.Context, c client.Client, namespaceID, key string) error {
+	_, err := setNamespaceData(ctx, c, namespaceID, key, "")
+	return err
+}
@@ -0,0 +1,301 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/temporaltest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestActivities(t *testing.T) (*Activities, *temporaltest.TestServer) {
+	t.Helper()
+	ts := temporaltest.NewServer(temporaltest.WithT(t))
+
+	registry, err := NewClusterRegistry(nil)
+	require.NoError(t, err)
+	require.NoError(t, registry.Add(config.ClusterConfig{
+		ID:       "test-cluster",
+		Name:     "active",
+		Region:   "us-east-1",
+		HostPort: ts.GetFrontendHostPort(),
+		Capacity: 10,
+	}, ts.GetDefaultClient()))
+
+	return NewActivities(nil, registry, nil, NewMemoryDNSProvider("tmprl.cloud"), nil, nil, log.NewNoopLogger()), ts
+}
+
+func TestRegisterNamespaceActivity(t *testing.T) {
+	a, ts := newTestActivities(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	input := RegisterNamespaceInput{
+		ClusterID:     "test-cluster",
+		NamespaceID:   "orders.abcd1234",
+		Name:          "orders",
+		RetentionDays: 3,
+		SearchAttributes: map[string]string{
+			"CustomerId": "keyword",
+			"Amount":     "double",
+		},
+		CertificateFilters: []CertificateFilter{{CommonName: "worker.example.com"}},
+	}
+	out, err := a.RegisterNamespaceActivity(ctx, input)
+	require.NoError(t, err)
+	require.True(t, out.Success)
+
+	c := ts.GetDefaultClient()
+	desc, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: input.NamespaceID})
+	require.NoError(t, err)
+	require.Equal(t, 3*24*time.Hour, desc.GetConfig().GetWorkflowExecutionRetentionTtl().AsDuration())
+	require.JSONEq(t, `[{"common_name":"worker.example.com"}]`, desc.GetNamespaceInfo().GetData()[certificateFiltersDataKey])
+
+	attrs, err := c.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{Namespace: input.NamespaceID})
+	require.NoError(t, err)
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_KEYWORD, attrs.GetCustomAttributes()["CustomerId"])
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_DOUBLE, attrs.GetCustomAttributes()["Amount"])
+
+	// Retrying after a partial success updates the namespace in place.
+	input.RetentionDays = 5
+	_, err = a.RegisterNamespaceActivity(ctx, input)
+	require.NoError(t, err)
+	desc, err = c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: input.NamespaceID})
+	require.NoError(t, err)
+	require.Equal(t, 5*24*time.Hour, desc.GetConfig().GetWorkflowExecutionRetentionTtl().AsDuration())
+
+	// Updates set the retention and add search attributes, keeping those
+	// already added.
+	update := UpdateNamespaceInput{
+		ClusterID:        "test-cluster",
+		NamespaceID:      input.NamespaceID,
+		RetentionDays:    30,
+		SearchAttributes: map[string]string{"CustomerId": "keyword", "Region": "keyword"},
+	}
+	require.NoError(t, a.UpdateClusterNamespaceActivity(ctx, update))
+	desc, err = c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: input.NamespaceID})
+	require.NoError(t, err)
+	require.Equal(t, 30*24*time.Hour, desc.GetConfig().GetWorkflowExecutionRetentionTtl().AsDuration())
+	attrs, err = c.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{Namespace: input.NamespaceID})
+	require.NoError(t, err)
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_KEYWORD, attrs.GetCustomAttributes()["Region"])
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_DOUBLE, attrs.GetCustomAttributes()["Amount"])
+
+	// Deprecation stops the namespace accepting new work and is idempotent.
+	clusterNamespace := ClusterNamespaceInput{ClusterID: "test-cluster", NamespaceID: input.NamespaceID}
+	require.NoError(t, a.DeprecateNamespaceActivity(ctx, clusterNamespace))
+	require.NoError(t, a.DeprecateNamespaceActivity(ctx, clusterNamespace))
+	desc, err = c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: input.NamespaceID})
+	require.NoError(t, err)
+	require.Equal(t, enumspb.NAMESPACE_STATE_DEPRECATED, desc.GetNamespaceInfo().GetState())
+
+	require.NoError(t, a.DeprecateNamespaceActivity(ctx, ClusterNamespaceInput{ClusterID: "test-cluster", NamespaceID: "missing.00000000"}))
+
+	require.NoError(t, a.DeleteClusterNamespaceActivity(ctx, clusterNamespace))
+	require.Eventually(t, func() bool {
+		_, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: input.NamespaceID})
+		var notFound *serviceerror.NamespaceNotFound
+		return errors.As(err, &notFound)
+	}, 20*time.Second, 100*time.Millisecond)
+	require.NoError(t, a.DeleteClusterNamespaceActivity(ctx, clusterNamespace))
+}
+
+func TestRegisterNamespaceActivityErrors(t *testing.T) {
+	a, _ := newTestActivities(t)
+	ctx := context.Background()
+
+	_, err := a.RegisterNamespaceActivity(ctx, RegisterNamespaceInput{ClusterID: "unknown", NamespaceID: "ns.1"})
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, errTypeUnknownCluster, appErr.Type())
+	require.True(t, appErr.NonRetryable())
+
+	_, err = a.RegisterNamespaceActivity(ctx, RegisterNamespaceInput{
+		ClusterID:        "test-cluster",
+		NamespaceID:      "ns.1",
+		RetentionDays:    1,
+		SearchAttributes: map[string]string{"Bad": "blob"},
+	})
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, errTypeInvalidSearchAttribute, appErr.Type())
+}
+
+func TestDNSRecordActivities(t *testing.T) {
+	ctx := context.Background()
+	registry, err := NewClusterRegistry([]config.ClusterConfig{
+		{ID: "use1", Region: "us-east-1", HostPort: "use1.clusters.internal:7233", Capacity: 10},
+		{ID: "usw2", Region: "us-west-2", HostPort: "usw2.clusters.internal:7233", Capacity: 10},
+	})
+	require.NoError(t, err)
+	dns := NewMemoryDNSProvider("tmprl.cloud")
+	a := NewActivities(nil, registry, nil, dns, nil, nil, log.NewNoopLogger())
+
+	out, err := a.CreateDNSRecordActivity(ctx, CreateDNSRecordInput{NamespaceID: "orders.abcd1234", Region: "us-east-1", ClusterID: "use1"})
+	require.NoError(t, err)
+	require.Equal(t, &CreateDNSRecordOutput{
+		GRPCEndpoint:    "orders.abcd1234.tmprl.cloud:7233",
+		WebEndpoint:     "https://orders.abcd1234.tmprl.cloud",
+		MetricsEndpoint: "https://metrics.us-east-1.tmprl.cloud/prometheus",
+	}, out)
+	requireCNAME(t, dns, "orders.abcd1234.us-east-1.tmprl.cloud", "use1.clusters.internal")
+	requireCNAME(t, dns, "orders.abcd1234.tmprl.cloud", "orders.abcd1234.us-east-1.tmprl.cloud")
+
+	// Failover requires the namespace to have a record in the target region.
+	failover := FailoverNamespaceInput{NamespaceID: "orders.abcd1234", TargetRegion: "us-west-2"}
+	err = a.UpdateDNSForFailoverActivity(ctx, failover)
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, errTypeMissingDNSRecord, appErr.Type())
+
+	require.NoError(t, a.upsertHostRecord(ctx, hostRecord("orders.abcd1234.us-west-2.tmprl.cloud", "10.0.2.1")))
+	require.NoError(t, a.UpdateDNSForFailoverActivity(ctx, failover))
+	requireCNAME(t, dns, "orders.abcd1234.tmprl.cloud", "orders.abcd1234.us-west-2.tmprl.cloud")
+
+	require.NoError(t, a.RemoveDNSRecordActivity(ctx, "orders.abcd1234"))
+	require.Empty(t, dns.Records())
+
+	_, err = a.CreateDNSRecordActivity(ctx, CreateDNSRecordInput{NamespaceID: "orders.abcd1234", Region: "us-east-1", ClusterID: "unknown"})
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, errTypeUnknownCluster, appErr.Type())
+}
+
+func TestCreateDNSRecordActivityForIPAddresses(t *testing.T) {
+	ctx := context.Background()
+	registry, err := NewClusterRegistry([]config.ClusterConfig{
+		{ID: "use1-v4", Region: "us-east-1", HostPort: "10.0.1.1:7233", Capacity: 10},
+		{ID: "use1-v6", Region: "us-east-1", HostPort: "[2001:db8::1]:7233", Capacity: 10},
+		{ID: "use1-name", Region: "us-east-1", HostPort: "use1.clusters.internal:7233", Capacity: 10},
+	})
+	require.NoError(t, err)
+	dns := NewMemoryDNSProvider("tmprl.cloud")
+	a := NewActivities(nil, registry, nil, dns, nil, nil, log.NewNoopLogger())
+	regional := "orders.abcd1234.us-east-1.tmprl.cloud"
+
+	for _, tc := range []struct {
+		clusterID  string
+		recordType string
+		value      string
+	}{
+		{clusterID: "use1-v4", recordType: "A", value: "10.0.1.1"},
+		{clusterID: "use1-v6", recordType: "AAAA", value: "2001:db8::1"},
+		// Moving to a cluster addressed by name replaces the address record.
+		{clusterID: "use1-name", recordType: "CNAME", value: "use1.clusters.internal"},
+	} {
+		out, err := a.CreateDNSRecordActivity(ctx, CreateDNSRecordInput{NamespaceID: "orders.abcd1234", Region: "us-east-1", ClusterID: tc.clusterID})
+		require.NoError(t, err)
+		require.Equal(t, "orders.abcd1234.tmprl.cloud:7233", out.GRPCEndpoint)
+		record, err := a.getHostRecord(ctx, regional)
+		require.NoError(t, err)
+		require.Equal(t, tc.recordType, record.Type)
+		require.Equal(t, []string{tc.value}, record.Values)
+		requireCNAME(t, dns, "orders.abcd1234.tmprl.cloud", regional)
+		require.Len(t, dns.Records(), 2)
+	}
+}
+
+func requireCNAME(t *testing.T, dns DNSProvider, name, target string) {
+	t.Helper()
+	record, err := dns.GetRecord(context.Background(), name, "CNAME")
+	require.NoError(t, err)
+	require.NotNil(t, record, "missing CNAME %s", name)
+	require.Equal(t, []string{target}, record.Values)
+}
+
+// fakeReplicationStatus returns the queued responses in order, repeating the
+// last one.
+type fakeReplicationStatus struct {
+	responses []*adminservice.GetReplicationStatusResponse
+	calls     int
+}
+
+func (f *fakeReplicationStatus) GetReplicationStatus(context.Context, config.ClusterConfig, []string) (*adminservice.GetReplicationStatusResponse, error) {
+	resp := f.responses[min(f.calls, len(f.responses)-1)]
+	f.calls++
+	return resp, nil
+}
+
+func TestFailoverActivities(t *testing.T) {
+	a, _ := newTestActivities(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := a.RegisterNamespaceActivity(ctx, RegisterNamespaceInput{
+		ClusterID:     "test-cluster",
+		NamespaceID:   "orders.abcd1234",
+		RetentionDays: 1,
+	})
+	require.NoError(t, err)
+
+	// The test server has no replication, so its namespaces cannot fail over.
+	_, err = a.PlanFailoverActivity(ctx, FailoverNamespaceInput{
+		NamespaceID:  "orders.abcd1234",
+		TargetRegion: "us-west-2",
+		ClusterID:    "test-cluster",
+	})
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, errTypeFailoverNotPossible, appErr.Type())
+
+	// The namespace is active on the test cluster, so a probe workflow task is
+	// dispatched by it.
+	plan := FailoverPlan{
+		NamespaceID:       "orders.abcd1234",
+		TargetClusterID:   "test-cluster",
+		TargetClusterName: "active",
+	}
+	require.NoError(t, a.VerifyTrafficSwitchedActivity(ctx, plan))
+	plan.TargetClusterName = "standby"
+	require.ErrorContains(t, a.VerifyTrafficSwitchedActivity(ctx, plan), "is active on cluster active")
+}
+
+func TestVerifyStandbyReadyActivity(t *testing.T) {
+	registry, err := NewClusterRegistry([]config.ClusterConfig{
+		{ID: "use1", Region: "us-east-1", HostPort: "use1.clusters.internal:7233", Capacity: 10},
+	})
+	require.NoError(t, err)
+	a := NewActivities(nil, registry, nil, nil, nil, nil, log.NewNoopLogger())
+	a.pollInterval = time.Millisecond
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+
+	now := time.Now()
+	status := func(ackedAgo time.Duration) *adminservice.GetReplicationStatusResponse {
+		return &adminservice.GetReplicationStatusResponse{
+			Shards: []*adminservice.GetReplicationStatusResponse_ShardReplicationStatus{{
+				ShardId:                          1,
+				MaxReplicationTaskId:             10,
+				MaxReplicationTaskVisibilityTime: timestamppb.New(now),
+				RemoteClusters: map[string]*adminservice.GetReplicationStatusResponse_ShardReplicationStatusPerCluster{
+					"standby": {AckedTaskId: 5, AckedTaskVisibilityTime: timestamppb.New(now.Add(-ackedAgo))},
+				},
+			}},
+		}
+	}
+	replication := &fakeReplicationStatus{responses: []*adminservice.GetReplicationStatusResponse{
+		status(time.Hour), status(time.Minute), status(time.Second),
+	}}
+	a.replication = replication
+
+	plan := FailoverPlan{NamespaceID: "ns", SourceClusterID: "use1", TargetClusterName: "standby"}
+	_, err = env.ExecuteActivity(a.VerifyStandbyReadyActivity, plan)
+	require.NoError(t, err)
+	require.Equal(t, 3, replication.calls)
+
+	plan.TargetClusterName = "other"
+	_, err = env.ExecuteActivity(a.VerifyStandbyReadyActivity, plan)
+	require.ErrorContains(t, err, "no replication status for cluster other")
+}
@@ -0,0 +1,110 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// auditStreamBatchSize is the most events delivered in one batch.
+	auditStreamBatchSize = 500
+	// auditStreamMaxBatches is the most batches an AuditStreamWorkflow
+	// delivers; a stream further behind catches up over the next runs.
+	auditStreamMaxBatches = 100
+)
+
+// StreamAuditEventsWorkflow delivers the audit events recorded since each
+// enabled audit stream's last delivery, one AuditStreamWorkflow per stream.
+// It is meant to run on a short schedule. A stream whose previous delivery is
+// still running is skipped.
+func StreamAuditEventsWorkflow(ctx workflow.Context) error {
+	logger := workflow.GetLogger(ctx)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var streamIDs []string
+	var a *Activities
+	if err := workflow.ExecuteActivity(ctx, a.ListAuditStreamsActivity).Get(ctx, &streamIDs); err != nil {
+		return err
+	}
+
+	futures := make([]workflow.ChildWorkflowFuture, len(streamIDs))
+	for i, streamID := range streamIDs {
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			// One delivery per stream at a time keeps its batches in order.
+			WorkflowID: "audit-stream-" + streamID,
+		})
+		futures[i] = workflow.ExecuteChildWorkflow(childCtx, AuditStreamWorkflow, AuditStreamInput{StreamID: streamID})
+	}
+	var failed int
+	for i, future := range futures {
+		err := future.Get(ctx, nil)
+		if temporal.IsWorkflowExecutionAlreadyStartedError(err) {
+			continue
+		}
+		if err != nil {
+			logger.Warn("Failed to deliver audit events", "stream_id", streamIDs[i], "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to deliver to %d of %d audit streams", failed, len(streamIDs))
+	}
+	return nil
+}
+
+// AuditStreamInput is the input for the audit stream workflow.
+type AuditStreamInput struct {
+	StreamID string
+}
+
+// AuditStreamWorkflow delivers the events recorded since the stream's last
+// delivery, batch by batch, until it has caught up. A failed delivery is
+// recorded on the stream and retried by the next run from the same batch.
+func AuditStreamWorkflow(ctx workflow.Context, input AuditStreamInput) (DeliverAuditBatchResult, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+
+	var a *Activities
+	var total DeliverAuditBatchResult
+	for i := 0; i < auditStreamMaxBatches; i++ {
+		var result DeliverAuditBatchResult
+		if err := workflow.ExecuteActivity(ctx, a.DeliverAuditBatchActivity, input.StreamID).Get(ctx, &result); err != nil {
+			return total, err
+		}
+		total.Batches += result.Batches
+		total.Events += result.Events
+		if !result.More {
+			return total, nil
+		}
+	}
+	total.More = true
+	return total, nil
+}
+
+// Activity input/output types for audit streams
+
+type DeliverAuditBatchResult struct {
+	Batches int
+	Events  int
+	// More is whether more events can be delivered right away.
+	More bool
+}
@@ -0,0 +1,70 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestStreamAuditEventsWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	env.RegisterWorkflow(AuditStreamWorkflow)
+
+	var a *Activities
+	env.OnActivity(a.ListAuditStreamsActivity, mock.Anything).Return([]string{"stream-a", "stream-b"}, nil)
+	// Stream a is two batches behind.
+	env.OnActivity(a.DeliverAuditBatchActivity, mock.Anything, "stream-a").
+		Return(DeliverAuditBatchResult{Batches: 1, Events: 500, More: true}, nil).Once()
+	env.OnActivity(a.DeliverAuditBatchActivity, mock.Anything, "stream-a").
+		Return(DeliverAuditBatchResult{Batches: 1, Events: 20}, nil).Once()
+	// Stream b is up to date.
+	env.OnActivity(a.DeliverAuditBatchActivity, mock.Anything, "stream-b").
+		Return(DeliverAuditBatchResult{}, nil).Once()
+
+	env.ExecuteWorkflow(StreamAuditEventsWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestAuditStreamWorkflowStopsAfterMaxBatches(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.DeliverAuditBatchActivity, mock.Anything, "stream-a").
+		Return(DeliverAuditBatchResult{Batches: 1, Events: auditStreamBatchSize, More: true}, nil).
+		Times(auditStreamMaxBatches)
+
+	env.ExecuteWorkflow(AuditStreamWorkflow, AuditStreamInput{StreamID: "stream-a"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result DeliverAuditBatchResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, DeliverAuditBatchResult{
+		Batches: auditStreamMaxBatches,
+		Events:  auditStreamMaxBatches * auditStreamBatchSize,
+		More:    true,
+	}, result)
+	env.AssertExpectations(t)
+}
+
+func TestAuditStreamWorkflowFailure(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.DeliverAuditBatchActivity, mock.Anything, "stream-a").
+		Return(DeliverAuditBatchResult{}, errors.New("webhook returned status 503"))
+
+	env.ExecuteWorkflow(AuditStreamWorkflow, AuditStreamInput{StreamID: "stream-a"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "status 503")
+}
@@ -1,6 +1,7 @@
 package workflows
 
 import (
+	"fmt"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
@@ -86,7 +87,9 @@ type DunningInput struct {
 	InvoiceID      string
 }
 
-// DunningWorkflow handles failed payment retry.
+// DunningWorkflow handles failed payment retry. It is started by the first
+// failed payment of an invoice and receives later payment events on
+// PaymentEventSignal.
 func DunningWorkflow(ctx workflow.Context, input DunningInput) error {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting dunning workflow", "invoice_id", input.InvoiceID)
@@ -109,8 +112,37 @@ func DunningWorkflow(ctx workflow.Context, input DunningInput) error {
 		14 * 24 * time.Hour, // Day 14
 	}
 
+	// Payment events from Stripe end dunning as soon as the invoice is paid.
+	paid := false
+	payments := workflow.GetSignalChannel(ctx, PaymentEventSignal)
+	waitForPayment := func(delay time.Duration) {
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		defer cancelTimer()
+		timer := workflow.NewTimer(timerCtx, delay)
+
+		timerFired := false
+		for !timerFired && !paid {
+			selector := workflow.NewSelector(ctx)
+			selector.AddFuture(timer, func(workflow.Future) { timerFired = true })
+			selector.AddReceive(payments, func(c workflow.ReceiveChannel, _ bool) {
+				var event PaymentEvent
+				c.Receive(ctx, &event)
+				if event.Paid {
+					paid = true
+				} else {
+					logger.Info("Payment attempt failed", "invoice_id", input.InvoiceID)
+				}
+			})
+			selector.Select(ctx)
+		}
+	}
+
 	for attempt, delay := range retrySchedule {
-		_ = workflow.Sleep(ctx, delay)
+		waitForPayment(delay)
+		if paid {
+			logger.Info("Invoice paid", "invoice_id", input.InvoiceID)
+			return nil
+		}
 
 		// Send reminder email
 		_ = workflow.ExecuteActivity(ctx, a.SendPaymentReminderActivity, SendPaymentReminderInput{
@@ -119,8 +151,7 @@ func DunningWorkflow(ctx workflow.Context, input DunningInput) error {
 			Attempt:        attempt + 1,
 		}).Get(ctx, nil)
 
-		// Check if paid
-		var paid bool
+		// Check if paid, in case a payment event was missed
 		err := workflow.ExecuteActivity(ctx, a.CheckInvoicePaidActivity, input.InvoiceID).Get(ctx, &paid)
 		if err != nil {
 			logger.Warn("Failed to check invoice status", "error", err)
@@ -189,6 +220,90 @@ func UsageAggregationWorkflow(ctx workflow.Context, input UsageAggregationInput)
 	return nil
 }
 
+// MeterUsageInput is the input for the usage metering workflow.
+type MeterUsageInput struct {
+	// Hour is any time within the hour to meter. It defaults to the previous
+	// hour.
+	Hour time.Time
+}
+
+// MeterUsageWorkflow records the usage of every placed namespace for an hour.
+// Metering an hour again replaces its usage records, so the workflow can be
+// re-run for an hour whose metering failed.
+func MeterUsageWorkflow(ctx workflow.Context, input MeterUsageInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	hour := input.Hour
+	if hour.IsZero() {
+		hour = workflow.Now(ctx).Add(-time.Hour)
+	}
+	hour = hour.UTC().Truncate(time.Hour)
+	logger.Info("Starting usage metering", "hour", hour)
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var namespaceIDs []string
+	var a *Activities
+	err := workflow.ExecuteActivity(ctx, a.ListPlacedNamespacesActivity).Get(ctx, &namespaceIDs)
+	if err != nil {
+		return err
+	}
+
+	futures := make([]workflow.Future, len(namespaceIDs))
+	for i, namespaceID := range namespaceIDs {
+		futures[i] = workflow.ExecuteActivity(ctx, a.MeterNamespaceUsageActivity, MeterNamespaceUsageInput{
+			NamespaceID: namespaceID,
+			Hour:        hour,
+		})
+	}
+	var failed int
+	for i, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			logger.Warn("Failed to meter namespace usage", "namespace_id", namespaceIDs[i], "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to meter usage of %d of %d namespaces", failed, len(namespaceIDs))
+	}
+
+	logger.Info("Usage metering completed", "hour", hour, "namespaces", len(namespaceIDs))
+	return nil
+}
+
+// ExpireCreditsWorkflow writes off prepaid credits that have passed their
+// expiry date. Credits are also expired whenever an invoice is generated;
+// running this on a schedule keeps balances accurate between invoices.
+func ExpireCreditsWorkflow(ctx workflow.Context) error {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var a *Activities
+	var orgs int
+	if err := workflow.ExecuteActivity(ctx, a.ExpireCreditsActivity, workflow.Now(ctx)).Get(ctx, &orgs); err != nil {
+		return err
+	}
+	workflow.GetLogger(ctx).Info("Credit expiry completed", "organizations", orgs)
+	return nil
+}
+
 // Activity input/output types for billing
 
 type AggregateUsageInput struct {
@@ -232,3 +347,8 @@ type AggregateOrgUsageInput struct {
 	PeriodType     string
 	PeriodDate     time.Time
 }
+
+type MeterNamespaceUsageInput struct {
+	NamespaceID string
+	Hour        time.Time
+}
@@ -0,0 +1,107 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestMeterUsageWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	env.SetStartTime(time.Date(2024, time.May, 1, 10, 17, 0, 0, time.UTC))
+
+	// The previous hour is metered by default.
+	hour := time.Date(2024, time.May, 1, 9, 0, 0, 0, time.UTC)
+	var a *Activities
+	env.OnActivity(a.ListPlacedNamespacesActivity, mock.Anything).Return([]string{"a.acct", "b.acct"}, nil)
+	env.OnActivity(a.MeterNamespaceUsageActivity, mock.Anything,
+		MeterNamespaceUsageInput{NamespaceID: "a.acct", Hour: hour}).Return(nil).Once()
+	env.OnActivity(a.MeterNamespaceUsageActivity, mock.Anything,
+		MeterNamespaceUsageInput{NamespaceID: "b.acct", Hour: hour}).Return(nil).Once()
+
+	env.ExecuteWorkflow(MeterUsageWorkflow, MeterUsageInput{})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestMeterUsageWorkflowReportsFailures(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	hour := time.Date(2024, time.May, 1, 9, 0, 0, 0, time.UTC)
+	var a *Activities
+	env.OnActivity(a.ListPlacedNamespacesActivity, mock.Anything).Return([]string{"a.acct", "b.acct"}, nil)
+	env.OnActivity(a.MeterNamespaceUsageActivity, mock.Anything,
+		MeterNamespaceUsageInput{NamespaceID: "a.acct", Hour: hour}).Return(errors.New("cluster unavailable"))
+	// One namespace failing does not stop the others from being metered.
+	env.OnActivity(a.MeterNamespaceUsageActivity, mock.Anything,
+		MeterNamespaceUsageInput{NamespaceID: "b.acct", Hour: hour}).Return(nil).Once()
+
+	env.ExecuteWorkflow(MeterUsageWorkflow, MeterUsageInput{Hour: hour.Add(25 * time.Minute)})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "1 of 2 namespaces")
+	env.AssertExpectations(t)
+}
+
+func TestDunningWorkflowEndsWhenPaid(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.SendPaymentReminderActivity, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(a.CheckInvoicePaidActivity, mock.Anything, "inv-1").Return(false, nil).Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(PaymentEventSignal, PaymentEvent{InvoiceID: "inv-1"})
+	}, time.Hour)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(PaymentEventSignal, PaymentEvent{InvoiceID: "inv-1", Paid: true})
+	}, 5*24*time.Hour)
+
+	env.ExecuteWorkflow(DunningWorkflow, DunningInput{OrganizationID: "org-1", InvoiceID: "inv-1"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	// Paid before the second reminder, and never suspended.
+	env.AssertExpectations(t)
+	env.AssertNotCalled(t, "SuspendAccountActivity", mock.Anything, mock.Anything)
+}
+
+func TestDunningWorkflowSuspendsUnpaidAccount(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.SendPaymentReminderActivity, mock.Anything, mock.Anything).Return(nil).Times(3)
+	env.OnActivity(a.CheckInvoicePaidActivity, mock.Anything, "inv-1").Return(false, nil).Times(3)
+	env.OnActivity(a.SuspendAccountActivity, mock.Anything, "org-1").Return(nil).Once()
+
+	env.ExecuteWorkflow(DunningWorkflow, DunningInput{OrganizationID: "org-1", InvoiceID: "inv-1"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestExpireCreditsWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	start := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	env.SetStartTime(start)
+
+	var a *Activities
+	env.OnActivity(a.ExpireCreditsActivity, mock.Anything, start).Return(3, nil).Once()
+
+	env.ExecuteWorkflow(ExpireCreditsWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
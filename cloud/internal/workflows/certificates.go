@@ -0,0 +1,82 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// RotateNamespaceCAInput is the input for the CA rotation workflow.
+type RotateNamespaceCAInput struct {
+	NamespaceID string
+	ClusterID   string
+}
+
+// RotateCAOutput is the output of RotateCAActivity.
+type RotateCAOutput struct {
+	Fingerprint string
+	// PreviousRetires is when the superseded CA stops being trusted.
+	PreviousRetires time.Time
+}
+
+// RotateNamespaceCAWorkflow rotates a namespace's client CA. Both the new and
+// the previous CA are published to the cluster for the overlap window, after
+// which the previous CA is removed from the bundle and deleted.
+func RotateNamespaceCAWorkflow(ctx workflow.Context, input RotateNamespaceCAInput) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting namespace CA rotation", "namespace_id", input.NamespaceID)
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	clusterNamespace := ClusterNamespaceInput{
+		ClusterID:   input.ClusterID,
+		NamespaceID: input.NamespaceID,
+	}
+
+	// Step 1: Create the new CA
+	var a *Activities
+	var rotated RotateCAOutput
+	err := workflow.ExecuteActivity(ctx, a.RotateCAActivity, input.NamespaceID).Get(ctx, &rotated)
+	if err != nil {
+		return fmt.Errorf("failed to rotate CA: %w", err)
+	}
+
+	// Step 2: Trust both CAs on the cluster
+	err = workflow.ExecuteActivity(ctx, a.PublishCABundleActivity, clusterNamespace).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to publish CA bundle: %w", err)
+	}
+
+	// Step 3: Wait out the overlap window
+	if wait := rotated.PreviousRetires.Sub(workflow.Now(ctx)); wait > 0 {
+		if err := workflow.Sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+
+	// Step 4: Drop the previous CA from the cluster
+	err = workflow.ExecuteActivity(ctx, a.PublishCABundleActivity, clusterNamespace).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to publish CA bundle: %w", err)
+	}
+
+	// Step 5: Delete retired CAs
+	err = workflow.ExecuteActivity(ctx, a.PruneRetiredCAsActivity).Get(ctx, nil)
+	if err != nil {
+		logger.Warn("Failed to prune retired CAs", "error", err)
+	}
+
+	logger.Info("Namespace CA rotation completed", "namespace_id", input.NamespaceID, "fingerprint", rotated.Fingerprint)
+	return nil
+}
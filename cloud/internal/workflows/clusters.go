@@ -0,0 +1,237 @@
+package workflows
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ClusterRegistry tracks the Temporal clusters available in each region and
+// the clients used to reach them.
+type ClusterRegistry struct {
+	mu       sync.Mutex
+	clusters map[string]*registeredCluster
+	byRegion map[string][]string
+	rand     *rand.Rand
+}
+
+type registeredCluster struct {
+	config.ClusterConfig
+	tls    *tls.Config
+	client client.Client
+	admin  *grpc.ClientConn
+}
+
+// NewClusterRegistry creates a registry for the given clusters. Clients are
+// created lazily on first use.
+func NewClusterRegistry(clusters []config.ClusterConfig) (*ClusterRegistry, error) {
+	r := &ClusterRegistry{
+		clusters: make(map[string]*registeredCluster),
+		byRegion: make(map[string][]string),
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, c := range clusters {
+		if err := r.Add(c, nil); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Add registers a cluster. If c is nil a lazy client is created from the
+// cluster's host:port.
+func (r *ClusterRegistry) Add(cluster config.ClusterConfig, c client.Client) error {
+	if cluster.ID == "" || cluster.Region == "" {
+		return fmt.Errorf("cluster id and region are required")
+	}
+	if cluster.Capacity <= 0 {
+		return fmt.Errorf("cluster %s: capacity must be positive", cluster.ID)
+	}
+	if cluster.Name == "" {
+		cluster.Name = cluster.ID
+	}
+	tlsConfig, err := clusterTLSConfig(cluster.TLS)
+	if err != nil {
+		return fmt.Errorf("cluster %s: %w", cluster.ID, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clusters[cluster.ID]; ok {
+		return fmt.Errorf("cluster %s already registered", cluster.ID)
+	}
+	r.clusters[cluster.ID] = &registeredCluster{ClusterConfig: cluster, tls: tlsConfig, client: c}
+	r.byRegion[cluster.Region] = append(r.byRegion[cluster.Region], cluster.ID)
+	sort.Strings(r.byRegion[cluster.Region])
+	return nil
+}
+
+// Select picks a cluster in the region at random, weighted by the capacity
+// each cluster has left given the current namespace counts.
+func (r *ClusterRegistry) Select(region string, namespaceCounts map[string]int) (config.ClusterConfig, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var total int
+	free := make([]int, len(r.byRegion[region]))
+	for i, id := range r.byRegion[region] {
+		if remaining := r.clusters[id].Capacity - namespaceCounts[id]; remaining > 0 {
+			free[i] = remaining
+			total += remaining
+		}
+	}
+	if total == 0 {
+		return config.ClusterConfig{}, fmt.Errorf("no cluster with free capacity in region %s", region)
+	}
+
+	pick := r.rand.Intn(total)
+	for i, id := range r.byRegion[region] {
+		if pick < free[i] {
+			return r.clusters[id].ClusterConfig, nil
+		}
+		pick -= free[i]
+	}
+	return config.ClusterConfig{}, fmt.Errorf("failed to select a cluster in region %s", region)
+}
+
+// Cluster returns the configuration of a cluster.
+func (r *ClusterRegistry) Cluster(clusterID string) (config.ClusterConfig, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cluster, ok := r.clusters[clusterID]
+	if !ok {
+		return config.ClusterConfig{}, false
+	}
+	return cluster.ClusterConfig, true
+}
+
+// ClusterByName returns the configuration of the cluster with the given name
+// in the Temporal cluster metadata.
+func (r *ClusterRegistry) ClusterByName(name string) (config.ClusterConfig, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, cluster := range r.clusters {
+		if cluster.Name == name {
+			return cluster.ClusterConfig, true
+		}
+	}
+	return config.ClusterConfig{}, false
+}
+
+// Regions returns the regions that have at least one cluster, sorted.
+func (r *ClusterRegistry) Regions() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	regions := make([]string, 0, len(r.byRegion))
+	for region := range r.byRegion {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// Client returns the client for a cluster.
+func (r *ClusterRegistry) Client(clusterID string) (client.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cluster, ok := r.clusters[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("unknown cluster %s", clusterID)
+	}
+	if cluster.client == nil {
+		c, err := client.NewLazyClient(client.Options{
+			HostPort:          cluster.HostPort,
+			ConnectionOptions: client.ConnectionOptions{TLS: cluster.tls},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for cluster %s: %w", clusterID, err)
+		}
+		cluster.client = c
+	}
+	return cluster.client, nil
+}
+
+// AdminClient returns a client for the admin service of a cluster's
+// frontend. It connects with the same TLS settings as the cluster's client.
+func (r *ClusterRegistry) AdminClient(clusterID string) (adminservice.AdminServiceClient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cluster, ok := r.clusters[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("unknown cluster %s", clusterID)
+	}
+	if cluster.admin == nil {
+		creds := insecure.NewCredentials()
+		if cluster.tls != nil {
+			creds = credentials.NewTLS(cluster.tls)
+		}
+		conn, err := grpc.NewClient(cluster.HostPort, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create admin client for cluster %s: %w", clusterID, err)
+		}
+		cluster.admin = conn
+	}
+	return adminservice.NewAdminServiceClient(cluster.admin), nil
+}
+
+// Close closes all cluster clients.
+func (r *ClusterRegistry) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, cluster := range r.clusters {
+		if cluster.client != nil {
+			cluster.client.Close()
+			cluster.client = nil
+		}
+		if cluster.admin != nil {
+			_ = cluster.admin.Close()
+			cluster.admin = nil
+		}
+	}
+}
+
+// clusterTLSConfig loads the TLS settings of a cluster, or returns nil if it
+// has none.
+func clusterTLSConfig(cfg *config.ClusterTLSConfig) (*tls.Config, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{ServerName: cfg.ServerName, MinVersion: tls.VersionTLS12}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", cfg.CAFile)
+		}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,84 @@
+package workflows
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/config"
+)
+
+func TestClusterRegistrySelect(t *testing.T) {
+	registry, err := NewClusterRegistry([]config.ClusterConfig{
+		{ID: "use1-a", Region: "us-east-1", HostPort: "a:7233", Capacity: 10},
+		{ID: "use1-b", Region: "us-east-1", HostPort: "b:7233", Capacity: 30},
+		{ID: "usw2-a", Region: "us-west-2", HostPort: "c:7233", Capacity: 5},
+	})
+	require.NoError(t, err)
+	registry.rand = rand.New(rand.NewSource(1))
+
+	picks := make(map[string]int)
+	for i := 0; i < 4000; i++ {
+		cluster, err := registry.Select("us-east-1", nil)
+		require.NoError(t, err)
+		picks[cluster.ID]++
+	}
+	require.Len(t, picks, 2)
+	// use1-b has three times the capacity of use1-a.
+	require.InDelta(t, 3.0, float64(picks["use1-b"])/float64(picks["use1-a"]), 0.5)
+
+	// Full clusters are never picked.
+	for i := 0; i < 100; i++ {
+		cluster, err := registry.Select("us-east-1", map[string]int{"use1-b": 30})
+		require.NoError(t, err)
+		require.Equal(t, "use1-a", cluster.ID)
+	}
+
+	_, err = registry.Select("us-west-2", map[string]int{"usw2-a": 5})
+	require.Error(t, err)
+	_, err = registry.Select("eu-west-1", nil)
+	require.Error(t, err)
+}
+
+func TestClusterRegistryAdd(t *testing.T) {
+	registry, err := NewClusterRegistry(nil)
+	require.NoError(t, err)
+
+	require.Error(t, registry.Add(config.ClusterConfig{ID: "a", Region: "us-east-1"}, nil))
+	require.NoError(t, registry.Add(config.ClusterConfig{ID: "a", Region: "us-east-1", Capacity: 1}, nil))
+	require.Error(t, registry.Add(config.ClusterConfig{ID: "a", Region: "us-east-1", Capacity: 1}, nil))
+
+	_, err = registry.Client("missing")
+	require.Error(t, err)
+}
+
+func TestClusterRegistryTLS(t *testing.T) {
+	registry, err := NewClusterRegistry(nil)
+	require.NoError(t, err)
+
+	err = registry.Add(config.ClusterConfig{
+		ID: "use1-a", Region: "us-east-1", HostPort: "a:7233", Capacity: 10,
+		TLS: &config.ClusterTLSConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+	}, nil)
+	require.ErrorContains(t, err, "failed to read CA file")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+	err = registry.Add(config.ClusterConfig{
+		ID: "use1-a", Region: "us-east-1", HostPort: "a:7233", Capacity: 10,
+		TLS: &config.ClusterTLSConfig{CAFile: caFile},
+	}, nil)
+	require.ErrorContains(t, err, "no certificates found")
+
+	require.NoError(t, registry.Add(config.ClusterConfig{
+		ID: "use1-a", Region: "us-east-1", HostPort: "a:7233", Capacity: 10,
+		TLS: &config.ClusterTLSConfig{ServerName: "frontend.use1-a.internal"},
+	}, nil))
+	_, err = registry.AdminClient("use1-a")
+	require.NoError(t, err)
+	_, err = registry.AdminClient("unknown")
+	require.Error(t, err)
+	registry.Close()
+}
@@ -0,0 +1,77 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// PublishIPAllowlistInput is the IP allowlist of a namespace, as published to
+// the cluster hosting it.
+type PublishIPAllowlistInput struct {
+	ClusterID   string
+	NamespaceID string
+	// CIDRs is the union of the CIDRs of the namespace's enabled IP allowlist
+	// rules. It is empty if the namespace is not restricted.
+	CIDRs []string
+}
+
+// SyncConnectivityWorkflow publishes the IP allowlist of every placed
+// namespace, derived from the connectivity rules bound to it, to the cluster
+// hosting the namespace. Namespaces whose allowlist is unchanged are left
+// alone, so it is cheap to run on a short schedule and after rule or binding
+// changes.
+func SyncConnectivityWorkflow(ctx workflow.Context) error {
+	logger := workflow.GetLogger(ctx)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var namespaceIDs []string
+	var a *Activities
+	if err := workflow.ExecuteActivity(ctx, a.ListPlacedNamespacesActivity).Get(ctx, &namespaceIDs); err != nil {
+		return err
+	}
+
+	futures := make([]workflow.Future, len(namespaceIDs))
+	for i, namespaceID := range namespaceIDs {
+		futures[i] = workflow.ExecuteActivity(ctx, a.GetIPAllowlistActivity, namespaceID)
+	}
+	var failed int
+	var published []workflow.Future
+	var publishedIDs []string
+	for i, future := range futures {
+		var allowlist *PublishIPAllowlistInput
+		if err := future.Get(ctx, &allowlist); err != nil {
+			logger.Warn("Failed to get namespace IP allowlist", "namespace_id", namespaceIDs[i], "error", err)
+			failed++
+			continue
+		}
+		if allowlist == nil {
+			continue
+		}
+		published = append(published, workflow.ExecuteActivity(ctx, a.PublishIPAllowlistActivity, *allowlist))
+		publishedIDs = append(publishedIDs, namespaceIDs[i])
+	}
+	for i, future := range published {
+		if err := future.Get(ctx, nil); err != nil {
+			logger.Warn("Failed to publish namespace IP allowlist", "namespace_id", publishedIDs[i], "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to sync connectivity of %d of %d namespaces", failed, len(namespaceIDs))
+	}
+
+	logger.Info("Connectivity sync completed", "namespaces", len(namespaceIDs))
+	return nil
+}
@@ -0,0 +1,74 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestSyncConnectivityWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.ListPlacedNamespacesActivity, mock.Anything).Return([]string{"ns-a", "ns-b", "ns-c"}, nil)
+	allowlistA := &PublishIPAllowlistInput{ClusterID: "cluster-1", NamespaceID: "ns-a", CIDRs: []string{"10.0.0.0/8"}}
+	allowlistB := &PublishIPAllowlistInput{ClusterID: "cluster-2", NamespaceID: "ns-b"}
+	env.OnActivity(a.GetIPAllowlistActivity, mock.Anything, "ns-a").Return(allowlistA, nil).Once()
+	env.OnActivity(a.GetIPAllowlistActivity, mock.Anything, "ns-b").Return(allowlistB, nil).Once()
+	// Namespace c was deleted since it was listed.
+	env.OnActivity(a.GetIPAllowlistActivity, mock.Anything, "ns-c").Return(nil, nil).Once()
+	env.OnActivity(a.PublishIPAllowlistActivity, mock.Anything, *allowlistA).Return(nil).Once()
+	env.OnActivity(a.PublishIPAllowlistActivity, mock.Anything, *allowlistB).Return(nil).Once()
+
+	env.ExecuteWorkflow(SyncConnectivityWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestPublishIPAllowlistActivity(t *testing.T) {
+	a, ts := newTestActivities(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := a.RegisterNamespaceActivity(ctx, RegisterNamespaceInput{
+		ClusterID:     "test-cluster",
+		NamespaceID:   "orders.abcd1234",
+		Name:          "orders",
+		RetentionDays: 1,
+	})
+	require.NoError(t, err)
+
+	allowlist := func() string {
+		desc, err := ts.GetDefaultClient().WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+			Namespace: "orders.abcd1234",
+		})
+		require.NoError(t, err)
+		return desc.GetNamespaceInfo().GetData()[ipAllowlistDataKey]
+	}
+
+	input := PublishIPAllowlistInput{
+		ClusterID:   "test-cluster",
+		NamespaceID: "orders.abcd1234",
+		CIDRs:       []string{"10.0.0.0/8", "203.0.113.7/32"},
+	}
+	// The frontend accepts updates once its namespace registry has the
+	// namespace; the activity is retried until then.
+	require.Eventually(t, func() bool {
+		return a.PublishIPAllowlistActivity(ctx, input) == nil
+	}, 20*time.Second, 100*time.Millisecond)
+	require.Equal(t, "10.0.0.0/8,203.0.113.7/32", allowlist())
+	// Publishing an unchanged allowlist is a no-op.
+	require.NoError(t, a.PublishIPAllowlistActivity(ctx, input))
+
+	input.CIDRs = nil
+	require.NoError(t, a.PublishIPAllowlistActivity(ctx, input))
+	require.Empty(t, allowlist())
+}
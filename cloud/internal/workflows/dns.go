@@ -0,0 +1,100 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"go.temporal.io/cloud/internal/config"
+)
+
+// dnsRecordTTL is the TTL, in seconds, of namespace records. It is kept short
+// so that failover takes effect quickly.
+const dnsRecordTTL = 60
+
+// hostRecordTypes are the types of record that can point a name at a host.
+var hostRecordTypes = []string{"A", "AAAA", "CNAME"}
+
+// DNSRecord is a DNS resource record set. Names are fully qualified and have no
+// trailing dot.
+type DNSRecord struct {
+	Name   string
+	Type   string
+	TTL    int64
+	Values []string
+}
+
+// DNSProvider manages the records of a single DNS zone.
+type DNSProvider interface {
+	// Zone returns the zone the provider manages, e.g. "tmprl.cloud".
+	Zone() string
+	// UpsertRecord creates the record or replaces an existing record with the
+	// same name and type.
+	UpsertRecord(ctx context.Context, record DNSRecord) error
+	// DeleteRecord deletes the record with the given name and type. Deleting a
+	// record that does not exist is not an error.
+	DeleteRecord(ctx context.Context, name, recordType string) error
+	// GetRecord returns the record with the given name and type, or nil if it
+	// does not exist.
+	GetRecord(ctx context.Context, name, recordType string) (*DNSRecord, error)
+}
+
+// NewDNSProvider creates the DNS provider selected by the configuration.
+func NewDNSProvider(cfg config.DNSConfig) (DNSProvider, error) {
+	switch cfg.Provider {
+	case "route53":
+		sess, err := session.NewSession()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create AWS session: %w", err)
+		}
+		return NewRoute53DNSProvider(route53.New(sess), cfg.HostedZoneID, cfg.Zone), nil
+	case "zonefile":
+		return NewZoneFileDNSProvider(cfg.Zone, cfg.ZoneFile)
+	case "", "memory":
+		return NewMemoryDNSProvider(cfg.Zone), nil
+	default:
+		return nil, fmt.Errorf("unknown DNS provider %q", cfg.Provider)
+	}
+}
+
+// namespaceRecordName is the region-independent name clients use to reach a
+// namespace. It points at the regional record of the active region.
+func namespaceRecordName(zone, namespaceID string) string {
+	return namespaceID + "." + zone
+}
+
+// regionalRecordName is the name of a namespace in one region. It points at
+// the cluster hosting the namespace in that region.
+func regionalRecordName(zone, namespaceID, region string) string {
+	return namespaceID + "." + region + "." + zone
+}
+
+// hostRecord returns the record pointing name at host, which is an IP address
+// or a host name without a port: an A or AAAA record for an address, since a
+// CNAME must name another host, and a CNAME otherwise.
+func hostRecord(name, host string) DNSRecord {
+	record := DNSRecord{Name: name, Type: "CNAME", TTL: dnsRecordTTL, Values: []string{host}}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addr = addr.Unmap()
+		record.Type = "AAAA"
+		if addr.Is4() {
+			record.Type = "A"
+		}
+		record.Values = []string{addr.String()}
+	}
+	return record
+}
+
+func normalizeDNSName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+func checkInZone(zone, name string) error {
+	if name != zone && !strings.HasSuffix(name, "."+zone) {
+		return fmt.Errorf("record %s is not in zone %s", name, zone)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package workflows
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LocalDNSProvider is an authoritative DNS backend that keeps records in
+// memory and, optionally, writes them to a zone file after every change. It
+// lets provisioning and failover run without a cloud DNS service.
+type LocalDNSProvider struct {
+	mu      sync.Mutex
+	zone    string
+	path    string
+	records map[localRecordKey]DNSRecord
+}
+
+type localRecordKey struct {
+	name       string
+	recordType string
+}
+
+// NewMemoryDNSProvider creates a provider that keeps records in memory only.
+func NewMemoryDNSProvider(zone string) *LocalDNSProvider {
+	return &LocalDNSProvider{
+		zone:    normalizeDNSName(zone),
+		records: make(map[localRecordKey]DNSRecord),
+	}
+}
+
+// NewZoneFileDNSProvider creates a provider backed by an RFC 1035 zone file.
+// Records already in the file are loaded; a missing file is treated as an
+// empty zone.
+func NewZoneFileDNSProvider(zone, path string) (*LocalDNSProvider, error) {
+	if path == "" {
+		return nil, errors.New("zone file path is required")
+	}
+	p := NewMemoryDNSProvider(zone)
+	p.path = path
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zone file: %w", err)
+	}
+	records, err := parseZoneFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse zone file %s: %w", path, err)
+	}
+	for _, record := range records {
+		p.records[localRecordKey{record.Name, record.Type}] = record
+	}
+	return p, nil
+}
+
+// Zone returns the zone the provider manages.
+func (p *LocalDNSProvider) Zone() string {
+	return p.zone
+}
+
+// UpsertRecord creates or replaces a record.
+func (p *LocalDNSProvider) UpsertRecord(_ context.Context, record DNSRecord) error {
+	record.Name = normalizeDNSName(record.Name)
+	if err := checkInZone(p.zone, record.Name); err != nil {
+		return err
+	}
+	if len(record.Values) == 0 {
+		return fmt.Errorf("record %s %s has no values", record.Type, record.Name)
+	}
+	record.Values = append([]string(nil), record.Values...)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := localRecordKey{record.Name, record.Type}
+	previous, existed := p.records[key]
+	p.records[key] = record
+	if err := p.flush(); err != nil {
+		if existed {
+			p.records[key] = previous
+		} else {
+			delete(p.records, key)
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteRecord deletes a record if it exists.
+func (p *LocalDNSProvider) DeleteRecord(_ context.Context, name, recordType string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := localRecordKey{normalizeDNSName(name), recordType}
+	previous, existed := p.records[key]
+	if !existed {
+		return nil
+	}
+	delete(p.records, key)
+	if err := p.flush(); err != nil {
+		p.records[key] = previous
+		return err
+	}
+	return nil
+}
+
+// GetRecord returns a record, or nil if it does not exist.
+func (p *LocalDNSProvider) GetRecord(_ context.Context, name, recordType string) (*DNSRecord, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	record, ok := p.records[localRecordKey{normalizeDNSName(name), recordType}]
+	if !ok {
+		return nil, nil
+	}
+	record.Values = append([]string(nil), record.Values...)
+	return &record, nil
+}
+
+// Records returns every record in the zone, sorted by name and type.
+func (p *LocalDNSProvider) Records() []DNSRecord {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.sortedRecords()
+}
+
+func (p *LocalDNSProvider) sortedRecords() []DNSRecord {
+	records := make([]DNSRecord, 0, len(p.records))
+	for _, record := range p.records {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		return records[i].Type < records[j].Type
+	})
+	return records
+}
+
+// flush writes the zone file, replacing it atomically. It is a no-op for
+// memory-only providers.
+func (p *LocalDNSProvider) flush() error {
+	if p.path == "" {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "$ORIGIN %s.\n", p.zone)
+	for _, record := range p.sortedRecords() {
+		for _, value := range record.Values {
+			if record.Type == "CNAME" {
+				value += "."
+			}
+			fmt.Fprintf(&buf, "%s.\t%d\tIN\t%s\t%s\n", record.Name, record.TTL, record.Type, value)
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p.path), filepath.Base(p.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write zone file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write zone file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write zone file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), p.path); err != nil {
+		return fmt.Errorf("failed to write zone file: %w", err)
+	}
+	return nil
+}
+
+// parseZoneFile parses the subset of the zone file format written by flush:
+// one "<name>. <ttl> IN <type> <value>" entry per line, with $ directives and
+// comments ignored.
+func parseZoneFile(data []byte) ([]DNSRecord, error) {
+	records := make(map[localRecordKey]*DNSRecord)
+	var order []localRecordKey
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "$") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 5 || fields[2] != "IN" {
+			return nil, fmt.Errorf("line %d: malformed record", lineNum)
+		}
+		ttl, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid TTL: %w", lineNum, err)
+		}
+
+		key := localRecordKey{normalizeDNSName(fields[0]), fields[3]}
+		value := strings.Join(fields[4:], " ")
+		if key.recordType == "CNAME" {
+			value = normalizeDNSName(value)
+		}
+		record, ok := records[key]
+		if !ok {
+			record = &DNSRecord{Name: key.name, Type: key.recordType, TTL: ttl}
+			records[key] = record
+			order = append(order, key)
+		}
+		record.Values = append(record.Values, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]DNSRecord, 0, len(order))
+	for _, key := range order {
+		result = append(result, *records[key])
+	}
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package workflows
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDNSProvider(t *testing.T) {
+	ctx := context.Background()
+	p := NewMemoryDNSProvider("tmprl.cloud.")
+	require.Equal(t, "tmprl.cloud", p.Zone())
+
+	record := DNSRecord{Name: "ns.us-east-1.tmprl.cloud", Type: "CNAME", TTL: 60, Values: []string{"cluster.internal"}}
+	require.NoError(t, p.UpsertRecord(ctx, record))
+
+	got, err := p.GetRecord(ctx, "NS.us-east-1.tmprl.cloud.", "CNAME")
+	require.NoError(t, err)
+	require.Equal(t, &record, got)
+
+	got, err = p.GetRecord(ctx, "ns.us-east-1.tmprl.cloud", "A")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.Error(t, p.UpsertRecord(ctx, DNSRecord{Name: "ns.example.com", Type: "CNAME", Values: []string{"x"}}))
+	require.Error(t, p.UpsertRecord(ctx, DNSRecord{Name: "ns.tmprl.cloud", Type: "CNAME"}))
+
+	require.NoError(t, p.DeleteRecord(ctx, record.Name, "CNAME"))
+	require.NoError(t, p.DeleteRecord(ctx, record.Name, "CNAME"))
+	require.Empty(t, p.Records())
+}
+
+func TestZoneFileDNSProvider(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "tmprl.cloud.zone")
+
+	p, err := NewZoneFileDNSProvider("tmprl.cloud", path)
+	require.NoError(t, err)
+	require.NoError(t, p.UpsertRecord(ctx, DNSRecord{Name: "ns.tmprl.cloud", Type: "CNAME", TTL: 60, Values: []string{"ns.us-east-1.tmprl.cloud"}}))
+	require.NoError(t, p.UpsertRecord(ctx, DNSRecord{Name: "ns.us-east-1.tmprl.cloud", Type: "A", TTL: 300, Values: []string{"10.0.0.1", "10.0.0.2"}}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "$ORIGIN tmprl.cloud.\n"+
+		"ns.tmprl.cloud.\t60\tIN\tCNAME\tns.us-east-1.tmprl.cloud.\n"+
+		"ns.us-east-1.tmprl.cloud.\t300\tIN\tA\t10.0.0.1\n"+
+		"ns.us-east-1.tmprl.cloud.\t300\tIN\tA\t10.0.0.2\n", string(data))
+
+	reloaded, err := NewZoneFileDNSProvider("tmprl.cloud", path)
+	require.NoError(t, err)
+	require.Equal(t, p.Records(), reloaded.Records())
+
+	require.NoError(t, reloaded.DeleteRecord(ctx, "ns.us-east-1.tmprl.cloud", "A"))
+	reloaded, err = NewZoneFileDNSProvider("tmprl.cloud", path)
+	require.NoError(t, err)
+	require.Len(t, reloaded.Records(), 1)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	_, err := parseZoneFile([]byte("ns.tmprl.cloud. 60 CNAME target.\n"))
+	require.Error(t, err)
+	_, err = parseZoneFile([]byte("ns.tmprl.cloud. ttl IN CNAME target.\n"))
+	require.Error(t, err)
+
+	records, err := parseZoneFile([]byte("; comment\n$TTL 60\n\nns.tmprl.cloud. 60 IN TXT \"a b\"\n"))
+	require.NoError(t, err)
+	require.Equal(t, []DNSRecord{{Name: "ns.tmprl.cloud", Type: "TXT", TTL: 60, Values: []string{`"a b"`}}}, records)
+}
@@ -0,0 +1,109 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+)
+
+// Route53DNSProvider manages records in an AWS Route 53 hosted zone.
+type Route53DNSProvider struct {
+	api          route53iface.Route53API
+	hostedZoneID string
+	zone         string
+}
+
+// NewRoute53DNSProvider creates a provider for the hosted zone with the given
+// ID. zone is the hosted zone's domain name.
+func NewRoute53DNSProvider(api route53iface.Route53API, hostedZoneID, zone string) *Route53DNSProvider {
+	return &Route53DNSProvider{
+		api:          api,
+		hostedZoneID: hostedZoneID,
+		zone:         normalizeDNSName(zone),
+	}
+}
+
+// Zone returns the hosted zone's domain name.
+func (p *Route53DNSProvider) Zone() string {
+	return p.zone
+}
+
+// UpsertRecord creates or replaces a record set.
+func (p *Route53DNSProvider) UpsertRecord(ctx context.Context, record DNSRecord) error {
+	record.Name = normalizeDNSName(record.Name)
+	if err := checkInZone(p.zone, record.Name); err != nil {
+		return err
+	}
+	return p.change(ctx, route53.ChangeActionUpsert, record)
+}
+
+// DeleteRecord deletes a record set. Route 53 only deletes a record set that
+// matches exactly, so the current record set is looked up first.
+func (p *Route53DNSProvider) DeleteRecord(ctx context.Context, name, recordType string) error {
+	record, err := p.GetRecord(ctx, name, recordType)
+	if err != nil || record == nil {
+		return err
+	}
+	return p.change(ctx, route53.ChangeActionDelete, *record)
+}
+
+// GetRecord looks up a record set.
+func (p *Route53DNSProvider) GetRecord(ctx context.Context, name, recordType string) (*DNSRecord, error) {
+	name = normalizeDNSName(name)
+	out, err := p.api.ListResourceRecordSetsWithContext(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(p.hostedZoneID),
+		StartRecordName: aws.String(name),
+		StartRecordType: aws.String(recordType),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list record sets for %s: %w", name, err)
+	}
+	// The listing starts at the requested name and type, so the first record
+	// set is the one we want if it exists at all.
+	if len(out.ResourceRecordSets) == 0 {
+		return nil, nil
+	}
+	set := out.ResourceRecordSets[0]
+	if normalizeDNSName(aws.StringValue(set.Name)) != name || aws.StringValue(set.Type) != recordType {
+		return nil, nil
+	}
+
+	record := &DNSRecord{
+		Name: name,
+		Type: recordType,
+		TTL:  aws.Int64Value(set.TTL),
+	}
+	for _, rr := range set.ResourceRecords {
+		record.Values = append(record.Values, aws.StringValue(rr.Value))
+	}
+	return record, nil
+}
+
+func (p *Route53DNSProvider) change(ctx context.Context, action string, record DNSRecord) error {
+	set := &route53.ResourceRecordSet{
+		Name: aws.String(record.Name + "."),
+		Type: aws.String(record.Type),
+		TTL:  aws.Int64(record.TTL),
+	}
+	for _, value := range record.Values {
+		set.ResourceRecords = append(set.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+	}
+
+	_, err := p.api.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(p.hostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{{
+				Action:            aws.String(action),
+				ResourceRecordSet: set,
+			}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to %s record %s %s: %w", action, record.Type, record.Name, err)
+	}
+	return nil
+}
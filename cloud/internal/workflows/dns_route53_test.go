@@ -0,0 +1,79 @@
+package workflows
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRoute53 implements the parts of the Route 53 API used by
+// Route53DNSProvider, including its exact-match requirement for deletes.
+type fakeRoute53 struct {
+	route53iface.Route53API
+	sets map[string]*route53.ResourceRecordSet
+}
+
+func (f *fakeRoute53) ChangeResourceRecordSetsWithContext(_ aws.Context, input *route53.ChangeResourceRecordSetsInput, _ ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error) {
+	for _, change := range input.ChangeBatch.Changes {
+		set := change.ResourceRecordSet
+		key := aws.StringValue(set.Name) + " " + aws.StringValue(set.Type)
+		switch aws.StringValue(change.Action) {
+		case route53.ChangeActionUpsert:
+			f.sets[key] = set
+		case route53.ChangeActionDelete:
+			existing, ok := f.sets[key]
+			if !ok || existing.String() != set.String() {
+				return nil, awserr.New(route53.ErrCodeInvalidChangeBatch, "record set not found", nil)
+			}
+			delete(f.sets, key)
+		}
+	}
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func (f *fakeRoute53) ListResourceRecordSetsWithContext(_ aws.Context, input *route53.ListResourceRecordSetsInput, _ ...request.Option) (*route53.ListResourceRecordSetsOutput, error) {
+	start := aws.StringValue(input.StartRecordName) + ". " + aws.StringValue(input.StartRecordType)
+	var keys []string
+	for key := range f.sets {
+		if key >= start {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	out := &route53.ListResourceRecordSetsOutput{}
+	if len(keys) > 0 {
+		out.ResourceRecordSets = []*route53.ResourceRecordSet{f.sets[keys[0]]}
+	}
+	return out, nil
+}
+
+func TestRoute53DNSProvider(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeRoute53{sets: make(map[string]*route53.ResourceRecordSet)}
+	p := NewRoute53DNSProvider(api, "Z123", "tmprl.cloud")
+
+	record := DNSRecord{Name: "ns.tmprl.cloud", Type: "CNAME", TTL: 60, Values: []string{"ns.us-east-1.tmprl.cloud"}}
+	require.NoError(t, p.UpsertRecord(ctx, record))
+	require.Contains(t, api.sets, "ns.tmprl.cloud. CNAME")
+
+	got, err := p.GetRecord(ctx, "ns.tmprl.cloud", "CNAME")
+	require.NoError(t, err)
+	require.Equal(t, &record, got)
+
+	got, err = p.GetRecord(ctx, "missing.tmprl.cloud", "CNAME")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.NoError(t, p.DeleteRecord(ctx, "ns.tmprl.cloud", "CNAME"))
+	require.Empty(t, api.sets)
+	require.NoError(t, p.DeleteRecord(ctx, "ns.tmprl.cloud", "CNAME"))
+
+	require.Error(t, p.UpsertRecord(ctx, DNSRecord{Name: "ns.example.com", Type: "CNAME", Values: []string{"x"}}))
+}
@@ -0,0 +1,168 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// exportVisibilityDelay is how long after closing an execution is left out of
+// exports, so that visibility has caught up with it.
+const exportVisibilityDelay = 5 * time.Minute
+
+// ExportHistoriesInput is the input for the history export workflow.
+type ExportHistoriesInput struct {
+	// End is the close time up to which histories are exported. It defaults
+	// to a few minutes ago.
+	End time.Time
+}
+
+// ExportHistoriesWorkflow exports the histories of the workflows that closed
+// since each enabled export sink's last export, one ExportSinkWorkflow per
+// sink.
+func ExportHistoriesWorkflow(ctx workflow.Context, input ExportHistoriesInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	end := input.End
+	if end.IsZero() {
+		end = workflow.Now(ctx).Add(-exportVisibilityDelay)
+	}
+	end = end.UTC()
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var sinkIDs []string
+	var a *Activities
+	if err := workflow.ExecuteActivity(ctx, a.ListExportSinksActivity).Get(ctx, &sinkIDs); err != nil {
+		return err
+	}
+
+	futures := make([]workflow.Future, len(sinkIDs))
+	for i, sinkID := range sinkIDs {
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID: fmt.Sprintf("export-%s-%d", sinkID, end.Unix()),
+		})
+		futures[i] = workflow.ExecuteChildWorkflow(childCtx, ExportSinkWorkflow, ExportSinkInput{SinkID: sinkID, End: end})
+	}
+	var failed int
+	for i, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			logger.Warn("Failed to export workflow histories", "sink_id", sinkIDs[i], "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to export to %d of %d sinks", failed, len(sinkIDs))
+	}
+
+	logger.Info("History export completed", "end", end, "sinks", len(sinkIDs))
+	return nil
+}
+
+// ExportSinkInput is the input for the export sink workflow.
+type ExportSinkInput struct {
+	SinkID string
+	End    time.Time
+}
+
+// ExportSinkWorkflow runs an export job writing the histories of the workflows
+// that closed between the sink's last export and input.End to the sink. A
+// failed job is recorded and its period is covered again by the next job.
+func ExportSinkWorkflow(ctx workflow.Context, input ExportSinkInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var jobID string
+	err := workflow.SideEffect(ctx, func(workflow.Context) any {
+		return uuid.NewString()
+	}).Get(&jobID)
+	if err != nil {
+		return err
+	}
+
+	var a *Activities
+	var created bool
+	err = workflow.ExecuteActivity(ctx, a.CreateExportJobActivity, CreateExportJobInput{
+		JobID:  jobID,
+		SinkID: input.SinkID,
+		End:    input.End,
+	}).Get(ctx, &created)
+	if err != nil {
+		return err
+	}
+	if !created {
+		// The sink was disabled or deleted, or is already up to date.
+		return nil
+	}
+
+	// The export heartbeats its progress after each page of executions and
+	// resumes from it when retried.
+	exportCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 6 * time.Hour,
+		HeartbeatTimeout:    10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    10 * time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+	var result ExportJobResult
+	exportErr := workflow.ExecuteActivity(exportCtx, a.ExportJobActivity, jobID).Get(ctx, &result)
+	if exportErr != nil {
+		err = workflow.ExecuteActivity(ctx, a.FailExportJobActivity, FailExportJobInput{
+			JobID:   jobID,
+			Message: exportErr.Error(),
+		}).Get(ctx, nil)
+		if err != nil {
+			workflow.GetLogger(ctx).Error("Failed to record export job failure", "job_id", jobID, "error", err)
+		}
+		return exportErr
+	}
+
+	return workflow.ExecuteActivity(ctx, a.CompleteExportJobActivity, CompleteExportJobInput{
+		JobID:  jobID,
+		Result: result,
+	}).Get(ctx, nil)
+}
+
+// Activity input/output types for exports
+
+type CreateExportJobInput struct {
+	JobID  string
+	SinkID string
+	End    time.Time
+}
+
+type ExportJobResult struct {
+	Workflows int64
+	Bytes     int64
+}
+
+type CompleteExportJobInput struct {
+	JobID  string
+	Result ExportJobResult
+}
+
+type FailExportJobInput struct {
+	JobID   string
+	Message string
+}
@@ -0,0 +1,68 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestExportHistoriesWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	env.RegisterWorkflow(ExportSinkWorkflow)
+	now := time.Date(2024, time.May, 1, 10, 17, 0, 0, time.UTC)
+	env.SetStartTime(now)
+
+	// Executions closed in the last few minutes are left for the next run.
+	end := now.Add(-exportVisibilityDelay)
+	var a *Activities
+	env.OnActivity(a.ListExportSinksActivity, mock.Anything).Return([]string{"sink-a", "sink-b"}, nil)
+	env.OnActivity(a.CreateExportJobActivity, mock.Anything, mock.MatchedBy(func(input CreateExportJobInput) bool {
+		return input.SinkID == "sink-a" && input.End.Equal(end) && input.JobID != ""
+	})).Return(true, nil).Once()
+	// Sink b is already up to date.
+	env.OnActivity(a.CreateExportJobActivity, mock.Anything, mock.MatchedBy(func(input CreateExportJobInput) bool {
+		return input.SinkID == "sink-b"
+	})).Return(false, nil).Once()
+	env.OnActivity(a.ExportJobActivity, mock.Anything, mock.Anything).
+		Return(ExportJobResult{Workflows: 3, Bytes: 1024}, nil).Once()
+	env.OnActivity(a.CompleteExportJobActivity, mock.Anything, mock.MatchedBy(func(input CompleteExportJobInput) bool {
+		return input.Result == ExportJobResult{Workflows: 3, Bytes: 1024}
+	})).Return(nil).Once()
+
+	env.ExecuteWorkflow(ExportHistoriesWorkflow, ExportHistoriesInput{})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestExportSinkWorkflowRecordsFailure(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	end := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+	var jobID string
+	var a *Activities
+	env.OnActivity(a.CreateExportJobActivity, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input CreateExportJobInput) (bool, error) {
+			jobID = input.JobID
+			return true, nil
+		}).Once()
+	env.OnActivity(a.ExportJobActivity, mock.Anything, mock.Anything).
+		Return(ExportJobResult{}, errors.New("access denied"))
+	env.OnActivity(a.FailExportJobActivity, mock.Anything, mock.MatchedBy(func(input FailExportJobInput) bool {
+		return input.JobID == jobID && input.Message != ""
+	})).Return(nil).Once()
+
+	env.ExecuteWorkflow(ExportSinkWorkflow, ExportSinkInput{SinkID: "sink-a", End: end})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "access denied")
+	env.AssertExpectations(t)
+}
@@ -0,0 +1,36 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// expiredInvitationRetention is how long expired invitations are kept, so
+// admins can still see and resend them.
+const expiredInvitationRetention = 7 * 24 * time.Hour
+
+// CleanupInvitationsWorkflow deletes invitations that expired more than
+// expiredInvitationRetention ago without being accepted. It runs daily on the
+// cleanup-invitations schedule.
+func CleanupInvitationsWorkflow(ctx workflow.Context) (int64, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	before := workflow.Now(ctx).Add(-expiredInvitationRetention)
+	var deleted int64
+	var a *Activities
+	if err := workflow.ExecuteActivity(ctx, a.DeleteExpiredInvitationsActivity, before).Get(ctx, &deleted); err != nil {
+		return 0, err
+	}
+	workflow.GetLogger(ctx).Info("Deleted expired invitations", "count", deleted)
+	return deleted, nil
+}
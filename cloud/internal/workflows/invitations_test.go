@@ -0,0 +1,45 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestCleanupInvitationsWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	now := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	env.SetStartTime(now)
+
+	var a *Activities
+	env.OnActivity(a.DeleteExpiredInvitationsActivity, mock.Anything, now.Add(-expiredInvitationRetention)).
+		Return(int64(3), nil).Once()
+
+	env.ExecuteWorkflow(CleanupInvitationsWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var deleted int64
+	require.NoError(t, env.GetWorkflowResult(&deleted))
+	require.EqualValues(t, 3, deleted)
+	env.AssertExpectations(t)
+}
+
+func TestCleanupInvitationsWorkflowFails(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.DeleteExpiredInvitationsActivity, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("database unavailable"))
+
+	env.ExecuteWorkflow(CleanupInvitationsWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "database unavailable")
+}
@@ -0,0 +1,143 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// NexusEndpointSyncInput is a version of a Nexus endpoint to provision on, or
+// remove from, the cluster hosting its target namespace.
+type NexusEndpointSyncInput struct {
+	EndpointID string
+	Version    int64
+	Deleted    bool
+
+	Name              string
+	Description       string
+	TargetNamespaceID string
+	TargetTaskQueue   string
+	// AllowedCallerNamespaceIDs is published to the target namespace; the
+	// cluster's frontends reject requests to the endpoint from workflows in
+	// other namespaces.
+	AllowedCallerNamespaceIDs []string
+	// ClusterID is the cluster hosting the target namespace. It is empty if
+	// the namespace is not placed.
+	ClusterID string
+
+	// SyncedClusterID and SyncedTargetNamespaceID are where the endpoint was
+	// last provisioned, if it was.
+	SyncedClusterID         string
+	SyncedTargetNamespaceID string
+}
+
+// NexusEndpointSyncResult is the outcome of provisioning or removing a version
+// of a Nexus endpoint.
+type NexusEndpointSyncResult struct {
+	EndpointID        string
+	Version           int64
+	Deleted           bool
+	ClusterID         string
+	ClusterEndpointID string
+	TargetNamespaceID string
+	// Error is set if the endpoint could not be provisioned or removed.
+	Error string
+}
+
+// SyncNexusEndpointsWorkflow provisions the Nexus endpoints changed since they
+// were last provisioned, and removes deleted endpoints, one
+// SyncNexusEndpointWorkflow per endpoint. Synced endpoints are left alone, so
+// it is cheap to run on a short schedule and after endpoint changes.
+func SyncNexusEndpointsWorkflow(ctx workflow.Context) error {
+	logger := workflow.GetLogger(ctx)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var endpointIDs []string
+	var a *Activities
+	if err := workflow.ExecuteActivity(ctx, a.ListUnsyncedNexusEndpointsActivity).Get(ctx, &endpointIDs); err != nil {
+		return err
+	}
+
+	futures := make([]workflow.Future, len(endpointIDs))
+	for i, endpointID := range endpointIDs {
+		// Syncs of an endpoint don't overlap: a sync still running from an
+		// earlier run fails this one, and the endpoint is picked up again by
+		// the next run.
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID: "sync-nexus-endpoint-" + endpointID,
+		})
+		futures[i] = workflow.ExecuteChildWorkflow(childCtx, SyncNexusEndpointWorkflow, endpointID)
+	}
+	var failed int
+	for i, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			logger.Warn("Failed to sync Nexus endpoint", "endpoint_id", endpointIDs[i], "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to sync %d of %d Nexus endpoints", failed, len(endpointIDs))
+	}
+
+	logger.Info("Nexus endpoint sync completed", "endpoints", len(endpointIDs))
+	return nil
+}
+
+// SyncNexusEndpointWorkflow provisions the current version of a Nexus endpoint
+// on the cluster hosting its target namespace, or removes it from its cluster
+// if it was deleted. A failure is recorded on the endpoint, which is retried
+// by the next SyncNexusEndpointsWorkflow.
+func SyncNexusEndpointWorkflow(ctx workflow.Context, endpointID string) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var a *Activities
+	var input *NexusEndpointSyncInput
+	if err := workflow.ExecuteActivity(ctx, a.GetNexusEndpointSyncActivity, endpointID).Get(ctx, &input); err != nil {
+		return err
+	}
+	if input == nil {
+		// The endpoint was removed or synced since it was listed.
+		return nil
+	}
+
+	result := NexusEndpointSyncResult{
+		EndpointID: input.EndpointID,
+		Version:    input.Version,
+		Deleted:    input.Deleted,
+	}
+	var syncErr error
+	if input.Deleted {
+		syncErr = workflow.ExecuteActivity(ctx, a.RemoveNexusEndpointActivity, *input).Get(ctx, nil)
+	} else {
+		result.ClusterID = input.ClusterID
+		result.TargetNamespaceID = input.TargetNamespaceID
+		syncErr = workflow.ExecuteActivity(ctx, a.ProvisionNexusEndpointActivity, *input).Get(ctx, &result.ClusterEndpointID)
+	}
+	if syncErr != nil {
+		result.Error = syncErr.Error()
+	}
+
+	if err := workflow.ExecuteActivity(ctx, a.CompleteNexusEndpointSyncActivity, result).Get(ctx, nil); err != nil {
+		return err
+	}
+	return syncErr
+}
@@ -0,0 +1,197 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestSyncNexusEndpointsWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	env.RegisterWorkflow(SyncNexusEndpointWorkflow)
+
+	var a *Activities
+	env.OnActivity(a.ListUnsyncedNexusEndpointsActivity, mock.Anything).Return([]string{"ep-a", "ep-b", "ep-c"}, nil)
+	provision := &NexusEndpointSyncInput{
+		EndpointID:                "ep-a",
+		Version:                   2,
+		Name:                      "orders",
+		TargetNamespaceID:         "orders.abcd1234",
+		TargetTaskQueue:           "orders-nexus",
+		AllowedCallerNamespaceIDs: []string{"billing.abcd1234"},
+		ClusterID:                 "cluster-1",
+	}
+	remove := &NexusEndpointSyncInput{
+		EndpointID:              "ep-b",
+		Version:                 5,
+		Deleted:                 true,
+		Name:                    "payments",
+		SyncedClusterID:         "cluster-2",
+		SyncedTargetNamespaceID: "payments.abcd1234",
+	}
+	env.OnActivity(a.GetNexusEndpointSyncActivity, mock.Anything, "ep-a").Return(provision, nil).Once()
+	env.OnActivity(a.GetNexusEndpointSyncActivity, mock.Anything, "ep-b").Return(remove, nil).Once()
+	// Endpoint c was synced since it was listed.
+	env.OnActivity(a.GetNexusEndpointSyncActivity, mock.Anything, "ep-c").Return(nil, nil).Once()
+	env.OnActivity(a.ProvisionNexusEndpointActivity, mock.Anything, *provision).Return("cluster-ep-a", nil).Once()
+	env.OnActivity(a.RemoveNexusEndpointActivity, mock.Anything, *remove).Return(nil).Once()
+	env.OnActivity(a.CompleteNexusEndpointSyncActivity, mock.Anything, NexusEndpointSyncResult{
+		EndpointID:        "ep-a",
+		Version:           2,
+		ClusterID:         "cluster-1",
+		ClusterEndpointID: "cluster-ep-a",
+		TargetNamespaceID: "orders.abcd1234",
+	}).Return(nil).Once()
+	env.OnActivity(a.CompleteNexusEndpointSyncActivity, mock.Anything, NexusEndpointSyncResult{
+		EndpointID: "ep-b",
+		Version:    5,
+		Deleted:    true,
+	}).Return(nil).Once()
+
+	env.ExecuteWorkflow(SyncNexusEndpointsWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestSyncNexusEndpointWorkflow_RecordsFailure(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	input := &NexusEndpointSyncInput{
+		EndpointID:        "ep-a",
+		Version:           1,
+		Name:              "orders",
+		TargetNamespaceID: "orders.abcd1234",
+		TargetTaskQueue:   "orders-nexus",
+	}
+	env.OnActivity(a.GetNexusEndpointSyncActivity, mock.Anything, "ep-a").Return(input, nil)
+	env.OnActivity(a.ProvisionNexusEndpointActivity, mock.Anything, *input).Return("",
+		temporal.NewNonRetryableApplicationError("target namespace orders.abcd1234 is not placed on a cluster", errTypeNamespaceNotPlaced, nil))
+	var recorded NexusEndpointSyncResult
+	env.OnActivity(a.CompleteNexusEndpointSyncActivity, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, result NexusEndpointSyncResult) error {
+			recorded = result
+			return nil
+		}).Once()
+
+	env.ExecuteWorkflow(SyncNexusEndpointWorkflow, "ep-a")
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	require.Equal(t, "ep-a", recorded.EndpointID)
+	require.Contains(t, recorded.Error, "is not placed on a cluster")
+	env.AssertExpectations(t)
+}
+
+func TestProvisionNexusEndpointActivity(t *testing.T) {
+	a, ts := newTestActivities(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, ns := range []string{"orders.abcd1234", "fulfillment.abcd1234"} {
+		_, err := a.RegisterNamespaceActivity(ctx, RegisterNamespaceInput{
+			ClusterID:     "test-cluster",
+			NamespaceID:   ns,
+			Name:          ns,
+			RetentionDays: 1,
+		})
+		require.NoError(t, err)
+	}
+	c := ts.GetDefaultClient()
+
+	allowlist := func(namespaceID string) string {
+		desc, err := c.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+			Namespace: namespaceID,
+		})
+		require.NoError(t, err)
+		return desc.GetNamespaceInfo().GetData()[nexusEndpointAllowlistDataKeyPrefix+"orders"]
+	}
+	getEndpoint := func(id string) *operatorservice.GetNexusEndpointResponse {
+		resp, err := c.OperatorService().GetNexusEndpoint(ctx, &operatorservice.GetNexusEndpointRequest{Id: id})
+		require.NoError(t, err)
+		return resp
+	}
+
+	input := NexusEndpointSyncInput{
+		EndpointID:                "ep-a",
+		Version:                   1,
+		Name:                      "orders",
+		Description:               "Order operations",
+		TargetNamespaceID:         "orders.abcd1234",
+		TargetTaskQueue:           "orders-nexus",
+		AllowedCallerNamespaceIDs: []string{"billing.abcd1234", "shipping.abcd1234"},
+		ClusterID:                 "test-cluster",
+	}
+	// The frontend accepts updates once its namespace registry has the
+	// namespace; the activity is retried until then.
+	var endpointID string
+	require.Eventually(t, func() bool {
+		var err error
+		endpointID, err = a.ProvisionNexusEndpointActivity(ctx, input)
+		return err == nil
+	}, 20*time.Second, 100*time.Millisecond)
+	require.Equal(t, "billing.abcd1234,shipping.abcd1234", allowlist("orders.abcd1234"))
+	endpoint := getEndpoint(endpointID).GetEndpoint()
+	require.Equal(t, "orders", endpoint.GetSpec().GetName())
+	require.Equal(t, "orders.abcd1234", endpoint.GetSpec().GetTarget().GetWorker().GetNamespace())
+	require.Equal(t, "orders-nexus", endpoint.GetSpec().GetTarget().GetWorker().GetTaskQueue())
+	var description string
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayload(endpoint.GetSpec().GetDescription(), &description))
+	require.Equal(t, "Order operations", description)
+
+	// Provisioning an unchanged endpoint is a no-op.
+	id, err := a.ProvisionNexusEndpointActivity(ctx, input)
+	require.NoError(t, err)
+	require.Equal(t, endpointID, id)
+	require.Equal(t, endpoint.GetVersion(), getEndpoint(endpointID).GetEndpoint().GetVersion())
+
+	// Retargeting the endpoint moves its allowlist to the new namespace.
+	input.Version = 2
+	input.TargetNamespaceID = "fulfillment.abcd1234"
+	input.AllowedCallerNamespaceIDs = []string{"billing.abcd1234"}
+	input.SyncedClusterID = "test-cluster"
+	input.SyncedTargetNamespaceID = "orders.abcd1234"
+	require.Eventually(t, func() bool {
+		id, err = a.ProvisionNexusEndpointActivity(ctx, input)
+		return err == nil
+	}, 20*time.Second, 100*time.Millisecond)
+	require.Equal(t, endpointID, id)
+	require.Equal(t, "billing.abcd1234", allowlist("fulfillment.abcd1234"))
+	require.Empty(t, allowlist("orders.abcd1234"))
+	endpoint = getEndpoint(endpointID).GetEndpoint()
+	require.Equal(t, "fulfillment.abcd1234", endpoint.GetSpec().GetTarget().GetWorker().GetNamespace())
+
+	input.Deleted = true
+	input.SyncedTargetNamespaceID = "fulfillment.abcd1234"
+	require.NoError(t, a.RemoveNexusEndpointActivity(ctx, input))
+	require.Empty(t, allowlist("fulfillment.abcd1234"))
+	_, err = c.OperatorService().GetNexusEndpoint(ctx, &operatorservice.GetNexusEndpointRequest{Id: endpointID})
+	require.Error(t, err)
+	// Removing an endpoint that is gone is a no-op.
+	require.NoError(t, a.RemoveNexusEndpointActivity(ctx, input))
+}
+
+func TestProvisionNexusEndpointActivity_NamespaceNotPlaced(t *testing.T) {
+	a := &Activities{}
+	_, err := a.ProvisionNexusEndpointActivity(context.Background(), NexusEndpointSyncInput{
+		Name:              "orders",
+		TargetNamespaceID: "orders.abcd1234",
+	})
+	var appErr *temporal.ApplicationError
+	require.True(t, errors.As(err, &appErr))
+	require.Equal(t, errTypeNamespaceNotPlaced, appErr.Type())
+	require.True(t, appErr.NonRetryable())
+}
@@ -0,0 +1,218 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// CancelOrganizationDeletionSignal is the signal that cancels a
+// DeleteOrganizationWorkflow during its grace period.
+const CancelOrganizationDeletionSignal = "cancel-organization-deletion"
+
+// OrganizationDeletionWorkflowID returns the ID of the workflow deleting an
+// organization.
+func OrganizationDeletionWorkflowID(orgID string) string {
+	return "delete-organization-" + orgID
+}
+
+// DeleteOrganizationInput is the input for the delete organization workflow.
+type DeleteOrganizationInput struct {
+	OrganizationID string
+	// DeleteAfter is the end of the grace period, when teardown begins.
+	DeleteAfter time.Time
+	// AuditArchive is where the organization's audit log is exported before
+	// it is deleted. The export is skipped if its SinkType is empty.
+	AuditArchive config.AuditArchiveConfig
+}
+
+// DeleteOrganizationWorkflow deletes an organization once its grace period is
+// over, unless CancelOrganizationDeletionSignal is received first. It revokes
+// the organization's API keys, deletes each of its namespaces with a
+// DeleteNamespaceWorkflow, issues a final invoice for the current month,
+// exports the audit log and finally marks the organization deleted.
+func DeleteOrganizationWorkflow(ctx workflow.Context, input DeleteOrganizationInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	if wait := input.DeleteAfter.Sub(workflow.Now(ctx)); wait > 0 {
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		var cancelled bool
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(workflow.NewTimer(timerCtx, wait), func(workflow.Future) {})
+		selector.AddReceive(workflow.GetSignalChannel(ctx, CancelOrganizationDeletionSignal), func(c workflow.ReceiveChannel, _ bool) {
+			c.Receive(ctx, nil)
+			cancelled = true
+		})
+		selector.Select(ctx)
+		cancelTimer()
+		if cancelled {
+			logger.Info("Organization deletion cancelled", "org_id", input.OrganizationID)
+			return nil
+		}
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	// Step 1: Check the deletion is still scheduled and make it final
+	var begun bool
+	var a *Activities
+	if err := workflow.ExecuteActivity(ctx, a.BeginOrganizationDeletionActivity, input.OrganizationID).Get(ctx, &begun); err != nil {
+		return err
+	}
+	if !begun {
+		logger.Info("Organization deletion is no longer scheduled", "org_id", input.OrganizationID)
+		return nil
+	}
+	logger.Info("Starting organization deletion", "org_id", input.OrganizationID)
+
+	// Step 2: Revoke API keys
+	var revoked int64
+	if err := workflow.ExecuteActivity(ctx, a.RevokeOrganizationAPIKeysActivity, input.OrganizationID).Get(ctx, &revoked); err != nil {
+		return fmt.Errorf("failed to revoke API keys: %w", err)
+	}
+
+	// Step 3: Drain and delete namespaces
+	var namespaces []DeleteNamespaceInput
+	if err := workflow.ExecuteActivity(ctx, a.ListNamespacesToDeleteActivity, input.OrganizationID).Get(ctx, &namespaces); err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	futures := make([]workflow.ChildWorkflowFuture, len(namespaces))
+	for i, ns := range namespaces {
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID: "delete-namespace-" + ns.NamespaceID,
+		})
+		futures[i] = workflow.ExecuteChildWorkflow(childCtx, DeleteNamespaceWorkflow, ns)
+	}
+	var failed int
+	for i, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			logger.Warn("Failed to delete namespace", "namespace_id", namespaces[i].NamespaceID, "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d namespaces", failed, len(namespaces))
+	}
+
+	// Step 4: Issue the final invoice and cancel the subscription
+	var invoiceID string
+	if err := workflow.ExecuteActivity(ctx, a.GenerateFinalInvoiceActivity, input.OrganizationID).Get(ctx, &invoiceID); err != nil {
+		return fmt.Errorf("failed to generate final invoice: %w", err)
+	}
+	if invoiceID != "" {
+		err := workflow.ExecuteActivity(ctx, a.ReportStripeUsageActivity, ReportStripeUsageInput{
+			OrganizationID: input.OrganizationID,
+			InvoiceID:      invoiceID,
+		}).Get(ctx, nil)
+		if err != nil {
+			logger.Warn("Failed to report usage to Stripe", "error", err)
+		}
+	}
+
+	// Step 5: Export the audit log. It heartbeats its position after each
+	// batch and resumes from it when retried.
+	if input.AuditArchive.SinkType != "" {
+		exportCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: time.Hour,
+			HeartbeatTimeout:    5 * time.Minute,
+			RetryPolicy: &temporal.RetryPolicy{
+				InitialInterval:    10 * time.Second,
+				BackoffCoefficient: 2.0,
+				MaximumInterval:    10 * time.Minute,
+				MaximumAttempts:    10,
+			},
+		})
+		var exported int
+		err := workflow.ExecuteActivity(exportCtx, a.ArchiveAuditLogActivity, ArchiveAuditLogInput{
+			OrganizationID: input.OrganizationID,
+			Archive:        input.AuditArchive,
+			End:            workflow.Now(ctx),
+		}).Get(ctx, &exported)
+		if err != nil {
+			return fmt.Errorf("failed to export audit log: %w", err)
+		}
+	} else {
+		logger.Warn("No audit archive configured, skipping audit log export", "org_id", input.OrganizationID)
+	}
+
+	// Step 6: Mark the organization deleted
+	if err := workflow.ExecuteActivity(ctx, a.CompleteOrganizationDeletionActivity, input.OrganizationID).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	logger.Info("Organization deletion completed", "org_id", input.OrganizationID,
+		"namespaces", len(namespaces), "api_keys_revoked", revoked, "invoice_id", invoiceID)
+	return nil
+}
+
+// ArchiveAuditLogInput is the input for ArchiveAuditLogActivity.
+type ArchiveAuditLogInput struct {
+	OrganizationID string
+	Archive        config.AuditArchiveConfig
+	// End is the time up to which events are exported.
+	End time.Time
+}
+
+// OrganizationDeleter starts and cancels organization deletion workflows. It
+// implements service.OrganizationDeleter.
+type OrganizationDeleter struct {
+	client       client.Client
+	taskQueue    string
+	auditArchive config.AuditArchiveConfig
+}
+
+// NewOrganizationDeleter creates an organization deleter that runs deletion
+// workflows on taskQueue, exporting audit logs to auditArchive.
+func NewOrganizationDeleter(c client.Client, taskQueue string, auditArchive config.AuditArchiveConfig) *OrganizationDeleter {
+	return &OrganizationDeleter{client: c, taskQueue: taskQueue, auditArchive: auditArchive}
+}
+
+// ScheduleDeletion starts the organization's deletion workflow. A workflow
+// left over from a cancelled deletion is terminated, so it cannot delete the
+// organization at the earlier time.
+func (d *OrganizationDeleter) ScheduleDeletion(ctx context.Context, orgID uuid.UUID, deleteAfter time.Time) error {
+	_, err := d.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                       OrganizationDeletionWorkflowID(orgID.String()),
+		TaskQueue:                d.taskQueue,
+		WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+	}, DeleteOrganizationWorkflow, DeleteOrganizationInput{
+		OrganizationID: orgID.String(),
+		DeleteAfter:    deleteAfter,
+		AuditArchive:   d.auditArchive,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start deletion of organization %s: %w", orgID, err)
+	}
+	return nil
+}
+
+// CancelDeletion signals the organization's deletion workflow to stop, if it
+// is running.
+func (d *OrganizationDeleter) CancelDeletion(ctx context.Context, orgID uuid.UUID) error {
+	err := d.client.SignalWorkflow(ctx, OrganizationDeletionWorkflowID(orgID.String()), "", CancelOrganizationDeletionSignal, nil)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to cancel deletion of organization %s: %w", orgID, err)
+	}
+	return nil
+}
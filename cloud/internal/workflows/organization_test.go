@@ -0,0 +1,120 @@
+package workflows
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestDeleteOrganizationWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	env.RegisterWorkflow(DeleteNamespaceWorkflow)
+	now := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(now)
+
+	archive := config.AuditArchiveConfig{SinkType: "s3", Config: json.RawMessage(`{"bucket":"audit","region":"us-east-1"}`), Format: "ndjson"}
+	namespaces := []DeleteNamespaceInput{
+		{NamespaceID: "orders.acct", OrganizationID: "org-1", ClusterID: "cluster-1"},
+		{NamespaceID: "payments.acct", OrganizationID: "org-1"},
+	}
+	var a *Activities
+	env.OnActivity(a.BeginOrganizationDeletionActivity, mock.Anything, "org-1").Return(true, nil).Once()
+	env.OnActivity(a.RevokeOrganizationAPIKeysActivity, mock.Anything, "org-1").Return(int64(3), nil).Once()
+	env.OnActivity(a.ListNamespacesToDeleteActivity, mock.Anything, "org-1").Return(namespaces, nil).Once()
+	// Only the placed namespace is drained on its cluster.
+	env.OnActivity(a.DeprecateNamespaceActivity, mock.Anything,
+		ClusterNamespaceInput{ClusterID: "cluster-1", NamespaceID: "orders.acct"}).Return(nil).Once()
+	env.OnActivity(a.DeleteClusterNamespaceActivity, mock.Anything,
+		ClusterNamespaceInput{ClusterID: "cluster-1", NamespaceID: "orders.acct"}).Return(nil).Once()
+	env.OnActivity(a.ArchiveNamespaceActivity, mock.Anything, mock.Anything).Return(nil).Twice()
+	env.OnActivity(a.RemoveDNSRecordActivity, mock.Anything, mock.Anything).Return(nil).Twice()
+	env.OnActivity(a.UpdateNamespaceStateActivity, mock.Anything, mock.MatchedBy(func(input UpdateNamespaceStateInput) bool {
+		return input.State == "deleted"
+	})).Return(nil).Twice()
+	env.OnActivity(a.GenerateFinalInvoiceActivity, mock.Anything, "org-1").Return("inv-1", nil).Once()
+	env.OnActivity(a.ReportStripeUsageActivity, mock.Anything, ReportStripeUsageInput{
+		OrganizationID: "org-1",
+		InvoiceID:      "inv-1",
+	}).Return(nil).Once()
+	env.OnActivity(a.ArchiveAuditLogActivity, mock.Anything, mock.MatchedBy(func(input ArchiveAuditLogInput) bool {
+		// Events up to the end of teardown are exported.
+		return input.OrganizationID == "org-1" && input.Archive.SinkType == "s3" && input.End.After(now.Add(24*time.Hour))
+	})).Return(42, nil).Once()
+	env.OnActivity(a.CompleteOrganizationDeletionActivity, mock.Anything, "org-1").Return(nil).Once()
+
+	env.ExecuteWorkflow(DeleteOrganizationWorkflow, DeleteOrganizationInput{
+		OrganizationID: "org-1",
+		DeleteAfter:    now.Add(24 * time.Hour),
+		AuditArchive:   archive,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestDeleteOrganizationWorkflowCancelled(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	now := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(now)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(CancelOrganizationDeletionSignal, nil)
+	}, 2*24*time.Hour)
+
+	env.ExecuteWorkflow(DeleteOrganizationWorkflow, DeleteOrganizationInput{
+		OrganizationID: "org-1",
+		DeleteAfter:    now.Add(7 * 24 * time.Hour),
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertNotCalled(t, "BeginOrganizationDeletionActivity", mock.Anything, mock.Anything)
+}
+
+func TestDeleteOrganizationWorkflowStopsIfUnscheduled(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	// The deletion was cancelled but the signal never arrived.
+	var a *Activities
+	env.OnActivity(a.BeginOrganizationDeletionActivity, mock.Anything, "org-1").Return(false, nil).Once()
+
+	env.ExecuteWorkflow(DeleteOrganizationWorkflow, DeleteOrganizationInput{OrganizationID: "org-1"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+	env.AssertNotCalled(t, "RevokeOrganizationAPIKeysActivity", mock.Anything, mock.Anything)
+}
+
+func TestDeleteOrganizationWorkflowKeepsOrganizationIfNamespaceFails(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	env.RegisterWorkflow(DeleteNamespaceWorkflow)
+
+	var a *Activities
+	env.OnActivity(a.BeginOrganizationDeletionActivity, mock.Anything, "org-1").Return(true, nil)
+	env.OnActivity(a.RevokeOrganizationAPIKeysActivity, mock.Anything, "org-1").Return(int64(0), nil)
+	env.OnActivity(a.ListNamespacesToDeleteActivity, mock.Anything, "org-1").Return([]DeleteNamespaceInput{
+		{NamespaceID: "orders.acct", OrganizationID: "org-1"},
+	}, nil)
+	env.OnActivity(a.ArchiveNamespaceActivity, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.RemoveDNSRecordActivity, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.UpdateNamespaceStateActivity, mock.Anything, mock.Anything).Return(temporal.NewNonRetryableApplicationError("database unavailable", "", nil))
+
+	env.ExecuteWorkflow(DeleteOrganizationWorkflow, DeleteOrganizationInput{OrganizationID: "org-1"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "1 of 1 namespaces")
+	env.AssertNotCalled(t, "GenerateFinalInvoiceActivity", mock.Anything, mock.Anything)
+	env.AssertNotCalled(t, "CompleteOrganizationDeletionActivity", mock.Anything, mock.Anything)
+}
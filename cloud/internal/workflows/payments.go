@@ -0,0 +1,77 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+// PaymentEventSignal is the signal DunningWorkflow receives payment events on.
+const PaymentEventSignal = "payment-event"
+
+// PaymentEvent reports the outcome of a payment attempt for an invoice.
+type PaymentEvent struct {
+	InvoiceID string
+	Paid      bool
+}
+
+// DunningWorkflowID returns the ID of the dunning workflow of an invoice.
+func DunningWorkflowID(invoiceID string) string {
+	return "dunning-" + invoiceID
+}
+
+// PaymentNotifier forwards invoice payment events to dunning workflows. It
+// implements service.PaymentNotifier.
+type PaymentNotifier struct {
+	client    client.Client
+	taskQueue string
+}
+
+// NewPaymentNotifier creates a payment notifier that starts dunning workflows
+// on taskQueue.
+func NewPaymentNotifier(c client.Client, taskQueue string) *PaymentNotifier {
+	return &PaymentNotifier{client: c, taskQueue: taskQueue}
+}
+
+// InvoicePaymentFailed starts dunning for the invoice, or tells the running
+// dunning workflow about the failure. An invoice that already went through
+// dunning is not dunned again.
+func (n *PaymentNotifier) InvoicePaymentFailed(ctx context.Context, orgID, invoiceID uuid.UUID) error {
+	_, err := n.client.SignalWithStartWorkflow(ctx, DunningWorkflowID(invoiceID.String()), PaymentEventSignal,
+		PaymentEvent{InvoiceID: invoiceID.String()},
+		client.StartWorkflowOptions{
+			TaskQueue:             n.taskQueue,
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		},
+		DunningWorkflow, DunningInput{
+			OrganizationID: orgID.String(),
+			InvoiceID:      invoiceID.String(),
+		})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to start dunning for invoice %s: %w", invoiceID, err)
+	}
+	return nil
+}
+
+// InvoicePaid stops dunning of the invoice, if any.
+func (n *PaymentNotifier) InvoicePaid(ctx context.Context, orgID, invoiceID uuid.UUID) error {
+	err := n.client.SignalWorkflow(ctx, DunningWorkflowID(invoiceID.String()), "", PaymentEventSignal,
+		PaymentEvent{InvoiceID: invoiceID.String(), Paid: true})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to signal dunning for invoice %s: %w", invoiceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/temporaltest"
+)
+
+func TestPaymentNotifier(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t))
+	c := ts.GetDefaultClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// No worker polls the task queue, so the workflow just waits for its
+	// first workflow task, which is enough to observe the signals.
+	notifier := NewPaymentNotifier(c, "billing")
+	orgID, invoiceID := uuid.New(), uuid.New()
+
+	// Paying an invoice that was never dunned is a no-op.
+	require.NoError(t, notifier.InvoicePaid(ctx, orgID, invoiceID))
+
+	require.NoError(t, notifier.InvoicePaymentFailed(ctx, orgID, invoiceID))
+	require.NoError(t, notifier.InvoicePaymentFailed(ctx, orgID, invoiceID))
+	require.NoError(t, notifier.InvoicePaid(ctx, orgID, invoiceID))
+
+	desc, err := c.DescribeWorkflowExecution(ctx, DunningWorkflowID(invoiceID.String()), "")
+	require.NoError(t, err)
+	require.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, desc.GetWorkflowExecutionInfo().GetStatus())
+
+	var signals int
+	iter := c.GetWorkflowHistory(ctx, DunningWorkflowID(invoiceID.String()), "", false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		require.NoError(t, err)
+		if event.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED {
+			require.Equal(t, PaymentEventSignal, event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+			signals++
+		}
+	}
+	require.Equal(t, 3, signals)
+}
@@ -2,13 +2,26 @@
 package workflows
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
+// Change IDs of the versioned changes to the namespace workflows.
+const (
+	registerNamespaceConfigChangeID = "register-namespace-config"
+	deleteClusterNamespaceChangeID  = "delete-cluster-namespace"
+)
+
 // ProvisionNamespaceInput is the input for the provision namespace workflow.
 type ProvisionNamespaceInput struct {
 	NamespaceID    string
@@ -18,6 +31,9 @@ type ProvisionNamespaceInput struct {
 	RetentionDays  int
 	HAEnabled      bool
 	StandbyRegion  string
+	// SearchAttributes maps custom search attribute names to their types.
+	SearchAttributes   map[string]string
+	CertificateFilters []CertificateFilter
 }
 
 // ProvisionNamespaceOutput is the output of the provision namespace workflow.
@@ -29,8 +45,10 @@ type ProvisionNamespaceOutput struct {
 	ClusterID       string
 }
 
-// ProvisionNamespaceWorkflow provisions a new namespace.
-func ProvisionNamespaceWorkflow(ctx workflow.Context, input ProvisionNamespaceInput) (*ProvisionNamespaceOutput, error) {
+// ProvisionNamespaceWorkflow provisions a new namespace. A namespace that
+// cannot be provisioned is marked failed, along with the cluster it was placed
+// on, so that it can be deleted.
+func ProvisionNamespaceWorkflow(ctx workflow.Context, input ProvisionNamespaceInput) (_ *ProvisionNamespaceOutput, retErr error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting namespace provisioning", "namespace_id", input.NamespaceID)
 
@@ -46,9 +64,23 @@ func ProvisionNamespaceWorkflow(ctx workflow.Context, input ProvisionNamespaceIn
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
-	// Step 1: Select target cluster
 	var clusterID string
 	var a *Activities
+	defer func() {
+		if retErr == nil {
+			return
+		}
+		err := workflow.ExecuteActivity(ctx, a.UpdateNamespaceStateActivity, UpdateNamespaceStateInput{
+			NamespaceID: input.NamespaceID,
+			State:       "failed",
+			ClusterID:   clusterID,
+		}).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to mark namespace failed", "namespace_id", input.NamespaceID, "error", err)
+		}
+	}()
+
+	// Step 1: Select target cluster
 	err := workflow.ExecuteActivity(ctx, a.SelectClusterActivity, SelectClusterInput{
 		Region:    input.Region,
 		HAEnabled: input.HAEnabled,
@@ -68,13 +100,21 @@ func ProvisionNamespaceWorkflow(ctx workflow.Context, input ProvisionNamespaceIn
 	}
 
 	// Step 3: Register namespace in Temporal cluster
-	var registerOutput RegisterNamespaceOutput
-	err = workflow.ExecuteActivity(ctx, a.RegisterNamespaceActivity, RegisterNamespaceInput{
+	register := RegisterNamespaceInput{
 		ClusterID:     clusterID,
 		NamespaceID:   input.NamespaceID,
 		Name:          input.Name,
 		RetentionDays: input.RetentionDays,
-	}).Get(ctx, &registerOutput)
+	}
+	// Workflows started before the namespace configuration was registered
+	// along with the namespace keep registering the bare namespace.
+	if workflow.GetVersion(ctx, registerNamespaceConfigChangeID, workflow.DefaultVersion, 1) >= 1 {
+		register.SearchAttributes = input.SearchAttributes
+		register.CertificateFilters = append(slices.Clone(input.CertificateFilters), certOutput.CertificateFilters...)
+		register.CABundlePEM = certOutput.CertificatePEM
+	}
+	var registerOutput RegisterNamespaceOutput
+	err = workflow.ExecuteActivity(ctx, a.RegisterNamespaceActivity, register).Get(ctx, &registerOutput)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register namespace: %w", err)
 	}
@@ -131,6 +171,8 @@ func ProvisionNamespaceWorkflow(ctx workflow.Context, input ProvisionNamespaceIn
 type DeleteNamespaceInput struct {
 	NamespaceID    string
 	OrganizationID string
+	// ClusterID is the cluster hosting the namespace, empty if it was never placed.
+	ClusterID string
 }
 
 // DeleteNamespaceWorkflow deletes a namespace.
@@ -149,29 +191,83 @@ func DeleteNamespaceWorkflow(ctx workflow.Context, input DeleteNamespaceInput) e
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
-	// Step 1: Deprecate namespace in Temporal cluster
 	var a *Activities
-	err := workflow.ExecuteActivity(ctx, a.DeprecateNamespaceActivity, input.NamespaceID).Get(ctx, nil)
+	clusterNamespace := ClusterNamespaceInput{
+		ClusterID:   input.ClusterID,
+		NamespaceID: input.NamespaceID,
+	}
+
+	if workflow.GetVersion(ctx, deleteClusterNamespaceChangeID, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		return deleteNamespaceV0(ctx, input, clusterNamespace)
+	}
+
+	if input.ClusterID != "" {
+		// Step 1: Deprecate namespace in Temporal cluster
+		err := workflow.ExecuteActivity(ctx, a.DeprecateNamespaceActivity, clusterNamespace).Get(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to deprecate namespace: %w", err)
+		}
+
+		// Step 2: Wait for workflows to drain (optional, with timeout)
+		_ = workflow.Sleep(ctx, 5*time.Minute)
+	}
+
+	// Step 3: Archive namespace data to S3
+	err := workflow.ExecuteActivity(ctx, a.ArchiveNamespaceActivity, input.NamespaceID).Get(ctx, nil)
+	if err != nil {
+		logger.Warn("Failed to archive namespace", "error", err)
+	}
+
+	// Step 4: Delete namespace from Temporal cluster
+	if input.ClusterID != "" {
+		err = workflow.ExecuteActivity(ctx, a.DeleteClusterNamespaceActivity, clusterNamespace).Get(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to delete namespace from cluster: %w", err)
+		}
+	}
+
+	// Step 5: Remove DNS record
+	err = workflow.ExecuteActivity(ctx, a.RemoveDNSRecordActivity, input.NamespaceID).Get(ctx, nil)
+	if err != nil {
+		logger.Warn("Failed to remove DNS record", "error", err)
+	}
+
+	// Step 6: Update namespace state to deleted
+	err = workflow.ExecuteActivity(ctx, a.UpdateNamespaceStateActivity, UpdateNamespaceStateInput{
+		NamespaceID: input.NamespaceID,
+		State:       "deleted",
+	}).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update namespace state: %w", err)
+	}
+
+	logger.Info("Namespace deletion completed", "namespace_id", input.NamespaceID)
+	return nil
+}
+
+// deleteNamespaceV0 runs the steps of deletions started before namespaces
+// were deleted from their cluster, in their original order.
+func deleteNamespaceV0(ctx workflow.Context, input DeleteNamespaceInput, clusterNamespace ClusterNamespaceInput) error {
+	logger := workflow.GetLogger(ctx)
+	var a *Activities
+
+	err := workflow.ExecuteActivity(ctx, a.DeprecateNamespaceActivity, clusterNamespace).Get(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to deprecate namespace: %w", err)
 	}
 
-	// Step 2: Wait for workflows to drain (optional, with timeout)
 	_ = workflow.Sleep(ctx, 5*time.Minute)
 
-	// Step 3: Remove DNS record
 	err = workflow.ExecuteActivity(ctx, a.RemoveDNSRecordActivity, input.NamespaceID).Get(ctx, nil)
 	if err != nil {
 		logger.Warn("Failed to remove DNS record", "error", err)
 	}
 
-	// Step 4: Archive namespace data to S3
 	err = workflow.ExecuteActivity(ctx, a.ArchiveNamespaceActivity, input.NamespaceID).Get(ctx, nil)
 	if err != nil {
 		logger.Warn("Failed to archive namespace", "error", err)
 	}
 
-	// Step 5: Update namespace state to deleted
 	err = workflow.ExecuteActivity(ctx, a.UpdateNamespaceStateActivity, UpdateNamespaceStateInput{
 		NamespaceID: input.NamespaceID,
 		State:       "deleted",
@@ -184,14 +280,92 @@ func DeleteNamespaceWorkflow(ctx workflow.Context, input DeleteNamespaceInput) e
 	return nil
 }
 
+// UpdateNamespaceInput is the input for the update namespace workflow.
+type UpdateNamespaceInput struct {
+	NamespaceID   string
+	ClusterID     string
+	RetentionDays int
+	// SearchAttributes maps the custom search attributes to add to their types.
+	SearchAttributes map[string]string
+}
+
+// UpdateNamespaceWorkflow applies a namespace's retention and new search
+// attributes to the cluster hosting it. The namespace is made active again
+// even if the cluster could not be updated, so that the change can be retried.
+func UpdateNamespaceWorkflow(ctx workflow.Context, input UpdateNamespaceInput) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting namespace update", "namespace_id", input.NamespaceID)
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var a *Activities
+	updateErr := workflow.ExecuteActivity(ctx, a.UpdateClusterNamespaceActivity, input).Get(ctx, nil)
+	if updateErr != nil {
+		logger.Error("Failed to update namespace on cluster", "namespace_id", input.NamespaceID, "error", updateErr)
+	}
+
+	err := workflow.ExecuteActivity(ctx, a.UpdateNamespaceStateActivity, UpdateNamespaceStateInput{
+		NamespaceID: input.NamespaceID,
+		State:       "active",
+	}).Get(ctx, nil)
+	if updateErr != nil {
+		return fmt.Errorf("failed to update namespace on cluster: %w", updateErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update namespace state: %w", err)
+	}
+
+	logger.Info("Namespace update completed", "namespace_id", input.NamespaceID)
+	return nil
+}
+
 // FailoverNamespaceInput is the input for the failover workflow.
 type FailoverNamespaceInput struct {
 	NamespaceID  string
 	TargetRegion string
+	// ClusterID is any cluster the namespace is replicated to. It is used to
+	// look up the namespace's current replication config.
+	ClusterID string
 }
 
-// FailoverNamespaceWorkflow performs a namespace failover.
-func FailoverNamespaceWorkflow(ctx workflow.Context, input FailoverNamespaceInput) error {
+// FailoverPlan describes the clusters a failover moves a namespace between.
+type FailoverPlan struct {
+	NamespaceID       string
+	SourceClusterID   string
+	SourceClusterName string
+	SourceRegion      string
+	TargetClusterID   string
+	TargetClusterName string
+	TargetRegion      string
+}
+
+// Reverse returns the plan that moves the namespace back.
+func (p FailoverPlan) Reverse() FailoverPlan {
+	return FailoverPlan{
+		NamespaceID:       p.NamespaceID,
+		SourceClusterID:   p.TargetClusterID,
+		SourceClusterName: p.TargetClusterName,
+		SourceRegion:      p.TargetRegion,
+		TargetClusterID:   p.SourceClusterID,
+		TargetClusterName: p.SourceClusterName,
+		TargetRegion:      p.SourceRegion,
+	}
+}
+
+// FailoverNamespaceWorkflow performs a graceful namespace failover. The active
+// cluster is fenced once the standby has caught up, the standby is promoted and
+// DNS is switched over. If any step after fencing fails, the completed steps
+// are rolled back so that the namespace is active on its original cluster.
+func FailoverNamespaceWorkflow(ctx workflow.Context, input FailoverNamespaceInput) (retErr error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting namespace failover", "namespace_id", input.NamespaceID, "target", input.TargetRegion)
 
@@ -206,38 +380,212 @@ func FailoverNamespaceWorkflow(ctx workflow.Context, input FailoverNamespaceInpu
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
-	// Step 1: Verify standby is ready
+	// Step 1: Resolve source and target clusters
 	var a *Activities
-	err := workflow.ExecuteActivity(ctx, a.VerifyStandbyReadyActivity, input).Get(ctx, nil)
+	var plan FailoverPlan
+	err := workflow.ExecuteActivity(ctx, a.PlanFailoverActivity, input).Get(ctx, &plan)
+	if err != nil {
+		return fmt.Errorf("failed to plan failover: %w", err)
+	}
+
+	// Step 2: Wait for the standby to catch up on replication
+	waitCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    30 * time.Second,
+		RetryPolicy:         ao.RetryPolicy,
+	})
+	err = workflow.ExecuteActivity(waitCtx, a.VerifyStandbyReadyActivity, plan).Get(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("standby not ready: %w", err)
 	}
 
-	// Step 2: Fence primary (stop accepting writes)
-	err = workflow.ExecuteActivity(ctx, a.FencePrimaryActivity, input.NamespaceID).Get(ctx, nil)
+	var promoted, dnsUpdated bool
+	defer func() {
+		if retErr != nil {
+			if err := rollbackFailover(ctx, plan, promoted, dnsUpdated); err != nil {
+				logger.Error("Failover rollback failed", "namespace_id", input.NamespaceID, "error", err)
+				retErr = fmt.Errorf("%w (rollback failed: %v)", retErr, err)
+			}
+		}
+	}()
+
+	// Step 3: Fence primary (stop accepting writes) and drain replication.
+	// The namespace is unavailable until it is promoted or unfenced, so this
+	// is not retried.
+	fenceCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		HeartbeatTimeout:    30 * time.Second,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 1},
+	})
+	err = workflow.ExecuteActivity(fenceCtx, a.FencePrimaryActivity, plan).Get(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to fence primary: %w", err)
 	}
 
-	// Step 3: Promote standby
-	err = workflow.ExecuteActivity(ctx, a.PromoteStandbyActivity, input).Get(ctx, nil)
+	// Step 4: Promote standby
+	err = workflow.ExecuteActivity(ctx, a.PromoteStandbyActivity, plan).Get(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to promote standby: %w", err)
 	}
+	promoted = true
 
-	// Step 4: Update DNS to point to new primary
-	err = workflow.ExecuteActivity(ctx, a.UpdateDNSForFailoverActivity, input).Get(ctx, nil)
+	// Step 5: Update DNS to point to new primary
+	err = workflow.ExecuteActivity(ctx, a.UpdateDNSForFailoverActivity, FailoverNamespaceInput{
+		NamespaceID:  plan.NamespaceID,
+		TargetRegion: plan.TargetRegion,
+	}).Get(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to update DNS: %w", err)
 	}
+	dnsUpdated = true
+
+	// Step 6: Verify traffic switched
+	err = workflow.ExecuteActivity(ctx, a.VerifyTrafficSwitchedActivity, plan).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("traffic verification failed: %w", err)
+	}
+
+	logger.Info("Namespace failover completed", "namespace_id", input.NamespaceID, "cluster", plan.TargetClusterName)
+	return nil
+}
+
+// rollbackFailover undoes the completed steps of a failed failover, newest
+// first. It runs on a disconnected context so that a cancelled failover is
+// rolled back too.
+func rollbackFailover(ctx workflow.Context, plan FailoverPlan, promoted, dnsUpdated bool) error {
+	ctx, cancel := workflow.NewDisconnectedContext(ctx)
+	defer cancel()
+
+	var a *Activities
+	if dnsUpdated {
+		err := workflow.ExecuteActivity(ctx, a.UpdateDNSForFailoverActivity, FailoverNamespaceInput{
+			NamespaceID:  plan.NamespaceID,
+			TargetRegion: plan.SourceRegion,
+		}).Get(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to restore DNS: %w", err)
+		}
+	}
+	if promoted {
+		err := workflow.ExecuteActivity(ctx, a.PromoteStandbyActivity, plan.Reverse()).Get(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to fail back to cluster %s: %w", plan.SourceClusterName, err)
+		}
+	}
+	err := workflow.ExecuteActivity(ctx, a.UnfencePrimaryActivity, plan).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to unfence cluster %s: %w", plan.SourceClusterName, err)
+	}
+	return nil
+}
+
+// NamespaceFailoverWorkflowID returns the ID of the failover workflow of a
+// namespace. Only one failover of a namespace runs at a time.
+func NamespaceFailoverWorkflowID(namespaceID string) string {
+	return "namespace-failover-" + namespaceID
+}
+
+// NamespaceFailoverStarter starts namespace failover workflows. It implements
+// service.NamespaceFailoverStarter.
+type NamespaceFailoverStarter struct {
+	client    client.Client
+	taskQueue string
+}
+
+// NewNamespaceFailoverStarter creates a failover starter that runs failover
+// workflows on taskQueue.
+func NewNamespaceFailoverStarter(c client.Client, taskQueue string) *NamespaceFailoverStarter {
+	return &NamespaceFailoverStarter{client: c, taskQueue: taskQueue}
+}
+
+// StartFailover starts failing the namespace over to targetRegion and returns
+// the run ID of the failover workflow.
+func (s *NamespaceFailoverStarter) StartFailover(ctx context.Context, namespaceID, targetRegion, clusterID string) (string, error) {
+	run, err := s.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                                       NamespaceFailoverWorkflowID(namespaceID),
+		TaskQueue:                                s.taskQueue,
+		WorkflowIDConflictPolicy:                 enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}, FailoverNamespaceWorkflow, FailoverNamespaceInput{
+		NamespaceID:  namespaceID,
+		TargetRegion: targetRegion,
+		ClusterID:    clusterID,
+	})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return "", serviceerror.NewFailedPreconditionf("a failover of namespace %s is already in progress", namespaceID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to start failover of namespace %s: %w", namespaceID, err)
+	}
+	return run.GetRunID(), nil
+}
+
+// NamespaceOperationStarter starts the workflows that apply namespace changes
+// to the Temporal clusters, using the operation IDs as workflow IDs. It
+// implements service.NamespaceOperationStarter.
+type NamespaceOperationStarter struct {
+	client    client.Client
+	taskQueue string
+}
+
+// NewNamespaceOperationStarter creates an operation starter that runs
+// namespace workflows on taskQueue.
+func NewNamespaceOperationStarter(c client.Client, taskQueue string) *NamespaceOperationStarter {
+	return &NamespaceOperationStarter{client: c, taskQueue: taskQueue}
+}
+
+// StartProvisioning starts provisioning the namespace.
+func (s *NamespaceOperationStarter) StartProvisioning(ctx context.Context, operationID string, ns *repository.Namespace) error {
+	_, err := s.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        operationID,
+		TaskQueue: s.taskQueue,
+	}, ProvisionNamespaceWorkflow, ProvisionNamespaceInput{
+		NamespaceID:    ns.ID,
+		OrganizationID: ns.OrganizationID.String(),
+		Name:           ns.Name,
+		Region:         ns.Region,
+		RetentionDays:  ns.RetentionDays,
+		HAEnabled:      ns.HAEnabled,
+		StandbyRegion:  ns.StandbyRegion.String,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start provisioning of namespace %s: %w", ns.ID, err)
+	}
+	return nil
+}
 
-	// Step 5: Verify traffic switched
-	err = workflow.ExecuteActivity(ctx, a.VerifyTrafficSwitchedActivity, input).Get(ctx, nil)
+// StartUpdate starts applying the namespace's retention and the given search
+// attributes to the cluster hosting it.
+func (s *NamespaceOperationStarter) StartUpdate(ctx context.Context, operationID string, ns *repository.Namespace, searchAttributes map[string]string) error {
+	_, err := s.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        operationID,
+		TaskQueue: s.taskQueue,
+	}, UpdateNamespaceWorkflow, UpdateNamespaceInput{
+		NamespaceID:      ns.ID,
+		ClusterID:        ns.ClusterID.String,
+		RetentionDays:    ns.RetentionDays,
+		SearchAttributes: searchAttributes,
+	})
 	if err != nil {
-		logger.Warn("Traffic verification failed", "error", err)
+		return fmt.Errorf("failed to start update of namespace %s: %w", ns.ID, err)
 	}
+	return nil
+}
 
-	logger.Info("Namespace failover completed", "namespace_id", input.NamespaceID)
+// StartDeletion starts deleting the namespace.
+func (s *NamespaceOperationStarter) StartDeletion(ctx context.Context, operationID string, ns *repository.Namespace) error {
+	_, err := s.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        operationID,
+		TaskQueue: s.taskQueue,
+	}, DeleteNamespaceWorkflow, DeleteNamespaceInput{
+		NamespaceID:    ns.ID,
+		OrganizationID: ns.OrganizationID.String(),
+		ClusterID:      ns.ClusterID.String,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start deletion of namespace %s: %w", ns.ID, err)
+	}
 	return nil
 }
 
@@ -254,15 +602,30 @@ type GenerateCertificatesInput struct {
 }
 
 type GenerateCertificatesOutput struct {
+	// CertificatePEM is the bundle of client CAs trusted by the namespace.
 	CertificatePEM string
-	Fingerprint    string
+	// Fingerprint is the fingerprint of the namespace's current CA.
+	Fingerprint        string
+	CertificateFilters []CertificateFilter
 }
 
 type RegisterNamespaceInput struct {
-	ClusterID     string
-	NamespaceID   string
-	Name          string
-	RetentionDays int
+	ClusterID          string
+	NamespaceID        string
+	Name               string
+	RetentionDays      int
+	SearchAttributes   map[string]string
+	CertificateFilters []CertificateFilter
+	CABundlePEM        string
+}
+
+// CertificateFilter restricts the client certificates accepted by a namespace.
+// Empty fields match any value.
+type CertificateFilter struct {
+	CommonName             string `json:"common_name,omitempty"`
+	Organization           string `json:"organization,omitempty"`
+	OrganizationalUnit     string `json:"organizational_unit,omitempty"`
+	SubjectAlternativeName string `json:"subject_alternative_name,omitempty"`
 }
 
 type RegisterNamespaceOutput struct {
@@ -295,3 +658,8 @@ type SetupStandbyInput struct {
 	PrimaryRegion string
 	StandbyRegion string
 }
+
+type ClusterNamespaceInput struct {
+	ClusterID   string
+	NamespaceID string
+}
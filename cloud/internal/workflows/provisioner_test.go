@@ -0,0 +1,297 @@
+package workflows
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/cloud/internal/repository"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/temporaltest"
+)
+
+var testFailoverPlan = FailoverPlan{
+	NamespaceID:       "orders.abcd1234",
+	SourceClusterID:   "use1",
+	SourceClusterName: "use1",
+	SourceRegion:      "us-east-1",
+	TargetClusterID:   "usw2",
+	TargetClusterName: "usw2",
+	TargetRegion:      "us-west-2",
+}
+
+func newFailoverTestEnv(t *testing.T) *testsuite.TestWorkflowEnvironment {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.PlanFailoverActivity, mock.Anything, mock.Anything).Return(&testFailoverPlan, nil)
+	env.OnActivity(a.VerifyStandbyReadyActivity, mock.Anything, testFailoverPlan).Return(nil)
+	env.OnActivity(a.FencePrimaryActivity, mock.Anything, testFailoverPlan).Return(nil)
+	env.OnActivity(a.PromoteStandbyActivity, mock.Anything, testFailoverPlan).Return(nil)
+	env.OnActivity(a.UpdateDNSForFailoverActivity, mock.Anything,
+		FailoverNamespaceInput{NamespaceID: "orders.abcd1234", TargetRegion: "us-west-2"}).Return(nil)
+	return env
+}
+
+func TestFailoverNamespaceWorkflow(t *testing.T) {
+	env := newFailoverTestEnv(t)
+	var a *Activities
+	env.OnActivity(a.VerifyTrafficSwitchedActivity, mock.Anything, testFailoverPlan).Return(nil)
+
+	env.ExecuteWorkflow(FailoverNamespaceWorkflow, FailoverNamespaceInput{
+		NamespaceID:  "orders.abcd1234",
+		TargetRegion: "us-west-2",
+		ClusterID:    "use1",
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestFailoverNamespaceWorkflowRollsBack(t *testing.T) {
+	env := newFailoverTestEnv(t)
+	var a *Activities
+	env.OnActivity(a.VerifyTrafficSwitchedActivity, mock.Anything, testFailoverPlan).Return(errors.New("no traffic"))
+	// Rollback restores DNS, fails back and unfences the original cluster.
+	env.OnActivity(a.UpdateDNSForFailoverActivity, mock.Anything,
+		FailoverNamespaceInput{NamespaceID: "orders.abcd1234", TargetRegion: "us-east-1"}).Return(nil).Once()
+	env.OnActivity(a.PromoteStandbyActivity, mock.Anything, testFailoverPlan.Reverse()).Return(nil).Once()
+	env.OnActivity(a.UnfencePrimaryActivity, mock.Anything, testFailoverPlan).Return(nil).Once()
+
+	env.ExecuteWorkflow(FailoverNamespaceWorkflow, FailoverNamespaceInput{
+		NamespaceID:  "orders.abcd1234",
+		TargetRegion: "us-west-2",
+		ClusterID:    "use1",
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "traffic verification failed")
+	env.AssertExpectations(t)
+}
+
+func TestFailoverNamespaceWorkflowFenceFailure(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.PlanFailoverActivity, mock.Anything, mock.Anything).Return(&testFailoverPlan, nil)
+	env.OnActivity(a.VerifyStandbyReadyActivity, mock.Anything, testFailoverPlan).Return(nil)
+	env.OnActivity(a.FencePrimaryActivity, mock.Anything, testFailoverPlan).Return(errors.New("handover timed out"))
+	env.OnActivity(a.UnfencePrimaryActivity, mock.Anything, testFailoverPlan).Return(nil).Once()
+
+	env.ExecuteWorkflow(FailoverNamespaceWorkflow, FailoverNamespaceInput{
+		NamespaceID:  "orders.abcd1234",
+		TargetRegion: "us-west-2",
+		ClusterID:    "use1",
+	})
+	require.ErrorContains(t, env.GetWorkflowError(), "failed to fence primary")
+	env.AssertExpectations(t)
+}
+
+func TestNamespaceFailoverStarter(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t))
+	c := ts.GetDefaultClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// No worker polls the task queue, so the first failover stays running.
+	starter := NewNamespaceFailoverStarter(c, "provisioning")
+	runID, err := starter.StartFailover(ctx, "orders.abcd1234", "us-west-2", "use1")
+	require.NoError(t, err)
+	require.NotEmpty(t, runID)
+
+	_, err = starter.StartFailover(ctx, "orders.abcd1234", "us-west-2", "use1")
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPrecondition)
+
+	desc, err := c.DescribeWorkflowExecution(ctx, NamespaceFailoverWorkflowID("orders.abcd1234"), "")
+	require.NoError(t, err)
+	require.Equal(t, runID, desc.GetWorkflowExecutionInfo().GetExecution().GetRunId())
+}
+
+func TestUpdateNamespaceWorkflow(t *testing.T) {
+	input := UpdateNamespaceInput{
+		NamespaceID:      "orders.abcd1234",
+		ClusterID:        "use1",
+		RetentionDays:    30,
+		SearchAttributes: map[string]string{"CustomerId": "keyword"},
+	}
+	for _, tt := range []struct {
+		name      string
+		updateErr error
+		wantErr   string
+	}{
+		{name: "updated"},
+		{name: "cluster update failed", updateErr: errors.New("cluster unavailable"), wantErr: "failed to update namespace on cluster"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var suite testsuite.WorkflowTestSuite
+			env := suite.NewTestWorkflowEnvironment()
+			env.RegisterActivity(&Activities{})
+
+			var a *Activities
+			env.OnActivity(a.UpdateClusterNamespaceActivity, mock.Anything, input).Return(tt.updateErr)
+			// The namespace is made active again either way.
+			env.OnActivity(a.UpdateNamespaceStateActivity, mock.Anything,
+				UpdateNamespaceStateInput{NamespaceID: "orders.abcd1234", State: "active"}).Return(nil).Once()
+
+			env.ExecuteWorkflow(UpdateNamespaceWorkflow, input)
+			require.True(t, env.IsWorkflowCompleted())
+			if tt.wantErr == "" {
+				require.NoError(t, env.GetWorkflowError())
+			} else {
+				require.ErrorContains(t, env.GetWorkflowError(), tt.wantErr)
+			}
+			env.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNamespaceOperationStarter(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t))
+	c := ts.GetDefaultClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	starter := NewNamespaceOperationStarter(c, "provisioning")
+	ns := &repository.Namespace{
+		ID:             "orders.abcd1234",
+		OrganizationID: uuid.New(),
+		Name:           "orders",
+		Region:         "us-east-1",
+		ClusterID:      sql.NullString{String: "use1", Valid: true},
+		RetentionDays:  30,
+	}
+	require.NoError(t, starter.StartProvisioning(ctx, "op-provision", ns))
+	require.NoError(t, starter.StartUpdate(ctx, "op-update", ns, map[string]string{"CustomerId": "keyword"}))
+	require.NoError(t, starter.StartDeletion(ctx, "op-delete", ns))
+
+	// The operation IDs name the workflows applying the changes.
+	for operationID, workflowType := range map[string]string{
+		"op-provision": "ProvisionNamespaceWorkflow",
+		"op-update":    "UpdateNamespaceWorkflow",
+		"op-delete":    "DeleteNamespaceWorkflow",
+	} {
+		desc, err := c.DescribeWorkflowExecution(ctx, operationID, "")
+		require.NoError(t, err)
+		require.Equal(t, workflowType, desc.GetWorkflowExecutionInfo().GetType().GetName())
+	}
+}
+
+func TestProvisionNamespaceWorkflowVersions(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		version workflow.Version
+		want    RegisterNamespaceInput
+	}{
+		{
+			name:    "legacy",
+			version: workflow.DefaultVersion,
+			want:    RegisterNamespaceInput{ClusterID: "use1", NamespaceID: "orders.abcd1234", Name: "orders", RetentionDays: 7},
+		},
+		{
+			name:    "current",
+			version: 1,
+			want: RegisterNamespaceInput{
+				ClusterID:          "use1",
+				NamespaceID:        "orders.abcd1234",
+				Name:               "orders",
+				RetentionDays:      7,
+				SearchAttributes:   map[string]string{"CustomerId": "keyword"},
+				CertificateFilters: []CertificateFilter{{CommonName: "worker"}, {Organization: "acme"}},
+				CABundlePEM:        "bundle",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var suite testsuite.WorkflowTestSuite
+			env := suite.NewTestWorkflowEnvironment()
+			env.RegisterActivity(&Activities{})
+			env.OnGetVersion(registerNamespaceConfigChangeID, workflow.DefaultVersion, 1).Return(tt.version)
+
+			var a *Activities
+			env.OnActivity(a.SelectClusterActivity, mock.Anything, mock.Anything).Return("use1", nil)
+			env.OnActivity(a.GenerateCertificatesActivity, mock.Anything, mock.Anything).Return(&GenerateCertificatesOutput{
+				CertificatePEM:     "bundle",
+				CertificateFilters: []CertificateFilter{{Organization: "acme"}},
+			}, nil)
+			env.OnActivity(a.RegisterNamespaceActivity, mock.Anything, tt.want).Return(&RegisterNamespaceOutput{Success: true}, nil).Once()
+			env.OnActivity(a.CreateDNSRecordActivity, mock.Anything, mock.Anything).Return(&CreateDNSRecordOutput{}, nil)
+			env.OnActivity(a.UpdateNamespaceStateActivity, mock.Anything, mock.Anything).Return(nil)
+
+			env.ExecuteWorkflow(ProvisionNamespaceWorkflow, ProvisionNamespaceInput{
+				NamespaceID:        "orders.abcd1234",
+				OrganizationID:     "org-1",
+				Name:               "orders",
+				Region:             "us-east-1",
+				RetentionDays:      7,
+				SearchAttributes:   map[string]string{"CustomerId": "keyword"},
+				CertificateFilters: []CertificateFilter{{CommonName: "worker"}},
+			})
+			require.NoError(t, env.GetWorkflowError())
+			env.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProvisionNamespaceWorkflowFailure(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+
+	var a *Activities
+	env.OnActivity(a.SelectClusterActivity, mock.Anything, mock.Anything).Return("use1", nil)
+	env.OnActivity(a.GenerateCertificatesActivity, mock.Anything, mock.Anything).Return(&GenerateCertificatesOutput{}, nil)
+	env.OnActivity(a.RegisterNamespaceActivity, mock.Anything, mock.Anything).Return(nil,
+		temporal.NewNonRetryableApplicationError("bad search attribute", errTypeInvalidSearchAttribute, nil))
+	// The namespace is marked failed with its cluster, so that deleting it
+	// cleans up the cluster.
+	env.OnActivity(a.UpdateNamespaceStateActivity, mock.Anything, UpdateNamespaceStateInput{
+		NamespaceID: "orders.abcd1234",
+		State:       "failed",
+		ClusterID:   "use1",
+	}).Return(nil).Once()
+
+	env.ExecuteWorkflow(ProvisionNamespaceWorkflow, ProvisionNamespaceInput{
+		NamespaceID:   "orders.abcd1234",
+		Name:          "orders",
+		Region:        "us-east-1",
+		RetentionDays: 7,
+	})
+	require.ErrorContains(t, env.GetWorkflowError(), "failed to register namespace")
+	env.AssertExpectations(t)
+}
+
+func TestDeleteNamespaceWorkflowLegacyOrder(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	env.OnGetVersion(deleteClusterNamespaceChangeID, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+
+	var order []string
+	record := func(name string) func(args mock.Arguments) {
+		return func(mock.Arguments) { order = append(order, name) }
+	}
+	input := ClusterNamespaceInput{ClusterID: "use1", NamespaceID: "orders.abcd1234"}
+	var a *Activities
+	env.OnActivity(a.DeprecateNamespaceActivity, mock.Anything, input).Return(nil).Run(record("deprecate")).Once()
+	env.OnActivity(a.RemoveDNSRecordActivity, mock.Anything, "orders.abcd1234").Return(nil).Run(record("dns")).Once()
+	env.OnActivity(a.ArchiveNamespaceActivity, mock.Anything, "orders.abcd1234").Return(nil).Run(record("archive")).Once()
+	env.OnActivity(a.UpdateNamespaceStateActivity, mock.Anything, mock.Anything).Return(nil).Run(record("state")).Once()
+
+	env.ExecuteWorkflow(DeleteNamespaceWorkflow, DeleteNamespaceInput{NamespaceID: "orders.abcd1234", ClusterID: "use1"})
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+	// The cluster namespace is not deleted and DNS goes before archiving.
+	require.Equal(t, []string{"deprecate", "dns", "archive", "state"}, order)
+}
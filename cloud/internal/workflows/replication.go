@@ -0,0 +1,81 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/cloud/internal/config"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// ReplicationStatusSource reports the replication status of every history
+// shard of a cluster.
+type ReplicationStatusSource interface {
+	GetReplicationStatus(ctx context.Context, cluster config.ClusterConfig, remoteClusters []string) (*adminservice.GetReplicationStatusResponse, error)
+}
+
+// adminReplicationStatus asks the admin service of a cluster's frontend, which
+// gathers the status from the cluster's history hosts.
+type adminReplicationStatus struct {
+	clusters *ClusterRegistry
+}
+
+func (s adminReplicationStatus) GetReplicationStatus(ctx context.Context, cluster config.ClusterConfig, remoteClusters []string) (*adminservice.GetReplicationStatusResponse, error) {
+	admin, err := s.clusters.AdminClient(cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := admin.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe cluster %s: %w", cluster.ID, err)
+	}
+	response, err := admin.GetReplicationStatus(ctx, &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replication status of cluster %s: %w", cluster.ID, err)
+	}
+
+	// A shard moving between hosts while the frontend asks can be missed or
+	// reported twice, so make sure the answer covers the cluster exactly once.
+	if shards := int32(len(response.GetShards())); shards != desc.GetHistoryShardCount() {
+		return nil, fmt.Errorf("cluster %s reported replication status for %d of %d shards",
+			cluster.ID, shards, desc.GetHistoryShardCount())
+	}
+	return response, nil
+}
+
+// replicationLag returns how far the remote cluster is behind on the shard
+// furthest behind. An error is returned if a shard has no status for the
+// remote cluster.
+func replicationLag(resp *adminservice.GetReplicationStatusResponse, remoteCluster string) (time.Duration, error) {
+	var lag time.Duration
+	for _, shard := range resp.GetShards() {
+		remote, ok := shard.GetRemoteClusters()[remoteCluster]
+		if !ok {
+			return 0, fmt.Errorf("shard %d has no replication status for cluster %s", shard.GetShardId(), remoteCluster)
+		}
+		if remote.GetAckedTaskId() >= shard.GetMaxReplicationTaskId() {
+			continue
+		}
+		shardLag := shard.GetMaxReplicationTaskVisibilityTime().AsTime().Sub(remote.GetAckedTaskVisibilityTime().AsTime())
+		lag = max(lag, shardLag)
+	}
+	return lag, nil
+}
+
+// handoverComplete reports whether the remote cluster has acknowledged every
+// replication task generated for the namespace before it entered handover.
+func handoverComplete(resp *adminservice.GetReplicationStatusResponse, namespaceID, remoteCluster string) bool {
+	for _, shard := range resp.GetShards() {
+		remote, hasRemote := shard.GetRemoteClusters()[remoteCluster]
+		handover, hasHandover := shard.GetHandoverNamespaces()[namespaceID]
+		// A shard whose namespace cache has not refreshed yet does not report
+		// the handover.
+		if !hasRemote || !hasHandover || remote.GetAckedTaskId() < handover.GetHandoverReplicationTaskId() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReplicationLag(t *testing.T) {
+	now := time.Now()
+	status := &adminservice.GetReplicationStatusResponse{
+		Shards: []*adminservice.GetReplicationStatusResponse_ShardReplicationStatus{
+			{
+				ShardId:                          1,
+				MaxReplicationTaskId:             100,
+				MaxReplicationTaskVisibilityTime: timestamppb.New(now),
+				RemoteClusters: map[string]*adminservice.GetReplicationStatusResponse_ShardReplicationStatusPerCluster{
+					"standby": {AckedTaskId: 100, AckedTaskVisibilityTime: timestamppb.New(now.Add(-time.Hour))},
+				},
+			},
+			{
+				ShardId:                          2,
+				MaxReplicationTaskId:             200,
+				MaxReplicationTaskVisibilityTime: timestamppb.New(now),
+				RemoteClusters: map[string]*adminservice.GetReplicationStatusResponse_ShardReplicationStatusPerCluster{
+					"standby": {AckedTaskId: 150, AckedTaskVisibilityTime: timestamppb.New(now.Add(-10 * time.Second))},
+				},
+			},
+		},
+	}
+
+	// Fully caught up shards do not count towards the lag, however old
+	// their last acked task is.
+	lag, err := replicationLag(status, "standby")
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, lag)
+
+	_, err = replicationLag(status, "other")
+	require.Error(t, err)
+}
+
+func TestHandoverComplete(t *testing.T) {
+	shard := &adminservice.GetReplicationStatusResponse_ShardReplicationStatus{
+		ShardId: 1,
+		RemoteClusters: map[string]*adminservice.GetReplicationStatusResponse_ShardReplicationStatusPerCluster{
+			"standby": {AckedTaskId: 10},
+		},
+	}
+	status := &adminservice.GetReplicationStatusResponse{Shards: []*adminservice.GetReplicationStatusResponse_ShardReplicationStatus{shard}}
+
+	// The shard has not seen the handover yet.
+	require.False(t, handoverComplete(status, "ns", "standby"))
+
+	shard.HandoverNamespaces = map[string]*adminservice.GetReplicationStatusResponse_HandoverNamespaceInfo{
+		"ns": {HandoverReplicationTaskId: 11},
+	}
+	require.False(t, handoverComplete(status, "ns", "standby"))
+
+	shard.RemoteClusters["standby"].AckedTaskId = 11
+	require.True(t, handoverComplete(status, "ns", "standby"))
+	require.False(t, handoverComplete(status, "ns", "other"))
+}
+
+func TestAdminReplicationStatus(t *testing.T) {
+	a, _ := newTestActivities(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The test server is not replicated, so its history service refuses the
+	// request once the frontend has forwarded it.
+	cluster, ok := a.clusters.Cluster("test-cluster")
+	require.True(t, ok)
+	_, err := adminReplicationStatus{clusters: a.clusters}.GetReplicationStatus(ctx, cluster, nil)
+	require.ErrorContains(t, err, "global namespace disabled")
+}
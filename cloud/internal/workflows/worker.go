@@ -0,0 +1,83 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/worker"
+)
+
+// schedule runs a periodic workflow.
+type schedule struct {
+	id       string
+	every    time.Duration
+	workflow any
+	args     []any
+}
+
+// schedules are the periodic workflows of the control plane. Runs that would
+// overlap a still running one are skipped.
+var schedules = []schedule{
+	{id: "export-histories", every: time.Hour, workflow: ExportHistoriesWorkflow, args: []any{ExportHistoriesInput{}}},
+	{id: "meter-usage", every: time.Hour, workflow: MeterUsageWorkflow, args: []any{MeterUsageInput{}}},
+	{id: "expire-credits", every: time.Hour, workflow: ExpireCreditsWorkflow},
+	{id: "sync-connectivity", every: time.Minute, workflow: SyncConnectivityWorkflow},
+	{id: "sync-nexus-endpoints", every: time.Minute, workflow: SyncNexusEndpointsWorkflow},
+	{id: "stream-audit-events", every: time.Minute, workflow: StreamAuditEventsWorkflow},
+	{id: "cleanup-invitations", every: 24 * time.Hour, workflow: CleanupInvitationsWorkflow},
+}
+
+// Register registers the control plane's workflows and activities with w.
+func Register(w worker.Registry, activities *Activities) {
+	for _, wf := range []any{
+		ProvisionNamespaceWorkflow,
+		UpdateNamespaceWorkflow,
+		DeleteNamespaceWorkflow,
+		FailoverNamespaceWorkflow,
+		RotateNamespaceCAWorkflow,
+		DeleteOrganizationWorkflow,
+		BillingCycleWorkflow,
+		DunningWorkflow,
+		UsageAggregationWorkflow,
+		MeterUsageWorkflow,
+		ExpireCreditsWorkflow,
+		ExportHistoriesWorkflow,
+		ExportSinkWorkflow,
+		SyncConnectivityWorkflow,
+		SyncNexusEndpointsWorkflow,
+		SyncNexusEndpointWorkflow,
+		StreamAuditEventsWorkflow,
+		AuditStreamWorkflow,
+		CleanupInvitationsWorkflow,
+	} {
+		w.RegisterWorkflow(wf)
+	}
+	w.RegisterActivity(activities)
+}
+
+// EnsureSchedules creates the schedules of the periodic workflows, running
+// them on taskQueue. Schedules that already exist are left alone.
+func EnsureSchedules(ctx context.Context, c client.Client, taskQueue string) error {
+	for _, s := range schedules {
+		_, err := c.ScheduleClient().Create(ctx, client.ScheduleOptions{
+			ID:      s.id,
+			Spec:    client.ScheduleSpec{Intervals: []client.ScheduleIntervalSpec{{Every: s.every}}},
+			Overlap: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+			Action: &client.ScheduleWorkflowAction{
+				ID:        s.id,
+				Workflow:  s.workflow,
+				Args:      s.args,
+				TaskQueue: taskQueue,
+			},
+		})
+		if err != nil && !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+			return fmt.Errorf("failed to create schedule %s: %w", s.id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/temporaltest"
+)
+
+func TestEnsureSchedules(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t))
+	c := ts.GetDefaultClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	require.NoError(t, EnsureSchedules(ctx, c, "control-plane"))
+	// Existing schedules are left alone.
+	require.NoError(t, EnsureSchedules(ctx, c, "control-plane"))
+
+	for _, s := range schedules {
+		desc, err := c.ScheduleClient().GetHandle(ctx, s.id).Describe(ctx)
+		require.NoError(t, err, s.id)
+		require.Equal(t, s.every, desc.Schedule.Spec.Intervals[0].Every, s.id)
+	}
+}
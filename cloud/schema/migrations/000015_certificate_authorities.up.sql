@@ -0,0 +1,17 @@
+-- Per-namespace client certificate authorities. Private keys are encrypted by
+-- the control plane before they are stored.
+CREATE TABLE namespace_certificate_authorities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    namespace_id VARCHAR(255) NOT NULL REFERENCES cloud_namespaces(id) ON DELETE CASCADE,
+    certificate_pem TEXT NOT NULL,
+    encrypted_private_key BYTEA NOT NULL,
+    fingerprint VARCHAR(255) NOT NULL,
+    not_before TIMESTAMPTZ NOT NULL,
+    not_after TIMESTAMPTZ NOT NULL,
+    -- Set when the CA is superseded; the CA stays in the bundle until then.
+    retire_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_ns_cas_namespace ON namespace_certificate_authorities(namespace_id, created_at DESC);
+CREATE UNIQUE INDEX idx_ns_cas_current ON namespace_certificate_authorities(namespace_id) WHERE retire_at IS NULL;
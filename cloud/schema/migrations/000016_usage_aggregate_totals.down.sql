@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_usage_agg_unique_period;
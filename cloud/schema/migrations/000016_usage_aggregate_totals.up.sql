@@ -0,0 +1,6 @@
+-- Organization-wide aggregates have a NULL namespace_id, which the existing
+-- unique constraint treats as distinct, so re-aggregating a period would add
+-- a second row. Treat NULL as a single organization-wide key instead.
+CREATE UNIQUE INDEX idx_usage_agg_unique_period ON usage_aggregates(
+    organization_id, (COALESCE(namespace_id, '')), period_type, period_start
+);
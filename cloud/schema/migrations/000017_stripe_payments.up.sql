@@ -0,0 +1,5 @@
+-- A Stripe payment intent buys credits at most once, however often its
+-- webhook is delivered.
+CREATE UNIQUE INDEX idx_credit_purchases_payment_intent
+    ON credit_purchases(stripe_payment_intent_id)
+    WHERE stripe_payment_intent_id IS NOT NULL;
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS credit_ledger_entries_append_only ON credit_ledger_entries;
+DROP TRIGGER IF EXISTS credit_transactions_append_only ON credit_transactions;
+DROP FUNCTION IF EXISTS reject_credit_ledger_change();
+DROP TRIGGER IF EXISTS credit_ledger_entries_balanced ON credit_ledger_entries;
+DROP FUNCTION IF EXISTS check_credit_transaction_balanced();
+ALTER TABLE credit_balance DROP CONSTRAINT IF EXISTS credit_balance_non_negative;
+ALTER TABLE credit_balance DROP COLUMN IF EXISTS last_transaction_id;
+DROP INDEX IF EXISTS idx_credit_purchases_remaining;
+ALTER TABLE credit_purchases DROP CONSTRAINT IF EXISTS credit_purchases_remaining_check;
+ALTER TABLE credit_purchases DROP COLUMN IF EXISTS remaining_cents;
+DROP TABLE IF EXISTS credit_ledger_entries;
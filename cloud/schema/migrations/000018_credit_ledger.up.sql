@@ -0,0 +1,101 @@
+-- Double-entry postings for credit_transactions. Every transaction posts to
+-- the organization's customer_credits account and one counter account
+-- (payments, invoices, expired or adjustments); the postings of a
+-- transaction sum to zero. The customer_credits postings sum to the balance.
+CREATE TABLE credit_ledger_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_id UUID NOT NULL REFERENCES credit_transactions(id) ON DELETE CASCADE,
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    account VARCHAR(50) NOT NULL,
+    amount_cents BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_credit_ledger_entries_txn ON credit_ledger_entries(transaction_id);
+CREATE INDEX idx_credit_ledger_entries_org_account ON credit_ledger_entries(organization_id, account);
+
+INSERT INTO credit_ledger_entries (transaction_id, organization_id, account, amount_cents, created_at)
+SELECT id, organization_id, 'customer_credits', amount_cents, created_at
+FROM credit_transactions
+UNION ALL
+SELECT id, organization_id,
+    CASE transaction_type
+        WHEN 'purchase' THEN 'payments'
+        WHEN 'usage' THEN 'invoices'
+        WHEN 'expiry' THEN 'expired'
+        ELSE 'adjustments'
+    END,
+    -amount_cents, created_at
+FROM credit_transactions;
+
+-- Unconsumed amount of each purchase, drawn down oldest-expiry first when
+-- credits are applied and written off when the purchase expires.
+ALTER TABLE credit_purchases ADD COLUMN remaining_cents BIGINT;
+-- Past usage and expiry were taken from the balance without recording which
+-- purchase they came from. Allocate them oldest-expiry first, as drawing down
+-- does from now on: the newest purchases keep the current balance and older
+-- ones are consumed, so the lots never sum to more than the balance.
+WITH lots AS (
+    SELECT p.id, p.amount_cents,
+        COALESCE(b.balance_cents, 0) - COALESCE(SUM(p.amount_cents) OVER (
+            PARTITION BY p.organization_id
+            ORDER BY p.expires_at DESC, p.purchased_at DESC, p.id
+            ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+        ), 0) AS available_cents
+    FROM credit_purchases p
+    LEFT JOIN credit_balance b ON b.organization_id = p.organization_id
+)
+UPDATE credit_purchases p
+SET remaining_cents = LEAST(lots.amount_cents, GREATEST(lots.available_cents, 0))
+FROM lots
+WHERE lots.id = p.id;
+ALTER TABLE credit_purchases ALTER COLUMN remaining_cents SET NOT NULL;
+ALTER TABLE credit_purchases ADD CONSTRAINT credit_purchases_remaining_check
+    CHECK (remaining_cents >= 0 AND remaining_cents <= amount_cents);
+
+CREATE INDEX idx_credit_purchases_remaining ON credit_purchases(organization_id, expires_at)
+    WHERE remaining_cents > 0;
+
+-- credit_balance is a snapshot of the ledger as of last_transaction_id.
+ALTER TABLE credit_balance ADD COLUMN last_transaction_id UUID
+    REFERENCES credit_transactions(id) ON DELETE SET NULL;
+ALTER TABLE credit_balance ADD CONSTRAINT credit_balance_non_negative CHECK (balance_cents >= 0);
+
+CREATE OR REPLACE FUNCTION check_credit_transaction_balanced()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (SELECT COALESCE(SUM(amount_cents), 0) FROM credit_ledger_entries
+        WHERE transaction_id = NEW.transaction_id) <> 0 THEN
+        RAISE EXCEPTION 'credit transaction % does not balance', NEW.transaction_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER credit_ledger_entries_balanced
+    AFTER INSERT ON credit_ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+    EXECUTE FUNCTION check_credit_transaction_balanced();
+
+-- The ledger is append-only. Rows may only be removed by the cascade from
+-- deleting their organization.
+CREATE OR REPLACE FUNCTION reject_credit_ledger_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER credit_transactions_append_only
+    BEFORE UPDATE OR DELETE ON credit_transactions
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_credit_ledger_change();
+
+CREATE TRIGGER credit_ledger_entries_append_only
+    BEFORE UPDATE OR DELETE ON credit_ledger_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_credit_ledger_change();
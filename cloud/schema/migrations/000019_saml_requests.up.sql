@@ -0,0 +1,14 @@
+-- Outstanding SAML AuthnRequests. The row ID is sent to the identity
+-- provider as the RelayState and comes back with the response, which must
+-- answer request_id. Each row is consumed by at most one login.
+CREATE TABLE saml_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    request_id VARCHAR(64) NOT NULL UNIQUE,
+    redirect_url TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    consumed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_saml_requests_expires ON saml_requests(expires_at);
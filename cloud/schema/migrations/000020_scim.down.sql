@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_scim_config_token_hash;
+DROP INDEX IF EXISTS idx_user_groups_scim_external_id;
+DROP INDEX IF EXISTS idx_org_members_scim_external_id;
+
+ALTER TABLE organization_members
+    DROP COLUMN IF EXISTS scim_external_id,
+    DROP COLUMN IF EXISTS active;
@@ -0,0 +1,16 @@
+-- SCIM provisioning state of organization members. Members deactivated by
+-- the identity provider keep their role and groups but cannot sign in.
+ALTER TABLE organization_members
+    ADD COLUMN active BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN scim_external_id VARCHAR(255);
+
+CREATE UNIQUE INDEX idx_org_members_scim_external_id
+    ON organization_members(organization_id, scim_external_id)
+    WHERE scim_external_id IS NOT NULL;
+
+CREATE UNIQUE INDEX idx_user_groups_scim_external_id
+    ON user_groups(organization_id, scim_external_id)
+    WHERE scim_external_id IS NOT NULL;
+
+-- SCIM clients authenticate with a bearer token that is looked up by hash.
+CREATE UNIQUE INDEX idx_scim_config_token_hash ON scim_configurations(token_hash);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_export_sinks_namespace_name;
+
+ALTER TABLE export_jobs
+    DROP COLUMN IF EXISTS window_end,
+    DROP COLUMN IF EXISTS window_start;
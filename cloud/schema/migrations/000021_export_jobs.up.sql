@@ -0,0 +1,13 @@
+-- The period of close times an export job covers. A sink's last_export_at is
+-- the end of its last completed job, where the next job starts.
+ALTER TABLE export_jobs
+    ADD COLUMN window_start TIMESTAMPTZ,
+    ADD COLUMN window_end TIMESTAMPTZ;
+
+UPDATE export_jobs SET window_start = created_at, window_end = created_at;
+
+ALTER TABLE export_jobs
+    ALTER COLUMN window_start SET NOT NULL,
+    ALTER COLUMN window_end SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_export_sinks_namespace_name ON export_sinks(namespace_id, name);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_connectivity_rules_org_name;
@@ -0,0 +1,2 @@
+-- Connectivity rules are referred to by name within an organization.
+CREATE UNIQUE INDEX idx_connectivity_rules_org_name ON connectivity_rules(organization_id, name);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS audit_events_default;
+DROP INDEX IF EXISTS idx_audit_org_cursor;
+DROP TABLE IF EXISTS audit_streams;
@@ -0,0 +1,33 @@
+-- Audit streams deliver an organization's audit events to a sink as they are
+-- recorded, in batches linked by a hash chain. The cursor is the last event
+-- delivered, and sequence and last_hash the last batch.
+CREATE TABLE audit_streams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    format VARCHAR(20) NOT NULL, -- 'ndjson', 'cef', 'ocsf'
+    sink_type VARCHAR(50) NOT NULL, -- 's3', 'webhook'
+    config JSONB NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    cursor_time TIMESTAMPTZ NOT NULL,
+    cursor_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    sequence BIGINT NOT NULL DEFAULT 0,
+    last_hash VARCHAR(64) NOT NULL DEFAULT '',
+    last_delivered_at TIMESTAMPTZ,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_audit_streams_org_name ON audit_streams(organization_id, name);
+
+CREATE TRIGGER update_audit_streams_updated_at
+    BEFORE UPDATE ON audit_streams
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Exports page through events in (created_at, id) order.
+CREATE INDEX idx_audit_org_cursor ON audit_events(organization_id, created_at, id);
+
+-- Events past the last monthly partition would otherwise fail to be recorded.
+CREATE TABLE audit_events_default PARTITION OF audit_events DEFAULT;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_invitations_expires_at;
+
+ALTER TABLE user_invitations
+    DROP COLUMN IF EXISTS last_sent_at,
+    DROP COLUMN IF EXISTS send_count,
+    DROP COLUMN IF EXISTS declined_at,
+    DROP COLUMN IF EXISTS status;
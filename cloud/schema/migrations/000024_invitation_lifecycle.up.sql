@@ -0,0 +1,12 @@
+-- Invitations are accepted or declined by their invitee, and expire. Their
+-- token column now holds the SHA-256 hash of the signed token sent by email.
+ALTER TABLE user_invitations
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    ADD COLUMN declined_at TIMESTAMPTZ,
+    ADD COLUMN send_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN last_sent_at TIMESTAMPTZ;
+
+UPDATE user_invitations SET status = 'accepted' WHERE accepted_at IS NOT NULL;
+
+-- Expired invitations are deleted by the cleanup workflow.
+CREATE INDEX idx_invitations_expires_at ON user_invitations(expires_at) WHERE status <> 'accepted';
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_nexus_endpoints_unsynced;
+DELETE FROM nexus_endpoints WHERE deleted_at IS NOT NULL;
+ALTER TABLE nexus_endpoints
+    DROP COLUMN IF EXISTS spec_version,
+    DROP COLUMN IF EXISTS synced_version,
+    DROP COLUMN IF EXISTS cluster_id,
+    DROP COLUMN IF EXISTS cluster_endpoint_id,
+    DROP COLUMN IF EXISTS synced_target_namespace_id,
+    DROP COLUMN IF EXISTS sync_error,
+    DROP COLUMN IF EXISTS synced_at,
+    DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE nexus_endpoints RENAME COLUMN target_task_queue TO handler_name;
+ALTER TABLE nexus_endpoints DROP CONSTRAINT IF EXISTS nexus_endpoints_name_key;
+ALTER TABLE nexus_endpoints ADD CONSTRAINT nexus_endpoints_organization_id_name_key UNIQUE (organization_id, name);
@@ -0,0 +1,21 @@
+-- Nexus endpoints are provisioned on the cluster hosting their target
+-- namespace, where endpoint names are global.
+ALTER TABLE nexus_endpoints DROP CONSTRAINT nexus_endpoints_organization_id_name_key;
+ALTER TABLE nexus_endpoints ADD CONSTRAINT nexus_endpoints_name_key UNIQUE (name);
+ALTER TABLE nexus_endpoints RENAME COLUMN handler_name TO target_task_queue;
+
+-- Every change bumps spec_version, and synced_version is the version last
+-- provisioned on the cluster, with the target namespace it was provisioned
+-- for. Deleted endpoints are kept until they are removed from their cluster.
+ALTER TABLE nexus_endpoints
+    ADD COLUMN spec_version BIGINT NOT NULL DEFAULT 1,
+    ADD COLUMN synced_version BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN cluster_id VARCHAR(255),
+    ADD COLUMN cluster_endpoint_id VARCHAR(255),
+    ADD COLUMN synced_target_namespace_id VARCHAR(255),
+    ADD COLUMN sync_error TEXT,
+    ADD COLUMN synced_at TIMESTAMPTZ,
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_nexus_endpoints_unsynced ON nexus_endpoints(id)
+    WHERE synced_version < spec_version OR deleted_at IS NOT NULL;
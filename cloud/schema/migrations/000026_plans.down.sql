@@ -0,0 +1,3 @@
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_plan_fkey;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS plan_version;
+DROP TABLE IF EXISTS plans;
@@ -0,0 +1,39 @@
+-- Versioned plan definitions. Subscriptions are pinned to the version of
+-- their plan they subscribed to, so a new version only applies to
+-- subscriptions that change plan after it is added.
+CREATE TABLE plans (
+    tier plan_tier NOT NULL,
+    version INT NOT NULL,
+    -- Plans are ordered by rank; moving to a lower rank is a downgrade.
+    rank INT NOT NULL,
+    base_fee_cents BIGINT NOT NULL,
+    actions_included BIGINT NOT NULL,
+    active_storage_gb DECIMAL(10,2) NOT NULL,
+    retained_storage_gb DECIMAL(10,2) NOT NULL,
+    -- Whether usage beyond the included amounts blocks changes instead of
+    -- being billed as overage.
+    hard_usage_limits BOOLEAN NOT NULL DEFAULT FALSE,
+    max_namespaces INT NOT NULL,
+    max_retention_days INT NOT NULL,
+    -- Namespaces that may be replicated to a standby region.
+    max_ha_namespaces INT NOT NULL,
+    features TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tier, version)
+);
+
+INSERT INTO plans (tier, version, rank, base_fee_cents, actions_included, active_storage_gb, retained_storage_gb,
+                   hard_usage_limits, max_namespaces, max_retention_days, max_ha_namespaces, features)
+VALUES
+    ('free', 1, 0, 0, 100000, 0.1, 4, TRUE, 1, 7, 0, '{}'),
+    ('essentials', 1, 1, 10000, 1000000, 1, 40, FALSE, 5, 30, 0, '{history_export}'),
+    ('business', 1, 2, 50000, 2500000, 2.5, 100, FALSE, 20, 90, 5,
+        '{history_export,private_connectivity,nexus}'),
+    ('enterprise', 1, 3, 0, 10000000, 10, 400, FALSE, 1000, 365, 1000,
+        '{history_export,private_connectivity,nexus}'),
+    ('mission_critical', 1, 4, 0, 100000000, 100, 4000, FALSE, 1000, 365, 1000,
+        '{history_export,private_connectivity,nexus}');
+
+ALTER TABLE subscriptions ADD COLUMN plan_version INT NOT NULL DEFAULT 1;
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_plan_fkey
+    FOREIGN KEY (plan, plan_version) REFERENCES plans(tier, version);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_organizations_slug_live;
+ALTER TABLE organizations ADD CONSTRAINT organizations_slug_key UNIQUE (slug);
+ALTER TABLE organizations
+    DROP COLUMN IF EXISTS deletion_requested_at,
+    DROP COLUMN IF EXISTS delete_after,
+    DROP COLUMN IF EXISTS deletion_started_at,
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,14 @@
+-- Organizations are deleted by DeleteOrganizationWorkflow after a grace
+-- period. The row is kept once the organization is deleted so that its final
+-- invoice, and the records it refers to, survive it.
+ALTER TABLE organizations
+    ADD COLUMN deletion_requested_at TIMESTAMPTZ,
+    -- When the grace period ends. Deletion can be cancelled until then.
+    ADD COLUMN delete_after TIMESTAMPTZ,
+    -- When teardown began; deletion can no longer be cancelled.
+    ADD COLUMN deletion_started_at TIMESTAMPTZ,
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- A deleted organization's slug can be reused.
+ALTER TABLE organizations DROP CONSTRAINT organizations_slug_key;
+CREATE UNIQUE INDEX idx_organizations_slug_live ON organizations(slug) WHERE deleted_at IS NULL;
@@ -0,0 +1,9 @@
+-- The history size of each namespace's closed executions, by the hour they
+-- closed in. Metering sums the hours still inside the namespace's retention
+-- period for retained storage instead of listing every retained execution.
+CREATE TABLE namespace_closed_history (
+    namespace_id VARCHAR(255) NOT NULL,
+    close_hour TIMESTAMPTZ NOT NULL,
+    history_bytes BIGINT NOT NULL,
+    PRIMARY KEY (namespace_id, close_hour)
+);
@@ -0,0 +1,15 @@
+-- Email domains claimed by organizations. A domain is verified by publishing
+-- verification_token in a DNS TXT record, and can be verified by at most one
+-- organization. SAML logins only link or create users in verified domains.
+CREATE TABLE organization_domains (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    domain VARCHAR(255) NOT NULL,
+    verification_token VARCHAR(64) NOT NULL,
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (organization_id, domain)
+);
+
+CREATE UNIQUE INDEX idx_organization_domains_verified
+    ON organization_domains(domain) WHERE verified_at IS NOT NULL;
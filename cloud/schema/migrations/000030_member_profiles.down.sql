@@ -0,0 +1,3 @@
+ALTER TABLE organization_members
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS display_name;
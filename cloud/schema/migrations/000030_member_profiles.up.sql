@@ -0,0 +1,8 @@
+-- The profile an organization's identity provider gives a member. Users can
+-- belong to several organizations, so SCIM updates this instead of the shared
+-- users row.
+ALTER TABLE organization_members
+    ADD COLUMN display_name VARCHAR(255),
+    ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE organization_members SET updated_at = created_at;
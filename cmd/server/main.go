@@ -10,6 +10,8 @@ import (
 	_ "time/tzdata" // embed tzdata as a fallback
 
 	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/build"
 	"go.temporal.io/server/common/config"
@@ -82,14 +84,24 @@ func buildCLI() *cli.App {
 			Name:      "validate-dynamic-config",
 			Usage:     "Validate a dynamic config file[s] with known keys and types",
 			ArgsUsage: "<file> ...",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "strict",
+					Usage: "report unknown keys, type mismatches and invalid constraints as errors",
+				},
+			},
 			Action: func(c *cli.Context) error {
+				validate := dynamicconfig.ValidateFile
+				if c.Bool("strict") {
+					validate = dynamicconfig.ValidateFileStrict
+				}
 				total := 0
 				for _, fileName := range c.Args().Slice() {
 					contents, err := os.ReadFile(fileName)
 					if err != nil {
 						return err
 					}
-					result := dynamicconfig.ValidateFile(contents)
+					result := validate(contents)
 					total += len(result.Errors)
 					fmt.Println(fileName)
 					t := template.Must(template.New("").Parse(
@@ -105,6 +117,58 @@ func buildCLI() *cli.App {
 				return nil
 			},
 		},
+		{
+			Name:      "dynamic-config-schema",
+			Usage:     "Print a JSON schema of dynamic config files with all known keys and types",
+			ArgsUsage: " ",
+			Action: func(c *cli.Context) error {
+				schema, err := dynamicconfig.JSONSchema()
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(schema))
+				return nil
+			},
+		},
+		{
+			Name:      "diff-dynamic-config",
+			Usage:     "Show dynamic config values that would change by replacing a file with another",
+			ArgsUsage: "<old file> <new file>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "namespace", Usage: "namespace to compare values for"},
+				&cli.StringFlag{Name: "namespace-id", Usage: "namespace ID to compare values for"},
+				&cli.StringFlag{Name: "task-queue", Usage: "task queue name to compare values for"},
+				&cli.StringFlag{Name: "task-queue-type", Usage: "task queue type to compare values for (Workflow, Activity, Nexus)"},
+				&cli.IntFlag{Name: "shard-id", Usage: "history shard ID to compare values for"},
+				&cli.StringFlag{Name: "history-task-type", Usage: "history task type to compare values for, e.g. TRANSFER_ACTIVITY_TASK"},
+				&cli.StringFlag{Name: "destination", Usage: "destination to compare values for"},
+			},
+			Action: func(c *cli.Context) error {
+				if c.NArg() != 2 {
+					return fmt.Errorf("expected 2 files, got %d", c.NArg())
+				}
+				constraints, err := diffDynamicConfigConstraints(c)
+				if err != nil {
+					return err
+				}
+				oldContents, err := os.ReadFile(c.Args().Get(0))
+				if err != nil {
+					return err
+				}
+				newContents, err := os.ReadFile(c.Args().Get(1))
+				if err != nil {
+					return err
+				}
+				diffs, err := dynamicconfig.DiffFiles(oldContents, newContents, constraints)
+				if err != nil {
+					return err
+				}
+				for _, d := range diffs {
+					fmt.Printf("%s: %v -> %v\n", d.Key, d.Old, d.New)
+				}
+				return nil
+			},
+		},
 		{
 			Name:      "render-config",
 			Usage:     "Render server config template",
@@ -258,3 +322,28 @@ func buildCLI() *cli.App {
 	}
 	return app
 }
+
+func diffDynamicConfigConstraints(c *cli.Context) (dynamicconfig.Constraints, error) {
+	constraints := dynamicconfig.Constraints{
+		Namespace:     c.String("namespace"),
+		NamespaceID:   c.String("namespace-id"),
+		TaskQueueName: c.String("task-queue"),
+		ShardID:       int32(c.Int("shard-id")),
+		Destination:   c.String("destination"),
+	}
+	if c.IsSet("task-queue-type") {
+		tqType, err := enumspb.TaskQueueTypeFromString(c.String("task-queue-type"))
+		if err != nil {
+			return constraints, fmt.Errorf("invalid task queue type: %w", err)
+		}
+		constraints.TaskQueueType = tqType
+	}
+	if c.IsSet("history-task-type") {
+		taskType, err := enumsspb.TaskTypeFromString(c.String("history-task-type"))
+		if err != nil {
+			return constraints, fmt.Errorf("invalid history task type: %w", err)
+		}
+		constraints.TaskType = taskType
+	}
+	return constraints, nil
+}
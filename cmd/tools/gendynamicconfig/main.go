@@ -16,6 +16,8 @@ type (
 		Name   string
 		GoArgs string
 		Expr   string
+		// LookupArgs are the GoArgs taken from the fields of Constraints l.
+		LookupArgs string
 	}
 
 	dynamicConfigData struct {
@@ -62,19 +64,22 @@ var (
 		},
 		Precedences: []settingPrecedence{
 			{
-				Name:   "Global",
-				GoArgs: "",
-				Expr:   "[]Constraints{{}}",
+				Name:       "Global",
+				GoArgs:     "",
+				Expr:       "[]Constraints{{}}",
+				LookupArgs: "",
 			},
 			{
-				Name:   "Namespace",
-				GoArgs: "namespace string",
-				Expr:   "[]Constraints{{Namespace: namespace}, {}}",
+				Name:       "Namespace",
+				GoArgs:     "namespace string",
+				Expr:       "[]Constraints{{Namespace: namespace}, {}}",
+				LookupArgs: "l.Namespace",
 			},
 			{
-				Name:   "NamespaceID",
-				GoArgs: "namespaceID namespace.ID",
-				Expr:   "[]Constraints{{NamespaceID: namespaceID.String()}, {}}",
+				Name:       "NamespaceID",
+				GoArgs:     "namespaceID namespace.ID",
+				Expr:       "[]Constraints{{NamespaceID: namespaceID.String()}, {}}",
+				LookupArgs: "namespace.ID(l.NamespaceID)",
 			},
 			{
 				Name:   "TaskQueue",
@@ -89,16 +94,19 @@ var (
 			{Namespace: namespace},
 			{},
 		}`,
+				LookupArgs: "l.Namespace, l.TaskQueueName, l.TaskQueueType",
 			},
 			{
-				Name:   "ShardID",
-				GoArgs: "shardID int32",
-				Expr:   "[]Constraints{{ShardID: shardID}, {}}",
+				Name:       "ShardID",
+				GoArgs:     "shardID int32",
+				Expr:       "[]Constraints{{ShardID: shardID}, {}}",
+				LookupArgs: "l.ShardID",
 			},
 			{
-				Name:   "TaskType",
-				GoArgs: "taskType enumsspb.TaskType",
-				Expr:   "[]Constraints{{TaskType: taskType}, {}}",
+				Name:       "TaskType",
+				GoArgs:     "taskType enumsspb.TaskType",
+				Expr:       "[]Constraints{{TaskType: taskType}, {}}",
+				LookupArgs: "l.TaskType",
 			},
 			{
 				Name:   "Destination",
@@ -109,6 +117,7 @@ var (
 			{Namespace: namespace},
 			{},
 		}`,
+				LookupArgs: "l.Namespace, l.Destination",
 			},
 		}}
 )
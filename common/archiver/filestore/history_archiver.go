@@ -51,7 +51,7 @@ var (
 
 type (
 	historyArchiver struct {
-		executionManager persistence.ExecutionManager
+		executionManager persistence.HistoryBranchReader
 		logger           log.Logger
 		metricsHandler   metrics.Handler
 		fileMode         os.FileMode
@@ -69,7 +69,7 @@ type (
 
 // NewHistoryArchiver creates a new archiver.HistoryArchiver based on filestore
 func NewHistoryArchiver(
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	config *config.FilestoreArchiver,
@@ -78,7 +78,7 @@ func NewHistoryArchiver(
 }
 
 func newHistoryArchiver(
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	config *config.FilestoreArchiver,
@@ -14,6 +14,7 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.uber.org/multierr"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 )
 
 var (
@@ -62,6 +63,17 @@ func NewClient(ctx context.Context, config *config.GstorageArchiver) (Client, er
 
 }
 
+// NewClientWithOptions returns a Temporal gcloudstorage.Client whose storage
+// client is created with the given options, e.g. to use credentials other
+// than the default ones.
+func NewClientWithOptions(ctx context.Context, opts ...option.ClientOption) (Client, error) {
+	nativeClient, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &storageWrapper{client: &clientDelegate{nativeClient: nativeClient}}, nil
+}
+
 // NewClientWithParams return a gcloudstorage.Client based on input parameters
 func NewClientWithParams(clientD GcloudStorageClient) (Client, error) {
 	return &storageWrapper{client: clientD}, nil
@@ -35,7 +35,7 @@ const (
 )
 
 type historyArchiver struct {
-	executionManager persistence.ExecutionManager
+	executionManager persistence.HistoryBranchReader
 	logger           log.Logger
 	metricsHandler   metrics.Handler
 	gcloudStorage    connector.Client
@@ -58,7 +58,7 @@ type getHistoryToken struct {
 
 // NewHistoryArchiver creates a new gcloud storage HistoryArchiver
 func NewHistoryArchiver(
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	config *config.GstorageArchiver,
@@ -70,7 +70,18 @@ func NewHistoryArchiver(
 	return nil, err
 }
 
-func newHistoryArchiver(executionManager persistence.ExecutionManager, logger log.Logger, metricsHandler metrics.Handler, historyIterator archiver.HistoryIterator, storage connector.Client) archiver.HistoryArchiver {
+// NewHistoryArchiverWithClient creates a new gcloud storage HistoryArchiver
+// writing through the given storage client.
+func NewHistoryArchiverWithClient(
+	executionManager persistence.HistoryBranchReader,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	storage connector.Client,
+) archiver.HistoryArchiver {
+	return newHistoryArchiver(executionManager, logger, metricsHandler, nil, storage)
+}
+
+func newHistoryArchiver(executionManager persistence.HistoryBranchReader, logger log.Logger, metricsHandler metrics.Handler, historyIterator archiver.HistoryIterator, storage connector.Client) archiver.HistoryArchiver {
 	return &historyArchiver{
 		executionManager: executionManager,
 		logger:           logger,
@@ -352,7 +363,7 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 	return highestVersion, highestVersionPart, lowestVersionPart, nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.HistoryBranchReader, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
 
 	defer func() {
 		if err != nil || historyIterator == nil {
@@ -37,7 +37,7 @@ type (
 		historyIteratorState
 
 		request               *ArchiveHistoryRequest
-		executionManager      persistence.ExecutionManager
+		executionManager      persistence.HistoryBranchReader
 		sizeEstimator         SizeEstimator
 		historyPageSize       int
 		targetHistoryBlobSize int
@@ -51,7 +51,7 @@ var (
 // NewHistoryIterator returns a new HistoryIterator
 func NewHistoryIterator(
 	request *ArchiveHistoryRequest,
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	targetHistoryBlobSize int,
 ) HistoryIterator {
 	return newHistoryIterator(request, executionManager, targetHistoryBlobSize)
@@ -60,7 +60,7 @@ func NewHistoryIterator(
 // NewHistoryIteratorFromState returns a new HistoryIterator with specified state
 func NewHistoryIteratorFromState(
 	request *ArchiveHistoryRequest,
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	targetHistoryBlobSize int,
 	initialState []byte,
 ) (HistoryIterator, error) {
@@ -76,7 +76,7 @@ func NewHistoryIteratorFromState(
 
 func newHistoryIterator(
 	request *ArchiveHistoryRequest,
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	targetHistoryBlobSize int,
 ) *historyIterator {
 	return &historyIterator{
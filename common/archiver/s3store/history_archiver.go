@@ -45,7 +45,7 @@ var (
 
 type (
 	historyArchiver struct {
-		executionManager persistence.ExecutionManager
+		executionManager persistence.HistoryBranchReader
 		logger           log.Logger
 		metricsHandler   metrics.Handler
 		s3cli            s3iface.S3API
@@ -68,7 +68,7 @@ type (
 
 // NewHistoryArchiver creates a new archiver.HistoryArchiver based on s3
 func NewHistoryArchiver(
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	config *config.S3Archiver,
@@ -76,8 +76,25 @@ func NewHistoryArchiver(
 	return newHistoryArchiver(executionManager, logger, metricsHandler, config, nil)
 }
 
+// NewHistoryArchiverWithClient creates a new archiver.HistoryArchiver based on
+// s3 writing through the given client, e.g. one using credentials other than
+// the default ones.
+func NewHistoryArchiverWithClient(
+	executionManager persistence.HistoryBranchReader,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	s3cli s3iface.S3API,
+) archiver.HistoryArchiver {
+	return &historyArchiver{
+		executionManager: executionManager,
+		logger:           logger,
+		metricsHandler:   metricsHandler,
+		s3cli:            s3cli,
+	}
+}
+
 func newHistoryArchiver(
-	executionManager persistence.ExecutionManager,
+	executionManager persistence.HistoryBranchReader,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	config *config.S3Archiver,
@@ -215,7 +232,7 @@ func (h *historyArchiver) Archive(
 	return nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.HistoryBranchReader, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
 	if featureCatalog.ProgressManager != nil {
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
 			err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
@@ -58,6 +58,13 @@ func (*noopClaimMapper) AuthInfoRequired() bool {
 	return false
 }
 
+// IsNoopClaimMapper reports whether claimMapper is the no-op claim mapper,
+// whose claims do not authenticate the caller.
+func IsNoopClaimMapper(claimMapper ClaimMapper) bool {
+	_, ok := claimMapper.(*noopClaimMapper)
+	return ok
+}
+
 func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
 
 	switch strings.ToLower(config.ClaimMapper) {
@@ -47,6 +47,9 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// PersistedDynamicConfig enables dynamic config overrides stored in persistence (and managed through the
+		// admin API), which are layered over the values from the dynamic config client.
+		PersistedDynamicConfig *dynamicconfig.PersistedClientConfig `yaml:"persistedDynamicConfig"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
@@ -637,6 +640,7 @@ const (
 	QueueV2Name            DataStoreName = "QueueV2"
 	ClusterMDStoreName     DataStoreName = "ClusterMDStore"
 	NexusEndpointStoreName DataStoreName = "NexusEndpointStore"
+	DynamicConfigStoreName DataStoreName = "DynamicConfigStore"
 )
 
 const (
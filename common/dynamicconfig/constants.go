@@ -145,6 +145,22 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	PersistenceBlobCompression = NewNamespaceIDStringSetting(
+		"system.persistenceBlobCompression",
+		"",
+		`PersistenceBlobCompression is the codec, "zstd" or "snappy", that a namespace's history event batches and
+mutable state are compressed with before they are persisted. Empty disables compression. Compressed and
+uncompressed rows are both readable whatever this is set to, but only by servers that support compression:
+enable it only once every service of the cluster runs such a version, and do not roll back to an earlier
+version afterwards. Disabling compression does not rewrite rows already compressed, so it does not make a
+rollback safe either.`,
+	)
+	PersistenceBlobCompressionMinSize = NewNamespaceIDIntSetting(
+		"system.persistenceBlobCompressionMinSize",
+		1024,
+		`PersistenceBlobCompressionMinSize is the size in bytes below which blobs are persisted uncompressed
+when PersistenceBlobCompression is set`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -814,6 +830,39 @@ This config is EXPERIMENTAL and may be changed or removed in a later release.`,
 		false,
 		`DisableListVisibilityByFilter is config to disable list open/close workflow using filter`,
 	)
+	FrontendEnableNamespaceIPAllowlist = NewNamespaceBoolSetting(
+		"frontend.enableNamespaceIPAllowlist",
+		true,
+		`FrontendEnableNamespaceIPAllowlist enforces the IP allowlist stored in a namespace's data under the
+"temporal.io/ip-allowlist" key: requests to the namespace from addresses outside the allowlist are denied.
+Requests from system principals and requests forwarded by remote clusters are not checked, unless the claim mapper
+is the no-op one, which cannot tell them from clients. Namespaces without an allowlist are not restricted.`,
+	)
+	FrontendEnableNamespaceClientCertificates = NewNamespaceBoolSetting(
+		"frontend.enableNamespaceClientCertificates",
+		true,
+		`FrontendEnableNamespaceClientCertificates enforces the client CAs and certificate filters stored in a
+namespace's data under the "temporal.io/client-ca-bundle" and "temporal.io/certificate-filters" keys: requests
+to the namespace must present a client certificate signed by one of the CAs and matching one of the filters.
+Requests from system principals are not checked, unless the claim mapper is the no-op one, which cannot tell them
+from clients. The frontend only sees client certificates if its TLS config requires client auth, so the CAs must also be trusted there. Namespaces without either key are not restricted.`,
+	)
+	FrontendEnableNexusEndpointAllowlist = NewNamespaceBoolSetting(
+		"frontend.enableNexusEndpointAllowlist",
+		true,
+		`FrontendEnableNexusEndpointAllowlist enforces the caller allowlists of the Nexus endpoints targeting a namespace,
+stored in the namespace's data under "temporal.io/nexus-endpoint-allowlist/<endpoint name>": requests to an endpoint
+from workflows in namespaces outside its allowlist, or from outside any namespace, are denied. Endpoints without an
+allowlist are not restricted. The caller namespace reported by the history service is trusted on the internal frontend,
+and on the frontend only from system principals authenticated by a claim mapper.`,
+	)
+	FrontendIPAllowlistTrustedProxies = NewGlobalTypedSetting(
+		"frontend.ipAllowlistTrustedProxies",
+		([]string)(nil),
+		`FrontendIPAllowlistTrustedProxies lists the addresses or CIDRs of the proxies and load balancers in front of
+the frontend. For requests arriving through them, the client address checked against namespace IP allowlists is
+taken from the x-forwarded-for header. By default the header is ignored.`,
+	)
 	ExposeAuthorizerErrors = NewGlobalBoolSetting(
 		"frontend.exposeAuthorizerErrors",
 		false,
@@ -0,0 +1,59 @@
+package dynamicconfig
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// ValueDiff is a change in the effective value of a setting between two dynamic config files.
+	ValueDiff struct {
+		Key Key
+		Old any
+		New any
+	}
+
+	// configValueClient is a Client for the values loaded from a dynamic config file.
+	configValueClient configValueMap
+)
+
+// DiffFiles loads two dynamic config files and returns the settings whose effective value
+// differs between them for the given constraints. Fields of the constraints that aren't used by
+// the precedence of a setting are ignored, so e.g. a namespace and task queue can be given to
+// compare both namespace and task queue settings. Settings are returned in key order.
+func DiffFiles(oldContents, newContents []byte, constraints Constraints) ([]ValueDiff, error) {
+	oldValues, lr := loadFile(oldContents, false)
+	if len(lr.Errors) > 0 {
+		return nil, errors.Join(lr.Errors...)
+	}
+	newValues, lr := loadFile(newContents, false)
+	if len(lr.Errors) > 0 {
+		return nil, errors.Join(lr.Errors...)
+	}
+
+	oldCollection := NewCollection(configValueClient(oldValues), log.NewNoopLogger())
+	newCollection := NewCollection(configValueClient(newValues), log.NewNoopLogger())
+
+	var diffs []ValueDiff
+	for _, s := range registeredSettings() {
+		key := strings.ToLower(s.Key().String())
+		if _, ok := oldValues[key]; !ok {
+			if _, ok := newValues[key]; !ok {
+				continue
+			}
+		}
+		oldValue := s.lookup(oldCollection, constraints)
+		newValue := s.lookup(newCollection, constraints)
+		if !reflect.DeepEqual(oldValue, newValue) {
+			diffs = append(diffs, ValueDiff{Key: s.Key(), Old: oldValue, New: newValue})
+		}
+	}
+	return diffs, nil
+}
+
+func (c configValueClient) GetValue(key Key) []ConstrainedValue {
+	return c[strings.ToLower(key.String())]
+}
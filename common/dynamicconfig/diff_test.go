@@ -0,0 +1,66 @@
+package dynamicconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestDiffFiles(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	enabled := dynamicconfig.NewGlobalBoolSetting("testDiff.enabled", true, "")
+	rps := dynamicconfig.NewNamespaceIntSetting("testDiff.rps", 10, "")
+	partitions := dynamicconfig.NewTaskQueueIntSetting("testDiff.partitions", 1, "")
+	dynamicconfig.NewGlobalIntSetting("testDiff.unchanged", 0, "")
+
+	oldFile := []byte(`
+testDiff.enabled:
+- value: false
+testDiff.rps:
+- value: 100
+testDiff.partitions:
+- value: 4
+testDiff.unchanged:
+- value: 3
+`)
+	newFile := []byte(`
+testdiff.rps:
+- value: 200
+- value: 50
+  constraints:
+    namespace: ns1
+testDiff.partitions:
+- value: 4
+- value: 8
+  constraints:
+    namespace: ns1
+    taskQueueName: tq
+    taskType: Workflow
+testDiff.unchanged:
+- value: 3
+`)
+
+	diffs, err := dynamicconfig.DiffFiles(oldFile, newFile, dynamicconfig.Constraints{})
+	require.NoError(t, err)
+	require.Equal(t, []dynamicconfig.ValueDiff{
+		{Key: enabled.Key(), Old: false, New: true},
+		{Key: rps.Key(), Old: 100, New: 200},
+	}, diffs)
+
+	diffs, err = dynamicconfig.DiffFiles(oldFile, newFile, dynamicconfig.Constraints{
+		Namespace:     "ns1",
+		TaskQueueName: "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []dynamicconfig.ValueDiff{
+		{Key: enabled.Key(), Old: false, New: true},
+		{Key: partitions.Key(), Old: 4, New: 8},
+		{Key: rps.Key(), Old: 100, New: 50},
+	}, diffs)
+
+	_, err = dynamicconfig.DiffFiles(oldFile, []byte("testDiff.rps: 5"), dynamicconfig.Constraints{})
+	require.Error(t, err)
+}
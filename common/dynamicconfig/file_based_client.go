@@ -40,6 +40,9 @@ type (
 	FileBasedClientConfig struct {
 		Filepath     string        `yaml:"filepath"`
 		PollInterval time.Duration `yaml:"pollInterval"`
+		// Strict rejects config files with unregistered keys, values that don't convert to the
+		// type of the setting, or constraints that aren't used by the setting (see ValidateFileStrict).
+		Strict bool `yaml:"strict"`
 	}
 
 	configValueMap map[string][]ConstrainedValue
@@ -68,11 +71,21 @@ type (
 	LoadResult struct {
 		Warnings []error
 		Errors   []error
+
+		// strict reports problems that are otherwise warnings as errors
+		strict bool
 	}
 )
 
 func ValidateFile(contents []byte) *LoadResult {
-	_, lr := loadFile(contents)
+	_, lr := loadFile(contents, false)
+	return lr
+}
+
+// ValidateFileStrict is like ValidateFile, but reports unregistered keys, values that don't convert
+// to the type of the setting, and constraints that aren't used by the setting as errors.
+func ValidateFileStrict(contents []byte) *LoadResult {
+	_, lr := loadFile(contents, true)
 	return lr
 }
 
@@ -175,7 +188,7 @@ func (fc *fileBasedClient) Update() error {
 		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
 	}
 
-	newValues, lr := loadFile(contents)
+	newValues, lr := loadFile(contents, fc.config.Strict)
 	for _, e := range lr.Errors {
 		fc.logger.Warn("dynamic config error", tag.Error(e))
 	}
@@ -207,8 +220,8 @@ func (fc *fileBasedClient) Update() error {
 	return nil
 }
 
-func loadFile(contents []byte) (configValueMap, *LoadResult) {
-	lr := &LoadResult{}
+func loadFile(contents []byte, strict bool) (configValueMap, *LoadResult) {
+	lr := &LoadResult{strict: strict}
 
 	var yamlValues map[string][]struct {
 		Constraints map[string]any
@@ -223,7 +236,7 @@ func loadFile(contents []byte) (configValueMap, *LoadResult) {
 		precedence := PrecedenceUnknown
 		setting := queryRegistry(Key(key))
 		if setting == nil {
-			lr.warnf("unregistered key %q", key)
+			lr.strictf("unregistered key %q", key)
 		} else {
 			precedence = setting.Precedence()
 		}
@@ -242,7 +255,7 @@ func loadFile(contents []byte) (configValueMap, *LoadResult) {
 			if setting != nil {
 				if valErr := setting.Validate(val); valErr != nil {
 					// TODO: raise this to error level
-					lr.warnf("validation failed: key %q value %v: %w", key, cv.Value, valErr)
+					lr.strictf("validation failed: key %q value %v: %w", key, cv.Value, valErr)
 				}
 			}
 
@@ -419,7 +432,6 @@ func convertKeyTypeToStringSlice(s []interface{}) ([]interface{}, error) {
 func convertYamlConstraints(key string, m map[string]any, precedence Precedence, lr *LoadResult) Constraints {
 	var cs Constraints
 	for k, v := range m {
-		validConstraint := true
 		switch strings.ToLower(k) {
 		case "namespace":
 			if v, ok := v.(string); ok {
@@ -427,21 +439,18 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 			} else {
 				lr.errorf("namespace constraint must be string")
 			}
-			validConstraint = precedence == PrecedenceNamespace || precedence == PrecedenceTaskQueue || precedence == PrecedenceDestination
 		case "namespaceid":
 			if v, ok := v.(string); ok {
 				cs.NamespaceID = v
 			} else {
 				lr.errorf("namespaceID constraint must be string")
 			}
-			validConstraint = precedence == PrecedenceNamespaceID
 		case "taskqueuename":
 			if v, ok := v.(string); ok {
 				cs.TaskQueueName = v
 			} else {
 				lr.errorf("taskQueueName constraint must be string")
 			}
-			validConstraint = precedence == PrecedenceTaskQueue
 		case "tasktype":
 			switch v := v.(type) {
 			case string:
@@ -461,7 +470,6 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 			default:
 				lr.errorf("taskType constraint must be Workflow/Activity")
 			}
-			validConstraint = precedence == PrecedenceTaskQueue
 		case "historytasktype":
 			switch v := v.(type) {
 			case string:
@@ -477,35 +485,75 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 			default:
 				lr.errorf("historytasktype %T constraint is not supported", v)
 			}
-			validConstraint = precedence == PrecedenceTaskType
 		case "shardid":
 			if v, ok := v.(int); ok {
 				cs.ShardID = int32(v)
 			} else {
 				lr.errorf("shardID constraint must be integer")
 			}
-			validConstraint = precedence == PrecedenceShardID
 		case "destination":
 			if v, ok := v.(string); ok {
 				cs.Destination = v
 			} else {
 				lr.errorf("destination constraint must be string")
 			}
-			validConstraint = precedence == PrecedenceDestination
 		default:
 			lr.errorf("unknown constraint type %q", k)
+			continue
 		}
 
 		// don't log error for PrecedenceUnknown, we would already have logged for an
 		// unregistered key above
 		// TODO: raise this to error level
-		if !validConstraint && precedence != PrecedenceUnknown {
-			lr.warnf("constraint %q isn't valid for dynamic config key %q", k, key)
+		if !usesConstraint(precedence, k) && precedence != PrecedenceUnknown {
+			lr.strictf("constraint %q isn't valid for dynamic config key %q", k, key)
 		}
 	}
 	return cs
 }
 
+// usesConstraint returns whether settings with the given precedence use the constraint with the given name (as
+// written in the dynamic config file, case-insensitive).
+func usesConstraint(precedence Precedence, name string) bool {
+	switch strings.ToLower(name) {
+	case "namespace":
+		return precedence == PrecedenceNamespace || precedence == PrecedenceTaskQueue || precedence == PrecedenceDestination
+	case "namespaceid":
+		return precedence == PrecedenceNamespaceID
+	case "taskqueuename", "tasktype":
+		return precedence == PrecedenceTaskQueue
+	case "historytasktype":
+		return precedence == PrecedenceTaskType
+	case "shardid":
+		return precedence == PrecedenceShardID
+	case "destination":
+		return precedence == PrecedenceDestination
+	}
+	return false
+}
+
+// unusedConstraints returns the names of the constraints set in cs that settings with the given precedence don't use.
+func unusedConstraints(precedence Precedence, cs Constraints) []string {
+	var unused []string
+	for _, c := range []struct {
+		name string
+		set  bool
+	}{
+		{"namespace", cs.Namespace != ""},
+		{"namespaceID", cs.NamespaceID != ""},
+		{"taskQueueName", cs.TaskQueueName != ""},
+		{"taskType", cs.TaskQueueType != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED},
+		{"historyTaskType", cs.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED},
+		{"shardID", cs.ShardID != 0},
+		{"destination", cs.Destination != ""},
+	} {
+		if c.set && !usesConstraint(precedence, c.name) {
+			unused = append(unused, c.name)
+		}
+	}
+	return unused
+}
+
 func (r *osReader) ReadFile() ([]byte, error) {
 	return os.ReadFile(r.path)
 }
@@ -535,3 +583,11 @@ func (lr *LoadResult) error(err error) *LoadResult {
 func (lr *LoadResult) errorf(format string, args ...any) *LoadResult {
 	return lr.error(fmt.Errorf(format, args...))
 }
+
+// strictf reports an error in strict mode and a warning otherwise.
+func (lr *LoadResult) strictf(format string, args ...any) *LoadResult {
+	if lr.strict {
+		return lr.errorf(format, args...)
+	}
+	return lr.warnf(format, args...)
+}
@@ -1,6 +1,7 @@
 package dynamicconfig_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -595,6 +596,29 @@ testGetIntPropertyKey:
 	s.Equal(3, len(lr.Warnings))
 }
 
+func (s *fileBasedClientSuite) TestStrictValidation() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFileStrict([]byte(`
+unknownKey:
+- value: "5d"
+testGetIntPropertyKey:
+- value: not a number
+  constraints:
+    namespace: samples-namespace
+`))
+	s.Empty(lr.Warnings)
+	s.Equal(3, len(lr.Errors))
+	s.ErrorContains(errors.Join(lr.Errors...), `unregistered key "unknownKey"`)
+
+	lr = dynamicconfig.ValidateFileStrict([]byte(`
+testGetIntPropertyKey:
+- value: 2000
+`))
+	s.Empty(lr.Warnings)
+	s.Empty(lr.Errors)
+}
+
 func (s *fileBasedClientSuite) TestErrorYamlDecode() {
 	lr := dynamicconfig.ValidateFile([]byte(`}}}}}}}}}`))
 	s.Equal(1, len(lr.Errors))
@@ -0,0 +1,354 @@
+package dynamicconfig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	expmaps "golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+)
+
+var _ Client = (*persistedClient)(nil)
+var _ NotifyingClient = (*persistedClient)(nil)
+
+const (
+	defaultPersistedPollInterval = 10 * time.Second
+	minPersistedPollInterval     = time.Second
+	persistedLoadTimeout         = 10 * time.Second
+)
+
+type (
+	// PersistedClientConfig is the config for the client that layers the dynamic config overrides stored in
+	// persistence (and managed through the admin API) over the configured dynamic config client.
+	PersistedClientConfig struct {
+		// Disabled turns off reading overrides from persistence.
+		Disabled bool `yaml:"disabled"`
+		// PollInterval is how often overrides are reloaded from persistence. Defaults to 10s.
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// PersistedConfigLoader loads the dynamic config overrides stored in persistence and their version.
+	PersistedConfigLoader func(ctx context.Context) (int64, *persistencespb.DynamicConfig, error)
+
+	persistedSnapshot struct {
+		version int64
+		values  configValueMap
+	}
+
+	// mergedValue caches the result of merging persisted and fallback values for a key, so that the same slice
+	// is returned as long as neither input changes.
+	mergedValue struct {
+		persisted []ConstrainedValue
+		fallback  []ConstrainedValue
+		merged    []ConstrainedValue
+	}
+
+	persistedClient struct {
+		fallback Client
+		loader   PersistedConfigLoader
+		logger   log.Logger
+		interval time.Duration
+		doneCh   <-chan interface{}
+
+		snapshot atomic.Pointer[persistedSnapshot]
+		keys     collection.SyncMap[Key, string] // normalized key cache
+		merged   collection.SyncMap[string, *mergedValue]
+
+		// updateLock serializes notifications to subscribers from the poll loop and the fallback client.
+		updateLock       sync.Mutex
+		subscriptionLock sync.Mutex
+		subscriptionIdx  int
+		subscriptions    map[int]ClientUpdateFunc
+	}
+)
+
+// Enabled returns whether the dynamic config overrides stored in persistence are used. A nil config disables them.
+func (c *PersistedClientConfig) Enabled() bool {
+	return c != nil && !c.Disabled
+}
+
+// NewPersistedClient returns a client that layers the dynamic config overrides returned by loader over the values
+// of the fallback client. For each key, a persisted value replaces the fallback value with the same constraints;
+// fallback values with other constraints are still used.
+//
+// Overrides are loaded once synchronously and then every PollInterval until doneCh is closed. Failing to load
+// overrides is logged and the last loaded overrides (or none) are used.
+func NewPersistedClient(
+	config *PersistedClientConfig,
+	fallback Client,
+	loader PersistedConfigLoader,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (*persistedClient, error) {
+	interval := defaultPersistedPollInterval
+	if config != nil && config.PollInterval != 0 {
+		interval = config.PollInterval
+	}
+	if interval < minPersistedPollInterval {
+		return nil, fmt.Errorf("persisted dynamic config poll interval should be at least %v", minPersistedPollInterval)
+	}
+
+	client := &persistedClient{
+		fallback:      fallback,
+		loader:        loader,
+		logger:        logger,
+		interval:      interval,
+		doneCh:        doneCh,
+		keys:          collection.NewSyncMap[Key, string](),
+		merged:        collection.NewSyncMap[string, *mergedValue](),
+		subscriptions: make(map[int]ClientUpdateFunc),
+	}
+	client.snapshot.Store(&persistedSnapshot{values: make(configValueMap)})
+
+	if err := client.Update(); err != nil {
+		logger.Error("Unable to load dynamic config overrides from persistence.", tag.Error(err))
+	}
+
+	if notifying, ok := fallback.(NotifyingClient); ok {
+		cancel := notifying.Subscribe(client.onFallbackUpdate)
+		go func() {
+			<-doneCh
+			cancel()
+		}()
+	}
+
+	go client.pollLoop()
+
+	return client, nil
+}
+
+func (pc *persistedClient) GetValue(key Key) []ConstrainedValue {
+	// Cache normalized keys to avoid string allocations
+	normalized, ok := pc.keys.Get(key)
+	if !ok {
+		normalized = strings.ToLower(key.String())
+		pc.keys.Set(key, normalized)
+	}
+
+	persisted := pc.snapshot.Load().values[normalized]
+	fallback := pc.fallback.GetValue(key)
+	if len(persisted) == 0 {
+		return fallback
+	}
+	if len(fallback) == 0 {
+		return persisted
+	}
+
+	if cached, ok := pc.merged.Get(normalized); ok &&
+		sameSlice(cached.persisted, persisted) && sameSlice(cached.fallback, fallback) {
+		return cached.merged
+	}
+	merged := mergeConstrainedValues(persisted, fallback)
+	pc.merged.Set(normalized, &mergedValue{persisted: persisted, fallback: fallback, merged: merged})
+	return merged
+}
+
+func (pc *persistedClient) Subscribe(f ClientUpdateFunc) (cancel func()) {
+	pc.subscriptionLock.Lock()
+	defer pc.subscriptionLock.Unlock()
+
+	pc.subscriptionIdx++
+	id := pc.subscriptionIdx
+	pc.subscriptions[id] = f
+
+	return func() {
+		pc.subscriptionLock.Lock()
+		defer pc.subscriptionLock.Unlock()
+		delete(pc.subscriptions, id)
+	}
+}
+
+func (pc *persistedClient) pollLoop() {
+	ticker := time.NewTicker(pc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := pc.Update(); err != nil {
+				pc.logger.Error("Unable to update dynamic config overrides from persistence.", tag.Error(err))
+			}
+		case <-pc.doneCh:
+			return
+		}
+	}
+}
+
+// Update reloads the overrides from persistence. This is public mainly for testing. The poll loop will call this
+// periodically, you don't have to call it explicitly.
+func (pc *persistedClient) Update() error {
+	ctx, cancel := context.WithTimeout(context.Background(), persistedLoadTimeout)
+	defer cancel()
+
+	version, config, err := pc.loader(ctx)
+	if err != nil {
+		return err
+	}
+
+	pc.updateLock.Lock()
+	defer pc.updateLock.Unlock()
+
+	old := pc.snapshot.Load()
+	if version == old.version {
+		return nil
+	}
+
+	values, errs := loadPersistedConfig(config)
+	for _, e := range errs {
+		// Invalid values are skipped, the rest of the overrides are still used.
+		pc.logger.Warn("dynamic config override error", tag.Error(e))
+	}
+	pc.snapshot.Store(&persistedSnapshot{version: version, values: values})
+	pc.logger.Info("Updated dynamic config overrides from persistence", tag.NewInt64("version", version))
+
+	changed := make(map[Key][]ConstrainedValue)
+	for key, newValues := range values {
+		if oldValues, ok := old.values[key]; !ok || !reflect.DeepEqual(oldValues, newValues) {
+			changed[Key(key)] = pc.GetValue(Key(key))
+		}
+	}
+	for key := range old.values {
+		if _, ok := values[key]; !ok {
+			changed[Key(key)] = pc.GetValue(Key(key))
+		}
+	}
+	pc.notifyLocked(changed)
+	return nil
+}
+
+func (pc *persistedClient) onFallbackUpdate(changed map[Key][]ConstrainedValue) {
+	pc.updateLock.Lock()
+	defer pc.updateLock.Unlock()
+
+	merged := make(map[Key][]ConstrainedValue, len(changed))
+	for key := range changed {
+		merged[key] = pc.GetValue(key)
+	}
+	pc.notifyLocked(merged)
+}
+
+func (pc *persistedClient) notifyLocked(changed map[Key][]ConstrainedValue) {
+	if len(changed) == 0 {
+		return
+	}
+
+	pc.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(pc.subscriptions)
+	pc.subscriptionLock.Unlock()
+
+	for _, update := range subscriptions {
+		update(changed)
+	}
+}
+
+func loadPersistedConfig(config *persistencespb.DynamicConfig) (configValueMap, []error) {
+	var errs []error
+	values := make(configValueMap, len(config.GetEntries()))
+	for _, entry := range config.GetEntries() {
+		cvs := make([]ConstrainedValue, 0, len(entry.GetValues()))
+		for _, v := range entry.GetValues() {
+			value, err := ParsePersistedValue(v.GetValue())
+			if err != nil {
+				errs = append(errs, fmt.Errorf("key %q: %w", entry.GetKey(), err))
+				continue
+			}
+			cvs = append(cvs, ConstrainedValue{
+				Constraints: ConstraintsFromProto(v.GetConstraints()),
+				Value:       value,
+			})
+		}
+		if len(cvs) > 0 {
+			values[strings.ToLower(entry.GetKey())] = cvs
+		}
+	}
+	return values, errs
+}
+
+// ParsePersistedValue parses a dynamic config value stored in persistence. Values are stored as YAML (or JSON) in
+// the form they would have in the dynamic config file.
+func ParsePersistedValue(value string) (any, error) {
+	var v any
+	if err := yaml.Unmarshal([]byte(value), &v); err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+	return convertKeyTypeToString(v)
+}
+
+// ValidatePersistedValue checks a dynamic config value to be stored in persistence for key as strictly as
+// ValidateFileStrict: key must be a registered setting, the value must convert to the type of the setting and only
+// constraints used by the setting may be set.
+func ValidatePersistedValue(key string, value *persistencespb.DynamicConfigValue) error {
+	setting := queryRegistry(Key(key))
+	if setting == nil {
+		return fmt.Errorf("unregistered key %q", key)
+	}
+	v, err := ParsePersistedValue(value.GetValue())
+	if err != nil {
+		return err
+	}
+	if err := setting.Validate(v); err != nil {
+		return fmt.Errorf("validation failed: key %q value %v: %w", key, v, err)
+	}
+	if unused := unusedConstraints(setting.Precedence(), ConstraintsFromProto(value.GetConstraints())); len(unused) > 0 {
+		return fmt.Errorf("constraints %v aren't valid for dynamic config key %q", unused, key)
+	}
+	return nil
+}
+
+// ConstraintsFromProto converts the constraints of a dynamic config value stored in persistence.
+func ConstraintsFromProto(c *persistencespb.DynamicConfigConstraints) Constraints {
+	return Constraints{
+		Namespace:     c.GetNamespace(),
+		NamespaceID:   c.GetNamespaceId(),
+		TaskQueueName: c.GetTaskQueueName(),
+		TaskQueueType: c.GetTaskQueueType(),
+		ShardID:       c.GetShardId(),
+		TaskType:      c.GetTaskType(),
+		Destination:   c.GetDestination(),
+	}
+}
+
+// ConstraintsToProto converts constraints to the form stored in persistence.
+func ConstraintsToProto(c Constraints) *persistencespb.DynamicConfigConstraints {
+	return &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.Namespace,
+		NamespaceId:   c.NamespaceID,
+		TaskQueueName: c.TaskQueueName,
+		TaskQueueType: c.TaskQueueType,
+		ShardId:       c.ShardID,
+		TaskType:      c.TaskType,
+		Destination:   c.Destination,
+	}
+}
+
+// mergeConstrainedValues returns the persisted values plus the fallback values with constraints that don't have a
+// persisted value.
+func mergeConstrainedValues(persisted, fallback []ConstrainedValue) []ConstrainedValue {
+	merged := make([]ConstrainedValue, len(persisted), len(persisted)+len(fallback))
+	copy(merged, persisted)
+	for _, fv := range fallback {
+		overridden := false
+		for _, pv := range persisted {
+			if pv.Constraints == fv.Constraints {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged = append(merged, fv)
+		}
+	}
+	return merged
+}
+
+func sameSlice(a, b []ConstrainedValue) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
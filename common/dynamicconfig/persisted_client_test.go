@@ -0,0 +1,205 @@
+package dynamicconfig_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+type testPersistedClient interface {
+	dynamicconfig.Client
+	dynamicconfig.NotifyingClient
+	Update() error
+}
+
+type testPersistedLoader struct {
+	sync.Mutex
+	version int64
+	config  *persistencespb.DynamicConfig
+	err     error
+}
+
+func (l *testPersistedLoader) load(context.Context) (int64, *persistencespb.DynamicConfig, error) {
+	l.Lock()
+	defer l.Unlock()
+	return l.version, l.config, l.err
+}
+
+func (l *testPersistedLoader) set(version int64, entries ...*persistencespb.DynamicConfigEntry) {
+	l.Lock()
+	defer l.Unlock()
+	l.version = version
+	l.config = &persistencespb.DynamicConfig{Entries: entries}
+}
+
+func persistedEntry(key string, namespace string, value string) *persistencespb.DynamicConfigEntry {
+	return &persistencespb.DynamicConfigEntry{
+		Key: key,
+		Values: []*persistencespb.DynamicConfigValue{{
+			Constraints: &persistencespb.DynamicConfigConstraints{Namespace: namespace},
+			Value:       value,
+		}},
+	}
+}
+
+func newTestPersistedClient(t *testing.T, fallback dynamicconfig.Client, loader *testPersistedLoader) testPersistedClient {
+	doneCh := make(chan interface{})
+	t.Cleanup(func() { close(doneCh) })
+	client, err := dynamicconfig.NewPersistedClient(
+		&dynamicconfig.PersistedClientConfig{PollInterval: time.Hour},
+		fallback,
+		loader.load,
+		log.NewNoopLogger(),
+		doneCh,
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func TestPersistedClient_LayersOverFallback(t *testing.T) {
+	fallback := dynamicconfig.NewMemoryClient()
+	fallback.OverrideSetting(dynamicconfig.FrontendRPS, 100)
+	fallback.OverrideSetting(dynamicconfig.FrontendNamespaceReplicationInducingAPIsRPS, 10)
+
+	loader := &testPersistedLoader{}
+	loader.set(1,
+		persistedEntry("Frontend.RPS", "", "200"),
+		persistedEntry("frontend.namespaceRPS", "ns", "50"),
+	)
+	client := newTestPersistedClient(t, fallback, loader)
+
+	// same constraints: persisted value wins
+	require.Equal(t,
+		[]dynamicconfig.ConstrainedValue{{Value: 200}},
+		client.GetValue(dynamicconfig.FrontendRPS.Key()))
+	// only persisted
+	require.Equal(t,
+		[]dynamicconfig.ConstrainedValue{{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 50}},
+		client.GetValue(dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key()))
+	// only fallback
+	require.Equal(t,
+		[]dynamicconfig.ConstrainedValue{{Value: 10}},
+		client.GetValue(dynamicconfig.FrontendNamespaceReplicationInducingAPIsRPS.Key()))
+}
+
+func TestPersistedClient_MergesConstraints(t *testing.T) {
+	key := dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key()
+	fallback := dynamicconfig.NewMemoryClient()
+	fallback.OverrideValue(key, []dynamicconfig.ConstrainedValue{
+		{Value: 10},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 20},
+	})
+
+	loader := &testPersistedLoader{}
+	loader.set(1, persistedEntry(key.String(), "ns", "30"))
+	client := newTestPersistedClient(t, fallback, loader)
+
+	cvs := client.GetValue(key)
+	require.ElementsMatch(t, []dynamicconfig.ConstrainedValue{
+		{Value: 10},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 30},
+	}, cvs)
+	// merged slice is cached while inputs don't change
+	require.Same(t, &cvs[0], &client.GetValue(key)[0])
+}
+
+func TestPersistedClient_UpdateAndSubscribe(t *testing.T) {
+	key := dynamicconfig.FrontendRPS.Key()
+	fallback := dynamicconfig.NewMemoryClient()
+	loader := &testPersistedLoader{}
+	loader.set(1, persistedEntry(key.String(), "", "200"))
+
+	client := newTestPersistedClient(t, fallback, loader)
+
+	var changes []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	client.Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		changes = append(changes, changed)
+	})
+
+	// same version: no change
+	require.NoError(t, client.Update())
+	require.Empty(t, changes)
+
+	loader.set(2, persistedEntry(key.String(), "", "300"))
+	require.NoError(t, client.Update())
+	require.Len(t, changes, 1)
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 300}}, changes[0][key])
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 300}}, client.GetValue(key))
+
+	// fallback updates are forwarded with the merged value
+	fallback.OverrideValue(key, 100)
+	require.Len(t, changes, 2)
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 300}}, changes[1][key])
+
+	// deleted override falls back
+	loader.set(3)
+	require.NoError(t, client.Update())
+	require.Len(t, changes, 3)
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 100}}, client.GetValue(key))
+}
+
+func TestPersistedClient_LoadErrors(t *testing.T) {
+	key := dynamicconfig.FrontendRPS.Key()
+	fallback := dynamicconfig.NewMemoryClient()
+	fallback.OverrideValue(key, 100)
+
+	loader := &testPersistedLoader{err: errors.New("unavailable")}
+	client := newTestPersistedClient(t, fallback, loader)
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 100}}, client.GetValue(key))
+
+	// invalid values are skipped
+	loader.Lock()
+	loader.err = nil
+	loader.Unlock()
+	loader.set(1,
+		persistedEntry(key.String(), "", "{"),
+		persistedEntry("frontend.namespaceRPS", "", "5"),
+	)
+	require.NoError(t, client.Update())
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 100}}, client.GetValue(key))
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 5}}, client.GetValue(dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key()))
+}
+
+func TestPersistedClient_InvalidPollInterval(t *testing.T) {
+	_, err := dynamicconfig.NewPersistedClient(
+		&dynamicconfig.PersistedClientConfig{PollInterval: time.Millisecond},
+		dynamicconfig.NewNoopClient(),
+		(&testPersistedLoader{}).load,
+		log.NewNoopLogger(),
+		make(chan interface{}),
+	)
+	require.Error(t, err)
+}
+
+func TestValidatePersistedValue(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	dynamicconfig.NewGlobalIntSetting("testValidate.rps", 10, "")
+	dynamicconfig.NewNamespaceIntSetting("testValidate.namespaceRPS", 10, "")
+
+	value := func(v string, constraints *persistencespb.DynamicConfigConstraints) *persistencespb.DynamicConfigValue {
+		return &persistencespb.DynamicConfigValue{Constraints: constraints, Value: v}
+	}
+	namespace := &persistencespb.DynamicConfigConstraints{Namespace: "ns"}
+
+	require.NoError(t, dynamicconfig.ValidatePersistedValue("testValidate.rps", value("100", nil)))
+	require.NoError(t, dynamicconfig.ValidatePersistedValue("TestValidate.NamespaceRPS", value("100", namespace)))
+
+	require.ErrorContains(t, dynamicconfig.ValidatePersistedValue("testValidate.unknown", value("100", nil)),
+		`unregistered key "testValidate.unknown"`)
+	require.ErrorContains(t, dynamicconfig.ValidatePersistedValue("testValidate.rps", value("{", nil)),
+		"decode error")
+	require.ErrorContains(t, dynamicconfig.ValidatePersistedValue("testValidate.rps", value("fast", nil)),
+		"validation failed")
+	require.ErrorContains(t, dynamicconfig.ValidatePersistedValue("testValidate.rps", value("100", namespace)),
+		`constraints [namespace] aren't valid for dynamic config key "testValidate.rps"`)
+	require.ErrorContains(t, dynamicconfig.ValidatePersistedValue("testValidate.namespaceRPS", value("100",
+		&persistencespb.DynamicConfigConstraints{Namespace: "ns", TaskQueueName: "tq", ShardId: 1})),
+		"constraints [taskQueueName shardID] aren't valid")
+}
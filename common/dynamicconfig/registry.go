@@ -2,6 +2,7 @@ package dynamicconfig
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"sync/atomic"
 )
@@ -38,6 +39,21 @@ func queryRegistry(k Key) GenericSetting {
 	return globalRegistry.settings[strings.ToLower(k.String())]
 }
 
+// registeredSettings returns all registered settings, sorted by key.
+func registeredSettings() []GenericSetting {
+	if !globalRegistry.queried.Load() {
+		globalRegistry.queried.Store(true)
+	}
+	settings := make([]GenericSetting, 0, len(globalRegistry.settings))
+	for _, s := range globalRegistry.settings {
+		settings = append(settings, s)
+	}
+	slices.SortFunc(settings, func(a, b GenericSetting) int {
+		return strings.Compare(strings.ToLower(a.Key().String()), strings.ToLower(b.Key().String()))
+	})
+	return settings
+}
+
 // For testing only; do not call from regular code!
 func ResetRegistryForTest() {
 	globalRegistry.settings = nil
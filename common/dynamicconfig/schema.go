@@ -0,0 +1,154 @@
+package dynamicconfig
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// Constraints that can be used for settings of each precedence, as they are written in the
+// dynamic config file.
+var fileConstraintsByPrecedence = map[Precedence][]string{
+	PrecedenceGlobal:      nil,
+	PrecedenceNamespace:   {"namespace"},
+	PrecedenceNamespaceID: {"namespaceId"},
+	PrecedenceTaskQueue:   {"namespace", "taskQueueName", "taskType"},
+	PrecedenceShardID:     {"shardId"},
+	PrecedenceTaskType:    {"historyTaskType"},
+	PrecedenceDestination: {"namespace", "destination"},
+}
+
+var fileConstraintSchemas = map[string]map[string]any{
+	"namespace":       {"type": "string"},
+	"namespaceId":     {"type": "string"},
+	"taskQueueName":   {"type": "string"},
+	"taskType":        {"description": "Task queue type, e.g. Workflow or Activity", "type": []string{"string", "integer"}},
+	"historyTaskType": {"description": "History task type, e.g. TRANSFER_ACTIVITY_TASK", "type": []string{"string", "integer"}},
+	"shardId":         {"type": "integer"},
+	"destination":     {"type": "string"},
+}
+
+// JSONSchema returns a JSON Schema for dynamic config files that describes all registered settings:
+// their type, default value, description, and the constraints they can be used with. Note that keys
+// are case-insensitive in dynamic config files but not in the schema.
+func JSONSchema() ([]byte, error) {
+	properties := make(map[string]any)
+	for _, s := range registeredSettings() {
+		properties[s.Key().String()] = settingSchema(s)
+	}
+	return json.MarshalIndent(map[string]any{
+		"$schema":              jsonSchemaDraft,
+		"title":                "Temporal dynamic config",
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}, "", "  ")
+}
+
+func settingSchema(s GenericSetting) map[string]any {
+	value := typeSchema(s.valueType())
+	if def, ok := s.defaultValue(); ok {
+		if def, ok := jsonDefault(def); ok {
+			value["default"] = def
+		}
+	}
+
+	constraintProperties := make(map[string]any)
+	for _, name := range fileConstraintsByPrecedence[s.Precedence()] {
+		constraintProperties[name] = fileConstraintSchemas[name]
+	}
+
+	return map[string]any{
+		"description": s.Description(),
+		"type":        "array",
+		"items": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"value": value,
+				"constraints": map[string]any{
+					"type":                 "object",
+					"properties":           constraintProperties,
+					"additionalProperties": false,
+				},
+			},
+			"required":             []string{"value"},
+			"additionalProperties": false,
+		},
+	}
+}
+
+// typeSchema returns the schema of values that can be converted to t. It's lenient where the
+// conversion is: e.g. numbers are accepted for durations and strings for types with a parse hook.
+func typeSchema(t reflect.Type) map[string]any {
+	switch {
+	case t == durationType:
+		return map[string]any{
+			"description": "Duration, e.g. 10s or 1h, or a number of seconds",
+			"type":        []string{"string", "number"},
+		}
+	case t.Implements(protoEnumType):
+		return map[string]any{"type": []string{"string", "integer"}}
+	}
+	if _, ok := t.MethodByName("DynamicConfigParseHook"); ok {
+		// may be parsed from any type
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		schema := map[string]any{"type": "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			schema["additionalProperties"] = typeSchema(t.Elem())
+		}
+		return schema
+	case reflect.Struct:
+		properties := make(map[string]any)
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.IsExported() {
+				properties[f.Name] = typeSchema(f.Type)
+			}
+		}
+		// field names are matched case-insensitively, so other properties can't be rejected
+		return map[string]any{"type": "object", "properties": properties}
+	case reflect.Pointer:
+		return typeSchema(t.Elem())
+	default:
+		return map[string]any{}
+	}
+}
+
+// jsonDefault returns the default value as it would be written in the dynamic config file, for
+// simple types.
+func jsonDefault(v any) (any, bool) {
+	if v == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type() == durationType:
+		return rv.Interface().(interface{ String() string }).String(), true
+	case rv.Type().Implements(protoEnumType):
+		return rv.Interface().(interface{ String() string }).String(), true
+	}
+	switch rv.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return v, true
+	default:
+		return nil, false
+	}
+}
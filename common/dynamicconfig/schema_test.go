@@ -0,0 +1,65 @@
+package dynamicconfig_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestJSONSchema(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	dynamicconfig.NewNamespaceIntSetting("testSchema.rps", 2400, "rate limit per second")
+	dynamicconfig.NewTaskQueueIntSettingWithConstrainedDefault("testSchema.partitions", []dynamicconfig.TypedConstrainedValue[int]{{Value: 4}}, "")
+	dynamicconfig.NewGlobalDurationSetting("testSchema.interval", 5*time.Minute, "")
+	dynamicconfig.NewGlobalTypedSetting("testSchema.struct", struct {
+		Count int
+		Names []string
+	}{}, "")
+
+	schemaJSON, err := dynamicconfig.JSONSchema()
+	require.NoError(t, err)
+
+	var schema struct {
+		Properties map[string]struct {
+			Description string
+			Items       struct {
+				Properties struct {
+					Value       map[string]any
+					Constraints struct {
+						Properties map[string]any
+					}
+				}
+			}
+		}
+		AdditionalProperties bool
+	}
+	require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+	require.False(t, schema.AdditionalProperties)
+	require.Len(t, schema.Properties, 4)
+
+	rps := schema.Properties["testSchema.rps"]
+	require.Equal(t, "rate limit per second", rps.Description)
+	require.Equal(t, "integer", rps.Items.Properties.Value["type"])
+	require.EqualValues(t, 2400, rps.Items.Properties.Value["default"])
+	require.Len(t, rps.Items.Properties.Constraints.Properties, 1)
+	require.Contains(t, rps.Items.Properties.Constraints.Properties, "namespace")
+
+	partitions := schema.Properties["testSchema.partitions"]
+	require.NotContains(t, partitions.Items.Properties.Value, "default")
+	require.Len(t, partitions.Items.Properties.Constraints.Properties, 3)
+
+	interval := schema.Properties["testSchema.interval"]
+	require.Equal(t, []any{"string", "number"}, interval.Items.Properties.Value["type"])
+	require.Equal(t, "5m0s", interval.Items.Properties.Value["default"])
+	require.Empty(t, interval.Items.Properties.Constraints.Properties)
+
+	structValue := schema.Properties["testSchema.struct"].Items.Properties.Value
+	require.Equal(t, "object", structValue["type"])
+	require.Equal(t, map[string]any{
+		"Count": map[string]any{"type": "integer"},
+		"Names": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+	}, structValue["properties"])
+}
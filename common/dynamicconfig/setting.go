@@ -2,6 +2,8 @@
 
 package dynamicconfig
 
+import "reflect"
+
 type (
 	// Precedence is an enum for the search order precedence of a dynamic config setting.
 	// E.g., use the global value, check namespace then global, check task queue then
@@ -34,9 +36,15 @@ type (
 		Key() Key
 		Precedence() Precedence
 		Validate(v any) error
+		Description() string
 
 		// for internal use:
 		dispatchUpdate(*Collection, any, []ConstrainedValue)
+		// defaultValue returns the default value, unless the setting has a constrained default.
+		defaultValue() (any, bool)
+		valueType() reflect.Type
+		// lookup returns the value of the setting in c for the fields of l used by its precedence.
+		lookup(c *Collection, l Constraints) any
 	}
 
 	// GenericParseHook is an interface that may be implemented by a setting type or a field
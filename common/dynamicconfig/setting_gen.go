@@ -3,6 +3,7 @@
 package dynamicconfig
 
 import (
+	"reflect"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -787,6 +788,12 @@ func (s GlobalTypedSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s GlobalTypedSetting[T]) Description() string       { return s.description }
+func (s GlobalTypedSetting[T]) defaultValue() (any, bool) { return s.def, true }
+func (s GlobalTypedSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s GlobalTypedSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)()
+}
 
 func (s GlobalTypedConstrainedDefaultSetting[T]) Key() Key               { return s.key }
 func (s GlobalTypedConstrainedDefaultSetting[T]) Precedence() Precedence { return PrecedenceGlobal }
@@ -794,6 +801,12 @@ func (s GlobalTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s GlobalTypedConstrainedDefaultSetting[T]) Description() string       { return s.description }
+func (s GlobalTypedConstrainedDefaultSetting[T]) defaultValue() (any, bool) { return nil, false }
+func (s GlobalTypedConstrainedDefaultSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s GlobalTypedConstrainedDefaultSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)()
+}
 
 func (s GlobalTypedSetting[T]) WithDefault(v T) GlobalTypedSetting[T] {
 	newS := s
@@ -923,6 +936,12 @@ func (s NamespaceTypedSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s NamespaceTypedSetting[T]) Description() string       { return s.description }
+func (s NamespaceTypedSetting[T]) defaultValue() (any, bool) { return s.def, true }
+func (s NamespaceTypedSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s NamespaceTypedSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.Namespace)
+}
 
 func (s NamespaceTypedConstrainedDefaultSetting[T]) Key() Key               { return s.key }
 func (s NamespaceTypedConstrainedDefaultSetting[T]) Precedence() Precedence { return PrecedenceNamespace }
@@ -930,6 +949,12 @@ func (s NamespaceTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s NamespaceTypedConstrainedDefaultSetting[T]) Description() string       { return s.description }
+func (s NamespaceTypedConstrainedDefaultSetting[T]) defaultValue() (any, bool) { return nil, false }
+func (s NamespaceTypedConstrainedDefaultSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s NamespaceTypedConstrainedDefaultSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.Namespace)
+}
 
 func (s NamespaceTypedSetting[T]) WithDefault(v T) NamespaceTypedSetting[T] {
 	newS := s
@@ -1059,6 +1084,12 @@ func (s NamespaceIDTypedSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s NamespaceIDTypedSetting[T]) Description() string       { return s.description }
+func (s NamespaceIDTypedSetting[T]) defaultValue() (any, bool) { return s.def, true }
+func (s NamespaceIDTypedSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s NamespaceIDTypedSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(namespace.ID(l.NamespaceID))
+}
 
 func (s NamespaceIDTypedConstrainedDefaultSetting[T]) Key() Key               { return s.key }
 func (s NamespaceIDTypedConstrainedDefaultSetting[T]) Precedence() Precedence { return PrecedenceNamespaceID }
@@ -1066,6 +1097,12 @@ func (s NamespaceIDTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s NamespaceIDTypedConstrainedDefaultSetting[T]) Description() string       { return s.description }
+func (s NamespaceIDTypedConstrainedDefaultSetting[T]) defaultValue() (any, bool) { return nil, false }
+func (s NamespaceIDTypedConstrainedDefaultSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s NamespaceIDTypedConstrainedDefaultSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(namespace.ID(l.NamespaceID))
+}
 
 func (s NamespaceIDTypedSetting[T]) WithDefault(v T) NamespaceIDTypedSetting[T] {
 	newS := s
@@ -1195,6 +1232,12 @@ func (s TaskQueueTypedSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s TaskQueueTypedSetting[T]) Description() string       { return s.description }
+func (s TaskQueueTypedSetting[T]) defaultValue() (any, bool) { return s.def, true }
+func (s TaskQueueTypedSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s TaskQueueTypedSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.Namespace, l.TaskQueueName, l.TaskQueueType)
+}
 
 func (s TaskQueueTypedConstrainedDefaultSetting[T]) Key() Key               { return s.key }
 func (s TaskQueueTypedConstrainedDefaultSetting[T]) Precedence() Precedence { return PrecedenceTaskQueue }
@@ -1202,6 +1245,12 @@ func (s TaskQueueTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s TaskQueueTypedConstrainedDefaultSetting[T]) Description() string       { return s.description }
+func (s TaskQueueTypedConstrainedDefaultSetting[T]) defaultValue() (any, bool) { return nil, false }
+func (s TaskQueueTypedConstrainedDefaultSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s TaskQueueTypedConstrainedDefaultSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.Namespace, l.TaskQueueName, l.TaskQueueType)
+}
 
 func (s TaskQueueTypedSetting[T]) WithDefault(v T) TaskQueueTypedSetting[T] {
 	newS := s
@@ -1355,6 +1404,12 @@ func (s ShardIDTypedSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s ShardIDTypedSetting[T]) Description() string       { return s.description }
+func (s ShardIDTypedSetting[T]) defaultValue() (any, bool) { return s.def, true }
+func (s ShardIDTypedSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s ShardIDTypedSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.ShardID)
+}
 
 func (s ShardIDTypedConstrainedDefaultSetting[T]) Key() Key               { return s.key }
 func (s ShardIDTypedConstrainedDefaultSetting[T]) Precedence() Precedence { return PrecedenceShardID }
@@ -1362,6 +1417,12 @@ func (s ShardIDTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s ShardIDTypedConstrainedDefaultSetting[T]) Description() string       { return s.description }
+func (s ShardIDTypedConstrainedDefaultSetting[T]) defaultValue() (any, bool) { return nil, false }
+func (s ShardIDTypedConstrainedDefaultSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s ShardIDTypedConstrainedDefaultSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.ShardID)
+}
 
 func (s ShardIDTypedSetting[T]) WithDefault(v T) ShardIDTypedSetting[T] {
 	newS := s
@@ -1491,6 +1552,12 @@ func (s TaskTypeTypedSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s TaskTypeTypedSetting[T]) Description() string       { return s.description }
+func (s TaskTypeTypedSetting[T]) defaultValue() (any, bool) { return s.def, true }
+func (s TaskTypeTypedSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s TaskTypeTypedSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.TaskType)
+}
 
 func (s TaskTypeTypedConstrainedDefaultSetting[T]) Key() Key               { return s.key }
 func (s TaskTypeTypedConstrainedDefaultSetting[T]) Precedence() Precedence { return PrecedenceTaskType }
@@ -1498,6 +1565,12 @@ func (s TaskTypeTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s TaskTypeTypedConstrainedDefaultSetting[T]) Description() string       { return s.description }
+func (s TaskTypeTypedConstrainedDefaultSetting[T]) defaultValue() (any, bool) { return nil, false }
+func (s TaskTypeTypedConstrainedDefaultSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s TaskTypeTypedConstrainedDefaultSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.TaskType)
+}
 
 func (s TaskTypeTypedSetting[T]) WithDefault(v T) TaskTypeTypedSetting[T] {
 	newS := s
@@ -1627,6 +1700,12 @@ func (s DestinationTypedSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s DestinationTypedSetting[T]) Description() string       { return s.description }
+func (s DestinationTypedSetting[T]) defaultValue() (any, bool) { return s.def, true }
+func (s DestinationTypedSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s DestinationTypedSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.Namespace, l.Destination)
+}
 
 func (s DestinationTypedConstrainedDefaultSetting[T]) Key() Key               { return s.key }
 func (s DestinationTypedConstrainedDefaultSetting[T]) Precedence() Precedence { return PrecedenceDestination }
@@ -1634,6 +1713,12 @@ func (s DestinationTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	_, err := s.convert(v)
 	return err
 }
+func (s DestinationTypedConstrainedDefaultSetting[T]) Description() string       { return s.description }
+func (s DestinationTypedConstrainedDefaultSetting[T]) defaultValue() (any, bool) { return nil, false }
+func (s DestinationTypedConstrainedDefaultSetting[T]) valueType() reflect.Type   { return reflect.TypeFor[T]() }
+func (s DestinationTypedConstrainedDefaultSetting[T]) lookup(c *Collection, l Constraints) any {
+	return s.Get(c)(l.Namespace, l.Destination)
+}
 
 func (s DestinationTypedSetting[T]) WithDefault(v T) DestinationTypedSetting[T] {
 	newS := s
@@ -269,6 +269,12 @@ const (
 	PersistenceCreateOrUpdateNexusEndpointScope = "CreateOrUpdateNexusEndpoint"
 	// PersistenceDeleteNexusEndpointScope tracks DeleteNexusEndpoint calls made by service to persistence layer
 	PersistenceDeleteNexusEndpointScope = "DeleteNexusEndpoint"
+	// PersistenceGetDynamicConfigScope tracks GetDynamicConfig calls made by service to persistence layer
+	PersistenceGetDynamicConfigScope = "GetDynamicConfig"
+	// PersistenceUpdateDynamicConfigScope tracks UpdateDynamicConfig calls made by service to persistence layer
+	PersistenceUpdateDynamicConfigScope = "UpdateDynamicConfig"
+	// PersistenceListDynamicConfigHistoryScope tracks ListDynamicConfigHistory calls made by service to persistence layer
+	PersistenceListDynamicConfigHistoryScope = "ListDynamicConfigHistory"
 
 	// VisibilityPersistenceRecordWorkflowExecutionStartedScope tracks RecordWorkflowExecutionStarted calls made by service to visibility persistence layer
 	VisibilityPersistenceRecordWorkflowExecutionStartedScope = "RecordWorkflowExecutionStarted"
@@ -1334,6 +1340,17 @@ var (
 	PersistenceSQLIdleConn                 = NewGaugeDef("persistence_sql_idle_conn")
 	PersistenceSQLInUse                    = NewGaugeDef("persistence_sql_in_use")
 
+	PersistenceBlobUncompressedSize = NewBytesHistogramDef(
+		"persistence_blob_uncompressed_size",
+		WithDescription("Size of persisted blobs before compression, keyed by `blob_type` and `compression_codec`"),
+	)
+	PersistenceBlobCompressedSize = NewBytesHistogramDef(
+		"persistence_blob_compressed_size",
+		WithDescription("Size of persisted blobs after compression, keyed by `blob_type` and `compression_codec`"),
+	)
+	PersistenceBlobCompressionLatency   = NewTimerDef("persistence_blob_compression_latency")
+	PersistenceBlobDecompressionLatency = NewTimerDef("persistence_blob_decompression_latency")
+
 	// Common service base metrics
 	RestartCount            = NewCounterDef("restarts")
 	NumGoRoutinesGauge      = NewGaugeDef("num_goroutines")
@@ -3,3 +3,18 @@ package nexus
 // SystemCallbackURL is the reserved callback URL used to route Nexus operation callbacks
 // internally within Temporal. It must match the scheme/host used in validation and routing logic.
 const SystemCallbackURL = "temporal://system"
+
+// CallerNamespaceHeader is set by the history service on requests to worker target endpoints to the name of the
+// namespace whose workflow scheduled the operation. The frontend only trusts it on requests from system principals.
+const CallerNamespaceHeader = "Temporal-Nexus-Caller-Namespace"
+
+// EndpointAllowlistNamespaceDataKeyPrefix prefixes the namespace data keys holding the allowlists of the endpoints
+// targeting a namespace. See EndpointAllowlistNamespaceDataKey.
+const EndpointAllowlistNamespaceDataKeyPrefix = "temporal.io/nexus-endpoint-allowlist/"
+
+// EndpointAllowlistNamespaceDataKey returns the data key, in the endpoint's target namespace, of the comma-separated
+// names of the namespaces allowed to call the endpoint. Endpoints without an allowlist may be called from any
+// namespace.
+func EndpointAllowlistNamespaceDataKey(endpointName string) string {
+	return EndpointAllowlistNamespaceDataKeyPrefix + endpointName
+}
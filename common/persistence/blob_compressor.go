@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	BlobTypeTagName         = "blob_type"
+	CompressionCodecTagName = "compression_codec"
+
+	blobTypeHistory      = "history"
+	blobTypeMutableState = "mutable_state"
+)
+
+type (
+	// BlobCompressor compresses history event batches and mutable state blobs before they are
+	// persisted, with the codec set for their namespace in dynamic config. Compressed blobs can be
+	// read whatever the current setting is, but not by server versions without compression
+	// support, so once any are written the cluster cannot be rolled back past this version.
+	BlobCompressor struct {
+		codec           dynamicconfig.StringPropertyFnWithNamespaceIDFilter
+		minSize         dynamicconfig.IntPropertyFnWithNamespaceIDFilter
+		metricsHandler  metrics.Handler
+		throttledLogger log.Logger
+	}
+)
+
+// NewBlobCompressor returns a BlobCompressor. Blobs are compressed if codec returns a codec name
+// for their namespace and they are at least minSize bytes.
+func NewBlobCompressor(
+	codec dynamicconfig.StringPropertyFnWithNamespaceIDFilter,
+	minSize dynamicconfig.IntPropertyFnWithNamespaceIDFilter,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *BlobCompressor {
+	return &BlobCompressor{
+		codec:           codec,
+		minSize:         minSize,
+		metricsHandler:  metricsHandler,
+		throttledLogger: log.NewThrottledLogger(logger, func() float64 { return 1 }),
+	}
+}
+
+// compress returns the blob compressed with the namespace's codec, or the blob itself if
+// compression is disabled for the namespace or the blob is too small. A nil BlobCompressor never
+// compresses.
+func (c *BlobCompressor) compress(
+	namespaceID string,
+	blobType string,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if c == nil || blob == nil {
+		return blob, nil
+	}
+	nsID := namespace.ID(namespaceID)
+	codec, err := serialization.ParseCompressionCodec(c.codec(nsID))
+	if err != nil {
+		c.throttledLogger.Warn("Invalid persistence blob compression codec, blobs are stored uncompressed",
+			tag.WorkflowNamespaceID(namespaceID), tag.Error(err))
+		return blob, nil
+	}
+	if codec == serialization.CompressionCodecNone || len(blob.Data) < c.minSize(nsID) {
+		return blob, nil
+	}
+
+	startTime := time.Now().UTC()
+	compressed, err := serialization.CompressBlob(blob, codec)
+	if err != nil {
+		return nil, err
+	}
+	handler := c.metricsHandler.WithTags(
+		metrics.StringTag(BlobTypeTagName, blobType),
+		metrics.StringTag(CompressionCodecTagName, string(codec)),
+	)
+	metrics.PersistenceBlobCompressionLatency.With(handler).Record(time.Since(startTime))
+	metrics.PersistenceBlobUncompressedSize.With(handler).Record(int64(len(blob.Data)))
+	metrics.PersistenceBlobCompressedSize.With(handler).Record(int64(len(compressed.Data)))
+	return compressed, nil
+}
+
+// decompress returns the blob's uncompressed form. Blobs that are not compressed are returned as
+// is.
+func (c *BlobCompressor) decompress(
+	blobType string,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if !serialization.IsCompressed(blob) {
+		return blob, nil
+	}
+
+	startTime := time.Now().UTC()
+	decompressed, err := serialization.DecompressBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		metrics.PersistenceBlobDecompressionLatency.With(c.metricsHandler).Record(
+			time.Since(startTime),
+			metrics.StringTag(BlobTypeTagName, blobType),
+		)
+	}
+	return decompressed, nil
+}
@@ -0,0 +1,158 @@
+package cassandra
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+const (
+	templateCreateDynamicConfigQuery = `INSERT INTO dynamic_config(partition, version, data, data_encoding) VALUES(0, ?, ?, ?) IF NOT EXISTS`
+	templateUpdateDynamicConfigQuery = `UPDATE dynamic_config SET version = ?, data = ?, data_encoding = ? WHERE partition = 0 IF version = ?`
+	templateGetDynamicConfigQuery    = `SELECT version, data, data_encoding FROM dynamic_config WHERE partition = 0`
+	// Each change is recorded under its key, and under the empty key that lists the changes to all keys.
+	templateCreateDynamicConfigChangeQuery = `INSERT INTO dynamic_config_history(config_key, version, data, data_encoding) VALUES(?, ?, ?, ?)`
+	templateListDynamicConfigHistoryQuery  = `SELECT version, data, data_encoding FROM dynamic_config_history WHERE config_key = ?`
+)
+
+type (
+	DynamicConfigStore struct {
+		session gocql.Session
+		logger  log.Logger
+	}
+)
+
+func NewDynamicConfigStore(
+	session gocql.Session,
+	logger log.Logger,
+) p.DynamicConfigStore {
+	return &DynamicConfigStore{
+		session: session,
+		logger:  logger,
+	}
+}
+
+func (s *DynamicConfigStore) GetName() string {
+	return cassandraPersistenceName
+}
+
+func (s *DynamicConfigStore) Close() {
+	if s.session != nil {
+		s.session.Close()
+	}
+}
+
+func (s *DynamicConfigStore) GetDynamicConfig(
+	ctx context.Context,
+) (*p.InternalGetDynamicConfigResponse, error) {
+	query := s.session.Query(templateGetDynamicConfigQuery).WithContext(ctx)
+
+	var version int64
+	var data []byte
+	var dataEncoding string
+
+	err := query.Scan(&version, &data, &dataEncoding)
+	if gocql.IsNotFoundError(err) {
+		return &p.InternalGetDynamicConfigResponse{}, nil
+	}
+	if err != nil {
+		return nil, gocql.ConvertError("GetDynamicConfig", err)
+	}
+
+	return &p.InternalGetDynamicConfigResponse{
+		Version: version,
+		Config:  p.NewDataBlob(data, dataEncoding),
+	}, nil
+}
+
+func (s *DynamicConfigStore) UpdateDynamicConfig(
+	ctx context.Context,
+	request *p.InternalUpdateDynamicConfigRequest,
+) error {
+	var query gocql.Query
+	if request.PreviousVersion == 0 {
+		query = s.session.Query(templateCreateDynamicConfigQuery,
+			1,
+			request.Config.Data,
+			request.Config.EncodingType.String(),
+		).WithContext(ctx)
+	} else {
+		query = s.session.Query(templateUpdateDynamicConfigQuery,
+			request.PreviousVersion+1,
+			request.Config.Data,
+			request.Config.EncodingType.String(),
+			request.PreviousVersion,
+		).WithContext(ctx)
+	}
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return gocql.ConvertError("UpdateDynamicConfig", err)
+	}
+
+	if !applied {
+		currentVersion, err := getTypedFieldFromRow[int64]("version", previous)
+		if err != nil {
+			return fmt.Errorf("error retrieving current dynamic config version: %w", err)
+		}
+		if currentVersion != request.PreviousVersion {
+			return fmt.Errorf("%w. provided version: %v current version: %v",
+				p.ErrDynamicConfigVersionConflict,
+				request.PreviousVersion,
+				currentVersion)
+		}
+
+		// This should never happen. This means the request had the correct version and gocql did not
+		// return an error but for some reason the update was not applied.
+		return serviceerror.NewInternal("UpdateDynamicConfig failed.")
+	}
+
+	// The history lives in another table, so it can't be written in the conditional update.
+	// Versions are only produced once, so the change can be recorded after the update is applied.
+	batch := s.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	for _, key := range []string{request.ChangeKey, ""} {
+		batch.Query(templateCreateDynamicConfigChangeQuery,
+			key,
+			request.PreviousVersion+1,
+			request.Change.Data,
+			request.Change.EncodingType.String(),
+		)
+	}
+	if err := s.session.ExecuteBatch(batch); err != nil {
+		return gocql.ConvertError("UpdateDynamicConfig", err)
+	}
+
+	return nil
+}
+
+func (s *DynamicConfigStore) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *p.ListDynamicConfigHistoryRequest,
+) (*p.InternalListDynamicConfigHistoryResponse, error) {
+	query := s.session.Query(templateListDynamicConfigHistoryQuery, request.Key).WithContext(ctx)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+
+	response := &p.InternalListDynamicConfigHistoryResponse{}
+
+	var version int64
+	var data []byte
+	var dataEncoding string
+	for iter.Scan(&version, &data, &dataEncoding) {
+		response.Changes = append(response.Changes, p.NewDataBlob(data, dataEncoding))
+	}
+
+	if len(iter.PageState()) > 0 {
+		response.NextPageToken = iter.PageState()
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("ListDynamicConfigHistory", err)
+	}
+
+	return response, nil
+}
@@ -107,6 +107,11 @@ func (f *Factory) NewNexusEndpointStore() (p.NexusEndpointStore, error) {
 	return NewNexusEndpointStore(f.session, f.logger), nil
 }
 
+// NewDynamicConfigStore returns a new DynamicConfigStore
+func (f *Factory) NewDynamicConfigStore() (p.DynamicConfigStore, error) {
+	return NewDynamicConfigStore(f.session, f.logger), nil
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.Lock()
@@ -45,6 +45,8 @@ type (
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
+		// NewDynamicConfigManager returns a new manager for dynamic config overrides
+		NewDynamicConfigManager() (persistence.DynamicConfigManager, error)
 	}
 
 	factoryImpl struct {
@@ -61,6 +63,7 @@ type (
 		healthSignals                               persistence.HealthSignalAggregator
 		enableDataLossMetrics                       dynamicconfig.BoolPropertyFn
 		enableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn
+		blobCompressor                              *persistence.BlobCompressor
 	}
 )
 
@@ -85,6 +88,7 @@ func NewFactory(
 	healthSignals persistence.HealthSignalAggregator,
 	enableDataLossMetrics EnableDataLossMetrics,
 	enableBestEffortDeleteTasksOnWorkflowUpdate EnableBestEffortDeleteTasksOnWorkflowUpdate,
+	blobCompressor *persistence.BlobCompressor,
 ) Factory {
 	factory := &factoryImpl{
 		dataStoreFactory:      dataStoreFactory,
@@ -100,6 +104,7 @@ func NewFactory(
 		healthSignals:         healthSignals,
 		enableDataLossMetrics: dynamicconfig.BoolPropertyFn(enableDataLossMetrics),
 		enableBestEffortDeleteTasksOnWorkflowUpdate: dynamicconfig.BoolPropertyFn(enableBestEffortDeleteTasksOnWorkflowUpdate),
+		blobCompressor: blobCompressor,
 	}
 	factory.initDependencies()
 	return factory
@@ -207,6 +212,7 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		f.logger,
 		f.config.TransactionSizeLimit,
 		f.enableBestEffortDeleteTasksOnWorkflowUpdate,
+		f.blobCompressor,
 	)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
@@ -259,6 +265,23 @@ func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManage
 	return result, nil
 }
 
+func (f *factoryImpl) NewDynamicConfigManager() (persistence.DynamicConfigManager, error) {
+	store, err := f.dataStoreFactory.NewDynamicConfigStore()
+	if err != nil {
+		return nil, err
+	}
+
+	result := persistence.NewDynamicConfigManager(store, f.logger)
+	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
+		result = persistence.NewDynamicConfigPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+	}
+	if f.metricsHandler != nil && f.healthSignals != nil {
+		result = persistence.NewDynamicConfigPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
+	}
+	result = persistence.NewDynamicConfigPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
+	return result, nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
@@ -57,6 +57,7 @@ type (
 		DynamicRateLimitingParams                   DynamicRateLimitingParams
 		EnableDataLossMetrics                       EnableDataLossMetrics
 		EnableBestEffortDeleteTasksOnWorkflowUpdate EnableBestEffortDeleteTasksOnWorkflowUpdate
+		BlobCompressor                              *persistence.BlobCompressor
 	}
 
 	FactoryProviderFn func(NewFactoryParams) Factory
@@ -74,6 +75,7 @@ var Module = fx.Options(
 	fx.Provide(managerProvider(Factory.NewExecutionManager)),
 	fx.Provide(managerProvider(Factory.NewHistoryTaskQueueManager)),
 	fx.Provide(managerProvider(Factory.NewNexusEndpointManager)),
+	fx.Provide(managerProvider(Factory.NewDynamicConfigManager)),
 
 	fx.Provide(ClusterNameProvider),
 	fx.Provide(HealthSignalAggregatorProvider),
@@ -81,6 +83,7 @@ var Module = fx.Options(
 	fx.Provide(EventBlobCacheProvider),
 	fx.Provide(EnableDataLossMetricsProvider),
 	fx.Provide(EnableBestEffortDeleteTasksOnWorkflowUpdateProvider),
+	fx.Provide(BlobCompressorProvider),
 )
 
 func ClusterNameProvider(config *cluster.Config) ClusterName {
@@ -110,6 +113,19 @@ func EnableBestEffortDeleteTasksOnWorkflowUpdateProvider(
 	return EnableBestEffortDeleteTasksOnWorkflowUpdate(dynamicconfig.EnableBestEffortDeleteTasksOnWorkflowUpdate.Get(dc))
 }
 
+func BlobCompressorProvider(
+	dc *dynamicconfig.Collection,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *persistence.BlobCompressor {
+	return persistence.NewBlobCompressor(
+		dynamicconfig.PersistenceBlobCompression.Get(dc),
+		dynamicconfig.PersistenceBlobCompressionMinSize.Get(dc),
+		metricsHandler,
+		logger,
+	)
+}
+
 func FactoryProvider(
 	params NewFactoryParams,
 ) Factory {
@@ -155,6 +171,7 @@ func FactoryProvider(
 		params.HealthSignals,
 		params.EnableDataLossMetrics,
 		params.EnableBestEffortDeleteTasksOnWorkflowUpdate,
+		params.BlobCompressor,
 	)
 }
 
@@ -172,6 +172,7 @@ func TestRateLimitedPersistenceClients(t *testing.T) {
 				nil,
 				func() bool { return false },
 				func() bool { return false },
+				nil,
 			)
 			shardManager, _ := factory.NewShardManager()
 			executionManager, _ := factory.NewExecutionManager()
@@ -1076,6 +1076,34 @@ type (
 		ID                    string
 	}
 
+	GetDynamicConfigResponse struct {
+		Version int64
+		Config  *persistencespb.DynamicConfig
+	}
+
+	UpdateDynamicConfigRequest struct {
+		PreviousVersion int64
+		Config          *persistencespb.DynamicConfig
+		// Change describes the update for the change history. Its version is set by the manager.
+		Change *persistencespb.DynamicConfigChange
+	}
+
+	UpdateDynamicConfigResponse struct {
+		Version int64
+	}
+
+	ListDynamicConfigHistoryRequest struct {
+		// Key, if set, restricts the history to the changes of this key. Keys are case-insensitive.
+		Key           string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	ListDynamicConfigHistoryResponse struct {
+		Changes       []*persistencespb.DynamicConfigChange
+		NextPageToken []byte
+	}
+
 	// Closeable is an interface for any entity that supports a close operation to release resources
 	// TODO: allow this method to return errors
 	Closeable interface {
@@ -1092,6 +1120,12 @@ type (
 		AssertShardOwnership(ctx context.Context, request *AssertShardOwnershipRequest) error
 	}
 
+	// HistoryBranchReader reads history branches by batch. It is the part of
+	// ExecutionManager history archivers need.
+	HistoryBranchReader interface {
+		ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error)
+	}
+
 	// ExecutionManager is used to manage workflow executions
 	ExecutionManager interface {
 		Closeable
@@ -1231,6 +1265,19 @@ type (
 		DeleteNexusEndpoint(ctx context.Context, request *DeleteNexusEndpointRequest) error
 	}
 
+	// DynamicConfigManager is used to manage the dynamic config overrides stored in persistence.
+	DynamicConfigManager interface {
+		Closeable
+		GetName() string
+		GetDynamicConfig(ctx context.Context) (*GetDynamicConfigResponse, error)
+		// UpdateDynamicConfig replaces the stored dynamic config overrides. It returns
+		// ErrDynamicConfigVersionConflict if PreviousVersion doesn't match the stored version.
+		UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error)
+		// ListDynamicConfigHistory lists the changes to the overrides, newest first. Stores may expire old changes;
+		// Cassandra keeps them for a year.
+		ListDynamicConfigHistory(ctx context.Context, request *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error)
+	}
+
 	// HistoryTaskQueueManager is responsible for managing a queue of internal history tasks. This is called a history
 	// task queue manager, but the actual history task queues are not managed by this object. Instead, this object is
 	// responsible for managing a generic queue of history tasks (which is what the history task DLQ is).
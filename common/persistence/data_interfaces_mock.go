@@ -1320,6 +1320,101 @@ func (mr *MockNexusEndpointManagerMockRecorder) ListNexusEndpoints(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockNexusEndpointManager)(nil).ListNexusEndpoints), ctx, request)
 }
 
+// MockDynamicConfigManager is a mock of DynamicConfigManager interface.
+type MockDynamicConfigManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockDynamicConfigManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockDynamicConfigManagerMockRecorder is the mock recorder for MockDynamicConfigManager.
+type MockDynamicConfigManagerMockRecorder struct {
+	mock *MockDynamicConfigManager
+}
+
+// NewMockDynamicConfigManager creates a new mock instance.
+func NewMockDynamicConfigManager(ctrl *gomock.Controller) *MockDynamicConfigManager {
+	mock := &MockDynamicConfigManager{ctrl: ctrl}
+	mock.recorder = &MockDynamicConfigManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDynamicConfigManager) EXPECT() *MockDynamicConfigManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockDynamicConfigManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockDynamicConfigManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDynamicConfigManager)(nil).Close))
+}
+
+// GetDynamicConfig mocks base method.
+func (m *MockDynamicConfigManager) GetDynamicConfig(ctx context.Context) (*GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", ctx)
+	ret0, _ := ret[0].(*GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockDynamicConfigManagerMockRecorder) GetDynamicConfig(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockDynamicConfigManager)(nil).GetDynamicConfig), ctx)
+}
+
+// GetName mocks base method.
+func (m *MockDynamicConfigManager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName.
+func (mr *MockDynamicConfigManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockDynamicConfigManager)(nil).GetName))
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockDynamicConfigManager) ListDynamicConfigHistory(ctx context.Context, request *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", ctx, request)
+	ret0, _ := ret[0].(*ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockDynamicConfigManagerMockRecorder) ListDynamicConfigHistory(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockDynamicConfigManager)(nil).ListDynamicConfigHistory), ctx, request)
+}
+
+// UpdateDynamicConfig mocks base method.
+func (m *MockDynamicConfigManager) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", ctx, request)
+	ret0, _ := ret[0].(*UpdateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig.
+func (mr *MockDynamicConfigManagerMockRecorder) UpdateDynamicConfig(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockDynamicConfigManager)(nil).UpdateDynamicConfig), ctx, request)
+}
+
 // MockHistoryTaskQueueManager is a mock of HistoryTaskQueueManager interface.
 type MockHistoryTaskQueueManager struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,139 @@
+package persistence
+
+import (
+	"context"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+var (
+	ErrDynamicConfigVersionConflict            = serviceerror.NewFailedPrecondition("dynamic config version mismatch")
+	ErrNonPositiveListDynamicConfigHistorySize = &InvalidPersistenceRequestError{
+		Msg: "received non-positive page size for listing dynamic config history",
+	}
+)
+
+type (
+	dynamicConfigManagerImpl struct {
+		persistence DynamicConfigStore
+		logger      log.Logger
+	}
+)
+
+func NewDynamicConfigManager(
+	persistence DynamicConfigStore,
+	logger log.Logger,
+) DynamicConfigManager {
+	return &dynamicConfigManagerImpl{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+func (m *dynamicConfigManagerImpl) GetName() string {
+	return m.persistence.GetName()
+}
+
+func (m *dynamicConfigManagerImpl) Close() {
+	m.persistence.Close()
+}
+
+func (m *dynamicConfigManagerImpl) GetDynamicConfig(
+	ctx context.Context,
+) (*GetDynamicConfigResponse, error) {
+	resp, err := m.persistence.GetDynamicConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Config == nil {
+		return &GetDynamicConfigResponse{
+			Version: resp.Version,
+			Config:  &persistencespb.DynamicConfig{},
+		}, nil
+	}
+
+	config, err := serialization.DynamicConfigFromBlob(resp.Config)
+	if err != nil {
+		m.logger.Error("error deserializing dynamic config", tag.Error(err))
+		return nil, err
+	}
+
+	return &GetDynamicConfigResponse{
+		Version: resp.Version,
+		Config:  config,
+	}, nil
+}
+
+func (m *dynamicConfigManagerImpl) UpdateDynamicConfig(
+	ctx context.Context,
+	request *UpdateDynamicConfigRequest,
+) (*UpdateDynamicConfigResponse, error) {
+	newVersion := request.PreviousVersion + 1
+
+	configBlob, err := serialization.DynamicConfigToBlob(request.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &persistencespb.DynamicConfigChange{}
+	if request.Change != nil {
+		change = common.CloneProto(request.Change)
+	}
+	change.Version = newVersion
+	changeBlob, err := serialization.DynamicConfigChangeToBlob(change)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.UpdateDynamicConfig(ctx, &InternalUpdateDynamicConfigRequest{
+		PreviousVersion: request.PreviousVersion,
+		Config:          configBlob,
+		Change:          changeBlob,
+		ChangeKey:       strings.ToLower(change.Key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateDynamicConfigResponse{Version: newVersion}, nil
+}
+
+func (m *dynamicConfigManagerImpl) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *ListDynamicConfigHistoryRequest,
+) (*ListDynamicConfigHistoryResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, ErrNonPositiveListDynamicConfigHistorySize
+	}
+
+	resp, err := m.persistence.ListDynamicConfigHistory(ctx, &ListDynamicConfigHistoryRequest{
+		Key:           strings.ToLower(request.Key),
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*persistencespb.DynamicConfigChange, len(resp.Changes))
+	for i, blob := range resp.Changes {
+		change, err := serialization.DynamicConfigChangeFromBlob(blob)
+		if err != nil {
+			m.logger.Error("error deserializing dynamic config change", tag.Error(err))
+			return nil, err
+		}
+		changes[i] = change
+	}
+
+	return &ListDynamicConfigHistoryResponse{
+		Changes:       changes,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
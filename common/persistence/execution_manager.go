@@ -35,6 +35,7 @@ type (
 		pagingTokenSerializer                       *jsonHistoryTokenSerializer
 		transactionSizeLimit                        dynamicconfig.IntPropertyFn
 		enableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn
+		blobCompressor                              *BlobCompressor
 	}
 )
 
@@ -48,6 +49,7 @@ func NewExecutionManager(
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
 	enableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn,
+	blobCompressor *BlobCompressor,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:            serializer,
@@ -57,6 +59,7 @@ func NewExecutionManager(
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
 		enableBestEffortDeleteTasksOnWorkflowUpdate: enableBestEffortDeleteTasksOnWorkflowUpdate,
+		blobCompressor: blobCompressor,
 	}
 }
 
@@ -526,6 +529,12 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 		workflowNewEvents = append(workflowNewEvents, newEvents)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
+
+		// History size and the XDC cache use the uncompressed blob, only the persisted one is compressed.
+		newEvents.Node.Events, err = m.blobCompressor.compress(workflowEvents.NamespaceID, blobTypeHistory, newEvents.Node.Events)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
 }
@@ -705,6 +714,18 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		return nil, err
 	}
 
+	if err := m.compressMutableState(
+		result.NamespaceID,
+		&result.ExecutionInfoBlob,
+		result.UpsertActivityInfos,
+		result.UpsertTimerInfos,
+		result.UpsertChildExecutionInfos,
+		result.UpsertRequestCancelInfos,
+		result.UpsertSignalInfos,
+	); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
@@ -802,9 +823,95 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		return nil, err
 	}
 
+	if err := m.compressMutableState(
+		result.NamespaceID,
+		&result.ExecutionInfoBlob,
+		result.ActivityInfos,
+		result.TimerInfos,
+		result.ChildExecutionInfos,
+		result.RequestCancelInfos,
+		result.SignalInfos,
+	); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
+// compressMutableState compresses the execution info and pending info blobs of a serialized
+// mutation or snapshot. The execution state is left uncompressed as stores read it.
+func (m *executionManagerImpl) compressMutableState(
+	namespaceID string,
+	executionInfo **commonpb.DataBlob,
+	activityInfos map[int64]*commonpb.DataBlob,
+	timerInfos map[string]*commonpb.DataBlob,
+	childExecutionInfos map[int64]*commonpb.DataBlob,
+	requestCancelInfos map[int64]*commonpb.DataBlob,
+	signalInfos map[int64]*commonpb.DataBlob,
+) error {
+	if m.blobCompressor == nil {
+		return nil
+	}
+	var err error
+	if *executionInfo, err = m.blobCompressor.compress(namespaceID, blobTypeMutableState, *executionInfo); err != nil {
+		return err
+	}
+	for _, blobs := range []map[int64]*commonpb.DataBlob{activityInfos, childExecutionInfos, requestCancelInfos, signalInfos} {
+		if err := compressBlobs(m.blobCompressor, namespaceID, blobs); err != nil {
+			return err
+		}
+	}
+	return compressBlobs(m.blobCompressor, namespaceID, timerInfos)
+}
+
+// decompressMutableState decompresses the blobs compressed by compressMutableState in place.
+func (m *executionManagerImpl) decompressMutableState(internState *InternalWorkflowMutableState) error {
+	var err error
+	if internState.ExecutionInfo, err = m.blobCompressor.decompress(blobTypeMutableState, internState.ExecutionInfo); err != nil {
+		return err
+	}
+	for _, blobs := range []map[int64]*commonpb.DataBlob{
+		internState.ActivityInfos,
+		internState.ChildExecutionInfos,
+		internState.RequestCancelInfos,
+		internState.SignalInfos,
+	} {
+		if err := decompressBlobs(m.blobCompressor, blobs); err != nil {
+			return err
+		}
+	}
+	return decompressBlobs(m.blobCompressor, internState.TimerInfos)
+}
+
+func compressBlobs[K comparable](
+	compressor *BlobCompressor,
+	namespaceID string,
+	blobs map[K]*commonpb.DataBlob,
+) error {
+	for key, blob := range blobs {
+		compressed, err := compressor.compress(namespaceID, blobTypeMutableState, blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = compressed
+	}
+	return nil
+}
+
+func decompressBlobs[K comparable](
+	compressor *BlobCompressor,
+	blobs map[K]*commonpb.DataBlob,
+) error {
+	for key, blob := range blobs {
+		decompressed, err := compressor.decompress(blobTypeMutableState, blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = decompressed
+	}
+	return nil
+}
+
 func (m *executionManagerImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *DeleteWorkflowExecutionRequest,
@@ -1090,6 +1197,10 @@ func (m *executionManagerImpl) trimHistoryNode(
 }
 
 func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkflowMutableState) (*persistencespb.WorkflowMutableState, error) {
+	if err := m.decompressMutableState(internState); err != nil {
+		return nil, err
+	}
+
 	state := &persistencespb.WorkflowMutableState{
 		ActivityInfos:       make(map[int64]*persistencespb.ActivityInfo),
 		TimerInfos:          make(map[string]*persistencespb.TimerInfo),
@@ -69,6 +69,7 @@ func TestExecutionManager_DeletesTasksWhenEnabled(t *testing.T) {
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(true),
+		nil,
 	)
 
 	_, err := em.UpdateWorkflowExecution(context.Background(), newTestUpdateRequest(expectedKeys))
@@ -93,6 +94,7 @@ func TestExecutionManager_DoesNotDeleteTasksWhenDisabled(t *testing.T) {
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(false),
+		nil,
 	)
 
 	keys := []tasks.Key{tasks.NewKey(time.Now().UTC(), 789)}
@@ -128,6 +130,7 @@ func TestExecutionManager_DeleteTasksError_DoesNotFailUpdate(t *testing.T) {
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(true),
+		nil,
 	)
 
 	// UpdateWorkflowExecution should succeed even though CompleteHistoryTask failed
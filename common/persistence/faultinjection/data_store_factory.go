@@ -19,6 +19,7 @@ type (
 		queueV2            persistence.QueueV2
 		clusterMDStore     persistence.ClusterMetadataStore
 		nexusEndpointStore persistence.NexusEndpointStore
+		dynamicConfigStore persistence.DynamicConfigStore
 	}
 )
 
@@ -196,3 +197,21 @@ func (d *FaultInjectionDataStoreFactory) NewNexusEndpointStore() (persistence.Ne
 	}
 	return d.nexusEndpointStore, nil
 }
+
+func (d *FaultInjectionDataStoreFactory) NewDynamicConfigStore() (persistence.DynamicConfigStore, error) {
+	if d.dynamicConfigStore == nil {
+		baseStore, err := d.baseFactory.NewDynamicConfigStore()
+		if err != nil {
+			return nil, err
+		}
+		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.DynamicConfigStoreName]; ok && len(storeConfig.Methods) > 0 {
+			d.dynamicConfigStore = newFaultInjectionDynamicConfigStore(
+				baseStore,
+				newStoreFaultGenerator(&storeConfig),
+			)
+		} else {
+			d.dynamicConfigStore = baseStore
+		}
+	}
+	return d.dynamicConfigStore, nil
+}
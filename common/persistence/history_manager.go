@@ -659,6 +659,9 @@ func (m *executionManagerImpl) readRawHistoryBranch(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := m.decompressHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
@@ -720,10 +723,26 @@ func (m *executionManagerImpl) readRawHistoryBranchReverse(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := m.decompressHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
 
+// decompressHistoryNodes decompresses the events of nodes in place, so that callers, including
+// those returning raw history to clients and remote clusters, never see compressed blobs.
+func (m *executionManagerImpl) decompressHistoryNodes(nodes []InternalHistoryNode) error {
+	for i := range nodes {
+		events, err := m.blobCompressor.decompress(blobTypeHistory, nodes[i].Events)
+		if err != nil {
+			return err
+		}
+		nodes[i].Events = events
+	}
+	return nil
+}
+
 func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	ctx context.Context,
 	request *ReadHistoryBranchRequest,
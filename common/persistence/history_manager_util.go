@@ -66,7 +66,7 @@ func ReadFullPageRawEvents(
 // of data read, the next page token, and an error if present.
 func ReadFullPageEventsByBatch(
 	ctx context.Context,
-	executionMgr ExecutionManager,
+	executionMgr HistoryBranchReader,
 	req *ReadHistoryBranchRequest,
 ) ([]*historypb.History, int, []byte, error) {
 	var historyBatches []*historypb.History
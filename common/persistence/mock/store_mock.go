@@ -69,6 +69,21 @@ func (mr *MockDataStoreFactoryMockRecorder) NewClusterMetadataStore() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClusterMetadataStore", reflect.TypeOf((*MockDataStoreFactory)(nil).NewClusterMetadataStore))
 }
 
+// NewDynamicConfigStore mocks base method.
+func (m *MockDataStoreFactory) NewDynamicConfigStore() (persistence.DynamicConfigStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDynamicConfigStore")
+	ret0, _ := ret[0].(persistence.DynamicConfigStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewDynamicConfigStore indicates an expected call of NewDynamicConfigStore.
+func (mr *MockDataStoreFactoryMockRecorder) NewDynamicConfigStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDynamicConfigStore", reflect.TypeOf((*MockDataStoreFactory)(nil).NewDynamicConfigStore))
+}
+
 // NewExecutionStore mocks base method.
 func (m *MockDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
 	m.ctrl.T.Helper()
@@ -1598,6 +1613,100 @@ func (mr *MockNexusEndpointStoreMockRecorder) ListNexusEndpoints(ctx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockNexusEndpointStore)(nil).ListNexusEndpoints), ctx, request)
 }
 
+// MockDynamicConfigStore is a mock of DynamicConfigStore interface.
+type MockDynamicConfigStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDynamicConfigStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockDynamicConfigStoreMockRecorder is the mock recorder for MockDynamicConfigStore.
+type MockDynamicConfigStoreMockRecorder struct {
+	mock *MockDynamicConfigStore
+}
+
+// NewMockDynamicConfigStore creates a new mock instance.
+func NewMockDynamicConfigStore(ctrl *gomock.Controller) *MockDynamicConfigStore {
+	mock := &MockDynamicConfigStore{ctrl: ctrl}
+	mock.recorder = &MockDynamicConfigStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDynamicConfigStore) EXPECT() *MockDynamicConfigStoreMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockDynamicConfigStore) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockDynamicConfigStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDynamicConfigStore)(nil).Close))
+}
+
+// GetDynamicConfig mocks base method.
+func (m *MockDynamicConfigStore) GetDynamicConfig(ctx context.Context) (*persistence.InternalGetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", ctx)
+	ret0, _ := ret[0].(*persistence.InternalGetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockDynamicConfigStoreMockRecorder) GetDynamicConfig(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockDynamicConfigStore)(nil).GetDynamicConfig), ctx)
+}
+
+// GetName mocks base method.
+func (m *MockDynamicConfigStore) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName.
+func (mr *MockDynamicConfigStoreMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockDynamicConfigStore)(nil).GetName))
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockDynamicConfigStore) ListDynamicConfigHistory(ctx context.Context, request *persistence.ListDynamicConfigHistoryRequest) (*persistence.InternalListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", ctx, request)
+	ret0, _ := ret[0].(*persistence.InternalListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockDynamicConfigStoreMockRecorder) ListDynamicConfigHistory(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockDynamicConfigStore)(nil).ListDynamicConfigHistory), ctx, request)
+}
+
+// UpdateDynamicConfig mocks base method.
+func (m *MockDynamicConfigStore) UpdateDynamicConfig(ctx context.Context, request *persistence.InternalUpdateDynamicConfigRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig.
+func (mr *MockDynamicConfigStoreMockRecorder) UpdateDynamicConfig(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockDynamicConfigStore)(nil).UpdateDynamicConfig), ctx, request)
+}
+
 // MockQueueV2 is a mock of QueueV2 interface.
 type MockQueueV2 struct {
 	ctrl     *gomock.Controller
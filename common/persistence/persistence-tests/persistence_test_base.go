@@ -214,6 +214,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		s.PersistenceHealthSignals,
 		func() bool { return false },
 		func() bool { return false },
+		nil,
 	)
 
 	s.TaskMgr, err = factory.NewTaskManager()
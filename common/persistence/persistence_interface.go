@@ -48,6 +48,8 @@ type (
 		NewClusterMetadataStore() (ClusterMetadataStore, error)
 		// NewNexusEndpointStore returns a new nexus service store
 		NewNexusEndpointStore() (NexusEndpointStore, error)
+		// NewDynamicConfigStore returns a new dynamic config store
+		NewDynamicConfigStore() (DynamicConfigStore, error)
 	}
 
 	// ShardStore is a lower level of ShardManager
@@ -194,6 +196,15 @@ type (
 		ListNexusEndpoints(ctx context.Context, request *ListNexusEndpointsRequest) (*InternalListNexusEndpointsResponse, error)
 	}
 
+	// DynamicConfigStore is a store for the dynamic config overrides managed through the admin API
+	DynamicConfigStore interface {
+		Closeable
+		GetName() string
+		GetDynamicConfig(ctx context.Context) (*InternalGetDynamicConfigResponse, error)
+		UpdateDynamicConfig(ctx context.Context, request *InternalUpdateDynamicConfigRequest) error
+		ListDynamicConfigHistory(ctx context.Context, request *ListDynamicConfigHistoryRequest) (*InternalListDynamicConfigHistoryResponse, error)
+	}
+
 	// QueueMessage is the message that stores in the queue
 	QueueMessage struct {
 		QueueType QueueType `json:"queue_type"`
@@ -794,6 +805,28 @@ type (
 		Endpoints     []InternalNexusEndpoint `json:",omitempty"`
 	}
 
+	InternalGetDynamicConfigResponse struct {
+		// Version is 0 if the dynamic config was never updated.
+		Version int64
+		Config  *commonpb.DataBlob
+	}
+
+	InternalUpdateDynamicConfigRequest struct {
+		// PreviousVersion must match the currently stored version. The new version is PreviousVersion+1.
+		PreviousVersion int64
+		Config          *commonpb.DataBlob
+		// Change is stored in the change history under the new version and the lowercased key of the changed
+		// setting, so that the history of a key can be listed.
+		Change    *commonpb.DataBlob
+		ChangeKey string
+	}
+
+	InternalListDynamicConfigHistoryResponse struct {
+		// Changes, most recent first.
+		Changes       []*commonpb.DataBlob
+		NextPageToken []byte
+	}
+
 	// QueueV2 is an interface for a generic FIFO queue. It should eventually replace the Queue interface. Why do we
 	// need this migration? The main problem is very simple. The `queue_metadata` table in Cassandra has a primary key
 	// of (queue_type). This means that we can only have one queue of each type. This is a problem because we want to
@@ -65,6 +65,12 @@ type (
 		healthSignals HealthSignalAggregator
 		persistence   NexusEndpointManager
 	}
+
+	dynamicConfigPersistenceClient struct {
+		metricEmitter
+		healthSignals HealthSignalAggregator
+		persistence   DynamicConfigManager
+	}
 )
 
 var _ ShardManager = (*shardPersistenceClient)(nil)
@@ -74,6 +80,7 @@ var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ ClusterMetadataManager = (*clusterMetadataPersistenceClient)(nil)
 var _ Queue = (*queuePersistenceClient)(nil)
 var _ NexusEndpointManager = (*nexusEndpointPersistenceClient)(nil)
+var _ DynamicConfigManager = (*dynamicConfigPersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
 func NewShardPersistenceMetricsClient(persistence ShardManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger, enableDataLossMetrics dynamicconfig.BoolPropertyFn) ShardManager {
@@ -166,6 +173,19 @@ func NewNexusEndpointPersistenceMetricsClient(persistence NexusEndpointManager,
 	}
 }
 
+// NewDynamicConfigPersistenceMetricsClient creates a DynamicConfigManager to manage dynamic config overrides
+func NewDynamicConfigPersistenceMetricsClient(persistence DynamicConfigManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger, enableDataLossMetrics dynamicconfig.BoolPropertyFn) DynamicConfigManager {
+	return &dynamicConfigPersistenceClient{
+		metricEmitter: metricEmitter{
+			metricsHandler:        metricsHandler,
+			logger:                logger,
+			enableDataLossMetrics: enableDataLossMetrics,
+		},
+		healthSignals: healthSignals,
+		persistence:   persistence,
+	}
+}
+
 func (p *shardPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -1400,6 +1420,55 @@ func (p *nexusEndpointPersistenceClient) DeleteNexusEndpoint(
 	return p.persistence.DeleteNexusEndpoint(ctx, request)
 }
 
+func (p *dynamicConfigPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *dynamicConfigPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *dynamicConfigPersistenceClient) GetDynamicConfig(
+	ctx context.Context,
+) (_ *GetDynamicConfigResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(CallerSegmentMissing, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceGetDynamicConfigScope, caller, time.Since(startTime), retErr)
+		p.recordDataLossMetrics(metrics.PersistenceGetDynamicConfigScope, caller, retErr, "", "")
+	}()
+	return p.persistence.GetDynamicConfig(ctx)
+}
+
+func (p *dynamicConfigPersistenceClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *UpdateDynamicConfigRequest,
+) (_ *UpdateDynamicConfigResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(CallerSegmentMissing, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceUpdateDynamicConfigScope, caller, time.Since(startTime), retErr)
+		p.recordDataLossMetrics(metrics.PersistenceUpdateDynamicConfigScope, caller, retErr, "", "")
+	}()
+	return p.persistence.UpdateDynamicConfig(ctx, request)
+}
+
+func (p *dynamicConfigPersistenceClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *ListDynamicConfigHistoryRequest,
+) (_ *ListDynamicConfigHistoryResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(CallerSegmentMissing, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceListDynamicConfigHistoryScope, caller, time.Since(startTime), retErr)
+		p.recordDataLossMetrics(metrics.PersistenceListDynamicConfigHistoryScope, caller, retErr, "", "")
+	}()
+	return p.persistence.ListDynamicConfigHistory(ctx, request)
+}
+
 func (p *metricEmitter) recordRequestMetrics(operation string, caller string, latency time.Duration, err error) {
 	handler := p.metricsHandler.WithTags(metrics.OperationTag(operation), metrics.NamespaceTag(caller))
 	metrics.PersistenceRequests.With(handler).Record(1)
@@ -94,6 +94,14 @@ type (
 		persistence          NexusEndpointManager
 		logger               log.Logger
 	}
+
+	dynamicConfigRateLimitedPersistenceClient struct {
+		systemRateLimiter    quotas.RequestRateLimiter
+		namespaceRateLimiter quotas.RequestRateLimiter
+		shardRateLimiter     quotas.RequestRateLimiter
+		persistence          DynamicConfigManager
+		logger               log.Logger
+	}
 )
 
 var _ ShardManager = (*shardRateLimitedPersistenceClient)(nil)
@@ -103,6 +111,7 @@ var _ MetadataManager = (*metadataRateLimitedPersistenceClient)(nil)
 var _ ClusterMetadataManager = (*clusterMetadataRateLimitedPersistenceClient)(nil)
 var _ Queue = (*queueRateLimitedPersistenceClient)(nil)
 var _ NexusEndpointManager = (*nexusEndpointRateLimitedPersistenceClient)(nil)
+var _ DynamicConfigManager = (*dynamicConfigRateLimitedPersistenceClient)(nil)
 
 // NewShardPersistenceRateLimitedClient creates a client to manage shards
 func NewShardPersistenceRateLimitedClient(
@@ -223,6 +232,23 @@ func NewNexusEndpointPersistenceRateLimitedClient(
 	}
 }
 
+// NewDynamicConfigPersistenceRateLimitedClient creates a DynamicConfigManager to manage dynamic config overrides
+func NewDynamicConfigPersistenceRateLimitedClient(
+	persistence DynamicConfigManager,
+	systemRateLimiter quotas.RequestRateLimiter,
+	namespaceRateLimiter quotas.RequestRateLimiter,
+	shardRateLimiter quotas.RequestRateLimiter,
+	logger log.Logger,
+) DynamicConfigManager {
+	return &dynamicConfigRateLimitedPersistenceClient{
+		persistence:          persistence,
+		systemRateLimiter:    systemRateLimiter,
+		namespaceRateLimiter: namespaceRateLimiter,
+		shardRateLimiter:     shardRateLimiter,
+		logger:               logger,
+	}
+}
+
 func (p *shardRateLimitedPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -1134,6 +1160,43 @@ func (p *nexusEndpointRateLimitedPersistenceClient) DeleteNexusEndpoint(
 	return p.persistence.DeleteNexusEndpoint(ctx, request)
 }
 
+func (p *dynamicConfigRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *dynamicConfigRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *dynamicConfigRateLimitedPersistenceClient) GetDynamicConfig(
+	ctx context.Context,
+) (*GetDynamicConfigResponse, error) {
+	if err := allow(ctx, "GetDynamicConfig", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetDynamicConfig(ctx)
+}
+
+func (p *dynamicConfigRateLimitedPersistenceClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *UpdateDynamicConfigRequest,
+) (*UpdateDynamicConfigResponse, error) {
+	if err := allow(ctx, "UpdateDynamicConfig", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+		return nil, err
+	}
+	return p.persistence.UpdateDynamicConfig(ctx, request)
+}
+
+func (p *dynamicConfigRateLimitedPersistenceClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *ListDynamicConfigHistoryRequest,
+) (*ListDynamicConfigHistoryResponse, error) {
+	if err := allow(ctx, "ListDynamicConfigHistory", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListDynamicConfigHistory(ctx, request)
+}
+
 func allow(
 	ctx context.Context,
 	api string,
@@ -50,6 +50,12 @@ type (
 		policy      backoff.RetryPolicy
 		isRetryable backoff.IsRetryable
 	}
+
+	dynamicConfigRetryablePersistenceClient struct {
+		persistence DynamicConfigManager
+		policy      backoff.RetryPolicy
+		isRetryable backoff.IsRetryable
+	}
 )
 
 var _ ShardManager = (*shardRetryablePersistenceClient)(nil)
@@ -59,6 +65,7 @@ var _ MetadataManager = (*metadataRetryablePersistenceClient)(nil)
 var _ ClusterMetadataManager = (*clusterMetadataRetryablePersistenceClient)(nil)
 var _ Queue = (*queueRetryablePersistenceClient)(nil)
 var _ NexusEndpointManager = (*nexusEndpointRetryablePersistenceClient)(nil)
+var _ DynamicConfigManager = (*dynamicConfigRetryablePersistenceClient)(nil)
 
 // NewShardPersistenceRetryableClient creates a client to manage shards
 func NewShardPersistenceRetryableClient(
@@ -151,6 +158,19 @@ func NewNexusEndpointPersistenceRetryableClient(
 	}
 }
 
+// NewDynamicConfigPersistenceRetryableClient creates a DynamicConfigManager client to manage dynamic config overrides
+func NewDynamicConfigPersistenceRetryableClient(
+	persistence DynamicConfigManager,
+	policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable,
+) DynamicConfigManager {
+	return &dynamicConfigRetryablePersistenceClient{
+		persistence: persistence,
+		policy:      policy,
+		isRetryable: isRetryable,
+	}
+}
+
 func (p *shardRetryablePersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -1246,3 +1266,52 @@ func (p *nexusEndpointRetryablePersistenceClient) DeleteNexusEndpoint(
 	}
 	return backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
 }
+
+func (p *dynamicConfigRetryablePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *dynamicConfigRetryablePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *dynamicConfigRetryablePersistenceClient) GetDynamicConfig(
+	ctx context.Context,
+) (*GetDynamicConfigResponse, error) {
+	var response *GetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetDynamicConfig(ctx)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *dynamicConfigRetryablePersistenceClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *UpdateDynamicConfigRequest,
+) (*UpdateDynamicConfigResponse, error) {
+	var response *UpdateDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.UpdateDynamicConfig(ctx, request)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *dynamicConfigRetryablePersistenceClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *ListDynamicConfigHistoryRequest,
+) (*ListDynamicConfigHistoryResponse, error) {
+	var response *ListDynamicConfigHistoryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListDynamicConfigHistory(ctx, request)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
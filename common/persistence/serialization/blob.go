@@ -106,3 +106,21 @@ func QueueStateFromBlob(data *commonpb.DataBlob) (*persistencespb.QueueState, er
 	result := &persistencespb.QueueState{}
 	return result, Decode(data, result)
 }
+
+func DynamicConfigToBlob(config *persistencespb.DynamicConfig) (*commonpb.DataBlob, error) {
+	return ProtoEncode(config)
+}
+
+func DynamicConfigFromBlob(data *commonpb.DataBlob) (*persistencespb.DynamicConfig, error) {
+	result := &persistencespb.DynamicConfig{}
+	return result, Decode(data, result)
+}
+
+func DynamicConfigChangeToBlob(change *persistencespb.DynamicConfigChange) (*commonpb.DataBlob, error) {
+	return ProtoEncode(change)
+}
+
+func DynamicConfigChangeFromBlob(data *commonpb.DataBlob) (*persistencespb.DynamicConfigChange, error) {
+	result := &persistencespb.DynamicConfigChange{}
+	return result, Decode(data, result)
+}
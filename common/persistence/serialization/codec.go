@@ -49,12 +49,16 @@ func Decode(data *commonpb.DataBlob, result proto.Message) error {
 	if data == nil {
 		return NewDeserializationError(enumspb.ENCODING_TYPE_UNSPECIFIED, errors.New("cannot decode nil"))
 	}
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return err
+	}
 
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(data.Data, result)
 	case enumspb.ENCODING_TYPE_PROTO3:
-		err := proto.Unmarshal(data.Data, result)
+		err = proto.Unmarshal(data.Data, result)
 		if err != nil {
 			return NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 		}
@@ -0,0 +1,117 @@
+package serialization
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// CompressionCodec names an algorithm blobs can be compressed with before they are persisted.
+type CompressionCodec string
+
+const (
+	// CompressionCodecNone leaves blobs uncompressed.
+	CompressionCodecNone CompressionCodec = ""
+	// CompressionCodecZstd compresses blobs with zstd.
+	CompressionCodecZstd CompressionCodec = "zstd"
+	// CompressionCodecSnappy compresses blobs with snappy.
+	CompressionCodecSnappy CompressionCodec = "snappy"
+)
+
+// Compressed blobs keep their encoding type, and their data is wrapped in an envelope:
+//
+//	compressionMarker | codec ID | compressed data
+//
+// No valid proto3 or JSON encoding starts with a zero byte (field number 0 is reserved), so
+// compressed and uncompressed blobs can be told apart and old rows remain readable.
+const (
+	compressionMarker    byte = 0x00
+	compressionHeaderLen      = 2
+
+	codecIDZstd   byte = 1
+	codecIDSnappy byte = 2
+)
+
+var (
+	// The zstd encoder and decoder are safe for concurrent use through EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+
+	errCorruptCompressedBlob = errors.New("corrupt compressed blob")
+)
+
+// ParseCompressionCodec returns the codec with the given name.
+func ParseCompressionCodec(name string) (CompressionCodec, error) {
+	switch codec := CompressionCodec(name); codec {
+	case CompressionCodecNone, CompressionCodecZstd, CompressionCodecSnappy:
+		return codec, nil
+	default:
+		return CompressionCodecNone, fmt.Errorf("unknown compression codec %q, supported codecs: %q, %q", name, CompressionCodecZstd, CompressionCodecSnappy)
+	}
+}
+
+// IsCompressed returns whether the blob's data was compressed by CompressBlob.
+func IsCompressed(blob *commonpb.DataBlob) bool {
+	return blob != nil && len(blob.Data) >= compressionHeaderLen && blob.Data[0] == compressionMarker
+}
+
+// CompressBlob returns a copy of the blob with its data compressed by codec. Blobs that are empty or
+// already compressed, and CompressionCodecNone, return the blob unchanged.
+func CompressBlob(blob *commonpb.DataBlob, codec CompressionCodec) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || IsCompressed(blob) {
+		return blob, nil
+	}
+
+	var data []byte
+	switch codec {
+	case CompressionCodecNone:
+		return blob, nil
+	case CompressionCodecZstd:
+		data = make([]byte, compressionHeaderLen, compressionHeaderLen+zstdEncoder.MaxEncodedSize(len(blob.Data)))
+		data[1] = codecIDZstd
+		data = zstdEncoder.EncodeAll(blob.Data, data)
+	case CompressionCodecSnappy:
+		data = make([]byte, compressionHeaderLen+snappy.MaxEncodedLen(len(blob.Data)))
+		data[1] = codecIDSnappy
+		data = data[:compressionHeaderLen+len(snappy.Encode(data[compressionHeaderLen:], blob.Data))]
+	default:
+		return nil, NewSerializationError(blob.EncodingType, fmt.Errorf("unknown compression codec %q", codec))
+	}
+	data[0] = compressionMarker
+
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// DecompressBlob returns a copy of the blob with its data decompressed. Blobs that are not compressed are
+// returned unchanged.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsCompressed(blob) {
+		return blob, nil
+	}
+
+	var data []byte
+	var err error
+	payload := blob.Data[compressionHeaderLen:]
+	switch blob.Data[1] {
+	case codecIDZstd:
+		data, err = zstdDecoder.DecodeAll(payload, nil)
+	case codecIDSnappy:
+		data, err = snappy.Decode(nil, payload)
+	default:
+		err = fmt.Errorf("%w: unknown codec ID %d", errCorruptCompressedBlob, blob.Data[1])
+	}
+	if err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, err)
+	}
+
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
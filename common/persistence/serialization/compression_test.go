@@ -0,0 +1,130 @@
+package serialization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestCompressBlob(t *testing.T) {
+	info := &persistencespb.ActivityInfo{
+		ActivityId:   "activity",
+		ActivityType: &commonpb.ActivityType{Name: "ActivityType"},
+		TaskQueue:    "task-queue-task-queue-task-queue-task-queue",
+	}
+	blob, err := ProtoEncode(info)
+	require.NoError(t, err)
+
+	for _, codec := range []CompressionCodec{CompressionCodecZstd, CompressionCodecSnappy} {
+		t.Run(string(codec), func(t *testing.T) {
+			compressed, err := CompressBlob(blob, codec)
+			require.NoError(t, err)
+			assert.True(t, IsCompressed(compressed))
+			assert.False(t, IsCompressed(blob))
+			assert.Equal(t, enumspb.ENCODING_TYPE_PROTO3, compressed.EncodingType)
+
+			// Compressing again is a no-op.
+			again, err := CompressBlob(compressed, codec)
+			require.NoError(t, err)
+			assert.Equal(t, compressed, again)
+
+			decompressed, err := DecompressBlob(compressed)
+			require.NoError(t, err)
+			assert.Equal(t, blob.Data, decompressed.Data)
+
+			result := &persistencespb.ActivityInfo{}
+			require.NoError(t, Decode(compressed, result))
+			protorequire.ProtoEqual(t, info, result)
+		})
+	}
+
+	t.Run("none", func(t *testing.T) {
+		result, err := CompressBlob(blob, CompressionCodecNone)
+		require.NoError(t, err)
+		assert.Same(t, blob, result)
+	})
+
+	t.Run("empty blob", func(t *testing.T) {
+		empty := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3}
+		result, err := CompressBlob(empty, CompressionCodecZstd)
+		require.NoError(t, err)
+		assert.Same(t, empty, result)
+	})
+
+	t.Run("unknown codec", func(t *testing.T) {
+		_, err := CompressBlob(blob, "lz4")
+		var serializationErr *SerializationError
+		require.ErrorAs(t, err, &serializationErr)
+	})
+}
+
+func TestDecompressBlob(t *testing.T) {
+	t.Run("uncompressed", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte{0x0a, 0x01, 'a'}}
+		result, err := DecompressBlob(blob)
+		require.NoError(t, err)
+		assert.Same(t, blob, result)
+	})
+
+	t.Run("unknown codec ID", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte{compressionMarker, 0xff, 0x01}}
+		_, err := DecompressBlob(blob)
+		var deserializationErr *DeserializationError
+		require.ErrorAs(t, err, &deserializationErr)
+	})
+
+	t.Run("corrupt data", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte{compressionMarker, codecIDZstd, 0x01, 0x02}}
+		_, err := DecompressBlob(blob)
+		var deserializationErr *DeserializationError
+		require.ErrorAs(t, err, &deserializationErr)
+	})
+}
+
+func TestDeserializeCompressedEvents(t *testing.T) {
+	serializer := NewSerializer()
+	events := []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, Version: 1},
+		{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, Version: 1},
+	}
+	blob, err := serializer.SerializeEvents(events)
+	require.NoError(t, err)
+	compressed, err := CompressBlob(blob, CompressionCodecSnappy)
+	require.NoError(t, err)
+
+	result, err := serializer.DeserializeEvents(compressed)
+	require.NoError(t, err)
+	require.Len(t, result, len(events))
+	for i := range events {
+		protorequire.ProtoEqual(t, events[i], result[i])
+	}
+
+	stripped, err := serializer.DeserializeStrippedEvents(compressed)
+	require.NoError(t, err)
+	require.Len(t, stripped, len(events))
+	assert.Equal(t, int64(2), stripped[1].GetEventId())
+
+	eventBlob, err := serializer.SerializeEvent(events[0])
+	require.NoError(t, err)
+	compressedEvent, err := CompressBlob(eventBlob, CompressionCodecZstd)
+	require.NoError(t, err)
+	event, err := serializer.DeserializeEvent(compressedEvent)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, events[0], event)
+}
+
+func TestParseCompressionCodec(t *testing.T) {
+	for _, name := range []string{"", "zstd", "snappy"} {
+		codec, err := ParseCompressionCodec(name)
+		require.NoError(t, err)
+		assert.Equal(t, CompressionCodec(name), codec)
+	}
+	_, err := ParseCompressionCodec("gzip")
+	require.Error(t, err)
+}
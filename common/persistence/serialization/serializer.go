@@ -144,9 +144,12 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 	if len(data.Data) == 0 {
 		return nil, nil
 	}
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	events := &historypb.History{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
@@ -167,9 +170,12 @@ func (t *serializerImpl) DeserializeStrippedEvents(data *commonpb.DataBlob) ([]*
 	if len(data.Data) == 0 {
 		return nil, nil
 	}
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	events := &historyspb.StrippedHistoryEvents{}
-	var err error
 	//nolint:exhaustive
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
@@ -201,9 +207,12 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 	if len(data.Data) == 0 {
 		return nil, nil
 	}
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	event := &historypb.HistoryEvent{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
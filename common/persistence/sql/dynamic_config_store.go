@@ -0,0 +1,138 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type (
+	sqlDynamicConfigStore struct {
+		SqlStore
+	}
+
+	listDynamicConfigHistoryNextPageToken struct {
+		MaxVersion int64
+	}
+)
+
+func NewSqlDynamicConfigStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+) p.DynamicConfigStore {
+	return &sqlDynamicConfigStore{
+		SqlStore: NewSqlStore(db, logger),
+	}
+}
+
+func (s *sqlDynamicConfigStore) GetDynamicConfig(
+	ctx context.Context,
+) (*p.InternalGetDynamicConfigResponse, error) {
+	row, err := s.DB.GetDynamicConfig(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &p.InternalGetDynamicConfigResponse{}, nil
+		}
+		s.logger.Error("error getting dynamic config", tag.Error(err))
+		return nil, serviceerror.NewUnavailable(err.Error())
+	}
+
+	return &p.InternalGetDynamicConfigResponse{
+		Version: row.Version,
+		Config:  p.NewDataBlob(row.Data, row.DataEncoding),
+	}, nil
+}
+
+func (s *sqlDynamicConfigStore) UpdateDynamicConfig(
+	ctx context.Context,
+	request *p.InternalUpdateDynamicConfigRequest,
+) error {
+	// txExecute converts unknown errors to Unavailable, so signal version conflicts with a ConditionFailedError and
+	// convert it back after the transaction.
+	conflictErr := &p.ConditionFailedError{Msg: p.ErrDynamicConfigVersionConflict.Error()}
+	err := s.txExecute(ctx, "UpdateDynamicConfig", func(tx sqlplugin.Tx) error {
+		row := sqlplugin.DynamicConfigRow{
+			Version:      request.PreviousVersion + 1,
+			Data:         request.Config.Data,
+			DataEncoding: request.Config.EncodingType.String(),
+		}
+		var result sql.Result
+		var err error
+		if request.PreviousVersion == 0 {
+			result, err = tx.InsertIntoDynamicConfig(ctx, &row)
+		} else {
+			result, err = tx.UpdateDynamicConfig(ctx, &row)
+		}
+		err = checkUpdateResult(result, err, conflictErr)
+		if s.DB.IsDupEntryError(err) {
+			return conflictErr
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.InsertIntoDynamicConfigHistory(ctx, &sqlplugin.DynamicConfigHistoryRow{
+			Version:      request.PreviousVersion + 1,
+			ConfigKey:    request.ChangeKey,
+			Data:         request.Change.Data,
+			DataEncoding: request.Change.EncodingType.String(),
+		})
+		if s.DB.IsDupEntryError(err) {
+			return conflictErr
+		}
+		return err
+	})
+	if err == conflictErr {
+		return p.ErrDynamicConfigVersionConflict
+	}
+	return err
+}
+
+func (s *sqlDynamicConfigStore) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *p.ListDynamicConfigHistoryRequest,
+) (*p.InternalListDynamicConfigHistoryResponse, error) {
+	maxVersion := int64(math.MaxInt64)
+	if len(request.NextPageToken) > 0 {
+		token, err := deserializePageTokenJson[listDynamicConfigHistoryNextPageToken](request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		maxVersion = token.MaxVersion
+	}
+
+	rows, err := s.DB.ListDynamicConfigHistory(ctx, &sqlplugin.ListDynamicConfigHistoryRequest{
+		MaxVersion: maxVersion,
+		ConfigKey:  request.Key,
+		Limit:      request.PageSize,
+	})
+	if err != nil {
+		s.logger.Error("error listing dynamic config history", tag.Error(err))
+		return nil, serviceerror.NewUnavailable(err.Error())
+	}
+
+	var response p.InternalListDynamicConfigHistoryResponse
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		response.NextPageToken, err = serializePageTokenJson(&listDynamicConfigHistoryNextPageToken{
+			MaxVersion: rows[len(rows)-1].Version,
+		})
+		if err != nil {
+			s.logger.Error("error serializing next page token during ListDynamicConfigHistory", tag.Error(err))
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+
+	response.Changes = make([]*commonpb.DataBlob, len(rows))
+	for i, row := range rows {
+		response.Changes[i] = p.NewDataBlob(row.Data, row.DataEncoding)
+	}
+	return &response, nil
+}
@@ -146,6 +146,15 @@ func (f *Factory) NewNexusEndpointStore() (p.NexusEndpointStore, error) {
 	return NewSqlNexusEndpointStore(conn, f.logger)
 }
 
+// NewDynamicConfigStore returns a new DynamicConfigStore
+func (f *Factory) NewDynamicConfigStore() (p.DynamicConfigStore, error) {
+	conn, err := f.mainDBConn.Get()
+	if err != nil {
+		return nil, err
+	}
+	return NewSqlDynamicConfigStore(conn, f.logger), nil
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.mainDBConn.ForceClose()
@@ -0,0 +1,41 @@
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+)
+
+type (
+	DynamicConfigRow struct {
+		Version      int64
+		Data         []byte
+		DataEncoding string
+	}
+
+	DynamicConfigHistoryRow struct {
+		Version int64
+		// ConfigKey is the lowercased key of the changed setting.
+		ConfigKey    string
+		Data         []byte
+		DataEncoding string
+	}
+
+	ListDynamicConfigHistoryRequest struct {
+		// MaxVersion is exclusive.
+		MaxVersion int64
+		// ConfigKey, if set, restricts the result to changes of this (lowercased) key.
+		ConfigKey string
+		Limit     int
+	}
+
+	// DynamicConfig is the SQL persistence interface for dynamic config overrides
+	DynamicConfig interface {
+		InsertIntoDynamicConfig(ctx context.Context, row *DynamicConfigRow) (sql.Result, error)
+		// UpdateDynamicConfig replaces the stored row if its version is row.Version-1.
+		UpdateDynamicConfig(ctx context.Context, row *DynamicConfigRow) (sql.Result, error)
+		GetDynamicConfig(ctx context.Context) (*DynamicConfigRow, error)
+
+		InsertIntoDynamicConfigHistory(ctx context.Context, row *DynamicConfigHistoryRow) (sql.Result, error)
+		ListDynamicConfigHistory(ctx context.Context, request *ListDynamicConfigHistoryRequest) ([]DynamicConfigHistoryRow, error)
+	}
+)
@@ -52,6 +52,8 @@ type (
 
 		NexusEndpoints
 
+		DynamicConfig
+
 		HistoryNode
 		HistoryTree
 
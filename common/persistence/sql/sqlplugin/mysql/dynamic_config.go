@@ -0,0 +1,77 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	createDynamicConfigQry = `INSERT INTO dynamic_config(data, data_encoding, version) VALUES (?, ?, ?)`
+	updateDynamicConfigQry = `UPDATE dynamic_config SET data = ?, data_encoding = ?, version = ? WHERE version = ?`
+	getDynamicConfigQry    = `SELECT data, data_encoding, version FROM dynamic_config`
+
+	createDynamicConfigHistoryQry    = `INSERT INTO dynamic_config_history(version, config_key, data, data_encoding) VALUES (?, ?, ?, ?)`
+	listDynamicConfigHistoryQry      = `SELECT version, config_key, data, data_encoding FROM dynamic_config_history WHERE version < ? ORDER BY version DESC LIMIT ?`
+	listDynamicConfigHistoryByKeyQry = `SELECT version, config_key, data, data_encoding FROM dynamic_config_history WHERE config_key = ? AND version < ? ORDER BY version DESC LIMIT ?`
+)
+
+func (mdb *db) InsertIntoDynamicConfig(
+	ctx context.Context,
+	row *sqlplugin.DynamicConfigRow,
+) (sql.Result, error) {
+	return mdb.ExecContext(
+		ctx,
+		createDynamicConfigQry,
+		row.Data,
+		row.DataEncoding,
+		row.Version)
+}
+
+func (mdb *db) UpdateDynamicConfig(
+	ctx context.Context,
+	row *sqlplugin.DynamicConfigRow,
+) (sql.Result, error) {
+	return mdb.ExecContext(
+		ctx,
+		updateDynamicConfigQry,
+		row.Data,
+		row.DataEncoding,
+		row.Version,
+		row.Version-1)
+}
+
+func (mdb *db) GetDynamicConfig(
+	ctx context.Context,
+) (*sqlplugin.DynamicConfigRow, error) {
+	var row sqlplugin.DynamicConfigRow
+	err := mdb.GetContext(ctx, &row, getDynamicConfigQry)
+	return &row, err
+}
+
+func (mdb *db) InsertIntoDynamicConfigHistory(
+	ctx context.Context,
+	row *sqlplugin.DynamicConfigHistoryRow,
+) (sql.Result, error) {
+	return mdb.ExecContext(
+		ctx,
+		createDynamicConfigHistoryQry,
+		row.Version,
+		row.ConfigKey,
+		row.Data,
+		row.DataEncoding)
+}
+
+func (mdb *db) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *sqlplugin.ListDynamicConfigHistoryRequest,
+) ([]sqlplugin.DynamicConfigHistoryRow, error) {
+	var rows []sqlplugin.DynamicConfigHistoryRow
+	if len(request.ConfigKey) > 0 {
+		err := mdb.SelectContext(ctx, &rows, listDynamicConfigHistoryByKeyQry, request.ConfigKey, request.MaxVersion, request.Limit)
+		return rows, err
+	}
+	err := mdb.SelectContext(ctx, &rows, listDynamicConfigHistoryQry, request.MaxVersion, request.Limit)
+	return rows, err
+}
@@ -0,0 +1,77 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	createDynamicConfigQry = `INSERT INTO dynamic_config(data, data_encoding, version) VALUES ($1, $2, $3)`
+	updateDynamicConfigQry = `UPDATE dynamic_config SET data = $1, data_encoding = $2, version = $3 WHERE version = $4`
+	getDynamicConfigQry    = `SELECT data, data_encoding, version FROM dynamic_config`
+
+	createDynamicConfigHistoryQry    = `INSERT INTO dynamic_config_history(version, config_key, data, data_encoding) VALUES ($1, $2, $3, $4)`
+	listDynamicConfigHistoryQry      = `SELECT version, config_key, data, data_encoding FROM dynamic_config_history WHERE version < $1 ORDER BY version DESC LIMIT $2`
+	listDynamicConfigHistoryByKeyQry = `SELECT version, config_key, data, data_encoding FROM dynamic_config_history WHERE config_key = $1 AND version < $2 ORDER BY version DESC LIMIT $3`
+)
+
+func (pdb *db) InsertIntoDynamicConfig(
+	ctx context.Context,
+	row *sqlplugin.DynamicConfigRow,
+) (sql.Result, error) {
+	return pdb.ExecContext(
+		ctx,
+		createDynamicConfigQry,
+		row.Data,
+		row.DataEncoding,
+		row.Version)
+}
+
+func (pdb *db) UpdateDynamicConfig(
+	ctx context.Context,
+	row *sqlplugin.DynamicConfigRow,
+) (sql.Result, error) {
+	return pdb.ExecContext(
+		ctx,
+		updateDynamicConfigQry,
+		row.Data,
+		row.DataEncoding,
+		row.Version,
+		row.Version-1)
+}
+
+func (pdb *db) GetDynamicConfig(
+	ctx context.Context,
+) (*sqlplugin.DynamicConfigRow, error) {
+	var row sqlplugin.DynamicConfigRow
+	err := pdb.GetContext(ctx, &row, getDynamicConfigQry)
+	return &row, err
+}
+
+func (pdb *db) InsertIntoDynamicConfigHistory(
+	ctx context.Context,
+	row *sqlplugin.DynamicConfigHistoryRow,
+) (sql.Result, error) {
+	return pdb.ExecContext(
+		ctx,
+		createDynamicConfigHistoryQry,
+		row.Version,
+		row.ConfigKey,
+		row.Data,
+		row.DataEncoding)
+}
+
+func (pdb *db) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *sqlplugin.ListDynamicConfigHistoryRequest,
+) ([]sqlplugin.DynamicConfigHistoryRow, error) {
+	var rows []sqlplugin.DynamicConfigHistoryRow
+	if len(request.ConfigKey) > 0 {
+		err := pdb.SelectContext(ctx, &rows, listDynamicConfigHistoryByKeyQry, request.ConfigKey, request.MaxVersion, request.Limit)
+		return rows, err
+	}
+	err := pdb.SelectContext(ctx, &rows, listDynamicConfigHistoryQry, request.MaxVersion, request.Limit)
+	return rows, err
+}
@@ -21,6 +21,7 @@ type (
 		queueV2            persistence.QueueV2
 		clusterMDStore     persistence.ClusterMetadataStore
 		nexusEndpointStore persistence.NexusEndpointStore
+		dynamicConfigStore persistence.DynamicConfigStore
 	}
 )
 
@@ -138,3 +139,14 @@ func (d *TelemetryDataStoreFactory) NewNexusEndpointStore() (persistence.NexusEn
 	}
 	return d.nexusEndpointStore, nil
 }
+
+func (d *TelemetryDataStoreFactory) NewDynamicConfigStore() (persistence.DynamicConfigStore, error) {
+	if d.dynamicConfigStore == nil {
+		baseStore, err := d.baseFactory.NewDynamicConfigStore()
+		if err != nil {
+			return nil, err
+		}
+		d.dynamicConfigStore = newTelemetryDynamicConfigStore(baseStore, d.logger, d.tracer)
+	}
+	return d.dynamicConfigStore, nil
+}
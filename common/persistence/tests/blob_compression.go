@@ -0,0 +1,205 @@
+package tests
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// BlobCompressionSuite checks that compressed history and mutable state are persisted compressed,
+	// and that rows written with and without compression can be read either way.
+	BlobCompressionSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		executionStore   p.ExecutionStore
+		serializer       serialization.Serializer
+		compressed       p.ExecutionManager
+		uncompressed     p.ExecutionManager
+		mutableStateTest *ExecutionMutableStateSuite
+	}
+)
+
+// NewCompressedExecutionMutableStateSuite returns an ExecutionMutableStateSuite whose execution
+// manager compresses every blob with codec.
+func NewCompressedExecutionMutableStateSuite(
+	t *testing.T,
+	shardStore p.ShardStore,
+	executionStore p.ExecutionStore,
+	serializer serialization.Serializer,
+	historyBranchUtil p.HistoryBranchUtil,
+	logger log.Logger,
+	codec serialization.CompressionCodec,
+) *ExecutionMutableStateSuite {
+	s := NewExecutionMutableStateSuite(t, shardStore, executionStore, serializer, historyBranchUtil, logger)
+	s.ExecutionManager = newCompressedExecutionManager(executionStore, serializer, logger, codec)
+	return s
+}
+
+func NewBlobCompressionSuite(
+	t *testing.T,
+	shardStore p.ShardStore,
+	executionStore p.ExecutionStore,
+	serializer serialization.Serializer,
+	historyBranchUtil p.HistoryBranchUtil,
+	logger log.Logger,
+	codec serialization.CompressionCodec,
+) *BlobCompressionSuite {
+	mutableStateTest := NewExecutionMutableStateSuite(t, shardStore, executionStore, serializer, historyBranchUtil, logger)
+	return &BlobCompressionSuite{
+		Assertions:       require.New(t),
+		executionStore:   executionStore,
+		serializer:       serializer,
+		compressed:       newCompressedExecutionManager(executionStore, serializer, logger, codec),
+		uncompressed:     mutableStateTest.ExecutionManager,
+		mutableStateTest: mutableStateTest,
+	}
+}
+
+func newCompressedExecutionManager(
+	executionStore p.ExecutionStore,
+	serializer serialization.Serializer,
+	logger log.Logger,
+	codec serialization.CompressionCodec,
+) p.ExecutionManager {
+	return p.NewExecutionManager(
+		executionStore,
+		serializer,
+		nil,
+		logger,
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		dynamicconfig.GetBoolPropertyFn(false),
+		p.NewBlobCompressor(
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(string(codec)),
+			dynamicconfig.GetIntPropertyFnFilteredByNamespaceID(0),
+			metrics.NoopMetricsHandler,
+			logger,
+		),
+	)
+}
+
+func (s *BlobCompressionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mutableStateTest.SetT(s.T())
+	s.mutableStateTest.SetupTest()
+}
+
+func (s *BlobCompressionSuite) TearDownTest() {
+	s.mutableStateTest.TearDownTest()
+}
+
+func (s *BlobCompressionSuite) TestCompressedRows() {
+	s.mutableStateTest.ExecutionManager = s.compressed
+	branchToken, snapshot, events := s.createWorkflow()
+	s.assertStoredCompressed(branchToken, true)
+
+	s.mutableStateTest.AssertMSEqualWithDB(chasm.WorkflowArchetypeID, snapshot)
+	s.mutableStateTest.AssertHEEqualWithDB(branchToken, events)
+
+	// Rows stay readable once compression is turned off.
+	s.mutableStateTest.ExecutionManager = s.uncompressed
+	s.mutableStateTest.AssertMSEqualWithDB(chasm.WorkflowArchetypeID, snapshot)
+	s.mutableStateTest.AssertHEEqualWithDB(branchToken, events)
+}
+
+func (s *BlobCompressionSuite) TestUncompressedRows() {
+	s.mutableStateTest.ExecutionManager = s.uncompressed
+	branchToken, snapshot, events := s.createWorkflow()
+	s.assertStoredCompressed(branchToken, false)
+
+	s.mutableStateTest.ExecutionManager = s.compressed
+	s.mutableStateTest.AssertMSEqualWithDB(chasm.WorkflowArchetypeID, snapshot)
+	s.mutableStateTest.AssertHEEqualWithDB(branchToken, events)
+}
+
+func (s *BlobCompressionSuite) TestReadRawHistoryBranch() {
+	s.mutableStateTest.ExecutionManager = s.compressed
+	branchToken, _, events := s.createWorkflow()
+
+	resp, err := s.compressed.ReadRawHistoryBranch(s.mutableStateTest.Ctx, &p.ReadHistoryBranchRequest{
+		ShardID:     s.mutableStateTest.ShardID,
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  math.MaxInt64,
+		PageSize:    len(events) + 1,
+	})
+	s.NoError(err)
+	s.Len(resp.HistoryEventBlobs, len(events))
+
+	var historyEvents []*historypb.HistoryEvent
+	for _, blob := range resp.HistoryEventBlobs {
+		// Raw history is returned to clients and remote clusters, which can't decompress it.
+		s.False(serialization.IsCompressed(blob))
+		batch, err := s.serializer.DeserializeEvents(blob)
+		s.NoError(err)
+		historyEvents = append(historyEvents, batch...)
+	}
+	var expected []*historypb.HistoryEvent
+	for _, batch := range events {
+		expected = append(expected, batch.Events...)
+	}
+	s.Len(historyEvents, len(expected))
+	for i, event := range expected {
+		s.mutableStateTest.ProtoEqual(event, historyEvents[i])
+	}
+}
+
+func (s *BlobCompressionSuite) createWorkflow() ([]byte, *p.WorkflowSnapshot, []*p.WorkflowEvents) {
+	return s.mutableStateTest.CreateWorkflow(
+		rand.Int63(),
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		rand.Int63(),
+	)
+}
+
+func (s *BlobCompressionSuite) assertStoredCompressed(branchToken []byte, compressed bool) {
+	ms := s.mutableStateTest
+	resp, err := s.executionStore.GetWorkflowExecution(ms.Ctx, &p.GetWorkflowExecutionRequest{
+		ShardID:     ms.ShardID,
+		NamespaceID: ms.NamespaceID,
+		WorkflowID:  ms.WorkflowID,
+		RunID:       ms.RunID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	})
+	s.NoError(err)
+	s.Equal(compressed, serialization.IsCompressed(resp.State.ExecutionInfo))
+	// The execution state is read by stores and never compressed.
+	s.False(serialization.IsCompressed(resp.State.ExecutionState))
+	for _, blob := range resp.State.ActivityInfos {
+		s.Equal(compressed, serialization.IsCompressed(blob))
+	}
+	for _, blob := range resp.State.TimerInfos {
+		s.Equal(compressed, serialization.IsCompressed(blob))
+	}
+
+	branch, err := ms.historyBranchUtil.ParseHistoryBranchInfo(branchToken)
+	s.NoError(err)
+	history, err := s.executionStore.ReadHistoryBranch(ms.Ctx, &p.InternalReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		BranchID:    branch.GetBranchId(),
+		MinNodeID:   common.FirstEventID,
+		MaxNodeID:   math.MaxInt64,
+		PageSize:    math.MaxInt32,
+		ShardID:     ms.ShardID,
+	})
+	s.NoError(err)
+	s.NotEmpty(history.Nodes)
+	for _, node := range history.Nodes {
+		s.Equal(compressed, serialization.IsCompressed(node.Events))
+	}
+}
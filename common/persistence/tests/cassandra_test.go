@@ -331,6 +331,16 @@ func TestCassandraNexusEndpointPersistence(t *testing.T) {
 	})
 }
 
+func TestCassandraDynamicConfigPersistence(t *testing.T) {
+	cluster := persistencetests.NewTestClusterForCassandra(&persistencetests.TestBaseOptions{}, log.NewNoopLogger())
+	cluster.SetupTestDatabase()
+	t.Cleanup(cluster.TearDownTestDatabase)
+
+	t.Run("Generic", func(t *testing.T) {
+		RunDynamicConfigTestSuite(t, cassandra.NewDynamicConfigStore(cluster.GetSession(), log.NewTestLogger()))
+	})
+}
+
 func testCassandraQueueV2DataCorruption(t *testing.T, cluster *cassandra.TestCluster) {
 	t.Run("ErrInvalidQueueMessageEncodingType", func(t *testing.T) {
 		t.Parallel()
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+// RunDynamicConfigTestSuite runs the dynamic config store tests. The store must be empty.
+func RunDynamicConfigTestSuite(t *testing.T, store persistence.DynamicConfigStore) {
+	manager := persistence.NewDynamicConfigManager(store, log.NewTestLogger())
+
+	// NB: These tests cannot be run in parallel because they all update the same version
+	t.Run("TestDynamicConfigSteadyState", func(t *testing.T) {
+		testDynamicConfigSteadyState(t, manager)
+	})
+	t.Run("TestUpdateDynamicConfigVersionConflict", func(t *testing.T) {
+		testUpdateDynamicConfigVersionConflict(t, manager)
+	})
+	t.Run("TestListDynamicConfigHistoryByKey", func(t *testing.T) {
+		testListDynamicConfigHistoryByKey(t, manager)
+	})
+}
+
+func RunDynamicConfigTestSuiteForSQL(t *testing.T, factory *sql.Factory) {
+	t.Run("Generic", func(t *testing.T) {
+		store, err := factory.NewDynamicConfigStore()
+		require.NoError(t, err)
+		RunDynamicConfigTestSuite(t, store)
+	})
+}
+
+func testDynamicConfigSteadyState(t *testing.T, manager persistence.DynamicConfigManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Get when nothing was stored yet
+	resp, err := manager.GetDynamicConfig(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), resp.Version)
+	require.Empty(t, resp.Config.GetEntries())
+
+	// History is empty
+	history, err := manager.ListDynamicConfigHistory(ctx, &persistence.ListDynamicConfigHistoryRequest{PageSize: 10})
+	require.NoError(t, err)
+	require.Empty(t, history.Changes)
+	require.Empty(t, history.NextPageToken)
+
+	// Apply a few updates
+	var configs []*persistencespb.DynamicConfig
+	for i := range 3 {
+		config := &persistencespb.DynamicConfig{
+			Entries: []*persistencespb.DynamicConfigEntry{{
+				Key: "testKey",
+				Values: []*persistencespb.DynamicConfigValue{{
+					Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"},
+					Value:       string(rune('1' + i)),
+				}},
+			}},
+		}
+		updateResp, err := manager.UpdateDynamicConfig(ctx, &persistence.UpdateDynamicConfigRequest{
+			PreviousVersion: int64(i),
+			Config:          config,
+			Change: &persistencespb.DynamicConfigChange{
+				Key:      "testKey",
+				Values:   config.Entries[0].Values,
+				Identity: "test",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(i+1), updateResp.Version)
+		configs = append(configs, config)
+
+		resp, err = manager.GetDynamicConfig(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(i+1), resp.Version)
+		protorequire.ProtoEqual(t, config, resp.Config)
+	}
+
+	// History is listed most recent first and paginated
+	var changes []*persistencespb.DynamicConfigChange
+	var pageToken []byte
+	for {
+		history, err = manager.ListDynamicConfigHistory(ctx, &persistence.ListDynamicConfigHistoryRequest{
+			PageSize:      2,
+			NextPageToken: pageToken,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(history.Changes), 2)
+		changes = append(changes, history.Changes...)
+		pageToken = history.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	require.Len(t, changes, 3)
+	for i, change := range changes {
+		version := int64(3 - i)
+		require.Equal(t, version, change.GetVersion())
+		require.Equal(t, "testKey", change.GetKey())
+		require.Equal(t, "test", change.GetIdentity())
+		protorequire.ProtoSliceEqual(t, configs[version-1].Entries[0].Values, change.GetValues())
+	}
+}
+
+func testUpdateDynamicConfigVersionConflict(t *testing.T, manager persistence.DynamicConfigManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := manager.GetDynamicConfig(ctx)
+	require.NoError(t, err)
+
+	for _, previousVersion := range []int64{0, resp.Version - 1, resp.Version + 1} {
+		_, err = manager.UpdateDynamicConfig(ctx, &persistence.UpdateDynamicConfigRequest{
+			PreviousVersion: previousVersion,
+			Config:          &persistencespb.DynamicConfig{},
+		})
+		require.ErrorIs(t, err, persistence.ErrDynamicConfigVersionConflict)
+	}
+
+	// Nothing changed
+	after, err := manager.GetDynamicConfig(ctx)
+	require.NoError(t, err)
+	require.Equal(t, resp.Version, after.Version)
+	protorequire.ProtoEqual(t, resp.Config, after.Config)
+}
+
+func testListDynamicConfigHistoryByKey(t *testing.T, manager persistence.DynamicConfigManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := manager.GetDynamicConfig(ctx)
+	require.NoError(t, err)
+	version := resp.Version
+
+	// Keys are stored as given, but matched case-insensitively
+	for _, key := range []string{"otherKey", "OtherKey", "filterKey"} {
+		updateResp, err := manager.UpdateDynamicConfig(ctx, &persistence.UpdateDynamicConfigRequest{
+			PreviousVersion: version,
+			Config:          resp.Config,
+			Change:          &persistencespb.DynamicConfigChange{Key: key},
+		})
+		require.NoError(t, err)
+		version = updateResp.Version
+	}
+
+	listKey := func(key string) []*persistencespb.DynamicConfigChange {
+		var changes []*persistencespb.DynamicConfigChange
+		var pageToken []byte
+		for {
+			history, err := manager.ListDynamicConfigHistory(ctx, &persistence.ListDynamicConfigHistoryRequest{
+				Key:           key,
+				PageSize:      1,
+				NextPageToken: pageToken,
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(history.Changes), 1)
+			changes = append(changes, history.Changes...)
+			pageToken = history.NextPageToken
+			if len(pageToken) == 0 {
+				return changes
+			}
+		}
+	}
+
+	changes := listKey("otherkey")
+	require.Len(t, changes, 2)
+	require.Equal(t, version-1, changes[0].GetVersion())
+	require.Equal(t, "OtherKey", changes[0].GetKey())
+	require.Equal(t, version-2, changes[1].GetVersion())
+	require.Equal(t, "otherKey", changes[1].GetKey())
+
+	changes = listKey("FILTERKEY")
+	require.Len(t, changes, 1)
+	require.Equal(t, version, changes[0].GetVersion())
+
+	require.Empty(t, listKey("missingKey"))
+}
@@ -74,6 +74,7 @@ func NewExecutionMutableStateSuite(
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
 			dynamicconfig.GetBoolPropertyFn(false),
+			nil,
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
@@ -84,6 +84,7 @@ func NewExecutionMutableStateTaskSuite(
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
 			dynamicconfig.GetBoolPropertyFn(false),
+			nil,
 		),
 		Logger: logger,
 	}
@@ -66,6 +66,7 @@ func NewHistoryEventsSuite(
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
 			dynamicconfig.GetBoolPropertyFn(false),
+			nil,
 		),
 		serializer: eventSerializer,
 		logger:     logger,
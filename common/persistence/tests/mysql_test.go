@@ -677,3 +677,9 @@ func TestMySQLNexusEndpointPersistence(t *testing.T) {
 		RunNexusEndpointTestSuite(t, store, &tableVersion)
 	})
 }
+
+func TestMySQLDynamicConfigPersistence(t *testing.T) {
+	testData, tearDown := setUpMySQLTest(t)
+	t.Cleanup(tearDown)
+	RunDynamicConfigTestSuiteForSQL(t, testData.Factory)
+}
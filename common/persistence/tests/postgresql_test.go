@@ -666,6 +666,12 @@ func (p *PostgreSQLSuite) TestPostgreSQLNexusEndpointPersistence() {
 	RunNexusEndpointTestSuiteForSQL(p.T(), testData.Factory)
 }
 
+func (p *PostgreSQLSuite) TestPostgreSQLDynamicConfigPersistence() {
+	testData, tearDown := setUpPostgreSQLTest(p.T(), p.pluginName)
+	p.T().Cleanup(tearDown)
+	RunDynamicConfigTestSuiteForSQL(p.T(), testData.Factory)
+}
+
 func TestPQ(t *testing.T) {
 	s := &PostgreSQLSuite{pluginName: "postgres12"}
 	suite.Run(t, s)
@@ -172,6 +172,88 @@ func TestSQLiteHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLiteCompressedExecutionMutableStateStoreSuite(t *testing.T) {
+	for _, codec := range []serialization.CompressionCodec{
+		serialization.CompressionCodecZstd,
+		serialization.CompressionCodecSnappy,
+	} {
+		t.Run(string(codec), func(t *testing.T) {
+			cfg := NewSQLiteMemoryConfig()
+			logger := log.NewNoopLogger()
+			factory := sql.NewFactory(
+				*cfg,
+				resolver.NewNoopResolver(),
+				testSQLiteClusterName,
+				logger,
+				metrics.NoopMetricsHandler,
+			)
+			shardStore, err := factory.NewShardStore()
+			if err != nil {
+				t.Fatalf("unable to create SQLite DB: %v", err)
+			}
+			executionStore, err := factory.NewExecutionStore()
+			if err != nil {
+				t.Fatalf("unable to create SQLite DB: %v", err)
+			}
+			defer func() {
+				factory.Close()
+			}()
+
+			s := NewCompressedExecutionMutableStateSuite(
+				t,
+				shardStore,
+				executionStore,
+				serialization.NewSerializer(),
+				&persistence.HistoryBranchUtilImpl{},
+				logger,
+				codec,
+			)
+			suite.Run(t, s)
+		})
+	}
+}
+
+func TestSQLiteBlobCompressionSuite(t *testing.T) {
+	for _, codec := range []serialization.CompressionCodec{
+		serialization.CompressionCodecZstd,
+		serialization.CompressionCodecSnappy,
+	} {
+		t.Run(string(codec), func(t *testing.T) {
+			cfg := NewSQLiteMemoryConfig()
+			logger := log.NewNoopLogger()
+			factory := sql.NewFactory(
+				*cfg,
+				resolver.NewNoopResolver(),
+				testSQLiteClusterName,
+				logger,
+				metrics.NoopMetricsHandler,
+			)
+			shardStore, err := factory.NewShardStore()
+			if err != nil {
+				t.Fatalf("unable to create SQLite DB: %v", err)
+			}
+			executionStore, err := factory.NewExecutionStore()
+			if err != nil {
+				t.Fatalf("unable to create SQLite DB: %v", err)
+			}
+			defer func() {
+				factory.Close()
+			}()
+
+			s := NewBlobCompressionSuite(
+				t,
+				shardStore,
+				executionStore,
+				serialization.NewSerializer(),
+				&persistence.HistoryBranchUtilImpl{},
+				logger,
+				codec,
+			)
+			suite.Run(t, s)
+		})
+	}
+}
+
 func TestSQLiteTaskQueueSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
 	logger := log.NewNoopLogger()
@@ -1302,6 +1384,24 @@ func TestSQLiteNexusEndpointPersistence(t *testing.T) {
 	RunNexusEndpointTestSuiteForSQL(t, factory)
 }
 
+func TestSQLiteDynamicConfigPersistence(t *testing.T) {
+	cfg := NewSQLiteFileConfig()
+	SetupSQLiteDatabase(t, cfg)
+	logger := log.NewNoopLogger()
+	factory := sql.NewFactory(
+		*cfg,
+		resolver.NewNoopResolver(),
+		testSQLiteClusterName,
+		logger,
+		metrics.NoopMetricsHandler,
+	)
+	t.Cleanup(func() {
+		factory.Close()
+		assert.NoError(t, os.Remove(cfg.DatabaseName))
+	})
+	RunDynamicConfigTestSuiteForSQL(t, factory)
+}
+
 // Go sql library will close the connection when a context is cancelled during a transaction. Since we only have one
 // connection to the sqlite database, we will lose the db in this case. We fixed this by extending the driver in
 // modernc.org/sqlite. This test verifies that fix.
@@ -0,0 +1,255 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// ClientCABundleNamespaceDataKey is the namespace data key holding the PEM
+	// bundle of the CAs trusted to sign the namespace's client certificates.
+	ClientCABundleNamespaceDataKey = "temporal.io/client-ca-bundle"
+	// CertificateFiltersNamespaceDataKey is the namespace data key holding a
+	// JSON list of certificate filters. A client certificate must match at
+	// least one filter; empty filter fields match any value.
+	CertificateFiltersNamespaceDataKey = "temporal.io/certificate-filters"
+
+	clientCertificateDeniedReason = "ClientCertificate"
+)
+
+var _ grpc.UnaryServerInterceptor = (*ClientCertificateInterceptor)(nil).Intercept
+
+type (
+	// ClientCertificateInterceptor denies requests to a namespace unless the
+	// client presented a certificate signed by one of the namespace's client
+	// CAs and matching its certificate filters.
+	//
+	// The CAs and filters are read from namespace data on each request, so
+	// changes take effect when the namespace registry refreshes. Requests from
+	// system principals are not checked, unless the claim mapper is the no-op
+	// one. The interceptor must run after the authorization interceptor, which
+	// maps the caller's claims.
+	ClientCertificateInterceptor struct {
+		namespaceRegistry namespace.Registry
+		enabledForNS      dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		claimMapper       authorization.ClaimMapper
+		logger            log.Logger
+
+		// policies caches the parsed policy of each namespace.
+		policies sync.Map
+	}
+
+	// CertificateFilter restricts the client certificates accepted by a
+	// namespace.
+	CertificateFilter struct {
+		CommonName             string `json:"common_name,omitempty"`
+		Organization           string `json:"organization,omitempty"`
+		OrganizationalUnit     string `json:"organizational_unit,omitempty"`
+		SubjectAlternativeName string `json:"subject_alternative_name,omitempty"`
+	}
+
+	clientCertificatePolicy struct {
+		key     [sha256.Size]byte
+		roots   *x509.CertPool
+		filters []CertificateFilter
+		err     error
+	}
+)
+
+func NewClientCertificateInterceptor(
+	dc *dynamicconfig.Collection,
+	namespaceRegistry namespace.Registry,
+	claimMapper authorization.ClaimMapper,
+	logger log.Logger,
+) *ClientCertificateInterceptor {
+	return &ClientCertificateInterceptor{
+		namespaceRegistry: namespaceRegistry,
+		enabledForNS:      dynamicconfig.FrontendEnableNamespaceClientCertificates.Get(dc),
+		claimMapper:       claimMapper,
+		logger:            logger,
+	}
+}
+
+func (i *ClientCertificateInterceptor) Intercept(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if err := i.check(ctx, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i *ClientCertificateInterceptor) check(ctx context.Context, req any) error {
+	if _, ok := req.(*workflowservice.RegisterNamespaceRequest); ok {
+		return nil
+	}
+	if isSystemCaller(ctx, i.claimMapper) {
+		return nil
+	}
+	namespaceName := MustGetNamespaceName(i.namespaceRegistry, req)
+	if namespaceName == namespace.EmptyName || !i.enabledForNS(namespaceName.String()) {
+		return nil
+	}
+	ns, err := i.namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		return nil
+	}
+	bundle := ns.GetCustomData(ClientCABundleNamespaceDataKey)
+	filters := ns.GetCustomData(CertificateFiltersNamespaceDataKey)
+	if strings.TrimSpace(bundle) == "" && strings.TrimSpace(filters) == "" {
+		return nil
+	}
+
+	policy := i.namespacePolicy(namespaceName, bundle, filters)
+	if policy.err != nil {
+		// Fail closed, like an invalid IP allowlist.
+		i.logger.Error("Invalid namespace client certificate policy",
+			tag.WorkflowNamespace(namespaceName.String()), tag.Error(policy.err))
+		return serviceerror.NewPermissionDeniedf(clientCertificateDeniedReason, "Namespace %s has an invalid client certificate policy.", namespaceName)
+	}
+
+	cert, err := policy.verify(authorization.TLSInfoFromContext(ctx))
+	if err != nil {
+		return serviceerror.NewPermissionDeniedf(clientCertificateDeniedReason, "Client certificate not accepted by namespace %s: %v.", namespaceName, err)
+	}
+	if len(policy.filters) > 0 && !slices.ContainsFunc(policy.filters, func(f CertificateFilter) bool { return f.Matches(cert) }) {
+		return serviceerror.NewPermissionDeniedf(clientCertificateDeniedReason, "Client certificate does not match the certificate filters of namespace %s.", namespaceName)
+	}
+	return nil
+}
+
+func (i *ClientCertificateInterceptor) namespacePolicy(namespaceName namespace.Name, bundle, filters string) *clientCertificatePolicy {
+	key := sha256.Sum256([]byte(bundle + "\x00" + filters))
+	if cached, ok := i.policies.Load(namespaceName); ok && cached.(*clientCertificatePolicy).key == key {
+		return cached.(*clientCertificatePolicy)
+	}
+	policy := parseClientCertificatePolicy(bundle, filters)
+	policy.key = key
+	i.policies.Store(namespaceName, policy)
+	return policy
+}
+
+func parseClientCertificatePolicy(bundle, filters string) *clientCertificatePolicy {
+	policy := &clientCertificatePolicy{}
+	if strings.TrimSpace(bundle) != "" {
+		policy.roots = x509.NewCertPool()
+		var cas int
+		rest := []byte(bundle)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				policy.err = fmt.Errorf("invalid client CA: %w", err)
+				return policy
+			}
+			policy.roots.AddCert(cert)
+			cas++
+		}
+		if cas == 0 || len(strings.TrimSpace(string(rest))) > 0 {
+			policy.err = errors.New("invalid client CA bundle")
+			return policy
+		}
+	}
+	if strings.TrimSpace(filters) != "" {
+		if err := json.Unmarshal([]byte(filters), &policy.filters); err != nil {
+			policy.err = fmt.Errorf("invalid certificate filters: %w", err)
+		}
+	}
+	return policy
+}
+
+// verify returns the client certificate of the connection, checked against
+// the namespace's CAs if it has any. The chain is verified here rather than
+// during the handshake because the CAs differ between namespaces.
+func (p *clientCertificatePolicy) verify(tlsInfo *credentials.TLSInfo) (*x509.Certificate, error) {
+	if tlsInfo == nil || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, errors.New("no client certificate")
+	}
+	cert := tlsInfo.State.PeerCertificates[0]
+	if p.roots == nil {
+		return cert, nil
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range tlsInfo.State.PeerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:         p.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// Matches reports whether the certificate matches the filter.
+func (f CertificateFilter) Matches(cert *x509.Certificate) bool {
+	if f.CommonName != "" && cert.Subject.CommonName != f.CommonName {
+		return false
+	}
+	if f.Organization != "" && !slices.Contains(cert.Subject.Organization, f.Organization) {
+		return false
+	}
+	if f.OrganizationalUnit != "" && !slices.Contains(cert.Subject.OrganizationalUnit, f.OrganizationalUnit) {
+		return false
+	}
+	if f.SubjectAlternativeName != "" && !slices.Contains(subjectAlternativeNames(cert), f.SubjectAlternativeName) {
+		return false
+	}
+	return true
+}
+
+func subjectAlternativeNames(cert *x509.Certificate) []string {
+	names := slices.Clone(cert.DNSNames)
+	names = append(names, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+	return names
+}
+
+// isSystemCaller reports whether the request was made by a system principal:
+// another server component or cluster, or the control plane.
+func isSystemCaller(ctx context.Context, claimMapper authorization.ClaimMapper) bool {
+	claims := callerClaims(ctx, claimMapper)
+	return claims != nil && claims.System&authorization.RoleAdmin != 0
+}
+
+// callerClaims returns the caller's claims mapped by the authorization
+// interceptor with claimMapper, or nil if they do not authenticate the caller.
+// The no-op claim mapper makes every caller a system admin, so its claims are
+// never trusted.
+func callerClaims(ctx context.Context, claimMapper authorization.ClaimMapper) *authorization.Claims {
+	if claimMapper == nil || authorization.IsNoopClaimMapper(claimMapper) {
+		return nil
+	}
+	claims, _ := ctx.Value(authorization.MappedClaims).(*authorization.Claims)
+	return claims
+}
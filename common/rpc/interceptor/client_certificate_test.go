@@ -0,0 +1,194 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+func newTestCertificate(t *testing.T, subject pkix.Name, issuer *testCertificate) *testCertificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	parent, signer := template, key
+	if issuer == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		template.DNSNames = []string{subject.CommonName}
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCertificate{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func certificateContext(certs ...*testCertificate) context.Context {
+	var state tls.ConnectionState
+	for _, c := range certs {
+		state.PeerCertificates = append(state.PeerCertificates, c.cert)
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+// newClientCertificateTestInterceptor creates an interceptor for
+// test-namespace. A nil claim mapper stands for one that authenticates
+// callers.
+func newClientCertificateTestInterceptor(t *testing.T, data map[string]string, dc dynamicconfig.StaticClient, claimMapper authorization.ClaimMapper) *ClientCertificateInterceptor {
+	ctrl := gomock.NewController(t)
+	if claimMapper == nil {
+		claimMapper = authorization.NewMockClaimMapper(ctrl)
+	}
+	registry := namespace.NewMockRegistry(ctrl)
+	info := &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace", Data: data}
+	ns := namespace.NewLocalNamespaceForTest(info, nil, cluster.TestCurrentClusterName)
+	registry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(ns, nil).AnyTimes()
+	return NewClientCertificateInterceptor(dynamicconfig.NewCollection(dc, log.NewNoopLogger()), registry, claimMapper, log.NewNoopLogger())
+}
+
+func TestClientCertificateInterceptor(t *testing.T) {
+	ca := newTestCertificate(t, pkix.Name{CommonName: "namespace CA"}, nil)
+	otherCA := newTestCertificate(t, pkix.Name{CommonName: "other CA"}, nil)
+	worker := newTestCertificate(t, pkix.Name{CommonName: "worker.example.com", Organization: []string{"test-namespace"}}, ca)
+	stranger := newTestCertificate(t, pkix.Name{CommonName: "worker.example.com", Organization: []string{"test-namespace"}}, otherCA)
+	systemCtx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{System: authorization.RoleAdmin})
+
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		dc          dynamicconfig.StaticClient
+		claimMapper authorization.ClaimMapper
+		ctx         context.Context
+		denied      string
+	}{
+		{
+			name: "no policy",
+			ctx:  context.Background(),
+		},
+		{
+			name: "signed by namespace CA",
+			data: map[string]string{ClientCABundleNamespaceDataKey: otherCA.pem + ca.pem},
+			ctx:  certificateContext(worker),
+		},
+		{
+			name:   "signed by other CA",
+			data:   map[string]string{ClientCABundleNamespaceDataKey: ca.pem},
+			ctx:    certificateContext(stranger),
+			denied: "Client certificate not accepted by namespace test-namespace",
+		},
+		{
+			name:   "no client certificate",
+			data:   map[string]string{ClientCABundleNamespaceDataKey: ca.pem},
+			ctx:    context.Background(),
+			denied: "no client certificate",
+		},
+		{
+			name: "matching filter",
+			data: map[string]string{
+				ClientCABundleNamespaceDataKey:     ca.pem,
+				CertificateFiltersNamespaceDataKey: `[{"common_name":"other"},{"organization":"test-namespace","subject_alternative_name":"worker.example.com"}]`,
+			},
+			ctx: certificateContext(worker),
+		},
+		{
+			name: "no matching filter",
+			data: map[string]string{
+				ClientCABundleNamespaceDataKey:     ca.pem,
+				CertificateFiltersNamespaceDataKey: `[{"organizational_unit":"payments"}]`,
+			},
+			ctx:    certificateContext(worker),
+			denied: "does not match the certificate filters",
+		},
+		{
+			name:   "invalid bundle",
+			data:   map[string]string{ClientCABundleNamespaceDataKey: "not a certificate"},
+			ctx:    certificateContext(worker),
+			denied: "Namespace test-namespace has an invalid client certificate policy.",
+		},
+		{
+			name:   "invalid filters",
+			data:   map[string]string{CertificateFiltersNamespaceDataKey: "{"},
+			ctx:    certificateContext(worker),
+			denied: "Namespace test-namespace has an invalid client certificate policy.",
+		},
+		{
+			name: "system caller",
+			data: map[string]string{ClientCABundleNamespaceDataKey: ca.pem},
+			ctx:  systemCtx,
+		},
+		{
+			// The no-op claim mapper makes every caller a system admin.
+			name:        "system caller with no-op claim mapper",
+			data:        map[string]string{ClientCABundleNamespaceDataKey: ca.pem},
+			claimMapper: authorization.NewNoopClaimMapper(),
+			ctx:         systemCtx,
+			denied:      "no client certificate",
+		},
+		{
+			name: "disabled",
+			data: map[string]string{ClientCABundleNamespaceDataKey: ca.pem},
+			dc:   dynamicconfig.StaticClient{dynamicconfig.FrontendEnableNamespaceClientCertificates.Key(): false},
+			ctx:  certificateContext(stranger),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			i := newClientCertificateTestInterceptor(t, tc.data, tc.dc, tc.claimMapper)
+			req := &workflowservice.DescribeNamespaceRequest{Namespace: "test-namespace"}
+			_, err := i.Intercept(tc.ctx, req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+				return &workflowservice.DescribeNamespaceResponse{}, nil
+			})
+			if tc.denied == "" {
+				require.NoError(t, err)
+				return
+			}
+			var permissionDenied *serviceerror.PermissionDenied
+			require.ErrorAs(t, err, &permissionDenied)
+			require.Equal(t, clientCertificateDeniedReason, permissionDenied.Reason)
+			require.Contains(t, err.Error(), tc.denied)
+		})
+	}
+}
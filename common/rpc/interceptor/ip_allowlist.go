@@ -0,0 +1,259 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// IPAllowlistNamespaceDataKey is the namespace data key holding the
+	// namespace's IP allowlist, a comma-separated list of addresses and CIDRs.
+	IPAllowlistNamespaceDataKey = "temporal.io/ip-allowlist"
+
+	forwardedForHeaderName  = "x-forwarded-for"
+	ipAllowlistDeniedReason = "IPAllowlist"
+)
+
+var _ grpc.UnaryServerInterceptor = (*IPAllowlistInterceptor)(nil).Intercept
+
+type (
+	// IPAllowlistInterceptor denies requests to a namespace from client
+	// addresses outside the namespace's IP allowlist.
+	//
+	// Allowlists are read from namespace data on each request, so changes
+	// take effect when the namespace registry refreshes. Enforcement and the
+	// trusted proxies are controlled by dynamic config. Requests from system
+	// principals and requests forwarded by remote clusters are not checked,
+	// unless the claim mapper is the no-op one, which does not authenticate
+	// them. The interceptor must run after the authorization interceptor,
+	// which maps the caller's claims.
+	IPAllowlistInterceptor struct {
+		namespaceRegistry namespace.Registry
+		enabledForNS      dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		trustedProxies    dynamicconfig.TypedPropertyFn[[]string]
+		claimMapper       authorization.ClaimMapper
+		logger            log.Logger
+
+		// allowlists caches the parsed allowlist of each namespace;
+		// proxies caches the parsed trusted proxies.
+		allowlists sync.Map
+		proxies    atomic.Pointer[ipAllowlist]
+	}
+
+	ipAllowlist struct {
+		raw      string
+		prefixes []netip.Prefix
+		err      error
+	}
+)
+
+func NewIPAllowlistInterceptor(
+	dc *dynamicconfig.Collection,
+	namespaceRegistry namespace.Registry,
+	claimMapper authorization.ClaimMapper,
+	logger log.Logger,
+) *IPAllowlistInterceptor {
+	return &IPAllowlistInterceptor{
+		namespaceRegistry: namespaceRegistry,
+		enabledForNS:      dynamicconfig.FrontendEnableNamespaceIPAllowlist.Get(dc),
+		trustedProxies:    dynamicconfig.FrontendIPAllowlistTrustedProxies.Get(dc),
+		claimMapper:       claimMapper,
+		logger:            logger,
+	}
+}
+
+func (i *IPAllowlistInterceptor) Intercept(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if err := i.check(ctx, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i *IPAllowlistInterceptor) check(ctx context.Context, req any) error {
+	if _, ok := req.(*workflowservice.RegisterNamespaceRequest); ok {
+		// The namespace does not exist yet, and looking it up would cache
+		// its absence.
+		return nil
+	}
+	if isSystemCaller(ctx, i.claimMapper) || isForwardedByRemoteCluster(ctx, i.claimMapper) {
+		// A forwarded request was checked by the cluster that received it;
+		// here its address is the remote cluster's.
+		return nil
+	}
+	namespaceName := MustGetNamespaceName(i.namespaceRegistry, req)
+	if namespaceName == namespace.EmptyName || !i.enabledForNS(namespaceName.String()) {
+		return nil
+	}
+	ns, err := i.namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		// The namespace validator reports unknown namespaces.
+		return nil
+	}
+	raw := ns.GetCustomData(IPAllowlistNamespaceDataKey)
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+
+	allowlist := i.namespaceAllowlist(namespaceName, raw)
+	if allowlist.err != nil {
+		// Fail closed: a namespace with an allowlist is never left open.
+		i.logger.Error("Invalid namespace IP allowlist",
+			tag.WorkflowNamespace(namespaceName.String()), tag.Error(allowlist.err))
+		return serviceerror.NewPermissionDeniedf(ipAllowlistDeniedReason, "Namespace %s has an invalid IP allowlist.", namespaceName)
+	}
+
+	addr, ok := i.clientAddr(ctx)
+	if !ok {
+		return serviceerror.NewPermissionDeniedf(ipAllowlistDeniedReason, "Unable to determine the client address for the IP allowlist of namespace %s.", namespaceName)
+	}
+	if !containsAddr(allowlist.prefixes, addr) {
+		return serviceerror.NewPermissionDeniedf(ipAllowlistDeniedReason, "Requests from %s are not allowed by the IP allowlist of namespace %s.", addr, namespaceName)
+	}
+	return nil
+}
+
+func (i *IPAllowlistInterceptor) namespaceAllowlist(namespaceName namespace.Name, raw string) *ipAllowlist {
+	if cached, ok := i.allowlists.Load(namespaceName); ok && cached.(*ipAllowlist).raw == raw {
+		return cached.(*ipAllowlist)
+	}
+	allowlist := parseIPAllowlist(raw)
+	i.allowlists.Store(namespaceName, allowlist)
+	return allowlist
+}
+
+func (i *IPAllowlistInterceptor) trustedProxyList() *ipAllowlist {
+	raw := strings.Join(i.trustedProxies(), ",")
+	if cached := i.proxies.Load(); cached != nil && cached.raw == raw {
+		return cached
+	}
+	proxies := parseIPAllowlist(raw)
+	i.proxies.Store(proxies)
+	return proxies
+}
+
+func parseIPAllowlist(raw string) *ipAllowlist {
+	prefixes, err := ParseIPAllowlist(strings.Split(raw, ","))
+	return &ipAllowlist{raw: raw, prefixes: prefixes, err: err}
+}
+
+// clientAddr returns the address of the client. Requests relayed by trusted
+// proxies are attributed to the last untrusted address in x-forwarded-for.
+func (i *IPAllowlistInterceptor) clientAddr(ctx context.Context) (netip.Addr, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, false
+	}
+	addr, ok := peerAddr(p.Addr)
+	if !ok {
+		return netip.Addr{}, false
+	}
+
+	trusted := i.trustedProxyList()
+	if trusted.err != nil {
+		i.logger.Error("Invalid trusted proxies for IP allowlists", tag.Error(trusted.err))
+		return addr, true
+	}
+	if !containsAddr(trusted.prefixes, addr) {
+		return addr, true
+	}
+
+	var hops []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(forwardedForHeaderName) {
+			hops = append(hops, strings.Split(value, ",")...)
+		}
+	}
+	for j := len(hops) - 1; j >= 0; j-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[j]))
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		hop = hop.Unmap()
+		if !containsAddr(trusted.prefixes, hop) {
+			return hop, true
+		}
+		addr = hop
+	}
+	return addr, true
+}
+
+// isForwardedByRemoteCluster reports whether the request was forwarded by the
+// frontend of a remote cluster. Remote clusters authenticate as system
+// principals, so the redirection header alone is not trusted.
+func isForwardedByRemoteCluster(ctx context.Context, claimMapper authorization.ClaimMapper) bool {
+	values := metadata.ValueFromIncomingContext(ctx, DCRedirectionApiHeaderName)
+	if len(values) == 0 || values[0] != "true" {
+		return false
+	}
+	claims := callerClaims(ctx, claimMapper)
+	return claims != nil && claims.System != authorization.RoleUndefined
+}
+
+func peerAddr(addr net.Addr) (netip.Addr, bool) {
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		ip, ok := netip.AddrFromSlice(tcpAddr.IP)
+		return ip.Unmap(), ok
+	}
+	addrPort, err := netip.ParseAddrPort(addr.String())
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addrPort.Addr().Unmap(), true
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseIPAllowlist parses a list of addresses and CIDRs. Blank entries are
+// ignored and single addresses allow just that address.
+func ParseIPAllowlist(entries []string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q: %w", entry, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", entry, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
@@ -0,0 +1,216 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// newIPAllowlistTestInterceptor creates an interceptor for test-namespace. A
+// nil claim mapper stands for one that authenticates callers.
+func newIPAllowlistTestInterceptor(t *testing.T, allowlist string, dc dynamicconfig.StaticClient, claimMapper authorization.ClaimMapper) *IPAllowlistInterceptor {
+	ctrl := gomock.NewController(t)
+	if claimMapper == nil {
+		claimMapper = authorization.NewMockClaimMapper(ctrl)
+	}
+	registry := namespace.NewMockRegistry(ctrl)
+	info := &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"}
+	if allowlist != "" {
+		info.Data = map[string]string{IPAllowlistNamespaceDataKey: allowlist}
+	}
+	ns := namespace.NewLocalNamespaceForTest(info, nil, cluster.TestCurrentClusterName)
+	registry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(ns, nil).AnyTimes()
+	return NewIPAllowlistInterceptor(dynamicconfig.NewCollection(dc, log.NewNoopLogger()), registry, claimMapper, log.NewNoopLogger())
+}
+
+func peerContext(addr string, forwardedFor ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 7233},
+	})
+	if len(forwardedFor) > 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(forwardedForHeaderName, forwardedFor[0]))
+	}
+	return ctx
+}
+
+func claimsContext(ctx context.Context, system authorization.Role) context.Context {
+	return context.WithValue(ctx, authorization.MappedClaims, &authorization.Claims{System: system})
+}
+
+func redirectedContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = metadata.Join(md, metadata.Pairs(DCRedirectionApiHeaderName, "true"))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func interceptIPAllowlist(i *IPAllowlistInterceptor, ctx context.Context) error {
+	req := &workflowservice.DescribeNamespaceRequest{Namespace: "test-namespace"}
+	_, err := i.Intercept(ctx, req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		return &workflowservice.DescribeNamespaceResponse{}, nil
+	})
+	return err
+}
+
+func TestIPAllowlistInterceptor(t *testing.T) {
+	testCases := []struct {
+		name        string
+		allowlist   string
+		dc          dynamicconfig.StaticClient
+		claimMapper authorization.ClaimMapper
+		ctx         context.Context
+		denied      string
+	}{
+		{
+			name: "no allowlist",
+			ctx:  peerContext("203.0.113.7"),
+		},
+		{
+			name:      "allowed CIDR",
+			allowlist: "10.0.0.0/8, 203.0.113.0/24",
+			ctx:       peerContext("203.0.113.7"),
+		},
+		{
+			name:      "allowed address",
+			allowlist: "203.0.113.7",
+			ctx:       peerContext("203.0.113.7"),
+		},
+		{
+			name:      "denied",
+			allowlist: "10.0.0.0/8",
+			ctx:       peerContext("203.0.113.7"),
+			denied:    "Requests from 203.0.113.7 are not allowed by the IP allowlist of namespace test-namespace.",
+		},
+		{
+			name:      "no peer",
+			allowlist: "10.0.0.0/8",
+			ctx:       context.Background(),
+			denied:    "Unable to determine the client address",
+		},
+		{
+			name:      "invalid allowlist",
+			allowlist: "10.0.0.0/33",
+			ctx:       peerContext("10.0.0.1"),
+			denied:    "Namespace test-namespace has an invalid IP allowlist.",
+		},
+		{
+			name:      "disabled",
+			allowlist: "10.0.0.0/8",
+			dc:        dynamicconfig.StaticClient{dynamicconfig.FrontendEnableNamespaceIPAllowlist.Key(): false},
+			ctx:       peerContext("203.0.113.7"),
+		},
+		{
+			name:      "system caller",
+			allowlist: "10.0.0.0/8",
+			ctx:       claimsContext(peerContext("203.0.113.7"), authorization.RoleAdmin),
+		},
+		{
+			name:      "forwarded by remote cluster",
+			allowlist: "10.0.0.0/8",
+			ctx:       redirectedContext(claimsContext(peerContext("203.0.113.7"), authorization.RoleWriter)),
+		},
+		{
+			// The no-op claim mapper makes every caller a system admin.
+			name:        "system caller with no-op claim mapper",
+			allowlist:   "10.0.0.0/8",
+			claimMapper: authorization.NewNoopClaimMapper(),
+			ctx:         claimsContext(peerContext("203.0.113.7"), authorization.RoleAdmin),
+			denied:      "Requests from 203.0.113.7 are not allowed",
+		},
+		{
+			name:        "forwarded with no-op claim mapper",
+			allowlist:   "10.0.0.0/8",
+			claimMapper: authorization.NewNoopClaimMapper(),
+			ctx:         redirectedContext(claimsContext(peerContext("203.0.113.7"), authorization.RoleAdmin)),
+			denied:      "Requests from 203.0.113.7 are not allowed",
+		},
+		{
+			name:      "redirection header from client",
+			allowlist: "10.0.0.0/8",
+			ctx:       redirectedContext(claimsContext(peerContext("203.0.113.7"), authorization.RoleUndefined)),
+			denied:    "Requests from 203.0.113.7 are not allowed",
+		},
+		{
+			name:      "non-system caller",
+			allowlist: "10.0.0.0/8",
+			ctx:       claimsContext(peerContext("203.0.113.7"), authorization.RoleWriter),
+			denied:    "Requests from 203.0.113.7 are not allowed",
+		},
+		{
+			name:      "forwarded header ignored without trusted proxies",
+			allowlist: "203.0.113.0/24",
+			ctx:       peerContext("10.0.0.1", "203.0.113.7"),
+			denied:    "Requests from 10.0.0.1 are not allowed",
+		},
+		{
+			name:      "forwarded through trusted proxy",
+			allowlist: "203.0.113.0/24",
+			dc:        dynamicconfig.StaticClient{dynamicconfig.FrontendIPAllowlistTrustedProxies.Key(): []string{"10.0.0.0/8"}},
+			ctx:       peerContext("10.0.0.1", "198.51.100.1, 203.0.113.7, 10.0.0.2"),
+		},
+		{
+			name:      "forwarded spoofed hop",
+			allowlist: "203.0.113.0/24",
+			dc:        dynamicconfig.StaticClient{dynamicconfig.FrontendIPAllowlistTrustedProxies.Key(): []string{"10.0.0.0/8"}},
+			ctx:       peerContext("10.0.0.1", "203.0.113.7, 198.51.100.1"),
+			denied:    "Requests from 198.51.100.1 are not allowed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			i := newIPAllowlistTestInterceptor(t, tc.allowlist, tc.dc, tc.claimMapper)
+			err := interceptIPAllowlist(i, tc.ctx)
+			if tc.denied == "" {
+				require.NoError(t, err)
+				return
+			}
+			var permissionDenied *serviceerror.PermissionDenied
+			require.ErrorAs(t, err, &permissionDenied)
+			require.Equal(t, ipAllowlistDeniedReason, permissionDenied.Reason)
+			require.Contains(t, err.Error(), tc.denied)
+		})
+	}
+}
+
+func TestIPAllowlistInterceptor_RegisterNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registry := namespace.NewMockRegistry(ctrl)
+	i := NewIPAllowlistInterceptor(dynamicconfig.NewNoopCollection(), registry, authorization.NewMockClaimMapper(ctrl), log.NewNoopLogger())
+
+	// The registry is not consulted for a namespace that does not exist yet.
+	req := &workflowservice.RegisterNamespaceRequest{Namespace: "new-namespace"}
+	_, err := i.Intercept(peerContext("203.0.113.7"), req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		return &workflowservice.RegisterNamespaceResponse{}, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestParseIPAllowlist(t *testing.T) {
+	prefixes, err := ParseIPAllowlist([]string{" 10.1.2.3/8 ", "", "203.0.113.7", "2001:db8::/32", "::ffff:192.0.2.1"})
+	require.NoError(t, err)
+	require.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("203.0.113.7/32"),
+		netip.MustParsePrefix("2001:db8::/32"),
+		netip.MustParsePrefix("192.0.2.1/32"),
+	}, prefixes)
+
+	_, err = ParseIPAllowlist([]string{"10.0.0.0/8", "example.com"})
+	require.ErrorContains(t, err, `invalid address "example.com"`)
+	_, err = ParseIPAllowlist([]string{"10.0.0.0/40"})
+	require.ErrorContains(t, err, `invalid CIDR "10.0.0.0/40"`)
+}
@@ -33,6 +33,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteDynamicConfigRequest:
+		return nil
+	case *adminservice.DeleteDynamicConfigResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -89,6 +93,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetDLQTasksResponse:
 		return nil
+	case *adminservice.GetDynamicConfigRequest:
+		return nil
+	case *adminservice.GetDynamicConfigResponse:
+		return nil
 	case *adminservice.GetNamespaceRequest:
 		return nil
 	case *adminservice.GetNamespaceResponse:
@@ -101,6 +109,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetReplicationMessagesResponse:
 		return nil
+	case *adminservice.GetReplicationStatusRequest:
+		return nil
+	case *adminservice.GetReplicationStatusResponse:
+		return nil
 	case *adminservice.GetSearchAttributesRequest:
 		return nil
 	case *adminservice.GetSearchAttributesResponse:
@@ -142,10 +154,26 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListClustersResponse:
 		return nil
+	case *adminservice.ListConcreteExecutionsRequest:
+		return nil
+	case *adminservice.ListConcreteExecutionsResponse:
+		return nil
+	case *adminservice.ListDynamicConfigRequest:
+		return nil
+	case *adminservice.ListDynamicConfigResponse:
+		return nil
+	case *adminservice.ListDynamicConfigHistoryRequest:
+		return nil
+	case *adminservice.ListDynamicConfigHistoryResponse:
+		return nil
 	case *adminservice.ListHistoryTasksRequest:
 		return nil
 	case *adminservice.ListHistoryTasksResponse:
 		return nil
+	case *adminservice.ListHistoryTreeBranchesRequest:
+		return nil
+	case *adminservice.ListHistoryTreeBranchesResponse:
+		return nil
 	case *adminservice.ListQueuesRequest:
 		return nil
 	case *adminservice.ListQueuesResponse:
@@ -206,6 +234,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.SetDynamicConfigRequest:
+		return nil
+	case *adminservice.SetDynamicConfigResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ReservedNamespaceDataPrefix prefixes the namespace data keys the server
+	// enforces, such as IPAllowlistNamespaceDataKey. Only system principals
+	// may set them.
+	ReservedNamespaceDataPrefix = "temporal.io/"
+
+	reservedNamespaceDataDeniedReason = "ReservedNamespaceData"
+)
+
+// NewReservedNamespaceDataInterceptor returns an interceptor denying
+// RegisterNamespace and UpdateNamespace requests that set namespace data under
+// ReservedNamespaceDataPrefix, unless they are made by a system principal
+// authenticated by claimMapper. Otherwise a namespace admin could lift the
+// restrictions the data carries. With the no-op claim mapper, reserved data
+// can only be set through the internal frontend. The interceptor must run
+// after the authorization interceptor, which maps the caller's claims.
+func NewReservedNamespaceDataInterceptor(claimMapper authorization.ClaimMapper) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		var data map[string]string
+		switch request := req.(type) {
+		case *workflowservice.RegisterNamespaceRequest:
+			data = request.GetData()
+		case *workflowservice.UpdateNamespaceRequest:
+			data = request.GetUpdateInfo().GetData()
+		}
+		for key := range data {
+			if strings.HasPrefix(key, ReservedNamespaceDataPrefix) && !isSystemCaller(ctx, claimMapper) {
+				return nil, serviceerror.NewPermissionDeniedf(reservedNamespaceDataDeniedReason, "Namespace data key %s is reserved.", key)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
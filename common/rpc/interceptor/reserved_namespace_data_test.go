@@ -0,0 +1,83 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+func TestReservedNamespaceDataInterceptor(t *testing.T) {
+	systemCtx := claimsContext(context.Background(), authorization.RoleAdmin)
+	userCtx := claimsContext(context.Background(), authorization.RoleUndefined)
+	reserved := map[string]string{IPAllowlistNamespaceDataKey: "0.0.0.0/0"}
+
+	testCases := []struct {
+		name        string
+		claimMapper authorization.ClaimMapper
+		ctx         context.Context
+		req         any
+		denied      bool
+	}{
+		{
+			name: "register with custom data",
+			ctx:  userCtx,
+			req:  &workflowservice.RegisterNamespaceRequest{Namespace: "ns", Data: map[string]string{"team": "payments"}},
+		},
+		{
+			name:   "register with reserved data",
+			ctx:    userCtx,
+			req:    &workflowservice.RegisterNamespaceRequest{Namespace: "ns", Data: reserved},
+			denied: true,
+		},
+		{
+			name:   "update with reserved data",
+			ctx:    context.Background(),
+			req:    &workflowservice.UpdateNamespaceRequest{Namespace: "ns", UpdateInfo: &namespacepb.UpdateNamespaceInfo{Data: reserved}},
+			denied: true,
+		},
+		{
+			name: "update with reserved data by system caller",
+			ctx:  systemCtx,
+			req:  &workflowservice.UpdateNamespaceRequest{Namespace: "ns", UpdateInfo: &namespacepb.UpdateNamespaceInfo{Data: reserved}},
+		},
+		{
+			// The no-op claim mapper makes every caller a system admin.
+			name:        "update with reserved data with no-op claim mapper",
+			claimMapper: authorization.NewNoopClaimMapper(),
+			ctx:         systemCtx,
+			req:         &workflowservice.UpdateNamespaceRequest{Namespace: "ns", UpdateInfo: &namespacepb.UpdateNamespaceInfo{Data: reserved}},
+			denied:      true,
+		},
+		{
+			name: "update without data",
+			ctx:  userCtx,
+			req:  &workflowservice.UpdateNamespaceRequest{Namespace: "ns"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claimMapper := tc.claimMapper
+			if claimMapper == nil {
+				claimMapper = authorization.NewMockClaimMapper(gomock.NewController(t))
+			}
+			intercept := NewReservedNamespaceDataInterceptor(claimMapper)
+			_, err := intercept(tc.ctx, tc.req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+				return nil, nil
+			})
+			if !tc.denied {
+				require.NoError(t, err)
+				return
+			}
+			var permissionDenied *serviceerror.PermissionDenied
+			require.ErrorAs(t, err, &permissionDenied)
+			require.Equal(t, reservedNamespaceDataDeniedReason, permissionDenied.Reason)
+		})
+	}
+}
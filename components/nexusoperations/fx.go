@@ -122,13 +122,20 @@ func ClientProviderFactory(
 		case *persistencespb.NexusEndpointTarget_Worker_:
 			url = cl.BaseURL() + "/" + commonnexus.RouteDispatchNexusTaskByEndpoint.Path(entry.Id)
 			httpClient = &cl.Client
-			if clusterID != "" {
-				httpCaller = func(r *http.Request) (*http.Response, error) {
+			callerNamespace, err := namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID))
+			if err != nil {
+				return nil, err
+			}
+			httpCaller = func(r *http.Request) (*http.Response, error) {
+				// Set, rather than add, so that a header of the same name in the operation's request cannot
+				// impersonate another caller.
+				r.Header.Set(commonnexus.CallerNamespaceHeader, callerNamespace.String())
+				if clusterID != "" {
 					r.Header.Set(NexusCallbackSourceHeader, clusterID)
-					resp, callErr := httpClient.Do(r)
-					commonnexus.SetFailureSourceOnContext(ctx, resp)
-					return resp, callErr
 				}
+				resp, callErr := httpClient.Do(r)
+				commonnexus.SetFailureSourceOnContext(ctx, resp)
+				return resp, callErr
 			}
 		default:
 			return nil, serviceerror.NewInternal("got unexpected endpoint target")
@@ -3,4 +3,4 @@ package cassandra
 // NOTE: whenever there is a new database schema update, plz update the following versions
 
 // Version is the Cassandra database release version
-const Version = "1.13"
+const Version = "1.14"
@@ -396,3 +396,23 @@ CREATE INDEX cm_idx_rolelasthb ON cluster_membership (role, last_heartbeat);
 CREATE INDEX cm_idx_rpchost ON cluster_membership (rpc_address, role);
 CREATE INDEX cm_idx_lasthb ON cluster_membership (last_heartbeat);
 CREATE INDEX cm_idx_recordexpiry ON cluster_membership (record_expiry);
+
+-- Stores the dynamic config overrides managed through the admin API
+CREATE TABLE dynamic_config (
+    id            INT NOT NULL PRIMARY KEY DEFAULT 0,
+    data          BYTEA NOT NULL,         -- temporal.server.api.persistence.v1.DynamicConfig
+    data_encoding VARCHAR(16) NOT NULL,
+    version       BIGINT NOT NULL,        -- Version of the overrides, used for optimistic concurrency
+    CONSTRAINT only_one_row CHECK (id = 0)  -- Restrict the table to a single row holding all overrides
+);
+
+-- Stores the changes made to the dynamic config overrides
+CREATE TABLE dynamic_config_history (
+    version       BIGINT NOT NULL,        -- Version of the overrides produced by the change
+    config_key    VARCHAR(255) NOT NULL,  -- Lowercased key of the changed setting
+    data          BYTEA NOT NULL,         -- temporal.server.api.persistence.v1.DynamicConfigChange
+    data_encoding VARCHAR(16) NOT NULL,
+    PRIMARY KEY (version)
+);
+
+CREATE INDEX dch_idx_key ON dynamic_config_history (config_key, version);
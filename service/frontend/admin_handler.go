@@ -8,6 +8,7 @@ import (
 	"maps"
 	"math"
 	"net"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -40,6 +41,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -70,6 +72,10 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+
+	listDynamicConfigHistoryPageSize = 100
+	// Attempts to apply a dynamic config change on top of concurrent changes by other callers.
+	updateDynamicConfigAttempts = 5
 )
 
 type (
@@ -86,11 +92,14 @@ type (
 		eventSerializer            serialization.Serializer
 		visibilityMgr              manager.VisibilityManager
 		persistenceExecutionName   string
+		persistenceExecutionMgr    persistence.ExecutionManager
 		namespaceReplicationQueue  persistence.NamespaceReplicationQueue
 		taskManager                persistence.TaskManager
 		fairTaskManager            persistence.FairTaskManager
 		clusterMetadataManager     persistence.ClusterMetadataManager
 		persistenceMetadataManager persistence.MetadataManager
+		dynamicConfigManager       persistence.DynamicConfigManager
+		persistedDynamicConfig     *dynamicconfig.PersistedClientConfig
 		clientFactory              serverClient.Factory
 		clientBean                 serverClient.Bean
 		historyClient              historyservice.HistoryServiceClient
@@ -126,6 +135,8 @@ type (
 		PersistenceExecutionManager         persistence.ExecutionManager
 		ClusterMetadataManager              persistence.ClusterMetadataManager
 		PersistenceMetadataManager          persistence.MetadataManager
+		DynamicConfigManager                persistence.DynamicConfigManager
+		PersistedDynamicConfig              *dynamicconfig.PersistedClientConfig
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
@@ -192,11 +203,14 @@ func NewAdminHandler(
 		eventSerializer:            args.EventSerializer,
 		visibilityMgr:              args.visibilityMgr,
 		persistenceExecutionName:   args.PersistenceExecutionManager.GetName(),
+		persistenceExecutionMgr:    args.PersistenceExecutionManager,
 		namespaceReplicationQueue:  args.NamespaceReplicationQueue,
 		taskManager:                args.TaskManager,
 		fairTaskManager:            args.FairTaskManager,
 		clusterMetadataManager:     args.ClusterMetadataManager,
 		persistenceMetadataManager: args.PersistenceMetadataManager,
+		dynamicConfigManager:       args.DynamicConfigManager,
+		persistedDynamicConfig:     args.PersistedDynamicConfig,
 		clientFactory:              args.ClientFactory,
 		clientBean:                 args.ClientBean,
 		historyClient:              args.HistoryClient,
@@ -881,6 +895,115 @@ func (adh *AdminHandler) ListHistoryTasks(
 	return resp.Response, nil
 }
 
+// ListConcreteExecutions returns the mutable states of the executions in a shard, read directly from persistence.
+func (adh *AdminHandler) ListConcreteExecutions(
+	ctx context.Context,
+	request *adminservice.ListConcreteExecutionsRequest,
+) (_ *adminservice.ListConcreteExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetShardId() <= 0 || request.GetShardId() > adh.numberOfHistoryShards {
+		return nil, errInvalidShardID
+	}
+	if request.GetPageSize() <= 0 {
+		return nil, errInvalidPageSize
+	}
+
+	resp, err := adh.persistenceExecutionMgr.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   request.GetShardId(),
+		PageSize:  int(request.GetPageSize()),
+		PageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListConcreteExecutionsResponse{
+		MutableStates: resp.States,
+		NextPageToken: resp.PageToken,
+	}, nil
+}
+
+// ListHistoryTreeBranches returns the history branches of all shards, read directly from persistence.
+func (adh *AdminHandler) ListHistoryTreeBranches(
+	ctx context.Context,
+	request *adminservice.ListHistoryTreeBranchesRequest,
+) (_ *adminservice.ListHistoryTreeBranchesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetPageSize() <= 0 {
+		return nil, errInvalidPageSize
+	}
+
+	resp, err := adh.persistenceExecutionMgr.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	branches := make([]*adminservice.ListHistoryTreeBranchesResponse_Branch, 0, len(resp.Branches))
+	for _, branch := range resp.Branches {
+		branches = append(branches, &adminservice.ListHistoryTreeBranchesResponse_Branch{
+			BranchInfo: branch.BranchInfo,
+			ForkTime:   branch.ForkTime,
+			Info:       branch.Info,
+		})
+	}
+	return &adminservice.ListHistoryTreeBranchesResponse{
+		Branches:      branches,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// GetReplicationStatus returns the replication status of every history shard, gathered from all history hosts.
+func (adh *AdminHandler) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+) (_ *adminservice.GetReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: request.GetRemoteClusters(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	shards := make([]*adminservice.GetReplicationStatusResponse_ShardReplicationStatus, 0, len(resp.GetShards()))
+	for _, shard := range resp.GetShards() {
+		status := &adminservice.GetReplicationStatusResponse_ShardReplicationStatus{
+			ShardId:                          shard.GetShardId(),
+			MaxReplicationTaskId:             shard.GetMaxReplicationTaskId(),
+			ShardLocalTime:                   shard.GetShardLocalTime(),
+			MaxReplicationTaskVisibilityTime: shard.GetMaxReplicationTaskVisibilityTime(),
+			RemoteClusters:                   make(map[string]*adminservice.GetReplicationStatusResponse_ShardReplicationStatusPerCluster, len(shard.GetRemoteClusters())),
+			HandoverNamespaces:               make(map[string]*adminservice.GetReplicationStatusResponse_HandoverNamespaceInfo, len(shard.GetHandoverNamespaces())),
+		}
+		for cluster, remote := range shard.GetRemoteClusters() {
+			status.RemoteClusters[cluster] = &adminservice.GetReplicationStatusResponse_ShardReplicationStatusPerCluster{
+				AckedTaskId:             remote.GetAckedTaskId(),
+				AckedTaskVisibilityTime: remote.GetAckedTaskVisibilityTime(),
+			}
+		}
+		for namespaceID, handover := range shard.GetHandoverNamespaces() {
+			status.HandoverNamespaces[namespaceID] = &adminservice.GetReplicationStatusResponse_HandoverNamespaceInfo{
+				HandoverReplicationTaskId: handover.GetHandoverReplicationTaskId(),
+			}
+		}
+		shards = append(shards, status)
+	}
+	return &adminservice.GetReplicationStatusResponse{Shards: shards}, nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *adminservice.DescribeHistoryHostRequest) (_ *adminservice.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -2204,6 +2327,243 @@ func (adh *AdminHandler) GenerateLastHistoryReplicationTasks(
 	}, nil
 }
 
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetKey()) == 0 {
+		return nil, errDynamicConfigKeyNotSet
+	}
+
+	resp, err := adh.dynamicConfigManager.GetDynamicConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var entry *persistencespb.DynamicConfigEntry
+	if idx := findDynamicConfigEntry(resp.Config, request.GetKey()); idx >= 0 {
+		entry = resp.Config.Entries[idx]
+	}
+	return &adminservice.GetDynamicConfigResponse{
+		Entry:   entry,
+		Version: resp.Version,
+	}, nil
+}
+
+func (adh *AdminHandler) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+) (_ *adminservice.SetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetKey()) == 0 {
+		return nil, errDynamicConfigKeyNotSet
+	}
+	if request.GetValue() == nil {
+		return nil, errDynamicConfigValueNotSet
+	}
+	if !adh.persistedDynamicConfig.Enabled() {
+		return nil, errDynamicConfigDisabled
+	}
+	if err := dynamicconfig.ValidatePersistedValue(request.GetKey(), request.GetValue()); err != nil {
+		return nil, serviceerror.NewInvalidArgumentf("invalid dynamic config value: %v", err)
+	}
+
+	value := common.CloneProto(request.GetValue())
+	constraints := dynamicconfig.ConstraintsFromProto(value.GetConstraints())
+	value.Constraints = dynamicconfig.ConstraintsToProto(constraints)
+
+	version, err := adh.updateDynamicConfig(
+		ctx,
+		request.GetKey(),
+		request.GetIdentity(),
+		request.GetReason(),
+		func(values []*persistencespb.DynamicConfigValue) ([]*persistencespb.DynamicConfigValue, error) {
+			if idx := findDynamicConfigValue(values, constraints); idx >= 0 {
+				values[idx] = value
+				return values, nil
+			}
+			return append(values, value), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SetDynamicConfigResponse{Version: version}, nil
+}
+
+func (adh *AdminHandler) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+) (_ *adminservice.DeleteDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetKey()) == 0 {
+		return nil, errDynamicConfigKeyNotSet
+	}
+	if !adh.persistedDynamicConfig.Enabled() {
+		return nil, errDynamicConfigDisabled
+	}
+
+	constraints := dynamicconfig.ConstraintsFromProto(request.GetConstraints())
+	version, err := adh.updateDynamicConfig(
+		ctx,
+		request.GetKey(),
+		request.GetIdentity(),
+		request.GetReason(),
+		func(values []*persistencespb.DynamicConfigValue) ([]*persistencespb.DynamicConfigValue, error) {
+			idx := findDynamicConfigValue(values, constraints)
+			if idx < 0 {
+				return nil, serviceerror.NewNotFoundf(
+					"no dynamic config value stored for key %q with the given constraints", request.GetKey())
+			}
+			return append(values[:idx], values[idx+1:]...), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteDynamicConfigResponse{Version: version}, nil
+}
+
+func (adh *AdminHandler) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.dynamicConfigManager.GetDynamicConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListDynamicConfigResponse{
+		Entries: resp.Config.GetEntries(),
+		Version: resp.Version,
+	}, nil
+}
+
+func (adh *AdminHandler) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+) (_ *adminservice.ListDynamicConfigHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = listDynamicConfigHistoryPageSize
+	}
+
+	resp, err := adh.dynamicConfigManager.ListDynamicConfigHistory(ctx, &persistence.ListDynamicConfigHistoryRequest{
+		Key:           request.GetKey(),
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListDynamicConfigHistoryResponse{
+		Changes:       resp.Changes,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// updateDynamicConfig applies update to the values stored for key and records the change. The update is retried on
+// top of the latest stored values if another change was made concurrently.
+func (adh *AdminHandler) updateDynamicConfig(
+	ctx context.Context,
+	key string,
+	identity string,
+	reason string,
+	update func([]*persistencespb.DynamicConfigValue) ([]*persistencespb.DynamicConfigValue, error),
+) (int64, error) {
+	for attempt := 1; attempt <= updateDynamicConfigAttempts; attempt++ {
+		resp, err := adh.dynamicConfigManager.GetDynamicConfig(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		config := resp.Config
+		idx := findDynamicConfigEntry(config, key)
+		var entry *persistencespb.DynamicConfigEntry
+		if idx >= 0 {
+			entry = config.Entries[idx]
+		} else {
+			entry = &persistencespb.DynamicConfigEntry{Key: key}
+		}
+		previousValues := entry.GetValues()
+
+		values, err := update(slices.Clone(previousValues))
+		if err != nil {
+			return 0, err
+		}
+		entry.Values = values
+
+		switch {
+		case idx >= 0 && len(values) == 0:
+			config.Entries = slices.Delete(config.Entries, idx, idx+1)
+		case idx < 0:
+			config.Entries = append(config.Entries, entry)
+			slices.SortFunc(config.Entries, func(a, b *persistencespb.DynamicConfigEntry) int {
+				return strings.Compare(strings.ToLower(a.GetKey()), strings.ToLower(b.GetKey()))
+			})
+		}
+
+		updateResp, err := adh.dynamicConfigManager.UpdateDynamicConfig(ctx, &persistence.UpdateDynamicConfigRequest{
+			PreviousVersion: resp.Version,
+			Config:          config,
+			Change: &persistencespb.DynamicConfigChange{
+				Key:            entry.GetKey(),
+				PreviousValues: previousValues,
+				Values:         values,
+				ChangeTime:     timestamppb.New(time.Now().UTC()),
+				Identity:       identity,
+				Reason:         reason,
+			},
+		})
+		if errors.Is(err, persistence.ErrDynamicConfigVersionConflict) {
+			adh.logger.Info("Dynamic config was changed concurrently, retrying.",
+				tag.Key(key), tag.Attempt(int32(attempt)))
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		adh.logger.Info("Dynamic config changed.",
+			tag.Key(key), tag.NewInt64("version", updateResp.Version), tag.NewStringTag("identity", identity))
+		return updateResp.Version, nil
+	}
+	return 0, serviceerror.NewUnavailable("dynamic config is being changed concurrently, please retry")
+}
+
+func findDynamicConfigEntry(config *persistencespb.DynamicConfig, key string) int {
+	return slices.IndexFunc(config.GetEntries(), func(entry *persistencespb.DynamicConfigEntry) bool {
+		return strings.EqualFold(entry.GetKey(), key)
+	})
+}
+
+func findDynamicConfigValue(values []*persistencespb.DynamicConfigValue, constraints dynamicconfig.Constraints) int {
+	return slices.IndexFunc(values, func(value *persistencespb.DynamicConfigValue) bool {
+		return dynamicconfig.ConstraintsFromProto(value.GetConstraints()) == constraints
+	})
+}
+
 func (adh *AdminHandler) getDLQWorkflowID(
 	key *commonspb.HistoryDLQKey,
 ) string {
@@ -62,6 +62,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -85,6 +86,7 @@ type (
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
 		mockSaMapper               *searchattribute.MockMapper
+		mockDynamicConfigMgr       *persistence.MockDynamicConfigManager
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -129,6 +131,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
+	s.mockDynamicConfigMgr = persistence.NewMockDynamicConfigManager(s.controller)
 
 	mockSaMapperProvider := searchattribute.NewMockMapperProvider(s.controller)
 	s.mockSaMapper = searchattribute.NewMockMapper(s.controller)
@@ -164,6 +167,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetExecutionManager(),
 		s.mockResource.GetClusterMetadataManager(),
 		s.mockResource.GetMetadataManager(),
+		s.mockDynamicConfigMgr,
+		&dynamicconfig.PersistedClientConfig{},
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
@@ -2094,3 +2099,286 @@ func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQu
 	s.Equal(expectedPhysicalTaskQueueInfo.GetTaskQueueStats(), responsePhysicalTaskQueueInfo.GetTaskQueueStats())
 	s.Equal(expectedPhysicalTaskQueueInfo.GetInternalTaskQueueStatus(), responsePhysicalTaskQueueInfo.GetInternalTaskQueueStatus())
 }
+
+func (s *adminHandlerSuite) TestSetDynamicConfig() {
+	existing := &persistencespb.DynamicConfig{
+		Entries: []*persistencespb.DynamicConfigEntry{
+			{
+				Key: "history.rps",
+				Values: []*persistencespb.DynamicConfigValue{
+					{Constraints: &persistencespb.DynamicConfigConstraints{}, Value: "100"},
+				},
+			},
+		},
+	}
+	s.mockDynamicConfigMgr.EXPECT().GetDynamicConfig(gomock.Any()).Return(&persistence.GetDynamicConfigResponse{
+		Version: 3,
+		Config:  existing,
+	}, nil)
+	s.mockDynamicConfigMgr.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateDynamicConfigRequest) (*persistence.UpdateDynamicConfigResponse, error) {
+			s.Equal(int64(3), request.PreviousVersion)
+			s.Len(request.Config.Entries, 2)
+			s.Equal("frontend.namespaceRPS", request.Config.Entries[0].Key)
+			s.Equal("history.rps", request.Config.Entries[1].Key)
+			s.Equal("frontend.namespaceRPS", request.Change.Key)
+			s.Empty(request.Change.PreviousValues)
+			s.Len(request.Change.Values, 1)
+			s.Equal("ns", request.Change.Values[0].Constraints.Namespace)
+			s.Equal("me", request.Change.Identity)
+			s.Equal("testing", request.Change.Reason)
+			s.NotNil(request.Change.ChangeTime)
+			return &persistence.UpdateDynamicConfigResponse{Version: 4}, nil
+		})
+
+	resp, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key: "frontend.namespaceRPS",
+		Value: &persistencespb.DynamicConfigValue{
+			Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"},
+			Value:       "200",
+		},
+		Identity: "me",
+		Reason:   "testing",
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.Version)
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfig_RetryOnConflict() {
+	s.mockDynamicConfigMgr.EXPECT().GetDynamicConfig(gomock.Any()).DoAndReturn(
+		func(context.Context) (*persistence.GetDynamicConfigResponse, error) {
+			return &persistence.GetDynamicConfigResponse{
+				Version: 1,
+				Config: &persistencespb.DynamicConfig{
+					Entries: []*persistencespb.DynamicConfigEntry{
+						{
+							Key:    "Frontend.RPS",
+							Values: []*persistencespb.DynamicConfigValue{{Value: "100"}},
+						},
+					},
+				},
+			}, nil
+		}).Times(2)
+	gomock.InOrder(
+		s.mockDynamicConfigMgr.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).
+			Return(nil, persistence.ErrDynamicConfigVersionConflict),
+		s.mockDynamicConfigMgr.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.UpdateDynamicConfigRequest) (*persistence.UpdateDynamicConfigResponse, error) {
+				// keys are case-insensitive, the value with the same constraints is replaced
+				s.Len(request.Config.Entries, 1)
+				s.Equal("Frontend.RPS", request.Change.Key)
+				s.Len(request.Change.PreviousValues, 1)
+				s.Len(request.Change.Values, 1)
+				s.Equal("200", request.Change.Values[0].Value)
+				return &persistence.UpdateDynamicConfigResponse{Version: 2}, nil
+			}),
+	)
+
+	resp, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key:   "frontend.rps",
+		Value: &persistencespb.DynamicConfigValue{Value: "200"},
+	})
+	s.NoError(err)
+	s.Equal(int64(2), resp.Version)
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfig_InvalidRequest() {
+	_, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Value: &persistencespb.DynamicConfigValue{Value: "200"},
+	})
+	s.ErrorIs(err, errDynamicConfigKeyNotSet)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	for _, request := range []*adminservice.SetDynamicConfigRequest{
+		{Key: "frontend.rps", Value: &persistencespb.DynamicConfigValue{Value: "{"}},
+		{Key: "frontend.unknownSetting", Value: &persistencespb.DynamicConfigValue{Value: "200"}},
+		{Key: "frontend.rps", Value: &persistencespb.DynamicConfigValue{Value: "fast"}},
+		{Key: "frontend.rps", Value: &persistencespb.DynamicConfigValue{
+			Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"},
+			Value:       "200",
+		}},
+	} {
+		_, err = s.handler.SetDynamicConfig(context.Background(), request)
+		s.ErrorAs(err, &invalidArgument)
+	}
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfig_Disabled() {
+	for _, config := range []*dynamicconfig.PersistedClientConfig{nil, {Disabled: true}} {
+		s.handler.persistedDynamicConfig = config
+
+		_, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+			Key:   "frontend.rps",
+			Value: &persistencespb.DynamicConfigValue{Value: "200"},
+		})
+		var failedPrecondition *serviceerror.FailedPrecondition
+		s.ErrorAs(err, &failedPrecondition)
+
+		_, err = s.handler.DeleteDynamicConfig(context.Background(), &adminservice.DeleteDynamicConfigRequest{
+			Key: "frontend.rps",
+		})
+		s.ErrorAs(err, &failedPrecondition)
+	}
+}
+
+func (s *adminHandlerSuite) TestDeleteDynamicConfig() {
+	s.mockDynamicConfigMgr.EXPECT().GetDynamicConfig(gomock.Any()).DoAndReturn(
+		func(context.Context) (*persistence.GetDynamicConfigResponse, error) {
+			return &persistence.GetDynamicConfigResponse{
+				Version: 5,
+				Config: &persistencespb.DynamicConfig{
+					Entries: []*persistencespb.DynamicConfigEntry{
+						{
+							Key:    "frontend.rps",
+							Values: []*persistencespb.DynamicConfigValue{{Value: "100"}},
+						},
+					},
+				},
+			}, nil
+		}).Times(2)
+
+	// no value with these constraints
+	_, err := s.handler.DeleteDynamicConfig(context.Background(), &adminservice.DeleteDynamicConfigRequest{
+		Key:         "frontend.rps",
+		Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"},
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+
+	s.mockDynamicConfigMgr.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateDynamicConfigRequest) (*persistence.UpdateDynamicConfigResponse, error) {
+			s.Empty(request.Config.Entries)
+			s.Len(request.Change.PreviousValues, 1)
+			s.Empty(request.Change.Values)
+			return &persistence.UpdateDynamicConfigResponse{Version: 6}, nil
+		})
+	resp, err := s.handler.DeleteDynamicConfig(context.Background(), &adminservice.DeleteDynamicConfigRequest{
+		Key: "frontend.rps",
+	})
+	s.NoError(err)
+	s.Equal(int64(6), resp.Version)
+}
+
+func (s *adminHandlerSuite) TestListDynamicConfigHistory_FilterByKey() {
+	s.mockDynamicConfigMgr.EXPECT().ListDynamicConfigHistory(gomock.Any(), &persistence.ListDynamicConfigHistoryRequest{
+		Key:           "frontend.rps",
+		PageSize:      listDynamicConfigHistoryPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.ListDynamicConfigHistoryResponse{
+		Changes: []*persistencespb.DynamicConfigChange{
+			{Version: 3, Key: "frontend.rps"},
+			{Version: 1, Key: "Frontend.RPS"},
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+
+	resp, err := s.handler.ListDynamicConfigHistory(context.Background(), &adminservice.ListDynamicConfigHistoryRequest{
+		Key:           "frontend.rps",
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Len(resp.Changes, 2)
+	s.Equal(int64(3), resp.Changes[0].Version)
+	s.Equal(int64(1), resp.Changes[1].Version)
+	s.Equal([]byte("next"), resp.NextPageToken)
+}
+
+func (s *adminHandlerSuite) TestListConcreteExecutions() {
+	_, err := s.handler.ListConcreteExecutions(context.Background(), &adminservice.ListConcreteExecutionsRequest{
+		ShardId:  2,
+		PageSize: 10,
+	})
+	s.ErrorIs(err, errInvalidShardID)
+
+	_, err = s.handler.ListConcreteExecutions(context.Background(), &adminservice.ListConcreteExecutionsRequest{
+		ShardId: 1,
+	})
+	s.ErrorIs(err, errInvalidPageSize)
+
+	states := []*persistencespb.WorkflowMutableState{
+		{ExecutionState: &persistencespb.WorkflowExecutionState{RunId: uuid.NewString()}},
+	}
+	s.mockExecutionMgr.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   1,
+		PageSize:  10,
+		PageToken: []byte("token"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States:    states,
+		PageToken: []byte("next"),
+	}, nil)
+	resp, err := s.handler.ListConcreteExecutions(context.Background(), &adminservice.ListConcreteExecutionsRequest{
+		ShardId:       1,
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(states, resp.MutableStates)
+	s.Equal([]byte("next"), resp.NextPageToken)
+}
+
+func (s *adminHandlerSuite) TestListHistoryTreeBranches() {
+	_, err := s.handler.ListHistoryTreeBranches(context.Background(), &adminservice.ListHistoryTreeBranchesRequest{})
+	s.ErrorIs(err, errInvalidPageSize)
+
+	branch := persistence.HistoryBranchDetail{
+		BranchInfo: &persistencespb.HistoryBranch{TreeId: uuid.NewString(), BranchId: uuid.NewString()},
+		ForkTime:   timestamppb.Now(),
+		Info:       persistence.BuildHistoryGarbageCleanupInfo(s.namespaceID.String(), "workflow-id", "run-id"),
+	}
+	s.mockExecutionMgr.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches:      []persistence.HistoryBranchDetail{branch},
+		NextPageToken: []byte("next"),
+	}, nil)
+	resp, err := s.handler.ListHistoryTreeBranches(context.Background(), &adminservice.ListHistoryTreeBranchesRequest{
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Len(resp.Branches, 1)
+	s.Equal(branch.BranchInfo, resp.Branches[0].BranchInfo)
+	s.Equal(branch.ForkTime, resp.Branches[0].ForkTime)
+	s.Equal(branch.Info, resp.Branches[0].Info)
+	s.Equal([]byte("next"), resp.NextPageToken)
+}
+
+func (s *adminHandlerSuite) TestGetReplicationStatus() {
+	now := timestamppb.Now()
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{"standby"},
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{{
+			ShardId:                          3,
+			MaxReplicationTaskId:             100,
+			ShardLocalTime:                   now,
+			MaxReplicationTaskVisibilityTime: now,
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"standby": {AckedTaskId: 90, AckedTaskVisibilityTime: now},
+			},
+			HandoverNamespaces: map[string]*historyservice.HandoverNamespaceInfo{
+				s.namespaceID.String(): {HandoverReplicationTaskId: 95},
+			},
+		}},
+	}, nil)
+
+	resp, err := s.handler.GetReplicationStatus(context.Background(), &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{"standby"},
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetReplicationStatusResponse{
+		Shards: []*adminservice.GetReplicationStatusResponse_ShardReplicationStatus{{
+			ShardId:                          3,
+			MaxReplicationTaskId:             100,
+			ShardLocalTime:                   now,
+			MaxReplicationTaskVisibilityTime: now,
+			RemoteClusters: map[string]*adminservice.GetReplicationStatusResponse_ShardReplicationStatusPerCluster{
+				"standby": {AckedTaskId: 90, AckedTaskVisibilityTime: now},
+			},
+			HandoverNamespaces: map[string]*adminservice.GetReplicationStatusResponse_HandoverNamespaceInfo{
+				s.namespaceID.String(): {HandoverReplicationTaskId: 95},
+			},
+		}},
+	}, resp)
+}
@@ -28,6 +28,7 @@ var (
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
 	errTaskRangeNotSet                                    = serviceerror.NewInvalidArgument("Task range is not set")
+	errInvalidShardID                                     = serviceerror.NewInvalidArgument("Invalid ShardId.")
 	errHistoryNotFound                                    = serviceerror.NewInvalidArgument("Requested workflow history not found, may have passed retention period.")
 	errNamespaceTooLong                                   = serviceerror.NewInvalidArgument("Namespace length exceeds limit.")
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
@@ -87,6 +88,10 @@ var (
 	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
 
+	errDynamicConfigKeyNotSet   = serviceerror.NewInvalidArgument("Dynamic config key is not set on request.")
+	errDynamicConfigValueNotSet = serviceerror.NewInvalidArgument("Dynamic config value is not set on request.")
+	errDynamicConfigDisabled    = serviceerror.NewFailedPrecondition("Dynamic config overrides stored in persistence are disabled (see persistedDynamicConfig in the server config).")
+
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
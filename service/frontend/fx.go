@@ -1,6 +1,7 @@
 package frontend
 
 import (
+	"context"
 	"fmt"
 	"net"
 
@@ -83,6 +84,8 @@ var Module = fx.Options(
 	fx.Provide(interceptor.NewHealthInterceptor),
 	fx.Provide(NamespaceCountLimitInterceptorProvider),
 	fx.Provide(NamespaceValidatorInterceptorProvider),
+	fx.Provide(IPAllowlistInterceptorProvider),
+	fx.Provide(ClientCertificateInterceptorProvider),
 	fx.Provide(NamespaceRateLimitInterceptorProvider),
 	fx.Provide(SDKVersionInterceptorProvider),
 	fx.Provide(CallerInfoInterceptorProvider),
@@ -199,6 +202,8 @@ func GrpcServerOptionsProvider(
 	namespaceCountLimiterInterceptor *interceptor.ConcurrentRequestLimitInterceptor,
 	namespaceValidatorInterceptor *interceptor.NamespaceValidatorInterceptor,
 	namespaceHandoverInterceptor *interceptor.NamespaceHandoverInterceptor,
+	ipAllowlistInterceptor *interceptor.IPAllowlistInterceptor,
+	clientCertificateInterceptor *interceptor.ClientCertificateInterceptor,
 	redirectionInterceptor *interceptor.Redirection,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
 	retryableInterceptor *interceptor.RetryableInterceptor,
@@ -209,6 +214,7 @@ func GrpcServerOptionsProvider(
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
 	authInterceptor *authorization.Interceptor,
+	claimMapper authorization.ClaimMapper,
 	maskInternalErrorDetailsInterceptor *interceptor.MaskInternalErrorDetailsInterceptor,
 	slowRequestLoggerInterceptor *interceptor.SlowRequestLoggerInterceptor,
 	customInterceptors []grpc.UnaryServerInterceptor,
@@ -239,6 +245,19 @@ func GrpcServerOptionsProvider(
 	if err != nil {
 		logger.Fatal("creating gRPC server options failed", tag.Error(err))
 	}
+	ipAllowlistIntercept := ipAllowlistInterceptor.Intercept
+	clientCertificateIntercept := clientCertificateInterceptor.Intercept
+	reservedNamespaceDataIntercept := interceptor.NewReservedNamespaceDataInterceptor(claimMapper)
+	if serviceName == primitives.InternalFrontendService {
+		// Namespace IP allowlists and client certificates restrict clients,
+		// not other server components.
+		passThrough := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			return handler(ctx, req)
+		}
+		ipAllowlistIntercept = passThrough
+		clientCertificateIntercept = passThrough
+		reservedNamespaceDataIntercept = passThrough
+	}
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		// Order or interceptors is important
 		// Mask error interceptor should be the most outer interceptor since it handle the errors format
@@ -250,6 +269,9 @@ func GrpcServerOptionsProvider(
 		namespaceLogInterceptor.Intercept, // TODO: Deprecate this with a outer custom interceptor
 		metrics.NewServerMetricsContextInjectorInterceptor(),
 		authInterceptor.Intercept,
+		ipAllowlistIntercept,
+		clientCertificateIntercept,
+		reservedNamespaceDataIntercept,
 		// Handover interceptor has to above redirection because the request will route to the correct cluster after handover completed.
 		// And retry cannot be performed before customInterceptors.
 		namespaceHandoverInterceptor.Intercept,
@@ -535,6 +557,50 @@ func NamespaceValidatorInterceptorProvider(
 	)
 }
 
+func IPAllowlistInterceptorProvider(
+	dc *dynamicconfig.Collection,
+	serviceName primitives.ServiceName,
+	namespaceRegistry namespace.Registry,
+	claimMapper authorization.ClaimMapper,
+	logger log.Logger,
+) *interceptor.IPAllowlistInterceptor {
+	if serviceName == primitives.FrontendService &&
+		authorization.IsNoopClaimMapper(claimMapper) &&
+		dynamicconfig.FrontendEnableNamespaceIPAllowlist.Get(dc)("") {
+		logger.Warn("Namespace IP allowlists are enforced with the no-op claim mapper, which cannot authenticate " +
+			"server components and remote clusters, so their requests are checked too. " +
+			"Configure a claim mapper or have them use the internal frontend.")
+	}
+	return interceptor.NewIPAllowlistInterceptor(
+		dc,
+		namespaceRegistry,
+		claimMapper,
+		logger,
+	)
+}
+
+func ClientCertificateInterceptorProvider(
+	dc *dynamicconfig.Collection,
+	serviceName primitives.ServiceName,
+	namespaceRegistry namespace.Registry,
+	claimMapper authorization.ClaimMapper,
+	logger log.Logger,
+) *interceptor.ClientCertificateInterceptor {
+	if serviceName == primitives.FrontendService &&
+		authorization.IsNoopClaimMapper(claimMapper) &&
+		dynamicconfig.FrontendEnableNamespaceClientCertificates.Get(dc)("") {
+		logger.Warn("Namespace client certificates are enforced with the no-op claim mapper, which cannot authenticate " +
+			"server components, so their requests are checked too. " +
+			"Configure a claim mapper or have them use the internal frontend.")
+	}
+	return interceptor.NewClientCertificateInterceptor(
+		dc,
+		namespaceRegistry,
+		claimMapper,
+		logger,
+	)
+}
+
 func SDKVersionInterceptorProvider() *interceptor.SDKVersionInterceptor {
 	return interceptor.NewSDKVersionInterceptor()
 }
@@ -638,6 +704,8 @@ func AdminHandlerProvider(
 	persistenceExecutionManager persistence.ExecutionManager,
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	persistenceMetadataManager persistence.MetadataManager,
+	dynamicConfigManager persistence.DynamicConfigManager,
+	cfg *config.Config,
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient resource.HistoryClient,
@@ -669,6 +737,8 @@ func AdminHandlerProvider(
 		persistenceExecutionManager,
 		clusterMetadataManager,
 		persistenceMetadataManager,
+		dynamicConfigManager,
+		cfg.PersistedDynamicConfig,
 		clientFactory,
 		clientBean,
 		historyClient,
@@ -796,6 +866,7 @@ func RegisterNexusHTTPHandler(
 	namespaceRegistry namespace.Registry,
 	endpointRegistry nexus.EndpointRegistry,
 	authInterceptor *authorization.Interceptor,
+	claimMapper authorization.ClaimMapper,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
 	requestErrorHandler *interceptor.RequestErrorHandler,
 	redirectionInterceptor *interceptor.Redirection,
@@ -807,8 +878,16 @@ func RegisterNexusHTTPHandler(
 	router *mux.Router,
 	httpTraceProvider nexus.HTTPClientTraceProvider,
 ) {
+	if serviceName == primitives.FrontendService &&
+		authorization.IsNoopClaimMapper(claimMapper) &&
+		serviceConfig.EnableNexusEndpointAllowlist("") {
+		logger.Warn("Nexus endpoint allowlists are enforced with the no-op claim mapper, which cannot authenticate " +
+			"the history service, so calls to restricted endpoints are rejected unless they go through the internal frontend. " +
+			"Configure a claim mapper or have the history service use the internal frontend.")
+	}
 	h := NewNexusHTTPHandler(
 		serviceConfig,
+		serviceName,
 		matchingClient,
 		metricsHandler,
 		clusterMetadata,
@@ -816,6 +895,7 @@ func RegisterNexusHTTPHandler(
 		namespaceRegistry,
 		endpointRegistry,
 		authInterceptor,
+		claimMapper,
 		telemetryInterceptor,
 		requestErrorHandler,
 		redirectionInterceptor,
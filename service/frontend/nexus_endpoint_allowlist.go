@@ -0,0 +1,58 @@
+package frontend
+
+import (
+	"strings"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	"go.temporal.io/server/common/authorization"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/primitives"
+)
+
+// callerNamespaceTrusted reports whether the caller namespace header of a request with the given claims can be trusted.
+// Only the history service sets the header. The internal frontend is reserved for server components, so the header is
+// trusted there. On the frontend it is trusted only on requests from system principals authenticated by a claim
+// mapper; the no-op claim mapper grants system claims to every caller.
+func (h *NexusHTTPHandler) callerNamespaceTrusted(claims *authorization.Claims) bool {
+	if h.serviceName == primitives.InternalFrontendService {
+		return true
+	}
+	if authorization.IsNoopClaimMapper(h.claimMapper) {
+		return false
+	}
+	return claims != nil && claims.System&authorization.RoleAdmin != 0
+}
+
+// callerNamespace returns the name of the namespace whose workflow is calling the endpoint, as reported by the history
+// service in the caller namespace header. It returns an empty string if the header is not trusted.
+func (c *operationContext) callerNamespace(header nexus.Header) string {
+	if !c.callerNamespaceTrusted {
+		return ""
+	}
+	return header.Get(commonnexus.CallerNamespaceHeader)
+}
+
+// checkEndpointAllowlist denies requests to an endpoint whose target namespace restricts its callers, unless they come
+// from a workflow in one of the allowed namespaces.
+func (c *operationContext) checkEndpointAllowlist(header nexus.Header) error {
+	// Requests dispatched by namespace and task queue don't go through an endpoint.
+	if c.endpointName == "" || !c.endpointAllowlistEnabled(c.namespaceName) {
+		return nil
+	}
+	allowlist := c.namespace.GetCustomData(commonnexus.EndpointAllowlistNamespaceDataKey(c.endpointName))
+	if allowlist == "" {
+		return nil
+	}
+	caller := c.callerNamespace(header)
+	if caller == "" {
+		return nexus.HandlerErrorf(nexus.HandlerErrorTypeUnauthorized,
+			"endpoint %q may only be called from workflows in allowed namespaces", c.endpointName)
+	}
+	for _, allowed := range strings.Split(allowlist, ",") {
+		if strings.TrimSpace(allowed) == caller {
+			return nil
+		}
+	}
+	return nexus.HandlerErrorf(nexus.HandlerErrorTypeUnauthorized,
+		"namespace %q is not allowed to call endpoint %q", caller, c.endpointName)
+}
@@ -53,6 +53,7 @@ type nexusContext struct {
 	endpointName                         string
 	endpointID                           string
 	claims                               *authorization.Claims
+	callerNamespaceTrusted               bool // Whether the caller namespace header comes from the history service.
 	namespaceValidationInterceptor       *interceptor.NamespaceValidatorInterceptor
 	namespaceRateLimitInterceptor        interceptor.NamespaceRateLimitInterceptor
 	namespaceConcurrencyLimitInterceptor *interceptor.ConcurrentRequestLimitInterceptor
@@ -81,6 +82,7 @@ type operationContext struct {
 	forwardingEnabledForNamespace dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	headersBlacklist              dynamicconfig.TypedPropertyFn[*regexp.Regexp]
 	metricTagConfig               dynamicconfig.TypedPropertyFn[nexusoperations.NexusMetricTagConfig]
+	endpointAllowlistEnabled      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	cleanupFunctions              []func(map[string]string, error)
 }
 
@@ -175,6 +177,11 @@ func (c *operationContext) interceptRequest(
 		return commonnexus.ConvertGRPCError(err, false)
 	}
 
+	if err := c.checkEndpointAllowlist(header); err != nil {
+		c.metricsHandler = c.metricsHandler.WithTags(metrics.OutcomeTag("caller_namespace_not_allowed"))
+		return err
+	}
+
 	if err := c.namespaceValidationInterceptor.ValidateState(c.namespace, c.apiName); err != nil {
 		c.metricsHandler = c.metricsHandler.WithTags(metrics.OutcomeTag("invalid_namespace_state"))
 		return commonnexus.ConvertGRPCError(err, false)
@@ -248,7 +255,12 @@ func (c *operationContext) interceptRequest(
 		// Making a copy to ensure the original map is not modified as it might be used somewhere else.
 		sanitizedHeaders := make(map[string]string, len(request.Request.Header))
 		headersBlacklist := c.headersBlacklist()
+		trustedCaller := c.callerNamespace(header) != ""
 		for name, value := range request.Request.Header {
+			// Handlers may rely on the caller namespace header, so it is only passed on when it is trusted.
+			if !trustedCaller && strings.EqualFold(name, commonnexus.CallerNamespaceHeader) {
+				continue
+			}
 			if !headersBlacklist.MatchString(name) {
 				sanitizedHeaders[name] = value
 			}
@@ -323,6 +335,7 @@ type nexusHandler struct {
 	headersBlacklist              dynamicconfig.TypedPropertyFn[*regexp.Regexp]
 	useForwardByEndpoint          dynamicconfig.BoolPropertyFn
 	metricTagConfig               dynamicconfig.TypedPropertyFn[nexusoperations.NexusMetricTagConfig]
+	endpointAllowlistEnabled      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	httpTraceProvider             commonnexus.HTTPClientTraceProvider
 }
 
@@ -345,6 +358,7 @@ func (h *nexusHandler) getOperationContext(ctx context.Context, method string) (
 		forwardingEnabledForNamespace: h.forwardingEnabledForNamespace,
 		headersBlacklist:              h.headersBlacklist,
 		metricTagConfig:               h.metricTagConfig,
+		endpointAllowlistEnabled:      h.endpointAllowlistEnabled,
 		cleanupFunctions:              make([]func(map[string]string, error), 0),
 	}
 	oc.metricsHandlerForInterceptors = h.metricsHandler.WithTags(
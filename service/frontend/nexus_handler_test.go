@@ -3,6 +3,7 @@ package frontend
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,10 +25,13 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/util"
+	"go.uber.org/mock/gomock"
 )
 
 type mockAuthorizer struct{}
@@ -77,6 +81,9 @@ type contextOptions struct {
 	rateLimitAllow          bool
 	redirectAllow           bool
 	headersBlacklist        []string
+	endpointName            string
+	namespaceData           map[string]string
+	callerNamespaceTrusted  bool
 }
 
 func newOperationContext(options contextOptions) *operationContext {
@@ -92,6 +99,8 @@ func newOperationContext(options contextOptions) *operationContext {
 	oc.responseHeaders = make(map[string]string)
 
 	oc.namespaceName = "test-namespace"
+	oc.endpointName = options.endpointName
+	oc.callerNamespaceTrusted = options.callerNamespaceTrusted
 	activeClusterName := cluster.TestCurrentClusterName
 	if options.namespacePassive {
 		activeClusterName = cluster.TestAlternativeClusterName
@@ -101,6 +110,7 @@ func newOperationContext(options contextOptions) *operationContext {
 			Id:    uuid.NewString(),
 			Name:  oc.namespaceName,
 			State: options.namespaceState,
+			Data:  options.namespaceData,
 		},
 		&persistencespb.NamespaceConfig{
 			Retention:                    timestamp.DurationFromDays(1),
@@ -149,6 +159,7 @@ func newOperationContext(options contextOptions) *operationContext {
 		panic(err) // nolint:forbidigo
 	}
 	oc.headersBlacklist = dynamicconfig.GetTypedPropertyFn(re)
+	oc.endpointAllowlistEnabled = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
 	oc.redirectionInterceptor = interceptor.NewRedirection(
 		nil,
 		nil,
@@ -363,3 +374,120 @@ func TestNexusInterceptRequest_HeadersSanitization(t *testing.T) {
 	require.Equal(t, initialHeader, header)
 	require.Equal(t, map[string]string{"ok-header": "ok"}, request.Request.Header)
 }
+
+func TestNexusInterceptRequest_EndpointAllowlist(t *testing.T) {
+	allowlist := map[string]string{
+		commonnexus.EndpointAllowlistNamespaceDataKey("test-endpoint"): "caller-a, caller-b",
+	}
+	cases := []struct {
+		name          string
+		endpointName  string
+		namespaceData map[string]string
+		trusted       bool
+		caller        string
+		allowed       bool
+	}{
+		{name: "allowed caller", endpointName: "test-endpoint", namespaceData: allowlist, trusted: true, caller: "caller-b", allowed: true},
+		{name: "other caller", endpointName: "test-endpoint", namespaceData: allowlist, trusted: true, caller: "caller-c"},
+		{name: "no caller", endpointName: "test-endpoint", namespaceData: allowlist, trusted: true},
+		{name: "untrusted caller", endpointName: "test-endpoint", namespaceData: allowlist, caller: "caller-a"},
+		{name: "no allowlist", endpointName: "test-endpoint", allowed: true},
+		{name: "other endpoint", endpointName: "other-endpoint", namespaceData: allowlist, allowed: true},
+		{name: "dispatch by task queue", namespaceData: allowlist, allowed: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oc := newOperationContext(contextOptions{
+				namespaceState:          enumspb.NAMESPACE_STATE_REGISTERED,
+				quota:                   1,
+				namespaceRateLimitAllow: true,
+				rateLimitAllow:          true,
+				endpointName:            tc.endpointName,
+				namespaceData:           tc.namespaceData,
+				callerNamespaceTrusted:  tc.trusted,
+			})
+			header := nexus.Header{}
+			if tc.caller != "" {
+				header.Set(commonnexus.CallerNamespaceHeader, tc.caller)
+			}
+			err := oc.interceptRequest(context.Background(), &matchingservice.DispatchNexusTaskRequest{}, header)
+			if tc.allowed {
+				require.NoError(t, err)
+				return
+			}
+			var handlerError *nexus.HandlerError
+			require.ErrorAs(t, err, &handlerError)
+			require.Equal(t, nexus.HandlerErrorTypeUnauthorized, handlerError.Type)
+		})
+	}
+}
+
+func TestNexusHTTPHandler_CallerNamespaceTrusted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	systemClaims := &authorization.Claims{System: authorization.RoleAdmin}
+	userClaims := &authorization.Claims{Namespaces: map[string]authorization.Role{"caller-a": authorization.RoleAdmin}}
+	cases := []struct {
+		name        string
+		serviceName primitives.ServiceName
+		claimMapper authorization.ClaimMapper
+		claims      *authorization.Claims
+		trusted     bool
+	}{
+		{name: "system claims", serviceName: primitives.FrontendService, claimMapper: authorization.NewMockClaimMapper(ctrl), claims: systemClaims, trusted: true},
+		{name: "user claims", serviceName: primitives.FrontendService, claimMapper: authorization.NewMockClaimMapper(ctrl), claims: userClaims},
+		{name: "no claims", serviceName: primitives.FrontendService, claimMapper: authorization.NewMockClaimMapper(ctrl)},
+		// The no-op claim mapper grants system claims to every caller.
+		{name: "noop claim mapper", serviceName: primitives.FrontendService, claimMapper: authorization.NewNoopClaimMapper(), claims: systemClaims},
+		{name: "internal frontend", serviceName: primitives.InternalFrontendService, claimMapper: authorization.NewNoopClaimMapper(), trusted: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &NexusHTTPHandler{serviceName: tc.serviceName, claimMapper: tc.claimMapper}
+			require.Equal(t, tc.trusted, h.callerNamespaceTrusted(tc.claims))
+		})
+	}
+}
+
+func TestNexusInterceptRequest_EndpointAllowlistDisabled(t *testing.T) {
+	oc := newOperationContext(contextOptions{
+		namespaceState:          enumspb.NAMESPACE_STATE_REGISTERED,
+		quota:                   1,
+		namespaceRateLimitAllow: true,
+		rateLimitAllow:          true,
+		endpointName:            "test-endpoint",
+		namespaceData: map[string]string{
+			commonnexus.EndpointAllowlistNamespaceDataKey("test-endpoint"): "caller-a",
+		},
+	})
+	oc.endpointAllowlistEnabled = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	err := oc.interceptRequest(context.Background(), &matchingservice.DispatchNexusTaskRequest{}, nexus.Header{})
+	require.NoError(t, err)
+}
+
+func TestNexusInterceptRequest_UntrustedCallerHeaderRemoved(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		trusted bool
+	}{
+		{name: "trusted", trusted: true},
+		{name: "untrusted"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			oc := newOperationContext(contextOptions{
+				namespaceState:          enumspb.NAMESPACE_STATE_REGISTERED,
+				quota:                   1,
+				namespaceRateLimitAllow: true,
+				rateLimitAllow:          true,
+				callerNamespaceTrusted:  tc.trusted,
+			})
+			header := nexus.Header{}
+			header.Set(commonnexus.CallerNamespaceHeader, "caller-a")
+			request := &matchingservice.DispatchNexusTaskRequest{
+				Request: &nexuspb.Request{Header: util.CloneMapNonNil(header)},
+			}
+			require.NoError(t, oc.interceptRequest(context.Background(), request, header))
+			_, ok := request.Request.Header[strings.ToLower(commonnexus.CallerNamespaceHeader)]
+			require.Equal(t, tc.trusted, ok)
+		})
+	}
+}
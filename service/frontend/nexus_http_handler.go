@@ -23,6 +23,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/nexus/nexusrpc"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/routing"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
@@ -45,10 +46,13 @@ type NexusHTTPHandler struct {
 	namespaceConcurrencyLimitInterceptor *interceptor.ConcurrentRequestLimitInterceptor
 	rateLimitInterceptor                 *interceptor.RateLimitInterceptor
 	enabled                              dynamicconfig.BoolPropertyFn
+	serviceName                          primitives.ServiceName
+	claimMapper                          authorization.ClaimMapper
 }
 
 func NewNexusHTTPHandler(
 	serviceConfig *Config,
+	serviceName primitives.ServiceName,
 	matchingClient matchingservice.MatchingServiceClient,
 	metricsHandler metrics.Handler,
 	clusterMetadata cluster.Metadata,
@@ -56,6 +60,7 @@ func NewNexusHTTPHandler(
 	namespaceRegistry namespace.Registry,
 	endpointRegistry commonnexus.EndpointRegistry,
 	authInterceptor *authorization.Interceptor,
+	claimMapper authorization.ClaimMapper,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
 	requestErrorHandler *interceptor.RequestErrorHandler,
 	redirectionInterceptor *interceptor.Redirection,
@@ -76,6 +81,8 @@ func NewNexusHTTPHandler(
 		namespaceConcurrencyLimitInterceptor: namespaceConcurrencyLimitIntercptor,
 		rateLimitInterceptor:                 rateLimitInterceptor,
 		enabled:                              serviceConfig.EnableNexusAPIs,
+		serviceName:                          serviceName,
+		claimMapper:                          claimMapper,
 		preprocessErrorCounter:               metricsHandler.Counter(metrics.NexusRequestPreProcessErrors.Name()).Record,
 		nexusHandler: nexusrpc.NewHTTPHandler(nexusrpc.HandlerOptions{
 			Handler: &nexusHandler{
@@ -94,6 +101,7 @@ func NewNexusHTTPHandler(
 				headersBlacklist:              serviceConfig.NexusRequestHeadersBlacklist,
 				useForwardByEndpoint:          serviceConfig.NexusForwardRequestUseEndpoint,
 				metricTagConfig:               serviceConfig.NexusOperationsMetricTagConfig,
+				endpointAllowlistEnabled:      serviceConfig.EnableNexusEndpointAllowlist,
 				httpTraceProvider:             httpTraceProvider,
 			},
 			GetResultTimeout: serviceConfig.KeepAliveMaxConnectionIdle(),
@@ -314,6 +322,7 @@ func (h *NexusHTTPHandler) parseTlsAndAuthInfo(r *http.Request, nc *nexusContext
 		// Make the auth info and claims available on the context.
 		r = r.WithContext(h.auth.EnhanceContext(r.Context(), authInfo, nc.claims))
 	}
+	nc.callerNamespaceTrusted = h.callerNamespaceTrusted(nc.claims)
 
 	return r, nil
 }
@@ -22,3 +22,13 @@ type (
 		Validate(ctx context.Context, mutableState *MutableState) ([]MutableStateValidationResult, error)
 	}
 )
+
+// FailureType returns the type tag of the validation failure.
+func (r MutableStateValidationResult) FailureType() string {
+	return r.failureType
+}
+
+// FailureDetails returns the details of the validation failure.
+func (r MutableStateValidationResult) FailureDetails() string {
+	return r.failureDetails
+}
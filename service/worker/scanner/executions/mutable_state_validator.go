@@ -53,59 +53,67 @@ func (v *mutableStateValidator) Validate(
 	mutableState *MutableState,
 ) ([]MutableStateValidationResult, error) {
 
-	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
-		mutableState.GetExecutionInfo().GetVersionHistories(),
-	)
-	if err != nil {
-		return nil, err
-	}
-	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	lastEventID, err := getLastEventID(mutableState)
 	if err != nil {
 		return nil, err
 	}
 
-	var results []MutableStateValidationResult
-
 	// First， to check if the data is expired on retention time.
 	retentionResult, err := v.validateRetention(
 		mutableState.GetExecutionInfo(),
 		mutableState.GetExecutionState().GetState(),
 	)
 	if err != nil {
-		return results, err
+		return nil, err
 	}
 	if retentionResult != nil {
 		// Skip all validation if the data is expired.
-		results = append(results, *retentionResult)
-		return results, nil
+		return []MutableStateValidationResult{*retentionResult}, nil
 	}
 
-	results = append(results, v.validateActivity(
-		mutableState.ActivityInfos,
-		lastItem.GetEventId())...,
-	)
-
-	results = append(results, v.validateTimer(
-		mutableState.TimerInfos,
-		lastItem.GetEventId())...,
-	)
-
-	results = append(results, v.validateChildWorkflow(
-		mutableState.ChildExecutionInfos,
-		lastItem.GetEventId())...,
-	)
+	return v.validateEventIDs(mutableState, lastEventID), nil
+}
 
-	results = append(results, v.validateRequestCancel(
-		mutableState.RequestCancelInfos,
-		lastItem.GetEventId())...,
-	)
+// ValidateMutableStateEventIDs does the same shallow correctness check of IDs in mutable state as the
+// mutable state validator, without the retention check that needs the namespace registry.
+func ValidateMutableStateEventIDs(
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	lastEventID, err := getLastEventID(mutableState)
+	if err != nil {
+		return nil, err
+	}
+	v := &mutableStateValidator{}
+	return v.validateEventIDs(mutableState, lastEventID), nil
+}
 
-	results = append(results, v.validateSignal(
-		mutableState.SignalInfos,
-		lastItem.GetEventId())...,
+func getLastEventID(
+	mutableState *MutableState,
+) (int64, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		mutableState.GetExecutionInfo().GetVersionHistories(),
 	)
+	if err != nil {
+		return 0, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return 0, err
+	}
+	return lastItem.GetEventId(), nil
+}
 
-	return results, nil
+func (v *mutableStateValidator) validateEventIDs(
+	mutableState *MutableState,
+	lastEventID int64,
+) []MutableStateValidationResult {
+	var results []MutableStateValidationResult
+	results = append(results, v.validateActivity(mutableState.ActivityInfos, lastEventID)...)
+	results = append(results, v.validateTimer(mutableState.TimerInfos, lastEventID)...)
+	results = append(results, v.validateChildWorkflow(mutableState.ChildExecutionInfos, lastEventID)...)
+	results = append(results, v.validateRequestCancel(mutableState.RequestCancelInfos, lastEventID)...)
+	results = append(results, v.validateSignal(mutableState.SignalInfos, lastEventID)...)
+	return results
 }
 
 func (v *mutableStateValidator) validateActivity(
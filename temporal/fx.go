@@ -140,6 +140,7 @@ var (
 			WorkerServiceProvider,
 			ApplyClusterMetadataConfigProvider,
 		),
+		fx.Decorate(PersistedDynamicConfigClientProvider),
 		dynamicconfig.Module,
 		pprof.Module,
 		TraceExportModule,
@@ -708,6 +709,72 @@ func ApplyClusterMetadataConfigProvider(
 	return svc.ClusterMetadata, svc.Persistence, nil
 }
 
+// PersistedDynamicConfigClientProvider layers the dynamic config overrides stored in persistence over the configured
+// dynamic config client, if enabled in the config.
+func PersistedDynamicConfigClientProvider(
+	dcClient dynamicconfig.Client,
+	logger log.Logger,
+	svc *config.Config,
+	persistenceServiceResolver resolver.ServiceResolver,
+	persistenceFactoryProvider persistenceClient.FactoryProviderFn,
+	customDataStoreFactory persistenceClient.AbstractDataStoreFactory,
+	metricsHandler metrics.Handler,
+	lc fx.Lifecycle,
+) (dynamicconfig.Client, error) {
+	pcConfig := svc.PersistedDynamicConfig
+	if !pcConfig.Enabled() {
+		return dcClient, nil
+	}
+
+	metricsHandler = metricsHandler.WithTags(metrics.ServiceNameTag(primitives.ServerService))
+	dataStoreFactory := persistenceClient.DataStoreFactoryProvider(
+		persistenceClient.ClusterName(svc.ClusterMetadata.CurrentClusterName),
+		persistenceServiceResolver,
+		&svc.Persistence,
+		customDataStoreFactory,
+		logger,
+		metricsHandler,
+		telemetry.NoopTracerProvider,
+	)
+	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
+		DataStoreFactory:           dataStoreFactory,
+		Cfg:                        &svc.Persistence,
+		PersistenceMaxQPS:          nil,
+		PersistenceNamespaceMaxQPS: nil,
+		ClusterName:                persistenceClient.ClusterName(svc.ClusterMetadata.CurrentClusterName),
+		MetricsHandler:             metricsHandler,
+		Logger:                     logger,
+	})
+	dynamicConfigManager, err := factory.NewDynamicConfigManager()
+	if err != nil {
+		factory.Close()
+		return nil, fmt.Errorf("error initializing dynamic config manager: %w", err)
+	}
+
+	loader := func(ctx context.Context) (int64, *persistencespb.DynamicConfig, error) {
+		ctx = headers.SetCallerInfo(ctx, headers.SystemOperatorCallerInfo)
+		resp, err := dynamicConfigManager.GetDynamicConfig(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		return resp.Version, resp.Config, nil
+	}
+
+	doneCh := make(chan interface{})
+	persistedClient, err := dynamicconfig.NewPersistedClient(pcConfig, dcClient, loader, logger, doneCh)
+	if err != nil {
+		dynamicConfigManager.Close()
+		factory.Close()
+		return nil, fmt.Errorf("unable to create persisted dynamic config client: %w", err)
+	}
+	lc.Append(fx.StopHook(func() {
+		close(doneCh)
+		dynamicConfigManager.Close()
+		factory.Close()
+	}))
+	return persistedClient, nil
+}
+
 func initCurrentClusterMetadataRecord(
 	ctx context.Context,
 	clusterMetadataManager persistence.ClusterMetadataManager,
@@ -0,0 +1,238 @@
+package tdbg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	dynamicConfigValueRow struct {
+		Key         string
+		Constraints string
+		Value       string
+	}
+
+	dynamicConfigChangeRow struct {
+		Version        int64
+		ChangeTime     string
+		Key            string
+		PreviousValues string
+		Values         string
+		Identity       string
+		Reason         string
+	}
+)
+
+// AdminGetDynamicConfig shows the values stored for a dynamic config key
+func AdminGetDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Key: c.String(FlagDynamicConfigKey),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic config: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminSetDynamicConfig stores a value for a dynamic config key
+func AdminSetDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	constraints, err := parseDynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+	value := c.String(FlagDynamicConfigValue)
+	if _, err := dynamicconfig.ParsePersistedValue(value); err != nil {
+		return fmt.Errorf("invalid value: %w", err)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Key: c.String(FlagDynamicConfigKey),
+		Value: &persistencespb.DynamicConfigValue{
+			Constraints: constraints,
+			Value:       value,
+		},
+		Identity: c.String(FlagIdentity),
+		Reason:   c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set dynamic config: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Dynamic config updated to version %d.\n", resp.GetVersion())
+	return nil
+}
+
+// AdminDeleteDynamicConfig deletes the value stored for a dynamic config key with the given constraints
+func AdminDeleteDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	constraints, err := parseDynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DeleteDynamicConfig(ctx, &adminservice.DeleteDynamicConfigRequest{
+		Key:         c.String(FlagDynamicConfigKey),
+		Constraints: constraints,
+		Identity:    c.String(FlagIdentity),
+		Reason:      c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete dynamic config: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Dynamic config updated to version %d.\n", resp.GetVersion())
+	return nil
+}
+
+// AdminListDynamicConfig lists all dynamic config values stored in persistence
+func AdminListDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list dynamic config: %w", err)
+	}
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(c, resp)
+		return nil
+	}
+
+	var rows []interface{}
+	for _, entry := range resp.GetEntries() {
+		for _, value := range entry.GetValues() {
+			rows = append(rows, dynamicConfigValueRow{
+				Key:         entry.GetKey(),
+				Constraints: formatDynamicConfigConstraints(value.GetConstraints()),
+				Value:       value.GetValue(),
+			})
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "Version: %d\n", resp.GetVersion())
+	return printTable(rows, c.App.Writer)
+}
+
+// AdminListDynamicConfigHistory lists changes to the dynamic config values stored in persistence
+func AdminListDynamicConfigHistory(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	pageSize := c.Int(FlagPageSize)
+	printJSON := c.Bool(FlagPrintJSON)
+
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+
+		resp, err := adminClient.ListDynamicConfigHistory(ctx, &adminservice.ListDynamicConfigHistoryRequest{
+			Key:           c.String(FlagDynamicConfigKey),
+			PageSize:      int32(pageSize),
+			NextPageToken: paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var items []interface{}
+		for _, change := range resp.GetChanges() {
+			if printJSON {
+				items = append(items, change)
+				continue
+			}
+			items = append(items, dynamicConfigChangeRow{
+				Version:        change.GetVersion(),
+				ChangeTime:     change.GetChangeTime().AsTime().String(),
+				Key:            change.GetKey(),
+				PreviousValues: formatDynamicConfigValues(change.GetPreviousValues()),
+				Values:         formatDynamicConfigValues(change.GetValues()),
+				Identity:       change.GetIdentity(),
+				Reason:         change.GetReason(),
+			})
+		}
+		return items, resp.GetNextPageToken(), nil
+	}
+
+	if err := paginate(c, paginationFunc, pageSize); err != nil {
+		return fmt.Errorf("unable to list dynamic config history: %w", err)
+	}
+	return nil
+}
+
+func parseDynamicConfigConstraints(c *cli.Context) (*persistencespb.DynamicConfigConstraints, error) {
+	constraints := &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.String(FlagConstraintNamespace),
+		NamespaceId:   c.String(FlagConstraintNamespaceID),
+		TaskQueueName: c.String(FlagConstraintTaskQueue),
+		ShardId:       int32(c.Int(FlagConstraintShardID)),
+		Destination:   c.String(FlagConstraintDestination),
+	}
+	if c.IsSet(FlagConstraintTaskQueueType) {
+		tqType, err := StringToEnum(c.String(FlagConstraintTaskQueueType), enumspb.TaskQueueType_value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task queue type: %w", err)
+		}
+		constraints.TaskQueueType = enumspb.TaskQueueType(tqType)
+	}
+	if c.IsSet(FlagConstraintTaskType) {
+		taskType, err := StringToEnum(c.String(FlagConstraintTaskType), enumsspb.TaskType_value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task type: %w", err)
+		}
+		constraints.TaskType = enumsspb.TaskType(taskType)
+	}
+	return constraints, nil
+}
+
+func formatDynamicConfigConstraints(constraints *persistencespb.DynamicConfigConstraints) string {
+	var parts []string
+	if v := constraints.GetNamespace(); v != "" {
+		parts = append(parts, "namespace="+v)
+	}
+	if v := constraints.GetNamespaceId(); v != "" {
+		parts = append(parts, "namespaceId="+v)
+	}
+	if v := constraints.GetTaskQueueName(); v != "" {
+		parts = append(parts, "taskQueueName="+v)
+	}
+	if v := constraints.GetTaskQueueType(); v != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		parts = append(parts, "taskQueueType="+v.String())
+	}
+	if v := constraints.GetShardId(); v != 0 {
+		parts = append(parts, fmt.Sprintf("shardId=%d", v))
+	}
+	if v := constraints.GetTaskType(); v != enumsspb.TASK_TYPE_UNSPECIFIED {
+		parts = append(parts, "taskType="+v.String())
+	}
+	if v := constraints.GetDestination(); v != "" {
+		parts = append(parts, "destination="+v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatDynamicConfigValues(values []*persistencespb.DynamicConfigValue) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = fmt.Sprintf("{%s: %s}", formatDynamicConfigConstraints(value.GetConstraints()), value.GetValue())
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,118 @@
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/grpc"
+)
+
+type dynamicConfigTestClient struct {
+	adminservice.AdminServiceClient
+	setRequests    []*adminservice.SetDynamicConfigRequest
+	deleteRequests []*adminservice.DeleteDynamicConfigRequest
+}
+
+func (t *dynamicConfigTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *dynamicConfigTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *dynamicConfigTestClient) SetDynamicConfig(_ context.Context, request *adminservice.SetDynamicConfigRequest, _ ...grpc.CallOption) (*adminservice.SetDynamicConfigResponse, error) {
+	t.setRequests = append(t.setRequests, request)
+	return &adminservice.SetDynamicConfigResponse{Version: 2}, nil
+}
+
+func (t *dynamicConfigTestClient) DeleteDynamicConfig(_ context.Context, request *adminservice.DeleteDynamicConfigRequest, _ ...grpc.CallOption) (*adminservice.DeleteDynamicConfigResponse, error) {
+	t.deleteRequests = append(t.deleteRequests, request)
+	return &adminservice.DeleteDynamicConfigResponse{Version: 3}, nil
+}
+
+func (t *dynamicConfigTestClient) ListDynamicConfig(context.Context, *adminservice.ListDynamicConfigRequest, ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
+	return &adminservice.ListDynamicConfigResponse{
+		Version: 3,
+		Entries: []*persistencespb.DynamicConfigEntry{
+			{
+				Key: "frontend.rps",
+				Values: []*persistencespb.DynamicConfigValue{
+					{Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"}, Value: "200"},
+				},
+			},
+		},
+	}, nil
+}
+
+func newDynamicConfigTestApp(client *dynamicConfigTestClient) (*cli.App, *bytes.Buffer) {
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+	var out bytes.Buffer
+	app.Writer = &out
+	return app, &out
+}
+
+func TestAdminSetDynamicConfig(t *testing.T) {
+	client := &dynamicConfigTestClient{}
+	app, out := newDynamicConfigTestApp(client)
+
+	err := app.Run([]string{"tdbg", "dynamic-config", "set",
+		"--key", "matching.numTaskqueueReadPartitions",
+		"--value", "8",
+		"--constraint-namespace", "ns",
+		"--constraint-task-queue", "tq",
+		"--constraint-task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY",
+		"--identity", "me",
+		"--reason", "load test",
+	})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "version 2")
+	require.Len(t, client.setRequests, 1)
+	request := client.setRequests[0]
+	require.Equal(t, "matching.numTaskqueueReadPartitions", request.GetKey())
+	require.Equal(t, "8", request.GetValue().GetValue())
+	require.Equal(t, "ns", request.GetValue().GetConstraints().GetNamespace())
+	require.Equal(t, "tq", request.GetValue().GetConstraints().GetTaskQueueName())
+	require.Equal(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetValue().GetConstraints().GetTaskQueueType())
+	require.Equal(t, "me", request.GetIdentity())
+	require.Equal(t, "load test", request.GetReason())
+
+	// invalid values are rejected before calling the server
+	err = app.Run([]string{"tdbg", "dynamic-config", "set", "--key", "frontend.rps", "--value", "{"})
+	require.ErrorContains(t, err, "invalid value")
+	err = app.Run([]string{"tdbg", "dynamic-config", "set", "--key", "frontend.rps", "--value", "1",
+		"--constraint-task-queue-type", "bogus"})
+	require.ErrorContains(t, err, "invalid task queue type")
+	require.Len(t, client.setRequests, 1)
+}
+
+func TestAdminDeleteDynamicConfig(t *testing.T) {
+	client := &dynamicConfigTestClient{}
+	app, _ := newDynamicConfigTestApp(client)
+
+	err := app.Run([]string{"tdbg", "dc", "delete", "--key", "history.rps", "--constraint-shard-id", "3"})
+	require.NoError(t, err)
+	require.Len(t, client.deleteRequests, 1)
+	require.Equal(t, "history.rps", client.deleteRequests[0].GetKey())
+	require.Equal(t, int32(3), client.deleteRequests[0].GetConstraints().GetShardId())
+}
+
+func TestAdminListDynamicConfig(t *testing.T) {
+	app, out := newDynamicConfigTestApp(&dynamicConfigTestClient{})
+
+	err := app.Run([]string{"tdbg", "dynamic-config", "list"})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "Version: 3")
+	require.Contains(t, out.String(), "frontend.rps")
+	require.Contains(t, out.String(), "namespace=ns")
+}
@@ -71,4 +71,21 @@ var (
 	FlagAllActive                  = "select-all-active"
 	FlagFair                       = "fair"
 	FlagMinPass                    = "min-pass"
+	FlagDynamicConfigKey           = "key"
+	FlagDynamicConfigValue         = "value"
+	FlagIdentity                   = "identity"
+	FlagConstraintNamespace        = "constraint-namespace"
+	FlagConstraintNamespaceID      = "constraint-namespace-id"
+	FlagConstraintTaskQueue        = "constraint-task-queue"
+	FlagConstraintTaskQueueType    = "constraint-task-queue-type"
+	FlagConstraintShardID          = "constraint-shard-id"
+	FlagConstraintTaskType         = "constraint-task-type"
+	FlagConstraintDestination      = "constraint-destination"
+	FlagEntryKind                  = "kind"
+	FlagEntryType                  = "entry-type"
+	FlagIncludeTasks               = "include-tasks"
+	FlagIncludeDLQ                 = "include-dlq"
+	FlagRepair                     = "repair"
+	FlagIncludeHistoryBranches     = "include-history-branches"
+	FlagMinBranchAge               = "min-branch-age"
 )
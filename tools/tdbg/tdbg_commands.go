@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -26,13 +27,13 @@ func getCommands(
 			Name:        "workflow",
 			Aliases:     []string{"w"},
 			Usage:       "Run admin operation on workflow",
-			Subcommands: newAdminWorkflowCommands(clientFactory, prompterFactory),
+			Subcommands: newAdminWorkflowCommands(clientFactory, prompterFactory, taskCategoryRegistry),
 		},
 		{
 			Name:        "shard",
 			Aliases:     []string{"s"},
 			Usage:       "Run admin operation on specific shard",
-			Subcommands: newAdminShardManagementCommands(clientFactory, taskCategoryRegistry),
+			Subcommands: newAdminShardManagementCommands(clientFactory, prompterFactory, taskCategoryRegistry),
 		},
 		{
 			Name:        "history-host",
@@ -69,10 +70,20 @@ func getCommands(
 			Usage:       "Decode payload",
 			Subcommands: newDecodeCommands(taskBlobEncoder),
 		},
+		{
+			Name:        "dynamic-config",
+			Aliases:     []string{"dc"},
+			Usage:       "Manage dynamic config overrides stored in persistence",
+			Subcommands: newAdminDynamicConfigCommands(clientFactory),
+		},
 	}
 }
 
-func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+func newAdminWorkflowCommands(
+	clientFactory ClientFactory,
+	prompterFactory PrompterFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "import",
@@ -160,6 +171,94 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminDescribeWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "inspect",
+			Usage: "Show a timeline of workflow history events with pending activities, timers, child workflows, tasks and DLQ entries",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "Inspect history exported to a JSON file instead of a workflow on the server",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagEntryKind,
+					Usage: "Only show entries of the given kinds: event, activity, timer, child, task, dlq",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagEntryType,
+					Usage: "Only show entries whose type contains one of the given strings, e.g. ActivityTask",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinEventID,
+					Usage: "Minimum event ID of entries to show",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMaxEventID,
+					Usage: "Maximum event ID of entries to show",
+				},
+				&cli.BoolFlag{
+					Name:  FlagIncludeTasks,
+					Usage: "Include history tasks of the workflow. This scans all task queues of the workflow's shard",
+				},
+				&cli.BoolFlag{
+					Name:  FlagIncludeDLQ,
+					Usage: "Include DLQ entries of the workflow. This reads all history task DLQs",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: defaultPageSize,
+					Usage: "Page size used to scan tasks and DLQs",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw JSON format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminInspectWorkflow(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "Check the consistency of a workflow's mutable state with its history and timer tasks",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Repair the problems found by rebuilding the mutable state or refreshing the tasks of the workflow, after confirmation",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: defaultPageSize,
+					Usage: "Page size used to scan the timer tasks of the workflow's shard",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw JSON format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminVerifyWorkflow(c, clientFactory, prompterFactory(c))
+			},
+		},
 		{
 			Name:    "refresh-tasks",
 			Aliases: []string{"rt"},
@@ -258,7 +357,11 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 	}
 }
 
-func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRegistry tasks.TaskCategoryRegistry) []*cli.Command {
+func newAdminShardManagementCommands(
+	clientFactory ClientFactory,
+	prompterFactory PrompterFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) []*cli.Command {
 	// There are two different categories for the task type, and they have slightly
 	// different semantics. The first is the task category for the list-tasks command,
 	// which is required and does not have a default. The second is the task category
@@ -330,6 +433,42 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminListShardTasks(c, clientFactory, taskCategoryRegistry)
 			},
 		},
+		{
+			Name:  "verify",
+			Usage: "Check the consistency of all executions in a shard with their history and timer tasks",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Repair the problems found by rebuilding the mutable state or refreshing the tasks of the affected workflows, after confirmation",
+				},
+				&cli.BoolFlag{
+					Name:  FlagIncludeHistoryBranches,
+					Usage: "Also find history branches of the shard that aren't referenced by any execution. This reads the history branches of all shards",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMinBranchAge,
+					Value: time.Hour,
+					Usage: "Only report unreferenced history branches that were created at least this long ago",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: defaultPageSize,
+					Usage: "Page size used to scan executions, timer tasks and history branches",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw JSON format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminVerifyShard(c, clientFactory, prompterFactory(c))
+			},
+		},
 		{
 			Name:  "close-shard",
 			Usage: "close a shard given a shard id",
@@ -897,3 +1036,124 @@ func newDecodeCommands(
 		},
 	}
 }
+
+func newAdminDynamicConfigCommands(clientFactory ClientFactory) []*cli.Command {
+	constraintFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagConstraintNamespace,
+			Usage: "Namespace constraint of the value",
+		},
+		&cli.StringFlag{
+			Name:  FlagConstraintNamespaceID,
+			Usage: "Namespace ID constraint of the value",
+		},
+		&cli.StringFlag{
+			Name:  FlagConstraintTaskQueue,
+			Usage: "Task queue name constraint of the value",
+		},
+		&cli.StringFlag{
+			Name:  FlagConstraintTaskQueueType,
+			Usage: "Task queue type constraint of the value, e.g. TASK_QUEUE_TYPE_WORKFLOW",
+		},
+		&cli.IntFlag{
+			Name:  FlagConstraintShardID,
+			Usage: "Shard ID constraint of the value",
+		},
+		&cli.StringFlag{
+			Name:  FlagConstraintTaskType,
+			Usage: "History task type constraint of the value, e.g. TASK_TYPE_TRANSFER_ACTIVITY_TASK",
+		},
+		&cli.StringFlag{
+			Name:  FlagConstraintDestination,
+			Usage: "Destination constraint of the value",
+		},
+	}
+	keyFlag := &cli.StringFlag{
+		Name:     FlagDynamicConfigKey,
+		Usage:    "Dynamic config key",
+		Required: true,
+	}
+	changeFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagIdentity,
+			Usage: "Identity of the operator making the change",
+		},
+		&cli.StringFlag{
+			Name:  FlagReason,
+			Usage: "Reason for the change",
+		},
+	}
+
+	return []*cli.Command{
+		{
+			Name:  "get",
+			Usage: "Show the values stored for a dynamic config key",
+			Flags: []cli.Flag{keyFlag},
+			Action: func(c *cli.Context) error {
+				return AdminGetDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "Store a value for a dynamic config key, replacing the value with the same constraints",
+			Flags: append(append([]cli.Flag{
+				keyFlag,
+				&cli.StringFlag{
+					Name:     FlagDynamicConfigValue,
+					Usage:    "Value in YAML or JSON, as it would be written in the dynamic config file",
+					Required: true,
+				},
+			}, constraintFlags...), changeFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminSetDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete the value stored for a dynamic config key with exactly the given constraints",
+			Flags: append(append([]cli.Flag{keyFlag}, constraintFlags...), changeFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminDeleteDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List all values stored in persistence",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "history",
+			Usage: "List changes to the values stored in persistence, most recent first",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Only list changes to this key",
+				},
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfigHistory(c, clientFactory)
+			},
+		},
+	}
+}
@@ -293,6 +293,22 @@ func getNamespaceID(c *cli.Context, clientFactory ClientFactory, nsName namespac
 	return namespace.ID(nsResponse.NamespaceInfo.GetId()), nil
 }
 
+func getNamespaceName(c *cli.Context, clientFactory ClientFactory, nsID namespace.ID) (namespace.Name, error) {
+	wfClient := clientFactory.WorkflowClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	nsResponse, err := wfClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Id: nsID.String(),
+	})
+	if err != nil {
+		return namespace.EmptyName, err
+	}
+
+	return namespace.Name(nsResponse.NamespaceInfo.GetName()), nil
+}
+
 func getArchetypeWithDefault(
 	c *cli.Context,
 	defaultAchetype chasm.Archetype,
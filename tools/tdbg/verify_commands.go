@@ -0,0 +1,584 @@
+package tdbg
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/scanner/executions"
+)
+
+type (
+	// verifyFinding is one consistency problem found by `workflow verify` or `shard verify`.
+	verifyFinding struct {
+		NamespaceID  string `json:"namespace_id"`
+		WorkflowID   string `json:"workflow_id"`
+		RunID        string `json:"run_id"`
+		Check        string `json:"check"`
+		Details      string `json:"details"`
+		Repair       string `json:"repair,omitempty"`
+		RepairResult string `json:"repair_result,omitempty"`
+	}
+
+	verifyResult struct {
+		Executions int             `json:"executions"`
+		Findings   []verifyFinding `json:"findings"`
+	}
+
+	// executionVerifier runs the consistency checks of executions through the admin API.
+	executionVerifier struct {
+		c              *cli.Context
+		clientFactory  ClientFactory
+		adminClient    adminservice.AdminServiceClient
+		prompter       *Prompter
+		namespaceNames map[string]namespace.Name
+	}
+)
+
+// Checks of the executions scanner's mutable state validator are reported with the validator's failure types.
+const (
+	verifyCheckHistoryEventID   = "history_event_id_validator"
+	verifyCheckHistoryBranch    = "history_branch"
+	verifyCheckPendingActivity  = "pending_activity"
+	verifyCheckPendingTimer     = "pending_timer"
+	verifyCheckPendingChild     = "pending_child_workflow"
+	verifyCheckDanglingActivity = "dangling_activity"
+	verifyCheckDanglingTimer    = "dangling_timer"
+	verifyCheckOrphanedBranch   = "orphaned_history_branch"
+)
+
+const (
+	verifyRepairRefreshTasks = "refresh-tasks"
+	verifyRepairRebuild      = "rebuild"
+
+	verifyRepairSucceeded = "succeeded"
+)
+
+// AdminVerifyWorkflow checks the consistency of a workflow's mutable state with its history and timer tasks, and
+// optionally repairs the problems found.
+func AdminVerifyWorkflow(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	resp, err := describeMutableState(c, clientFactory)
+	if err != nil {
+		return err
+	}
+	shardID, err := strconv.Atoi(resp.GetShardId())
+	if err != nil {
+		return fmt.Errorf("invalid shard ID %q: %w", resp.GetShardId(), err)
+	}
+	workflow := newInspectWorkflow(resp.GetDatabaseMutableState())
+
+	v := newExecutionVerifier(c, clientFactory, prompter)
+	scanTime := time.Now().UTC()
+	timerTasks, err := v.listTimerTasks(int32(shardID), &workflow)
+	if err != nil {
+		return err
+	}
+	// Mutable state is read again after the timer tasks, so that its timers and activities can be expected to have
+	// tasks if it hasn't changed since the scan started.
+	resp, err = describeMutableState(c, clientFactory)
+	if err != nil {
+		return err
+	}
+
+	findings, err := v.verifyExecution(resp.GetDatabaseMutableState(), timerTasks[workflow], scanTime)
+	if err != nil {
+		return err
+	}
+	return v.report(findings, 1)
+}
+
+// AdminVerifyShard checks the consistency of all executions in a shard, and optionally its history branches, and
+// optionally repairs the problems found.
+func AdminVerifyShard(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	shardID := int32(c.Int(FlagShardID))
+	pageSize := c.Int(FlagPageSize)
+
+	v := newExecutionVerifier(c, clientFactory, prompter)
+	scanTime := time.Now().UTC()
+	timerTasks, err := v.listTimerTasks(shardID, nil)
+	if err != nil {
+		return err
+	}
+
+	var findings []verifyFinding
+	workflows := make(map[inspectWorkflow]struct{})
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		ctx, cancel := newContext(c)
+		resp, err := v.adminClient.ListConcreteExecutions(ctx, &adminservice.ListConcreteExecutionsRequest{
+			ShardId:       shardID,
+			PageSize:      int32(pageSize),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to list executions: %w", err)
+		}
+		for _, mutableState := range resp.GetMutableStates() {
+			workflow := newInspectWorkflow(mutableState)
+			workflows[workflow] = struct{}{}
+			executionFindings, err := v.verifyExecution(mutableState, timerTasks[workflow], scanTime)
+			if err != nil {
+				return fmt.Errorf("unable to verify workflow %s, run %s: %w", workflow.workflowID, workflow.runID, err)
+			}
+			findings = append(findings, executionFindings...)
+		}
+		token = resp.GetNextPageToken()
+	}
+
+	if c.Bool(FlagIncludeHistoryBranches) {
+		branchFindings, err := v.verifyHistoryBranches(shardID, workflows)
+		if err != nil {
+			return err
+		}
+		findings = append(findings, branchFindings...)
+	}
+	return v.report(findings, len(workflows))
+}
+
+func newExecutionVerifier(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) *executionVerifier {
+	return &executionVerifier{
+		c:              c,
+		clientFactory:  clientFactory,
+		adminClient:    clientFactory.AdminClient(c),
+		prompter:       prompter,
+		namespaceNames: make(map[string]namespace.Name),
+	}
+}
+
+func newInspectWorkflow(mutableState *persistencespb.WorkflowMutableState) inspectWorkflow {
+	return inspectWorkflow{
+		namespaceID: mutableState.GetExecutionInfo().GetNamespaceId(),
+		workflowID:  mutableState.GetExecutionInfo().GetWorkflowId(),
+		runID:       mutableState.GetExecutionState().GetRunId(),
+	}
+}
+
+// listTimerTasks returns the types of the timer tasks of each workflow in the shard, or of only one workflow if given.
+func (v *executionVerifier) listTimerTasks(
+	shardID int32,
+	workflow *inspectWorkflow,
+) (map[inspectWorkflow][]enumsspb.TaskType, error) {
+	timerTasks := make(map[inspectWorkflow][]enumsspb.TaskType)
+	err := scanHistoryTasks(v.c, v.adminClient, shardID, tasks.CategoryTimer, func(task *adminservice.Task) {
+		if workflow != nil && !workflow.matches(task.GetNamespaceId(), task.GetWorkflowId(), task.GetRunId()) {
+			return
+		}
+		key := inspectWorkflow{
+			namespaceID: task.GetNamespaceId(),
+			workflowID:  task.GetWorkflowId(),
+			runID:       task.GetRunId(),
+		}
+		timerTasks[key] = append(timerTasks[key], task.GetTaskType())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return timerTasks, nil
+}
+
+// verifyExecution runs all checks of one execution. Timer tasks are only checked if the execution hasn't been updated
+// since scanTime, when the scan of its tasks started.
+func (v *executionVerifier) verifyExecution(
+	mutableState *persistencespb.WorkflowMutableState,
+	timerTasks []enumsspb.TaskType,
+	scanTime time.Time,
+) ([]verifyFinding, error) {
+	workflow := newInspectWorkflow(mutableState)
+	newFinding := func(check string, details string, repair string) verifyFinding {
+		return verifyFinding{
+			NamespaceID: workflow.namespaceID,
+			WorkflowID:  workflow.workflowID,
+			RunID:       workflow.runID,
+			Check:       check,
+			Details:     details,
+			Repair:      repair,
+		}
+	}
+
+	// only workflows have history events
+	if len(mutableState.GetExecutionInfo().GetVersionHistories().GetHistories()) == 0 {
+		return nil, nil
+	}
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	if versionhistory.IsEmptyVersionHistory(currentVersionHistory) {
+		return nil, nil
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []verifyFinding
+	results, err := executions.ValidateMutableStateEventIDs(&executions.MutableState{WorkflowMutableState: mutableState})
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		findings = append(findings, newFinding(result.FailureType(), result.FailureDetails(), verifyRepairRebuild))
+	}
+
+	events, err := getWorkflowHistoryEvents(v.c, v.adminClient, workflow, lastItem.GetEventId()+1)
+	var notFound *serviceerror.NotFound
+	var dataLoss *serviceerror.DataLoss
+	if errors.As(err, &notFound) || errors.As(err, &dataLoss) {
+		exists, existsErr := v.executionExists(workflow)
+		if existsErr != nil {
+			return nil, existsErr
+		}
+		if !exists {
+			// deleted since it was listed
+			return nil, nil
+		}
+		return append(findings, newFinding(verifyCheckHistoryEventID, err.Error(), "")), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if details := verifyHistoryBranch(mutableState, lastItem, events); details != "" {
+		findings = append(findings, newFinding(verifyCheckHistoryBranch, details, verifyRepairRebuild))
+	}
+
+	if mutableState.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING {
+		return findings, nil
+	}
+
+	// buffered events are included since they can complete pending activities and child workflows that were already
+	// removed from mutable state
+	pendingFromHistory := make(map[string]map[int64]struct{})
+	for _, entry := range inspectPendingFromHistory(slices.Concat(events, mutableState.GetBufferedEvents())) {
+		if pendingFromHistory[entry.Kind] == nil {
+			pendingFromHistory[entry.Kind] = make(map[int64]struct{})
+		}
+		pendingFromHistory[entry.Kind][entry.EventID] = struct{}{}
+	}
+	var timerEventIDs []int64
+	for _, timer := range mutableState.GetTimerInfos() {
+		timerEventIDs = append(timerEventIDs, timer.GetStartedEventId())
+	}
+	for _, pending := range []struct {
+		check string
+		name  string
+		kind  string
+		ids   []int64
+	}{
+		{verifyCheckPendingActivity, "Activity scheduled", inspectKindActivity, slices.Collect(maps.Keys(mutableState.GetActivityInfos()))},
+		{verifyCheckPendingTimer, "Timer started", inspectKindTimer, timerEventIDs},
+		{verifyCheckPendingChild, "Child workflow initiated", inspectKindChild, slices.Collect(maps.Keys(mutableState.GetChildExecutionInfos()))},
+	} {
+		onlyMutableState, onlyHistory := diffPendingEventIDs(pending.ids, pendingFromHistory[pending.kind])
+		for _, eventID := range onlyMutableState {
+			details := fmt.Sprintf("%s by event %d is pending in mutable state but not in history", pending.name, eventID)
+			findings = append(findings, newFinding(pending.check, details, verifyRepairRebuild))
+		}
+		for _, eventID := range onlyHistory {
+			details := fmt.Sprintf("%s by event %d is pending in history but not in mutable state", pending.name, eventID)
+			findings = append(findings, newFinding(pending.check, details, verifyRepairRebuild))
+		}
+	}
+
+	if !mutableState.GetExecutionInfo().GetLastUpdateTime().AsTime().Before(scanTime) {
+		return findings, nil
+	}
+	if n := len(mutableState.GetTimerInfos()); n > 0 && !slices.Contains(timerTasks, enumsspb.TASK_TYPE_USER_TIMER) {
+		details := fmt.Sprintf("%d pending timers but no user timer task", n)
+		findings = append(findings, newFinding(verifyCheckDanglingTimer, details, verifyRepairRefreshTasks))
+	}
+	if n := len(mutableState.GetActivityInfos()); n > 0 &&
+		!slices.Contains(timerTasks, enumsspb.TASK_TYPE_ACTIVITY_TIMEOUT) &&
+		!slices.Contains(timerTasks, enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER) {
+		details := fmt.Sprintf("%d pending activities but no activity timeout or retry timer task", n)
+		findings = append(findings, newFinding(verifyCheckDanglingActivity, details, verifyRepairRefreshTasks))
+	}
+	return findings, nil
+}
+
+// verifyHistoryBranch checks that the history events of the current branch are contiguous and end at the last item
+// of the current version history, and returns the problem found if any.
+func verifyHistoryBranch(
+	mutableState *persistencespb.WorkflowMutableState,
+	lastItem *historyspb.VersionHistoryItem,
+	events []*historypb.HistoryEvent,
+) string {
+	for i, event := range events {
+		if event.GetEventId() != common.FirstEventID+int64(i) {
+			return fmt.Sprintf("Event %d found at position %d of history", event.GetEventId(), i)
+		}
+	}
+	if len(events) == 0 {
+		return fmt.Sprintf("No history events, last version history item is event %d", lastItem.GetEventId())
+	}
+	lastEvent := events[len(events)-1]
+	if lastEvent.GetEventId() != lastItem.GetEventId() || lastEvent.GetVersion() != lastItem.GetVersion() {
+		return fmt.Sprintf(
+			"Last history event %d (version %d) doesn't match last version history item %d (version %d)",
+			lastEvent.GetEventId(),
+			lastEvent.GetVersion(),
+			lastItem.GetEventId(),
+			lastItem.GetVersion(),
+		)
+	}
+	if mutableState.GetNextEventId() != lastItem.GetEventId()+1 {
+		return fmt.Sprintf("Next event ID %d doesn't follow last event %d", mutableState.GetNextEventId(), lastItem.GetEventId())
+	}
+	return ""
+}
+
+// diffPendingEventIDs returns the sorted event IDs that are only pending in mutable state and only pending in history.
+func diffPendingEventIDs(
+	mutableStateIDs []int64,
+	historyIDs map[int64]struct{},
+) (onlyMutableState []int64, onlyHistory []int64) {
+	inMutableState := make(map[int64]struct{}, len(mutableStateIDs))
+	for _, id := range mutableStateIDs {
+		inMutableState[id] = struct{}{}
+		if _, ok := historyIDs[id]; !ok {
+			onlyMutableState = append(onlyMutableState, id)
+		}
+	}
+	for id := range historyIDs {
+		if _, ok := inMutableState[id]; !ok {
+			onlyHistory = append(onlyHistory, id)
+		}
+	}
+	slices.Sort(onlyMutableState)
+	slices.Sort(onlyHistory)
+	return onlyMutableState, onlyHistory
+}
+
+// verifyHistoryBranches finds the history branches of the shard's workflows that aren't referenced by any execution,
+// like the history scavenger does. Branches younger than the min branch age are skipped, since they can belong to
+// executions that were created after the executions were listed.
+func (v *executionVerifier) verifyHistoryBranches(
+	shardID int32,
+	workflows map[inspectWorkflow]struct{},
+) ([]verifyFinding, error) {
+	pageSize := v.c.Int(FlagPageSize)
+	maxForkTime := time.Now().UTC().Add(-v.c.Duration(FlagMinBranchAge))
+
+	ctx, cancel := newContext(v.c)
+	clusterResp, err := v.adminClient.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("unable to describe cluster: %w", err)
+	}
+	numShards := clusterResp.GetHistoryShardCount()
+
+	var findings []verifyFinding
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		ctx, cancel := newContext(v.c)
+		resp, err := v.adminClient.ListHistoryTreeBranches(ctx, &adminservice.ListHistoryTreeBranchesRequest{
+			PageSize:      int32(pageSize),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to list history branches: %w", err)
+		}
+		for _, branch := range resp.GetBranches() {
+			if branch.GetForkTime().AsTime().After(maxForkTime) {
+				continue
+			}
+			namespaceID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(branch.GetInfo())
+			if err != nil {
+				// nolint:errcheck // assuming that write will succeed.
+				fmt.Fprintf(v.c.App.ErrWriter, "Skipping history branch %s: %v\n", branch.GetBranchInfo().GetBranchId(), err)
+				continue
+			}
+			if common.WorkflowIDToHistoryShard(namespaceID, workflowID, numShards) != shardID {
+				continue
+			}
+			workflow := inspectWorkflow{namespaceID: namespaceID, workflowID: workflowID, runID: runID}
+			if _, ok := workflows[workflow]; ok {
+				continue
+			}
+			findings = append(findings, verifyFinding{
+				NamespaceID: namespaceID,
+				WorkflowID:  workflowID,
+				RunID:       runID,
+				Check:       verifyCheckOrphanedBranch,
+				Details: fmt.Sprintf(
+					"treeId=%s, branchId=%s, forkTime=%s",
+					branch.GetBranchInfo().GetTreeId(),
+					branch.GetBranchInfo().GetBranchId(),
+					branch.GetForkTime().AsTime().Format(defaultDateTimeFormat),
+				),
+			})
+		}
+		token = resp.GetNextPageToken()
+	}
+	return findings, nil
+}
+
+func (v *executionVerifier) executionExists(workflow inspectWorkflow) (bool, error) {
+	nsName, err := v.namespaceName(workflow.namespaceID)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := newContext(v.c)
+	defer cancel()
+	_, err = v.adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: nsName.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflow.workflowID,
+			RunId:      workflow.runID,
+		},
+		Archetype: chasm.WorkflowArchetype,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (v *executionVerifier) namespaceName(namespaceID string) (namespace.Name, error) {
+	if nsName, ok := v.namespaceNames[namespaceID]; ok {
+		return nsName, nil
+	}
+	nsName, err := getNamespaceName(v.c, v.clientFactory, namespace.ID(namespaceID))
+	if err != nil {
+		return namespace.EmptyName, fmt.Errorf("unable to describe namespace %s: %w", namespaceID, err)
+	}
+	v.namespaceNames[namespaceID] = nsName
+	return nsName, nil
+}
+
+// repair repairs each workflow with findings once, by rebuilding its mutable state from history if any finding needs
+// it, and refreshing its tasks. The user is asked to confirm the repairs first.
+func (v *executionVerifier) repair(findings []verifyFinding) {
+	repairs := make(map[inspectWorkflow]string)
+	var workflows []inspectWorkflow
+	for _, finding := range findings {
+		if finding.Repair == "" {
+			continue
+		}
+		workflow := inspectWorkflow{namespaceID: finding.NamespaceID, workflowID: finding.WorkflowID, runID: finding.RunID}
+		if _, ok := repairs[workflow]; !ok {
+			workflows = append(workflows, workflow)
+		}
+		if finding.Repair == verifyRepairRebuild || repairs[workflow] == "" {
+			repairs[workflow] = finding.Repair
+		}
+	}
+
+	if len(workflows) == 0 {
+		return
+	}
+	rebuilds := 0
+	for _, workflow := range workflows {
+		if repairs[workflow] == verifyRepairRebuild {
+			rebuilds++
+		}
+	}
+	v.prompter.Prompt(fmt.Sprintf("Rebuild the mutable state of %d workflows from their history and refresh the tasks of %d workflows?",
+		rebuilds, len(workflows)))
+
+	results := make(map[inspectWorkflow]string, len(workflows))
+	for _, workflow := range workflows {
+		if err := v.repairWorkflow(workflow, repairs[workflow] == verifyRepairRebuild); err != nil {
+			results[workflow] = "failed: " + err.Error()
+		} else {
+			results[workflow] = verifyRepairSucceeded
+		}
+	}
+	for i, finding := range findings {
+		if finding.Repair != "" {
+			findings[i].RepairResult = results[inspectWorkflow{namespaceID: finding.NamespaceID, workflowID: finding.WorkflowID, runID: finding.RunID}]
+		}
+	}
+}
+
+func (v *executionVerifier) repairWorkflow(workflow inspectWorkflow, rebuild bool) error {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: workflow.workflowID,
+		RunId:      workflow.runID,
+	}
+	if rebuild {
+		nsName, err := v.namespaceName(workflow.namespaceID)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := newContext(v.c)
+		_, err = v.adminClient.RebuildMutableState(ctx, &adminservice.RebuildMutableStateRequest{
+			Namespace: nsName.String(),
+			Execution: execution,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("rebuild mutable state failed: %w", err)
+		}
+	}
+
+	// tasks are refreshed after a rebuild too, since the rebuilt mutable state can have different timers and
+	// activities
+	ctx, cancel := newContext(v.c)
+	defer cancel()
+	_, err := v.adminClient.RefreshWorkflowTasks(ctx, &adminservice.RefreshWorkflowTasksRequest{
+		NamespaceId: workflow.namespaceID,
+		Execution:   execution,
+		Archetype:   chasm.WorkflowArchetype,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to refresh workflow tasks: %w", err)
+	}
+	return nil
+}
+
+// report repairs the findings if requested and prints them. It returns an error if any problem is left unrepaired.
+func (v *executionVerifier) report(findings []verifyFinding, executionCount int) error {
+	if v.c.Bool(FlagRepair) {
+		v.repair(findings)
+	}
+	unrepaired := 0
+	for _, finding := range findings {
+		if finding.RepairResult != verifyRepairSucceeded {
+			unrepaired++
+		}
+	}
+
+	if v.c.Bool(FlagPrintJSON) {
+		if findings == nil {
+			findings = []verifyFinding{}
+		}
+		if err := newEncoder(v.c.App.Writer).Encode(verifyResult{Executions: executionCount, Findings: findings}); err != nil {
+			return err
+		}
+	} else {
+		items := make([]interface{}, len(findings))
+		for i, finding := range findings {
+			items[i] = finding
+		}
+		if err := printTable(items, v.c.App.Writer); err != nil {
+			return err
+		}
+		// nolint:errcheck // assuming that write will succeed.
+		fmt.Fprintf(v.c.App.Writer, "Verified %d executions, found %d problems.\n", executionCount, len(findings))
+	}
+
+	if unrepaired > 0 {
+		return fmt.Errorf("%d problems are not repaired", unrepaired)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type verifyTestClient struct {
+	adminservice.AdminServiceClient
+	t *testing.T
+
+	// mutable states by run ID
+	mutableStates map[string]*persistencespb.WorkflowMutableState
+	timerTasks    []*adminservice.Task
+	branches      []*adminservice.ListHistoryTreeBranchesResponse_Branch
+
+	rebuilt   []string
+	refreshed []string
+}
+
+func (t *verifyTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *verifyTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	return &verifyTestWorkflowClient{t: t.t}
+}
+
+type verifyTestWorkflowClient struct {
+	workflowservice.WorkflowServiceClient
+	t *testing.T
+}
+
+func (t *verifyTestWorkflowClient) DescribeNamespace(_ context.Context, request *workflowservice.DescribeNamespaceRequest, _ ...grpc.CallOption) (*workflowservice.DescribeNamespaceResponse, error) {
+	require.Equal(t.t, inspectTestNamespaceID, request.GetId())
+	return &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Id: inspectTestNamespaceID, Name: "test-namespace"},
+	}, nil
+}
+
+func (t *verifyTestClient) DescribeMutableState(_ context.Context, request *adminservice.DescribeMutableStateRequest, _ ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
+	mutableState, ok := t.mutableStates[request.GetExecution().GetRunId()]
+	if !ok {
+		return nil, serviceerror.NewNotFound("workflow not found")
+	}
+	return &adminservice.DescribeMutableStateResponse{
+		ShardId:              "1",
+		DatabaseMutableState: mutableState,
+	}, nil
+}
+
+func (t *verifyTestClient) GetWorkflowExecutionRawHistoryV2(_ context.Context, request *adminservice.GetWorkflowExecutionRawHistoryV2Request, _ ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	if _, ok := t.mutableStates[request.GetExecution().GetRunId()]; !ok {
+		return nil, serviceerror.NewNotFound("workflow not found")
+	}
+	events := newInspectTestEvents()
+	require.Equal(t.t, int64(len(events)+1), request.GetEndEventId())
+	blob, err := serialization.NewSerializer().SerializeEvents(events)
+	require.NoError(t.t, err)
+	return &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*commonpb.DataBlob{blob},
+	}, nil
+}
+
+func (t *verifyTestClient) ListHistoryTasks(_ context.Context, request *adminservice.ListHistoryTasksRequest, _ ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
+	require.Equal(t.t, int32(1), request.GetShardId())
+	require.Equal(t.t, int32(tasks.CategoryIDTimer), request.GetCategory())
+	return &adminservice.ListHistoryTasksResponse{Tasks: t.timerTasks}, nil
+}
+
+func (t *verifyTestClient) ListConcreteExecutions(_ context.Context, request *adminservice.ListConcreteExecutionsRequest, _ ...grpc.CallOption) (*adminservice.ListConcreteExecutionsResponse, error) {
+	require.Equal(t.t, int32(1), request.GetShardId())
+	resp := &adminservice.ListConcreteExecutionsResponse{}
+	for _, mutableState := range t.mutableStates {
+		resp.MutableStates = append(resp.MutableStates, mutableState)
+	}
+	return resp, nil
+}
+
+func (t *verifyTestClient) DescribeCluster(context.Context, *adminservice.DescribeClusterRequest, ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
+	return &adminservice.DescribeClusterResponse{HistoryShardCount: 1}, nil
+}
+
+func (t *verifyTestClient) ListHistoryTreeBranches(context.Context, *adminservice.ListHistoryTreeBranchesRequest, ...grpc.CallOption) (*adminservice.ListHistoryTreeBranchesResponse, error) {
+	return &adminservice.ListHistoryTreeBranchesResponse{Branches: t.branches}, nil
+}
+
+func (t *verifyTestClient) RebuildMutableState(_ context.Context, request *adminservice.RebuildMutableStateRequest, _ ...grpc.CallOption) (*adminservice.RebuildMutableStateResponse, error) {
+	require.Equal(t.t, "test-namespace", request.GetNamespace())
+	t.rebuilt = append(t.rebuilt, request.GetExecution().GetRunId())
+	return &adminservice.RebuildMutableStateResponse{}, nil
+}
+
+func (t *verifyTestClient) RefreshWorkflowTasks(_ context.Context, request *adminservice.RefreshWorkflowTasksRequest, _ ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
+	require.Equal(t.t, inspectTestNamespaceID, request.GetNamespaceId())
+	t.refreshed = append(t.refreshed, request.GetExecution().GetRunId())
+	return &adminservice.RefreshWorkflowTasksResponse{}, nil
+}
+
+// newVerifyTestMutableState returns the mutable state of a running workflow that is consistent with
+// newInspectTestEvents.
+func newVerifyTestMutableState(runID string) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    inspectTestNamespaceID,
+			WorkflowId:     inspectTestWorkflowID,
+			LastUpdateTime: timestamppb.New(time.Now().Add(-time.Minute)),
+			VersionHistories: &historyspb.VersionHistories{
+				Histories: []*historyspb.VersionHistory{
+					{Items: []*historyspb.VersionHistoryItem{{EventId: 5, Version: 0}}},
+				},
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId: runID,
+			State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		},
+		NextEventId: 6,
+		ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+			5: {ScheduledEventId: 5, ActivityId: "activity-1"},
+		},
+		TimerInfos: map[string]*persistencespb.TimerInfo{
+			"timer-2": {TimerId: "timer-2", StartedEventId: 4},
+		},
+	}
+}
+
+func newVerifyTestTimerTasks(runID string, taskTypes ...enumsspb.TaskType) []*adminservice.Task {
+	var timerTasks []*adminservice.Task
+	for _, taskType := range taskTypes {
+		timerTasks = append(timerTasks, &adminservice.Task{
+			NamespaceId: inspectTestNamespaceID,
+			WorkflowId:  inspectTestWorkflowID,
+			RunId:       runID,
+			TaskType:    taskType,
+		})
+	}
+	return timerTasks
+}
+
+func runVerify(t *testing.T, clientFactory ClientFactory, args ...string) (verifyResult, error) {
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = clientFactory
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+	var out bytes.Buffer
+	app.Writer = &out
+
+	err := app.Run(append([]string{"tdbg"}, args...))
+	var result verifyResult
+	require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	return result, err
+}
+
+func TestAdminVerifyWorkflow(t *testing.T) {
+	client := &verifyTestClient{
+		t:             t,
+		mutableStates: map[string]*persistencespb.WorkflowMutableState{inspectTestRunID: newVerifyTestMutableState(inspectTestRunID)},
+		timerTasks:    newVerifyTestTimerTasks(inspectTestRunID, enumsspb.TASK_TYPE_USER_TIMER, enumsspb.TASK_TYPE_ACTIVITY_TIMEOUT),
+	}
+
+	result, err := runVerify(t, client, "--namespace", "test-namespace", "workflow", "verify", "--workflow-id", inspectTestWorkflowID, "--run-id", inspectTestRunID, "--print-json")
+	require.NoError(t, err)
+	require.Equal(t, verifyResult{Executions: 1, Findings: []verifyFinding{}}, result)
+}
+
+func TestAdminVerifyWorkflow_Repair(t *testing.T) {
+	mutableState := newVerifyTestMutableState(inspectTestRunID)
+	// the activity completed in history but is still pending in mutable state
+	mutableState.ActivityInfos[3] = &persistencespb.ActivityInfo{ScheduledEventId: 3, ActivityId: "activity-0"}
+	client := &verifyTestClient{
+		t:             t,
+		mutableStates: map[string]*persistencespb.WorkflowMutableState{inspectTestRunID: mutableState},
+		timerTasks:    newVerifyTestTimerTasks(inspectTestRunID, enumsspb.TASK_TYPE_ACTIVITY_TIMEOUT),
+	}
+
+	args := []string{"--namespace", "test-namespace", "workflow", "verify", "--workflow-id", inspectTestWorkflowID, "--run-id", inspectTestRunID, "--print-json"}
+	result, err := runVerify(t, client, args...)
+	require.ErrorContains(t, err, "2 problems are not repaired")
+	require.Equal(t, []verifyFinding{
+		{
+			NamespaceID: inspectTestNamespaceID,
+			WorkflowID:  inspectTestWorkflowID,
+			RunID:       inspectTestRunID,
+			Check:       verifyCheckPendingActivity,
+			Details:     "Activity scheduled by event 3 is pending in mutable state but not in history",
+			Repair:      verifyRepairRebuild,
+		},
+		{
+			NamespaceID: inspectTestNamespaceID,
+			WorkflowID:  inspectTestWorkflowID,
+			RunID:       inspectTestRunID,
+			Check:       verifyCheckDanglingTimer,
+			Details:     "1 pending timers but no user timer task",
+			Repair:      verifyRepairRefreshTasks,
+		},
+	}, result.Findings)
+	require.Empty(t, client.rebuilt)
+
+	result, err = runVerify(t, client, append([]string{"--yes"}, append(args, "--repair")...)...)
+	require.NoError(t, err)
+	require.Len(t, result.Findings, 2)
+	for _, finding := range result.Findings {
+		require.Equal(t, verifyRepairSucceeded, finding.RepairResult)
+	}
+	// the workflow is repaired once, by rebuilding mutable state and then refreshing tasks
+	require.Equal(t, []string{inspectTestRunID}, client.rebuilt)
+	require.Equal(t, []string{inspectTestRunID}, client.refreshed)
+}
+
+type verifyTestFlags map[string]bool
+
+func (f verifyTestFlags) Bool(name string) bool {
+	return f[name]
+}
+
+func TestVerifyRepair_Declined(t *testing.T) {
+	client := &verifyTestClient{t: t}
+	var prompt bytes.Buffer
+	exited := errors.New("exited")
+	v := &executionVerifier{
+		adminClient: client,
+		prompter: NewPrompter(verifyTestFlags{}, func(params *PrompterParams) {
+			params.Reader = strings.NewReader("n\n")
+			params.Writer = &prompt
+			params.Exiter = func(code int) {
+				require.Equal(t, 1, code)
+				panic(exited)
+			}
+		}),
+	}
+	findings := []verifyFinding{
+		{NamespaceID: inspectTestNamespaceID, WorkflowID: inspectTestWorkflowID, RunID: inspectTestRunID, Repair: verifyRepairRebuild},
+		{NamespaceID: inspectTestNamespaceID, WorkflowID: inspectTestWorkflowID, RunID: inspectTestRunID, Repair: verifyRepairRefreshTasks},
+		{NamespaceID: inspectTestNamespaceID, WorkflowID: inspectTestWorkflowID, RunID: "run-2", Repair: verifyRepairRefreshTasks},
+	}
+
+	require.PanicsWithValue(t, exited, func() { v.repair(findings) })
+	require.Equal(t, "Rebuild the mutable state of 1 workflows from their history and refresh the tasks of 2 workflows? [y/N]: ", prompt.String())
+	require.Empty(t, client.rebuilt)
+	require.Empty(t, client.refreshed)
+}
+
+func TestAdminVerifyShard(t *testing.T) {
+	inconsistent := newVerifyTestMutableState("run-2")
+	inconsistent.NextEventId = 8
+	inconsistent.ExecutionInfo.LastUpdateTime = timestamppb.New(time.Now().Add(time.Minute))
+	oldForkTime := timestamppb.New(time.Now().Add(-2 * time.Hour))
+	client := &verifyTestClient{
+		t: t,
+		mutableStates: map[string]*persistencespb.WorkflowMutableState{
+			inspectTestRunID: newVerifyTestMutableState(inspectTestRunID),
+			"run-2":          inconsistent,
+		},
+		timerTasks: newVerifyTestTimerTasks(inspectTestRunID, enumsspb.TASK_TYPE_USER_TIMER, enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER),
+		branches: []*adminservice.ListHistoryTreeBranchesResponse_Branch{
+			{
+				BranchInfo: &persistencespb.HistoryBranch{TreeId: "tree-1", BranchId: "branch-1"},
+				ForkTime:   oldForkTime,
+				Info:       persistence.BuildHistoryGarbageCleanupInfo(inspectTestNamespaceID, inspectTestWorkflowID, inspectTestRunID),
+			},
+			{
+				BranchInfo: &persistencespb.HistoryBranch{TreeId: "tree-3", BranchId: "branch-3"},
+				ForkTime:   oldForkTime,
+				Info:       persistence.BuildHistoryGarbageCleanupInfo(inspectTestNamespaceID, inspectTestWorkflowID, "run-3"),
+			},
+			{
+				// too young to be reported
+				BranchInfo: &persistencespb.HistoryBranch{TreeId: "tree-4", BranchId: "branch-4"},
+				ForkTime:   timestamppb.Now(),
+				Info:       persistence.BuildHistoryGarbageCleanupInfo(inspectTestNamespaceID, inspectTestWorkflowID, "run-4"),
+			},
+		},
+	}
+
+	result, err := runVerify(t, client, "shard", "verify", "--shard-id", "1", "--include-history-branches", "--print-json")
+	require.ErrorContains(t, err, "2 problems are not repaired")
+	require.Equal(t, 2, result.Executions)
+	// run-2 was updated after the scan of timer tasks started, so its tasks aren't checked
+	require.Equal(t, []verifyFinding{
+		{
+			NamespaceID: inspectTestNamespaceID,
+			WorkflowID:  inspectTestWorkflowID,
+			RunID:       "run-2",
+			Check:       verifyCheckHistoryBranch,
+			Details:     "Next event ID 8 doesn't follow last event 5",
+			Repair:      verifyRepairRebuild,
+		},
+		{
+			NamespaceID: inspectTestNamespaceID,
+			WorkflowID:  inspectTestWorkflowID,
+			RunID:       "run-3",
+			Check:       verifyCheckOrphanedBranch,
+			Details:     "treeId=tree-3, branchId=branch-3, forkTime=" + oldForkTime.AsTime().Format(defaultDateTimeFormat),
+		},
+	}, result.Findings)
+}
+
+func TestVerifyHistoryBranch(t *testing.T) {
+	mutableState := newVerifyTestMutableState(inspectTestRunID)
+	lastItem := &historyspb.VersionHistoryItem{EventId: 5, Version: 0}
+	events := newInspectTestEvents()
+	require.Empty(t, verifyHistoryBranch(mutableState, lastItem, events))
+
+	require.Equal(t,
+		"Event 3 found at position 1 of history",
+		verifyHistoryBranch(mutableState, lastItem, append(events[:1:1], events[2:]...)),
+	)
+	require.Equal(t,
+		"Last history event 4 (version 0) doesn't match last version history item 5 (version 0)",
+		verifyHistoryBranch(mutableState, lastItem, events[:4]),
+	)
+	require.Equal(t,
+		"Last history event 5 (version 0) doesn't match last version history item 5 (version 2)",
+		verifyHistoryBranch(mutableState, &historyspb.VersionHistoryItem{EventId: 5, Version: 2}, events),
+	)
+}
@@ -0,0 +1,677 @@
+package tdbg
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// inspectEntry is one row of the timeline printed by `workflow inspect`.
+	inspectEntry struct {
+		EventID int64  `json:"event_id,omitempty"`
+		Time    string `json:"time,omitempty"`
+		Kind    string `json:"kind"`
+		Type    string `json:"type"`
+		Status  string `json:"status,omitempty"`
+		Details string `json:"details,omitempty"`
+
+		time time.Time
+	}
+
+	inspectWorkflow struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+)
+
+const (
+	inspectKindEvent    = "event"
+	inspectKindActivity = "activity"
+	inspectKindTimer    = "timer"
+	inspectKindChild    = "child"
+	inspectKindTask     = "task"
+	inspectKindDLQ      = "dlq"
+)
+
+// inspectKindOrder orders entries with the same event ID, so that an event comes before the pending state and tasks
+// that reference it.
+var inspectKindOrder = []string{
+	inspectKindEvent,
+	inspectKindActivity,
+	inspectKindTimer,
+	inspectKindChild,
+	inspectKindTask,
+	inspectKindDLQ,
+}
+
+// Attribute fields of history events that are included in the details column, in order.
+var inspectEventDetailFields = []protoreflect.Name{
+	"activity_id",
+	"activity_type",
+	"timer_id",
+	"workflow_type",
+	"workflow_id",
+	"workflow_execution",
+	"task_queue",
+	"signal_name",
+	"scheduled_event_id",
+	"started_event_id",
+	"initiated_event_id",
+	"attempt",
+}
+
+// AdminInspectWorkflow prints a timeline of a workflow's history events joined with its pending activities, timers
+// and child workflows, and optionally its history tasks and DLQ entries.
+func AdminInspectWorkflow(c *cli.Context, clientFactory ClientFactory, taskCategoryRegistry tasks.TaskCategoryRegistry) error {
+	var entries []inspectEntry
+	if inputFileName := c.String(FlagInputFilename); inputFileName != "" {
+		if c.Bool(FlagIncludeTasks) || c.Bool(FlagIncludeDLQ) {
+			return fmt.Errorf("--%s and --%s can't be used with --%s", FlagIncludeTasks, FlagIncludeDLQ, FlagInputFilename)
+		}
+		events, err := readHistoryFile(inputFileName)
+		if err != nil {
+			return err
+		}
+		entries = append(inspectEvents(events), inspectPendingFromHistory(events)...)
+	} else {
+		var err error
+		entries, err = inspectWorkflowExecution(c, clientFactory, taskCategoryRegistry)
+		if err != nil {
+			return err
+		}
+	}
+
+	entries = filterInspectEntries(c, entries)
+	slices.SortStableFunc(entries, compareInspectEntries)
+	for i := range entries {
+		// immediate tasks and unset timestamps have the unix epoch as their time
+		if entries[i].time.Unix() > 0 {
+			entries[i].Time = entries[i].time.Format(defaultDateTimeFormat)
+		}
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		if entries == nil {
+			entries = []inspectEntry{}
+		}
+		return newEncoder(c.App.Writer).Encode(entries)
+	}
+	items := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		items[i] = entry
+	}
+	return printTable(items, c.App.Writer)
+}
+
+func inspectWorkflowExecution(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) ([]inspectEntry, error) {
+	resp, err := describeMutableState(c, clientFactory)
+	if err != nil {
+		return nil, err
+	}
+	mutableState := resp.GetDatabaseMutableState()
+	workflow := newInspectWorkflow(mutableState)
+
+	adminClient := clientFactory.AdminClient(c)
+	events, err := getWorkflowHistoryEvents(c, adminClient, workflow, mutableState.GetNextEventId())
+	if err != nil {
+		return nil, err
+	}
+	entries := append(inspectEvents(events), inspectPendingFromMutableState(mutableState)...)
+
+	if c.Bool(FlagIncludeTasks) {
+		shardID, err := strconv.Atoi(resp.GetShardId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid shard ID %q: %w", resp.GetShardId(), err)
+		}
+		taskEntries, err := inspectHistoryTasks(c, adminClient, taskCategoryRegistry, int32(shardID), workflow)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, taskEntries...)
+	}
+	if c.Bool(FlagIncludeDLQ) {
+		dlqEntries, err := inspectDLQTasks(c, adminClient, taskCategoryRegistry, workflow)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, dlqEntries...)
+	}
+	return entries, nil
+}
+
+func getWorkflowHistoryEvents(
+	c *cli.Context,
+	adminClient adminservice.AdminServiceClient,
+	workflow inspectWorkflow,
+	nextEventID int64,
+) ([]*historypb.HistoryEvent, error) {
+	serializer := serialization.NewSerializer()
+	var events []*historypb.HistoryEvent
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			NamespaceId: workflow.namespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflow.workflowID,
+				RunId:      workflow.runID,
+			},
+			EndEventId:      nextEventID,
+			MaximumPageSize: 100,
+			NextPageToken:   token,
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get workflow history: %w", err)
+		}
+		for _, blob := range resp.GetHistoryBatches() {
+			batch, err := serializer.DeserializeEvents(blob)
+			if err != nil {
+				return nil, fmt.Errorf("unable to deserialize Events: %w", err)
+			}
+			events = append(events, batch...)
+		}
+		token = resp.GetNextPageToken()
+	}
+	return events, nil
+}
+
+// readHistoryFile reads history events from a file written by `workflow show --output-filename`, which contains a
+// list of history batches, or from a file with a single history as exported by the UI and CLI.
+func readHistoryFile(fileName string) ([]*historypb.HistoryEvent, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read History data file: %w", err)
+	}
+	encoder := codec.NewJSONPBEncoder()
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		histories, err := encoder.DecodeHistories(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to deserialize History data: %w", err)
+		}
+		var events []*historypb.HistoryEvent
+		for _, history := range histories {
+			events = append(events, history.GetEvents()...)
+		}
+		return events, nil
+	}
+	var history historypb.History
+	if err := encoder.Decode(data, &history); err != nil {
+		return nil, fmt.Errorf("unable to deserialize History data: %w", err)
+	}
+	return history.GetEvents(), nil
+}
+
+func inspectEvents(events []*historypb.HistoryEvent) []inspectEntry {
+	entries := make([]inspectEntry, len(events))
+	for i, event := range events {
+		entries[i] = inspectEntry{
+			EventID: event.GetEventId(),
+			Kind:    inspectKindEvent,
+			Type:    event.GetEventType().String(),
+			Details: inspectEventDetails(event),
+			time:    event.GetEventTime().AsTime(),
+		}
+	}
+	return entries
+}
+
+func inspectEventDetails(event *historypb.HistoryEvent) string {
+	m := event.ProtoReflect()
+	attributesField := m.WhichOneof(m.Descriptor().Oneofs().ByName("attributes"))
+	if attributesField == nil {
+		return ""
+	}
+	attributes := m.Get(attributesField).Message()
+	var details []string
+	for _, name := range inspectEventDetailFields {
+		field := attributes.Descriptor().Fields().ByName(name)
+		if field == nil || !attributes.Has(field) {
+			continue
+		}
+		value := attributes.Get(field)
+		var text string
+		if field.Kind() == protoreflect.MessageKind {
+			text = inspectMessageSummary(value.Message())
+		} else {
+			text = value.String()
+		}
+		if text != "" {
+			details = append(details, field.JSONName()+"="+text)
+		}
+	}
+	return strings.Join(details, ", ")
+}
+
+// inspectMessageSummary returns the name of types like ActivityType and TaskQueue, or the IDs of a
+// WorkflowExecution.
+func inspectMessageSummary(m protoreflect.Message) string {
+	fields := m.Descriptor().Fields()
+	if name := fields.ByName("name"); name != nil {
+		return m.Get(name).String()
+	}
+	if workflowID := fields.ByName("workflow_id"); workflowID != nil {
+		summary := m.Get(workflowID).String()
+		if runID := fields.ByName("run_id"); runID != nil && m.Has(runID) {
+			summary += "/" + m.Get(runID).String()
+		}
+		return summary
+	}
+	return ""
+}
+
+// inspectPendingFromHistory finds pending activities, timers and child workflows by replaying history events, for when
+// mutable state isn't available.
+func inspectPendingFromHistory(events []*historypb.HistoryEvent) []inspectEntry {
+	activities := make(map[int64]*inspectEntry)
+	timers := make(map[int64]*inspectEntry)
+	children := make(map[int64]*inspectEntry)
+
+	for _, event := range events {
+		eventTime := event.GetEventTime().AsTime()
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+			attrs := event.GetActivityTaskScheduledEventAttributes()
+			activities[event.GetEventId()] = &inspectEntry{
+				EventID: event.GetEventId(),
+				Kind:    inspectKindActivity,
+				Type:    attrs.GetActivityType().GetName(),
+				Status:  "Scheduled",
+				Details: "activityId=" + attrs.GetActivityId(),
+				time:    eventTime,
+			}
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED:
+			attrs := event.GetActivityTaskStartedEventAttributes()
+			if activity, ok := activities[attrs.GetScheduledEventId()]; ok {
+				activity.Status = "Started"
+				activity.Details += fmt.Sprintf(", attempt=%d", attrs.GetAttempt())
+			}
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+			delete(activities, event.GetActivityTaskCompletedEventAttributes().GetScheduledEventId())
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+			delete(activities, event.GetActivityTaskFailedEventAttributes().GetScheduledEventId())
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+			delete(activities, event.GetActivityTaskTimedOutEventAttributes().GetScheduledEventId())
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+			delete(activities, event.GetActivityTaskCanceledEventAttributes().GetScheduledEventId())
+
+		case enumspb.EVENT_TYPE_TIMER_STARTED:
+			attrs := event.GetTimerStartedEventAttributes()
+			expiryTime := eventTime.Add(attrs.GetStartToFireTimeout().AsDuration())
+			timers[event.GetEventId()] = &inspectEntry{
+				EventID: event.GetEventId(),
+				Kind:    inspectKindTimer,
+				Type:    "Timer",
+				Status:  "Started",
+				Details: fmt.Sprintf("timerId=%s, expiryTime=%s", attrs.GetTimerId(), expiryTime.Format(defaultDateTimeFormat)),
+				time:    expiryTime,
+			}
+		case enumspb.EVENT_TYPE_TIMER_FIRED:
+			delete(timers, event.GetTimerFiredEventAttributes().GetStartedEventId())
+		case enumspb.EVENT_TYPE_TIMER_CANCELED:
+			delete(timers, event.GetTimerCanceledEventAttributes().GetStartedEventId())
+
+		case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+			attrs := event.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+			children[event.GetEventId()] = &inspectEntry{
+				EventID: event.GetEventId(),
+				Kind:    inspectKindChild,
+				Type:    attrs.GetWorkflowType().GetName(),
+				Status:  "Initiated",
+				Details: "workflowId=" + attrs.GetWorkflowId(),
+				time:    eventTime,
+			}
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+			attrs := event.GetChildWorkflowExecutionStartedEventAttributes()
+			if child, ok := children[attrs.GetInitiatedEventId()]; ok {
+				child.Status = "Started"
+				child.Details += ", runId=" + attrs.GetWorkflowExecution().GetRunId()
+			}
+		case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+			delete(children, event.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+			delete(children, event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+			delete(children, event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+			delete(children, event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+			delete(children, event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+			delete(children, event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventId())
+		}
+	}
+
+	var entries []inspectEntry
+	for _, pending := range []map[int64]*inspectEntry{activities, timers, children} {
+		for _, entry := range pending {
+			entries = append(entries, *entry)
+		}
+	}
+	return entries
+}
+
+func inspectPendingFromMutableState(mutableState *persistencespb.WorkflowMutableState) []inspectEntry {
+	var entries []inspectEntry
+	for _, activity := range mutableState.GetActivityInfos() {
+		entry := inspectEntry{
+			EventID: activity.GetScheduledEventId(),
+			Kind:    inspectKindActivity,
+			Type:    activity.GetActivityType().GetName(),
+			Status:  "Scheduled",
+			Details: fmt.Sprintf("activityId=%s, attempt=%d", activity.GetActivityId(), activity.GetAttempt()),
+			time:    activity.GetScheduledTime().AsTime(),
+		}
+		if activity.GetStartedEventId() != common.EmptyEventID {
+			entry.Status = "Started"
+			entry.time = activity.GetStartedTime().AsTime()
+		}
+		if activity.GetCancelRequested() {
+			entry.Status += ", CancelRequested"
+		}
+		if failure := activity.GetRetryLastFailure(); failure != nil {
+			entry.Details += ", lastFailure=" + failure.GetMessage()
+		}
+		entries = append(entries, entry)
+	}
+	for _, timer := range mutableState.GetTimerInfos() {
+		expiryTime := timer.GetExpiryTime().AsTime()
+		entries = append(entries, inspectEntry{
+			EventID: timer.GetStartedEventId(),
+			Kind:    inspectKindTimer,
+			Type:    "Timer",
+			Status:  "Started",
+			Details: fmt.Sprintf("timerId=%s, expiryTime=%s", timer.GetTimerId(), expiryTime.Format(defaultDateTimeFormat)),
+			time:    expiryTime,
+		})
+	}
+	for _, child := range mutableState.GetChildExecutionInfos() {
+		entry := inspectEntry{
+			EventID: child.GetInitiatedEventId(),
+			Kind:    inspectKindChild,
+			Type:    child.GetWorkflowTypeName(),
+			Status:  "Initiated",
+			Details: fmt.Sprintf("namespace=%s", child.GetNamespace()),
+		}
+		if child.GetStartedEventId() != common.EmptyEventID {
+			entry.Status = "Started"
+			entry.Details += fmt.Sprintf(", workflowId=%s, runId=%s", child.GetStartedWorkflowId(), child.GetStartedRunId())
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// inspectHistoryTasks scans all queues of the shard for the workflow's history tasks.
+func inspectHistoryTasks(
+	c *cli.Context,
+	adminClient adminservice.AdminServiceClient,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	shardID int32,
+	workflow inspectWorkflow,
+) ([]inspectEntry, error) {
+	var entries []inspectEntry
+	for _, category := range getSupportedDLQTaskCategories(taskCategoryRegistry) {
+		err := scanHistoryTasks(c, adminClient, shardID, category, func(task *adminservice.Task) {
+			if !workflow.matches(task.GetNamespaceId(), task.GetWorkflowId(), task.GetRunId()) {
+				return
+			}
+			entry := inspectEntry{
+				Kind:    inspectKindTask,
+				Type:    task.GetTaskType().String(),
+				Status:  category.Name(),
+				Details: fmt.Sprintf("taskId=%d, version=%d", task.GetTaskId(), task.GetVersion()),
+			}
+			if category.Type() == tasks.CategoryTypeScheduled {
+				entry.time = task.GetFireTime().AsTime()
+			}
+			entries = append(entries, entry)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// scanHistoryTasks calls fn for each task in the queue of the given category of the shard.
+func scanHistoryTasks(
+	c *cli.Context,
+	adminClient adminservice.AdminServiceClient,
+	shardID int32,
+	category tasks.Category,
+	fn func(task *adminservice.Task),
+) error {
+	pageSize := c.Int(FlagPageSize)
+
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListHistoryTasks(ctx, &adminservice.ListHistoryTasksRequest{
+			ShardId:  shardID,
+			Category: int32(category.ID()),
+			TaskRange: &historyspb.TaskRange{
+				InclusiveMinTaskKey: &historyspb.TaskKey{
+					FireTime: timestamppb.New(tasks.MinimumKey.FireTime),
+					TaskId:   tasks.MinimumKey.TaskID,
+				},
+				ExclusiveMaxTaskKey: &historyspb.TaskKey{
+					FireTime: timestamppb.New(tasks.MaximumKey.FireTime),
+					TaskId:   tasks.MaximumKey.TaskID,
+				},
+			},
+			BatchSize:     int32(pageSize),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to list %s tasks: %w", category.Name(), err)
+		}
+		for _, task := range resp.GetTasks() {
+			fn(task)
+		}
+		token = resp.GetNextPageToken()
+	}
+	return nil
+}
+
+// inspectDLQTasks reads all history task DLQs for the workflow's tasks.
+func inspectDLQTasks(
+	c *cli.Context,
+	adminClient adminservice.AdminServiceClient,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	workflow inspectWorkflow,
+) ([]inspectEntry, error) {
+	pageSize := c.Int(FlagPageSize)
+
+	var queues []*adminservice.ListQueuesResponse_QueueInfo
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListQueues(ctx, &adminservice.ListQueuesRequest{
+			QueueType:     int32(persistence.QueueTypeHistoryDLQ),
+			PageSize:      int32(pageSize),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to list DLQs: %w", err)
+		}
+		queues = append(queues, resp.GetQueues()...)
+		token = resp.GetNextPageToken()
+	}
+
+	serializer := serialization.NewTaskSerializer()
+	var entries []inspectEntry
+	for _, queue := range queues {
+		if queue.GetMessageCount() == 0 {
+			continue
+		}
+		category, dlqKey, ok := parseHistoryDLQKey(queue.GetQueueName(), taskCategoryRegistry)
+		if !ok {
+			continue
+		}
+		var token []byte
+		for doContinue := true; doContinue; doContinue = len(token) != 0 {
+			ctx, cancel := newContext(c)
+			resp, err := adminClient.GetDLQTasks(ctx, &adminservice.GetDLQTasksRequest{
+				DlqKey:        dlqKey,
+				PageSize:      int32(pageSize),
+				NextPageToken: token,
+			})
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf("unable to read DLQ %s: %w", queue.GetQueueName(), err)
+			}
+			for _, dlqTask := range resp.GetDlqTasks() {
+				task, err := serializer.DeserializeTask(category, dlqTask.GetPayload().GetBlob())
+				if err != nil {
+					return nil, fmt.Errorf("unable to deserialize DLQ task %d of %s: %w",
+						dlqTask.GetMetadata().GetMessageId(), queue.GetQueueName(), err)
+				}
+				if !workflow.matches(task.GetNamespaceID(), task.GetWorkflowID(), task.GetRunID()) {
+					continue
+				}
+				entries = append(entries, inspectEntry{
+					EventID: taskEventID(task),
+					Kind:    inspectKindDLQ,
+					Type:    task.GetType().String(),
+					Status:  fmt.Sprintf("%s %s->%s", category.Name(), dlqKey.GetSourceCluster(), dlqKey.GetTargetCluster()),
+					Details: fmt.Sprintf("messageId=%d, taskId=%d", dlqTask.GetMetadata().GetMessageId(), task.GetTaskID()),
+					time:    task.GetVisibilityTime(),
+				})
+			}
+			token = resp.GetNextPageToken()
+		}
+	}
+	return entries, nil
+}
+
+// parseHistoryDLQKey returns the DLQ key of a queue named by [persistence.GetHistoryTaskQueueName]. Cluster names may
+// contain the separator, so every split is checked against the name it would produce.
+func parseHistoryDLQKey(
+	queueName string,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) (tasks.Category, *commonspb.HistoryDLQKey, bool) {
+	categoryID, err := persistence.GetHistoryTaskQueueCategoryID(queueName)
+	if err != nil {
+		return tasks.Category{}, nil, false
+	}
+	category, ok := taskCategoryRegistry.GetCategoryByID(categoryID)
+	if !ok {
+		return tasks.Category{}, nil, false
+	}
+	clusters := strings.TrimPrefix(queueName, strconv.Itoa(categoryID)+"_")
+	clusters = clusters[:max(strings.LastIndex(clusters, "_"), 0)]
+	for i := range len(clusters) {
+		if clusters[i] != '_' {
+			continue
+		}
+		sourceCluster, targetCluster := clusters[:i], clusters[i+1:]
+		if persistence.GetHistoryTaskQueueName(categoryID, sourceCluster, targetCluster) == queueName {
+			return category, &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(categoryID),
+				SourceCluster: sourceCluster,
+				TargetCluster: targetCluster,
+			}, true
+		}
+	}
+	return tasks.Category{}, nil, false
+}
+
+// taskEventID returns the ID of the history event that a task was generated for, if any.
+func taskEventID(task tasks.Task) int64 {
+	switch task := task.(type) {
+	case *tasks.ActivityTask:
+		return task.ScheduledEventID
+	case *tasks.ActivityRetryTimerTask:
+		return task.EventID
+	case *tasks.ActivityTimeoutTask:
+		return task.EventID
+	case *tasks.UserTimerTask:
+		return task.EventID
+	case *tasks.WorkflowTask:
+		return task.ScheduledEventID
+	case *tasks.WorkflowTaskTimeoutTask:
+		return task.EventID
+	case *tasks.StartChildExecutionTask:
+		return task.InitiatedEventID
+	case *tasks.CancelExecutionTask:
+		return task.InitiatedEventID
+	case *tasks.SignalExecutionTask:
+		return task.InitiatedEventID
+	default:
+		return common.EmptyEventID
+	}
+}
+
+func (w inspectWorkflow) matches(namespaceID, workflowID, runID string) bool {
+	return namespaceID == w.namespaceID && workflowID == w.workflowID && runID == w.runID
+}
+
+func filterInspectEntries(c *cli.Context, entries []inspectEntry) []inspectEntry {
+	kinds := c.StringSlice(FlagEntryKind)
+	types := c.StringSlice(FlagEntryType)
+	minEventID := c.Int64(FlagMinEventID)
+	maxEventID := c.Int64(FlagMaxEventID)
+
+	return slices.DeleteFunc(entries, func(entry inspectEntry) bool {
+		if len(kinds) > 0 && !slices.Contains(kinds, entry.Kind) {
+			return true
+		}
+		if len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool {
+			return strings.Contains(strings.ToLower(entry.Type), strings.ToLower(t))
+		}) {
+			return true
+		}
+		// entries without an event ID aren't filtered by event ID
+		if entry.EventID != common.EmptyEventID {
+			if entry.EventID < minEventID || (maxEventID > 0 && entry.EventID > maxEventID) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// compareInspectEntries orders entries by event ID, and puts entries without an event ID last in time order.
+func compareInspectEntries(a, b inspectEntry) int {
+	if (a.EventID == common.EmptyEventID) != (b.EventID == common.EmptyEventID) {
+		if a.EventID == common.EmptyEventID {
+			return 1
+		}
+		return -1
+	}
+	return cmp.Or(
+		cmp.Compare(a.EventID, b.EventID),
+		cmp.Compare(slices.Index(inspectKindOrder, a.Kind), slices.Index(inspectKindOrder, b.Kind)),
+		a.time.Compare(b.time),
+	)
+}
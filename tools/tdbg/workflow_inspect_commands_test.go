@@ -0,0 +1,279 @@
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	inspectTestNamespaceID = "ns-id"
+	inspectTestWorkflowID  = "wid"
+	inspectTestRunID       = "rid"
+)
+
+type inspectTestClient struct {
+	adminservice.AdminServiceClient
+	t      *testing.T
+	events []*historypb.HistoryEvent
+}
+
+func (t *inspectTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *inspectTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *inspectTestClient) DescribeMutableState(context.Context, *adminservice.DescribeMutableStateRequest, ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
+	return &adminservice.DescribeMutableStateResponse{
+		ShardId: "3",
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: inspectTestNamespaceID,
+				WorkflowId:  inspectTestWorkflowID,
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: inspectTestRunID},
+			NextEventId:    int64(len(t.events) + 1),
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				5: {
+					ScheduledEventId: 5,
+					StartedEventId:   -124,
+					ActivityId:       "activity-1",
+					ActivityType:     &commonpb.ActivityType{Name: "MyActivity"},
+					Attempt:          3,
+				},
+			},
+		},
+	}, nil
+}
+
+func (t *inspectTestClient) GetWorkflowExecutionRawHistoryV2(_ context.Context, request *adminservice.GetWorkflowExecutionRawHistoryV2Request, _ ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	require.Equal(t.t, inspectTestNamespaceID, request.GetNamespaceId())
+	require.Equal(t.t, inspectTestRunID, request.GetExecution().GetRunId())
+	blob, err := serialization.NewSerializer().SerializeEvents(t.events)
+	require.NoError(t.t, err)
+	return &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*commonpb.DataBlob{blob},
+	}, nil
+}
+
+func (t *inspectTestClient) ListHistoryTasks(_ context.Context, request *adminservice.ListHistoryTasksRequest, _ ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
+	require.Equal(t.t, int32(3), request.GetShardId())
+	if request.GetCategory() != tasks.CategoryIDTransfer {
+		return &adminservice.ListHistoryTasksResponse{}, nil
+	}
+	return &adminservice.ListHistoryTasksResponse{
+		Tasks: []*adminservice.Task{
+			{
+				NamespaceId: inspectTestNamespaceID,
+				WorkflowId:  inspectTestWorkflowID,
+				RunId:       inspectTestRunID,
+				TaskId:      1001,
+				TaskType:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+			},
+			{
+				NamespaceId: inspectTestNamespaceID,
+				WorkflowId:  "other",
+				RunId:       inspectTestRunID,
+				TaskId:      1002,
+				TaskType:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+			},
+		},
+	}, nil
+}
+
+func (t *inspectTestClient) ListQueues(context.Context, *adminservice.ListQueuesRequest, ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
+	return &adminservice.ListQueuesResponse{
+		Queues: []*adminservice.ListQueuesResponse_QueueInfo{
+			{QueueName: persistence.GetHistoryTaskQueueName(tasks.CategoryIDTimer, "cluster_a", "cluster_a"), MessageCount: 1},
+			{QueueName: persistence.GetHistoryTaskQueueName(tasks.CategoryIDTransfer, "cluster_a", "cluster_a")},
+		},
+	}, nil
+}
+
+func (t *inspectTestClient) GetDLQTasks(_ context.Context, request *adminservice.GetDLQTasksRequest, _ ...grpc.CallOption) (*adminservice.GetDLQTasksResponse, error) {
+	require.Equal(t.t, int32(tasks.CategoryIDTimer), request.GetDlqKey().GetTaskCategory())
+	require.Equal(t.t, "cluster_a", request.GetDlqKey().GetSourceCluster())
+	blob, err := serialization.NewTaskSerializer().SerializeTask(&tasks.ActivityTimeoutTask{
+		WorkflowKey:         definition.NewWorkflowKey(inspectTestNamespaceID, inspectTestWorkflowID, inspectTestRunID),
+		VisibilityTimestamp: time.Unix(1700000000, 0),
+		TaskID:              2001,
+		TimeoutType:         enumspb.TIMEOUT_TYPE_START_TO_CLOSE,
+		EventID:             5,
+	})
+	require.NoError(t.t, err)
+	return &adminservice.GetDLQTasksResponse{
+		DlqTasks: []*commonspb.HistoryDLQTask{
+			{
+				Metadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 7},
+				Payload:  &commonspb.HistoryTask{ShardId: 3, Blob: blob},
+			},
+		},
+	}, nil
+}
+
+func newInspectTestEvents() []*historypb.HistoryEvent {
+	eventTime := timestamppb.New(time.Unix(1700000000, 0))
+	return []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventTime: eventTime,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					WorkflowType: &commonpb.WorkflowType{Name: "MyWorkflow"},
+				},
+			},
+		},
+		{
+			EventId:   2,
+			EventTime: eventTime,
+			EventType: enumspb.EVENT_TYPE_TIMER_STARTED,
+			Attributes: &historypb.HistoryEvent_TimerStartedEventAttributes{
+				TimerStartedEventAttributes: &historypb.TimerStartedEventAttributes{
+					TimerId:            "timer-1",
+					StartToFireTimeout: durationpb.New(time.Minute),
+				},
+			},
+		},
+		{
+			EventId:   3,
+			EventTime: eventTime,
+			EventType: enumspb.EVENT_TYPE_TIMER_FIRED,
+			Attributes: &historypb.HistoryEvent_TimerFiredEventAttributes{
+				TimerFiredEventAttributes: &historypb.TimerFiredEventAttributes{
+					TimerId:        "timer-1",
+					StartedEventId: 2,
+				},
+			},
+		},
+		{
+			EventId:   4,
+			EventTime: eventTime,
+			EventType: enumspb.EVENT_TYPE_TIMER_STARTED,
+			Attributes: &historypb.HistoryEvent_TimerStartedEventAttributes{
+				TimerStartedEventAttributes: &historypb.TimerStartedEventAttributes{
+					TimerId:            "timer-2",
+					StartToFireTimeout: durationpb.New(time.Hour),
+				},
+			},
+		},
+		{
+			EventId:   5,
+			EventTime: eventTime,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+				ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+					ActivityId:   "activity-1",
+					ActivityType: &commonpb.ActivityType{Name: "MyActivity"},
+				},
+			},
+		},
+	}
+}
+
+func runInspect(t *testing.T, clientFactory ClientFactory, args ...string) []inspectEntry {
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = clientFactory
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+	var out bytes.Buffer
+	app.Writer = &out
+
+	err := app.Run(append([]string{"tdbg", "workflow", "inspect", "--print-json"}, args...))
+	require.NoError(t, err)
+	var entries []inspectEntry
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entries))
+	return entries
+}
+
+func TestAdminInspectWorkflow_Offline(t *testing.T) {
+	encoder := codec.NewJSONPBEncoder()
+	data, err := encoder.EncodeHistories([]*historypb.History{{Events: newInspectTestEvents()}})
+	require.NoError(t, err)
+	fileName := filepath.Join(t.TempDir(), "history.json")
+	require.NoError(t, os.WriteFile(fileName, data, 0644))
+
+	entries := runInspect(t, nil, "--input-filename", fileName)
+	require.Len(t, entries, 7)
+	require.Equal(t, inspectEntry{
+		EventID: 1,
+		Time:    time.Unix(1700000000, 0).Format(defaultDateTimeFormat),
+		Kind:    inspectKindEvent,
+		Type:    "WorkflowExecutionStarted",
+		Details: "workflowType=MyWorkflow",
+	}, entries[0])
+	require.Equal(t, "timerId=timer-1, startedEventId=2", entries[2].Details)
+	// the fired timer isn't pending
+	require.Equal(t, inspectKindTimer, entries[4].Kind)
+	require.Equal(t, int64(4), entries[4].EventID)
+	require.Contains(t, entries[4].Details, "timerId=timer-2")
+	require.Equal(t, inspectKindActivity, entries[6].Kind)
+	require.Equal(t, "Scheduled", entries[6].Status)
+
+	entries = runInspect(t, nil, "--input-filename", fileName, "--kind", "timer,activity")
+	require.Len(t, entries, 2)
+	entries = runInspect(t, nil, "--input-filename", fileName, "--entry-type", "timer", "--max-event-id", "3")
+	require.Len(t, entries, 2)
+}
+
+func TestAdminInspectWorkflow_Online(t *testing.T) {
+	client := &inspectTestClient{t: t, events: newInspectTestEvents()}
+
+	entries := runInspect(t, client, "--workflow-id", inspectTestWorkflowID, "--include-tasks", "--include-dlq")
+	// pending state comes from mutable state, so there is no pending timer
+	require.Len(t, entries, 8)
+	require.Equal(t, int64(5), entries[5].EventID)
+	require.Equal(t, inspectKindActivity, entries[5].Kind)
+	require.Equal(t, "Started", entries[5].Status)
+	require.Equal(t, "activityId=activity-1, attempt=3", entries[5].Details)
+	require.Equal(t, inspectKindDLQ, entries[6].Kind)
+	require.Equal(t, int64(5), entries[6].EventID)
+	require.Equal(t, "timer cluster_a->cluster_a", entries[6].Status)
+	require.Equal(t, "messageId=7, taskId=2001", entries[6].Details)
+	require.Equal(t, inspectEntry{
+		Kind:    inspectKindTask,
+		Type:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK.String(),
+		Status:  "transfer",
+		Details: "taskId=1001, version=0",
+	}, entries[7])
+}
+
+func TestParseHistoryDLQKey(t *testing.T) {
+	registry := tasks.NewDefaultTaskCategoryRegistry()
+	queueName := persistence.GetHistoryTaskQueueName(tasks.CategoryIDTransfer, "a_b", "c")
+
+	category, key, ok := parseHistoryDLQKey(queueName, registry)
+	require.True(t, ok)
+	require.Equal(t, tasks.CategoryTransfer, category)
+	require.Equal(t, "a_b", key.GetSourceCluster())
+	require.Equal(t, "c", key.GetTargetCluster())
+
+	_, _, ok = parseHistoryDLQKey("1_a_b", registry)
+	require.False(t, ok)
+}